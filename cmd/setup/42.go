@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 42.sql
+	addSAMLOptions string
+)
+
+type Apps7SAMLConfigsOptions struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7SAMLConfigsOptions) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLOptions)
+	return err
+}
+
+func (mig *Apps7SAMLConfigsOptions) String() string {
+	return "42_apps7_saml_configs_add_options"
+}
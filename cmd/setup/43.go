@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 43.sql
+	addExecutionCondition string
+)
+
+type Executions1AddCondition struct {
+	dbClient *database.DB
+}
+
+func (mig *Executions1AddCondition) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addExecutionCondition)
+	return err
+}
+
+func (mig *Executions1AddCondition) String() string {
+	return "43_executions1_add_condition"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.executions1 ADD COLUMN IF NOT EXISTS condition TEXT;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 44.sql
+	addTargetTransports string
+)
+
+type Targets2AddTransports struct {
+	dbClient *database.DB
+}
+
+func (mig *Targets2AddTransports) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTargetTransports)
+	return err
+}
+
+func (mig *Targets2AddTransports) String() string {
+	return "44_targets2_add_transports"
+}
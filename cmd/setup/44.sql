@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS tls JSONB;
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS oauth2 JSONB;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 45.sql
+	addTargetModule string
+)
+
+type Targets2AddModule struct {
+	dbClient *database.DB
+}
+
+func (mig *Targets2AddModule) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTargetModule)
+	return err
+}
+
+func (mig *Targets2AddModule) String() string {
+	return "45_targets2_add_module"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.targets2 ADD COLUMN IF NOT EXISTS module BYTEA;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 46.sql
+	addOIDCAppRequireConsent string
+)
+
+type Apps7OIDCConfigsRequireConsent struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequireConsent) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCAppRequireConsent)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequireConsent) String() string {
+	return "46_apps7_oidc_configs_require_consent"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 47.sql
+	addOIDCAppRequiredACR string
+)
+
+type Apps7OIDCConfigsRequiredACR struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequiredACR) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCAppRequiredACR)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequiredACR) String() string {
+	return "47_apps7_oidc_configs_required_acr"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 48.sql
+	addTrustedDevicesColumns string
+)
+
+type TrustedDevices struct {
+	dbClient *database.DB
+}
+
+func (mig *TrustedDevices) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTrustedDevicesColumns)
+	return err
+}
+
+func (mig *TrustedDevices) String() string {
+	return "48_trusted_devices"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 49.sql
+	addSessionRiskColumns string
+)
+
+type SessionRisk struct {
+	dbClient *database.DB
+}
+
+func (mig *SessionRisk) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionRiskColumns)
+	return err
+}
+
+func (mig *SessionRisk) String() string {
+	return "49_session_risk"
+}
@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_score BIGINT;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_action SMALLINT;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_signals SMALLINT[];
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_evaluated_at TIMESTAMPTZ;
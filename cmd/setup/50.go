@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 50.sql
+	addSecurityNotificationColumns string
+)
+
+type SecurityNotifications struct {
+	dbClient *database.DB
+}
+
+func (mig *SecurityNotifications) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSecurityNotificationColumns)
+	return err
+}
+
+func (mig *SecurityNotifications) String() string {
+	return "50_security_notifications"
+}
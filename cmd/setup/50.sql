@@ -0,0 +1,7 @@
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_new_device_sign_in BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_mfa_added BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_mfa_removed BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_passkey_added BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_email_changed BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_account_locked BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_pat_created BOOLEAN DEFAULT FALSE;
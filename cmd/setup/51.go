@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 51.sql
+	addSMSProviderTables string
+)
+
+type SMSProviders struct {
+	dbClient *database.DB
+}
+
+func (mig *SMSProviders) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSMSProviderTables)
+	return err
+}
+
+func (mig *SMSProviders) String() string {
+	return "51_sms_providers"
+}
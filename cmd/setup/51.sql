@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS projections.sms_configs3_vonage (sms_id TEXT NOT NULL, instance_id TEXT NOT NULL, api_key TEXT NOT NULL, api_secret JSONB NOT NULL, sender_number TEXT NOT NULL, verify_brand TEXT NOT NULL, PRIMARY KEY (instance_id, sms_id), CONSTRAINT fk_vonage_ref_sms_configs3 FOREIGN KEY (instance_id, sms_id) REFERENCES projections.sms_configs3 ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS projections.sms_configs3_sns (sms_id TEXT NOT NULL, instance_id TEXT NOT NULL, region TEXT NOT NULL, access_key_id TEXT NOT NULL, secret_access_key JSONB NOT NULL, sender_id TEXT NOT NULL, PRIMARY KEY (instance_id, sms_id), CONSTRAINT fk_sns_ref_sms_configs3 FOREIGN KEY (instance_id, sms_id) REFERENCES projections.sms_configs3 ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS projections.sms_configs3_messagebird (sms_id TEXT NOT NULL, instance_id TEXT NOT NULL, access_key JSONB NOT NULL, originator TEXT NOT NULL, verify BOOLEAN NOT NULL, PRIMARY KEY (instance_id, sms_id), CONSTRAINT fk_messagebird_ref_sms_configs3 FOREIGN KEY (instance_id, sms_id) REFERENCES projections.sms_configs3 ON DELETE CASCADE);
+CREATE TABLE IF NOT EXISTS projections.sms_configs3_smpp (sms_id TEXT NOT NULL, instance_id TEXT NOT NULL, host TEXT NOT NULL, tls BOOLEAN NOT NULL, system_id TEXT NOT NULL, password JSONB NOT NULL, system_type TEXT NOT NULL, source_address TEXT NOT NULL, PRIMARY KEY (instance_id, sms_id), CONSTRAINT fk_smpp_ref_sms_configs3 FOREIGN KEY (instance_id, sms_id) REFERENCES projections.sms_configs3 ON DELETE CASCADE);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 52.sql
+	addAccessValidityColumns string
+)
+
+type AccessValidity struct {
+	dbClient *database.DB
+}
+
+func (mig *AccessValidity) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAccessValidityColumns)
+	return err
+}
+
+func (mig *AccessValidity) String() string {
+	return "52_access_validity"
+}
@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.instance_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.org_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_grant_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ, ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 53.sql
+	addPasswordExpiryNotified string
+)
+
+type PasswordExpiryNotified struct {
+	dbClient *database.DB
+}
+
+func (mig *PasswordExpiryNotified) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordExpiryNotified)
+	return err
+}
+
+func (mig *PasswordExpiryNotified) String() string {
+	return "53_password_expiry_notified"
+}
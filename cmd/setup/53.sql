@@ -0,0 +1,13 @@
+ALTER TABLE IF EXISTS projections.users13_humans ADD COLUMN IF NOT EXISTS password_expiry_notified SMALLINT DEFAULT 0;
+
+UPDATE projections.users13_humans h SET password_expiry_notified = n.threshold
+FROM (
+    SELECT e.instance_id, e.aggregate_id, MAX((e.payload->>'threshold')::SMALLINT) AS threshold
+    FROM eventstore.events2 e
+    JOIN projections.users13_humans u ON u.instance_id = e.instance_id AND u.user_id = e.aggregate_id
+    WHERE e.aggregate_type = 'user'
+        AND e.event_type = 'user.human.password.expiry.notification.added'
+        AND e.created_at >= u.password_changed
+    GROUP BY e.instance_id, e.aggregate_id
+) n
+WHERE h.instance_id = n.instance_id AND h.user_id = n.aggregate_id AND h.password_expiry_notified < n.threshold;
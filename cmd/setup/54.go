@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 54.sql
+	addLoginPolicyRiskThresholds string
+)
+
+type LoginPolicyRiskThresholds struct {
+	dbClient *database.DB
+}
+
+func (mig *LoginPolicyRiskThresholds) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLoginPolicyRiskThresholds)
+	return err
+}
+
+func (mig *LoginPolicyRiskThresholds) String() string {
+	return "54_login_policy_risk_thresholds"
+}
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS risk_notify_threshold BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS risk_require_mfa_threshold BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS risk_block_threshold BIGINT DEFAULT 0;
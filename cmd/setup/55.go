@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 55.sql
+	addSMPPTLSConfig string
+)
+
+type SMPPTLSConfig struct {
+	dbClient *database.DB
+}
+
+func (mig *SMPPTLSConfig) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSMPPTLSConfig)
+	return err
+}
+
+func (mig *SMPPTLSConfig) String() string {
+	return "55_smpp_tls_config"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.sms_configs3_smpp ADD COLUMN IF NOT EXISTS tls_ca TEXT DEFAULT '';
+ALTER TABLE IF EXISTS projections.sms_configs3_smpp ADD COLUMN IF NOT EXISTS tls_server_name TEXT DEFAULT '';
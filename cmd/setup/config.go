@@ -129,6 +129,20 @@ type Steps struct {
 	s40InitPushFunc                         *InitPushFunc
 	s39DeleteStaleOrgFields                 *DeleteStaleOrgFields
 	s41FillFieldsForInstanceDomains         *FillFieldsForInstanceDomains
+	s42Apps7SAMLConfigsOptions              *Apps7SAMLConfigsOptions
+	s43Executions1AddCondition              *Executions1AddCondition
+	s44Targets2AddTransports                *Targets2AddTransports
+	s45Targets2AddModule                    *Targets2AddModule
+	s46Apps7OIDCConfigsRequireConsent       *Apps7OIDCConfigsRequireConsent
+	s47Apps7OIDCConfigsRequiredACR          *Apps7OIDCConfigsRequiredACR
+	s48TrustedDevices                       *TrustedDevices
+	s49SessionRisk                          *SessionRisk
+	s50SecurityNotifications                *SecurityNotifications
+	s51SMSProviders                         *SMSProviders
+	s52AccessValidity                       *AccessValidity
+	s53PasswordExpiryNotified               *PasswordExpiryNotified
+	s54LoginPolicyRiskThresholds            *LoginPolicyRiskThresholds
+	s55SMPPTLSConfig                        *SMPPTLSConfig
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
@@ -172,6 +172,20 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s39DeleteStaleOrgFields = &DeleteStaleOrgFields{dbClient: esPusherDBClient}
 	steps.s40InitPushFunc = &InitPushFunc{dbClient: esPusherDBClient}
 	steps.s41FillFieldsForInstanceDomains = &FillFieldsForInstanceDomains{eventstore: eventstoreClient}
+	steps.s42Apps7SAMLConfigsOptions = &Apps7SAMLConfigsOptions{dbClient: esPusherDBClient}
+	steps.s43Executions1AddCondition = &Executions1AddCondition{dbClient: esPusherDBClient}
+	steps.s44Targets2AddTransports = &Targets2AddTransports{dbClient: esPusherDBClient}
+	steps.s45Targets2AddModule = &Targets2AddModule{dbClient: esPusherDBClient}
+	steps.s46Apps7OIDCConfigsRequireConsent = &Apps7OIDCConfigsRequireConsent{dbClient: esPusherDBClient}
+	steps.s47Apps7OIDCConfigsRequiredACR = &Apps7OIDCConfigsRequiredACR{dbClient: esPusherDBClient}
+	steps.s48TrustedDevices = &TrustedDevices{dbClient: esPusherDBClient}
+	steps.s49SessionRisk = &SessionRisk{dbClient: esPusherDBClient}
+	steps.s50SecurityNotifications = &SecurityNotifications{dbClient: esPusherDBClient}
+	steps.s51SMSProviders = &SMSProviders{dbClient: esPusherDBClient}
+	steps.s52AccessValidity = &AccessValidity{dbClient: esPusherDBClient}
+	steps.s53PasswordExpiryNotified = &PasswordExpiryNotified{dbClient: esPusherDBClient}
+	steps.s54LoginPolicyRiskThresholds = &LoginPolicyRiskThresholds{dbClient: esPusherDBClient}
+	steps.s55SMPPTLSConfig = &SMPPTLSConfig{dbClient: esPusherDBClient}
 
 	err = projection.Create(ctx, projectionDBClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -238,6 +252,20 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s33SMSConfigs3TwilioAddVerifyServiceSid,
 		steps.s37Apps7OIDConfigsBackChannelLogoutURI,
 		steps.s39DeleteStaleOrgFields,
+		steps.s42Apps7SAMLConfigsOptions,
+		steps.s43Executions1AddCondition,
+		steps.s44Targets2AddTransports,
+		steps.s45Targets2AddModule,
+		steps.s46Apps7OIDCConfigsRequireConsent,
+		steps.s47Apps7OIDCConfigsRequiredACR,
+		steps.s48TrustedDevices,
+		steps.s49SessionRisk,
+		steps.s50SecurityNotifications,
+		steps.s51SMSProviders,
+		steps.s52AccessValidity,
+		steps.s53PasswordExpiryNotified,
+		steps.s54LoginPolicyRiskThresholds,
+		steps.s55SMPPTLSConfig,
 	} {
 		mustExecuteMigration(ctx, eventstoreClient, step, "migration failed")
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
@@ -34,6 +35,7 @@ import (
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
 	profiler "github.com/zitadel/zitadel/internal/telemetry/profiler/config"
 	tracing "github.com/zitadel/zitadel/internal/telemetry/tracing/config"
+	"github.com/zitadel/zitadel/internal/userimport"
 )
 
 type Config struct {
@@ -55,6 +57,8 @@ type Config struct {
 	Profiler            profiler.Config
 	Projections         projection.Config
 	Notifications       handlers.WorkerConfig
+	TargetDeliveries    execution.WorkerConfig
+	UserImports         userimport.WorkerConfig
 	Auth                auth_es.Config
 	Admin               admin_es.Config
 	UserAgentCookie     *middleware.UserAgentCookieConfig
@@ -124,6 +128,7 @@ func MustNewConfig(v *viper.Viper) *Config {
 
 	id.Configure(config.Machine)
 	actions.SetHTTPConfig(&config.Actions.HTTP)
+	actions.SetWASMConfig(&config.Actions.WASM)
 
 	return config
 }
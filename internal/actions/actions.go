@@ -14,6 +14,7 @@ import (
 
 type Config struct {
 	HTTP HTTPConfig
+	WASM WASMConfig
 }
 
 var ErrHalt = errors.New("interrupt")
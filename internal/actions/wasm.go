@@ -0,0 +1,386 @@
+package actions
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	"github.com/tetratelabs/wazero/sys"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The modules run by the WebAssembly runtime have to export:
+//   - memory: the linear memory used to exchange data with the host
+//   - alloc(size i32) -> i32: allocates size bytes and returns the pointer
+//   - handle(ptr i32, len i32) -> i64: called with the JSON payload, which is also sent to the targets,
+//     returns the pointer (upper 32 bits) and length (lower 32 bits) of the JSON output,
+//     which has the same contract as the response of call targets, an empty output is ignored
+//
+// The host functions are imported from the module "zitadel", see wasm_host.go.
+const (
+	wasmExportMemory = "memory"
+	wasmExportAlloc  = "alloc"
+	wasmExportHandle = "handle"
+)
+
+const (
+	defaultWASMMaxModuleSize        = 2 << 20
+	defaultWASMMaxMemoryPages       = 256
+	defaultWASMMaxRunDuration       = 10 * time.Second
+	defaultWASMMaxFetchResponseSize = 1 << 20
+	defaultWASMMaxCachedModules     = 100
+	defaultWASMMaxRunFuel           = 10_000_000
+)
+
+// wasmExitCodeFuelExhausted is the exit code of modules closed because the fuel of the run is exhausted
+const wasmExitCodeFuelExhausted = 0xf0e1
+
+var wasmConfig = &WASMConfig{
+	MaxModuleSize:        defaultWASMMaxModuleSize,
+	MaxMemoryPages:       defaultWASMMaxMemoryPages,
+	MaxRunDuration:       defaultWASMMaxRunDuration,
+	MaxFetchResponseSize: defaultWASMMaxFetchResponseSize,
+	MaxCachedModules:     defaultWASMMaxCachedModules,
+	MaxRunFuel:           defaultWASMMaxRunFuel,
+}
+
+type WASMConfig struct {
+	// MaxModuleSize is the maximum size of a module in bytes
+	MaxModuleSize uint32
+	// MaxMemoryPages limits the memory of a running module, a page has 64KiB
+	MaxMemoryPages uint32
+	// MaxRunDuration limits the duration of a run, the timeout of the target is capped to it, 0 disables the limit.
+	// The module is stopped as soon as the duration is exceeded, also inside of loops.
+	MaxRunDuration time.Duration
+	// MaxFetchResponseSize limits the body of the responses read by http_fetch in bytes
+	MaxFetchResponseSize int64
+	// MaxCachedModules is the amount of compiled modules kept in memory,
+	// the least recently used modules are removed first
+	MaxCachedModules int
+	// MaxRunFuel is the budget of a run, every call of a function of the module consumes one unit, 0 disables the limit.
+	// Unlike the duration, the budget doesn't depend on the load of the host,
+	// so the same payload always stops at the same point.
+	MaxRunFuel uint64
+}
+
+// SetWASMConfig must be called before the first module is run
+func SetWASMConfig(config *WASMConfig) {
+	wasmConfig = config
+}
+
+// wasmRuntime is shared by all runs, so that compiled modules are reused
+var wasmRuntime = sync.OnceValues(func() (wazero.Runtime, error) {
+	ctx := context.Background()
+	// closing the module if the context is done stops the run when the timeout is exceeded
+	runtimeConfig := wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true)
+	if wasmConfig.MaxMemoryPages > 0 {
+		runtimeConfig = runtimeConfig.WithMemoryLimitPages(wasmConfig.MaxMemoryPages)
+	}
+	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	if err := instantiateWASMHost(ctx, runtime); err != nil {
+		return nil, err
+	}
+	return runtime, nil
+})
+
+// compiledWASMModules are the compiled modules keyed by the hash of the binary,
+// evicted modules are closed as soon as they are not used anymore
+var compiledWASMModules = sync.OnceValue(func() *lru.Cache[[sha256.Size]byte, *compiledWASMModule] {
+	size := wasmConfig.MaxCachedModules
+	if size <= 0 {
+		size = defaultWASMMaxCachedModules
+	}
+	cache, err := lru.NewWithEvict(size, func(_ [sha256.Size]byte, compiled *compiledWASMModule) {
+		compiled.evict()
+	})
+	logging.OnError(err).Panic("unable to create cache of compiled modules")
+	return cache
+})
+
+// compiledWASMModule counts the runs using the compiled module,
+// as closing it while a run instantiates it fails the run
+type compiledWASMModule struct {
+	wazero.CompiledModule
+
+	mu      sync.Mutex
+	refs    int
+	evicted bool
+	closed  bool
+}
+
+// acquire must be called before the module is used, it returns false if the module is already closed
+func (m *compiledWASMModule) acquire() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return false
+	}
+	m.refs++
+	return true
+}
+
+// release must be called after the module was used, it closes the module if it was evicted and is not used anymore
+func (m *compiledWASMModule) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refs--
+	m.closeUnused()
+}
+
+// evict closes the module as soon as it is not used anymore
+func (m *compiledWASMModule) evict() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evicted = true
+	m.closeUnused()
+}
+
+func (m *compiledWASMModule) closeUnused() {
+	if !m.evicted || m.refs > 0 || m.closed {
+		return
+	}
+	m.closed = true
+	_ = m.CompiledModule.Close(context.Background())
+}
+
+// ValidateWASMModule checks the size of the module and if it compiles with the required exports
+func ValidateWASMModule(module []byte) error {
+	compiled, err := compileWASM(context.Background(), module)
+	if err != nil {
+		return err
+	}
+	compiled.release()
+	return nil
+}
+
+// compileWASM returns the acquired compiled module, which must be released after it was used
+func compileWASM(ctx context.Context, module []byte) (*compiledWASMModule, error) {
+	if len(module) == 0 || (wasmConfig.MaxModuleSize > 0 && len(module) > int(wasmConfig.MaxModuleSize)) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ACTIO-Wd3kQs", "Errors.Target.InvalidModule")
+	}
+	key := sha256.Sum256(module)
+	// the module might be evicted and closed between the lookup and the acquire, it is compiled again in that case
+	if compiled, ok := compiledWASMModules().Get(key); ok && compiled.acquire() {
+		return compiled, nil
+	}
+	runtime, err := wasmRuntime()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACTIO-Tq5pLw", "Errors.Internal")
+	}
+	if wasmConfig.MaxRunFuel > 0 {
+		// the listeners are bound to the functions of the compiled module
+		ctx = experimental.WithFunctionListenerFactory(ctx, wasmFuelListener{})
+	}
+	compiledModule, err := runtime.CompileModule(ctx, module)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "ACTIO-Jf8mVn", "Errors.Target.InvalidModule")
+	}
+	if err := checkWASMExports(compiledModule); err != nil {
+		_ = compiledModule.Close(ctx)
+		return nil, err
+	}
+	compiled := &compiledWASMModule{CompiledModule: compiledModule, refs: 1}
+	// another run might have compiled the same module in the meantime
+	previous, ok, _ := compiledWASMModules().PeekOrAdd(key, compiled)
+	if !ok {
+		return compiled, nil
+	}
+	if previous.acquire() {
+		_ = compiledModule.Close(ctx)
+		return previous, nil
+	}
+	// the previous module is closed, the compiled module is not cached and closed after the run
+	compiled.evict()
+	return compiled, nil
+}
+
+func checkWASMExports(compiled wazero.CompiledModule) error {
+	if _, ok := compiled.ExportedMemories()[wasmExportMemory]; !ok {
+		return zerrors.ThrowInvalidArgument(nil, "ACTIO-Bv2nXe", "Errors.Target.InvalidModule")
+	}
+	functions := compiled.ExportedFunctions()
+	alloc, ok := functions[wasmExportAlloc]
+	if !ok || !sameTypes(alloc.ParamTypes(), api.ValueTypeI32) || !sameTypes(alloc.ResultTypes(), api.ValueTypeI32) {
+		return zerrors.ThrowInvalidArgument(nil, "ACTIO-Hs7rCk", "Errors.Target.InvalidModule")
+	}
+	handle, ok := functions[wasmExportHandle]
+	if !ok || !sameTypes(handle.ParamTypes(), api.ValueTypeI32, api.ValueTypeI32) || !sameTypes(handle.ResultTypes(), api.ValueTypeI64) {
+		return zerrors.ThrowInvalidArgument(nil, "ACTIO-Lm4tDy", "Errors.Target.InvalidModule")
+	}
+	return nil
+}
+
+func sameTypes(types []api.ValueType, expected ...api.ValueType) bool {
+	if len(types) != len(expected) {
+		return false
+	}
+	for i := range types {
+		if types[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// RunWASM runs the module with the payload inside a sandbox limited by the timeout and memory,
+// the timeout is capped to the configured maximum duration of a run.
+// The duration of the run is logged to the execution logs,
+// which are accounted to the actions.all.runs.seconds quota of the instance.
+func RunWASM(ctx context.Context, module, payload []byte, timeout time.Duration) (output []byte, err error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	logger := newLogger(ctx, instanceID)
+
+	remaining := logstoreService.Limit(ctx, instanceID)
+	if remaining != nil {
+		if *remaining == 0 {
+			return nil, zerrors.ThrowResourceExhausted(nil, "ACTIO-Cz6wNu", "Errors.Quota.Execution.Exhausted")
+		}
+		timeout = min(timeout, time.Duration(*remaining)*time.Second)
+	}
+
+	if wasmConfig.MaxRunDuration > 0 {
+		timeout = min(timeout, wasmConfig.MaxRunDuration)
+	}
+
+	logger.Log(actionStartedMessage)
+	defer func() {
+		if err != nil {
+			logger.log(actionFailedMessage(err), logrus.ErrorLevel, true)
+			return
+		}
+		logger.log(actionSucceededMessage, logrus.InfoLevel, true)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	compiled, err := compileWASM(ctx, module)
+	if err != nil {
+		return nil, err
+	}
+	defer compiled.release()
+	runtime, err := wasmRuntime()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ACTIO-Yx1vRa", "Errors.Internal")
+	}
+	ctx = withWASMRun(ctx, &wasmRun{
+		logger: logger,
+		client: &http.Client{Transport: &transport{lookup: net.LookupIP}},
+		fuel:   wasmConfig.MaxRunFuel,
+	})
+	// anonymous modules can be instantiated concurrently
+	instance, err := runtime.InstantiateModule(ctx, compiled.CompiledModule, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, wasmError(ctx, err)
+	}
+	defer instance.Close(context.WithoutCancel(ctx))
+
+	output, err = callWASMHandle(ctx, instance, payload)
+	if err != nil {
+		return nil, wasmError(ctx, err)
+	}
+	return output, nil
+}
+
+func callWASMHandle(ctx context.Context, instance api.Module, payload []byte) ([]byte, error) {
+	ptr, err := writeWASMMemory(ctx, instance, payload)
+	if err != nil {
+		return nil, err
+	}
+	results, err := instance.ExportedFunction(wasmExportHandle).Call(ctx, api.EncodeU32(ptr), api.EncodeU32(uint32(len(payload))))
+	if err != nil {
+		return nil, err
+	}
+	outputPtr, outputLen := uint32(results[0]>>32), uint32(results[0])
+	if outputLen == 0 {
+		return nil, nil
+	}
+	output, ok := instance.Memory().Read(outputPtr, outputLen)
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ACTIO-Pw9sEg", "Errors.Execution.Failed")
+	}
+	// the memory is released when the module is closed
+	return append([]byte(nil), output...), nil
+}
+
+// writeWASMMemory allocates memory in the module and writes the data to it
+func writeWASMMemory(ctx context.Context, instance api.Module, data []byte) (uint32, error) {
+	results, err := instance.ExportedFunction(wasmExportAlloc).Call(ctx, api.EncodeU32(uint32(len(data))))
+	if err != nil {
+		return 0, err
+	}
+	ptr := api.DecodeU32(results[0])
+	if !instance.Memory().Write(ptr, data) {
+		return 0, zerrors.ThrowPreconditionFailed(nil, "ACTIO-Rk3bYt", "Errors.Execution.Failed")
+	}
+	return ptr, nil
+}
+
+// wasmError returns a timeout if the context is done, as the module is closed in that case
+func wasmError(ctx context.Context, err error) error {
+	var exitErr *sys.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == wasmExitCodeFuelExhausted {
+		return zerrors.ThrowResourceExhausted(err, "ACTIO-Fu3lXh", "Errors.Execution.FuelExhausted")
+	}
+	if ctx.Err() != nil {
+		return zerrors.ThrowDeadlineExceeded(err, "ACTIO-Ga4hXm", "Errors.Execution.Timeout")
+	}
+	return zerrors.ThrowPreconditionFailed(err, "ACTIO-Ns8qUf", "Errors.Execution.Failed")
+}
+
+type wasmRunKey struct{}
+
+// wasmRun is the state of a single run, used by the host functions
+type wasmRun struct {
+	logger *logger
+	client *http.Client
+	// fuel is the remaining budget of the run, a run is executed by a single goroutine
+	fuel uint64
+}
+
+func withWASMRun(ctx context.Context, run *wasmRun) context.Context {
+	return context.WithValue(ctx, wasmRunKey{}, run)
+}
+
+func wasmRunFromContext(ctx context.Context) *wasmRun {
+	run, _ := ctx.Value(wasmRunKey{}).(*wasmRun)
+	return run
+}
+
+// wasmFuelListener consumes the fuel of the run on every function call of the module.
+// If the fuel is exhausted, the module is closed,
+// which stops it on the next function call or loop iteration, as the runtime closes modules on done contexts.
+type wasmFuelListener struct{}
+
+func (l wasmFuelListener) NewFunctionListener(api.FunctionDefinition) experimental.FunctionListener {
+	return l
+}
+
+func (wasmFuelListener) Before(ctx context.Context, mod api.Module, _ api.FunctionDefinition, _ []uint64, _ experimental.StackIterator) {
+	run := wasmRunFromContext(ctx)
+	if run == nil || wasmConfig.MaxRunFuel == 0 {
+		return
+	}
+	if run.fuel == 0 {
+		_ = mod.CloseWithExitCode(ctx, wasmExitCodeFuelExhausted)
+		return
+	}
+	run.fuel--
+}
+
+func (wasmFuelListener) After(context.Context, api.Module, api.FunctionDefinition, []uint64) {}
+
+func (wasmFuelListener) Abort(context.Context, api.Module, api.FunctionDefinition, error) {}
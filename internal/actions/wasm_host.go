@@ -0,0 +1,179 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// wasmHostModule provides the host functions equivalent to the modules of the javascript actions:
+//   - log(level i32, ptr i32, len i32): logs the message, level 0 is info, 1 warn and 2 error
+//   - uuid(version i32, nsPtr i32, nsLen i32, namePtr i32, nameLen i32, outPtr i32) -> i32:
+//     writes the 36 characters of a uuid of version 1, 3, 4 or 5 to outPtr, returns 0 on success
+//   - http_fetch(ptr i32, len i32) -> i64: sends the JSON encoded wasmFetchRequest,
+//     returns the pointer (upper 32 bits) and length (lower 32 bits) of the JSON encoded wasmFetchResponse
+const wasmHostModule = "zitadel"
+
+const (
+	wasmLogInfo uint32 = iota
+	wasmLogWarn
+	wasmLogError
+)
+
+var (
+	errUnknownUUIDVersion    = errors.New("unknown uuid version")
+	errFetchResponseTooLarge = errors.New("response body exceeds the maximum size")
+)
+
+type wasmFetchRequest struct {
+	URL     string          `json:"url"`
+	Method  string          `json:"method,omitempty"`
+	Headers http.Header     `json:"headers,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+type wasmFetchResponse struct {
+	Status  int         `json:"status,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+func instantiateWASMHost(ctx context.Context, runtime wazero.Runtime) error {
+	_, err := runtime.NewHostModuleBuilder(wasmHostModule).
+		NewFunctionBuilder().
+		WithFunc(wasmLog).
+		WithParameterNames("level", "ptr", "len").
+		Export("log").
+		NewFunctionBuilder().
+		WithFunc(wasmUUID).
+		WithParameterNames("version", "ns_ptr", "ns_len", "name_ptr", "name_len", "out_ptr").
+		Export("uuid").
+		NewFunctionBuilder().
+		WithFunc(wasmHTTPFetch).
+		WithParameterNames("ptr", "len").
+		Export("http_fetch").
+		Instantiate(ctx)
+	return err
+}
+
+func wasmLog(ctx context.Context, mod api.Module, level, ptr, length uint32) {
+	run := wasmRunFromContext(ctx)
+	if run == nil {
+		return
+	}
+	msg, ok := mod.Memory().Read(ptr, length)
+	if !ok {
+		panic("log: out of memory range")
+	}
+	switch level {
+	case wasmLogWarn:
+		run.logger.Warn(string(msg))
+	case wasmLogError:
+		run.logger.Error(string(msg))
+	default:
+		run.logger.Log(string(msg))
+	}
+}
+
+func wasmUUID(_ context.Context, mod api.Module, version, nsPtr, nsLength, namePtr, nameLength, outPtr uint32) uint32 {
+	id, err := wasmNewUUID(mod.Memory(), version, nsPtr, nsLength, namePtr, nameLength)
+	if err != nil {
+		return 1
+	}
+	if !mod.Memory().WriteString(outPtr, id.String()) {
+		return 1
+	}
+	return 0
+}
+
+func wasmNewUUID(memory api.Memory, version, nsPtr, nsLength, namePtr, nameLength uint32) (uuid.UUID, error) {
+	switch version {
+	case 1:
+		return uuid.NewUUID()
+	case 4:
+		return uuid.NewRandom()
+	}
+	namespace, ok := memory.Read(nsPtr, nsLength)
+	if !ok {
+		return uuid.Nil, io.ErrUnexpectedEOF
+	}
+	space, err := uuid.ParseBytes(namespace)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	name, ok := memory.Read(namePtr, nameLength)
+	if !ok {
+		return uuid.Nil, io.ErrUnexpectedEOF
+	}
+	switch version {
+	case 3:
+		return uuid.NewMD5(space, name), nil
+	case 5:
+		return uuid.NewSHA1(space, name), nil
+	}
+	return uuid.Nil, errUnknownUUIDVersion
+}
+
+func wasmHTTPFetch(ctx context.Context, mod api.Module, ptr, length uint32) uint64 {
+	data, ok := mod.Memory().Read(ptr, length)
+	if !ok {
+		panic("http_fetch: out of memory range")
+	}
+	response, err := json.Marshal(wasmFetch(ctx, data))
+	if err != nil {
+		panic(err)
+	}
+	responsePtr, err := writeWASMMemory(ctx, mod, response)
+	if err != nil {
+		panic(err)
+	}
+	return uint64(responsePtr)<<32 | uint64(len(response))
+}
+
+func wasmFetch(ctx context.Context, data []byte) *wasmFetchResponse {
+	run := wasmRunFromContext(ctx)
+	if run == nil {
+		return &wasmFetchResponse{Error: "http is not available"}
+	}
+	request := new(wasmFetchRequest)
+	if err := json.Unmarshal(data, request); err != nil {
+		return &wasmFetchResponse{Error: err.Error()}
+	}
+	if request.Method == "" {
+		request.Method = defaultFetchConfig.Method
+	}
+	req, err := http.NewRequestWithContext(ctx, request.Method, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return &wasmFetchResponse{Error: err.Error()}
+	}
+	req.Header = defaultFetchConfig.Headers.Clone()
+	for key, values := range request.Headers {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+	res, err := run.client.Do(req)
+	if err != nil {
+		return &wasmFetchResponse{Error: err.Error()}
+	}
+	defer res.Body.Close()
+	// read one byte more than allowed to detect bodies exceeding the limit
+	body, err := io.ReadAll(io.LimitReader(res.Body, wasmConfig.MaxFetchResponseSize+1))
+	if err != nil {
+		return &wasmFetchResponse{Error: err.Error()}
+	}
+	if int64(len(body)) > wasmConfig.MaxFetchResponseSize {
+		return &wasmFetchResponse{Error: errFetchResponseTooLarge.Error()}
+	}
+	return &wasmFetchResponse{
+		Status:  res.StatusCode,
+		Headers: res.Header,
+		Body:    string(body),
+	}
+}
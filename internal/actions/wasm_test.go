@@ -0,0 +1,256 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// the test modules are encoded by hand, see https://webassembly.github.io/spec/core/binary/modules.html
+var (
+	// echoModule logs the payload with zitadel.log and returns it as output
+	echoModule = wasmTestModule(
+		wasmTestSection(1, wasmTestVector(
+			[]byte{0x60, 0x03, 0x7f, 0x7f, 0x7f, 0x00}, // (i32, i32, i32) -> ()
+			[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},       // (i32) -> i32
+			[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}, // (i32, i32) -> i64
+		)),
+		wasmTestSection(2, wasmTestVector(
+			append(append(wasmTestName("zitadel"), wasmTestName("log")...), 0x00, 0x00),
+		)),
+		wasmTestSection(3, wasmTestVector([]byte{0x01}, []byte{0x02})),
+		wasmTestSection(5, wasmTestVector([]byte{0x00, 0x01})),
+		// heap pointer starting at 1024
+		wasmTestSection(6, wasmTestVector([]byte{0x7f, 0x01, 0x41, 0x80, 0x08, 0x0b})),
+		wasmTestSection(7, wasmTestVector(
+			append(wasmTestName("memory"), 0x02, 0x00),
+			append(wasmTestName("alloc"), 0x00, 0x01),
+			append(wasmTestName("handle"), 0x00, 0x02),
+		)),
+		wasmTestSection(10, wasmTestVector(
+			// alloc: return heap, heap += size
+			wasmTestCode(0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6a, 0x24, 0x00, 0x0b),
+			// handle: log(0, ptr, len), return ptr << 32 | len
+			wasmTestCode(
+				0x41, 0x00, 0x20, 0x00, 0x20, 0x01, 0x10, 0x00,
+				0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b,
+			),
+		)),
+	)
+	// spinModule loops forever without function calls in handle
+	spinModule = wasmTestLoopModule(0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b)
+	// burnModule calls an empty function in an endless loop in handle
+	burnModule = wasmTestLoopModule(0x03, 0x40, 0x10, 0x02, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b)
+)
+
+func TestValidateWASMModule(t *testing.T) {
+	tests := []struct {
+		name    string
+		module  []byte
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			module:  nil,
+			wantErr: true,
+		},
+		{
+			name:    "invalid binary",
+			module:  []byte("no wasm"),
+			wantErr: true,
+		},
+		{
+			name: "missing exports",
+			module: wasmTestModule(
+				wasmTestSection(5, wasmTestVector([]byte{0x00, 0x01})),
+			),
+			wantErr: true,
+		},
+		{
+			name:   "ok",
+			module: echoModule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWASMModule(tt.module)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRunWASM(t *testing.T) {
+	SetLogstoreService(logstore.New[*record.ExecutionLog](nil, nil))
+	config := wasmConfig
+	t.Cleanup(func() { SetWASMConfig(config) })
+	SetWASMConfig(&WASMConfig{
+		MaxModuleSize:        defaultWASMMaxModuleSize,
+		MaxMemoryPages:       defaultWASMMaxMemoryPages,
+		MaxRunDuration:       time.Second,
+		MaxFetchResponseSize: defaultWASMMaxFetchResponseSize,
+		MaxCachedModules:     defaultWASMMaxCachedModules,
+		MaxRunFuel:           1000,
+	})
+	tests := []struct {
+		name    string
+		module  []byte
+		want    []byte
+		wantErr func(error) bool
+	}{
+		{
+			name:   "echo",
+			module: echoModule,
+			want:   []byte(`{"request":"content"}`),
+		},
+		{
+			name:    "timeout",
+			module:  spinModule,
+			wantErr: zerrors.IsDeadlineExceeded,
+		},
+		{
+			// the fuel is exhausted long before the timeout
+			name:    "fuel exhausted",
+			module:  burnModule,
+			wantErr: zerrors.IsResourceExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the timeout is capped to the maximum run duration
+			got, err := RunWASM(context.Background(), tt.module, []byte(`{"request":"content"}`), time.Hour)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_compiledWASMModule(t *testing.T) {
+	runtime, err := wasmRuntime()
+	require.NoError(t, err)
+	module, err := runtime.CompileModule(context.Background(), echoModule)
+	require.NoError(t, err)
+	compiled := &compiledWASMModule{CompiledModule: module, refs: 1}
+
+	// an evicted module is not closed while it is used
+	require.True(t, compiled.acquire())
+	compiled.evict()
+	compiled.release()
+	assert.False(t, compiled.closed)
+	_, err = runtime.InstantiateModule(context.Background(), compiled.CompiledModule, wazero.NewModuleConfig().WithName(""))
+	require.NoError(t, err)
+
+	// the module is closed after the last release and can't be acquired anymore
+	compiled.release()
+	assert.True(t, compiled.closed)
+	assert.False(t, compiled.acquire())
+}
+
+func Test_wasmFetch(t *testing.T) {
+	config := wasmConfig
+	t.Cleanup(func() { SetWASMConfig(config) })
+	SetWASMConfig(&WASMConfig{MaxFetchResponseSize: 8})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Query().Get("body")))
+	}))
+	defer server.Close()
+	ctx := withWASMRun(context.Background(), &wasmRun{client: server.Client()})
+
+	tests := []struct {
+		name string
+		body string
+		want *wasmFetchResponse
+	}{
+		{
+			name: "body within limit",
+			body: "12345678",
+			want: &wasmFetchResponse{Status: http.StatusOK, Body: "12345678"},
+		},
+		{
+			name: "body exceeds limit",
+			body: "123456789",
+			want: &wasmFetchResponse{Error: errFetchResponseTooLarge.Error()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := json.Marshal(&wasmFetchRequest{URL: server.URL + "?body=" + tt.body})
+			require.NoError(t, err)
+			got := wasmFetch(ctx, request)
+			got.Headers = nil
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// wasmTestLoopModule returns a module with the required exports and the code of handle
+func wasmTestLoopModule(handle ...byte) []byte {
+	return wasmTestModule(
+		wasmTestSection(1, wasmTestVector(
+			[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},       // (i32) -> i32
+			[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}, // (i32, i32) -> i64
+			[]byte{0x60, 0x00, 0x00},                   // () -> ()
+		)),
+		wasmTestSection(3, wasmTestVector([]byte{0x00}, []byte{0x01}, []byte{0x02})),
+		wasmTestSection(5, wasmTestVector([]byte{0x00, 0x01})),
+		wasmTestSection(7, wasmTestVector(
+			append(wasmTestName("memory"), 0x02, 0x00),
+			append(wasmTestName("alloc"), 0x00, 0x00),
+			append(wasmTestName("handle"), 0x00, 0x01),
+		)),
+		wasmTestSection(10, wasmTestVector(
+			// alloc: always returns 1024
+			wasmTestCode(0x41, 0x80, 0x08, 0x0b),
+			wasmTestCode(handle...),
+			wasmTestCode(0x0b),
+		)),
+	)
+}
+
+func wasmTestModule(sections ...[]byte) []byte {
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	for _, section := range sections {
+		module = append(module, section...)
+	}
+	return module
+}
+
+func wasmTestSection(id byte, content []byte) []byte {
+	return append([]byte{id, byte(len(content))}, content...)
+}
+
+func wasmTestVector(entries ...[]byte) []byte {
+	vector := []byte{byte(len(entries))}
+	for _, entry := range entries {
+		vector = append(vector, entry...)
+	}
+	return vector
+}
+
+func wasmTestName(name string) []byte {
+	return append([]byte{byte(len(name))}, name...)
+}
+
+// wasmTestCode returns the code of a function without locals
+func wasmTestCode(body ...byte) []byte {
+	return append([]byte{byte(len(body) + 1), 0x00}, body...)
+}
@@ -2,6 +2,7 @@ package assets
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -70,6 +71,16 @@ type Uploader interface {
 	ObjectType() static.ObjectType
 }
 
+// AssetReferencer is implemented by uploaders of files, which are referenced by a subsequent API call.
+// The reference is returned as id in the response of the upload.
+type AssetReferencer interface {
+	AssetReference(objectName string) string
+}
+
+type uploadResponse struct {
+	ID string `json:"id"`
+}
+
 type Downloader interface {
 	ObjectName(ctx context.Context, path string) (string, error)
 	ResourceOwner(ctx context.Context, ownerPath string) string
@@ -191,6 +202,11 @@ func UploadHandleFunc(s AssetsService, uploader Uploader) func(http.ResponseWrit
 			s.ErrorHandler()(w, r, fmt.Errorf("upload failed: %w", err), http.StatusInternalServerError)
 			return
 		}
+		if referencer, ok := uploader.(AssetReferencer); ok {
+			w.Header().Set(http_util.ContentType, "application/json")
+			err = json.NewEncoder(w).Encode(&uploadResponse{ID: referencer.AssetReference(objectName)})
+			logging.OnError(err).Error("error writing response for asset upload")
+		}
 	}
 }
 
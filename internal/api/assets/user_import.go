@@ -0,0 +1,61 @@
+package assets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/static"
+)
+
+func (h *Handler) UploadOrgUserImportFile() Uploader {
+	return &userImportFileUploader{h.idGenerator, []string{"text/", "application/json", "application/x-ndjson"}, 100 << 20}
+}
+
+// userImportFileUploader stores the CSV or NDJSON file of a user import.
+// The returned id of the file is passed to AddUserImportJob and becomes the id of the job.
+type userImportFileUploader struct {
+	idGenerator  id.Generator
+	contentTypes []string
+	maxSize      int64
+}
+
+func (l *userImportFileUploader) ContentTypeAllowed(contentType string) bool {
+	for _, ct := range l.contentTypes {
+		if strings.HasPrefix(contentType, ct) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *userImportFileUploader) ObjectType() static.ObjectType {
+	return static.ObjectTypeUserImport
+}
+
+func (l *userImportFileUploader) MaxFileSize() int64 {
+	return l.maxSize
+}
+
+func (l *userImportFileUploader) ObjectName(_ authz.CtxData) (string, error) {
+	fileID, err := l.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	return domain.GetUserImportAssetPath(fileID), nil
+}
+
+func (l *userImportFileUploader) ResourceOwner(_ authz.Instance, ctxData authz.CtxData) string {
+	return ctxData.OrgID
+}
+
+func (l *userImportFileUploader) UploadAsset(ctx context.Context, orgID string, upload *command.AssetUpload, commands *command.Commands) error {
+	return commands.AddUserImportFile(ctx, orgID, upload)
+}
+
+func (l *userImportFileUploader) AssetReference(objectName string) string {
+	return strings.TrimPrefix(objectName, domain.UserImportAssetPath+"/")
+}
@@ -12,6 +12,7 @@ const (
 
 type MembershipsResolver interface {
 	SearchMyMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) ([]*Membership, error)
+	SearchCustomRoles(ctx context.Context, orgID string) ([]RoleMapping, error)
 }
 
 type authZRepo interface {
@@ -16,6 +16,7 @@ type APITokenVerifier interface {
 	ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (_ string, _ []string, err error)
 	ExistsOrg(ctx context.Context, id, domain string) (orgID string, err error)
 	SearchMyMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) (_ []*Membership, err error)
+	SearchCustomRoles(ctx context.Context, orgID string) (_ []RoleMapping, err error)
 }
 
 type ApiTokenVerifier struct {
@@ -50,6 +51,12 @@ func (v *ApiTokenVerifier) SearchMyMemberships(ctx context.Context, orgID string
 	return v.authZRepo.SearchMyMemberships(ctx, orgID, shouldTriggerBulk)
 }
 
+func (v *ApiTokenVerifier) SearchCustomRoles(ctx context.Context, orgID string) (_ []RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	return v.authZRepo.SearchCustomRoles(ctx, orgID)
+}
+
 func (v *ApiTokenVerifier) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (_ string, _ []string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
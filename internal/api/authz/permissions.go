@@ -2,6 +2,7 @@ package authz
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -49,10 +50,38 @@ func getUserPermissions(ctx context.Context, resolver MembershipsResolver, requi
 			return nil, nil, err
 		}
 	}
+	roleMappings, err = appendCustomRoles(ctx, resolver, roleMappings, memberships, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
 	requestedPermissions, allPermissions = mapMembershipsToPermissions(requiredPerm, memberships, roleMappings)
 	return requestedPermissions, allPermissions, nil
 }
 
+// appendCustomRoles adds the roles defined at runtime on the instance and organization to the configured role mappings.
+// They are only resolved if a membership has a role which is not configured.
+func appendCustomRoles(ctx context.Context, resolver MembershipsResolver, roleMappings []RoleMapping, memberships []*Membership, orgID string) ([]RoleMapping, error) {
+	if !hasUnmappedRole(memberships, roleMappings) {
+		return roleMappings, nil
+	}
+	customRoles, err := resolver.SearchCustomRoles(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return append(slices.Clip(roleMappings), customRoles...), nil
+}
+
+func hasUnmappedRole(memberships []*Membership, roleMappings []RoleMapping) bool {
+	for _, membership := range memberships {
+		for _, role := range membership.Roles {
+			if !slices.ContainsFunc(roleMappings, func(mapping RoleMapping) bool { return mapping.Role == role }) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // checkUserResourcePermissions checks that if a user i granted either the requested permission globally (project.write)
 // or the specific resource (project.write:123)
 func checkUserResourcePermissions(userPerms []string, resourceID string) error {
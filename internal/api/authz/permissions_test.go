@@ -25,6 +25,19 @@ func (m membershipsResolverFunc) SearchMyMemberships(ctx context.Context, orgID
 	return m(ctx, orgID, shouldTriggerBulk)
 }
 
+func (m membershipsResolverFunc) SearchCustomRoles(ctx context.Context, orgID string) ([]RoleMapping, error) {
+	return nil, nil
+}
+
+type customRolesResolver struct {
+	membershipsResolverFunc
+	customRoles []RoleMapping
+}
+
+func (r *customRolesResolver) SearchCustomRoles(ctx context.Context, orgID string) ([]RoleMapping, error) {
+	return r.customRoles, nil
+}
+
 func Test_GetUserPermissions(t *testing.T) {
 	type args struct {
 		ctxData             CtxData
@@ -117,6 +130,40 @@ func Test_GetUserPermissions(t *testing.T) {
 			},
 			result: []string{"project.read"},
 		},
+		{
+			name: "Get Permissions of custom role",
+			args: args{
+				ctxData: CtxData{UserID: "userID", OrgID: "orgID"},
+				membershipsResolver: &customRolesResolver{
+					membershipsResolverFunc: func(ctx context.Context, orgID string, shouldTriggerBulk bool) ([]*Membership, error) {
+						return []*Membership{
+							{
+								AggregateID: "orgID",
+								ObjectID:    "orgID",
+								MemberType:  MemberTypeOrganization,
+								Roles:       []string{"ORG_OWNER_VIEWER", "ORG_CUSTOM_HELPDESK"},
+							},
+						}, nil
+					},
+					customRoles: []RoleMapping{
+						{
+							Role:        "ORG_CUSTOM_HELPDESK",
+							Permissions: []string{"user.write"},
+						},
+					},
+				},
+				requiredPerm: "user.write",
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "ORG_OWNER_VIEWER",
+							Permissions: []string{"org.read"},
+						},
+					},
+				},
+			},
+			result: []string{"org.read", "user.write"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,129 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/notifications/delivery"
+
+	varProviderID = "providerid"
+
+	twilioPath = "/twilio/{" + varProviderID + "}"
+	emailPath  = "/email/{" + varProviderID + "}"
+)
+
+// Commands is the subset of the commands needed to update the delivery status of a notification.
+type Commands interface {
+	ChangeNotificationDeliveryStatus(ctx context.Context, id, resourceOwner, providerID, providerMessageID string, status domain.NotificationDeliveryStatus, errorMessage string) (*domain.ObjectDetails, error)
+}
+
+// Queries is the subset of the queries needed to authenticate the callbacks and find the notification.
+type Queries interface {
+	NotificationDeliveryByProviderMessageID(ctx context.Context, shouldTriggerBulk bool, providerID, providerMessageID string) (*query.NotificationDelivery, error)
+	SMSProviderConfigByID(ctx context.Context, id string) (*query.SMSConfig, error)
+	SMTPConfigByID(ctx context.Context, instanceID, id string) (*query.SMTPConfig, error)
+}
+
+// Handler receives the delivery status callbacks of the notification providers
+// and reports them on the delivery log of the corresponding notification.
+type Handler struct {
+	commands       Commands
+	queries        Queries
+	smtpEncryption crypto.EncryptionAlgorithm
+	smsEncryption  crypto.EncryptionAlgorithm
+	twilioURL      func(ctx context.Context, providerID string) string
+}
+
+// TwilioStatusCallbackURL generates the instance specific URL Twilio reports the message status to.
+// It returns an empty string if the origin of the request is unknown.
+func TwilioStatusCallbackURL(ctx context.Context, providerID string) string {
+	origin := http_utils.DomainContext(ctx).Origin()
+	if origin == "" {
+		return ""
+	}
+	return origin + HandlerPrefix + "/twilio/" + providerID
+}
+
+func NewHandler(
+	commands Commands,
+	queries Queries,
+	smtpEncryption crypto.EncryptionAlgorithm,
+	smsEncryption crypto.EncryptionAlgorithm,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	h := &Handler{
+		commands:       commands,
+		queries:        queries,
+		smtpEncryption: smtpEncryption,
+		smsEncryption:  smsEncryption,
+		twilioURL:      TwilioStatusCallbackURL,
+	}
+
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor)
+	router.HandleFunc(twilioPath, h.handleTwilio).Methods(http.MethodPost)
+	router.HandleFunc(emailPath, h.handleEmail).Methods(http.MethodPost)
+	return router
+}
+
+// statusUpdate is a status reported by a provider for a single message.
+type statusUpdate struct {
+	messageID string
+	status    domain.NotificationDeliveryStatus
+	reason    string
+}
+
+func (h *Handler) updateStatus(ctx context.Context, providerID string, update *statusUpdate) error {
+	messageID := normalizeMessageID(update.messageID)
+	if messageID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "DELIV-Kx3mPa", "Errors.Notification.Delivery.NotFound")
+	}
+	delivery, err := h.queries.NotificationDeliveryByProviderMessageID(ctx, true, providerID, messageID)
+	if err != nil {
+		return err
+	}
+	_, err = h.commands.ChangeNotificationDeliveryStatus(ctx, delivery.ID, delivery.ResourceOwner, providerID, messageID, update.status, update.reason)
+	return err
+}
+
+// updateStatuses reports all updates and ignores messages unknown to the instance,
+// so that the provider does not retry the whole batch.
+func (h *Handler) updateStatuses(ctx context.Context, providerID string, updates []*statusUpdate) error {
+	for _, update := range updates {
+		err := h.updateStatus(ctx, providerID, update)
+		if zerrors.IsNotFound(err) {
+			logging.WithFields("provider", providerID, "message", update.messageID).Debug("delivery status for unknown message ignored")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeMessageID removes the angle brackets some providers keep around the Message-ID header value.
+func normalizeMessageID(id string) string {
+	return strings.Trim(strings.TrimSpace(id), "<>")
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	statusCode, ok := http_utils.ZitadelErrorToHTTPStatusCode(err)
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+	logging.WithError(err).Debug("unable to handle delivery callback")
+	http.Error(w, err.Error(), statusCode)
+}
@@ -0,0 +1,321 @@
+package delivery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/delivery/mock"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func twilioSignature(token, callbackURL string, form url.Values) string {
+	keys := make([]string, 0, len(form))
+	for key := range form {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	data := callbackURL
+	for _, key := range keys {
+		data += key + form.Get(key)
+	}
+	mac := hmac.New(sha1.New, []byte(token))
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_handleTwilio(t *testing.T) {
+	const callbackURL = "https://zitadel.example.com/notifications/delivery/twilio/provider1"
+	form := url.Values{
+		"MessageSid":    {"SM123"},
+		"MessageStatus": {"undelivered"},
+		"ErrorCode":     {"30003"},
+	}
+	smsConfig := &query.SMSConfig{
+		ID: "provider1",
+		TwilioConfig: &query.Twilio{
+			Token: &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("token"),
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		signature string
+		expect    func(*mock.MockQueries, *mock.MockCommands)
+		wantCode  int
+	}{
+		{
+			name:      "missing signature",
+			signature: "",
+			expect:    func(*mock.MockQueries, *mock.MockCommands) {},
+			wantCode:  http.StatusUnauthorized,
+		},
+		{
+			name:      "invalid signature",
+			signature: twilioSignature("other", callbackURL, form),
+			expect: func(queries *mock.MockQueries, _ *mock.MockCommands) {
+				queries.EXPECT().SMSProviderConfigByID(gomock.Any(), "provider1").Return(smsConfig, nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:      "unknown message, ignored",
+			signature: twilioSignature("token", callbackURL, form),
+			expect: func(queries *mock.MockQueries, _ *mock.MockCommands) {
+				queries.EXPECT().SMSProviderConfigByID(gomock.Any(), "provider1").Return(smsConfig, nil)
+				queries.EXPECT().NotificationDeliveryByProviderMessageID(gomock.Any(), true, "provider1", "SM123").
+					Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Nf1", "Errors.Notification.Delivery.NotFound"))
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:      "status updated",
+			signature: twilioSignature("token", callbackURL, form),
+			expect: func(queries *mock.MockQueries, commands *mock.MockCommands) {
+				queries.EXPECT().SMSProviderConfigByID(gomock.Any(), "provider1").Return(smsConfig, nil)
+				queries.EXPECT().NotificationDeliveryByProviderMessageID(gomock.Any(), true, "provider1", "SM123").
+					Return(&query.NotificationDelivery{
+						ID:                "notification1",
+						ResourceOwner:     "instance1",
+						ProviderID:        "provider1",
+						ProviderMessageID: "SM123",
+					}, nil)
+				commands.EXPECT().ChangeNotificationDeliveryStatus(gomock.Any(), "notification1", "instance1", "provider1", "SM123",
+					domain.NotificationDeliveryStatusUndelivered, "twilio error code 30003").
+					Return(&domain.ObjectDetails{}, nil)
+			},
+			wantCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			tt.expect(queries, commands)
+			h := &Handler{
+				commands:      commands,
+				queries:       queries,
+				smsEncryption: crypto.CreateMockEncryptionAlg(ctrl),
+				twilioURL: func(context.Context, string) string {
+					return callbackURL
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/twilio/provider1", strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.signature != "" {
+				req.Header.Set(twilioSignatureHeader, tt.signature)
+			}
+			req = mux.SetURLVars(req, map[string]string{varProviderID: "provider1"})
+			rec := httptest.NewRecorder()
+
+			h.handleTwilio(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestHandler_handleEmail(t *testing.T) {
+	const body = `[{"event":"bounce","smtp-id":"<a@example.com>","reason":"550 unknown user"}]`
+	smtpConfig := func(token *crypto.CryptoValue) *query.SMTPConfig {
+		return &query.SMTPConfig{
+			ID: "provider1",
+			SMTPConfig: &query.SMTP{
+				User: "user",
+				Password: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("password"),
+				},
+				WebhookToken: token,
+			},
+		}
+	}
+	webhookToken := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("token"),
+	}
+	tests := []struct {
+		name     string
+		user     string
+		password string
+		noAuth   bool
+		expect   func(*mock.MockQueries, *mock.MockCommands)
+		wantCode int
+	}{
+		{
+			name:     "missing credentials",
+			noAuth:   true,
+			expect:   func(*mock.MockQueries, *mock.MockCommands) {},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "no webhook token generated",
+			user:     "user",
+			password: "password",
+			expect: func(queries *mock.MockQueries, _ *mock.MockCommands) {
+				queries.EXPECT().SMTPConfigByID(gomock.Any(), "instance1", "provider1").Return(smtpConfig(nil), nil)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "smtp password, unauthenticated",
+			user:     "user",
+			password: "password",
+			expect: func(queries *mock.MockQueries, _ *mock.MockCommands) {
+				queries.EXPECT().SMTPConfigByID(gomock.Any(), "instance1", "provider1").Return(smtpConfig(webhookToken), nil)
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "status updated",
+			user:     "zitadel",
+			password: "token",
+			expect: func(queries *mock.MockQueries, commands *mock.MockCommands) {
+				queries.EXPECT().SMTPConfigByID(gomock.Any(), "instance1", "provider1").Return(smtpConfig(webhookToken), nil)
+				queries.EXPECT().NotificationDeliveryByProviderMessageID(gomock.Any(), true, "provider1", "a@example.com").
+					Return(&query.NotificationDelivery{
+						ID:                "notification1",
+						ResourceOwner:     "instance1",
+						ProviderID:        "provider1",
+						ProviderMessageID: "a@example.com",
+					}, nil)
+				commands.EXPECT().ChangeNotificationDeliveryStatus(gomock.Any(), "notification1", "instance1", "provider1", "a@example.com",
+					domain.NotificationDeliveryStatusBounced, "550 unknown user").
+					Return(&domain.ObjectDetails{}, nil)
+			},
+			wantCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			tt.expect(queries, commands)
+			h := &Handler{
+				commands:       commands,
+				queries:        queries,
+				smtpEncryption: crypto.CreateMockEncryptionAlg(ctrl),
+			}
+			req := httptest.NewRequest(http.MethodPost, "/email/provider1", strings.NewReader(body))
+			req = req.WithContext(authz.NewMockContext("instance1", "", ""))
+			if !tt.noAuth {
+				req.SetBasicAuth(tt.user, tt.password)
+			}
+			req = mux.SetURLVars(req, map[string]string{varProviderID: "provider1"})
+			rec := httptest.NewRecorder()
+
+			h.handleEmail(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func Test_parseEmailEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    []*statusUpdate
+		wantErr error
+	}{
+		{
+			name:    "invalid json",
+			body:    `{`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DELIV-Em4rKe", "Errors.Notification.Delivery.InvalidPayload"),
+		},
+		{
+			name:    "unknown format",
+			body:    `{"foo":"bar"}`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DELIV-Em4rKf", "Errors.Notification.Delivery.InvalidPayload"),
+		},
+		{
+			name: "sendgrid",
+			body: `[
+				{"event":"processed","smtp-id":"<a@example.com>"},
+				{"event":"delivered","smtp-id":"<a@example.com>"},
+				{"event":"bounce","smtp-id":"<b@example.com>","reason":"550 unknown user"},
+				{"event":"dropped","smtp-id":"<c@example.com>","reason":"Bounced Address"}
+			]`,
+			want: []*statusUpdate{
+				{messageID: "<a@example.com>", status: domain.NotificationDeliveryStatusDelivered},
+				{messageID: "<b@example.com>", status: domain.NotificationDeliveryStatusBounced, reason: "550 unknown user"},
+				{messageID: "<c@example.com>", status: domain.NotificationDeliveryStatusUndelivered, reason: "Bounced Address"},
+			},
+		},
+		{
+			name: "mailgun temporary failure",
+			body: `{"event-data":{"event":"failed","severity":"temporary","message":{"headers":{"message-id":"a@example.com"}}}}`,
+		},
+		{
+			name: "mailgun permanent failure",
+			body: `{"event-data":{"event":"failed","severity":"permanent","message":{"headers":{"message-id":"a@example.com"}},"delivery-status":{"description":"mailbox full"}}}`,
+			want: []*statusUpdate{
+				{messageID: "a@example.com", status: domain.NotificationDeliveryStatusBounced, reason: "mailbox full"},
+			},
+		},
+		{
+			name: "ses subscription confirmation",
+			body: `{"Type":"SubscriptionConfirmation","Message":"confirm"}`,
+		},
+		{
+			name: "ses delivery",
+			body: `{"Type":"Notification","Message":"{\"notificationType\":\"Delivery\",\"mail\":{\"commonHeaders\":{\"messageId\":\"<a@example.com>\"}}}"}`,
+			want: []*statusUpdate{
+				{messageID: "<a@example.com>", status: domain.NotificationDeliveryStatusDelivered},
+			},
+		},
+		{
+			name: "ses permanent bounce",
+			body: `{"Type":"Notification","Message":"{\"notificationType\":\"Bounce\",\"bounce\":{\"bounceType\":\"Permanent\",\"bouncedRecipients\":[{\"diagnosticCode\":\"smtp; 550 5.1.1 user unknown\"}]},\"mail\":{\"commonHeaders\":{\"messageId\":\"<a@example.com>\"}}}"}`,
+			want: []*statusUpdate{
+				{messageID: "<a@example.com>", status: domain.NotificationDeliveryStatusBounced, reason: "smtp; 550 5.1.1 user unknown"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEmailEvents([]byte(tt.body))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if len(tt.want) == 0 {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_normalizeMessageID(t *testing.T) {
+	assert.Equal(t, "a@example.com", normalizeMessageID(" <a@example.com> "))
+	assert.Equal(t, "SM123", normalizeMessageID("SM123"))
+}
@@ -0,0 +1,216 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// maxEmailEventsSize limits the size of a batch of events sent by an email relay.
+const maxEmailEventsSize = 1 << 20
+
+func (h *Handler) handleEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerID := mux.Vars(r)[varProviderID]
+	if err := h.verifyEmailCredentials(ctx, providerID, r); err != nil {
+		writeError(w, err)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxEmailEventsSize))
+	if err != nil {
+		writeError(w, zerrors.ThrowInvalidArgument(err, "DELIV-Em4rKa", "Errors.Notification.Delivery.InvalidPayload"))
+		return
+	}
+	updates, err := parseEmailEvents(body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err = h.updateStatuses(ctx, providerID, updates); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verifyEmailCredentials checks the webhook token generated for the SMTP configuration,
+// which has to be set as basic auth password in the webhook URL of the email relay.
+// The username is ignored, as relays mostly require one to be set.
+func (h *Handler) verifyEmailCredentials(ctx context.Context, providerID string, r *http.Request) error {
+	_, token, ok := r.BasicAuth()
+	if !ok || token == "" {
+		return zerrors.ThrowUnauthenticated(nil, "DELIV-Em4rKb", "Errors.Notification.Delivery.InvalidSignature")
+	}
+	config, err := h.queries.SMTPConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), providerID)
+	if err != nil {
+		return err
+	}
+	if config.SMTPConfig == nil || config.SMTPConfig.WebhookToken == nil {
+		return zerrors.ThrowNotFound(nil, "DELIV-Em4rKc", "Errors.SMTPConfig.NotFound")
+	}
+	expected, err := crypto.DecryptString(config.SMTPConfig.WebhookToken, h.smtpEncryption)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return zerrors.ThrowUnauthenticated(nil, "DELIV-Em4rKd", "Errors.Notification.Delivery.InvalidSignature")
+	}
+	return nil
+}
+
+// parseEmailEvents detects the format of the supported email relays (SendGrid, Mailgun and Amazon SES over SNS)
+// and returns the final states of the messages contained.
+func parseEmailEvents(body []byte) ([]*statusUpdate, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		return parseSendGridEvents(body)
+	}
+	var envelope struct {
+		EventData json.RawMessage `json:"event-data"`
+		Type      string          `json:"Type"`
+		Message   string          `json:"Message"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DELIV-Em4rKe", "Errors.Notification.Delivery.InvalidPayload")
+	}
+	switch {
+	case len(envelope.EventData) > 0:
+		return parseMailgunEvent(envelope.EventData)
+	case envelope.Type != "":
+		return parseSESNotification(envelope.Type, envelope.Message)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DELIV-Em4rKf", "Errors.Notification.Delivery.InvalidPayload")
+	}
+}
+
+type sendGridEvent struct {
+	Event  string `json:"event"`
+	SMTPID string `json:"smtp-id"`
+	Reason string `json:"reason"`
+}
+
+// https://www.twilio.com/docs/sendgrid/for-developers/tracking-events/event
+func parseSendGridEvents(body []byte) ([]*statusUpdate, error) {
+	var events []*sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DELIV-Sg5tNa", "Errors.Notification.Delivery.InvalidPayload")
+	}
+	updates := make([]*statusUpdate, 0, len(events))
+	for _, event := range events {
+		update := &statusUpdate{
+			messageID: event.SMTPID,
+			reason:    event.Reason,
+		}
+		switch event.Event {
+		case "delivered":
+			update.status = domain.NotificationDeliveryStatusDelivered
+			update.reason = ""
+		case "bounce":
+			update.status = domain.NotificationDeliveryStatusBounced
+		case "dropped":
+			update.status = domain.NotificationDeliveryStatusUndelivered
+		default:
+			continue
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+type mailgunEvent struct {
+	Event    string `json:"event"`
+	Severity string `json:"severity"`
+	Message  struct {
+		Headers struct {
+			MessageID string `json:"message-id"`
+		} `json:"headers"`
+	} `json:"message"`
+	DeliveryStatus struct {
+		Description string `json:"description"`
+		Message     string `json:"message"`
+	} `json:"delivery-status"`
+}
+
+// https://documentation.mailgun.com/docs/mailgun/user-manual/tracking-messages/#webhooks
+func parseMailgunEvent(data []byte) ([]*statusUpdate, error) {
+	event := new(mailgunEvent)
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DELIV-Mg6uPa", "Errors.Notification.Delivery.InvalidPayload")
+	}
+	update := &statusUpdate{
+		messageID: event.Message.Headers.MessageID,
+	}
+	switch event.Event {
+	case "delivered":
+		update.status = domain.NotificationDeliveryStatusDelivered
+	case "failed":
+		// temporary failures are retried by mailgun
+		if event.Severity != "permanent" {
+			return nil, nil
+		}
+		update.status = domain.NotificationDeliveryStatusBounced
+		update.reason = event.DeliveryStatus.Description
+		if update.reason == "" {
+			update.reason = event.DeliveryStatus.Message
+		}
+	default:
+		return nil, nil
+	}
+	return []*statusUpdate{update}, nil
+}
+
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	Mail             struct {
+		CommonHeaders struct {
+			MessageID string `json:"messageId"`
+		} `json:"commonHeaders"`
+	} `json:"mail"`
+	Bounce struct {
+		BounceType        string `json:"bounceType"`
+		BouncedRecipients []struct {
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+}
+
+// https://docs.aws.amazon.com/ses/latest/dg/notification-contents.html
+// Only notifications are handled, the SNS subscription has to be confirmed manually.
+func parseSESNotification(messageType, message string) ([]*statusUpdate, error) {
+	if messageType != "Notification" {
+		return nil, nil
+	}
+	notification := new(sesNotification)
+	if err := json.Unmarshal([]byte(message), notification); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DELIV-Se7vQa", "Errors.Notification.Delivery.InvalidPayload")
+	}
+	update := &statusUpdate{
+		messageID: notification.Mail.CommonHeaders.MessageID,
+	}
+	switch notification.NotificationType {
+	case "Delivery":
+		update.status = domain.NotificationDeliveryStatusDelivered
+	case "Bounce":
+		// transient bounces are retried by SES
+		if notification.Bounce.BounceType != "Permanent" {
+			return nil, nil
+		}
+		update.status = domain.NotificationDeliveryStatusBounced
+		if len(notification.Bounce.BouncedRecipients) > 0 {
+			update.reason = notification.Bounce.BouncedRecipients[0].DiagnosticCode
+		}
+	default:
+		return nil, nil
+	}
+	return []*statusUpdate{update}, nil
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/zitadel/zitadel/internal/api/delivery (interfaces: Commands)
+//
+// Generated by this command:
+//
+//	mockgen -package mock -destination ./mock/commands.mock.go github.com/zitadel/zitadel/internal/api/delivery Commands
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/zitadel/zitadel/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommands is a mock of Commands interface.
+type MockCommands struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommandsMockRecorder
+	isgomock struct{}
+}
+
+// MockCommandsMockRecorder is the mock recorder for MockCommands.
+type MockCommandsMockRecorder struct {
+	mock *MockCommands
+}
+
+// NewMockCommands creates a new mock instance.
+func NewMockCommands(ctrl *gomock.Controller) *MockCommands {
+	mock := &MockCommands{ctrl: ctrl}
+	mock.recorder = &MockCommandsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
+	return m.recorder
+}
+
+// ChangeNotificationDeliveryStatus mocks base method.
+func (m *MockCommands) ChangeNotificationDeliveryStatus(ctx context.Context, id, resourceOwner, providerID, providerMessageID string, status domain.NotificationDeliveryStatus, errorMessage string) (*domain.ObjectDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeNotificationDeliveryStatus", ctx, id, resourceOwner, providerID, providerMessageID, status, errorMessage)
+	ret0, _ := ret[0].(*domain.ObjectDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeNotificationDeliveryStatus indicates an expected call of ChangeNotificationDeliveryStatus.
+func (mr *MockCommandsMockRecorder) ChangeNotificationDeliveryStatus(ctx, id, resourceOwner, providerID, providerMessageID, status, errorMessage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeNotificationDeliveryStatus", reflect.TypeOf((*MockCommands)(nil).ChangeNotificationDeliveryStatus), ctx, id, resourceOwner, providerID, providerMessageID, status, errorMessage)
+}
@@ -0,0 +1,93 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	twilioClient "github.com/twilio/twilio-go/client"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	twilioSignatureHeader = "X-Twilio-Signature"
+
+	twilioParamMessageSID    = "MessageSid"
+	twilioParamMessageStatus = "MessageStatus"
+	twilioParamErrorCode     = "ErrorCode"
+)
+
+func (h *Handler) handleTwilio(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerID := mux.Vars(r)[varProviderID]
+	if err := r.ParseForm(); err != nil {
+		writeError(w, zerrors.ThrowInvalidArgument(err, "DELIV-Tw2sLa", "Errors.Notification.Delivery.InvalidSignature"))
+		return
+	}
+	params := make(map[string]string, len(r.PostForm))
+	for key := range r.PostForm {
+		params[key] = r.PostForm.Get(key)
+	}
+	if err := h.verifyTwilioSignature(ctx, providerID, params, r.Header.Get(twilioSignatureHeader)); err != nil {
+		writeError(w, err)
+		return
+	}
+	update := parseTwilioStatus(params)
+	if update == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err := h.updateStatuses(ctx, providerID, []*statusUpdate{update}); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verifyTwilioSignature checks the request was signed with the auth token of the configured Twilio account
+// https://www.twilio.com/docs/usage/security#validating-requests
+func (h *Handler) verifyTwilioSignature(ctx context.Context, providerID string, params map[string]string, signature string) error {
+	if signature == "" {
+		return zerrors.ThrowUnauthenticated(nil, "DELIV-Tw2sLb", "Errors.Notification.Delivery.InvalidSignature")
+	}
+	config, err := h.queries.SMSProviderConfigByID(ctx, providerID)
+	if err != nil {
+		return err
+	}
+	if config.TwilioConfig == nil || config.TwilioConfig.Token == nil {
+		return zerrors.ThrowNotFound(nil, "DELIV-Tw2sLc", "Errors.SMSConfig.NotFound")
+	}
+	token, err := crypto.DecryptString(config.TwilioConfig.Token, h.smsEncryption)
+	if err != nil {
+		return err
+	}
+	callbackURL := h.twilioURL(ctx, providerID)
+	validator := twilioClient.NewRequestValidator(token)
+	if callbackURL == "" || !validator.Validate(callbackURL, params, signature) {
+		return zerrors.ThrowUnauthenticated(nil, "DELIV-Tw2sLd", "Errors.Notification.Delivery.InvalidSignature")
+	}
+	return nil
+}
+
+// parseTwilioStatus maps the final message states to a delivery status.
+// Intermediate states (queued, sending, sent) return nil, as the notification is already marked as sent.
+func parseTwilioStatus(params map[string]string) *statusUpdate {
+	update := &statusUpdate{
+		messageID: params[twilioParamMessageSID],
+	}
+	switch params[twilioParamMessageStatus] {
+	case "delivered":
+		update.status = domain.NotificationDeliveryStatusDelivered
+	case "undelivered", "failed":
+		update.status = domain.NotificationDeliveryStatusUndelivered
+		if code := params[twilioParamErrorCode]; code != "" {
+			update.reason = "twilio error code " + code
+		}
+	default:
+		return nil
+	}
+	return update
+}
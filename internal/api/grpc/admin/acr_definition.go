@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListDefaultACRDefinitions(ctx context.Context, _ *admin_pb.ListDefaultACRDefinitionsRequest) (*admin_pb.ListDefaultACRDefinitionsResponse, error) {
+	ownerQuery, err := query.NewACRDefinitionResourceOwnerSearchQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := s.query.SearchACRDefinitions(ctx, &query.ACRDefinitionSearchQueries{Queries: []query.SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListDefaultACRDefinitionsResponse{
+		Details: object.ToListDetails(definitions.Count, definitions.Sequence, definitions.LastRun),
+		Result:  policy_grpc.ACRDefinitionsToPb(definitions.Definitions),
+	}, nil
+}
+
+func (s *Server) SetDefaultACRDefinition(ctx context.Context, req *admin_pb.SetDefaultACRDefinitionRequest) (*admin_pb.SetDefaultACRDefinitionResponse, error) {
+	details, err := s.command.SetACRDefinition(ctx, authz.GetInstance(ctx).InstanceID(), policy_grpc.ACRDefinitionToDomain(req.GetAcr(), req.GetPhishingResistant(), req.GetMultiFactor(), req.GetMaxAge()))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultACRDefinitionResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveDefaultACRDefinition(ctx context.Context, req *admin_pb.RemoveDefaultACRDefinitionRequest) (*admin_pb.RemoveDefaultACRDefinitionResponse, error) {
+	details, err := s.command.RemoveACRDefinition(ctx, authz.GetInstance(ctx).InstanceID(), req.GetAcr())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveDefaultACRDefinitionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListIAMCustomRoles(ctx context.Context, _ *admin_pb.ListIAMCustomRolesRequest) (*admin_pb.ListIAMCustomRolesResponse, error) {
+	ownerQuery, err := query.NewCustomRoleResourceOwnerSearchQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.query.SearchCustomRoles(ctx, &query.CustomRoleSearchQueries{Queries: []query.SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListIAMCustomRolesResponse{
+		Details: object.ToListDetails(roles.Count, roles.Sequence, roles.LastRun),
+		Result:  member.CustomRolesToPb(roles.Roles),
+	}, nil
+}
+
+func (s *Server) AddIAMCustomRole(ctx context.Context, req *admin_pb.AddIAMCustomRoleRequest) (*admin_pb.AddIAMCustomRoleResponse, error) {
+	details, err := s.command.AddCustomRole(ctx, authz.GetInstance(ctx).InstanceID(), member.CustomRoleToDomain(req.GetKey(), req.GetDisplayName(), req.GetPermissions()))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddIAMCustomRoleResponse{
+		Details: object.AddToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateIAMCustomRole(ctx context.Context, req *admin_pb.UpdateIAMCustomRoleRequest) (*admin_pb.UpdateIAMCustomRoleResponse, error) {
+	details, err := s.command.ChangeCustomRole(ctx, authz.GetInstance(ctx).InstanceID(), member.CustomRoleToDomain(req.GetKey(), req.GetDisplayName(), req.GetPermissions()))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateIAMCustomRoleResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveIAMCustomRole(ctx context.Context, req *admin_pb.RemoveIAMCustomRoleRequest) (*admin_pb.RemoveIAMCustomRoleResponse, error) {
+	details, err := s.command.RemoveCustomRole(ctx, authz.GetInstance(ctx).InstanceID(), req.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveIAMCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -90,8 +90,19 @@ func (s *Server) UpdateEmailProviderSMTPPassword(ctx context.Context, req *admin
 	}, nil
 }
 
+func (s *Server) GenerateEmailProviderSMTPWebhookToken(ctx context.Context, req *admin_pb.GenerateEmailProviderSMTPWebhookTokenRequest) (*admin_pb.GenerateEmailProviderSMTPWebhookTokenResponse, error) {
+	token, details, err := s.command.GenerateSMTPConfigWebhookToken(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GenerateEmailProviderSMTPWebhookTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+		Token:   token,
+	}, nil
+}
+
 func (s *Server) ListEmailProviders(ctx context.Context, req *admin_pb.ListEmailProvidersRequest) (*admin_pb.ListEmailProvidersResponse, error) {
-	queries, err := listEmailProvidersToModel(req)
+	queries, err := listEmailProvidersToModel(req, authz.GetInstance(ctx).InstanceID())
 	if err != nil {
 		return nil, err
 	}
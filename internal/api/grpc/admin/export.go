@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
 	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -400,6 +401,7 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 		mfaInitSkip := durationpb.New(time.Duration(queriedLogin.MFAInitSkipLifetime))
 		secondFactor := durationpb.New(time.Duration(queriedLogin.SecondFactorCheckLifetime))
 		multiFactor := durationpb.New(time.Duration(queriedLogin.MultiFactorCheckLifetime))
+		trustedDevice := durationpb.New(time.Duration(queriedLogin.TrustedDeviceLifetime))
 
 		secondFactors := []policy_pb.SecondFactorType{}
 		for _, factor := range queriedLogin.SecondFactors {
@@ -451,6 +453,8 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 			MfaInitSkipLifetime:        mfaInitSkip,
 			SecondFactorCheckLifetime:  secondFactor,
 			MultiFactorCheckLifetime:   multiFactor,
+			TrustedDeviceLifetime:      trustedDevice,
+			RiskThresholds:             policy_grpc.RiskThresholdsToPb(queriedLogin.RiskThresholds),
 			SecondFactors:              secondFactors,
 			MultiFactors:               multiFactors,
 			Idps:                       idpLinks,
@@ -541,6 +545,8 @@ func (s *Server) getPrivacyPolicy(ctx context.Context, orgID string) (_ *managem
 			DocsLink:       queriedPrivacy.DocsLink,
 			CustomLink:     queriedPrivacy.CustomLink,
 			CustomLinkText: queriedPrivacy.CustomLinkText,
+
+			DeletionGracePeriod: durationpb.New(queriedPrivacy.DeletionGracePeriod),
 		}, nil
 	}
 	return nil, nil
@@ -816,6 +822,8 @@ func (s *Server) getProjectsAndApps(ctx context.Context, org string) ([]*v1_pb.D
 						ClockSkew:                durationpb.New(app.OIDCConfig.ClockSkew),
 						AdditionalOrigins:        app.OIDCConfig.AdditionalOrigins,
 						SkipNativeAppSuccessPage: app.OIDCConfig.SkipNativeAppSuccessPage,
+						RequireConsent:           app.OIDCConfig.RequireConsent,
+						RequiredAcr:              app.OIDCConfig.RequiredACR,
 					},
 				})
 			}
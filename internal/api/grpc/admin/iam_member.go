@@ -2,15 +2,26 @@ package admin
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/member"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
 func (s *Server) ListIAMMemberRoles(ctx context.Context, req *admin_pb.ListIAMMemberRolesRequest) (*admin_pb.ListIAMMemberRolesResponse, error) {
 	roles := s.query.GetIAMMemberRoles()
+	customRoles, err := s.query.CustomRoleMappingsByOrg(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range customRoles {
+		if strings.HasPrefix(role.Role, domain.IAMCustomRolePrefix) {
+			roles = append(roles, role.Role)
+		}
+	}
 	return &admin_pb.ListIAMMemberRolesResponse{
 		Roles:   roles,
 		Details: object.ToListDetails(uint64(len(roles)), 0, time.Now()),
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func UpdateInactivityPolicyToDomain(policy *admin_pb.UpdateInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnBeforeDays:             uint64(policy.WarnBeforeDays),
+		HumanDeactivateAfterDays:   uint64(policy.HumanDeactivateAfterDays),
+		HumanDeleteAfterDays:       uint64(policy.HumanDeleteAfterDays),
+		MachineDeactivateAfterDays: uint64(policy.MachineDeactivateAfterDays),
+		MachineDeleteAfterDays:     uint64(policy.MachineDeleteAfterDays),
+	}
+}
+
+func ListInactiveUsersRequestToQuery(req *admin_pb.ListInactiveUsersRequest) *query.InactiveUserSearchQueries {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.InactiveUserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		ResourceOwner: req.OrgId,
+	}
+}
+
+func InactiveUsersToPb(users []*query.InactiveUser) []*admin_pb.InactiveUser {
+	result := make([]*admin_pb.InactiveUser, len(users))
+	for i, user := range users {
+		result[i] = &admin_pb.InactiveUser{
+			UserId:       user.UserID,
+			OrgId:        user.ResourceOwner,
+			Username:     user.Username,
+			Type:         user_grpc.TypeToPb(user.Type),
+			State:        user_grpc.UserStateToPb(user.State),
+			LastActivity: timestamppb.New(user.LastActivity),
+			Action:       policy_grpc.InactivityActionToPb(user.Action),
+			DueDate:      timestamppb.New(user.DueDate),
+			Warning:      user.Warning,
+			Notified:     user.Notified,
+		}
+	}
+	return result
+}
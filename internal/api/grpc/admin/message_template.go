@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	"github.com/zitadel/zitadel/internal/command"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetDefaultMessageTemplate(ctx context.Context, req *admin_pb.GetDefaultMessageTemplateRequest) (*admin_pb.GetDefaultMessageTemplateResponse, error) {
+	template, err := s.query.DefaultMessageTemplate(ctx, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultMessageTemplateResponse{
+		Template: text_grpc.MessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetDefaultMessageTemplate(ctx context.Context, req *admin_pb.SetDefaultMessageTemplateRequest) (*admin_pb.SetDefaultMessageTemplateResponse, error) {
+	result, err := s.command.SetDefaultMessageTemplate(ctx, &command.MessageTemplateUpload{
+		MessageType: req.MessageType,
+		Language:    language.Make(req.Language),
+		Format:      text_grpc.MessageTemplateFormatToDomain(req.Format),
+		Template:    req.Template,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) RemoveDefaultMessageTemplate(ctx context.Context, req *admin_pb.RemoveDefaultMessageTemplateRequest) (*admin_pb.RemoveDefaultMessageTemplateResponse, error) {
+	result, err := s.command.RemoveDefaultMessageTemplate(ctx, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveDefaultMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) PreviewDefaultMessageTemplate(ctx context.Context, req *admin_pb.PreviewDefaultMessageTemplateRequest) (*admin_pb.PreviewDefaultMessageTemplateResponse, error) {
+	html, err := s.query.PreviewMessageTemplate(ctx, authz.GetInstance(ctx).InstanceID(), req.MessageType, language.Make(req.Language), req.Template, text_grpc.MessageTemplateFormatToDomain(req.Format))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.PreviewDefaultMessageTemplateResponse{
+		Html: html,
+	}, nil
+}
@@ -14,5 +14,7 @@ func UpdatePrivacyPolicyToDomain(req *admin_pb.UpdatePrivacyPolicyRequest) *doma
 		DocsLink:       req.DocsLink,
 		CustomLink:     req.CustomLink,
 		CustomLinkText: req.CustomLinkText,
+
+		DeletionGracePeriod: req.DeletionGracePeriod.AsDuration(),
 	}
 }
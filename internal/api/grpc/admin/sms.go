@@ -9,7 +9,7 @@ import (
 )
 
 func (s *Server) ListSMSProviders(ctx context.Context, req *admin_pb.ListSMSProvidersRequest) (*admin_pb.ListSMSProvidersResponse, error) {
-	queries, err := listSMSConfigsToModel(req)
+	queries, err := listSMSConfigsToModel(req, authz.GetInstance(ctx).InstanceID())
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +86,130 @@ func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.Update
 	}, nil
 }
 
+func (s *Server) AddSMSProviderVonage(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) (*admin_pb.AddSMSProviderVonageResponse, error) {
+	smsConfig := addSMSConfigVonageToConfig(ctx, req)
+	if err := s.command.AddSMSConfigVonage(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVonageResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonage(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) (*admin_pb.UpdateSMSProviderVonageResponse, error) {
+	smsConfig := updateSMSConfigVonageToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigVonage(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonageAPISecret(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageAPISecretRequest) (*admin_pb.UpdateSMSProviderVonageAPISecretResponse, error) {
+	result, err := s.command.ChangeSMSConfigVonageAPISecret(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ApiSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageAPISecretResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderSNS(ctx context.Context, req *admin_pb.AddSMSProviderSNSRequest) (*admin_pb.AddSMSProviderSNSResponse, error) {
+	smsConfig := addSMSConfigSNSToConfig(ctx, req)
+	if err := s.command.AddSMSConfigSNS(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderSNSResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSNS(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSRequest) (*admin_pb.UpdateSMSProviderSNSResponse, error) {
+	smsConfig := updateSMSConfigSNSToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigSNS(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSNSResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSNSSecretAccessKey(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSSecretAccessKeyRequest) (*admin_pb.UpdateSMSProviderSNSSecretAccessKeyResponse, error) {
+	result, err := s.command.ChangeSMSConfigSNSSecretAccessKey(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.SecretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSNSSecretAccessKeyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderMessageBird(ctx context.Context, req *admin_pb.AddSMSProviderMessageBirdRequest) (*admin_pb.AddSMSProviderMessageBirdResponse, error) {
+	smsConfig := addSMSConfigMessageBirdToConfig(ctx, req)
+	if err := s.command.AddSMSConfigMessageBird(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderMessageBirdResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderMessageBird(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdRequest) (*admin_pb.UpdateSMSProviderMessageBirdResponse, error) {
+	smsConfig := updateSMSConfigMessageBirdToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigMessageBird(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderMessageBirdResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderMessageBirdAccessKey(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdAccessKeyRequest) (*admin_pb.UpdateSMSProviderMessageBirdAccessKeyResponse, error) {
+	result, err := s.command.ChangeSMSConfigMessageBirdAccessKey(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.AccessKey)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderMessageBirdAccessKeyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderSMPP(ctx context.Context, req *admin_pb.AddSMSProviderSMPPRequest) (*admin_pb.AddSMSProviderSMPPResponse, error) {
+	smsConfig := addSMSConfigSMPPToConfig(ctx, req)
+	if err := s.command.AddSMSConfigSMPP(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderSMPPResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSMPP(ctx context.Context, req *admin_pb.UpdateSMSProviderSMPPRequest) (*admin_pb.UpdateSMSProviderSMPPResponse, error) {
+	smsConfig := updateSMSConfigSMPPToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigSMPP(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSMPPResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSMPPPassword(ctx context.Context, req *admin_pb.UpdateSMSProviderSMPPPasswordRequest) (*admin_pb.UpdateSMSProviderSMPPPasswordResponse, error) {
+	result, err := s.command.ChangeSMSConfigSMPPPassword(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSMPPPasswordResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
@@ -14,14 +14,19 @@ import (
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
 )
 
-func listSMSConfigsToModel(req *admin_pb.ListSMSProvidersRequest) (*query.SMSConfigsSearchQueries, error) {
+func listSMSConfigsToModel(req *admin_pb.ListSMSProvidersRequest, instanceID string) (*query.SMSConfigsSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
+	resourceOwnerQuery, err := query.NewSMSProviderResourceOwnerSearchQuery(instanceID)
+	if err != nil {
+		return nil, err
+	}
 	return &query.SMSConfigsSearchQueries{
 		SearchRequest: query.SearchRequest{
 			Offset: offset,
 			Limit:  limit,
 			Asc:    asc,
 		},
+		Queries: []query.SearchQuery{resourceOwnerQuery},
 	}, nil
 }
 
@@ -50,6 +55,18 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.HTTPConfig != nil {
 		return HTTPConfigToPb(config.HTTPConfig)
 	}
+	if config.VonageConfig != nil {
+		return VonageConfigToPb(config.VonageConfig)
+	}
+	if config.SNSConfig != nil {
+		return SNSConfigToPb(config.SNSConfig)
+	}
+	if config.MessageBirdConfig != nil {
+		return MessageBirdConfigToPb(config.MessageBirdConfig)
+	}
+	if config.SMPPConfig != nil {
+		return SMPPConfigToPb(config.SMPPConfig)
+	}
 	return nil
 }
 
@@ -71,6 +88,49 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func VonageConfigToPb(vonage *query.Vonage) *settings_pb.SMSProvider_Vonage {
+	return &settings_pb.SMSProvider_Vonage{
+		Vonage: &settings_pb.VonageConfig{
+			ApiKey:       vonage.APIKey,
+			SenderNumber: vonage.SenderNumber,
+			VerifyBrand:  vonage.VerifyBrand,
+		},
+	}
+}
+
+func SNSConfigToPb(sns *query.SNS) *settings_pb.SMSProvider_Sns {
+	return &settings_pb.SMSProvider_Sns{
+		Sns: &settings_pb.SNSConfig{
+			Region:      sns.Region,
+			AccessKeyId: sns.AccessKeyID,
+			SenderId:    sns.SenderID,
+		},
+	}
+}
+
+func MessageBirdConfigToPb(messageBird *query.MessageBird) *settings_pb.SMSProvider_Messagebird {
+	return &settings_pb.SMSProvider_Messagebird{
+		Messagebird: &settings_pb.MessageBirdConfig{
+			Originator: messageBird.Originator,
+			Verify:     messageBird.Verify,
+		},
+	}
+}
+
+func SMPPConfigToPb(smpp *query.SMPP) *settings_pb.SMSProvider_Smpp {
+	return &settings_pb.SMSProvider_Smpp{
+		Smpp: &settings_pb.SMPPConfig{
+			Host:          smpp.Host,
+			Tls:           smpp.TLS,
+			TlsCa:         smpp.TLSCA,
+			TlsServerName: smpp.TLSServerName,
+			SystemId:      smpp.SystemID,
+			SystemType:    smpp.SystemType,
+			SourceAddress: smpp.SourceAddress,
+		},
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateInactive:
@@ -120,3 +180,97 @@ func updateSMSConfigHTTPToConfig(ctx context.Context, req *admin_pb.UpdateSMSPro
 		Endpoint:      gu.Ptr(req.Endpoint),
 	}
 }
+
+func addSMSConfigVonageToConfig(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) *command.AddSMSVonage {
+	return &command.AddSMSVonage{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		Description:   req.GetDescription(),
+		APIKey:        req.GetApiKey(),
+		APISecret:     req.GetApiSecret(),
+		SenderNumber:  req.GetSenderNumber(),
+		VerifyBrand:   req.GetVerifyBrand(),
+	}
+}
+
+func updateSMSConfigVonageToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) *command.ChangeSMSVonage {
+	return &command.ChangeSMSVonage{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		APIKey:        gu.Ptr(req.ApiKey),
+		SenderNumber:  gu.Ptr(req.SenderNumber),
+		VerifyBrand:   gu.Ptr(req.VerifyBrand),
+	}
+}
+
+func addSMSConfigSNSToConfig(ctx context.Context, req *admin_pb.AddSMSProviderSNSRequest) *command.AddSMSSNS {
+	return &command.AddSMSSNS{
+		ResourceOwner:   authz.GetInstance(ctx).InstanceID(),
+		Description:     req.GetDescription(),
+		Region:          req.GetRegion(),
+		AccessKeyID:     req.GetAccessKeyId(),
+		SecretAccessKey: req.GetSecretAccessKey(),
+		SenderID:        req.GetSenderId(),
+	}
+}
+
+func updateSMSConfigSNSToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSRequest) *command.ChangeSMSSNS {
+	return &command.ChangeSMSSNS{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		Region:        gu.Ptr(req.Region),
+		AccessKeyID:   gu.Ptr(req.AccessKeyId),
+		SenderID:      gu.Ptr(req.SenderId),
+	}
+}
+
+func addSMSConfigMessageBirdToConfig(ctx context.Context, req *admin_pb.AddSMSProviderMessageBirdRequest) *command.AddSMSMessageBird {
+	return &command.AddSMSMessageBird{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		Description:   req.GetDescription(),
+		AccessKey:     req.GetAccessKey(),
+		Originator:    req.GetOriginator(),
+		Verify:        req.GetVerify(),
+	}
+}
+
+func updateSMSConfigMessageBirdToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderMessageBirdRequest) *command.ChangeSMSMessageBird {
+	return &command.ChangeSMSMessageBird{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		Originator:    gu.Ptr(req.Originator),
+		Verify:        gu.Ptr(req.Verify),
+	}
+}
+
+func addSMSConfigSMPPToConfig(ctx context.Context, req *admin_pb.AddSMSProviderSMPPRequest) *command.AddSMSSMPP {
+	return &command.AddSMSSMPP{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		Description:   req.GetDescription(),
+		Host:          req.GetHost(),
+		TLS:           req.GetTls(),
+		TLSCA:         req.GetTlsCa(),
+		TLSServerName: req.GetTlsServerName(),
+		SystemID:      req.GetSystemId(),
+		Password:      req.GetPassword(),
+		SystemType:    req.GetSystemType(),
+		SourceAddress: req.GetSourceAddress(),
+	}
+}
+
+func updateSMSConfigSMPPToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderSMPPRequest) *command.ChangeSMSSMPP {
+	return &command.ChangeSMSSMPP{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		Host:          gu.Ptr(req.Host),
+		TLS:           gu.Ptr(req.Tls),
+		TLSCA:         gu.Ptr(req.TlsCa),
+		TLSServerName: gu.Ptr(req.TlsServerName),
+		SystemID:      gu.Ptr(req.SystemId),
+		SystemType:    gu.Ptr(req.SystemType),
+		SourceAddress: gu.Ptr(req.SourceAddress),
+	}
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) RequestMyProjectAccess(ctx context.Context, req *auth_pb.RequestMyProjectAccessRequest) (*auth_pb.RequestMyProjectAccessResponse, error) {
+	details, err := s.command.RequestAccess(ctx, &domain.AccessRequest{
+		UserID:    authz.GetCtxData(ctx).UserID,
+		ProjectID: req.GetProjectId(),
+		RoleKeys:  req.GetRoleKeys(),
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RequestMyProjectAccessResponse{
+		RequestId: details.ID,
+		Details:   object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyAccessRequests(ctx context.Context, req *auth_pb.ListMyAccessRequestsRequest) (*auth_pb.ListMyAccessRequestsResponse, error) {
+	q, err := ListMyAccessRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessRequests(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessRequestsResponse{
+		Result:  project_grpc.AccessRequestsToPb(res.AccessRequests),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) CancelMyAccessRequest(ctx context.Context, req *auth_pb.CancelMyAccessRequestRequest) (*auth_pb.CancelMyAccessRequestResponse, error) {
+	details, err := s.command.CancelAccessRequest(ctx, req.GetRequestId(), authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.CancelMyAccessRequestResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListMyAccessRequestsRequestToQuery(ctx context.Context, req *auth_pb.ListMyAccessRequestsRequest) (*query.AccessRequestSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	queries, err := project_grpc.AccessRequestQueriesToModel(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewAccessRequestUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, userIDQuery),
+	}, nil
+}
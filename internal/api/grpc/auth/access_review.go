@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyAccessReviews(ctx context.Context, req *auth_pb.ListMyAccessReviewsRequest) (*auth_pb.ListMyAccessReviewsResponse, error) {
+	q, err := ListMyAccessReviewsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessReviews(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessReviewsResponse{
+		Result:  project_grpc.AccessReviewsToPb(res.AccessReviews),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) ListMyAccessReviewItems(ctx context.Context, req *auth_pb.ListMyAccessReviewItemsRequest) (*auth_pb.ListMyAccessReviewItemsResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, req.GetReviewId(), "")
+	if err != nil {
+		return nil, err
+	}
+	// only the assigned reviewers are allowed to see the items
+	if !slices.Contains(review.Reviewers, authz.GetCtxData(ctx).UserID) {
+		return nil, zerrors.ThrowNotFound(nil, "AUTH-Rv7aAa", "Errors.AccessReview.NotFound")
+	}
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	queries, err := project_grpc.AccessReviewItemQueriesToModel(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessReviewItems(ctx, review.ID, &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyAccessReviewItemsResponse{
+		Result:  project_grpc.AccessReviewItemsToPb(res.AccessReviewItems),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) DecideMyAccessReviewItem(ctx context.Context, req *auth_pb.DecideMyAccessReviewItemRequest) (*auth_pb.DecideMyAccessReviewItemResponse, error) {
+	details, err := s.command.DecideAccessReviewItem(ctx, req.GetReviewId(), req.GetItemId(), project_grpc.AccessReviewDecisionToDomain(req.GetDecision()), req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.DecideMyAccessReviewItemResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListMyAccessReviewsRequestToQuery(ctx context.Context, req *auth_pb.ListMyAccessReviewsRequest) (*query.AccessReviewSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	queries, err := project_grpc.AccessReviewQueriesToModel(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	reviewerQuery, err := query.NewAccessReviewReviewerSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, reviewerQuery),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyTrustedDevices(ctx context.Context, req *auth_pb.ListMyTrustedDevicesRequest) (*auth_pb.ListMyTrustedDevicesResponse, error) {
+	q, err := ListMyTrustedDevicesRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchTrustedDevices(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(res.Devices),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) RemoveMyTrustedDevice(ctx context.Context, req *auth_pb.RemoveMyTrustedDeviceRequest) (*auth_pb.RemoveMyTrustedDeviceResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RemoveTrustedDevice(ctx, ctxData.UserID, ctxData.ResourceOwner, req.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RemoveMyTrustedDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListMyTrustedDevicesRequestToQuery(ctx context.Context, req *auth_pb.ListMyTrustedDevicesRequest) (*query.TrustedDeviceSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	userIDQuery, err := query.NewTrustedDeviceUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.TrustedDeviceSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{userIDQuery},
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
 )
 
@@ -27,6 +28,14 @@ func (s *Server) GetMyUser(ctx context.Context, _ *auth_pb.GetMyUserRequest) (*a
 
 func (s *Server) RemoveMyUser(ctx context.Context, _ *auth_pb.RemoveMyUserRequest) (*auth_pb.RemoveMyUserResponse, error) {
 	ctxData := authz.GetCtxData(ctx)
+	// if the privacy policy requires a grace period, the deletion must be scheduled
+	privacyPolicy, err := s.query.PrivacyPolicyByOrg(ctx, true, ctxData.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	if privacyPolicy.DeletionGracePeriod > 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "AUTH-Dl4aAa", "Errors.User.Deletion.ScheduleRequired")
+	}
 	userGrantUserID, err := query.NewUserGrantUserIDSearchQuery(ctxData.UserID)
 	if err != nil {
 		return nil, err
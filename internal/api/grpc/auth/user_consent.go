@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) ListMyUserConsents(ctx context.Context, req *auth_pb.ListMyUserConsentsRequest) (*auth_pb.ListMyUserConsentsResponse, error) {
+	q, err := ListMyUserConsentsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserConsents(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyUserConsentsResponse{
+		Result:  user_grpc.UserConsentsToPb(res.Consents),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) RevokeMyUserConsent(ctx context.Context, req *auth_pb.RevokeMyUserConsentRequest) (*auth_pb.RevokeMyUserConsentResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.RevokeUserConsent(ctx, ctxData.UserID, ctxData.ResourceOwner, req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RevokeMyUserConsentResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListMyUserConsentsRequestToQuery(ctx context.Context, req *auth_pb.ListMyUserConsentsRequest) (*query.UserConsentSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	userIDQuery, err := query.NewUserConsentUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserConsentSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{userIDQuery},
+	}, nil
+}
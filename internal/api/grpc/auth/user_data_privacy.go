@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	auth_pb "github.com/zitadel/zitadel/pkg/grpc/auth"
+)
+
+func (s *Server) RequestMyDataExport(ctx context.Context, _ *auth_pb.RequestMyDataExportRequest) (*auth_pb.RequestMyDataExportResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	exportID, details, err := s.command.RequestUserDataExport(ctx, ctxData.ResourceOwner, ctxData.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.RequestMyDataExportResponse{
+		ExportId: exportID,
+		Details:  object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListMyDataExports(ctx context.Context, req *auth_pb.ListMyDataExportsRequest) (*auth_pb.ListMyDataExportsResponse, error) {
+	q, err := ListMyDataExportsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserDataExports(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ListMyDataExportsResponse{
+		Result:  user_grpc.DataExportsToPb(res.Exports),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) DownloadMyDataExport(ctx context.Context, req *auth_pb.DownloadMyDataExportRequest) (*auth_pb.DownloadMyDataExportResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	data, details, err := s.command.DownloadUserDataExport(ctx, ctxData.ResourceOwner, ctxData.UserID, req.GetExportId())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.DownloadMyDataExportResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+		Data:    data,
+	}, nil
+}
+
+func (s *Server) ScheduleMyUserDeletion(ctx context.Context, req *auth_pb.ScheduleMyUserDeletionRequest) (*auth_pb.ScheduleMyUserDeletionResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	deletionDate, details, err := s.command.ScheduleUserDeletion(ctx, ctxData.ResourceOwner, ctxData.UserID, req.GetSessionId(), req.GetSessionToken())
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.ScheduleMyUserDeletionResponse{
+		Details:      object.DomainToChangeDetailsPb(details),
+		DeletionDate: timestamppb.New(deletionDate),
+	}, nil
+}
+
+func (s *Server) GetMyUserDeletion(ctx context.Context, _ *auth_pb.GetMyUserDeletionRequest) (*auth_pb.GetMyUserDeletionResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	deletion, err := s.query.ScheduledUserDeletionByUserID(ctx, ctxData.UserID, ctxData.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.GetMyUserDeletionResponse{
+		Details: object.ToViewDetailsPb(
+			deletion.Sequence,
+			deletion.CreationDate,
+			deletion.ChangeDate,
+			deletion.ResourceOwner,
+		),
+		DeletionDate: timestamppb.New(deletion.DeletionDate),
+	}, nil
+}
+
+func (s *Server) CancelMyUserDeletion(ctx context.Context, _ *auth_pb.CancelMyUserDeletionRequest) (*auth_pb.CancelMyUserDeletionResponse, error) {
+	ctxData := authz.GetCtxData(ctx)
+	details, err := s.command.CancelUserDeletion(ctx, ctxData.ResourceOwner, ctxData.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &auth_pb.CancelMyUserDeletionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListMyDataExportsRequestToQuery(ctx context.Context, req *auth_pb.ListMyDataExportsRequest) (*query.UserDataExportSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	userIDQuery, err := query.NewUserDataExportUserIDSearchQuery(authz.GetCtxData(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserDataExportSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{userIDQuery},
+	}, nil
+}
@@ -0,0 +1,81 @@
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListAccessRequests(ctx context.Context, req *mgmt_pb.ListAccessRequestsRequest) (*mgmt_pb.ListAccessRequestsResponse, error) {
+	q, err := ListAccessRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessRequests(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessRequestsResponse{
+		Result:  project_grpc.AccessRequestsToPb(res.AccessRequests),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) GetAccessRequestByID(ctx context.Context, req *mgmt_pb.GetAccessRequestByIDRequest) (*mgmt_pb.GetAccessRequestByIDResponse, error) {
+	request, err := s.query.AccessRequestByID(ctx, req.RequestId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessRequestByIDResponse{
+		AccessRequest: project_grpc.AccessRequestToPb(request),
+	}, nil
+}
+
+func (s *Server) ApproveAccessRequest(ctx context.Context, req *mgmt_pb.ApproveAccessRequestRequest) (*mgmt_pb.ApproveAccessRequestResponse, error) {
+	var validUntil time.Time
+	if req.ValidUntil != nil {
+		validUntil = req.ValidUntil.AsTime()
+	}
+	details, err := s.command.ApproveAccessRequest(ctx, req.RequestId, authz.GetCtxData(ctx).OrgID, req.Justification, validUntil)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ApproveAccessRequestResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) DenyAccessRequest(ctx context.Context, req *mgmt_pb.DenyAccessRequestRequest) (*mgmt_pb.DenyAccessRequestResponse, error) {
+	details, err := s.command.DenyAccessRequest(ctx, req.RequestId, authz.GetCtxData(ctx).OrgID, req.Justification)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DenyAccessRequestResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListAccessRequestsRequestToQuery(ctx context.Context, req *mgmt_pb.ListAccessRequestsRequest) (*query.AccessRequestSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := project_grpc.AccessRequestQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewAccessRequestResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
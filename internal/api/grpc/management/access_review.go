@@ -0,0 +1,170 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+	proj_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func (s *Server) AddAccessReview(ctx context.Context, req *mgmt_pb.AddAccessReviewRequest) (*mgmt_pb.AddAccessReviewResponse, error) {
+	details, err := s.command.AddAccessReview(ctx, AddAccessReviewRequestToDomain(req), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddAccessReviewResponse{
+		Id:      details.ID,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListAccessReviews(ctx context.Context, req *mgmt_pb.ListAccessReviewsRequest) (*mgmt_pb.ListAccessReviewsResponse, error) {
+	q, err := ListAccessReviewsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessReviews(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewsResponse{
+		Result:  project_grpc.AccessReviewsToPb(res.AccessReviews),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) GetAccessReviewByID(ctx context.Context, req *mgmt_pb.GetAccessReviewByIDRequest) (*mgmt_pb.GetAccessReviewByIDResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessReviewByIDResponse{
+		AccessReview: project_grpc.AccessReviewToPb(review),
+	}, nil
+}
+
+func (s *Server) ListAccessReviewItems(ctx context.Context, req *mgmt_pb.ListAccessReviewItemsRequest) (*mgmt_pb.ListAccessReviewItemsResponse, error) {
+	review, err := s.query.AccessReviewByID(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	q, err := ListAccessReviewItemsRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchAccessReviewItems(ctx, review.ID, q)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccessReviewItemsResponse{
+		Result:  project_grpc.AccessReviewItemsToPb(res.AccessReviewItems),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) SetAccessReviewReviewers(ctx context.Context, req *mgmt_pb.SetAccessReviewReviewersRequest) (*mgmt_pb.SetAccessReviewReviewersResponse, error) {
+	details, err := s.command.SetAccessReviewReviewers(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID, req.ReviewerIds)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAccessReviewReviewersResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) CompleteAccessReview(ctx context.Context, req *mgmt_pb.CompleteAccessReviewRequest) (*mgmt_pb.CompleteAccessReviewResponse, error) {
+	details, err := s.command.CompleteAccessReview(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CompleteAccessReviewResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) CancelAccessReview(ctx context.Context, req *mgmt_pb.CancelAccessReviewRequest) (*mgmt_pb.CancelAccessReviewResponse, error) {
+	details, err := s.command.CancelAccessReview(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CancelAccessReviewResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetAccessReviewReport(ctx context.Context, req *mgmt_pb.GetAccessReviewReportRequest) (*mgmt_pb.GetAccessReviewReportResponse, error) {
+	report, err := s.query.AccessReviewReport(ctx, req.ReviewId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Format == proj_pb.AccessReviewReportFormat_ACCESS_REVIEW_REPORT_FORMAT_JSON {
+		data, err := report.JSON()
+		if err != nil {
+			return nil, err
+		}
+		return &mgmt_pb.GetAccessReviewReportResponse{
+			Report:      data,
+			ContentType: "application/json",
+		}, nil
+	}
+	data, err := report.CSV()
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAccessReviewReportResponse{
+		Report:      data,
+		ContentType: "text/csv",
+	}, nil
+}
+
+func AddAccessReviewRequestToDomain(req *mgmt_pb.AddAccessReviewRequest) *domain.AccessReview {
+	return &domain.AccessReview{
+		Name:       req.Name,
+		ProjectID:  req.ProjectId,
+		RoleKeys:   req.RoleKeys,
+		Reviewers:  req.ReviewerIds,
+		Deadline:   req.GetDeadline().AsTime(),
+		AutoRevoke: req.AutoRevoke,
+	}
+}
+
+func ListAccessReviewsRequestToQuery(ctx context.Context, req *mgmt_pb.ListAccessReviewsRequest) (*query.AccessReviewSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := project_grpc.AccessReviewQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewAccessReviewResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func ListAccessReviewItemsRequestToQuery(req *mgmt_pb.ListAccessReviewItemsRequest) (*query.AccessReviewItemSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := project_grpc.AccessReviewItemQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	return &query.AccessReviewItemSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
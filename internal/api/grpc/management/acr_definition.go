@@ -0,0 +1,46 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListACRDefinitions(ctx context.Context, _ *mgmt_pb.ListACRDefinitionsRequest) (*mgmt_pb.ListACRDefinitionsResponse, error) {
+	ownerQuery, err := query.NewACRDefinitionResourceOwnersSearchQuery(authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := s.query.SearchACRDefinitions(ctx, &query.ACRDefinitionSearchQueries{Queries: []query.SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListACRDefinitionsResponse{
+		Details: object.ToListDetails(definitions.Count, definitions.Sequence, definitions.LastRun),
+		Result:  policy_grpc.ACRDefinitionsToPb(definitions.Definitions),
+	}, nil
+}
+
+func (s *Server) SetCustomACRDefinition(ctx context.Context, req *mgmt_pb.SetCustomACRDefinitionRequest) (*mgmt_pb.SetCustomACRDefinitionResponse, error) {
+	details, err := s.command.SetACRDefinition(ctx, authz.GetCtxData(ctx).OrgID, policy_grpc.ACRDefinitionToDomain(req.GetAcr(), req.GetPhishingResistant(), req.GetMultiFactor(), req.GetMaxAge()))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomACRDefinitionResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveCustomACRDefinition(ctx context.Context, req *mgmt_pb.RemoveCustomACRDefinitionRequest) (*mgmt_pb.RemoveCustomACRDefinitionResponse, error) {
+	details, err := s.command.RemoveACRDefinition(ctx, authz.GetCtxData(ctx).OrgID, req.GetAcr())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveCustomACRDefinitionResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListOrgCustomRoles(ctx context.Context, _ *mgmt_pb.ListOrgCustomRolesRequest) (*mgmt_pb.ListOrgCustomRolesResponse, error) {
+	ownerQuery, err := query.NewCustomRoleResourceOwnersSearchQuery(authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	keyQuery, err := query.NewCustomRoleKeyPrefixSearchQuery(domain.OrgCustomRolePrefix)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.query.SearchCustomRoles(ctx, &query.CustomRoleSearchQueries{Queries: []query.SearchQuery{ownerQuery, keyQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgCustomRolesResponse{
+		Details: object.ToListDetails(roles.Count, roles.Sequence, roles.LastRun),
+		Result:  member_grpc.CustomRolesToPb(roles.Roles),
+	}, nil
+}
+
+func (s *Server) AddOrgCustomRole(ctx context.Context, req *mgmt_pb.AddOrgCustomRoleRequest) (*mgmt_pb.AddOrgCustomRoleResponse, error) {
+	details, err := s.command.AddCustomRole(ctx, authz.GetCtxData(ctx).OrgID, member_grpc.CustomRoleToDomain(req.GetKey(), req.GetDisplayName(), req.GetPermissions()))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgCustomRoleResponse{
+		Details: object.AddToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) UpdateOrgCustomRole(ctx context.Context, req *mgmt_pb.UpdateOrgCustomRoleRequest) (*mgmt_pb.UpdateOrgCustomRoleResponse, error) {
+	details, err := s.command.ChangeCustomRole(ctx, authz.GetCtxData(ctx).OrgID, member_grpc.CustomRoleToDomain(req.GetKey(), req.GetDisplayName(), req.GetPermissions()))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOrgCustomRoleResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveOrgCustomRole(ctx context.Context, req *mgmt_pb.RemoveOrgCustomRoleRequest) (*mgmt_pb.RemoveOrgCustomRoleResponse, error) {
+	details, err := s.command.RemoveCustomRole(ctx, authz.GetCtxData(ctx).OrgID, req.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgCustomRoleResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
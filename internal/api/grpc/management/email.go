@@ -0,0 +1,141 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetEmailProvider(ctx context.Context, req *mgmt_pb.GetEmailProviderRequest) (*mgmt_pb.GetEmailProviderResponse, error) {
+	smtp, err := s.query.SMTPConfigActive(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetEmailProviderResponse{
+		Config: emailProviderToProviderPb(smtp),
+	}, nil
+}
+
+func (s *Server) GetEmailProviderById(ctx context.Context, req *mgmt_pb.GetEmailProviderByIdRequest) (*mgmt_pb.GetEmailProviderByIdResponse, error) {
+	smtp, err := s.query.SMTPConfigByID(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if smtp.ResourceOwner != authz.GetCtxData(ctx).OrgID {
+		return nil, zerrors.ThrowNotFound(nil, "MGMT-Qe4fTz", "Errors.SMTPConfig.NotFound")
+	}
+	return &mgmt_pb.GetEmailProviderByIdResponse{
+		Config: emailProviderToProviderPb(smtp),
+	}, nil
+}
+
+func (s *Server) AddEmailProviderSMTP(ctx context.Context, req *mgmt_pb.AddEmailProviderSMTPRequest) (*mgmt_pb.AddEmailProviderSMTPResponse, error) {
+	config := addEmailProviderSMTPToConfig(ctx, req)
+	if err := s.command.AddOrgSMTPConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddEmailProviderSMTPResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+		Id:      config.ID,
+	}, nil
+}
+
+func (s *Server) UpdateEmailProviderSMTP(ctx context.Context, req *mgmt_pb.UpdateEmailProviderSMTPRequest) (*mgmt_pb.UpdateEmailProviderSMTPResponse, error) {
+	config := updateEmailProviderSMTPToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMTPConfig(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateEmailProviderSMTPResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+	}, nil
+}
+
+func (s *Server) AddEmailProviderHTTP(ctx context.Context, req *mgmt_pb.AddEmailProviderHTTPRequest) (*mgmt_pb.AddEmailProviderHTTPResponse, error) {
+	config := addEmailProviderHTTPToConfig(ctx, req)
+	if err := s.command.AddOrgSMTPConfigHTTP(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddEmailProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+		Id:      config.ID,
+	}, nil
+}
+
+func (s *Server) UpdateEmailProviderHTTP(ctx context.Context, req *mgmt_pb.UpdateEmailProviderHTTPRequest) (*mgmt_pb.UpdateEmailProviderHTTPResponse, error) {
+	config := updateEmailProviderHTTPToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMTPConfigHTTP(ctx, config); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateEmailProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+	}, nil
+}
+
+func (s *Server) RemoveEmailProvider(ctx context.Context, req *mgmt_pb.RemoveEmailProviderRequest) (*mgmt_pb.RemoveEmailProviderResponse, error) {
+	details, err := s.command.RemoveOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveEmailProviderResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateEmailProviderSMTPPassword(ctx context.Context, req *mgmt_pb.UpdateEmailProviderSMTPPasswordRequest) (*mgmt_pb.UpdateEmailProviderSMTPPasswordResponse, error) {
+	details, err := s.command.ChangeOrgSMTPConfigPassword(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateEmailProviderSMTPPasswordResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GenerateEmailProviderSMTPWebhookToken(ctx context.Context, req *mgmt_pb.GenerateEmailProviderSMTPWebhookTokenRequest) (*mgmt_pb.GenerateEmailProviderSMTPWebhookTokenResponse, error) {
+	token, details, err := s.command.GenerateOrgSMTPConfigWebhookToken(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GenerateEmailProviderSMTPWebhookTokenResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+		Token:   token,
+	}, nil
+}
+
+func (s *Server) ListEmailProviders(ctx context.Context, req *mgmt_pb.ListEmailProvidersRequest) (*mgmt_pb.ListEmailProvidersResponse, error) {
+	queries, err := listEmailProvidersToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMTPConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListEmailProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  emailProvidersToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) ActivateEmailProvider(ctx context.Context, req *mgmt_pb.ActivateEmailProviderRequest) (*mgmt_pb.ActivateEmailProviderResponse, error) {
+	result, err := s.command.ActivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateEmailProviderResponse{
+		Details: object.DomainToAddDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) DeactivateEmailProvider(ctx context.Context, req *mgmt_pb.DeactivateEmailProviderRequest) (*mgmt_pb.DeactivateEmailProviderResponse, error) {
+	result, err := s.command.DeactivateOrgSMTPConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeactivateEmailProviderResponse{
+		Details: object.DomainToAddDetailsPb(result),
+	}, nil
+}
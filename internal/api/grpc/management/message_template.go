@@ -0,0 +1,58 @@
+package management
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
+	"github.com/zitadel/zitadel/internal/command"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetMessageTemplate(ctx context.Context, req *mgmt_pb.GetMessageTemplateRequest) (*mgmt_pb.GetMessageTemplateResponse, error) {
+	template, err := s.query.MessageTemplateByOrg(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetMessageTemplateResponse{
+		Template: text_grpc.MessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetCustomMessageTemplate(ctx context.Context, req *mgmt_pb.SetCustomMessageTemplateRequest) (*mgmt_pb.SetCustomMessageTemplateResponse, error) {
+	result, err := s.command.SetOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, &command.MessageTemplateUpload{
+		MessageType: req.MessageType,
+		Language:    language.Make(req.Language),
+		Format:      text_grpc.MessageTemplateFormatToDomain(req.Format),
+		Template:    req.Template,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomMessageTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) ResetMessageTemplateToDefault(ctx context.Context, req *mgmt_pb.ResetMessageTemplateToDefaultRequest) (*mgmt_pb.ResetMessageTemplateToDefaultResponse, error) {
+	result, err := s.command.RemoveOrgMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetMessageTemplateToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) PreviewMessageTemplate(ctx context.Context, req *mgmt_pb.PreviewMessageTemplateRequest) (*mgmt_pb.PreviewMessageTemplateResponse, error) {
+	html, err := s.query.PreviewMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language), req.Template, text_grpc.MessageTemplateFormatToDomain(req.Format))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.PreviewMessageTemplateResponse{
+		Html: html,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/api/grpc/settings"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListNotificationDeliveries(ctx context.Context, req *mgmt_pb.ListNotificationDeliveriesRequest) (*mgmt_pb.ListNotificationDeliveriesResponse, error) {
+	queries, err := listNotificationDeliveriesToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchNotificationDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListNotificationDeliveriesResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  settings.NotificationDeliveriesToPb(result.Deliveries),
+	}, nil
+}
+
+func listNotificationDeliveriesToModel(req *mgmt_pb.ListNotificationDeliveriesRequest, orgID string) (*query.NotificationDeliverySearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := settings.NotificationDeliveryFiltersToQuery(req.UserId, req.CreationDateFrom, req.CreationDateTo, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	orgQuery, err := query.NewNotificationDeliveryUserResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, orgQuery),
+	}, nil
+}
@@ -2,6 +2,7 @@ package management
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	change_grpc "github.com/zitadel/zitadel/internal/api/grpc/change"
@@ -141,6 +142,30 @@ func (s *Server) RemoveOrg(ctx context.Context, req *mgmt_pb.RemoveOrgRequest) (
 	return &mgmt_pb.RemoveOrgResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
 }
 
+func (s *Server) SetOrgParent(ctx context.Context, req *mgmt_pb.SetOrgParentRequest) (*mgmt_pb.SetOrgParentResponse, error) {
+	details, err := s.command.SetOrgParent(ctx, authz.GetCtxData(ctx).OrgID, SetOrgParentRequestToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgParentResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
+}
+
+func (s *Server) RemoveOrgParent(ctx context.Context, req *mgmt_pb.RemoveOrgParentRequest) (*mgmt_pb.RemoveOrgParentResponse, error) {
+	details, err := s.command.RemoveOrgParent(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgParentResponse{Details: object.DomainToChangeDetailsPb(details)}, nil
+}
+
+func (s *Server) ListOrgAncestors(ctx context.Context, req *mgmt_pb.ListOrgAncestorsRequest) (*mgmt_pb.ListOrgAncestorsResponse, error) {
+	ancestors, err := s.query.OrgAncestors(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListOrgAncestorsResponse{Result: org_grpc.OrgAncestorsToPb(ancestors)}, nil
+}
+
 func (s *Server) GetDomainPolicy(ctx context.Context, req *mgmt_pb.GetDomainPolicyRequest) (*mgmt_pb.GetDomainPolicyResponse, error) {
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
 	if err != nil {
@@ -258,6 +283,15 @@ func (s *Server) ListOrgMemberRoles(ctx context.Context, _ *mgmt_pb.ListOrgMembe
 		return nil, err
 	}
 	roles := s.query.GetOrgMemberRoles(authz.GetCtxData(ctx).OrgID == instance.DefaultOrgID)
+	customRoles, err := s.query.CustomRoleMappingsByOrg(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range customRoles {
+		if strings.HasPrefix(role.Role, domain.OrgCustomRolePrefix) {
+			roles = append(roles, role.Role)
+		}
+	}
 	return &mgmt_pb.ListOrgMemberRolesResponse{
 		Result: roles,
 	}, nil
@@ -306,6 +340,16 @@ func (s *Server) UpdateOrgMember(ctx context.Context, req *mgmt_pb.UpdateOrgMemb
 	}, nil
 }
 
+func (s *Server) SetOrgMemberValidity(ctx context.Context, req *mgmt_pb.SetOrgMemberValidityRequest) (*mgmt_pb.SetOrgMemberValidityResponse, error) {
+	details, err := s.command.SetOrgMemberValidity(ctx, authz.GetCtxData(ctx).OrgID, req.UserId, AccessValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetOrgMemberValidityResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RemoveOrgMember(ctx context.Context, req *mgmt_pb.RemoveOrgMemberRequest) (*mgmt_pb.RemoveOrgMemberResponse, error) {
 	details, err := s.command.RemoveOrgMember(ctx, authz.GetCtxData(ctx).OrgID, req.UserId)
 	if err != nil {
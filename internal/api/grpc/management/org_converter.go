@@ -67,6 +67,15 @@ func SetPrimaryOrgDomainRequestToDomain(ctx context.Context, req *mgmt_pb.SetPri
 	}
 }
 
+func SetOrgParentRequestToDomain(req *mgmt_pb.SetOrgParentRequest) *domain.OrgParent {
+	return &domain.OrgParent{
+		ParentOrgID:          req.ParentOrgId,
+		InheritIDPs:          req.InheritIdps,
+		InheritProjectGrants: req.InheritProjectGrants,
+		InheritMembers:       req.InheritMembers,
+	}
+}
+
 func UpdateOrgMemberRequestToDomain(ctx context.Context, req *mgmt_pb.UpdateOrgMemberRequest) *domain.Member {
 	return domain.NewMember(authz.GetCtxData(ctx).OrgID, req.UserId, req.Roles...)
 }
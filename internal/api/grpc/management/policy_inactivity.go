@@ -0,0 +1,68 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetInactivityPolicy(ctx context.Context, req *mgmt_pb.GetInactivityPolicyRequest) (*mgmt_pb.GetInactivityPolicyResponse, error) {
+	policy, err := s.query.InactivityPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetInactivityPolicyResponse{
+		Policy: policy_grpc.ModelInactivityPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) GetDefaultInactivityPolicy(ctx context.Context, req *mgmt_pb.GetDefaultInactivityPolicyRequest) (*mgmt_pb.GetDefaultInactivityPolicyResponse, error) {
+	policy, err := s.query.DefaultInactivityPolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetDefaultInactivityPolicyResponse{
+		Policy: policy_grpc.ModelInactivityPolicyToPb(policy),
+	}, nil
+}
+
+func (s *Server) AddCustomInactivityPolicy(ctx context.Context, req *mgmt_pb.AddCustomInactivityPolicyRequest) (*mgmt_pb.AddCustomInactivityPolicyResponse, error) {
+	result, err := s.command.AddInactivityPolicy(ctx, authz.GetCtxData(ctx).OrgID, AddInactivityPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddCustomInactivityPolicyResponse{
+		Details: object.AddToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) UpdateCustomInactivityPolicy(ctx context.Context, req *mgmt_pb.UpdateCustomInactivityPolicyRequest) (*mgmt_pb.UpdateCustomInactivityPolicyResponse, error) {
+	result, err := s.command.ChangeInactivityPolicy(ctx, authz.GetCtxData(ctx).OrgID, UpdateInactivityPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateCustomInactivityPolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.ChangeDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetInactivityPolicyToDefault(ctx context.Context, req *mgmt_pb.ResetInactivityPolicyToDefaultRequest) (*mgmt_pb.ResetInactivityPolicyToDefaultResponse, error) {
+	objectDetails, err := s.command.RemoveInactivityPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetInactivityPolicyToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
@@ -0,0 +1,26 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddInactivityPolicyToDomain(policy *mgmt_pb.AddCustomInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnBeforeDays:             uint64(policy.WarnBeforeDays),
+		HumanDeactivateAfterDays:   uint64(policy.HumanDeactivateAfterDays),
+		HumanDeleteAfterDays:       uint64(policy.HumanDeleteAfterDays),
+		MachineDeactivateAfterDays: uint64(policy.MachineDeactivateAfterDays),
+		MachineDeleteAfterDays:     uint64(policy.MachineDeleteAfterDays),
+	}
+}
+
+func UpdateInactivityPolicyToDomain(policy *mgmt_pb.UpdateCustomInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnBeforeDays:             uint64(policy.WarnBeforeDays),
+		HumanDeactivateAfterDays:   uint64(policy.HumanDeactivateAfterDays),
+		HumanDeleteAfterDays:       uint64(policy.HumanDeleteAfterDays),
+		MachineDeactivateAfterDays: uint64(policy.MachineDeactivateAfterDays),
+		MachineDeleteAfterDays:     uint64(policy.MachineDeleteAfterDays),
+	}
+}
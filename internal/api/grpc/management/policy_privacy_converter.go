@@ -14,6 +14,8 @@ func AddPrivacyPolicyToDomain(req *mgmt_pb.AddCustomPrivacyPolicyRequest) *domai
 		DocsLink:       req.DocsLink,
 		CustomLink:     req.CustomLink,
 		CustomLinkText: req.CustomLinkText,
+
+		DeletionGracePeriod: req.DeletionGracePeriod.AsDuration(),
 	}
 }
 
@@ -26,5 +28,7 @@ func UpdatePrivacyPolicyToDomain(req *mgmt_pb.UpdateCustomPrivacyPolicyRequest)
 		DocsLink:       req.DocsLink,
 		CustomLink:     req.CustomLink,
 		CustomLinkText: req.CustomLinkText,
+
+		DeletionGracePeriod: req.DeletionGracePeriod.AsDuration(),
 	}
 }
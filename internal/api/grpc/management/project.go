@@ -379,6 +379,16 @@ func (s *Server) UpdateProjectMember(ctx context.Context, req *mgmt_pb.UpdatePro
 	}, nil
 }
 
+func (s *Server) SetProjectMemberValidity(ctx context.Context, req *mgmt_pb.SetProjectMemberValidityRequest) (*mgmt_pb.SetProjectMemberValidityResponse, error) {
+	details, err := s.command.SetProjectMemberValidity(ctx, req.ProjectId, req.UserId, authz.GetCtxData(ctx).OrgID, AccessValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectMemberValidityResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RemoveProjectMember(ctx context.Context, req *mgmt_pb.RemoveProjectMemberRequest) (*mgmt_pb.RemoveProjectMemberResponse, error) {
 	details, err := s.command.RemoveProjectMember(ctx, req.ProjectId, req.UserId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
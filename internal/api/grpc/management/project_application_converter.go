@@ -58,6 +58,8 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) *domain.OIDCApp {
 		AdditionalOrigins:        req.AdditionalOrigins,
 		SkipNativeAppSuccessPage: req.SkipNativeAppSuccessPage,
 		BackChannelLogoutURI:     req.GetBackChannelLogoutUri(),
+		RequireConsent:           req.GetRequireConsent(),
+		RequiredACR:              req.GetRequiredAcr(),
 	}
 }
 
@@ -69,6 +71,7 @@ func AddSAMLAppRequestToDomain(req *mgmt_pb.AddSAMLAppRequest) *domain.SAMLApp {
 		AppName:     req.Name,
 		Metadata:    req.GetMetadataXml(),
 		MetadataURL: req.GetMetadataUrl(),
+		Options:     app_grpc.SAMLOptionsToDomain(req.GetOptions()),
 	}
 }
 
@@ -110,6 +113,8 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		AdditionalOrigins:        app.AdditionalOrigins,
 		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
 		BackChannelLogoutURI:     app.BackChannelLogoutUri,
+		RequireConsent:           app.RequireConsent,
+		RequiredACR:              app.RequiredAcr,
 	}
 }
 
@@ -121,6 +126,7 @@ func UpdateSAMLAppConfigRequestToDomain(app *mgmt_pb.UpdateSAMLAppConfigRequest)
 		AppID:       app.AppId,
 		Metadata:    app.GetMetadataXml(),
 		MetadataURL: app.GetMetadataUrl(),
+		Options:     app_grpc.SAMLOptionsToDomain(app.GetOptions()),
 	}
 }
 
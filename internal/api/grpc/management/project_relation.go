@@ -0,0 +1,113 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	project_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetProjectRelationSchema(ctx context.Context, req *mgmt_pb.GetProjectRelationSchemaRequest) (*mgmt_pb.GetProjectRelationSchemaResponse, error) {
+	schema, err := s.query.RelationSchemaByProjectID(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetProjectRelationSchemaResponse{
+		Details: object_grpc.ToViewDetailsPb(schema.Sequence, schema.CreationDate, schema.ChangeDate, schema.ResourceOwner),
+		Schema:  project_grpc.RelationSchemaToPb(schema.Schema),
+	}, nil
+}
+
+func (s *Server) SetProjectRelationSchema(ctx context.Context, req *mgmt_pb.SetProjectRelationSchemaRequest) (*mgmt_pb.SetProjectRelationSchemaResponse, error) {
+	details, err := s.command.SetRelationSchema(ctx, req.ProjectId, authz.GetCtxData(ctx).OrgID, project_grpc.RelationSchemaToDomain(req.Schema))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetProjectRelationSchemaResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) WriteProjectRelationTuples(ctx context.Context, req *mgmt_pb.WriteProjectRelationTuplesRequest) (*mgmt_pb.WriteProjectRelationTuplesResponse, error) {
+	details, err := s.command.WriteRelationTuples(ctx, req.ProjectId, authz.GetCtxData(ctx).OrgID, project_grpc.RelationTuplesToDomain(req.Tuples))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.WriteProjectRelationTuplesResponse{
+		Details:          object_grpc.DomainToChangeDetailsPb(&details.ObjectDetails),
+		ConsistencyToken: details.ConsistencyToken,
+	}, nil
+}
+
+func (s *Server) DeleteProjectRelationTuples(ctx context.Context, req *mgmt_pb.DeleteProjectRelationTuplesRequest) (*mgmt_pb.DeleteProjectRelationTuplesResponse, error) {
+	details, err := s.command.DeleteRelationTuples(ctx, req.ProjectId, authz.GetCtxData(ctx).OrgID, project_grpc.RelationTuplesToDomain(req.Tuples))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.DeleteProjectRelationTuplesResponse{
+		Details:          object_grpc.DomainToChangeDetailsPb(&details.ObjectDetails),
+		ConsistencyToken: details.ConsistencyToken,
+	}, nil
+}
+
+func (s *Server) ListProjectRelationTuples(ctx context.Context, req *mgmt_pb.ListProjectRelationTuplesRequest) (*mgmt_pb.ListProjectRelationTuplesResponse, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	queries, err := project_grpc.RelationTupleQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	tuples, err := s.query.SearchRelationTuples(ctx, req.ProjectId, &query.RelationTupleSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectRelationTuplesResponse{
+		Details: object_grpc.ToListDetails(tuples.Count, tuples.Sequence, tuples.LastRun),
+		Result:  project_grpc.RelationTuplesToPb(tuples.Tuples),
+	}, nil
+}
+
+func (s *Server) CheckProjectRelation(ctx context.Context, req *mgmt_pb.CheckProjectRelationRequest) (*mgmt_pb.CheckProjectRelationResponse, error) {
+	allowed, err := s.query.CheckRelation(ctx, req.ProjectId, req.ObjectType, req.ObjectId, req.Permission, req.UserId, project_grpc.RelationConsistencyToDomain(req.Consistency))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CheckProjectRelationResponse{
+		Allowed: allowed,
+	}, nil
+}
+
+func (s *Server) ListProjectRelationObjects(ctx context.Context, req *mgmt_pb.ListProjectRelationObjectsRequest) (*mgmt_pb.ListProjectRelationObjectsResponse, error) {
+	offset, limit, asc := object_grpc.ListQueryToModel(req.Query)
+	objects, err := s.query.ListRelationObjects(ctx, req.ProjectId, req.ObjectType, req.Permission, req.UserId, &query.SearchRequest{
+		Offset: offset,
+		Limit:  limit,
+		Asc:    asc,
+	}, project_grpc.RelationConsistencyToDomain(req.Consistency))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectRelationObjectsResponse{
+		Details:   object_grpc.ToListDetails(objects.Count, objects.Sequence, objects.LastRun),
+		ObjectIds: objects.ObjectIDs,
+	}, nil
+}
+
+func (s *Server) ExpandProjectRelation(ctx context.Context, req *mgmt_pb.ExpandProjectRelationRequest) (*mgmt_pb.ExpandProjectRelationResponse, error) {
+	tree, err := s.query.ExpandRelation(ctx, req.ProjectId, req.ObjectType, req.ObjectId, req.Permission, project_grpc.RelationConsistencyToDomain(req.Consistency))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ExpandProjectRelationResponse{
+		Tree: project_grpc.RelationTreeToPb(tree),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListSMSProviders(ctx context.Context, req *mgmt_pb.ListSMSProvidersRequest) (*mgmt_pb.ListSMSProvidersResponse, error) {
+	queries, err := listSMSConfigsToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchSMSConfigs(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListSMSProvidersResponse{
+		Details: object.ToListDetails(result.Count, result.Sequence, result.LastRun),
+		Result:  smsConfigsToPb(result.Configs),
+	}, nil
+}
+
+func (s *Server) GetSMSProvider(ctx context.Context, req *mgmt_pb.GetSMSProviderRequest) (*mgmt_pb.GetSMSProviderResponse, error) {
+	result, err := s.query.SMSProviderConfigByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if result.ResourceOwner != authz.GetCtxData(ctx).OrgID {
+		return nil, zerrors.ThrowNotFound(nil, "MGMT-Vb7kLs", "Errors.SMSConfig.NotFound")
+	}
+	return &mgmt_pb.GetSMSProviderResponse{
+		Config: smsConfigToProviderPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderTwilio(ctx context.Context, req *mgmt_pb.AddSMSProviderTwilioRequest) (*mgmt_pb.AddSMSProviderTwilioResponse, error) {
+	smsConfig := addSMSConfigTwilioToConfig(ctx, req)
+	if err := s.command.AddOrgSMSConfigTwilio(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSMSProviderTwilioResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderTwilio(ctx context.Context, req *mgmt_pb.UpdateSMSProviderTwilioRequest) (*mgmt_pb.UpdateSMSProviderTwilioResponse, error) {
+	smsConfig := updateSMSConfigTwilioToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMSConfigTwilio(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSMSProviderTwilioResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderTwilioToken(ctx context.Context, req *mgmt_pb.UpdateSMSProviderTwilioTokenRequest) (*mgmt_pb.UpdateSMSProviderTwilioTokenResponse, error) {
+	result, err := s.command.ChangeOrgSMSConfigTwilioToken(ctx, authz.GetCtxData(ctx).OrgID, req.Id, req.Token)
+	if err != nil {
+		return nil, err
+
+	}
+	return &mgmt_pb.UpdateSMSProviderTwilioTokenResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderHTTP(ctx context.Context, req *mgmt_pb.AddSMSProviderHTTPRequest) (*mgmt_pb.AddSMSProviderHTTPResponse, error) {
+	smsConfig := addSMSConfigHTTPToConfig(ctx, req)
+	if err := s.command.AddOrgSMSConfigHTTP(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddSMSProviderHTTPResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *mgmt_pb.UpdateSMSProviderHTTPRequest) (*mgmt_pb.UpdateSMSProviderHTTPResponse, error) {
+	smsConfig := updateSMSConfigHTTPToConfig(ctx, req)
+	if err := s.command.ChangeOrgSMSConfigHTTP(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateSMSProviderHTTPResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) ActivateSMSProvider(ctx context.Context, req *mgmt_pb.ActivateSMSProviderRequest) (*mgmt_pb.ActivateSMSProviderResponse, error) {
+	result, err := s.command.ActivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ActivateSMSProviderResponse{
+		Details: object.DomainToAddDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) DeactivateSMSProvider(ctx context.Context, req *mgmt_pb.DeactivateSMSProviderRequest) (*mgmt_pb.DeactivateSMSProviderResponse, error) {
+	result, err := s.command.DeactivateOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+
+	}
+	return &mgmt_pb.DeactivateSMSProviderResponse{
+		Details: object.DomainToAddDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) RemoveSMSProvider(ctx context.Context, req *mgmt_pb.RemoveSMSProviderRequest) (*mgmt_pb.RemoveSMSProviderResponse, error) {
+	result, err := s.command.RemoveOrgSMSConfig(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveSMSProviderResponse{
+		Details: object.DomainToAddDetailsPb(result),
+	}, nil
+}
@@ -78,6 +78,25 @@ func (s *Server) ListUsers(ctx context.Context, req *mgmt_pb.ListUsersRequest) (
 	}, nil
 }
 
+func (s *Server) ListUsersWithExpiringPasswords(ctx context.Context, req *mgmt_pb.ListUsersWithExpiringPasswordsRequest) (*mgmt_pb.ListUsersWithExpiringPasswordsResponse, error) {
+	offset, limit, asc := obj_grpc.ListQueryToModel(req.Query)
+	res, err := s.query.SearchExpiringPasswords(ctx, &query.ExpiringPasswordSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		ResourceOwner: authz.GetCtxData(ctx).OrgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUsersWithExpiringPasswordsResponse{
+		Result:  user_grpc.ExpiringPasswordsToPb(res.ExpiringPasswords),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
 func (s *Server) ListUserChanges(ctx context.Context, req *mgmt_pb.ListUserChangesRequest) (*mgmt_pb.ListUserChangesResponse, error) {
 	var (
 		limit    uint64
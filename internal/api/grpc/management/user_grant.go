@@ -76,6 +76,16 @@ func (s *Server) UpdateUserGrant(ctx context.Context, req *mgmt_pb.UpdateUserGra
 	}, nil
 }
 
+func (s *Server) SetUserGrantValidity(ctx context.Context, req *mgmt_pb.SetUserGrantValidityRequest) (*mgmt_pb.SetUserGrantValidityResponse, error) {
+	objectDetails, err := s.command.SetUserGrantValidity(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID, AccessValidityToDomain(req.ValidFrom, req.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetUserGrantValidityResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) DeactivateUserGrant(ctx context.Context, req *mgmt_pb.DeactivateUserGrantRequest) (*mgmt_pb.DeactivateUserGrantResponse, error) {
 	objectDetails, err := s.command.DeactivateUserGrant(ctx, req.GrantId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
@@ -3,6 +3,8 @@ package management
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
@@ -55,7 +57,20 @@ func AddUserGrantRequestToDomain(req *mgmt_pb.AddUserGrantRequest) *domain.UserG
 		ProjectID:      req.ProjectId,
 		ProjectGrantID: req.ProjectGrantId,
 		RoleKeys:       req.RoleKeys,
+		AccessValidity: AccessValidityToDomain(req.ValidFrom, req.ValidUntil),
+	}
+}
+
+// AccessValidityToDomain maps the optional timestamps of the request to the validity, missing timestamps don't restrict the access.
+func AccessValidityToDomain(validFrom, validUntil *timestamppb.Timestamp) domain.AccessValidity {
+	var validity domain.AccessValidity
+	if validFrom != nil {
+		validity.ValidFrom = validFrom.AsTime()
+	}
+	if validUntil != nil {
+		validity.ValidUntil = validUntil.AsTime()
 	}
+	return validity
 }
 
 func UpdateUserGrantRequestToDomain(req *mgmt_pb.UpdateUserGrantRequest) *domain.UserGrant {
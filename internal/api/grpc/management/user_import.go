@@ -0,0 +1,101 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) AddUserImportJob(ctx context.Context, req *mgmt_pb.AddUserImportJobRequest) (*mgmt_pb.AddUserImportJobResponse, error) {
+	details, err := s.command.AddUserImportJob(ctx, authz.GetCtxData(ctx).OrgID, user_grpc.UserImportFormatToDomain(req.Format), req.DryRun, req.FileId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddUserImportJobResponse{
+		Id:      req.FileId,
+		Details: object.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListUserImportJobs(ctx context.Context, req *mgmt_pb.ListUserImportJobsRequest) (*mgmt_pb.ListUserImportJobsResponse, error) {
+	q, err := ListUserImportJobsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserImportJobs(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserImportJobsResponse{
+		Result:  user_grpc.UserImportJobsToPb(res.Jobs),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) GetUserImportJobByID(ctx context.Context, req *mgmt_pb.GetUserImportJobByIDRequest) (*mgmt_pb.GetUserImportJobByIDResponse, error) {
+	job, err := s.query.UserImportJobByID(ctx, req.JobId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetUserImportJobByIDResponse{
+		Job: user_grpc.UserImportJobToPb(job),
+	}, nil
+}
+
+func (s *Server) ListUserImportJobErrors(ctx context.Context, req *mgmt_pb.ListUserImportJobErrorsRequest) (*mgmt_pb.ListUserImportJobErrorsResponse, error) {
+	// the job is queried first to ensure it belongs to the organization
+	job, err := s.query.UserImportJobByID(ctx, req.JobId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	res, err := s.query.SearchUserImportJobErrors(ctx, job.ID, &query.UserImportJobErrorSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.UserImportJobErrorColumnRow,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserImportJobErrorsResponse{
+		Result:  user_grpc.UserImportRowErrorsToPb(res.Errors),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) CancelUserImportJob(ctx context.Context, req *mgmt_pb.CancelUserImportJobRequest) (*mgmt_pb.CancelUserImportJobResponse, error) {
+	details, err := s.command.CancelUserImportJob(ctx, authz.GetCtxData(ctx).OrgID, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.CancelUserImportJobResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListUserImportJobsRequestToQuery(ctx context.Context, req *mgmt_pb.ListUserImportJobsRequest) (*query.UserImportJobSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := user_grpc.UserImportJobQueriesToModel(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewUserImportJobResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.UserImportJobSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
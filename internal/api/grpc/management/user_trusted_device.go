@@ -0,0 +1,56 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	"github.com/zitadel/zitadel/internal/query"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) ListHumanTrustedDevices(ctx context.Context, req *mgmt_pb.ListHumanTrustedDevicesRequest) (*mgmt_pb.ListHumanTrustedDevicesResponse, error) {
+	q, err := ListHumanTrustedDevicesRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchTrustedDevices(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListHumanTrustedDevicesResponse{
+		Result:  user_grpc.TrustedDevicesToPb(res.Devices),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) RemoveHumanTrustedDevice(ctx context.Context, req *mgmt_pb.RemoveHumanTrustedDeviceRequest) (*mgmt_pb.RemoveHumanTrustedDeviceResponse, error) {
+	details, err := s.command.RemoveTrustedDevice(ctx, req.GetUserId(), authz.GetCtxData(ctx).OrgID, req.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveHumanTrustedDeviceResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func ListHumanTrustedDevicesRequestToQuery(ctx context.Context, req *mgmt_pb.ListHumanTrustedDevicesRequest) (*query.TrustedDeviceSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.GetQuery())
+	userIDQuery, err := query.NewTrustedDeviceUserIDSearchQuery(req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewTrustedDeviceResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.TrustedDeviceSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{userIDQuery, resourceOwnerQuery},
+	}, nil
+}
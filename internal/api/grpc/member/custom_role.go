@@ -0,0 +1,39 @@
+package member
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	member_pb "github.com/zitadel/zitadel/pkg/grpc/member"
+)
+
+func CustomRolesToPb(roles []*query.CustomRole) []*member_pb.CustomRole {
+	result := make([]*member_pb.CustomRole, len(roles))
+	for i, role := range roles {
+		result[i] = CustomRoleToPb(role)
+	}
+	return result
+}
+
+func CustomRoleToPb(role *query.CustomRole) *member_pb.CustomRole {
+	return &member_pb.CustomRole{
+		Key:         role.Key,
+		DisplayName: role.DisplayName,
+		Permissions: role.Permissions,
+		IsDefault:   role.IsDefault,
+		Details: object.ToViewDetailsPb(
+			role.Sequence,
+			role.CreationDate,
+			role.ChangeDate,
+			role.ResourceOwner,
+		),
+	}
+}
+
+func CustomRoleToDomain(key, displayName string, permissions []string) *domain.CustomRole {
+	return &domain.CustomRole{
+		Key:         key,
+		DisplayName: displayName,
+		Permissions: permissions,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/oidc"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -23,8 +24,16 @@ func (s *Server) GetAuthRequest(ctx context.Context, req *oidc_pb.GetAuthRequest
 		logging.WithError(err).Error("query authRequest by ID")
 		return nil, err
 	}
+	pba := authRequestToPb(authRequest)
+	if !pba.RequireConsent {
+		app, err := s.query.AppByOIDCClientID(ctx, authRequest.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		pba.RequireConsent = app.OIDCConfig.RequireConsent
+	}
 	return &oidc_pb.GetAuthRequestResponse{
-		AuthRequest: authRequestToPb(authRequest),
+		AuthRequest: pba,
 	}, nil
 }
 
@@ -39,6 +48,8 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 		UiLocales:    a.UiLocales,
 		LoginHint:    a.LoginHint,
 		HintUserId:   a.HintUserID,
+		// the application setting is resolved by the caller
+		RequireConsent: domain.IsPrompt(a.Prompt, domain.PromptConsent),
 	}
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
@@ -101,7 +112,11 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*oidc_pb.CreateCallbackResponse, error) {
-	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true)
+	consent, acr, err := s.authRequestRequirements(ctx, authRequestID, session.GetConsentGranted())
+	if err != nil {
+		return nil, err
+	}
+	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, consent, acr)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +137,28 @@ func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID str
 	}, nil
 }
 
+// authRequestRequirements returns the consent and acr requirements of the application of the auth request.
+func (s *Server) authRequestRequirements(ctx context.Context, authRequestID string, granted bool) (*command.AuthRequestConsent, *command.AuthRequestACR, error) {
+	authRequest, err := s.query.AuthRequestByID(ctx, true, authRequestID, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	app, err := s.query.AppByOIDCClientID(ctx, authRequest.ClientID)
+	if err != nil {
+		return nil, nil, err
+	}
+	consent := &command.AuthRequestConsent{
+		Required:  app.OIDCConfig.RequireConsent,
+		Granted:   granted,
+		ProjectID: app.ProjectID,
+	}
+	acr := &command.AuthRequestACR{
+		Required:    app.OIDCConfig.RequiredACR,
+		Definitions: s.query.ACRDefinitionsByOrg,
+	}
+	return consent, acr, nil
+}
+
 func errorReasonToDomain(errorReason oidc_pb.ErrorReason) domain.OIDCErrorReason {
 	switch errorReason {
 	case oidc_pb.ErrorReason_ERROR_REASON_UNSPECIFIED:
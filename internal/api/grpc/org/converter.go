@@ -75,6 +75,20 @@ func OrgToPb(org *query.Org) *org_pb.Org {
 	}
 }
 
+func OrgAncestorsToPb(ancestors query.OrgAncestors) []*org_pb.OrgHierarchyLink {
+	links := make([]*org_pb.OrgHierarchyLink, len(ancestors))
+	for i, link := range ancestors {
+		links[i] = &org_pb.OrgHierarchyLink{
+			OrgId:                link.OrgID,
+			ParentOrgId:          link.ParentID,
+			InheritIdps:          link.InheritIDPs,
+			InheritProjectGrants: link.InheritProjectGrants,
+			InheritMembers:       link.InheritMembers,
+		}
+	}
+	return links
+}
+
 func OrgStateToPb(state domain.OrgState) org_pb.OrgState {
 	switch state {
 	case domain.OrgStateActive:
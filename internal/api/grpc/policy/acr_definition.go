@@ -0,0 +1,43 @@
+package policy
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ACRDefinitionsToPb(definitions []*query.ACRDefinition) []*policy_pb.ACRDefinition {
+	result := make([]*policy_pb.ACRDefinition, len(definitions))
+	for i, definition := range definitions {
+		result[i] = ACRDefinitionToPb(definition)
+	}
+	return result
+}
+
+func ACRDefinitionToPb(definition *query.ACRDefinition) *policy_pb.ACRDefinition {
+	return &policy_pb.ACRDefinition{
+		IsDefault:         definition.IsDefault,
+		Acr:               definition.ACR,
+		PhishingResistant: definition.PhishingResistant,
+		MultiFactor:       definition.MultiFactor,
+		MaxAge:            durationpb.New(definition.MaxAge),
+		Details: object.ToViewDetailsPb(
+			definition.Sequence,
+			definition.CreationDate,
+			definition.ChangeDate,
+			definition.ResourceOwner,
+		),
+	}
+}
+
+func ACRDefinitionToDomain(acr string, phishingResistant, multiFactor bool, maxAge *durationpb.Duration) *domain.ACRDefinition {
+	return &domain.ACRDefinition{
+		ACR:               acr,
+		PhishingResistant: phishingResistant,
+		MultiFactor:       multiFactor,
+		MaxAge:            maxAge.AsDuration(),
+	}
+}
@@ -0,0 +1,36 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelInactivityPolicyToPb(policy *query.InactivityPolicy) *policy_pb.InactivityPolicy {
+	return &policy_pb.InactivityPolicy{
+		IsDefault:                  policy.IsDefault,
+		WarnBeforeDays:             policy.WarnBeforeDays,
+		HumanDeactivateAfterDays:   policy.HumanDeactivateAfterDays,
+		HumanDeleteAfterDays:       policy.HumanDeleteAfterDays,
+		MachineDeactivateAfterDays: policy.MachineDeactivateAfterDays,
+		MachineDeleteAfterDays:     policy.MachineDeleteAfterDays,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func InactivityActionToPb(action domain.InactivityAction) policy_pb.InactivityAction {
+	switch action {
+	case domain.InactivityActionDeactivate:
+		return policy_pb.InactivityAction_INACTIVITY_ACTION_DEACTIVATE
+	case domain.InactivityActionDelete:
+		return policy_pb.InactivityAction_INACTIVITY_ACTION_DELETE
+	default:
+		return policy_pb.InactivityAction_INACTIVITY_ACTION_UNSPECIFIED
+	}
+}
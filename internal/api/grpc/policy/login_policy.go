@@ -33,6 +33,8 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(policy.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(policy.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(policy.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(policy.TrustedDeviceLifetime)),
+		RiskThresholds:             RiskThresholdsToPb(policy.RiskThresholds),
 		SecondFactors:              ModelSecondFactorTypesToPb(policy.SecondFactors),
 		MultiFactors:               ModelMultiFactorTypesToPb(policy.MultiFactors),
 		Idps:                       idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
@@ -45,6 +47,22 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 	}
 }
 
+func RiskThresholdsToPb(thresholds domain.RiskThresholds) *policy_pb.RiskThresholds {
+	return &policy_pb.RiskThresholds{
+		Notify:     uint32(thresholds.Notify),
+		RequireMfa: uint32(thresholds.RequireMFA),
+		Block:      uint32(thresholds.Block),
+	}
+}
+
+func RiskThresholdsToDomain(thresholds *policy_pb.RiskThresholds) domain.RiskThresholds {
+	return domain.RiskThresholds{
+		Notify:     int(thresholds.GetNotify()),
+		RequireMFA: int(thresholds.GetRequireMfa()),
+		Block:      int(thresholds.GetBlock()),
+	}
+}
+
 func PasswordlessTypeToDomain(passwordlessType policy_pb.PasswordlessType) domain.PasswordlessType {
 	switch passwordlessType {
 	case policy_pb.PasswordlessType_PASSWORDLESS_TYPE_ALLOWED:
@@ -2,14 +2,16 @@ package policy
 
 import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
 )
 
 func ModelNotificationPolicyToPb(policy *query.NotificationPolicy) *policy_pb.NotificationPolicy {
 	return &policy_pb.NotificationPolicy{
-		IsDefault:      policy.IsDefault,
-		PasswordChange: policy.PasswordChange,
+		IsDefault:             policy.IsDefault,
+		PasswordChange:        policy.PasswordChange,
+		SecurityNotifications: SecurityNotificationsToPb(policy.SecurityNotifications),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
@@ -18,3 +20,30 @@ func ModelNotificationPolicyToPb(policy *query.NotificationPolicy) *policy_pb.No
 		),
 	}
 }
+
+func SecurityNotificationsToPb(notifications domain.SecurityNotifications) *policy_pb.SecurityNotifications {
+	return &policy_pb.SecurityNotifications{
+		NewDeviceSignIn: notifications.NewDeviceSignIn,
+		MfaAdded:        notifications.MFAAdded,
+		MfaRemoved:      notifications.MFARemoved,
+		PasskeyAdded:    notifications.PasskeyAdded,
+		EmailChanged:    notifications.EmailChanged,
+		AccountLocked:   notifications.AccountLocked,
+		PatCreated:      notifications.PATCreated,
+	}
+}
+
+func SecurityNotificationsToDomain(notifications *policy_pb.SecurityNotifications) *domain.SecurityNotifications {
+	if notifications == nil {
+		return nil
+	}
+	return &domain.SecurityNotifications{
+		NewDeviceSignIn: notifications.GetNewDeviceSignIn(),
+		MFAAdded:        notifications.GetMfaAdded(),
+		MFARemoved:      notifications.GetMfaRemoved(),
+		PasskeyAdded:    notifications.GetPasskeyAdded(),
+		EmailChanged:    notifications.GetEmailChanged(),
+		AccountLocked:   notifications.GetAccountLocked(),
+		PATCreated:      notifications.GetPatCreated(),
+	}
+}
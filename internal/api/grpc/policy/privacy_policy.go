@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/query"
 	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
@@ -22,5 +24,7 @@ func ModelPrivacyPolicyToPb(policy *query.PrivacyPolicy) *policy_pb.PrivacyPolic
 		DocsLink:       policy.DocsLink,
 		CustomLink:     policy.CustomLink,
 		CustomLinkText: policy.CustomLinkText,
+
+		DeletionGracePeriod: durationpb.New(policy.DeletionGracePeriod),
 	}
 }
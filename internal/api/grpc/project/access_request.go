@@ -0,0 +1,96 @@
+package project
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	proj_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func AccessRequestsToPb(requests []*query.AccessRequest) []*proj_pb.AccessRequest {
+	r := make([]*proj_pb.AccessRequest, len(requests))
+	for i, request := range requests {
+		r[i] = AccessRequestToPb(request)
+	}
+	return r
+}
+
+func AccessRequestToPb(request *query.AccessRequest) *proj_pb.AccessRequest {
+	pb := &proj_pb.AccessRequest{
+		Id:            request.ID,
+		Details:       object.ToViewDetailsPb(request.Sequence, request.CreationDate, request.ChangeDate, request.ResourceOwner),
+		State:         accessRequestStateToPb(request.State),
+		UserId:        request.UserID,
+		ProjectId:     request.ProjectID,
+		RoleKeys:      request.RoleKeys,
+		Reason:        request.Reason,
+		DecidedBy:     request.DecidedBy,
+		Justification: request.Justification,
+		UserGrantId:   request.UserGrantID,
+	}
+	if !request.ValidUntil.IsZero() {
+		pb.ValidUntil = timestamppb.New(request.ValidUntil)
+	}
+	return pb
+}
+
+func accessRequestStateToPb(state domain.AccessRequestState) proj_pb.AccessRequestState {
+	switch state {
+	case domain.AccessRequestStatePending:
+		return proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING
+	case domain.AccessRequestStateApproved:
+		return proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED
+	case domain.AccessRequestStateDenied:
+		return proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED
+	case domain.AccessRequestStateCancelled:
+		return proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_CANCELLED
+	case domain.AccessRequestStateUnspecified:
+		fallthrough
+	default:
+		return proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED
+	}
+}
+
+func accessRequestStateToDomain(state proj_pb.AccessRequestState) domain.AccessRequestState {
+	switch state {
+	case proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_PENDING:
+		return domain.AccessRequestStatePending
+	case proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_APPROVED:
+		return domain.AccessRequestStateApproved
+	case proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_DENIED:
+		return domain.AccessRequestStateDenied
+	case proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_CANCELLED:
+		return domain.AccessRequestStateCancelled
+	case proj_pb.AccessRequestState_ACCESS_REQUEST_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AccessRequestStateUnspecified
+	}
+}
+
+func AccessRequestQueriesToModel(queries []*proj_pb.AccessRequestQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AccessRequestQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AccessRequestQueryToModel(apiQuery *proj_pb.AccessRequestQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.AccessRequestQuery_UserIdQuery:
+		return query.NewAccessRequestUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *proj_pb.AccessRequestQuery_ProjectIdQuery:
+		return query.NewAccessRequestProjectIDSearchQuery(q.ProjectIdQuery.ProjectId)
+	case *proj_pb.AccessRequestQuery_StateQuery:
+		return query.NewAccessRequestStateSearchQuery(accessRequestStateToDomain(q.StateQuery.State))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ar5mQa", "List.Query.Invalid")
+	}
+}
@@ -0,0 +1,177 @@
+package project
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	proj_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func AccessReviewsToPb(reviews []*query.AccessReview) []*proj_pb.AccessReview {
+	r := make([]*proj_pb.AccessReview, len(reviews))
+	for i, review := range reviews {
+		r[i] = AccessReviewToPb(review)
+	}
+	return r
+}
+
+func AccessReviewToPb(review *query.AccessReview) *proj_pb.AccessReview {
+	return &proj_pb.AccessReview{
+		Id:          review.ID,
+		Details:     object.ToViewDetailsPb(review.Sequence, review.CreationDate, review.ChangeDate, review.ResourceOwner),
+		State:       accessReviewStateToPb(review.State),
+		Name:        review.Name,
+		ProjectId:   review.ProjectID,
+		RoleKeys:    review.RoleKeys,
+		ReviewerIds: review.Reviewers,
+		Deadline:    timestamppb.New(review.Deadline),
+		AutoRevoke:  review.AutoRevoke,
+		Creator:     review.Creator,
+	}
+}
+
+func AccessReviewItemsToPb(items []*query.AccessReviewItem) []*proj_pb.AccessReviewItem {
+	r := make([]*proj_pb.AccessReviewItem, len(items))
+	for i, item := range items {
+		r[i] = AccessReviewItemToPb(item)
+	}
+	return r
+}
+
+func AccessReviewItemToPb(item *query.AccessReviewItem) *proj_pb.AccessReviewItem {
+	pb := &proj_pb.AccessReviewItem{
+		Id:                  item.ID,
+		Type:                accessReviewItemTypeToPb(item.Type),
+		ObjectId:            item.ObjectID,
+		ObjectResourceOwner: item.ObjectResourceOwner,
+		UserId:              item.UserID,
+		ProjectId:           item.ProjectID,
+		Roles:               item.Roles,
+		Decision:            AccessReviewDecisionToPb(item.Decision),
+		DecidedBy:           item.DecidedBy,
+		Comment:             item.Comment,
+		Automatic:           item.Automatic,
+	}
+	if !item.DecisionDate.IsZero() {
+		pb.DecisionDate = timestamppb.New(item.DecisionDate)
+	}
+	return pb
+}
+
+func accessReviewStateToPb(state domain.AccessReviewState) proj_pb.AccessReviewState {
+	switch state {
+	case domain.AccessReviewStateActive:
+		return proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE
+	case domain.AccessReviewStateCompleted:
+		return proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_COMPLETED
+	case domain.AccessReviewStateCancelled:
+		return proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_CANCELLED
+	case domain.AccessReviewStateUnspecified:
+		fallthrough
+	default:
+		return proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED
+	}
+}
+
+func accessReviewStateToDomain(state proj_pb.AccessReviewState) domain.AccessReviewState {
+	switch state {
+	case proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_ACTIVE:
+		return domain.AccessReviewStateActive
+	case proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_COMPLETED:
+		return domain.AccessReviewStateCompleted
+	case proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_CANCELLED:
+		return domain.AccessReviewStateCancelled
+	case proj_pb.AccessReviewState_ACCESS_REVIEW_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AccessReviewStateUnspecified
+	}
+}
+
+func accessReviewItemTypeToPb(itemType domain.AccessReviewItemType) proj_pb.AccessReviewItemType {
+	switch itemType {
+	case domain.AccessReviewItemTypeUserGrant:
+		return proj_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_USER_GRANT
+	case domain.AccessReviewItemTypeOrgMember:
+		return proj_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_ORG_MEMBER
+	case domain.AccessReviewItemTypeProjectMember:
+		return proj_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_PROJECT_MEMBER
+	case domain.AccessReviewItemTypeUnspecified:
+		fallthrough
+	default:
+		return proj_pb.AccessReviewItemType_ACCESS_REVIEW_ITEM_TYPE_UNSPECIFIED
+	}
+}
+
+func AccessReviewDecisionToPb(decision domain.AccessReviewDecision) proj_pb.AccessReviewDecision {
+	switch decision {
+	case domain.AccessReviewDecisionKeep:
+		return proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP
+	case domain.AccessReviewDecisionRevoke:
+		return proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE
+	case domain.AccessReviewDecisionUnspecified:
+		fallthrough
+	default:
+		return proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED
+	}
+}
+
+func AccessReviewDecisionToDomain(decision proj_pb.AccessReviewDecision) domain.AccessReviewDecision {
+	switch decision {
+	case proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_KEEP:
+		return domain.AccessReviewDecisionKeep
+	case proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_REVOKE:
+		return domain.AccessReviewDecisionRevoke
+	case proj_pb.AccessReviewDecision_ACCESS_REVIEW_DECISION_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AccessReviewDecisionUnspecified
+	}
+}
+
+func AccessReviewQueriesToModel(queries []*proj_pb.AccessReviewQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AccessReviewQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AccessReviewQueryToModel(apiQuery *proj_pb.AccessReviewQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.AccessReviewQuery_ProjectIdQuery:
+		return query.NewAccessReviewProjectIDSearchQuery(q.ProjectIdQuery.ProjectId)
+	case *proj_pb.AccessReviewQuery_StateQuery:
+		return query.NewAccessReviewStateSearchQuery(accessReviewStateToDomain(q.StateQuery.State))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Rv6aAa", "List.Query.Invalid")
+	}
+}
+
+func AccessReviewItemQueriesToModel(queries []*proj_pb.AccessReviewItemQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = AccessReviewItemQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func AccessReviewItemQueryToModel(apiQuery *proj_pb.AccessReviewItemQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.AccessReviewItemQuery_UserIdQuery:
+		return query.NewAccessReviewItemUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *proj_pb.AccessReviewItemQuery_DecisionQuery:
+		return query.NewAccessReviewItemDecisionSearchQuery(AccessReviewDecisionToDomain(q.DecisionQuery.Decision))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Rv6bBb", "List.Query.Invalid")
+	}
+}
@@ -62,6 +62,8 @@ func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 			AllowedOrigins:           app.AllowedOrigins,
 			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
 			BackChannelLogoutUri:     app.BackChannelLogoutURI,
+			RequireConsent:           app.RequireConsent,
+			RequiredAcr:              app.RequiredACR,
 		},
 	}
 }
@@ -70,10 +72,125 @@ func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 	return &app_pb.App_SamlConfig{
 		SamlConfig: &app_pb.SAMLConfig{
 			Metadata: &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			Options:  SAMLOptionsToPb(app.Options),
 		},
 	}
 }
 
+func SAMLOptionsToPb(options *domain.SAMLAppOptions) *app_pb.SAMLOptions {
+	if options == nil {
+		return nil
+	}
+	return &app_pb.SAMLOptions{
+		NameIdFormat:     samlNameIDFormatToPb(options.NameIDFormat),
+		AttributeMapping: samlAttributeMappingToPb(options.AttributeMapping),
+	}
+}
+
+func SAMLOptionsToDomain(options *app_pb.SAMLOptions) *domain.SAMLAppOptions {
+	if options == nil {
+		return nil
+	}
+	return &domain.SAMLAppOptions{
+		NameIDFormat:     samlNameIDFormatToDomain(options.GetNameIdFormat()),
+		AttributeMapping: samlAttributeMappingToDomain(options.GetAttributeMapping()),
+	}
+}
+
+func samlAttributeMappingToPb(mapping *domain.SAMLAttributeMapping) *app_pb.SAMLAttributeMapping {
+	if mapping == nil {
+		return nil
+	}
+	attributes := make([]app_pb.SAMLUserAttribute, len(mapping.UserAttributes))
+	for i, attribute := range mapping.UserAttributes {
+		attributes[i] = samlUserAttributeToPb(attribute)
+	}
+	return &app_pb.SAMLAttributeMapping{
+		UserAttributes:     attributes,
+		MetadataKeys:       mapping.MetadataKeys,
+		RolesAttributeName: mapping.RolesAttributeName,
+	}
+}
+
+func samlAttributeMappingToDomain(mapping *app_pb.SAMLAttributeMapping) *domain.SAMLAttributeMapping {
+	if mapping == nil {
+		return nil
+	}
+	attributes := make([]domain.SAMLUserAttribute, len(mapping.GetUserAttributes()))
+	for i, attribute := range mapping.GetUserAttributes() {
+		attributes[i] = samlUserAttributeToDomain(attribute)
+	}
+	return &domain.SAMLAttributeMapping{
+		UserAttributes:     attributes,
+		MetadataKeys:       mapping.GetMetadataKeys(),
+		RolesAttributeName: mapping.GetRolesAttributeName(),
+	}
+}
+
+func samlNameIDFormatToPb(format domain.SAMLNameIDFormat) app_pb.SAMLNameIDFormat {
+	switch format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS
+	case domain.SAMLNameIDFormatPersistent:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT
+	case domain.SAMLNameIDFormatTransient:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT
+	default:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED
+	}
+}
+
+func samlNameIDFormatToDomain(format app_pb.SAMLNameIDFormat) domain.SAMLNameIDFormat {
+	switch format {
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		return domain.SAMLNameIDFormatEmailAddress
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return domain.SAMLNameIDFormatPersistent
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return domain.SAMLNameIDFormatTransient
+	default:
+		return domain.SAMLNameIDFormatUnspecified
+	}
+}
+
+func samlUserAttributeToPb(attribute domain.SAMLUserAttribute) app_pb.SAMLUserAttribute {
+	switch attribute {
+	case domain.SAMLUserAttributeEmail:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_EMAIL
+	case domain.SAMLUserAttributeFullName:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_FULL_NAME
+	case domain.SAMLUserAttributeGivenName:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_GIVEN_NAME
+	case domain.SAMLUserAttributeSurname:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_SURNAME
+	case domain.SAMLUserAttributeUsername:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_USERNAME
+	case domain.SAMLUserAttributeUserID:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_USER_ID
+	default:
+		return app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_UNSPECIFIED
+	}
+}
+
+func samlUserAttributeToDomain(attribute app_pb.SAMLUserAttribute) domain.SAMLUserAttribute {
+	switch attribute {
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_EMAIL:
+		return domain.SAMLUserAttributeEmail
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_FULL_NAME:
+		return domain.SAMLUserAttributeFullName
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_GIVEN_NAME:
+		return domain.SAMLUserAttributeGivenName
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_SURNAME:
+		return domain.SAMLUserAttributeSurname
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_USERNAME:
+		return domain.SAMLUserAttributeUsername
+	case app_pb.SAMLUserAttribute_SAML_USER_ATTRIBUTE_USER_ID:
+		return domain.SAMLUserAttributeUserID
+	default:
+		return domain.SAMLUserAttributeUnspecified
+	}
+}
+
 func AppAPIConfigToPb(app *query.APIApp) app_pb.AppConfig {
 	return &app_pb.App_ApiConfig{
 		ApiConfig: &app_pb.APIConfig{
@@ -0,0 +1,167 @@
+package project
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	proj_pb "github.com/zitadel/zitadel/pkg/grpc/project"
+)
+
+func RelationSchemaToPb(schema *domain.RelationSchema) *proj_pb.RelationSchema {
+	if schema == nil {
+		return nil
+	}
+	types := make([]*proj_pb.RelationObjectType, len(schema.Types))
+	for i, objectType := range schema.Types {
+		relations := make([]*proj_pb.RelationDefinition, len(objectType.Relations))
+		for j, relation := range objectType.Relations {
+			relations[j] = &proj_pb.RelationDefinition{
+				Name:     relation.Name,
+				Subjects: relation.Subjects,
+			}
+		}
+		permissions := make([]*proj_pb.PermissionDefinition, len(objectType.Permissions))
+		for j, permission := range objectType.Permissions {
+			permissions[j] = &proj_pb.PermissionDefinition{
+				Name:  permission.Name,
+				Union: permission.Union,
+			}
+		}
+		types[i] = &proj_pb.RelationObjectType{
+			Name:        objectType.Name,
+			Relations:   relations,
+			Permissions: permissions,
+		}
+	}
+	return &proj_pb.RelationSchema{Types: types}
+}
+
+func RelationSchemaToDomain(schema *proj_pb.RelationSchema) *domain.RelationSchema {
+	if schema == nil {
+		return nil
+	}
+	types := make([]*domain.RelationObjectType, len(schema.GetTypes()))
+	for i, objectType := range schema.GetTypes() {
+		relations := make([]*domain.RelationDefinition, len(objectType.GetRelations()))
+		for j, relation := range objectType.GetRelations() {
+			relations[j] = &domain.RelationDefinition{
+				Name:     relation.GetName(),
+				Subjects: relation.GetSubjects(),
+			}
+		}
+		permissions := make([]*domain.PermissionDefinition, len(objectType.GetPermissions()))
+		for j, permission := range objectType.GetPermissions() {
+			permissions[j] = &domain.PermissionDefinition{
+				Name:  permission.GetName(),
+				Union: permission.GetUnion(),
+			}
+		}
+		types[i] = &domain.RelationObjectType{
+			Name:        objectType.GetName(),
+			Relations:   relations,
+			Permissions: permissions,
+		}
+	}
+	return &domain.RelationSchema{Types: types}
+}
+
+func RelationTuplesToDomain(tuples []*proj_pb.RelationTuple) []*domain.RelationTuple {
+	result := make([]*domain.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		result[i] = &domain.RelationTuple{
+			ObjectType: tuple.GetObjectType(),
+			ObjectID:   tuple.GetObjectId(),
+			Relation:   tuple.GetRelation(),
+			Subject:    RelationSubjectToDomain(tuple.GetSubject()),
+		}
+	}
+	return result
+}
+
+func RelationTuplesToPb(tuples []*query.RelationTuple) []*proj_pb.RelationTuple {
+	result := make([]*proj_pb.RelationTuple, len(tuples))
+	for i, tuple := range tuples {
+		result[i] = &proj_pb.RelationTuple{
+			ObjectType: tuple.ObjectType,
+			ObjectId:   tuple.ObjectID,
+			Relation:   tuple.Relation,
+			Subject:    RelationSubjectToPb(tuple.Subject),
+		}
+	}
+	return result
+}
+
+func RelationSubjectToDomain(subject *proj_pb.RelationSubject) domain.RelationSubject {
+	return domain.RelationSubject{
+		Type:     subject.GetType(),
+		ID:       subject.GetId(),
+		Relation: subject.GetRelation(),
+	}
+}
+
+func RelationSubjectToPb(subject domain.RelationSubject) *proj_pb.RelationSubject {
+	return &proj_pb.RelationSubject{
+		Type:     subject.Type,
+		Id:       subject.ID,
+		Relation: subject.Relation,
+	}
+}
+
+func RelationSubjectsToPb(subjects []domain.RelationSubject) []*proj_pb.RelationSubject {
+	result := make([]*proj_pb.RelationSubject, len(subjects))
+	for i, subject := range subjects {
+		result[i] = RelationSubjectToPb(subject)
+	}
+	return result
+}
+
+func RelationTreeToPb(tree *query.RelationTree) *proj_pb.RelationTree {
+	children := make([]*proj_pb.RelationTree, len(tree.Children))
+	for i, child := range tree.Children {
+		children[i] = RelationTreeToPb(child)
+	}
+	return &proj_pb.RelationTree{
+		Object:   RelationSubjectToPb(tree.Object),
+		Subjects: RelationSubjectsToPb(tree.Subjects),
+		Roles:    tree.Roles,
+		Children: children,
+	}
+}
+
+func RelationConsistencyToDomain(consistency *proj_pb.RelationConsistency) *domain.RelationConsistency {
+	if consistency == nil {
+		return nil
+	}
+	return &domain.RelationConsistency{
+		AtLeastAsFresh:  consistency.GetAtLeastAsFresh(),
+		FullyConsistent: consistency.GetFullyConsistent(),
+	}
+}
+
+func RelationTupleQueriesToModel(queries []*proj_pb.RelationTupleQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = RelationTupleQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func RelationTupleQueryToModel(apiQuery *proj_pb.RelationTupleQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *proj_pb.RelationTupleQuery_ObjectType:
+		return query.NewRelationTupleObjectTypeSearchQuery(q.ObjectType)
+	case *proj_pb.RelationTupleQuery_ObjectId:
+		return query.NewRelationTupleObjectIDSearchQuery(q.ObjectId)
+	case *proj_pb.RelationTupleQuery_Relation:
+		return query.NewRelationTupleRelationSearchQuery(q.Relation)
+	case *proj_pb.RelationTupleQuery_SubjectType:
+		return query.NewRelationTupleSubjectTypeSearchQuery(q.SubjectType)
+	case *proj_pb.RelationTupleQuery_SubjectId:
+		return query.NewRelationTupleSubjectIDSearchQuery(q.SubjectId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Rl7aWq", "List.Query.Invalid")
+	}
+}
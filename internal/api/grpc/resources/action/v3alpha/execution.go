@@ -32,7 +32,8 @@ func (s *Server) SetExecution(ctx context.Context, req *action.SetExecutionReque
 		}
 	}
 	set := &command.SetExecution{
-		Targets: targets,
+		Targets:   targets,
+		Condition: req.GetExecution().GetFilter(),
 	}
 	var err error
 	var details *domain.ObjectDetails
@@ -96,6 +96,8 @@ func targetToPb(t *query.Target) *action.GetTarget {
 			Name:     t.Name,
 			Timeout:  durationpb.New(t.Timeout),
 			Endpoint: t.Endpoint,
+			Tls:      targetTLSToPb(t.TLS),
+			Oauth2:   targetOAuth2ToPb(t.OAuth2),
 		},
 		SigningKey: t.SigningKey,
 	}
@@ -106,12 +108,38 @@ func targetToPb(t *query.Target) *action.GetTarget {
 		target.Config.TargetType = &action.Target_RestCall{RestCall: &action.SetRESTCall{InterruptOnError: t.InterruptOnError}}
 	case domain.TargetTypeAsync:
 		target.Config.TargetType = &action.Target_RestAsync{RestAsync: &action.SetRESTAsync{}}
+	case domain.TargetTypeWASM:
+		// the module is not returned
+		target.Config.TargetType = &action.Target_Wasm{Wasm: &action.SetWASM{InterruptOnError: t.InterruptOnError}}
 	default:
 		target.Config.TargetType = nil
 	}
 	return target
 }
 
+// targetTLSToPb returns the TLS configuration without the client key
+func targetTLSToPb(tls *domain.TargetTLS) *action.TargetTLS {
+	if tls == nil {
+		return nil
+	}
+	return &action.TargetTLS{
+		ClientCertificate: tls.ClientCertificate,
+		CaCertificates:    tls.CACertificates,
+	}
+}
+
+// targetOAuth2ToPb returns the client credentials without the client secret
+func targetOAuth2ToPb(oauth2 *domain.TargetOAuth2) *action.TargetOAuth2 {
+	if oauth2 == nil {
+		return nil
+	}
+	return &action.TargetOAuth2{
+		TokenEndpoint: oauth2.TokenEndpoint,
+		ClientId:      oauth2.ClientID,
+		Scopes:        oauth2.Scopes,
+	}
+}
+
 func (s *Server) searchTargetsRequestToModel(req *action.SearchTargetsRequest) (*query.TargetSearchQueries, error) {
 	offset, limit, asc, err := resource_object.SearchQueryPbToQuery(s.systemDefaults, req.Query)
 	if err != nil {
@@ -346,6 +374,7 @@ func executionToPb(e *query.Execution) *action.GetExecution {
 		Details: resource_object.DomainToDetailsPb(&e.ObjectDetails, object.OwnerType_OWNER_TYPE_INSTANCE, e.ResourceOwner),
 		Execution: &action.Execution{
 			Targets: targets,
+			Filter:  e.Condition,
 		},
 	}
 }
@@ -65,6 +65,7 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 	var (
 		targetType       domain.TargetType
 		interruptOnError bool
+		module           []byte
 	)
 	switch t := reqTarget.GetTargetType().(type) {
 	case *action.Target_RestWebhook:
@@ -75,6 +76,10 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		interruptOnError = t.RestCall.InterruptOnError
 	case *action.Target_RestAsync:
 		targetType = domain.TargetTypeAsync
+	case *action.Target_Wasm:
+		targetType = domain.TargetTypeWASM
+		interruptOnError = t.Wasm.InterruptOnError
+		module = t.Wasm.GetModule()
 	}
 	return &command.AddTarget{
 		Name:             reqTarget.GetName(),
@@ -82,6 +87,9 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		Endpoint:         reqTarget.GetEndpoint(),
 		Timeout:          reqTarget.GetTimeout().AsDuration(),
 		InterruptOnError: interruptOnError,
+		TLS:              targetTLSToCommand(reqTarget.GetTls()),
+		OAuth2:           targetOAuth2ToCommand(reqTarget.GetOauth2()),
+		Module:           module,
 	}
 }
 
@@ -103,6 +111,8 @@ func patchTargetToCommand(req *action.PatchTargetRequest) *command.ChangeTarget
 		Name:                 reqTarget.Name,
 		Endpoint:             reqTarget.Endpoint,
 		ExpirationSigningKey: expirationSigningKey,
+		TLS:                  targetTLSToCommand(reqTarget.GetTls()),
+		OAuth2:               targetOAuth2ToCommand(reqTarget.GetOauth2()),
 	}
 	if reqTarget.TargetType != nil {
 		switch t := reqTarget.GetTargetType().(type) {
@@ -115,6 +125,13 @@ func patchTargetToCommand(req *action.PatchTargetRequest) *command.ChangeTarget
 		case *action.PatchTarget_RestAsync:
 			target.TargetType = gu.Ptr(domain.TargetTypeAsync)
 			target.InterruptOnError = gu.Ptr(false)
+		case *action.PatchTarget_Wasm:
+			target.TargetType = gu.Ptr(domain.TargetTypeWASM)
+			target.InterruptOnError = gu.Ptr(t.Wasm.InterruptOnError)
+			// the existing module is kept if none is set
+			if len(t.Wasm.GetModule()) > 0 {
+				target.Module = t.Wasm.GetModule()
+			}
 		}
 	}
 	if reqTarget.Timeout != nil {
@@ -122,3 +139,26 @@ func patchTargetToCommand(req *action.PatchTargetRequest) *command.ChangeTarget
 	}
 	return target
 }
+
+func targetTLSToCommand(tls *action.TargetTLS) *domain.TargetTLS {
+	if tls == nil {
+		return nil
+	}
+	return &domain.TargetTLS{
+		ClientCertificate: tls.GetClientCertificate(),
+		ClientKey:         tls.GetClientKey(),
+		CACertificates:    tls.GetCaCertificates(),
+	}
+}
+
+func targetOAuth2ToCommand(oauth2 *action.TargetOAuth2) *domain.TargetOAuth2 {
+	if oauth2 == nil {
+		return nil
+	}
+	return &domain.TargetOAuth2{
+		TokenEndpoint: oauth2.GetTokenEndpoint(),
+		ClientID:      oauth2.GetClientId(),
+		ClientSecret:  oauth2.GetClientSecret(),
+		Scopes:        oauth2.GetScopes(),
+	}
+}
@@ -0,0 +1,139 @@
+package action
+
+import (
+	"context"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
+	action "github.com/zitadel/zitadel/pkg/grpc/resources/action/v3alpha"
+)
+
+func (s *Server) SearchTargetDeliveries(ctx context.Context, req *action.SearchTargetDeliveriesRequest) (*action.SearchTargetDeliveriesResponse, error) {
+	if err := checkActionsEnabled(ctx); err != nil {
+		return nil, err
+	}
+	queries, err := s.searchTargetDeliveriesRequestToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchTargetDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &action.SearchTargetDeliveriesResponse{
+		Result:  targetDeliveriesToPb(resp.TargetDeliveries),
+		Details: resource_object.ToSearchDetailsPb(queries.SearchRequest, resp.SearchResponse),
+	}, nil
+}
+
+func (s *Server) ReplayTargetDelivery(ctx context.Context, req *action.ReplayTargetDeliveryRequest) (*action.ReplayTargetDeliveryResponse, error) {
+	if err := checkActionsEnabled(ctx); err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	details, err := s.command.ReplayTargetDelivery(ctx, req.GetId(), instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return &action.ReplayTargetDeliveryResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_INSTANCE, instanceID),
+	}, nil
+}
+
+func (s *Server) searchTargetDeliveriesRequestToModel(req *action.SearchTargetDeliveriesRequest) (*query.TargetDeliverySearchQueries, error) {
+	offset, limit, asc, err := resource_object.SearchQueryPbToQuery(s.systemDefaults, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	targetQuery, err := query.NewTargetDeliveryTargetIDSearchQuery(req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{targetQuery}
+	if req.State != nil {
+		stateQuery, err := query.NewTargetDeliveryStateSearchQuery(targetDeliveryStateToDomain(req.GetState()))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, stateQuery)
+	}
+	return &query.TargetDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.TargetDeliveryColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func targetDeliveriesToPb(deliveries []*query.TargetDelivery) []*action.TargetDelivery {
+	d := make([]*action.TargetDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i] = targetDeliveryToPb(delivery)
+	}
+	return d
+}
+
+func targetDeliveryToPb(d *query.TargetDelivery) *action.TargetDelivery {
+	delivery := &action.TargetDelivery{
+		Details:        resource_object.DomainToDetailsPb(&d.ObjectDetails, object.OwnerType_OWNER_TYPE_INSTANCE, d.ResourceOwner),
+		TargetId:       d.TargetID,
+		ExecutionId:    d.ExecutionID,
+		IdempotencyKey: d.IdempotencyKey,
+		State:          targetDeliveryStateToPb(d.State),
+		Attempts:       uint32(d.Attempts),
+		LastError:      d.LastError,
+	}
+	if d.ReplayOf != "" {
+		delivery.ReplayOf = gu.Ptr(d.ReplayOf)
+	}
+	if d.ReplayID != "" {
+		delivery.ReplayId = gu.Ptr(d.ReplayID)
+	}
+	return delivery
+}
+
+func targetDeliveryStateToPb(state domain.TargetDeliveryState) action.TargetDeliveryState {
+	switch state {
+	case domain.TargetDeliveryStateRequested:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_REQUESTED
+	case domain.TargetDeliveryStateRetrying:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_RETRYING
+	case domain.TargetDeliveryStateSucceeded:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_SUCCEEDED
+	case domain.TargetDeliveryStateFailed:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_FAILED
+	case domain.TargetDeliveryStateReplayed:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_REPLAYED
+	case domain.TargetDeliveryStateUnspecified:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	default:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func targetDeliveryStateToDomain(state action.TargetDeliveryState) domain.TargetDeliveryState {
+	switch state {
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_REQUESTED:
+		return domain.TargetDeliveryStateRequested
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_RETRYING:
+		return domain.TargetDeliveryStateRetrying
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_SUCCEEDED:
+		return domain.TargetDeliveryStateSucceeded
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_FAILED:
+		return domain.TargetDeliveryStateFailed
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_REPLAYED:
+		return domain.TargetDeliveryStateReplayed
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED:
+		return domain.TargetDeliveryStateUnspecified
+	default:
+		return domain.TargetDeliveryStateUnspecified
+	}
+}
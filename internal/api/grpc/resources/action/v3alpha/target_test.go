@@ -88,6 +88,65 @@ func Test_createTargetToCommand(t *testing.T) {
 				InterruptOnError: true,
 			},
 		},
+		{
+			name: "all fields (grpc with transports)",
+			args: args{&action.Target{
+				Name:     "target 1",
+				Endpoint: "grpcs://example.com:443",
+				TargetType: &action.Target_RestCall{
+					RestCall: &action.SetRESTCall{},
+				},
+				Timeout: durationpb.New(10 * time.Second),
+				Tls: &action.TargetTLS{
+					ClientCertificate: []byte("cert"),
+					ClientKey:         []byte("key"),
+					CaCertificates:    []byte("ca"),
+				},
+				Oauth2: &action.TargetOAuth2{
+					TokenEndpoint: "https://example.com/oauth/token",
+					ClientId:      "client",
+					ClientSecret:  "secret",
+					Scopes:        []string{"actions"},
+				},
+			}},
+			want: &command.AddTarget{
+				Name:       "target 1",
+				TargetType: domain.TargetTypeCall,
+				Endpoint:   "grpcs://example.com:443",
+				Timeout:    10 * time.Second,
+				TLS: &domain.TargetTLS{
+					ClientCertificate: []byte("cert"),
+					ClientKey:         []byte("key"),
+					CACertificates:    []byte("ca"),
+				},
+				OAuth2: &domain.TargetOAuth2{
+					TokenEndpoint: "https://example.com/oauth/token",
+					ClientID:      "client",
+					ClientSecret:  "secret",
+					Scopes:        []string{"actions"},
+				},
+			},
+		},
+		{
+			name: "all fields (wasm)",
+			args: args{&action.Target{
+				Name: "target 1",
+				TargetType: &action.Target_Wasm{
+					Wasm: &action.SetWASM{
+						Module:           []byte("module"),
+						InterruptOnError: true,
+					},
+				},
+				Timeout: durationpb.New(10 * time.Second),
+			}},
+			want: &command.AddTarget{
+				Name:             "target 1",
+				TargetType:       domain.TargetTypeWASM,
+				Timeout:          10 * time.Second,
+				InterruptOnError: true,
+				Module:           []byte("module"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -199,6 +258,34 @@ func Test_updateTargetToCommand(t *testing.T) {
 				InterruptOnError: gu.Ptr(false),
 			},
 		},
+		{
+			name: "wasm without module",
+			args: args{&action.PatchTarget{
+				TargetType: &action.PatchTarget_Wasm{
+					Wasm: &action.SetWASM{},
+				},
+			}},
+			want: &command.ChangeTarget{
+				TargetType:       gu.Ptr(domain.TargetTypeWASM),
+				InterruptOnError: gu.Ptr(false),
+			},
+		},
+		{
+			name: "wasm with module",
+			args: args{&action.PatchTarget{
+				TargetType: &action.PatchTarget_Wasm{
+					Wasm: &action.SetWASM{
+						Module:           []byte("module"),
+						InterruptOnError: true,
+					},
+				},
+			}},
+			want: &command.ChangeTarget{
+				TargetType:       gu.Ptr(domain.TargetTypeWASM),
+				InterruptOnError: gu.Ptr(true),
+				Module:           []byte("module"),
+			},
+		},
 		{
 			name: "all fields (interrupting response)",
 			args: args{&action.PatchTarget{
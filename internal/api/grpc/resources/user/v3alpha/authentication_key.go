@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+
+	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/resources/user/v3alpha"
+)
+
+func (s *Server) AddAuthenticationKey(ctx context.Context, req *user.AddAuthenticationKeyRequest) (_ *user.AddAuthenticationKeyResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	key := addAuthenticationKeyRequestToAddSchemaUserPublicKey(req)
+	details, err := s.command.AddSchemaUserPublicKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	keyContent, err := key.Detail()
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddAuthenticationKeyResponse{
+		Details:             resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+		AuthenticationKeyId: key.PublicKeyID,
+		KeyContent:          keyContent,
+	}, nil
+}
+
+func addAuthenticationKeyRequestToAddSchemaUserPublicKey(req *user.AddAuthenticationKeyRequest) *command.AddSchemaUserPublicKey {
+	key := &command.AddSchemaUserPublicKey{
+		ResourceOwner: organizationToUpdateResourceOwner(req.Organization),
+		ID:            req.GetId(),
+		Type:          authNKeyTypeToDomain(req.GetAuthenticationKey().GetType()),
+		PublicKey:     req.GetAuthenticationKey().GetPublicKey(),
+	}
+	if expirationDate := req.GetAuthenticationKey().GetExpirationDate(); expirationDate != nil {
+		key.ExpirationDate = expirationDate.AsTime()
+	}
+	return key
+}
+
+func authNKeyTypeToDomain(keyType user.AuthNKeyType) domain.AuthNKeyType {
+	switch keyType {
+	case user.AuthNKeyType_AUTHN_KEY_TYPE_UNSPECIFIED:
+		return domain.AuthNKeyTypeNONE
+	case user.AuthNKeyType_AUTHN_KEY_TYPE_JSON:
+		return domain.AuthNKeyTypeJSON
+	default:
+		return domain.AuthNKeyTypeNONE
+	}
+}
+
+func (s *Server) RemoveAuthenticationKey(ctx context.Context, req *user.RemoveAuthenticationKeyRequest) (_ *user.RemoveAuthenticationKeyResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveSchemaUserPublicKey(ctx, organizationToUpdateResourceOwner(req.Organization), req.GetId(), req.GetAuthenticationKeyId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveAuthenticationKeyResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+
+	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/resources/user/v3alpha"
+)
+
+func (s *Server) SetPassword(ctx context.Context, req *user.SetPasswordRequest) (_ *user.SetPasswordResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.SetSchemaUserPassword(ctx, setPasswordRequestToSetSchemaUserPassword(req))
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetPasswordResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+	}, nil
+}
+
+func setPasswordRequestToSetSchemaUserPassword(req *user.SetPasswordRequest) *command.SetSchemaUserPassword {
+	return &command.SetSchemaUserPassword{
+		ResourceOwner: organizationToUpdateResourceOwner(req.Organization),
+		ID:            req.GetId(),
+		Password:      setPasswordToPassword(req.GetNewPassword()),
+	}
+}
+
+func setPasswordToPassword(setPassword *user.SetPassword) *command.Password {
+	if setPassword == nil {
+		return nil
+	}
+	return &command.Password{
+		PasswordCode:        setPassword.GetVerificationCode(),
+		OldPassword:         setPassword.GetCurrentPassword(),
+		Password:            setPassword.GetPassword(),
+		EncodedPasswordHash: setPassword.GetHash(),
+		ChangeRequired:      setPassword.GetChangeRequired(),
+	}
+}
+
+func (s *Server) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetRequest) (_ *user.RequestPasswordResetResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	request := requestPasswordResetRequestToRequestSchemaUserPasswordReset(req)
+	details, err := s.command.RequestSchemaUserPasswordReset(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &user.RequestPasswordResetResponse{
+		Details:          resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+		VerificationCode: request.PlainCode,
+	}, nil
+}
+
+func requestPasswordResetRequestToRequestSchemaUserPasswordReset(req *user.RequestPasswordResetRequest) *command.RequestSchemaUserPasswordReset {
+	notificationType := domain.NotificationTypeEmail
+	if req.GetSendSms() != nil {
+		notificationType = domain.NotificationTypeSms
+	}
+	return &command.RequestSchemaUserPasswordReset{
+		ResourceOwner:    organizationToUpdateResourceOwner(req.Organization),
+		ID:               req.GetId(),
+		NotificationType: notificationType,
+		URLTemplate:      req.GetSendEmail().GetUrlTemplate(),
+		ReturnCode:       req.GetReturnCode() != nil,
+	}
+}
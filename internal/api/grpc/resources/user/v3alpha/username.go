@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
+	"github.com/zitadel/zitadel/internal/command"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/resources/user/v3alpha"
+)
+
+func (s *Server) AddUsername(ctx context.Context, req *user.AddUsernameRequest) (_ *user.AddUsernameResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	username := addUsernameRequestToAddSchemaUserUsername(req)
+	details, err := s.command.AddSchemaUserUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddUsernameResponse{
+		Details:    resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+		UsernameId: username.UsernameID,
+	}, nil
+}
+
+func addUsernameRequestToAddSchemaUserUsername(req *user.AddUsernameRequest) *command.AddSchemaUserUsername {
+	return &command.AddSchemaUserUsername{
+		ResourceOwner:          organizationToUpdateResourceOwner(req.Organization),
+		ID:                     req.GetId(),
+		Username:               req.GetUsername().GetUsername(),
+		IsOrganizationSpecific: req.GetUsername().GetIsOrganizationSpecific(),
+	}
+}
+
+func (s *Server) RemoveUsername(ctx context.Context, req *user.RemoveUsernameRequest) (_ *user.RemoveUsernameResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveSchemaUserUsername(ctx, organizationToUpdateResourceOwner(req.Organization), req.GetId(), req.GetUsernameId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveUsernameResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
+	user "github.com/zitadel/zitadel/pkg/grpc/resources/user/v3alpha"
+)
+
+func (s *Server) StartWebAuthNRegistration(ctx context.Context, req *user.StartWebAuthNRegistrationRequest) (_ *user.StartWebAuthNRegistrationResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetRegistration().GetCode() != nil {
+		return nil, zerrors.ThrowUnimplemented(nil, "USERv3-Wa4aAa", "Errors.User.WebAuthN.CodeNotSupported")
+	}
+	registration := startWebAuthNRegistrationRequestToStartSchemaUserWebAuthNRegistration(req)
+	details, err := s.command.StartSchemaUserWebAuthNRegistration(ctx, registration)
+	if err != nil {
+		return nil, err
+	}
+	options := new(structpb.Struct)
+	if err := options.UnmarshalJSON(registration.CredentialCreationData); err != nil {
+		return nil, zerrors.ThrowInternal(err, "USERv3-Wa4bBb", "Errors.Internal")
+	}
+	return &user.StartWebAuthNRegistrationResponse{
+		Details:                            resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+		WebAuthNId:                         registration.WebAuthNID,
+		PublicKeyCredentialCreationOptions: options,
+	}, nil
+}
+
+func startWebAuthNRegistrationRequestToStartSchemaUserWebAuthNRegistration(req *user.StartWebAuthNRegistrationRequest) *command.StartSchemaUserWebAuthNRegistration {
+	return &command.StartSchemaUserWebAuthNRegistration{
+		ResourceOwner:           organizationToUpdateResourceOwner(req.Organization),
+		ID:                      req.GetId(),
+		RPID:                    req.GetRegistration().GetDomain(),
+		AuthenticatorAttachment: webAuthNAuthenticatorTypeToDomain(req.GetRegistration().GetAuthenticatorType()),
+		UserVerification:        domain.UserVerificationRequirementRequired,
+	}
+}
+
+func webAuthNAuthenticatorTypeToDomain(authenticatorType user.WebAuthNAuthenticatorType) domain.AuthenticatorAttachment {
+	switch authenticatorType {
+	case user.WebAuthNAuthenticatorType_WEB_AUTH_N_AUTHENTICATOR_UNSPECIFIED:
+		return domain.AuthenticatorAttachmentUnspecified
+	case user.WebAuthNAuthenticatorType_WEB_AUTH_N_AUTHENTICATOR_PLATFORM:
+		return domain.AuthenticatorAttachmentPlattform
+	case user.WebAuthNAuthenticatorType_WEB_AUTH_N_AUTHENTICATOR_CROSS_PLATFORM:
+		return domain.AuthenticatorAttachmentCrossPlattform
+	default:
+		return domain.AuthenticatorAttachmentUnspecified
+	}
+}
+
+func (s *Server) VerifyWebAuthNRegistration(ctx context.Context, req *user.VerifyWebAuthNRegistrationRequest) (_ *user.VerifyWebAuthNRegistrationResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	publicKeyCredential, err := req.GetVerify().GetPublicKeyCredential().MarshalJSON()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USERv3-Wa5aAa", "Errors.Internal")
+	}
+	details, err := s.command.VerifySchemaUserWebAuthNRegistration(ctx, &command.VerifySchemaUserWebAuthNRegistration{
+		ResourceOwner:       organizationToUpdateResourceOwner(req.Organization),
+		ID:                  req.GetId(),
+		WebAuthNID:          req.GetWebAuthNId(),
+		Name:                req.GetVerify().GetWebAuthNName(),
+		PublicKeyCredential: publicKeyCredential,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyWebAuthNRegistrationResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+	}, nil
+}
+
+func (s *Server) RemoveWebAuthNAuthenticator(ctx context.Context, req *user.RemoveWebAuthNAuthenticatorRequest) (_ *user.RemoveWebAuthNAuthenticatorResponse, err error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveSchemaUserWebAuthN(ctx, organizationToUpdateResourceOwner(req.Organization), req.GetId(), req.GetWebAuthNId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveWebAuthNAuthenticatorResponse{
+		Details: resource_object.DomainToDetailsPb(details, object.OwnerType_OWNER_TYPE_ORG, details.ResourceOwner),
+	}, nil
+}
@@ -150,6 +150,8 @@ func authenticatorTypeToPb(authenticator domain.AuthenticatorType) schema.Authen
 		return schema.AuthenticatorType_AUTHENTICATOR_TYPE_AUTHENTICATION_KEY
 	case domain.AuthenticatorTypeIdentityProvider:
 		return schema.AuthenticatorType_AUTHENTICATOR_TYPE_IDENTITY_PROVIDER
+	case domain.AuthenticatorTypePersonalAccessToken:
+		return schema.AuthenticatorType_AUTHENTICATOR_TYPE_PERSONAL_ACCESS_TOKEN
 	case domain.AuthenticatorTypeUnspecified:
 		return schema.AuthenticatorType_AUTHENTICATOR_TYPE_UNSPECIFIED
 	default:
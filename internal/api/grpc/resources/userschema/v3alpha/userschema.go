@@ -2,6 +2,7 @@ package userschema
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/muhlemmer/gu"
 
@@ -9,10 +10,13 @@ import (
 	resource_object "github.com/zitadel/zitadel/internal/api/grpc/resources/object/v3alpha"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
 	object "github.com/zitadel/zitadel/pkg/grpc/object/v3alpha"
 	schema "github.com/zitadel/zitadel/pkg/grpc/resources/userschema/v3alpha"
 )
 
+const defaultMigrateUsersLimit = 100
+
 func (s *Server) CreateUserSchema(ctx context.Context, req *schema.CreateUserSchemaRequest) (*schema.CreateUserSchemaResponse, error) {
 	if err := checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
@@ -48,6 +52,21 @@ func (s *Server) PatchUserSchema(ctx context.Context, req *schema.PatchUserSchem
 	}, nil
 }
 
+func (s *Server) MigrateUsers(ctx context.Context, req *schema.MigrateUsersRequest) (*schema.MigrateUsersResponse, error) {
+	if err := checkUserSchemaEnabled(ctx); err != nil {
+		return nil, err
+	}
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultMigrateUsersLimit
+	}
+	result, err := s.command.MigrateSchemaUsers(ctx, req.GetId(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return migrateUsersResultToPb(result), nil
+}
+
 func (s *Server) DeactivateUserSchema(ctx context.Context, req *schema.DeactivateUserSchemaRequest) (*schema.DeactivateUserSchemaResponse, error) {
 	if err := checkUserSchemaEnabled(ctx); err != nil {
 		return nil, err
@@ -113,15 +132,78 @@ func patchUserSchemaToCommand(req *schema.PatchUserSchemaRequest, resourceOwner
 	if req.GetUserSchema() != nil && req.GetUserSchema().GetType() != "" {
 		ty = gu.Ptr(req.GetUserSchema().GetType())
 	}
+	migrations, err := migrationsToDomain(req.GetUserSchema().GetMigrations())
+	if err != nil {
+		return nil, err
+	}
 	return &command.ChangeUserSchema{
 		ID:                     req.GetId(),
 		ResourceOwner:          resourceOwner,
 		Type:                   ty,
 		Schema:                 schema,
 		PossibleAuthenticators: authenticatorsToDomain(req.GetUserSchema().GetPossibleAuthenticators()),
+		Migrations:             migrations,
 	}, nil
 }
 
+func migrationsToDomain(migrations []*schema.Migration) ([]*domain_schema.Migration, error) {
+	if migrations == nil {
+		return nil, nil
+	}
+	domainMigrations := make([]*domain_schema.Migration, len(migrations))
+	for i, migration := range migrations {
+		var value json.RawMessage
+		if migration.GetValue() != nil {
+			var err error
+			value, err = migration.GetValue().MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+		}
+		domainMigrations[i] = &domain_schema.Migration{
+			Type:  migrationTypeToDomain(migration.GetType()),
+			Field: migration.GetField(),
+			To:    migration.GetTo(),
+			Value: value,
+		}
+	}
+	return domainMigrations, nil
+}
+
+func migrationTypeToDomain(migrationType schema.MigrationType) domain_schema.MigrationType {
+	switch migrationType {
+	case schema.MigrationType_MIGRATION_TYPE_RENAME:
+		return domain_schema.MigrationTypeRename
+	case schema.MigrationType_MIGRATION_TYPE_MOVE:
+		return domain_schema.MigrationTypeMove
+	case schema.MigrationType_MIGRATION_TYPE_DEFAULT:
+		return domain_schema.MigrationTypeDefault
+	case schema.MigrationType_MIGRATION_TYPE_UNSPECIFIED:
+		return ""
+	default:
+		return ""
+	}
+}
+
+func migrateUsersResultToPb(result *command.MigrateSchemaUsersResult) *schema.MigrateUsersResponse {
+	migrated := make([]string, len(result.Migrated))
+	for i, user := range result.Migrated {
+		migrated[i] = user.ID
+	}
+	failed := make([]*schema.MigrationFailure, len(result.Failed))
+	for i, failure := range result.Failed {
+		failed[i] = &schema.MigrationFailure{
+			UserId: failure.User.ID,
+			Reason: failure.Err.Error(),
+		}
+	}
+	return &schema.MigrateUsersResponse{
+		Migrated:  migrated,
+		Failed:    failed,
+		Remaining: result.Remaining,
+	}
+}
+
 func authenticatorsToDomain(authenticators []schema.AuthenticatorType) []domain.AuthenticatorType {
 	if authenticators == nil {
 		return nil
@@ -153,6 +235,8 @@ func authenticatorTypeToDomain(authenticator schema.AuthenticatorType) domain.Au
 		return domain.AuthenticatorTypeAuthenticationKey
 	case schema.AuthenticatorType_AUTHENTICATOR_TYPE_IDENTITY_PROVIDER:
 		return domain.AuthenticatorTypeIdentityProvider
+	case schema.AuthenticatorType_AUTHENTICATOR_TYPE_PERSONAL_ACCESS_TOKEN:
+		return domain.AuthenticatorTypePersonalAccessToken
 	default:
 		return domain.AuthenticatorTypeUnspecified
 	}
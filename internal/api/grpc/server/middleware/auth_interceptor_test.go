@@ -28,6 +28,10 @@ func (v *authzRepoMock) SearchMyMemberships(ctx context.Context, orgID string, _
 	}}, nil
 }
 
+func (v *authzRepoMock) SearchCustomRoles(context.Context, string) ([]authz.RoleMapping, error) {
+	return nil, nil
+}
+
 func (v *authzRepoMock) ProjectIDAndOriginsByClientID(ctx context.Context, clientID string) (string, []string, error) {
 	return "", nil, nil
 }
@@ -17,12 +17,16 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func ExecutionHandler(queries *query.Queries) grpc.UnaryServerInterceptor {
+func ExecutionHandler(queries *query.Queries, deliveries execution.Deliveries) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		requestTargets, responseTargets := queryTargets(ctx, queries, info.FullMethod)
 
+		var requestUser *execution.ConditionUser
+		if hasConditions(requestTargets) {
+			requestUser = conditionUser(ctx, queries, affectedUserID(req))
+		}
 		// call targets otherwise return req
-		handledReq, err := executeTargetsForRequest(ctx, requestTargets, info.FullMethod, req)
+		handledReq, err := executeTargetsForRequest(ctx, requestTargets, info.FullMethod, req, requestUser, deliveries)
 		if err != nil {
 			return nil, err
 		}
@@ -32,11 +36,73 @@ func ExecutionHandler(queries *query.Queries) grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
-		return executeTargetsForResponse(ctx, responseTargets, info.FullMethod, handledReq, response)
+		var responseUser *execution.ConditionUser
+		if hasConditions(responseTargets) {
+			// the affected user might only be known after the call, e.g. if the user was created
+			userID := affectedUserID(handledReq, response)
+			responseUser = requestUser
+			if responseUser == nil || responseUser.ID != userID {
+				responseUser = conditionUser(ctx, queries, userID)
+			}
+		}
+		return executeTargetsForResponse(ctx, responseTargets, info.FullMethod, handledReq, response, responseUser, deliveries)
+	}
+}
+
+// affectedUserID returns the id of the user the call is about,
+// taken from the first of the messages which contains a user id
+func affectedUserID(messages ...interface{}) string {
+	for _, message := range messages {
+		userMessage, ok := message.(interface{ GetUserId() string })
+		if !ok {
+			continue
+		}
+		if userID := userMessage.GetUserId(); userID != "" {
+			return userID
+		}
+	}
+	return ""
+}
+
+// conditionUser returns the affected user with its organization and metadata,
+// if the call does not affect a user, the user is returned empty
+func conditionUser(ctx context.Context, queries *query.Queries, userID string) *execution.ConditionUser {
+	user := &execution.ConditionUser{
+		ID:       userID,
+		Metadata: make(map[string]string),
+	}
+	if user.ID == "" {
+		return user
+	}
+	queriedUser, err := queries.GetUserByID(ctx, false, user.ID)
+	if err != nil {
+		logging.WithFields("userID", user.ID).WithError(err).Info("unable to query user for conditions")
+		return user
+	}
+	user.OrgID = queriedUser.ResourceOwner
+	metadata, err := queries.SearchUserMetadata(ctx, false, user.ID, &query.UserMetadataSearchQueries{}, false)
+	if err != nil {
+		logging.WithFields("userID", user.ID).WithError(err).Info("unable to query metadata for conditions")
+		return user
+	}
+	for _, m := range metadata.Metadata {
+		user.Metadata[m.Key] = string(m.Value)
+	}
+	return user
+}
+
+func hasConditions(targetLists ...[]execution.Target) bool {
+	for _, targets := range targetLists {
+		for _, target := range targets {
+			if len(target.GetConditions()) > 0 {
+				return true
+			}
+		}
 	}
+	return false
 }
 
-func executeTargetsForRequest(ctx context.Context, targets []execution.Target, fullMethod string, req interface{}) (_ interface{}, err error) {
+func executeTargetsForRequest(ctx context.Context, targets []execution.Target, fullMethod string, req interface{}, user *execution.ConditionUser, deliveries execution.Deliveries) (_ interface{}, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer span.EndWithError(err)
 
@@ -53,12 +119,13 @@ func executeTargetsForRequest(ctx context.Context, targets []execution.Target, f
 		OrgID:      ctxData.OrgID,
 		UserID:     ctxData.UserID,
 		Request:    req,
+		User:       user,
 	}
 
-	return execution.CallTargets(ctx, targets, info)
+	return execution.CallTargets(ctx, targets, info, deliveries)
 }
 
-func executeTargetsForResponse(ctx context.Context, targets []execution.Target, fullMethod string, req, resp interface{}) (_ interface{}, err error) {
+func executeTargetsForResponse(ctx context.Context, targets []execution.Target, fullMethod string, req, resp interface{}, user *execution.ConditionUser, deliveries execution.Deliveries) (_ interface{}, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer span.EndWithError(err)
 
@@ -76,9 +143,10 @@ func executeTargetsForResponse(ctx context.Context, targets []execution.Target,
 		UserID:     ctxData.UserID,
 		Request:    req,
 		Response:   resp,
+		User:       user,
 	}
 
-	return execution.CallTargets(ctx, targets, info)
+	return execution.CallTargets(ctx, targets, info, deliveries)
 }
 
 type ExecutionQueries interface {
@@ -133,6 +201,8 @@ type ContextInfoRequest struct {
 	ProjectID  string      `json:"projectID,omitempty"`
 	UserID     string      `json:"userID,omitempty"`
 	Request    interface{} `json:"request,omitempty"`
+
+	User *execution.ConditionUser `json:"-"`
 }
 
 func (c *ContextInfoRequest) GetHTTPRequestBody() []byte {
@@ -154,6 +224,10 @@ func (c *ContextInfoRequest) GetContent() interface{} {
 	return c.Request
 }
 
+func (c *ContextInfoRequest) GetConditionUser() *execution.ConditionUser {
+	return c.User
+}
+
 var _ execution.ContextInfo = &ContextInfoResponse{}
 
 type ContextInfoResponse struct {
@@ -164,6 +238,8 @@ type ContextInfoResponse struct {
 	UserID     string      `json:"userID,omitempty"`
 	Request    interface{} `json:"request,omitempty"`
 	Response   interface{} `json:"response,omitempty"`
+
+	User *execution.ConditionUser `json:"-"`
 }
 
 func (c *ContextInfoResponse) GetHTTPRequestBody() []byte {
@@ -181,3 +257,7 @@ func (c *ContextInfoResponse) SetHTTPResponseBody(resp []byte) error {
 func (c *ContextInfoResponse) GetContent() interface{} {
 	return c.Response
 }
+
+func (c *ContextInfoResponse) GetConditionUser() *execution.ConditionUser {
+	return c.User
+}
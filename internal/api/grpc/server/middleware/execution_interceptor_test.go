@@ -27,6 +27,10 @@ type mockExecutionTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+	Conditions       []string
+	TLS              *domain.TargetTLS
+	OAuth2           *domain.TargetOAuth2
+	Module           []byte
 }
 
 func (e *mockExecutionTarget) SetEndpoint(endpoint string) {
@@ -53,6 +57,31 @@ func (e *mockExecutionTarget) GetExecutionID() string {
 func (e *mockExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockExecutionTarget) GetConditions() []string {
+	return e.Conditions
+}
+func (e *mockExecutionTarget) GetTLS() *domain.TargetTLS {
+	return e.TLS
+}
+func (e *mockExecutionTarget) GetOAuth2() *domain.TargetOAuth2 {
+	return e.OAuth2
+}
+func (e *mockExecutionTarget) GetModule() []byte {
+	return e.Module
+}
+
+type mockDeliveries struct {
+	err    error
+	queued []string
+}
+
+func (d *mockDeliveries) RequestTargetDelivery(_ context.Context, targetID, _ string, _ []byte) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.queued = append(d.queued, targetID)
+	return nil
+}
 
 type mockContentRequest struct {
 	Content string
@@ -93,10 +122,12 @@ func Test_executeTargetsForGRPCFullMethod_request(t *testing.T) {
 		targets          []target
 		fullMethod       string
 		req              interface{}
+		deliveriesErr    error
 	}
 	type res struct {
 		want    interface{}
 		wantErr bool
+		queued  []string
 	}
 	tests := []struct {
 		name string
@@ -295,32 +326,34 @@ func Test_executeTargetsForGRPCFullMethod_request(t *testing.T) {
 			},
 		},
 		{
-			"target async, timeout",
+			"target async, queue error",
 			args{
 				ctx:        context.Background(),
 				fullMethod: "/service/method",
 				executionTargets: []execution.Target{
 					&mockExecutionTarget{
-						InstanceID:  "instance",
-						ExecutionID: "request./zitadel.session.v2.SessionService/SetSession",
-						TargetID:    "target",
-						TargetType:  domain.TargetTypeAsync,
-						Timeout:     time.Second,
-						SigningKey:  "signingkey",
+						InstanceID:       "instance",
+						ExecutionID:      "request./zitadel.session.v2.SessionService/SetSession",
+						TargetID:         "target",
+						TargetType:       domain.TargetTypeAsync,
+						Timeout:          time.Second,
+						InterruptOnError: true,
+						SigningKey:       "signingkey",
 					},
 				},
 				targets: []target{
 					{
 						reqBody:    newMockContextInfoRequest("/service/method", "content"),
 						respBody:   newMockContentRequest("content1"),
-						sleep:      5 * time.Second,
+						sleep:      0,
 						statusCode: http.StatusOK,
 					},
 				},
-				req: newMockContentRequest("content"),
+				req:           newMockContentRequest("content"),
+				deliveriesErr: io.ErrUnexpectedEOF,
 			},
 			res{
-				want: newMockContentRequest("content"),
+				wantErr: true,
 			},
 		},
 		{
@@ -349,7 +382,8 @@ func Test_executeTargetsForGRPCFullMethod_request(t *testing.T) {
 				req: newMockContentRequest("content"),
 			},
 			res{
-				want: newMockContentRequest("content"),
+				want:   newMockContentRequest("content"),
+				queued: []string{"target"},
 			},
 		},
 		{
@@ -579,11 +613,14 @@ func Test_executeTargetsForGRPCFullMethod_request(t *testing.T) {
 				closeFuncs[i] = closeF
 			}
 
+			deliveries := &mockDeliveries{err: tt.args.deliveriesErr}
 			resp, err := executeTargetsForRequest(
 				tt.args.ctx,
 				tt.args.executionTargets,
 				tt.args.fullMethod,
 				tt.args.req,
+				nil,
+				deliveries,
 			)
 
 			if tt.res.wantErr {
@@ -592,6 +629,7 @@ func Test_executeTargetsForGRPCFullMethod_request(t *testing.T) {
 				assert.NoError(t, err)
 			}
 			assert.Equal(t, tt.res.want, resp)
+			assert.Equal(t, tt.res.queued, deliveries.queued)
 
 			for _, closeF := range closeFuncs {
 				closeF()
@@ -783,6 +821,8 @@ func Test_executeTargetsForGRPCFullMethod_response(t *testing.T) {
 				tt.args.fullMethod,
 				tt.args.req,
 				tt.args.resp,
+				nil,
+				&mockDeliveries{},
 			)
 
 			if tt.res.wantErr {
@@ -798,3 +838,45 @@ func Test_executeTargetsForGRPCFullMethod_response(t *testing.T) {
 		})
 	}
 }
+
+type mockUserIDMessage struct {
+	UserId string
+}
+
+func (m *mockUserIDMessage) GetUserId() string {
+	return m.UserId
+}
+
+func Test_affectedUserID(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []interface{}
+		want     string
+	}{
+		{
+			"no user message",
+			[]interface{}{&struct{}{}, nil},
+			"",
+		},
+		{
+			"empty user id",
+			[]interface{}{&mockUserIDMessage{}},
+			"",
+		},
+		{
+			"user id of request",
+			[]interface{}{&mockUserIDMessage{UserId: "request"}, &mockUserIDMessage{UserId: "response"}},
+			"request",
+		},
+		{
+			"user id of response",
+			[]interface{}{&mockUserIDMessage{}, &mockUserIDMessage{UserId: "response"}},
+			"response",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, affectedUserID(tt.messages...))
+		})
+	}
+}
@@ -128,6 +128,59 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		Risk:           riskToPb(s.Risk),
+	}
+}
+
+func riskToPb(risk query.SessionRisk) *session.Risk {
+	if risk.EvaluatedAt.IsZero() {
+		return nil
+	}
+	signals := make([]session.RiskSignal, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = riskSignalToPb(signal)
+	}
+	return &session.Risk{
+		Score:       int32(risk.Score),
+		Action:      riskActionToPb(risk.Action),
+		Signals:     signals,
+		EvaluatedAt: timestamppb.New(risk.EvaluatedAt),
+	}
+}
+
+func riskActionToPb(action domain.RiskAction) session.RiskAction {
+	switch action {
+	case domain.RiskActionAllow:
+		return session.RiskAction_RISK_ACTION_ALLOW
+	case domain.RiskActionNotify:
+		return session.RiskAction_RISK_ACTION_NOTIFY
+	case domain.RiskActionRequireMFA:
+		return session.RiskAction_RISK_ACTION_REQUIRE_MFA
+	case domain.RiskActionBlock:
+		return session.RiskAction_RISK_ACTION_BLOCK
+	case domain.RiskActionUnspecified:
+		return session.RiskAction_RISK_ACTION_UNSPECIFIED
+	default:
+		return session.RiskAction_RISK_ACTION_UNSPECIFIED
+	}
+}
+
+func riskSignalToPb(signal domain.RiskSignal) session.RiskSignal {
+	switch signal {
+	case domain.RiskSignalNewCountry:
+		return session.RiskSignal_RISK_SIGNAL_NEW_COUNTRY
+	case domain.RiskSignalNewASN:
+		return session.RiskSignal_RISK_SIGNAL_NEW_ASN
+	case domain.RiskSignalImpossibleTravel:
+		return session.RiskSignal_RISK_SIGNAL_IMPOSSIBLE_TRAVEL
+	case domain.RiskSignalNewDevice:
+		return session.RiskSignal_RISK_SIGNAL_NEW_DEVICE
+	case domain.RiskSignalIPFailures:
+		return session.RiskSignal_RISK_SIGNAL_IP_FAILURES
+	case domain.RiskSignalUnspecified:
+		return session.RiskSignal_RISK_SIGNAL_UNSPECIFIED
+	default:
+		return session.RiskSignal_RISK_SIGNAL_UNSPECIFIED
 	}
 }
 
@@ -168,13 +221,14 @@ func factorsToPb(s *query.Session) *session.Factors {
 		return nil
 	}
 	return &session.Factors{
-		User:     user,
-		Password: passwordFactorToPb(s.PasswordFactor),
-		WebAuthN: webAuthNFactorToPb(s.WebAuthNFactor),
-		Intent:   intentFactorToPb(s.IntentFactor),
-		Totp:     totpFactorToPb(s.TOTPFactor),
-		OtpSms:   otpFactorToPb(s.OTPSMSFactor),
-		OtpEmail: otpFactorToPb(s.OTPEmailFactor),
+		User:          user,
+		Password:      passwordFactorToPb(s.PasswordFactor),
+		WebAuthN:      webAuthNFactorToPb(s.WebAuthNFactor),
+		Intent:        intentFactorToPb(s.IntentFactor),
+		Totp:          totpFactorToPb(s.TOTPFactor),
+		OtpSms:        otpFactorToPb(s.OTPSMSFactor),
+		OtpEmail:      otpFactorToPb(s.OTPEmailFactor),
+		TrustedDevice: trustedDeviceFactorToPb(s.TrustedDeviceFactor),
 	}
 }
 
@@ -224,6 +278,15 @@ func otpFactorToPb(factor query.SessionOTPFactor) *session.OTPFactor {
 	}
 }
 
+func trustedDeviceFactorToPb(factor query.SessionTrustedDeviceFactor) *session.TrustedDeviceFactor {
+	if factor.TrustedDeviceCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.TrustedDeviceFactor{
+		VerifiedAt: timestamppb.New(factor.TrustedDeviceCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
@@ -340,7 +403,7 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if err != nil {
 		return nil, err
 	}
-	sessionChecks := make([]command.SessionCommand, 0, 7)
+	sessionChecks := make([]command.SessionCommand, 0, 8)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
 		if err != nil {
@@ -374,6 +437,9 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if otp := checks.GetOtpEmail(); otp != nil {
 		sessionChecks = append(sessionChecks, command.CheckOTPEmail(otp.GetCode()))
 	}
+	if device := checks.GetTrustedDevice(); device != nil {
+		sessionChecks = append(sessionChecks, command.CheckTrustedDevice(device.GetTrust()))
+	}
 	return sessionChecks, nil
 }
 
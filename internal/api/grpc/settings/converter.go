@@ -1,7 +1,10 @@
 package settings
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	obj_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
 )
@@ -13,3 +16,71 @@ func NotificationProviderToPb(provider *query.DebugNotificationProvider) *settin
 	}
 	return mapped
 }
+
+func NotificationDeliveriesToPb(deliveries []*query.NotificationDelivery) []*settings_pb.NotificationDelivery {
+	result := make([]*settings_pb.NotificationDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = NotificationDeliveryToPb(delivery)
+	}
+	return result
+}
+
+func NotificationDeliveryToPb(delivery *query.NotificationDelivery) *settings_pb.NotificationDelivery {
+	return &settings_pb.NotificationDelivery{
+		Details:           obj_pb.ToViewDetailsPb(delivery.Sequence, delivery.CreationDate, delivery.ChangeDate, delivery.ResourceOwner),
+		Id:                delivery.ID,
+		CreationDate:      timestamppb.New(delivery.CreationDate),
+		UserId:            delivery.UserID,
+		UserResourceOwner: delivery.UserResourceOwner,
+		MessageType:       delivery.MessageType,
+		Channel:           notificationChannelToPb(delivery.Channel),
+		ProviderId:        delivery.ProviderID,
+		ProviderMessageId: delivery.ProviderMessageID,
+		RecipientHash:     delivery.RecipientHash,
+		Attempts:          delivery.Attempts,
+		Status:            settings_pb.NotificationDeliveryStatus(delivery.Status),
+		Error:             delivery.Error,
+	}
+}
+
+func notificationChannelToPb(channel domain.NotificationType) settings_pb.NotificationChannel {
+	if channel == domain.NotificationTypeSms {
+		return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	}
+	return settings_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+}
+
+// NotificationDeliveryFiltersToQuery maps the optional filters of the list requests.
+// The restriction to the instance or organization has to be added by the caller.
+func NotificationDeliveryFiltersToQuery(userID string, from, to *timestamppb.Timestamp, status settings_pb.NotificationDeliveryStatus) ([]query.SearchQuery, error) {
+	queries := make([]query.SearchQuery, 0, 4)
+	if userID != "" {
+		q, err := query.NewNotificationDeliveryUserIDSearchQuery(userID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	if from != nil {
+		q, err := query.NewNotificationDeliveryCreationDateSearchQuery(from.AsTime(), query.TimestampGreaterOrEquals)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	if to != nil {
+		q, err := query.NewNotificationDeliveryCreationDateSearchQuery(to.AsTime(), query.TimestampLess)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	if status != settings_pb.NotificationDeliveryStatus_NOTIFICATION_DELIVERY_STATUS_UNSPECIFIED {
+		q, err := query.NewNotificationDeliveryStatusSearchQuery(domain.NotificationDeliveryStatus(status))
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	return queries, nil
+}
@@ -39,6 +39,8 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(current.TrustedDeviceLifetime)),
+		RiskThresholds:             riskThresholdsToPb(current.RiskThresholds),
 		SecondFactors:              second,
 		MultiFactors:               multi,
 		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
@@ -52,6 +54,14 @@ func isDefaultToResourceOwnerTypePb(isDefault bool) settings.ResourceOwnerType {
 	return settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_ORG
 }
 
+func riskThresholdsToPb(thresholds domain.RiskThresholds) *settings.RiskThresholds {
+	return &settings.RiskThresholds{
+		Notify:     uint32(thresholds.Notify),
+		RequireMfa: uint32(thresholds.RequireMFA),
+		Block:      uint32(thresholds.Block),
+	}
+}
+
 func passkeysTypeToPb(passwordlessType domain.PasswordlessType) settings.PasskeysType {
 	switch passwordlessType {
 	case domain.PasswordlessTypeAllowed:
@@ -40,6 +40,12 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MFAInitSkipLifetime:        database.Duration(time.Millisecond),
 		SecondFactorCheckLifetime:  database.Duration(time.Microsecond),
 		MultiFactorCheckLifetime:   database.Duration(time.Nanosecond),
+		TrustedDeviceLifetime:      database.Duration(time.Hour),
+		RiskThresholds: domain.RiskThresholds{
+			Notify:     20,
+			RequireMFA: 40,
+			Block:      80,
+		},
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -70,6 +76,12 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
 		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
 		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		TrustedDeviceLifetime:      durationpb.New(time.Hour),
+		RiskThresholds: &settings.RiskThresholds{
+			Notify:     20,
+			RequireMfa: 40,
+			Block:      80,
+		},
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
@@ -0,0 +1,51 @@
+package text
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	text_pb "github.com/zitadel/zitadel/pkg/grpc/text"
+)
+
+func MessageTemplateToPb(template *query.MessageTemplate) *text_pb.MessageTemplate {
+	return &text_pb.MessageTemplate{
+		Details: object.ToViewDetailsPb(
+			template.Sequence,
+			template.CreationDate,
+			template.ChangeDate,
+			template.AggregateID,
+		),
+		MessageType: template.MessageType,
+		Language:    template.Language.String(),
+		Format:      MessageTemplateFormatToPb(template.Format),
+		StoreKey:    template.StoreKey,
+		Template:    template.Template,
+		IsDefault:   template.IsDefault,
+	}
+}
+
+func MessageTemplateFormatToPb(format domain.MessageTemplateFormat) text_pb.MessageTemplateFormat {
+	switch format {
+	case domain.MessageTemplateFormatHTML:
+		return text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_HTML
+	case domain.MessageTemplateFormatMJML:
+		return text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_MJML
+	case domain.MessageTemplateFormatUnspecified:
+		fallthrough
+	default:
+		return text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_UNSPECIFIED
+	}
+}
+
+func MessageTemplateFormatToDomain(format text_pb.MessageTemplateFormat) domain.MessageTemplateFormat {
+	switch format {
+	case text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_HTML:
+		return domain.MessageTemplateFormatHTML
+	case text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_MJML:
+		return domain.MessageTemplateFormatMJML
+	case text_pb.MessageTemplateFormat_MESSAGE_TEMPLATE_FORMAT_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.MessageTemplateFormatUnspecified
+	}
+}
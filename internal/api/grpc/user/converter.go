@@ -266,3 +266,63 @@ func TypeToPb(userType domain.UserType) user_pb.Type {
 		return user_pb.Type_TYPE_UNSPECIFIED
 	}
 }
+
+func ExpiringPasswordsToPb(passwords []*query.ExpiringPassword) []*user_pb.ExpiringPassword {
+	p := make([]*user_pb.ExpiringPassword, len(passwords))
+	for i, password := range passwords {
+		p[i] = &user_pb.ExpiringPassword{
+			UserId:          password.UserID,
+			UserName:        password.Username,
+			PasswordChanged: timestamppb.New(password.PasswordChanged),
+			ExpirationDate:  timestamppb.New(password.ExpirationDate),
+			Threshold:       PasswordExpiryThresholdToPb(password.Threshold),
+		}
+	}
+	return p
+}
+
+func PasswordExpiryThresholdToPb(threshold domain.PasswordExpiryThreshold) user_pb.PasswordExpiryThreshold {
+	switch threshold {
+	case domain.PasswordExpiryThresholdWarning:
+		return user_pb.PasswordExpiryThreshold_PASSWORD_EXPIRY_THRESHOLD_WARNING
+	case domain.PasswordExpiryThresholdExpired:
+		return user_pb.PasswordExpiryThreshold_PASSWORD_EXPIRY_THRESHOLD_EXPIRED
+	case domain.PasswordExpiryThresholdUnspecified:
+		return user_pb.PasswordExpiryThreshold_PASSWORD_EXPIRY_THRESHOLD_UNSPECIFIED
+	default:
+		return user_pb.PasswordExpiryThreshold_PASSWORD_EXPIRY_THRESHOLD_UNSPECIFIED
+	}
+}
+
+func DataExportsToPb(exports []*query.UserDataExport) []*user_pb.DataExport {
+	e := make([]*user_pb.DataExport, len(exports))
+	for i, export := range exports {
+		e[i] = &user_pb.DataExport{
+			Id: export.ID,
+			Details: object.ToViewDetailsPb(
+				export.Sequence,
+				export.CreationDate,
+				export.ChangeDate,
+				export.ResourceOwner,
+			),
+			State:        DataExportStateToPb(export.State),
+			CreationDate: timestamppb.New(export.CreationDate),
+		}
+	}
+	return e
+}
+
+func DataExportStateToPb(state domain.UserDataExportState) user_pb.DataExportState {
+	switch state {
+	case domain.UserDataExportStateRequested:
+		return user_pb.DataExportState_DATA_EXPORT_STATE_REQUESTED
+	case domain.UserDataExportStateReady:
+		return user_pb.DataExportState_DATA_EXPORT_STATE_READY
+	case domain.UserDataExportStateDownloaded:
+		return user_pb.DataExportState_DATA_EXPORT_STATE_DOWNLOADED
+	case domain.UserDataExportStateUnspecified:
+		return user_pb.DataExportState_DATA_EXPORT_STATE_UNSPECIFIED
+	default:
+		return user_pb.DataExportState_DATA_EXPORT_STATE_UNSPECIFIED
+	}
+}
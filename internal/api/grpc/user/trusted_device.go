@@ -0,0 +1,28 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func TrustedDevicesToPb(devices []*query.TrustedDevice) []*user.TrustedDevice {
+	d := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		d[i] = TrustedDeviceToPb(device)
+	}
+	return d
+}
+
+func TrustedDeviceToPb(device *query.TrustedDevice) *user.TrustedDevice {
+	return &user.TrustedDevice{
+		Details:     object.ToViewDetailsPb(device.Sequence, device.CreationDate, device.ChangeDate, device.ResourceOwner),
+		DeviceId:    device.DeviceID,
+		TrustedAt:   timestamppb.New(device.TrustedAt),
+		LastSeen:    timestamppb.New(device.LastSeen),
+		Fingerprint: device.Fingerprint,
+		Ip:          device.IP,
+	}
+}
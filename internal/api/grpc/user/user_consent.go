@@ -0,0 +1,25 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func UserConsentsToPb(consents []*query.UserConsent) []*user.UserConsent {
+	c := make([]*user.UserConsent, len(consents))
+	for i, consent := range consents {
+		c[i] = UserConsentToPb(consent)
+	}
+	return c
+}
+
+func UserConsentToPb(consent *query.UserConsent) *user.UserConsent {
+	return &user.UserConsent{
+		Details:   object.ToViewDetailsPb(consent.Sequence, consent.CreationDate, consent.ChangeDate, consent.ResourceOwner),
+		ClientId:  consent.ClientID,
+		ProjectId: consent.ProjectID,
+		AppName:   consent.AppName,
+		Scopes:    consent.Scopes,
+	}
+}
@@ -3,6 +3,9 @@ package user
 import (
 	"context"
 	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
@@ -42,6 +45,8 @@ func UserGrantToPb(assetPrefix string, grant *query.UserGrant) *user_pb.UserGran
 		GrantedOrgId:       grant.GrantedOrgID,
 		GrantedOrgName:     grant.GrantedOrgName,
 		GrantedOrgDomain:   grant.GrantedOrgDomain,
+		ValidFrom:          timestampOrNil(grant.ValidFrom),
+		ValidUntil:         timestampOrNil(grant.ValidUntil),
 		Details: object.ToViewDetailsPb(
 			grant.Sequence,
 			grant.CreationDate,
@@ -51,6 +56,13 @@ func UserGrantToPb(assetPrefix string, grant *query.UserGrant) *user_pb.UserGran
 	}
 }
 
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 func UserGrantStateToPb(state domain.UserGrantState) user_pb.UserGrantState {
 	switch state {
 	case domain.UserGrantStateActive:
@@ -0,0 +1,128 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func UserImportJobsToPb(jobs []*query.UserImportJob) []*user_pb.UserImportJob {
+	j := make([]*user_pb.UserImportJob, len(jobs))
+	for i, job := range jobs {
+		j[i] = UserImportJobToPb(job)
+	}
+	return j
+}
+
+func UserImportJobToPb(job *query.UserImportJob) *user_pb.UserImportJob {
+	return &user_pb.UserImportJob{
+		Id:            job.ID,
+		Details:       object.ToViewDetailsPb(job.Sequence, job.CreationDate, job.ChangeDate, job.ResourceOwner),
+		State:         userImportJobStateToPb(job.State),
+		Format:        UserImportFormatToPb(job.Format),
+		DryRun:        job.DryRun,
+		TotalRows:     job.TotalRows,
+		ProcessedRows: job.ProcessedRows,
+		FailedRows:    job.FailedRows,
+		Creator:       job.Creator,
+		FailureReason: job.FailureReason,
+	}
+}
+
+func UserImportRowErrorsToPb(rowErrors []*query.UserImportJobError) []*user_pb.UserImportRowError {
+	e := make([]*user_pb.UserImportRowError, len(rowErrors))
+	for i, rowError := range rowErrors {
+		e[i] = &user_pb.UserImportRowError{
+			Row:     rowError.Row,
+			UserId:  rowError.UserID,
+			Message: rowError.Message,
+		}
+	}
+	return e
+}
+
+func UserImportFormatToPb(format domain.UserImportFormat) user_pb.UserImportFormat {
+	switch format {
+	case domain.UserImportFormatCSV:
+		return user_pb.UserImportFormat_USER_IMPORT_FORMAT_CSV
+	case domain.UserImportFormatNDJSON:
+		return user_pb.UserImportFormat_USER_IMPORT_FORMAT_NDJSON
+	case domain.UserImportFormatUnspecified:
+		fallthrough
+	default:
+		return user_pb.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func UserImportFormatToDomain(format user_pb.UserImportFormat) domain.UserImportFormat {
+	switch format {
+	case user_pb.UserImportFormat_USER_IMPORT_FORMAT_CSV:
+		return domain.UserImportFormatCSV
+	case user_pb.UserImportFormat_USER_IMPORT_FORMAT_NDJSON:
+		return domain.UserImportFormatNDJSON
+	case user_pb.UserImportFormat_USER_IMPORT_FORMAT_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.UserImportFormatUnspecified
+	}
+}
+
+func userImportJobStateToPb(state domain.UserImportJobState) user_pb.UserImportJobState {
+	switch state {
+	case domain.UserImportJobStateQueued:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_QUEUED
+	case domain.UserImportJobStateRunning:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_RUNNING
+	case domain.UserImportJobStateCompleted:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_COMPLETED
+	case domain.UserImportJobStateCanceled:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_CANCELED
+	case domain.UserImportJobStateFailed:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_FAILED
+	case domain.UserImportJobStateUnspecified:
+		fallthrough
+	default:
+		return user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_UNSPECIFIED
+	}
+}
+
+func userImportJobStateToDomain(state user_pb.UserImportJobState) domain.UserImportJobState {
+	switch state {
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_QUEUED:
+		return domain.UserImportJobStateQueued
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_RUNNING:
+		return domain.UserImportJobStateRunning
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_COMPLETED:
+		return domain.UserImportJobStateCompleted
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_CANCELED:
+		return domain.UserImportJobStateCanceled
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_FAILED:
+		return domain.UserImportJobStateFailed
+	case user_pb.UserImportJobState_USER_IMPORT_JOB_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.UserImportJobStateUnspecified
+	}
+}
+
+func UserImportJobQueriesToModel(queries []*user_pb.UserImportJobQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = UserImportJobQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func UserImportJobQueryToModel(apiQuery *user_pb.UserImportJobQuery) (query.SearchQuery, error) {
+	switch q := apiQuery.Query.(type) {
+	case *user_pb.UserImportJobQuery_StateQuery:
+		return query.NewUserImportJobStateSearchQuery(userImportJobStateToDomain(q.StateQuery.State))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-Ui5aAa", "List.Query.Invalid")
+	}
+}
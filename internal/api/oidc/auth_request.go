@@ -81,6 +81,7 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		Prompt:           PromptToBusiness(req.Prompt),
 		UILocales:        UILocalesToBusiness(req.UILocales),
 		MaxAge:           MaxAgeToBusiness(req.MaxAge),
+		ACRValues:        req.ACRValues,
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -541,6 +542,9 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 		if err != nil {
 			return nil, err
 		}
+		if authReq.ConsentDenied {
+			return authReq, oidc.ErrAccessDenied().WithDescription("The user denied the consent.")
+		}
 		if !authReq.Done() {
 			return authReq, oidc.ErrInteractionRequired().WithDescription("Unfortunately, the user may be not logged in and/or additional interaction is required.")
 		}
@@ -593,6 +597,7 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		authReq.Audience,
 		authReq.AuthMethods(),
 		authReq.AuthTime,
+		authReq.ACR,
 		authReq.GetNonce(),
 		authReq.PreferredLanguage,
 		authReq.ToUserAgent(),
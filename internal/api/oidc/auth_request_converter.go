@@ -27,8 +27,7 @@ func (a *AuthRequest) GetID() string {
 }
 
 func (a *AuthRequest) GetACR() string {
-	// return a.
-	return "" //PLANNED: impl
+	return a.ACR
 }
 
 func (a *AuthRequest) GetAMR() []string {
@@ -112,6 +111,7 @@ func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest,
 		TransferState:       authReq.State,
 		Prompt:              PromptToBusiness(authReq.Prompt),
 		PossibleLOAs:        ACRValuesToBusiness(authReq.ACRValues),
+		ACRValues:           authReq.ACRValues,
 		UiLocales:           UILocalesToBusiness(authReq.UILocales),
 		LoginHint:           authReq.LoginHint,
 		SelectedIDPConfigID: GetSelectedIDPIDFromScopes(authReq.Scopes),
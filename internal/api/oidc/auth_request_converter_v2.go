@@ -17,7 +17,7 @@ func (a *AuthRequestV2) GetID() string {
 }
 
 func (a *AuthRequestV2) GetACR() string {
-	return "" //PLANNED: impl
+	return a.ACR
 }
 
 func (a *AuthRequestV2) GetAMR() []string {
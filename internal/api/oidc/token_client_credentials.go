@@ -41,6 +41,7 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
 		time.Now(),
 		"",
+		"",
 		nil,
 		nil,
 		domain.TokenReasonClientCredentials,
@@ -80,6 +80,7 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 		authReq.Audience,
 		authReq.AuthMethods(),
 		authReq.AuthTime,
+		authReq.ACR,
 		authReq.GetNonce(),
 		authReq.PreferredLanguage,
 		authReq.ToUserAgent(),
@@ -51,6 +51,7 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		[]domain.UserAuthMethodType{domain.UserAuthMethodTypePrivateKey},
 		time.Now(),
 		"",
+		"",
 		nil,
 		nil,
 		domain.TokenReasonJWTProfile,
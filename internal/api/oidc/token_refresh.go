@@ -60,6 +60,7 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		AMRToAuthMethodTypes(refreshToken.AuthMethodsReferences),
 		refreshToken.AuthTime,
 		"",
+		"",
 		nil, // Preferred language not in refresh token view
 		&domain.UserAgent{
 			FingerprintID: &refreshToken.UserAgentID,
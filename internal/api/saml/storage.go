@@ -3,6 +3,7 @@ package saml
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/dop251/goja"
@@ -140,12 +141,26 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, applicationID strin
 		return err
 	}
 
+	options, err := p.getSAMLAppOptions(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+
 	customAttributes, err := p.getCustomAttributes(ctx, user, userGrants)
 	if err != nil {
 		return err
 	}
+	mappedAttributes, err := p.getMappedAttributes(ctx, user, userGrants, options)
+	if err != nil {
+		return err
+	}
+	for name, attr := range mappedAttributes {
+		if _, ok := customAttributes[name]; !ok {
+			customAttributes[name] = attr
+		}
+	}
 
-	setUserinfo(user, userinfo, attributes, customAttributes)
+	setUserinfo(user, userinfo, attributes, customAttributes, options)
 
 	// trigger activity log for authentication for user
 	activity.Trigger(ctx, user.ResourceOwner, user.ID, activity.SAMLResponse, p.eventstore.FilterToQueryReducer)
@@ -164,52 +179,142 @@ func (p *Storage) SetUserinfoWithLoginName(ctx context.Context, userinfo models.
 		return zerrors.ThrowPreconditionFailed(nil, "SAML-FJ262", "Errors.User.NotActive")
 	}
 
-	setUserinfo(user, userinfo, attributes, map[string]*customAttribute{})
+	setUserinfo(user, userinfo, attributes, map[string]*customAttribute{}, nil)
 	return nil
 }
 
-func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes []int, customAttributes map[string]*customAttribute) {
+func setUserinfo(user *query.User, userinfo models.AttributeSetter, attributes []int, customAttributes map[string]*customAttribute, options *domain.SAMLAppOptions) {
 	for name, attr := range customAttributes {
 		userinfo.SetCustomAttribute(name, "", attr.nameFormat, attr.attributeValue)
 	}
+	var mapping *domain.SAMLAttributeMapping
+	if options != nil {
+		mapping = options.AttributeMapping
+	}
 	if len(attributes) == 0 {
-		userinfo.SetUsername(user.PreferredLoginName)
-		userinfo.SetUserID(user.ID)
+		// the username is used as NameID of the subject
+		userinfo.SetUsername(nameIDValue(user, options))
+		if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeUserID) {
+			userinfo.SetUserID(user.ID)
+		}
 		if user.Human == nil {
 			return
 		}
-		userinfo.SetEmail(string(user.Human.Email))
-		userinfo.SetSurname(user.Human.LastName)
-		userinfo.SetGivenName(user.Human.FirstName)
-		userinfo.SetFullName(user.Human.DisplayName)
+		if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeEmail) {
+			userinfo.SetEmail(string(user.Human.Email))
+		}
+		if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeSurname) {
+			userinfo.SetSurname(user.Human.LastName)
+		}
+		if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeGivenName) {
+			userinfo.SetGivenName(user.Human.FirstName)
+		}
+		if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeFullName) {
+			userinfo.SetFullName(user.Human.DisplayName)
+		}
 		return
 	}
 	for _, attribute := range attributes {
 		switch attribute {
 		case provider.AttributeEmail:
-			if user.Human != nil {
+			if user.Human != nil && mapping.ReleasesUserAttribute(domain.SAMLUserAttributeEmail) {
 				userinfo.SetEmail(string(user.Human.Email))
 			}
 		case provider.AttributeSurname:
-			if user.Human != nil {
+			if user.Human != nil && mapping.ReleasesUserAttribute(domain.SAMLUserAttributeSurname) {
 				userinfo.SetSurname(user.Human.LastName)
 			}
 		case provider.AttributeFullName:
-			if user.Human != nil {
+			if user.Human != nil && mapping.ReleasesUserAttribute(domain.SAMLUserAttributeFullName) {
 				userinfo.SetFullName(user.Human.DisplayName)
 			}
 		case provider.AttributeGivenName:
-			if user.Human != nil {
+			if user.Human != nil && mapping.ReleasesUserAttribute(domain.SAMLUserAttributeGivenName) {
 				userinfo.SetGivenName(user.Human.FirstName)
 			}
 		case provider.AttributeUsername:
-			userinfo.SetUsername(user.PreferredLoginName)
+			userinfo.SetUsername(nameIDValue(user, options))
 		case provider.AttributeUserID:
-			userinfo.SetUserID(user.ID)
+			if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeUserID) {
+				userinfo.SetUserID(user.ID)
+			}
 		}
 	}
 }
 
+// nameIDValue returns the value used as NameID of the subject based on the configured NameID format.
+// The subject is always required, so the ID of the user is used if the username (or email) is not released by the attribute mapping.
+func nameIDValue(user *query.User, options *domain.SAMLAppOptions) string {
+	if options == nil {
+		return user.PreferredLoginName
+	}
+	mapping := options.AttributeMapping
+	switch options.NameIDFormat {
+	case domain.SAMLNameIDFormatEmailAddress:
+		if user.Human != nil && user.Human.Email != "" && mapping.ReleasesUserAttribute(domain.SAMLUserAttributeEmail) {
+			return string(user.Human.Email)
+		}
+	case domain.SAMLNameIDFormatPersistent:
+		return user.ID
+	case domain.SAMLNameIDFormatUnspecified,
+		domain.SAMLNameIDFormatTransient:
+	}
+	if mapping.ReleasesUserAttribute(domain.SAMLUserAttributeUsername) {
+		return user.PreferredLoginName
+	}
+	return user.ID
+}
+
+func (p *Storage) getSAMLAppOptions(ctx context.Context, applicationID string) (*domain.SAMLAppOptions, error) {
+	app, err := p.query.AppByID(ctx, applicationID, true)
+	if err != nil {
+		return nil, err
+	}
+	if app.SAMLConfig == nil {
+		return nil, nil
+	}
+	return app.SAMLConfig.Options, nil
+}
+
+// getMappedAttributes returns the metadata and roles of the user, which are released based on the attribute mapping of the application.
+func (p *Storage) getMappedAttributes(ctx context.Context, user *query.User, userGrants *query.UserGrants, options *domain.SAMLAppOptions) (map[string]*customAttribute, error) {
+	mappedAttributes := make(map[string]*customAttribute)
+	if options == nil || options.AttributeMapping == nil {
+		return mappedAttributes, nil
+	}
+	mapping := options.AttributeMapping
+	if len(mapping.MetadataKeys) > 0 {
+		resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(user.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		metadata, err := p.query.SearchUserMetadata(ctx, true, user.ID, &query.UserMetadataSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}}, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, md := range metadata.Metadata {
+			if !slices.Contains(mapping.MetadataKeys, md.Key) {
+				continue
+			}
+			mappedAttributes = appendCustomAttribute(mappedAttributes, md.Key, attributeNameFormatBasic, []string{string(md.Value)})
+		}
+	}
+	if mapping.RolesAttributeName != "" && userGrants != nil {
+		roles := make([]string, 0)
+		for _, grant := range userGrants.UserGrants {
+			for _, role := range grant.Roles {
+				if !slices.Contains(roles, role) {
+					roles = append(roles, role)
+				}
+			}
+		}
+		if len(roles) > 0 {
+			mappedAttributes = appendCustomAttribute(mappedAttributes, mapping.RolesAttributeName, attributeNameFormatBasic, roles)
+		}
+	}
+	return mappedAttributes, nil
+}
+
 func (p *Storage) getCustomAttributes(ctx context.Context, user *query.User, userGrants *query.UserGrants) (map[string]*customAttribute, error) {
 	customAttributes := make(map[string]*customAttribute, 0)
 	queriedActions, err := p.query.GetActiveActionsByFlowAndTriggerType(ctx, domain.FlowTypeCustomizeSAMLResponse, domain.TriggerTypePreSAMLResponseCreation, user.ResourceOwner)
@@ -334,15 +439,22 @@ func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (
 	if err != nil {
 		return nil, err
 	}
+	validQuery, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	return p.query.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: []query.SearchQuery{
 			projectQuery,
 			userIDQuery,
 			activeQuery,
+			validQuery,
 		},
 	}, true)
 }
 
+const attributeNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+
 type customAttribute struct {
 	nameFormat     string
 	attributeValue []string
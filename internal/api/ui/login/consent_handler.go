@@ -0,0 +1,66 @@
+package login
+
+import (
+	"net/http"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	tmplConsent = "consent"
+)
+
+type consentFormData struct {
+	Deny bool `schema:"deny"`
+}
+
+type consentData struct {
+	userData
+	AppName string
+	Scopes  []string
+}
+
+func (l *Login) handleConsent(w http.ResponseWriter, r *http.Request) {
+	data := new(consentFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if data.Deny {
+		err = l.authRepo.DenyConsent(setContext(r.Context(), authReq.UserOrgID), authReq.ID, userAgentID)
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		l.renderNextStep(w, r, authReq)
+		return
+	}
+	err = l.authRepo.GrantConsent(setContext(r.Context(), authReq.UserOrgID), authReq.ID, userAgentID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.ConsentStep, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := consentData{
+		userData: l.getUserData(r, authReq, translator, "Consent.Title", "", errID, errMessage),
+		Scopes:   step.Scopes,
+	}
+	app, err := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID)
+	if err != nil {
+		l.renderInternalError(w, r, authReq, err)
+		return
+	}
+	data.AppName = app.Name
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplConsent], data, nil)
+}
@@ -0,0 +1,113 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	tmplGrantRequired = "grantrequired"
+)
+
+type grantRequiredFormData struct {
+	RoleKeys []string `schema:"roleKeys"`
+	Reason   string   `schema:"reason"`
+}
+
+type grantRequiredData struct {
+	userData
+	ProjectName string
+	Roles       []*query.ProjectRole
+	// Pending is set if the user already requested access to the project
+	Pending bool
+}
+
+func (l *Login) handleGrantRequired(w http.ResponseWriter, r *http.Request) {
+	data := new(grantRequiredFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	project, err := l.query.ProjectByClientID(r.Context(), authReq.ApplicationID)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	_, err = l.command.RequestAccess(setContext(r.Context(), authReq.UserOrgID), &domain.AccessRequest{
+		UserID:    authReq.UserID,
+		ProjectID: project.ID,
+		RoleKeys:  data.RoleKeys,
+		Reason:    data.Reason,
+	})
+	l.renderGrantRequired(w, r, authReq, err)
+}
+
+func (l *Login) renderGrantRequired(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := grantRequiredData{
+		userData: l.getUserData(r, authReq, translator, "GrantRequired.Title", "GrantRequired.Description", errID, errMessage),
+	}
+	project, err := l.query.ProjectByClientID(r.Context(), authReq.ApplicationID)
+	if err != nil {
+		l.renderInternalError(w, r, authReq, err)
+		return
+	}
+	data.ProjectName = project.Name
+	data.Pending, err = l.hasPendingAccessRequest(r, authReq.UserID, project.ID)
+	if err != nil {
+		l.renderInternalError(w, r, authReq, err)
+		return
+	}
+	if !data.Pending {
+		data.Roles, err = l.projectRoles(r, project.ID)
+		if err != nil {
+			l.renderInternalError(w, r, authReq, err)
+			return
+		}
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplGrantRequired], data, nil)
+}
+
+func (l *Login) hasPendingAccessRequest(r *http.Request, userID, projectID string) (bool, error) {
+	userIDQuery, err := query.NewAccessRequestUserIDSearchQuery(userID)
+	if err != nil {
+		return false, err
+	}
+	projectIDQuery, err := query.NewAccessRequestProjectIDSearchQuery(projectID)
+	if err != nil {
+		return false, err
+	}
+	stateQuery, err := query.NewAccessRequestStateSearchQuery(domain.AccessRequestStatePending)
+	if err != nil {
+		return false, err
+	}
+	requests, err := l.query.SearchAccessRequests(r.Context(), &query.AccessRequestSearchQueries{
+		SearchRequest: query.SearchRequest{Limit: 1},
+		Queries:       []query.SearchQuery{userIDQuery, projectIDQuery, stateQuery},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(requests.AccessRequests) > 0, nil
+}
+
+func (l *Login) projectRoles(r *http.Request, projectID string) ([]*query.ProjectRole, error) {
+	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := l.query.SearchProjectRoles(r.Context(), false, &query.ProjectRoleSearchQueries{
+		Queries: []query.SearchQuery{projectIDQuery},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles.ProjectRoles, nil
+}
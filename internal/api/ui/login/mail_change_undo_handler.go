@@ -0,0 +1,89 @@
+package login
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	tmplMailChangeUndo   = "mail_change_undo"
+	tmplMailChangeUndone = "mail_change_undone"
+)
+
+type mailChangeUndoFormData struct {
+	Code   string `schema:"code"`
+	UserID string `schema:"userID"`
+}
+
+type mailChangeUndoData struct {
+	baseData
+	profileData
+	UserID string
+	Code   string
+}
+
+// MailChangeUndoLinkTemplate returns the link sent to the previous email address of a user,
+// which allows to restore it.
+func MailChangeUndoLinkTemplate(origin, userID, orgID string) string {
+	return fmt.Sprintf("%s%s?%s=%s&%s=%s&%s=%s",
+		externalLink(origin), EndpointMailChangeUndo,
+		queryUserID, userID,
+		queryCode, "{{.Code}}",
+		queryOrgID, orgID)
+}
+
+// handleMailChangeUndo only renders a confirmation,
+// so that link scanners of mail providers do not revert the change.
+func (l *Login) handleMailChangeUndo(w http.ResponseWriter, r *http.Request) {
+	userID := r.FormValue(queryUserID)
+	code := r.FormValue(queryCode)
+	if userID == "" || code == "" {
+		l.renderError(w, r, nil, nil)
+		return
+	}
+	l.renderMailChangeUndo(w, r, userID, code, nil)
+}
+
+func (l *Login) handleMailChangeUndoCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(mailChangeUndoFormData)
+	if err := l.parser.Parse(r, data); err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	orgID := r.FormValue(queryOrgID)
+	_, err := l.command.UndoHumanEmailChange(setContext(r.Context(), orgID), data.UserID, data.Code)
+	if err != nil {
+		l.renderMailChangeUndo(w, r, data.UserID, data.Code, err)
+		return
+	}
+	l.renderMailChangeUndone(w, r, orgID)
+}
+
+func (l *Login) renderMailChangeUndo(w http.ResponseWriter, r *http.Request, userID, code string, err error) {
+	var errID, errMessage string
+	if err != nil {
+		errID, errMessage = l.getErrorMessage(r, err)
+	}
+	translator := l.getTranslator(r.Context(), nil)
+	data := mailChangeUndoData{
+		baseData:    l.getBaseData(r, nil, translator, "EmailChangeUndo.Title", "EmailChangeUndo.Description", errID, errMessage),
+		profileData: l.getProfileData(nil),
+		UserID:      userID,
+		Code:        code,
+	}
+	user, err := l.query.GetUserByID(r.Context(), false, userID)
+	if err == nil {
+		l.customTexts(r.Context(), translator, user.ResourceOwner)
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMailChangeUndo], data, nil)
+}
+
+func (l *Login) renderMailChangeUndone(w http.ResponseWriter, r *http.Request, orgID string) {
+	translator := l.getTranslator(r.Context(), nil)
+	data := mailChangeUndoData{
+		baseData:    l.getBaseData(r, nil, translator, "EmailChangeUndone.Title", "EmailChangeUndone.Description", "", ""),
+		profileData: l.getProfileData(nil),
+	}
+	l.customTexts(r.Context(), translator, orgID)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMailChangeUndone], data, nil)
+}
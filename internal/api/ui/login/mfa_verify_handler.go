@@ -3,6 +3,9 @@ package login
 import (
 	"net/http"
 
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/domain"
 )
@@ -15,6 +18,7 @@ type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	RememberDevice   bool           `schema:"rememberDevice"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -48,10 +52,41 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
 			return
 		}
+		l.trustDevice(r, authReq, data.RememberDevice)
 	}
 	l.renderNextStep(w, r, authReq)
 }
 
+// trustDevice remembers the user agent of the user after a successful multi-factor verification,
+// if the user chose to and the login policy allows trusted devices.
+// A failure is only logged, since the verification itself already succeeded.
+func (l *Login) trustDevice(r *http.Request, authReq *domain.AuthRequest, remember bool) {
+	if !remember || authReq.LoginPolicy == nil || authReq.LoginPolicy.TrustedDeviceLifetime <= 0 {
+		return
+	}
+	userAgentID, ok := http_mw.UserAgentIDFromCtx(r.Context())
+	if !ok || userAgentID == "" {
+		return
+	}
+	_, err := l.command.TrustDevice(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, &domain.TrustedDevice{
+		DeviceID:    userAgentID,
+		Fingerprint: r.UserAgent(),
+		IP:          http_util.RemoteIPStringFromRequest(r),
+	})
+	logging.WithFields("authRequest", authReq.ID).OnError(err).Warn("unable to trust device")
+}
+
+// trustedDeviceSeen records the usage of the trusted device,
+// if it replaced the multi-factor verification of the succeeded authentication.
+// A failure is only logged, since the authentication itself already succeeded.
+func (l *Login) trustedDeviceSeen(r *http.Request, authReq *domain.AuthRequest) {
+	if !authReq.TrustedDeviceUsed {
+		return
+	}
+	err := l.command.TrustedDeviceSeen(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.AgentID, http_util.RemoteIPStringFromRequest(r), authReq.CreationDate)
+	logging.WithFields("authRequest", authReq.ID).OnError(err).Warn("unable to track usage of trusted device")
+}
+
 func (l *Login) renderMFAVerify(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, verificationStep *domain.MFAVerificationStep, err error) {
 	if verificationStep == nil {
 		l.renderError(w, r, authReq, err)
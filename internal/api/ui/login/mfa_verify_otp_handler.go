@@ -25,6 +25,7 @@ type mfaOTPFormData struct {
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"selectedProvider"`
 	Provider         domain.MFAType `schema:"provider"`
+	RememberDevice   bool           `schema:"rememberDevice"`
 }
 
 func OTPLink(origin, authRequestID, code string, provider domain.MFAType) string {
@@ -127,5 +128,6 @@ func (l *Login) handleOTPVerificationCheck(w http.ResponseWriter, r *http.Reques
 		l.renderOTPVerification(w, r, authReq, step.MFAProviders, formData.SelectedProvider, err)
 		return
 	}
+	l.trustDevice(r, authReq, formData.RememberDevice)
 	l.renderNextStep(w, r, authReq)
 }
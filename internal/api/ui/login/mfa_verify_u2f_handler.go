@@ -21,6 +21,7 @@ type mfaU2FData struct {
 type mfaU2FFormData struct {
 	webAuthNFormData
 	SelectedProvider domain.MFAType `schema:"provider"`
+	RememberDevice   bool           `schema:"rememberDevice"`
 }
 
 func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, err error) {
@@ -83,5 +84,6 @@ func (l *Login) handleU2FVerification(w http.ResponseWriter, r *http.Request) {
 		l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
 		return
 	}
+	l.trustDevice(r, authReq, formData.RememberDevice)
 	l.renderNextStep(w, r, authReq)
 }
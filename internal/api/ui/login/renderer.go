@@ -64,6 +64,8 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplMFAInitDone:                  "mfa_init_done.html",
 		tmplMailVerification:             "mail_verification.html",
 		tmplMailVerified:                 "mail_verified.html",
+		tmplMailChangeUndo:               "mail_change_undo.html",
+		tmplMailChangeUndone:             "mail_change_undone.html",
 		tmplInitPassword:                 "init_password.html",
 		tmplInitPasswordDone:             "init_password_done.html",
 		tmplInitUser:                     "init_user.html",
@@ -81,9 +83,11 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplLinkUsersDone:                "link_users_done.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
+		tmplConsent:                      "consent.html",
 		tmplLDAPLogin:                    "ldap_login.html",
 		tmplDeviceAuthUserCode:           "device_usercode.html",
 		tmplDeviceAuthAction:             "device_action.html",
+		tmplGrantRequired:                "grant_required.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -164,6 +168,12 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"mfaVerifyUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFAVerify)
 		},
+		"consentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointConsent)
+		},
+		"grantRequiredUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointGrantRequired)
+		},
 		"mfaPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFAPrompt)
 		},
@@ -188,6 +198,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"mailVerificationUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMailVerification)
 		},
+		"mailChangeUndoUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointMailChangeUndo)
+		},
 		"initPasswordUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointInitPassword)
 		},
@@ -310,8 +323,10 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 			l.chooseNextStep(w, r, authReq, 1, err)
 			return
 		}
+		l.trustedDeviceSeen(r, authReq)
 		l.redirectToCallback(w, r, authReq)
 	case *domain.LoginSucceededStep:
+		l.trustedDeviceSeen(r, authReq)
 		l.redirectToLoginSuccess(w, r, authReq.ID)
 	case *domain.ChangePasswordStep:
 		l.renderChangePassword(w, r, authReq, err)
@@ -329,8 +344,10 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderExternalNotFoundOption(w, r, authReq, nil, nil, nil, err)
 	case *domain.ExternalLoginStep:
 		l.handleExternalLoginStep(w, r, authReq, step.SelectedIDPConfigID)
+	case *domain.ConsentStep:
+		l.renderConsent(w, r, authReq, step, err)
 	case *domain.GrantRequiredStep:
-		l.renderInternalError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "APP-asb43", "Errors.User.GrantRequired"))
+		l.renderGrantRequired(w, r, authReq, err)
 	case *domain.ProjectRequiredStep:
 		l.renderInternalError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "APP-m92d", "Errors.User.ProjectRequired"))
 	case *domain.VerifyInviteStep:
@@ -40,6 +40,7 @@ const (
 	EndpointU2FVerification               = "/mfa/u2f/verify"
 	EndpointMailVerification              = "/mail/verification"
 	EndpointMailVerified                  = "/mail/verified"
+	EndpointMailChangeUndo                = "/mail/change/undo"
 	EndpointRegisterOption                = "/register/option"
 	EndpointRegister                      = "/register"
 	EndpointExternalRegister              = "/register/externalidp"
@@ -47,6 +48,8 @@ const (
 	EndpointRegisterOrg                   = "/register/org"
 	EndpointLogoutDone                    = "/logout/done"
 	EndpointLoginSuccess                  = "/login/success"
+	EndpointConsent                       = "/consent"
+	EndpointGrantRequired                 = "/grant/required"
 	EndpointExternalNotFoundOption        = "/externaluser/option"
 
 	EndpointResources        = "/resources"
@@ -108,6 +111,8 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointU2FVerification, login.handleU2FVerification).Methods(http.MethodPost)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerification).Methods(http.MethodGet)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerificationCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointMailChangeUndo, login.handleMailChangeUndo).Methods(http.MethodGet)
+	router.HandleFunc(EndpointMailChangeUndo, login.handleMailChangeUndoCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointChangePassword, login.handleChangePassword).Methods(http.MethodPost)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOption).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOptionCheck).Methods(http.MethodPost)
@@ -122,6 +127,8 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrg).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrgCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
+	router.HandleFunc(EndpointConsent, login.handleConsent).Methods(http.MethodPost)
+	router.HandleFunc(EndpointGrantRequired, login.handleGrantRequired).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLDAPLogin, login.handleLDAP).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPCallback, login.handleLDAPCallback).Methods(http.MethodPost)
 	router.SkipClean(true).Handle("", http.RedirectHandler(HandlerPrefix+"/", http.StatusMovedPermanently))
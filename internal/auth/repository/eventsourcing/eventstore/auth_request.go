@@ -58,6 +58,9 @@ type AuthRequestRepo struct {
 	UserGrantProvider         userGrantProvider
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
+	UserConsentProvider       userConsentProvider
+	ACRDefinitionProvider     acrDefinitionProvider
+	TrustedDeviceProvider     trustedDeviceProvider
 	CustomTextProvider        customTextProvider
 	PasswordReset             passwordReset
 	PasswordChecker           passwordChecker
@@ -111,6 +114,8 @@ type userEventProvider interface {
 
 type userCommandProvider interface {
 	BulkAddedUserIDPLinks(ctx context.Context, userID, resourceOwner string, externalIDPs []*domain.UserIDPLink) error
+	EvaluateLoginRisk(ctx context.Context, userID, resourceOwner, ip, deviceID string) (domain.RiskAction, error)
+	LoginRiskCheckFailed(ctx context.Context, userID, resourceOwner, ip string) error
 }
 
 type orgViewProvider interface {
@@ -132,6 +137,18 @@ type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string) (*query.App, error)
 }
 
+type userConsentProvider interface {
+	UserConsentByClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (*query.UserConsent, error)
+}
+
+type acrDefinitionProvider interface {
+	ACRDefinitionsByOrg(ctx context.Context, orgID string) ([]*domain.ACRDefinition, error)
+}
+
+type trustedDeviceProvider interface {
+	UserTrustedDevice(ctx context.Context, shouldTriggerBulk bool, userID, deviceID string) (*query.TrustedDevice, error)
+}
+
 type customTextProvider interface {
 	CustomTextListByTemplate(ctx context.Context, aggregateID string, text string, withOwnerRemoved bool) (texts *query.CustomTexts, err error)
 }
@@ -366,11 +383,43 @@ func (repo *AuthRequestRepo) VerifyPassword(ctx context.Context, authReqID, user
 		return err
 	}
 	err = repo.PasswordChecker.HumanCheckPassword(ctx, resourceOwner, userID, password, request.WithCurrentInfo(info))
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+	}
 	if isIgnoreUserInvalidPasswordError(err, request) {
 		// use the same errorID as above (otherwise it would expose the error reason)
 		return zerrors.ThrowInvalidArgument(nil, "EVENT-SDe2f", "Errors.User.UsernameOrPassword.Invalid")
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return repo.evaluateLoginRisk(ctx, request, info)
+}
+
+// evaluateLoginRisk assesses the sign-in of the user after a successful first factor, see [command.Commands.EvaluateLoginRisk].
+// If the assessment requires a multi-factor verification, the time is stored on the auth request,
+// so only a verification afterward satisfies the next steps.
+func (repo *AuthRequestRepo) evaluateLoginRisk(ctx context.Context, request *domain.AuthRequest, info *domain.BrowserInfo) error {
+	action, err := repo.UserCommandProvider.EvaluateLoginRisk(ctx, request.UserID, request.UserOrgID, remoteIP(info), request.AgentID)
+	if err != nil || action != domain.RiskActionRequireMFA {
+		return err
+	}
+	request.RiskMFARequiredAt = time.Now().UTC()
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
+// loginRiskCheckFailed records the failed check for the risk assessment of later sign-ins,
+// errors are only logged, so the original error of the check is returned to the user.
+func (repo *AuthRequestRepo) loginRiskCheckFailed(ctx context.Context, request *domain.AuthRequest, info *domain.BrowserInfo) {
+	err := repo.UserCommandProvider.LoginRiskCheckFailed(ctx, request.UserID, request.UserOrgID, remoteIP(info))
+	logging.WithFields("authRequest", request.ID).OnError(err).Warn("unable to record failed check for risk assessment")
+}
+
+func remoteIP(info *domain.BrowserInfo) string {
+	if info == nil || info.RemoteIP == nil {
+		return ""
+	}
+	return info.RemoteIP.String()
 }
 
 func isIgnoreUserNotFoundError(err error, request *domain.AuthRequest) bool {
@@ -404,7 +453,11 @@ func (repo *AuthRequestRepo) VerifyMFAOTP(ctx context.Context, authRequestID, us
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanCheckMFATOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	err = repo.Command.HumanCheckMFATOTP(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+	}
+	return err
 }
 
 func (repo *AuthRequestRepo) SendMFAOTPSMS(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (err error) {
@@ -425,7 +478,11 @@ func (repo *AuthRequestRepo) VerifyMFAOTPSMS(ctx context.Context, userID, resour
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanCheckOTPSMS(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	err = repo.Command.HumanCheckOTPSMS(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+	}
+	return err
 }
 
 func (repo *AuthRequestRepo) SendMFAOTPEmail(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (err error) {
@@ -446,7 +503,11 @@ func (repo *AuthRequestRepo) VerifyMFAOTPEmail(ctx context.Context, userID, reso
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	err = repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+	}
+	return err
 }
 
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
@@ -467,7 +528,11 @@ func (repo *AuthRequestRepo) VerifyMFAU2F(ctx context.Context, userID, resourceO
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request)
+	err = repo.Command.HumanFinishU2FLogin(ctx, userID, resourceOwner, credentialData, request)
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+	}
+	return err
 }
 
 func (repo *AuthRequestRepo) BeginPasswordlessSetup(ctx context.Context, userID, resourceOwner string, authenticatorPlatform domain.AuthenticatorAttachment) (login *domain.WebAuthNToken, err error) {
@@ -521,7 +586,12 @@ func (repo *AuthRequestRepo) VerifyPasswordless(ctx context.Context, userID, res
 	if err != nil {
 		return err
 	}
-	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request)
+	err = repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request)
+	if err != nil {
+		repo.loginRiskCheckFailed(ctx, request, info)
+		return err
+	}
+	return repo.evaluateLoginRisk(ctx, request, info)
 }
 
 func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) (err error) {
@@ -554,6 +624,46 @@ func (repo *AuthRequestRepo) ResetLinkingUsers(ctx context.Context, authReqID, u
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+func (repo *AuthRequestRepo) GrantConsent(ctx context.Context, authReqID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	oidcRequest, ok := request.Request.(*domain.AuthRequestOIDC)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "EVENT-Vb4nQz", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+	if err != nil {
+		return err
+	}
+	_, err = repo.Command.GrantUserConsent(ctx, request.UserID, request.UserOrgID, request.ApplicationID, app.ProjectID, oidcRequest.Scopes)
+	if err != nil {
+		return err
+	}
+	request.ConsentGranted = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
+// DenyConsent marks the consent of the auth request as denied by the user, so the request is returned
+// to the client with an access_denied error. No consent is stored for the user.
+func (repo *AuthRequestRepo) DenyConsent(ctx context.Context, authReqID, userAgentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	if _, ok := request.Request.(*domain.AuthRequestOIDC); !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "EVENT-Dn7qWe", "Errors.AuthRequest.RequestTypeNotSupported")
+	}
+	request.ConsentGranted = false
+	request.ConsentDenied = true
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) ResetSelectedIDP(ctx context.Context, authReqID, userAgentID string) error {
 	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
 	if err != nil {
@@ -970,6 +1080,8 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        time.Duration(policy.MFAInitSkipLifetime),
 		SecondFactorCheckLifetime:  time.Duration(policy.SecondFactorCheckLifetime),
 		MultiFactorCheckLifetime:   time.Duration(policy.MultiFactorCheckLifetime),
+		TrustedDeviceLifetime:      time.Duration(policy.TrustedDeviceLifetime),
+		RiskThresholds:             policy.RiskThresholds,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
 	}
@@ -1081,7 +1193,7 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		}
 	}
 
-	step, ok, err := repo.mfaChecked(userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
+	step, ok, err := repo.mfaChecked(ctx, userSession, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
 	if err != nil {
 		return nil, err
 	}
@@ -1109,8 +1221,6 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
-
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
 		return nil, err
@@ -1127,6 +1237,25 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, &domain.GrantRequiredStep{}), nil
 	}
 
+	step, err = repo.acrChecked(ctx, request, user, isInternalLogin && len(request.LinkingUsers) == 0)
+	if err != nil {
+		return nil, err
+	}
+	if step != nil {
+		return append(steps, step), nil
+	}
+
+	if request.ConsentDenied {
+		return append(steps, &domain.RedirectToCallbackStep{}), nil
+	}
+	consentStep, err := repo.consentRequired(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if consentStep != nil {
+		return append(steps, consentStep), nil
+	}
+
 	ok, err = repo.hasSucceededPage(ctx, request, repo.ApplicationProvider)
 	if err != nil {
 		return nil, err
@@ -1157,8 +1286,12 @@ func (repo *AuthRequestRepo) nextStepsUser(ctx context.Context, request *domain.
 	if domain.IsPrompt(request.Prompt, domain.PromptCreate) {
 		return append(steps, &domain.RegistrationStep{}), nil
 	}
-	// if there's a login or consent prompt, but not select account, just return the login step
-	if len(request.Prompt) > 0 && !domain.IsPrompt(request.Prompt, domain.PromptSelectAccount) {
+	// the consent prompt is handled after the user is authenticated
+	prompts := slices.DeleteFunc(slices.Clone(request.Prompt), func(prompt domain.Prompt) bool {
+		return prompt == domain.PromptConsent
+	})
+	// if there's a login prompt, but not select account, just return the login step
+	if len(prompts) > 0 && !domain.IsPrompt(prompts, domain.PromptSelectAccount) {
 		return append(steps, new(domain.LoginStep)), nil
 	} else {
 		// if no user was specified, either select_account or no prompt was provided,
@@ -1182,7 +1315,7 @@ func (repo *AuthRequestRepo) nextStepsUser(ctx context.Context, request *domain.
 			steps = append(steps, new(domain.LoginStep))
 		}
 		// if no prompt was provided, but there are multiple user sessions, then the user must decide which to use
-		if len(request.Prompt) == 0 && len(users) > 1 {
+		if len(prompts) == 0 && len(users) > 1 {
 			steps = append(steps, &domain.SelectUserStep{Users: users})
 		}
 		if len(steps) > 0 {
@@ -1315,12 +1448,15 @@ func (repo *AuthRequestRepo) idpChecked(request *domain.AuthRequest, idps []*que
 	return nil, zerrors.ThrowPreconditionFailed(nil, "LOGIN-5Hm8s", "Errors.Org.IdpNotExisting")
 }
 
-func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
+func (repo *AuthRequestRepo) mfaChecked(ctx context.Context, userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
 	mfaLevel := request.MFALevel()
 	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) {
 		return nil, true, nil
 	}
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy, isInternalAuthentication)
+	// a risky sign-in requires a multi-factor verification, as if it was forced by the login policy
+	riskMFARequired := !request.RiskMFARequiredAt.IsZero()
+	required = required || riskMFARequired
 	promptRequired := (user.MFAMaxSetUp < mfaLevel) || (len(allowedProviders) == 0 && required)
 	if promptRequired || !repo.mfaSkippedOrSetUp(user, request) {
 		types := user.MFATypesSetupPossible(mfaLevel, request.LoginPolicy)
@@ -1344,24 +1480,112 @@ func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView,
 		}
 		fallthrough
 	case domain.MFALevelSecondFactor:
-		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) {
+		if checkVerificationTimeMaxAge(userSession.SecondFactorVerification, request.LoginPolicy.SecondFactorCheckLifetime, request) &&
+			verifiedAfterRisk(userSession.SecondFactorVerification, request) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.SecondFactorVerificationType)
 			request.AuthTime = userSession.SecondFactorVerification
 			return nil, true, nil
 		}
 		fallthrough
 	case domain.MFALevelMultiFactor:
-		if checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
+		if checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) &&
+			verifiedAfterRisk(userSession.MultiFactorVerification, request) {
 			request.MFAsVerified = append(request.MFAsVerified, userSession.MultiFactorVerificationType)
 			request.AuthTime = userSession.MultiFactorVerification
 			return nil, true, nil
 		}
 	}
+	if mfaLevel <= domain.MFALevelSecondFactor && !riskMFARequired && repo.trustedDeviceChecked(ctx, request, user, isInternalAuthentication) {
+		request.TrustedDeviceUsed = true
+		return nil, true, nil
+	}
 	return &domain.MFAVerificationStep{
 		MFAProviders: allowedProviders,
 	}, false, nil
 }
 
+// verifiedAfterRisk returns false if the risk assessment of the sign-in required a multi-factor verification after the verification time
+func verifiedAfterRisk(verificationTime time.Time, request *domain.AuthRequest) bool {
+	return request.RiskMFARequiredAt.IsZero() || verificationTime.After(request.RiskMFARequiredAt)
+}
+
+// trustedDeviceChecked returns true if the user agent of the request has been trusted (remembered) by the user
+// and the trust has not yet exceeded the lifetime of the login policy.
+// A trusted device only replaces the second factor, it's never sufficient for a multi-factor requirement
+// and never replaces a multi-factor verification forced by the login policy.
+// The usage of the device is recorded by the login once the authentication succeeded.
+func (repo *AuthRequestRepo) trustedDeviceChecked(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) bool {
+	policy := request.LoginPolicy
+	if policy == nil || policy.TrustedDeviceLifetime <= 0 || request.AgentID == "" {
+		return false
+	}
+	if policy.ForceMFA || (policy.ForceMFALocalOnly && isInternalAuthentication) {
+		return false
+	}
+	device, err := repo.TrustedDeviceProvider.UserTrustedDevice(ctx, false, user.ID, request.AgentID)
+	if err != nil {
+		logging.WithFields("authRequest", request.ID).OnError(err).Debug("trusted device not found")
+		return false
+	}
+	return domain.TrustedDeviceValid(device.TrustedAt, policy.TrustedDeviceLifetime)
+}
+
+// acrChecked selects the acr of the authentication based on the requested `acr_values` and the minimum of the application.
+// If the selected definition is not satisfied by the authentication, the user has to verify
+// or set up an (additional) factor.
+func (repo *AuthRequestRepo) acrChecked(ctx context.Context, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (_ domain.NextStep, err error) {
+	request.ACR = ""
+	if _, ok := request.Request.(*domain.AuthRequestOIDC); !ok {
+		return nil, nil
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	var required string
+	if app.OIDCConfig != nil {
+		required = app.OIDCConfig.RequiredACR
+	}
+	if required == "" && len(request.ACRValues) == 0 {
+		return nil, nil
+	}
+	definitions, err := repo.ACRDefinitionProvider.ACRDefinitionsByOrg(ctx, user.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	acr, unsatisfied := domain.SelectACR(definitions, request.ACRValues, required, request.AuthMethods(), request.AuthTime)
+	if unsatisfied == nil {
+		request.ACR = acr
+		return nil, nil
+	}
+	allowedProviders, _ := user.MFATypesAllowed(domain.MFALevelSecondFactor, request.LoginPolicy, isInternalAuthentication)
+	if unsatisfied.PhishingResistant {
+		allowedProviders = phishingResistantMFATypes(allowedProviders)
+	}
+	if len(allowedProviders) > 0 {
+		return &domain.MFAVerificationStep{
+			MFAProviders: allowedProviders,
+		}, nil
+	}
+	types := user.MFATypesSetupPossible(domain.MFALevelSecondFactor, request.LoginPolicy)
+	if unsatisfied.PhishingResistant {
+		types = phishingResistantMFATypes(types)
+	}
+	if len(types) > 0 {
+		return &domain.MFAPromptStep{
+			Required:     true,
+			MFAProviders: types,
+		}, nil
+	}
+	return nil, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Qr4vBt", "Errors.AuthRequest.ACRUnsatisfiable")
+}
+
+func phishingResistantMFATypes(types []domain.MFAType) []domain.MFAType {
+	return slices.DeleteFunc(slices.Clone(types), func(mfaType domain.MFAType) bool {
+		return mfaType != domain.MFATypeU2F
+	})
+}
+
 func (repo *AuthRequestRepo) mfaSkippedOrSetUp(user *user_model.UserView, request *domain.AuthRequest) bool {
 	if user.MFAMaxSetUp > domain.MFALevelNotSetUp {
 		return true
@@ -1492,6 +1716,40 @@ func (repo *AuthRequestRepo) hasSucceededPage(ctx context.Context, request *doma
 	return app.OIDCConfig.AppType == domain.OIDCApplicationTypeNative && !app.OIDCConfig.SkipNativeAppSuccessPage, nil
 }
 
+// consentRequired returns a [domain.ConsentStep] if the user has to consent to the requested scopes,
+// either because it was explicitly prompted or because the application requires a consent
+// which the user has not given (for all scopes) yet.
+func (repo *AuthRequestRepo) consentRequired(ctx context.Context, request *domain.AuthRequest) (_ domain.NextStep, err error) {
+	oidcRequest, ok := request.Request.(*domain.AuthRequestOIDC)
+	if !ok || request.ConsentGranted {
+		return nil, nil
+	}
+	step := &domain.ConsentStep{Scopes: oidcRequest.Scopes}
+	if domain.IsPrompt(request.Prompt, domain.PromptConsent) {
+		return step, nil
+	}
+	app, err := repo.ApplicationProvider.AppByOIDCClientID(ctx, request.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if app.OIDCConfig == nil || !app.OIDCConfig.RequireConsent {
+		return nil, nil
+	}
+	consent, err := repo.UserConsentProvider.UserConsentByClientID(ctx, false, request.UserID, request.ApplicationID)
+	if zerrors.IsNotFound(err) {
+		return step, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range oidcRequest.Scopes {
+		if !slices.Contains(consent.Scopes, scope) {
+			return step, nil
+		}
+	}
+	return nil, nil
+}
+
 func (repo *AuthRequestRepo) getDomainPolicy(ctx context.Context, orgID string) (*query.DomainPolicy, error) {
 	return repo.Query.DomainPolicyByOrg(ctx, false, orgID, false)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"net"
 	"testing"
 	"time"
 
@@ -303,6 +304,36 @@ func (m *mockApp) AppByOIDCClientID(ctx context.Context, id string) (*query.App,
 	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
 }
 
+type mockUserConsent struct {
+	scopes []string
+}
+
+func (m *mockUserConsent) UserConsentByClientID(ctx context.Context, _ bool, userID, clientID string) (*query.UserConsent, error) {
+	if m.scopes != nil {
+		return &query.UserConsent{UserID: userID, ClientID: clientID, Scopes: m.scopes}, nil
+	}
+	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
+}
+
+type mockACRDefinitions struct {
+	definitions []*domain.ACRDefinition
+}
+
+func (m *mockACRDefinitions) ACRDefinitionsByOrg(context.Context, string) ([]*domain.ACRDefinition, error) {
+	return m.definitions, nil
+}
+
+type mockTrustedDevice struct {
+	device *query.TrustedDevice
+}
+
+func (m *mockTrustedDevice) UserTrustedDevice(_ context.Context, _ bool, userID, deviceID string) (*query.TrustedDevice, error) {
+	if m.device != nil && m.device.UserID == userID && m.device.DeviceID == deviceID {
+		return m.device, nil
+	}
+	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
+}
+
 type mockIDPUserLinks struct {
 	idps []*query.IDPUserLink
 }
@@ -333,6 +364,25 @@ func (m *mockPasswordReset) RequestSetPassword(ctx context.Context, userID, reso
 	return nil, err
 }
 
+type mockUserCommands struct {
+	riskAction  domain.RiskAction
+	riskErr     error
+	checkFailed []string
+}
+
+func (m *mockUserCommands) BulkAddedUserIDPLinks(context.Context, string, string, []*domain.UserIDPLink) error {
+	return nil
+}
+
+func (m *mockUserCommands) EvaluateLoginRisk(context.Context, string, string, string, string) (domain.RiskAction, error) {
+	return m.riskAction, m.riskErr
+}
+
+func (m *mockUserCommands) LoginRiskCheckFailed(_ context.Context, userID, _, _ string) error {
+	m.checkFailed = append(m.checkFailed, userID)
+	return nil
+}
+
 type mockPasswordChecker struct {
 	err error
 }
@@ -352,6 +402,8 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		userGrantProvider         userGrantProvider
 		projectProvider           projectProvider
 		applicationProvider       applicationProvider
+		userConsentProvider       userConsentProvider
+		acrDefinitionProvider     acrDefinitionProvider
 		loginPolicyProvider       loginPolicyViewProvider
 		lockoutPolicyProvider     lockoutPolicyViewProvider
 		idpUserLinksProvider      idpUserLinksProvider
@@ -1686,7 +1738,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true and authenticated, redirect to callback step",
+			"consent required and missing, consent step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1701,7 +1753,8 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
 				userGrantProvider:   &mockUserGrants{},
 				projectProvider:     &mockProject{},
-				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequireConsent: true}}},
+				userConsentProvider: &mockUserConsent{},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1711,20 +1764,19 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scopes: []string{"openid", "profile"}}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
+			"consent required and not covering all scopes, consent step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1739,7 +1791,8 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
 				userGrantProvider:   &mockUserGrants{},
 				projectProvider:     &mockProject{},
-				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeNative}}},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequireConsent: true}}},
+				userConsentProvider: &mockUserConsent{scopes: []string{"openid"}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1749,20 +1802,19 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.LoginSucceededStep{}, &domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scopes: []string{"openid", "profile"}}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required user grants missing, grant required step",
+			"consent required and given, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1773,13 +1825,12 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 0,
-				},
-				projectProvider: &mockProject{},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequireConsent: true}}},
+				userConsentProvider: &mockUserConsent{scopes: []string{"openid", "profile"}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1789,20 +1840,19 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required user grants exist, redirect to callback step",
+			"acr requested and satisfied, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1811,16 +1861,15 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				userViewProvider: &mockViewUser{
 					PasswordSet:     true,
 					IsEmailVerified: true,
+					OTPState:        int32(user_model.MFAStateReady),
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{
-					roleCheck:  true,
-					userGrants: 2,
-				},
-				projectProvider:     &mockProject{},
-				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				userEventProvider:     &mockEventUser{},
+				orgViewProvider:       &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:     &mockUserGrants{},
+				projectProvider:       &mockProject{},
+				applicationProvider:   &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				acrDefinitionProvider: &mockACRDefinitions{definitions: []*domain.ACRDefinition{{ACR: "mfa", MultiFactor: true}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1829,21 +1878,22 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				idpUserLinksProvider: &mockIDPUserLinks{},
 			},
 			args{&domain.AuthRequest{
-				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				UserID:    "UserID",
+				ACRValues: []string{"mfa"},
+				Request:   &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
+					MFAInitSkipLifetime:       30 * 24 * time.Hour,
 				},
-			}, true},
+			}, false},
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
+			"acr requested and max age exceeded, mfa verification step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1852,16 +1902,54 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				userViewProvider: &mockViewUser{
 					PasswordSet:     true,
 					IsEmailVerified: true,
+					OTPState:        int32(user_model.MFAStateReady),
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{},
-				projectProvider: &mockProject{
-					projectCheck:  true,
-					hasProject:    false,
-					resourceOwner: "other-org",
+				userEventProvider:     &mockEventUser{},
+				orgViewProvider:       &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:     &mockUserGrants{},
+				projectProvider:       &mockProject{},
+				applicationProvider:   &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				acrDefinitionProvider: &mockACRDefinitions{definitions: []*domain.ACRDefinition{{ACR: "mfa", MultiFactor: true, MaxAge: time.Minute}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:    "UserID",
+				ACRValues: []string{"mfa"},
+				Request:   &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+					MFAInitSkipLifetime:       30 * 24 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.MFAVerificationStep{MFAProviders: []domain.MFAType{domain.MFATypeTOTP}}},
+			nil,
+		},
+		{
+			"acr required by app and mfa not set up, mfa prompt step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-5 * time.Minute),
 				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAInitSkipped:  testNow.Add(-time.Hour),
+				},
+				userEventProvider:     &mockEventUser{},
+				orgViewProvider:       &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:     &mockUserGrants{},
+				projectProvider:       &mockProject{},
+				applicationProvider:   &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequiredACR: "mfa"}}},
+				acrDefinitionProvider: &mockACRDefinitions{definitions: []*domain.ACRDefinition{{ACR: "mfa", MultiFactor: true}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1870,21 +1958,97 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				idpUserLinksProvider: &mockIDPUserLinks{},
 			},
 			args{&domain.AuthRequest{
-				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				UserID:    "UserID",
+				ACRValues: nil,
+				Request:   &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
+					MFAInitSkipLifetime:       30 * 24 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.ProjectRequiredStep{}},
+			}, false},
+			[]domain.NextStep{&domain.MFAPromptStep{Required: true, MFAProviders: []domain.MFAType{domain.MFATypeTOTP}}},
 			nil,
 		},
 		{
-			"prompt none, checkLoggedIn true, authenticated and required project exist, redirect to callback step",
+			"acr required by app and phishing resistant factor not possible, precondition failed error",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAInitSkipped:  testNow.Add(-time.Hour),
+				},
+				userEventProvider:     &mockEventUser{},
+				orgViewProvider:       &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:     &mockUserGrants{},
+				projectProvider:       &mockProject{},
+				applicationProvider:   &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequiredACR: "phr"}}},
+				acrDefinitionProvider: &mockACRDefinitions{definitions: []*domain.ACRDefinition{{ACR: "phr", PhishingResistant: true}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:    "UserID",
+				ACRValues: nil,
+				Request:   &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+					MFAInitSkipLifetime:       30 * 24 * time.Hour,
+				},
+			}, false},
+			nil,
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"acr required by app without definition, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAInitSkipped:  testNow.Add(-time.Hour),
+				},
+				userEventProvider:     &mockEventUser{},
+				orgViewProvider:       &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:     &mockUserGrants{},
+				projectProvider:       &mockProject{},
+				applicationProvider:   &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequiredACR: "unknown"}}},
+				acrDefinitionProvider: &mockACRDefinitions{definitions: []*domain.ACRDefinition{{ACR: "mfa", MultiFactor: true}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:    "UserID",
+				ACRValues: nil,
+				Request:   &domain.AuthRequestOIDC{Scopes: []string{"openid"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword: true,
+					PasswordCheckLifetime: 10 * 24 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt consent, consent step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1895,15 +2059,12 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
-				userGrantProvider: &mockUserGrants{},
-				projectProvider: &mockProject{
-					projectCheck:  true,
-					hasProject:    true,
-					resourceOwner: "other-org",
-				},
-				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequireConsent: false}}},
+				userConsentProvider: &mockUserConsent{},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1913,22 +2074,23 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			},
 			args{&domain.AuthRequest{
 				UserID:  "UserID",
-				Prompt:  []domain.Prompt{domain.PromptNone},
-				Request: &domain.AuthRequestOIDC{},
+				Prompt:  []domain.Prompt{domain.PromptConsent},
+				Request: &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
 				LoginPolicy: &domain.LoginPolicy{
 					AllowUsernamePassword:     true,
 					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
 					PasswordCheckLifetime:     10 * 24 * time.Hour,
 					SecondFactorCheckLifetime: 18 * time.Hour,
 				},
-			}, true},
-			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scopes: []string{"openid", "profile"}}},
 			nil,
 		},
 		{
-			"linking users, password step",
+			"consent denied, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
 					SecondFactorVerification: testNow.Add(-5 * time.Minute),
 				},
 				userViewProvider: &mockViewUser{
@@ -1936,32 +2098,36 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb, RequireConsent: true}}},
+				userConsentProvider: &mockUserConsent{},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
 					},
 				},
-				loginPolicyProvider: &mockLoginPolicy{
-					policy: &query.LoginPolicy{
-						SecondFactorCheckLifetime: database.Duration(18 * time.Hour),
-					},
-				},
-				userEventProvider:    &mockEventUser{},
-				orgViewProvider:      &mockViewOrg{State: domain.OrgStateActive},
 				idpUserLinksProvider: &mockIDPUserLinks{},
 			},
-			args{
-				&domain.AuthRequest{
-					UserID:              "UserID",
-					LoginPolicy:         &domain.LoginPolicy{},
-					SelectedIDPConfigID: "IDPConfigID",
-					LinkingUsers:        []*domain.ExternalUser{{IDPConfigID: "IDPConfigID", ExternalUserID: "UserID", DisplayName: "DisplayName"}},
-				}, false},
-			[]domain.NextStep{&domain.PasswordStep{}},
+			args{&domain.AuthRequest{
+				UserID:        "UserID",
+				Prompt:        []domain.Prompt{domain.PromptConsent},
+				ConsentDenied: true,
+				Request:       &domain.AuthRequestOIDC{Scopes: []string{"openid", "profile"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
 		{
-			"linking users, linking step",
+			"prompt none, checkLoggedIn true and authenticated, redirect to callback step",
 			fields{
 				userSessionViewProvider: &mockViewUserSession{
 					PasswordVerification:     testNow.Add(-5 * time.Minute),
@@ -1972,8 +2138,11 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 					IsEmailVerified: true,
 					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
 				},
-				userEventProvider: &mockEventUser{},
-				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
 				lockoutPolicyProvider: &mockLockoutPolicy{
 					policy: &query.LockoutPolicy{
 						ShowFailures: true,
@@ -1981,36 +2150,310 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				},
 				idpUserLinksProvider: &mockIDPUserLinks{},
 			},
-			args{
-				&domain.AuthRequest{
-					UserID:              "UserID",
-					SelectedIDPConfigID: "IDPConfigID",
-					LinkingUsers:        []*domain.ExternalUser{{IDPConfigID: "IDPConfigID", ExternalUserID: "UserID", DisplayName: "DisplayName"}},
-					LoginPolicy: &domain.LoginPolicy{
-						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
-						SecondFactorCheckLifetime: 18 * time.Hour,
-						PasswordCheckLifetime:     10 * 24 * time.Hour,
-					},
-				}, false},
-			[]domain.NextStep{&domain.LinkUsersStep{}},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			repo := &AuthRequestRepo{
-				AuthRequests:              tt.fields.AuthRequests,
-				View:                      tt.fields.View,
-				UserSessionViewProvider:   tt.fields.userSessionViewProvider,
-				UserViewProvider:          tt.fields.userViewProvider,
-				UserEventProvider:         tt.fields.userEventProvider,
-				OrgViewProvider:           tt.fields.orgViewProvider,
-				UserGrantProvider:         tt.fields.userGrantProvider,
-				ProjectProvider:           tt.fields.projectProvider,
-				ApplicationProvider:       tt.fields.applicationProvider,
-				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
-				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
-				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
+		{
+			"prompt none, checkLoggedIn true, authenticated and native, login succeeded step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeNative}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.LoginSucceededStep{}, &domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grants missing, grant required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:  true,
+					userGrants: 0,
+				},
+				projectProvider: &mockProject{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.GrantRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required user grants exist, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:  true,
+					userGrants: 2,
+				},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{},
+				projectProvider: &mockProject{
+					projectCheck:  true,
+					hasProject:    false,
+					resourceOwner: "other-org",
+				},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.ProjectRequiredStep{}},
+			nil,
+		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required project exist, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{},
+				projectProvider: &mockProject{
+					projectCheck:  true,
+					hasProject:    true,
+					resourceOwner: "other-org",
+				},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
+		{
+			"linking users, password step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				loginPolicyProvider: &mockLoginPolicy{
+					policy: &query.LoginPolicy{
+						SecondFactorCheckLifetime: database.Duration(18 * time.Hour),
+					},
+				},
+				userEventProvider:    &mockEventUser{},
+				orgViewProvider:      &mockViewOrg{State: domain.OrgStateActive},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID:              "UserID",
+					LoginPolicy:         &domain.LoginPolicy{},
+					SelectedIDPConfigID: "IDPConfigID",
+					LinkingUsers:        []*domain.ExternalUser{{IDPConfigID: "IDPConfigID", ExternalUserID: "UserID", DisplayName: "DisplayName"}},
+				}, false},
+			[]domain.NextStep{&domain.PasswordStep{}},
+			nil,
+		},
+		{
+			"linking users, linking step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{
+				&domain.AuthRequest{
+					UserID:              "UserID",
+					SelectedIDPConfigID: "IDPConfigID",
+					LinkingUsers:        []*domain.ExternalUser{{IDPConfigID: "IDPConfigID", ExternalUserID: "UserID", DisplayName: "DisplayName"}},
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+						PasswordCheckLifetime:     10 * 24 * time.Hour,
+					},
+				}, false},
+			[]domain.NextStep{&domain.LinkUsersStep{}},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				AuthRequests:              tt.fields.AuthRequests,
+				View:                      tt.fields.View,
+				UserSessionViewProvider:   tt.fields.userSessionViewProvider,
+				UserViewProvider:          tt.fields.userViewProvider,
+				UserEventProvider:         tt.fields.userEventProvider,
+				OrgViewProvider:           tt.fields.orgViewProvider,
+				UserGrantProvider:         tt.fields.userGrantProvider,
+				ProjectProvider:           tt.fields.projectProvider,
+				ApplicationProvider:       tt.fields.applicationProvider,
+				UserConsentProvider:       tt.fields.userConsentProvider,
+				ACRDefinitionProvider:     tt.fields.acrDefinitionProvider,
+				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
+				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
+				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
 				PrivacyPolicyProvider:     tt.fields.privacyPolicyProvider,
 				LabelPolicyProvider:       tt.fields.labelPolicyProvider,
 				PasswordAgePolicyProvider: tt.fields.passwordAgePolicyProvider,
@@ -2349,11 +2792,90 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"risk requires mfa, checked second factor before assessment, check and false",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					RiskMFARequiredAt: testNow.Add(-time.Minute),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow.Add(-5 * time.Hour)},
+				isInternal:  true,
+			},
+			&domain.MFAVerificationStep{
+				MFAProviders: []domain.MFAType{domain.MFATypeTOTP},
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"risk requires mfa, checked second factor after assessment, true",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						SecondFactorCheckLifetime: 18 * time.Hour,
+					},
+					RiskMFARequiredAt: testNow.Add(-time.Minute),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp: domain.MFALevelSecondFactor,
+						OTPState:    user_model.MFAStateReady,
+					},
+				},
+				userSession: &user_model.UserSessionView{SecondFactorVerification: testNow},
+				isInternal:  true,
+			},
+			nil,
+			true,
+			nil,
+			[]domain.MFAType{domain.MFATypeTOTP},
+		},
+		{
+			"risk requires mfa, not set up and skipped, required prompt and false",
+			args{
+				request: &domain.AuthRequest{
+					LoginPolicy: &domain.LoginPolicy{
+						SecondFactors:       []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+						MFAInitSkipLifetime: 30 * 24 * time.Hour,
+					},
+					RiskMFARequiredAt: testNow.Add(-time.Minute),
+				},
+				user: &user_model.UserView{
+					HumanView: &user_model.HumanView{
+						MFAMaxSetUp:    domain.MFALevelNotSetUp,
+						MFAInitSkipped: testNow,
+					},
+				},
+				userSession: &user_model.UserSessionView{},
+				isInternal:  true,
+			},
+			&domain.MFAPromptStep{
+				Required: true,
+				MFAProviders: []domain.MFAType{
+					domain.MFATypeTOTP,
+				},
+			},
+			false,
+			nil,
+			nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &AuthRequestRepo{}
-			got, ok, err := repo.mfaChecked(tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
+			got, ok, err := repo.mfaChecked(context.Background(), tt.args.userSession, tt.args.request, tt.args.user, tt.args.isInternal)
 			if (tt.errFunc != nil && !tt.errFunc(err)) || (err != nil && tt.errFunc == nil) {
 				t.Errorf("got wrong err: %v ", err)
 				return
@@ -2367,6 +2889,136 @@ func TestAuthRequestRepo_mfaChecked(t *testing.T) {
 	}
 }
 
+func TestAuthRequestRepo_trustedDeviceChecked(t *testing.T) {
+	type fields struct {
+		trustedDeviceProvider trustedDeviceProvider
+	}
+	type args struct {
+		request                  *domain.AuthRequest
+		user                     *user_model.UserView
+		isInternalAuthentication bool
+	}
+	trustedDevice := &mockTrustedDevice{
+		device: &query.TrustedDevice{UserID: "userID", DeviceID: "agentID", TrustedAt: testNow.Add(-time.Hour)},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   bool
+	}{
+		{
+			"lifetime not set, false",
+			fields{},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{},
+				},
+				user: &user_model.UserView{ID: "userID"},
+			},
+			false,
+		},
+		{
+			"device not trusted, false",
+			fields{
+				trustedDeviceProvider: &mockTrustedDevice{},
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				user: &user_model.UserView{ID: "userID"},
+			},
+			false,
+		},
+		{
+			"trust expired, false",
+			fields{
+				trustedDeviceProvider: &mockTrustedDevice{
+					device: &query.TrustedDevice{UserID: "userID", DeviceID: "agentID", TrustedAt: testNow.Add(-48 * time.Hour)},
+				},
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				user: &user_model.UserView{ID: "userID"},
+			},
+			false,
+		},
+		{
+			"mfa forced, false",
+			fields{
+				trustedDeviceProvider: trustedDevice,
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour, ForceMFA: true},
+				},
+				user: &user_model.UserView{ID: "userID"},
+			},
+			false,
+		},
+		{
+			"mfa forced for local authentication, false",
+			fields{
+				trustedDeviceProvider: trustedDevice,
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour, ForceMFALocalOnly: true},
+				},
+				user:                     &user_model.UserView{ID: "userID"},
+				isInternalAuthentication: true,
+			},
+			false,
+		},
+		{
+			"mfa forced for local authentication, external authentication, true",
+			fields{
+				trustedDeviceProvider: trustedDevice,
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour, ForceMFALocalOnly: true},
+				},
+				user: &user_model.UserView{ID: "userID"},
+			},
+			true,
+		},
+		{
+			"trusted, true",
+			fields{
+				trustedDeviceProvider: trustedDevice,
+			},
+			args{
+				request: &domain.AuthRequest{
+					AgentID:     "agentID",
+					LoginPolicy: &domain.LoginPolicy{TrustedDeviceLifetime: 24 * time.Hour},
+				},
+				user:                     &user_model.UserView{ID: "userID"},
+				isInternalAuthentication: true,
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				TrustedDeviceProvider: tt.fields.trustedDeviceProvider,
+			}
+			got := repo.trustedDeviceChecked(context.Background(), tt.args.request, tt.args.user, tt.args.isInternalAuthentication)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestAuthRequestRepo_mfaSkippedOrSetUp(t *testing.T) {
 	type fields struct {
 		MFAInitSkippedLifeTime time.Duration
@@ -2754,31 +3406,18 @@ func Test_userByID(t *testing.T) {
 	}
 }
 
-func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
-	authRequest := func(userID string) *domain.AuthRequest {
-		a := &domain.AuthRequest{
-			ID:      "authRequestID",
-			AgentID: "userAgentID",
-			UserID:  userID,
-			LoginPolicy: &domain.LoginPolicy{
-				ObjectRoot:            es_models.ObjectRoot{},
-				Default:               true,
-				AllowUsernamePassword: true,
-				AllowRegister:         true,
-				AllowExternalIDP:      true,
-				IDPProviders: []*domain.IDPProvider{
-					{
-						ObjectRoot:  es_models.ObjectRoot{},
-						Type:        domain.IdentityProviderTypeSystem,
-						IDPConfigID: "idpConfig1",
-						Name:        "IdP",
-						IDPType:     domain.IDPTypeOIDC,
-						IDPState:    domain.IDPConfigStateActive,
-					},
-				},
-				IgnoreUnknownUsernames: true,
-			},
-			AllowedExternalIDPs: []*domain.IDPProvider{
+func verifyPasswordAuthRequest(userID string) *domain.AuthRequest {
+	a := &domain.AuthRequest{
+		ID:      "authRequestID",
+		AgentID: "userAgentID",
+		UserID:  userID,
+		LoginPolicy: &domain.LoginPolicy{
+			ObjectRoot:            es_models.ObjectRoot{},
+			Default:               true,
+			AllowUsernamePassword: true,
+			AllowRegister:         true,
+			AllowExternalIDP:      true,
+			IDPProviders: []*domain.IDPProvider{
 				{
 					ObjectRoot:  es_models.ObjectRoot{},
 					Type:        domain.IdentityProviderTypeSystem,
@@ -2788,32 +3427,45 @@ func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
 					IDPState:    domain.IDPConfigStateActive,
 				},
 			},
-			LabelPolicy: &domain.LabelPolicy{
-				ObjectRoot: es_models.ObjectRoot{},
-				State:      domain.LabelPolicyStateActive,
-				Default:    true,
-			},
-			PrivacyPolicy: &domain.PrivacyPolicy{
-				ObjectRoot: es_models.ObjectRoot{},
-				State:      domain.PolicyStateActive,
-				Default:    true,
-			},
-			LockoutPolicy: &domain.LockoutPolicy{
-				Default: true,
-			},
-			PasswordAgePolicy: &domain.PasswordAgePolicy{
-				ObjectRoot:     es_models.ObjectRoot{},
-				MaxAgeDays:     0,
-				ExpireWarnDays: 0,
+			IgnoreUnknownUsernames: true,
+		},
+		AllowedExternalIDPs: []*domain.IDPProvider{
+			{
+				ObjectRoot:  es_models.ObjectRoot{},
+				Type:        domain.IdentityProviderTypeSystem,
+				IDPConfigID: "idpConfig1",
+				Name:        "IdP",
+				IDPType:     domain.IDPTypeOIDC,
+				IDPState:    domain.IDPConfigStateActive,
 			},
-			DefaultTranslations: []*domain.CustomText{{}},
-			OrgTranslations:     []*domain.CustomText{{}},
-			SAMLRequestID:       "",
-		}
-		a.SetPolicyOrgID("instance1")
-		return a
+		},
+		LabelPolicy: &domain.LabelPolicy{
+			ObjectRoot: es_models.ObjectRoot{},
+			State:      domain.LabelPolicyStateActive,
+			Default:    true,
+		},
+		PrivacyPolicy: &domain.PrivacyPolicy{
+			ObjectRoot: es_models.ObjectRoot{},
+			State:      domain.PolicyStateActive,
+			Default:    true,
+		},
+		LockoutPolicy: &domain.LockoutPolicy{
+			Default: true,
+		},
+		PasswordAgePolicy: &domain.PasswordAgePolicy{
+			ObjectRoot:     es_models.ObjectRoot{},
+			MaxAgeDays:     0,
+			ExpireWarnDays: 0,
+		},
+		DefaultTranslations: []*domain.CustomText{{}},
+		OrgTranslations:     []*domain.CustomText{{}},
+		SAMLRequestID:       "",
 	}
+	a.SetPolicyOrgID("instance1")
+	return a
+}
 
+func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
 	type fields struct {
 		AuthRequests      func(*testing.T, string) cache.AuthRequestCache
 		UserViewProvider  userViewProvider
@@ -2840,7 +3492,7 @@ func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
 			fields: fields{
 				AuthRequests: func(tt *testing.T, userID string) cache.AuthRequestCache {
 					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
-					a := authRequest(userID)
+					a := verifyPasswordAuthRequest(userID)
 					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(a, nil)
 					m.EXPECT().CacheAuthRequest(gomock.Any(), a)
 					return m
@@ -2865,7 +3517,7 @@ func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
 			fields: fields{
 				AuthRequests: func(tt *testing.T, userID string) cache.AuthRequestCache {
 					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
-					a := authRequest(userID)
+					a := verifyPasswordAuthRequest(userID)
 					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(a, nil)
 					m.EXPECT().CacheAuthRequest(gomock.Any(), a)
 					return m
@@ -2893,14 +3545,110 @@ func TestAuthRequestRepo_VerifyPassword_IgnoreUnknownUsernames(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &AuthRequestRepo{
-				AuthRequests:      tt.fields.AuthRequests(t, tt.args.userID),
-				UserViewProvider:  tt.fields.UserViewProvider,
-				UserEventProvider: tt.fields.UserEventProvider,
-				OrgViewProvider:   tt.fields.OrgViewProvider,
-				PasswordChecker:   tt.fields.PasswordChecker,
+				AuthRequests:        tt.fields.AuthRequests(t, tt.args.userID),
+				UserViewProvider:    tt.fields.UserViewProvider,
+				UserEventProvider:   tt.fields.UserEventProvider,
+				OrgViewProvider:     tt.fields.OrgViewProvider,
+				PasswordChecker:     tt.fields.PasswordChecker,
+				UserCommandProvider: &mockUserCommands{},
 			}
 			err := repo.VerifyPassword(tt.args.ctx, tt.args.authReqID, tt.args.userID, tt.args.resourceOwner, tt.args.password, tt.args.userAgentID, tt.args.info)
 			assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "EVENT-SDe2f", "Errors.User.UsernameOrPassword.Invalid"))
 		})
 	}
 }
+
+func TestAuthRequestRepo_VerifyPassword_Risk(t *testing.T) {
+	type fields struct {
+		AuthRequests        func(*testing.T) cache.AuthRequestCache
+		PasswordChecker     passwordChecker
+		UserCommandProvider *mockUserCommands
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		wantErr         error
+		wantCheckFailed []string
+	}{
+		{
+			name: "allow",
+			fields: fields{
+				AuthRequests: func(tt *testing.T) cache.AuthRequestCache {
+					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
+					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(verifyPasswordAuthRequest("user1"), nil)
+					m.EXPECT().CacheAuthRequest(gomock.Any(), gomock.Any())
+					return m
+				},
+				PasswordChecker:     &mockPasswordChecker{},
+				UserCommandProvider: &mockUserCommands{riskAction: domain.RiskActionAllow},
+			},
+		},
+		{
+			name: "require mfa, stored on auth request",
+			fields: fields{
+				AuthRequests: func(tt *testing.T) cache.AuthRequestCache {
+					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
+					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(verifyPasswordAuthRequest("user1"), nil)
+					m.EXPECT().CacheAuthRequest(gomock.Any(), gomock.Any())
+					m.EXPECT().UpdateAuthRequest(gomock.Any(), gomock.Cond(func(request *domain.AuthRequest) bool {
+						return !request.RiskMFARequiredAt.IsZero()
+					}))
+					return m
+				},
+				PasswordChecker:     &mockPasswordChecker{},
+				UserCommandProvider: &mockUserCommands{riskAction: domain.RiskActionRequireMFA},
+			},
+		},
+		{
+			name: "block, error",
+			fields: fields{
+				AuthRequests: func(tt *testing.T) cache.AuthRequestCache {
+					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
+					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(verifyPasswordAuthRequest("user1"), nil)
+					m.EXPECT().CacheAuthRequest(gomock.Any(), gomock.Any())
+					return m
+				},
+				PasswordChecker: &mockPasswordChecker{},
+				UserCommandProvider: &mockUserCommands{
+					riskAction: domain.RiskActionBlock,
+					riskErr:    zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bQv", "Errors.Session.Risk.Blocked"),
+				},
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bQv", "Errors.Session.Risk.Blocked"),
+		},
+		{
+			name: "invalid password, check failed recorded",
+			fields: fields{
+				AuthRequests: func(tt *testing.T) cache.AuthRequestCache {
+					m := mock.NewMockAuthRequestCache(gomock.NewController(tt))
+					m.EXPECT().GetAuthRequestByID(gomock.Any(), "authRequestID").Return(verifyPasswordAuthRequest("user1"), nil)
+					m.EXPECT().CacheAuthRequest(gomock.Any(), gomock.Any())
+					return m
+				},
+				PasswordChecker: &mockPasswordChecker{
+					err: command.ErrPasswordInvalid(nil),
+				},
+				UserCommandProvider: &mockUserCommands{},
+			},
+			wantErr:         zerrors.ThrowInvalidArgument(nil, "EVENT-SDe2f", "Errors.User.UsernameOrPassword.Invalid"),
+			wantCheckFailed: []string{"user1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &AuthRequestRepo{
+				AuthRequests:        tt.fields.AuthRequests(t),
+				UserViewProvider:    &mockViewUser{},
+				UserEventProvider:   &mockEventUser{},
+				OrgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				PasswordChecker:     tt.fields.PasswordChecker,
+				UserCommandProvider: tt.fields.UserCommandProvider,
+			}
+			err := repo.VerifyPassword(authz.NewMockContext("instance1", "", ""), "authRequestID", "user1", "org1", "password", "userAgentID", &domain.BrowserInfo{
+				RemoteIP: net.IPv4(192, 0, 2, 1),
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantCheckFailed, tt.fields.UserCommandProvider.checkFailed)
+		})
+	}
+}
@@ -23,6 +23,14 @@ func (repo *UserMembershipRepo) SearchMyMemberships(ctx context.Context, orgID s
 	return userMembershipsToMemberships(memberships), nil
 }
 
+// SearchCustomRoles returns the roles defined at runtime on the instance and the organization
+func (repo *UserMembershipRepo) SearchCustomRoles(ctx context.Context, orgID string) (_ []authz.RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return repo.Queries.CustomRoleMappingsByOrg(ctx, orgID)
+}
+
 func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) (_ []*query.Membership, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -45,7 +53,48 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
-	return memberships.Memberships, nil
+	inherited, err := repo.searchInheritedOrgMemberships(ctx, orgID, userIDQuery, shouldTriggerBulk)
+	if err != nil {
+		return nil, err
+	}
+	return append(memberships.Memberships, inherited...), nil
+}
+
+// searchInheritedOrgMemberships returns the memberships on the ancestors of the organization,
+// which pass their members down the hierarchy.
+// Only organization memberships are inherited, project memberships stay on their projects.
+func (repo *UserMembershipRepo) searchInheritedOrgMemberships(ctx context.Context, orgID string, userIDQuery query.SearchQuery, shouldTriggerBulk bool) (_ []*query.Membership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || orgID == authz.GetInstance(ctx).InstanceID() {
+		return nil, nil
+	}
+	ancestors, err := repo.Queries.OrgAncestors(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	orgIDs := ancestors.MemberOrgIDs()
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+	orgIDsQuery, err := query.NewMembershipResourceOwnersSearchQuery(orgIDs...)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repo.Queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{userIDQuery, orgIDsQuery},
+	}, shouldTriggerBulk)
+	if err != nil {
+		return nil, err
+	}
+	inherited := make([]*query.Membership, 0, len(memberships.Memberships))
+	for _, membership := range memberships.Memberships {
+		if membership.Org != nil {
+			inherited = append(inherited, membership)
+		}
+	}
+	return inherited, nil
 }
 
 func userMembershipToMembership(membership *query.Membership) *authz.Membership {
@@ -8,4 +8,5 @@ import (
 
 type UserMembershipRepository interface {
 	SearchMyMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) ([]*authz.Membership, error)
+	SearchCustomRoles(ctx context.Context, orgID string) ([]authz.RoleMapping, error)
 }
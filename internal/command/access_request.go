@@ -0,0 +1,204 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestAccess adds a pending request of the user for roles on the project.
+// The request is owned by the organization of the project, so its approvers can decide on it.
+func (c *Commands) RequestAccess(ctx context.Context, request *domain.AccessRequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !request.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aq7rTa", "Errors.AccessRequest.Invalid")
+	}
+	project, err := c.getProjectWriteModelByID(ctx, request.ProjectID, "")
+	if err != nil {
+		return nil, err
+	}
+	if project.State != domain.ProjectStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aq7rUb", "Errors.Project.NotFound")
+	}
+	err = c.checkUserGrantPreCondition(ctx, &domain.UserGrant{
+		UserID:    request.UserID,
+		ProjectID: request.ProjectID,
+		RoleKeys:  request.RoleKeys,
+	}, project.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewAccessRequestWriteModel(id, project.ResourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel, accessrequest.NewAddedEvent(
+		ctx,
+		accessrequest.NewAggregate(id, project.ResourceOwner, authz.GetInstance(ctx).InstanceID()),
+		request.UserID,
+		request.ProjectID,
+		request.RoleKeys,
+		request.Reason,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveAccessRequest grants the requested roles to the requester.
+// If validUntil is set, the created user grant expires at that time.
+func (c *Commands) ApproveAccessRequest(ctx context.Context, requestID, resourceOwner, justification string, validUntil time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !validUntil.IsZero() && !validUntil.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aq7rVc", "Errors.AccessRequest.ValidUntilInvalid")
+	}
+	writeModel, err := c.pendingAccessRequestWriteModel(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkExplicitProjectPermission(ctx, "", writeModel.ProjectID); err != nil {
+		return nil, err
+	}
+	grantCommand, grantWriteModel, err := c.addUserGrant(ctx, &domain.UserGrant{
+		UserID:    writeModel.UserID,
+		ProjectID: writeModel.ProjectID,
+		RoleKeys:  writeModel.RoleKeys,
+	}, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	cmds := []eventstore.Command{grantCommand}
+	if !validUntil.IsZero() {
+		cmds = append(cmds, c.setUserGrantValidity(ctx, grantWriteModel, domain.AccessValidity{ValidUntil: validUntil}))
+	}
+	cmds = append(cmds, accessrequest.NewApprovedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.UserID,
+		writeModel.ProjectID,
+		grantWriteModel.AggregateID,
+		justification,
+		validUntil,
+	))
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	// only the last event belongs to the access request
+	err = AppendAndReduce(writeModel, events[len(events)-1])
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DenyAccessRequest rejects the access request, the justification is shown to the requester.
+func (c *Commands) DenyAccessRequest(ctx context.Context, requestID, resourceOwner, justification string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingAccessRequestWriteModel(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkExplicitProjectPermission(ctx, "", writeModel.ProjectID); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, accessrequest.NewDeniedEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.UserID,
+		writeModel.ProjectID,
+		justification,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CancelAccessRequest withdraws a pending access request of the user.
+func (c *Commands) CancelAccessRequest(ctx context.Context, requestID, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingAccessRequestWriteModel(ctx, requestID, "")
+	if err != nil {
+		return nil, err
+	}
+	// requests of other users are not disclosed
+	if writeModel.UserID != userID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Aq7rWd", "Errors.AccessRequest.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, accessrequest.NewCancelledEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.UserID,
+		writeModel.ProjectID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// AccessRequestNotificationSent marks the notification about the access request as sent to the approver.
+func (c *Commands) AccessRequestNotificationSent(ctx context.Context, requestID, resourceOwner, recipientID string) (err error) {
+	if requestID == "" || recipientID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Aq7rXe", "Errors.IDMissing")
+	}
+	writeModel, err := c.accessRequestWriteModelByID(ctx, requestID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.State == domain.AccessRequestStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Aq7rYf", "Errors.AccessRequest.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, accessrequest.NewNotificationSentEvent(
+		ctx,
+		AccessRequestAggregateFromWriteModel(&writeModel.WriteModel),
+		recipientID,
+	))
+	return err
+}
+
+func (c *Commands) pendingAccessRequestWriteModel(ctx context.Context, requestID, resourceOwner string) (*AccessRequestWriteModel, error) {
+	if requestID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aq7rZg", "Errors.IDMissing")
+	}
+	writeModel, err := c.accessRequestWriteModelByID(ctx, requestID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.AccessRequestStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Aq7s0h", "Errors.AccessRequest.NotFound")
+	}
+	if !writeModel.State.IsPending() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aq7s1i", "Errors.AccessRequest.NotPending")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) accessRequestWriteModelByID(ctx context.Context, requestID, resourceOwner string) (_ *AccessRequestWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewAccessRequestWriteModel(requestID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
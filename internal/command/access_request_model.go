@@ -0,0 +1,71 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+)
+
+type AccessRequestWriteModel struct {
+	eventstore.WriteModel
+
+	UserID      string
+	ProjectID   string
+	RoleKeys    []string
+	Reason      string
+	UserGrantID string
+	ValidUntil  time.Time
+	State       domain.AccessRequestState
+}
+
+func NewAccessRequestWriteModel(id, resourceOwner string) *AccessRequestWriteModel {
+	return &AccessRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *AccessRequestWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessrequest.AddedEvent:
+			wm.UserID = e.UserID
+			wm.ProjectID = e.ProjectID
+			wm.RoleKeys = e.RoleKeys
+			wm.Reason = e.Reason
+			wm.State = domain.AccessRequestStatePending
+		case *accessrequest.ApprovedEvent:
+			wm.UserGrantID = e.UserGrantID
+			wm.ValidUntil = e.ValidUntil
+			wm.State = domain.AccessRequestStateApproved
+		case *accessrequest.DeniedEvent:
+			wm.State = domain.AccessRequestStateDenied
+		case *accessrequest.CancelledEvent:
+			wm.State = domain.AccessRequestStateCancelled
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessrequest.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessrequest.AddedEventType,
+			accessrequest.ApprovedEventType,
+			accessrequest.DeniedEventType,
+			accessrequest.CancelledEventType,
+		).
+		Builder()
+}
+
+func AccessRequestAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessrequest.AggregateType, accessrequest.AggregateVersion)
+}
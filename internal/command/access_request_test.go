@@ -0,0 +1,505 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessRequestAddedTestEvent() eventstore.Event {
+	return eventFromEventPusher(
+		accessrequest.NewAddedEvent(context.Background(),
+			accessrequest.NewAggregate("request1", "org1", ""),
+			"user1",
+			"project1",
+			[]string{"rolekey1"},
+			"reason",
+		),
+	)
+}
+
+func TestCommands_RequestAccess(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		request *domain.AccessRequest
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "no roles, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			request: &domain.AccessRequest{
+				UserID:    "user1",
+				ProjectID: "project1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "project not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			request: &domain.AccessRequest{
+				UserID:    "user1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1"},
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "role not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org2").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			request: &domain.AccessRequest{
+				UserID:    "user1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1"},
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "request, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org2").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"rolekey1",
+								"rolekey",
+								"",
+							),
+						),
+					),
+					expectPush(
+						accessrequest.NewAddedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1", ""),
+							"user1",
+							"project1",
+							[]string{"rolekey1"},
+							"reason",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "request1"),
+			},
+			request: &domain.AccessRequest{
+				UserID:    "user1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1"},
+				Reason:    "reason",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "request1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.RequestAccess(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ApproveAccessRequest(t *testing.T) {
+	validUntil := time.Now().Add(24 * time.Hour).UTC()
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		requestID  string
+		validUntil time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "expiry in the past, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:        authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:  "request1",
+				validUntil: time.Now().Add(-time.Hour),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:       authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID: "request1",
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "already denied, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestAddedTestEvent(),
+						eventFromEventPusher(
+							accessrequest.NewDeniedEvent(context.Background(),
+								accessrequest.NewAggregate("request1", "org1", ""),
+								"user1", "project1", "no"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:       authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID: "request1",
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "no permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(accessRequestAddedTestEvent()),
+				),
+			},
+			args: args{
+				ctx:       context.Background(),
+				requestID: "request1",
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "approve with expiry, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(accessRequestAddedTestEvent()),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org2").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"rolekey1",
+								"rolekey",
+								"",
+							),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						),
+						usergrant.NewUserGrantValiditySetEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							time.Time{},
+							validUntil,
+						),
+						accessrequest.NewApprovedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1", ""),
+							"user1",
+							"project1",
+							"usergrant1",
+							"justification",
+							validUntil,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "usergrant1"),
+			},
+			args: args{
+				ctx:        authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID:  "request1",
+				validUntil: validUntil,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "request1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.ApproveAccessRequest(tt.args.ctx, tt.args.requestID, "org1", "justification", tt.args.validUntil)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_DenyAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		requestID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "already approved, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						accessRequestAddedTestEvent(),
+						eventFromEventPusher(
+							accessrequest.NewApprovedEvent(context.Background(),
+								accessrequest.NewAggregate("request1", "org1", ""),
+								"user1", "project1", "usergrant1", "", time.Time{}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:       authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID: "request1",
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "deny, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(accessRequestAddedTestEvent()),
+					expectPush(
+						accessrequest.NewDeniedEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1", ""),
+							"user1", "project1", "justification"),
+					),
+				),
+			},
+			args: args{
+				ctx:       authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				requestID: "request1",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "request1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.DenyAccessRequest(tt.args.ctx, tt.args.requestID, "org1", "justification")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_CancelAccessRequest(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		userID  string
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "other user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(accessRequestAddedTestEvent()),
+				),
+			},
+			userID:  "user2",
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "cancel, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(accessRequestAddedTestEvent()),
+					expectPush(
+						accessrequest.NewCancelledEvent(context.Background(),
+							accessrequest.NewAggregate("request1", "org1", ""),
+							"user1", "project1"),
+					),
+				),
+			},
+			userID: "user1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "request1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.CancelAccessRequest(context.Background(), "request1", tt.userID)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
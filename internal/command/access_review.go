@@ -0,0 +1,341 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddAccessReview starts an access review of the organization or, if the project id is set, of the project.
+// The current user grants and memberships in scope of the review are added as its items.
+func (c *Commands) AddAccessReview(ctx context.Context, review *domain.AccessReview, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" || !review.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3aAa", "Errors.AccessReview.Invalid")
+	}
+	if !review.Deadline.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3bBb", "Errors.AccessReview.DeadlineInvalid")
+	}
+	if review.ProjectID != "" {
+		existingProject, err := c.getProjectWriteModelByID(ctx, review.ProjectID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingProject.State == domain.ProjectStateUnspecified || existingProject.State == domain.ProjectStateRemoved {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rv3cCc", "Errors.Project.NotFound")
+		}
+	}
+	if err = c.checkAccessReviewers(ctx, review.Reviewers); err != nil {
+		return nil, err
+	}
+	items, err := c.accessReviewItems(ctx, review, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewAccessReviewWriteModel(id, resourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel, accessreview.NewAddedEvent(
+		ctx,
+		accessreview.NewAggregate(id, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+		review.Name,
+		review.ProjectID,
+		review.RoleKeys,
+		review.Reviewers,
+		review.Deadline,
+		review.AutoRevoke,
+		items,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// SetAccessReviewReviewers replaces the reviewers of the active access review.
+func (c *Commands) SetAccessReviewReviewers(ctx context.Context, reviewID, resourceOwner string, reviewers []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(reviewers) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3dDd", "Errors.AccessReview.ReviewersMissing")
+	}
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkAccessReviewers(ctx, reviewers); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, accessreview.NewReviewersSetEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+		reviewers,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// DecideAccessReviewItem records the decision of the reviewer on the item.
+// If the access is revoked, the user grant or membership is removed in the same step.
+// Decisions are final and can only be taken by the reviewers of the access review.
+func (c *Commands) DecideAccessReviewItem(ctx context.Context, reviewID, itemID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if itemID == "" || !decision.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3eEe", "Errors.AccessReview.DecisionInvalid")
+	}
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, "")
+	if err != nil {
+		return nil, err
+	}
+	// access reviews of other reviewers are not disclosed
+	if !writeModel.IsReviewer(authz.GetCtxData(ctx).UserID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rv3fFf", "Errors.AccessReview.NotFound")
+	}
+	item := writeModel.Item(itemID)
+	if item == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rv3gGg", "Errors.AccessReview.ItemNotFound")
+	}
+	if _, ok := writeModel.Decisions[itemID]; ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rv3hHh", "Errors.AccessReview.AlreadyDecided")
+	}
+	cmds, err := c.accessReviewDecisionCommands(ctx, writeModel, item, decision, comment, false)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAccessReviewCommands(ctx, writeModel, cmds)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CompleteAccessReview closes the access review, items without decision keep their access.
+func (c *Commands) CompleteAccessReview(ctx context.Context, reviewID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, accessreview.NewCompletedEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CancelAccessReview stops the access review, the decisions taken until then stay in effect.
+func (c *Commands) CancelAccessReview(ctx context.Context, reviewID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.activeAccessReviewWriteModel(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, accessreview.NewCancelledEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ExpireAccessReview completes the access review after its deadline.
+// If configured, the access of the items nobody decided on is revoked.
+// Access reviews which are not active anymore or whose deadline did not pass are ignored.
+func (c *Commands) ExpireAccessReview(ctx context.Context, reviewID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if reviewID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3iIi", "Errors.IDMissing")
+	}
+	writeModel, err := c.accessReviewWriteModelByID(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !writeModel.State.IsActive() || writeModel.Deadline.After(time.Now()) {
+		return nil
+	}
+	var cmds []eventstore.Command
+	if writeModel.AutoRevoke {
+		for _, item := range writeModel.UndecidedItems() {
+			itemCmds, err := c.accessReviewDecisionCommands(ctx, writeModel, item, domain.AccessReviewDecisionRevoke, "", true)
+			if err != nil {
+				return err
+			}
+			cmds = append(cmds, itemCmds...)
+		}
+	}
+	cmds = append(cmds, accessreview.NewCompletedEvent(
+		ctx,
+		AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+	))
+	return c.pushAccessReviewCommands(ctx, writeModel, cmds)
+}
+
+// accessReviewDecisionCommands returns the decision on the item and, if revoked, the removal of the user grant or membership.
+// User grants and memberships which were removed since the start of the review are not removed again.
+func (c *Commands) accessReviewDecisionCommands(ctx context.Context, writeModel *AccessReviewWriteModel, item *domain.AccessReviewItem, decision domain.AccessReviewDecision, comment string, automatic bool) ([]eventstore.Command, error) {
+	cmds := []eventstore.Command{
+		accessreview.NewItemDecidedEvent(
+			ctx,
+			AccessReviewAggregateFromWriteModel(&writeModel.WriteModel),
+			item.ID,
+			decision,
+			comment,
+			automatic,
+		),
+	}
+	if decision != domain.AccessReviewDecisionRevoke {
+		return cmds, nil
+	}
+	revocation, err := c.accessReviewRevocation(ctx, item)
+	if err != nil || revocation == nil {
+		return cmds, err
+	}
+	return append(cmds, revocation), nil
+}
+
+func (c *Commands) accessReviewRevocation(ctx context.Context, item *domain.AccessReviewItem) (eventstore.Command, error) {
+	switch item.Type {
+	case domain.AccessReviewItemTypeUserGrant:
+		existingUserGrant, err := c.userGrantWriteModelByID(ctx, item.ObjectID, item.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+			return nil, nil
+		}
+		return usergrant.NewUserGrantRemovedEvent(
+			ctx,
+			UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+			existingUserGrant.UserID,
+			existingUserGrant.ProjectID,
+			existingUserGrant.ProjectGrantID,
+		), nil
+	case domain.AccessReviewItemTypeOrgMember:
+		existingMember := NewOrgMemberWriteModel(item.ObjectID, item.UserID)
+		if err := c.eventstore.FilterToQueryReducer(ctx, existingMember); err != nil {
+			return nil, err
+		}
+		if existingMember.State != domain.MemberStateActive {
+			return nil, nil
+		}
+		return org.NewMemberRemovedEvent(ctx, OrgAggregateFromWriteModel(&existingMember.WriteModel), item.UserID), nil
+	case domain.AccessReviewItemTypeProjectMember:
+		existingMember := NewProjectMemberWriteModel(item.ObjectID, item.UserID, item.ResourceOwner)
+		if err := c.eventstore.FilterToQueryReducer(ctx, existingMember); err != nil {
+			return nil, err
+		}
+		if existingMember.State != domain.MemberStateActive {
+			return nil, nil
+		}
+		return project.NewProjectMemberRemovedEvent(ctx, ProjectAggregateFromWriteModel(&existingMember.WriteModel), item.UserID), nil
+	default:
+		return nil, zerrors.ThrowInternal(nil, "COMMAND-Rv3jJj", "Errors.AccessReview.ItemNotFound")
+	}
+}
+
+// pushAccessReviewCommands pushes the commands and reduces the events of the access review into the write model.
+func (c *Commands) pushAccessReviewCommands(ctx context.Context, writeModel *AccessReviewWriteModel, cmds []eventstore.Command) error {
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return err
+	}
+	reviewEvents := make([]eventstore.Event, 0, len(events))
+	for _, event := range events {
+		if event.Aggregate().Type == accessreview.AggregateType {
+			reviewEvents = append(reviewEvents, event)
+		}
+	}
+	return AppendAndReduce(writeModel, reviewEvents...)
+}
+
+func (c *Commands) accessReviewItems(ctx context.Context, review *domain.AccessReview, resourceOwner string) ([]*accessreview.Item, error) {
+	snapshot := NewAccessReviewSnapshotWriteModel(resourceOwner, review.ProjectID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	snapshotItems := snapshot.Items(review.RoleKeys)
+	items := make([]*accessreview.Item, len(snapshotItems))
+	for i, item := range snapshotItems {
+		id, err := c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+		items[i] = &accessreview.Item{
+			ID:            id,
+			Type:          item.Type,
+			ObjectID:      item.ObjectID,
+			ResourceOwner: item.ResourceOwner,
+			UserID:        item.UserID,
+			ProjectID:     item.ProjectID,
+			Roles:         item.Roles,
+		}
+	}
+	return items, nil
+}
+
+func (c *Commands) checkAccessReviewers(ctx context.Context, reviewers []string) error {
+	for _, reviewer := range reviewers {
+		if err := c.checkUserExists(ctx, reviewer, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Commands) activeAccessReviewWriteModel(ctx context.Context, reviewID, resourceOwner string) (*AccessReviewWriteModel, error) {
+	if reviewID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rv3kKk", "Errors.IDMissing")
+	}
+	writeModel, err := c.accessReviewWriteModelByID(ctx, reviewID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.AccessReviewStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rv3lLl", "Errors.AccessReview.NotFound")
+	}
+	if !writeModel.State.IsActive() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rv3mMm", "Errors.AccessReview.NotActive")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) accessReviewWriteModelByID(ctx context.Context, reviewID, resourceOwner string) (_ *AccessReviewWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewAccessReviewWriteModel(reviewID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,298 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+type AccessReviewWriteModel struct {
+	eventstore.WriteModel
+
+	Name       string
+	ProjectID  string
+	RoleKeys   []string
+	Reviewers  []string
+	Deadline   time.Time
+	AutoRevoke bool
+	Items      []*domain.AccessReviewItem
+	Decisions  map[string]domain.AccessReviewDecision
+	State      domain.AccessReviewState
+}
+
+func NewAccessReviewWriteModel(id, resourceOwner string) *AccessReviewWriteModel {
+	return &AccessReviewWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		Decisions: make(map[string]domain.AccessReviewDecision),
+	}
+}
+
+func (wm *AccessReviewWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessreview.AddedEvent:
+			wm.Name = e.Name
+			wm.ProjectID = e.ProjectID
+			wm.RoleKeys = e.RoleKeys
+			wm.Reviewers = e.Reviewers
+			wm.Deadline = e.Deadline
+			wm.AutoRevoke = e.AutoRevoke
+			wm.Items = make([]*domain.AccessReviewItem, len(e.Items))
+			for i, item := range e.Items {
+				wm.Items[i] = &domain.AccessReviewItem{
+					ID:            item.ID,
+					Type:          item.Type,
+					ObjectID:      item.ObjectID,
+					ResourceOwner: item.ResourceOwner,
+					UserID:        item.UserID,
+					ProjectID:     item.ProjectID,
+					Roles:         item.Roles,
+				}
+			}
+			wm.State = domain.AccessReviewStateActive
+		case *accessreview.ReviewersSetEvent:
+			wm.Reviewers = e.Reviewers
+		case *accessreview.ItemDecidedEvent:
+			wm.Decisions[e.ItemID] = e.Decision
+		case *accessreview.CompletedEvent:
+			wm.State = domain.AccessReviewStateCompleted
+		case *accessreview.CancelledEvent:
+			wm.State = domain.AccessReviewStateCancelled
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessreview.AddedEventType,
+			accessreview.ReviewersSetEventType,
+			accessreview.ItemDecidedEventType,
+			accessreview.CompletedEventType,
+			accessreview.CancelledEventType,
+		).
+		Builder()
+}
+
+func (wm *AccessReviewWriteModel) IsReviewer(userID string) bool {
+	return slices.Contains(wm.Reviewers, userID)
+}
+
+func (wm *AccessReviewWriteModel) Item(itemID string) *domain.AccessReviewItem {
+	index := slices.IndexFunc(wm.Items, func(item *domain.AccessReviewItem) bool {
+		return item.ID == itemID
+	})
+	if index < 0 {
+		return nil
+	}
+	return wm.Items[index]
+}
+
+// UndecidedItems returns the items none of the reviewers decided on.
+func (wm *AccessReviewWriteModel) UndecidedItems() []*domain.AccessReviewItem {
+	items := make([]*domain.AccessReviewItem, 0, len(wm.Items))
+	for _, item := range wm.Items {
+		if _, ok := wm.Decisions[item.ID]; !ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func AccessReviewAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, accessreview.AggregateType, accessreview.AggregateVersion)
+}
+
+// AccessReviewSnapshotWriteModel collects the current user grants and memberships of the organization
+// or, if the project id is set, the user grants of the project in all organizations and the members of the project.
+type AccessReviewSnapshotWriteModel struct {
+	eventstore.WriteModel
+
+	projectID string
+	items     []*accessReviewSnapshotItem
+}
+
+type accessReviewSnapshotItem struct {
+	domain.AccessReviewItem
+	active bool
+}
+
+func NewAccessReviewSnapshotWriteModel(orgID, projectID string) *AccessReviewSnapshotWriteModel {
+	return &AccessReviewSnapshotWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: orgID,
+		},
+		projectID: projectID,
+	}
+}
+
+func (wm *AccessReviewSnapshotWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *usergrant.UserGrantAddedEvent:
+			if wm.projectID != "" && e.ProjectID != wm.projectID {
+				continue
+			}
+			wm.add(domain.AccessReviewItemTypeUserGrant, e.Aggregate(), e.UserID, e.ProjectID, e.RoleKeys)
+		case *usergrant.UserGrantChangedEvent:
+			wm.changeRoles(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "", e.RoleKeys)
+		case *usergrant.UserGrantCascadeChangedEvent:
+			wm.changeRoles(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "", e.RoleKeys)
+		case *usergrant.UserGrantDeactivatedEvent:
+			wm.setActive(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "", false)
+		case *usergrant.UserGrantReactivatedEvent:
+			wm.setActive(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "", true)
+		case *usergrant.UserGrantRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "")
+		case *usergrant.UserGrantCascadeRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "")
+		case *usergrant.UserGrantExpiredEvent:
+			wm.remove(domain.AccessReviewItemTypeUserGrant, e.Aggregate().ID, "")
+		case *org.MemberAddedEvent:
+			wm.add(domain.AccessReviewItemTypeOrgMember, e.Aggregate(), e.UserID, "", e.Roles)
+		case *org.MemberChangedEvent:
+			wm.changeRoles(domain.AccessReviewItemTypeOrgMember, e.Aggregate().ID, e.UserID, e.Roles)
+		case *org.MemberRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeOrgMember, e.Aggregate().ID, e.UserID)
+		case *org.MemberCascadeRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeOrgMember, e.Aggregate().ID, e.UserID)
+		case *org.MemberExpiredEvent:
+			wm.remove(domain.AccessReviewItemTypeOrgMember, e.Aggregate().ID, e.UserID)
+		case *project.MemberAddedEvent:
+			wm.add(domain.AccessReviewItemTypeProjectMember, e.Aggregate(), e.UserID, e.Aggregate().ID, e.Roles)
+		case *project.MemberChangedEvent:
+			wm.changeRoles(domain.AccessReviewItemTypeProjectMember, e.Aggregate().ID, e.UserID, e.Roles)
+		case *project.MemberRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeProjectMember, e.Aggregate().ID, e.UserID)
+		case *project.MemberCascadeRemovedEvent:
+			wm.remove(domain.AccessReviewItemTypeProjectMember, e.Aggregate().ID, e.UserID)
+		case *project.MemberExpiredEvent:
+			wm.remove(domain.AccessReviewItemTypeProjectMember, e.Aggregate().ID, e.UserID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewSnapshotWriteModel) Query() *eventstore.SearchQueryBuilder {
+	userGrantEventTypes := []eventstore.EventType{
+		usergrant.UserGrantAddedType,
+		usergrant.UserGrantChangedType,
+		usergrant.UserGrantCascadeChangedType,
+		usergrant.UserGrantDeactivatedType,
+		usergrant.UserGrantReactivatedType,
+		usergrant.UserGrantRemovedType,
+		usergrant.UserGrantCascadeRemovedType,
+		usergrant.UserGrantExpiredType,
+	}
+	if wm.projectID == "" {
+		return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+			ResourceOwner(wm.ResourceOwner).
+			AddQuery().
+			AggregateTypes(usergrant.AggregateType).
+			EventTypes(userGrantEventTypes...).
+			Or().
+			AggregateTypes(org.AggregateType).
+			AggregateIDs(wm.ResourceOwner).
+			EventTypes(
+				org.MemberAddedEventType,
+				org.MemberChangedEventType,
+				org.MemberRemovedEventType,
+				org.MemberCascadeRemovedEventType,
+				org.MemberExpiredEventType,
+			).
+			Builder()
+	}
+	// user grants of granted projects are owned by the granted organizations
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(userGrantEventTypes...).
+		Or().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.projectID).
+		EventTypes(
+			project.MemberAddedType,
+			project.MemberChangedType,
+			project.MemberRemovedType,
+			project.MemberCascadeRemovedType,
+			project.MemberExpiredType,
+		).
+		Builder()
+}
+
+// Items returns the active user grants and memberships with at least one of the role keys,
+// all of them if no role keys are provided.
+func (wm *AccessReviewSnapshotWriteModel) Items(roleKeys []string) []*domain.AccessReviewItem {
+	items := make([]*domain.AccessReviewItem, 0, len(wm.items))
+	for _, item := range wm.items {
+		if !item.active || !item.InScope(roleKeys) {
+			continue
+		}
+		items = append(items, &item.AccessReviewItem)
+	}
+	return items
+}
+
+func (wm *AccessReviewSnapshotWriteModel) add(itemType domain.AccessReviewItemType, aggregate *eventstore.Aggregate, userID, projectID string, roles []string) {
+	wm.items = append(wm.items, &accessReviewSnapshotItem{
+		AccessReviewItem: domain.AccessReviewItem{
+			Type:          itemType,
+			ObjectID:      aggregate.ID,
+			ResourceOwner: aggregate.ResourceOwner,
+			UserID:        userID,
+			ProjectID:     projectID,
+			Roles:         roles,
+		},
+		active: true,
+	})
+}
+
+func (wm *AccessReviewSnapshotWriteModel) item(itemType domain.AccessReviewItemType, objectID, userID string) *accessReviewSnapshotItem {
+	index := slices.IndexFunc(wm.items, func(item *accessReviewSnapshotItem) bool {
+		return item.matches(itemType, objectID, userID)
+	})
+	if index < 0 {
+		return nil
+	}
+	return wm.items[index]
+}
+
+func (wm *AccessReviewSnapshotWriteModel) changeRoles(itemType domain.AccessReviewItemType, objectID, userID string, roles []string) {
+	if item := wm.item(itemType, objectID, userID); item != nil {
+		item.Roles = roles
+	}
+}
+
+func (wm *AccessReviewSnapshotWriteModel) setActive(itemType domain.AccessReviewItemType, objectID, userID string, active bool) {
+	if item := wm.item(itemType, objectID, userID); item != nil {
+		item.active = active
+	}
+}
+
+func (wm *AccessReviewSnapshotWriteModel) remove(itemType domain.AccessReviewItemType, objectID, userID string) {
+	wm.items = slices.DeleteFunc(wm.items, func(item *accessReviewSnapshotItem) bool {
+		return item.matches(itemType, objectID, userID)
+	})
+}
+
+// matches compares the user only for memberships, the user grants are identified by their id.
+func (i *accessReviewSnapshotItem) matches(itemType domain.AccessReviewItemType, objectID, userID string) bool {
+	if i.Type != itemType || i.ObjectID != objectID {
+		return false
+	}
+	return itemType == domain.AccessReviewItemTypeUserGrant || i.UserID == userID
+}
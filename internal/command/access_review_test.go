@@ -0,0 +1,467 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessReviewTestItems() []*accessreview.Item {
+	return []*accessreview.Item{
+		{
+			ID:            "item1",
+			Type:          domain.AccessReviewItemTypeUserGrant,
+			ObjectID:      "usergrant1",
+			ResourceOwner: "org1",
+			UserID:        "user1",
+			ProjectID:     "project1",
+			Roles:         []string{"rolekey1"},
+		},
+		{
+			ID:            "item2",
+			Type:          domain.AccessReviewItemTypeOrgMember,
+			ObjectID:      "org1",
+			ResourceOwner: "org1",
+			UserID:        "user2",
+			Roles:         []string{"ORG_OWNER"},
+		},
+	}
+}
+
+func accessReviewAddedTestEvent(deadline time.Time, autoRevoke bool) eventstore.Event {
+	return eventFromEventPusher(
+		accessreview.NewAddedEvent(context.Background(),
+			accessreview.NewAggregate("review1", "org1", "instance1"),
+			"review",
+			"",
+			nil,
+			[]string{"reviewer1"},
+			deadline,
+			autoRevoke,
+			accessReviewTestItems(),
+		),
+	)
+}
+
+func TestCommands_AddAccessReview(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		review  *domain.AccessReview
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "no reviewers, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			review: &domain.AccessReview{
+				Name:     "review",
+				Deadline: deadline,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "deadline passed, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			review: &domain.AccessReview{
+				Name:      "review",
+				Reviewers: []string{"reviewer1"},
+				Deadline:  time.Now().Add(-time.Hour),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "reviewer not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			review: &domain.AccessReview{
+				Name:      "review",
+				Reviewers: []string{"reviewer1"},
+				Deadline:  deadline,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "organization review, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user2",
+								"ORG_OWNER",
+							),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
+								"user3",
+								"project1",
+								"",
+								[]string{"rolekey1"},
+							),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantRemovedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
+								"user3",
+								"project1",
+								"",
+							),
+						),
+					),
+					expectPush(
+						accessreview.NewAddedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1", "instance1"),
+							"review",
+							"",
+							nil,
+							[]string{"reviewer1"},
+							deadline,
+							true,
+							accessReviewTestItems(),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "item1", "item2", "review1"),
+			},
+			review: &domain.AccessReview{
+				Name:       "review",
+				Reviewers:  []string{"reviewer1"},
+				Deadline:   deadline,
+				AutoRevoke: true,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "review1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddAccessReview(authz.WithInstanceID(context.Background(), "instance1"), tt.review, "org1")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_DecideAccessReviewItem(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+	reviewerCtx := authz.NewMockContext("instance1", "org1", "reviewer1")
+	type args struct {
+		userID   string
+		itemID   string
+		decision domain.AccessReviewDecision
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "no decision, error",
+			eventstore: expectEventstore(),
+			args: args{
+				userID: "reviewer1",
+				itemID: "item1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "not reviewer, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(deadline, false),
+				),
+			),
+			args: args{
+				userID:   "user1",
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "already decided, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(deadline, false),
+					eventFromEventPusher(
+						accessreview.NewItemDecidedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1", "instance1"),
+							"item1",
+							domain.AccessReviewDecisionKeep,
+							"",
+							false,
+						),
+					),
+				),
+			),
+			args: args{
+				userID:   "reviewer1",
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "review completed, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(deadline, false),
+					eventFromEventPusher(
+						accessreview.NewCompletedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1", "instance1"),
+						),
+					),
+				),
+			),
+			args: args{
+				userID:   "reviewer1",
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "keep, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(deadline, false),
+				),
+				expectPush(
+					accessreview.NewItemDecidedEvent(reviewerCtx,
+						accessreview.NewAggregate("review1", "org1", "instance1"),
+						"item2",
+						domain.AccessReviewDecisionKeep,
+						"comment",
+						false,
+					),
+				),
+			),
+			args: args{
+				userID:   "reviewer1",
+				itemID:   "item2",
+				decision: domain.AccessReviewDecisionKeep,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "review1",
+			},
+		},
+		{
+			name: "revoke, user grant removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(deadline, false),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						),
+					),
+				),
+				expectPush(
+					accessreview.NewItemDecidedEvent(reviewerCtx,
+						accessreview.NewAggregate("review1", "org1", "instance1"),
+						"item1",
+						domain.AccessReviewDecisionRevoke,
+						"comment",
+						false,
+					),
+					usergrant.NewUserGrantRemovedEvent(reviewerCtx,
+						&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+						"user1",
+						"project1",
+						"",
+					),
+				),
+			),
+			args: args{
+				userID:   "reviewer1",
+				itemID:   "item1",
+				decision: domain.AccessReviewDecisionRevoke,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+				ID:            "review1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.DecideAccessReviewItem(authz.NewMockContext("instance1", "org1", tt.args.userID), "review1", tt.args.itemID, tt.args.decision, "comment")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ExpireAccessReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "deadline not reached, ignored",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(time.Now().Add(time.Hour), true),
+				),
+			),
+		},
+		{
+			name: "already completed, ignored",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(time.Now().Add(-time.Hour), true),
+					eventFromEventPusher(
+						accessreview.NewCompletedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1", "instance1"),
+						),
+					),
+				),
+			),
+		},
+		{
+			name: "without auto revoke, completed",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(time.Now().Add(-time.Hour), false),
+				),
+				expectPush(
+					accessreview.NewCompletedEvent(context.Background(),
+						accessreview.NewAggregate("review1", "org1", "instance1"),
+					),
+				),
+			),
+		},
+		{
+			name: "auto revoke, undecided items revoked",
+			eventstore: expectEventstore(
+				expectFilter(
+					accessReviewAddedTestEvent(time.Now().Add(-time.Hour), true),
+					eventFromEventPusher(
+						accessreview.NewItemDecidedEvent(context.Background(),
+							accessreview.NewAggregate("review1", "org1", "instance1"),
+							"item1",
+							domain.AccessReviewDecisionKeep,
+							"",
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMemberAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user2",
+							"ORG_OWNER",
+						),
+					),
+				),
+				expectPush(
+					accessreview.NewItemDecidedEvent(context.Background(),
+						accessreview.NewAggregate("review1", "org1", "instance1"),
+						"item2",
+						domain.AccessReviewDecisionRevoke,
+						"",
+						true,
+					),
+					org.NewMemberRemovedEvent(context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"user2",
+					),
+					accessreview.NewCompletedEvent(context.Background(),
+						accessreview.NewAggregate("review1", "org1", "instance1"),
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireAccessReview(authz.WithInstanceID(context.Background(), "instance1"), "review1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/acrdefinition"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetACRDefinition creates or replaces the definition of an acr value.
+// The resource owner is either the instance, which defines the default, or an organization.
+func (c *Commands) SetACRDefinition(ctx context.Context, resourceOwner string, definition *domain.ACRDefinition) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Lp4sKe", "Errors.ResourceOwnerMissing")
+	}
+	if err := validateACRDefinition(definition); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getACRDefinitionWriteModel(ctx, definition.ACR, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.isEqual(definition) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		acrdefinition.NewSetEvent(ctx,
+			acrdefinition.NewAggregate(definition.ACR, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			definition.ACR,
+			definition.PhishingResistant,
+			definition.MultiFactor,
+			definition.MaxAge,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveACRDefinition removes the definition of an acr value of the resource owner.
+// If an organization removes its definition, the definition of the instance applies again.
+func (c *Commands) RemoveACRDefinition(ctx context.Context, resourceOwner, acr string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" || acr == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wd7fPn", "Errors.IDMissing")
+	}
+	writeModel, err := c.getACRDefinitionWriteModel(ctx, acr, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Gz3nRb", "Errors.ACRDefinition.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		acrdefinition.NewRemovedEvent(ctx,
+			acrdefinition.NewAggregate(acr, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			acr,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getACRDefinitionWriteModel(ctx context.Context, acr, resourceOwner string) (*ACRDefinitionWriteModel, error) {
+	writeModel := NewACRDefinitionWriteModel(acr, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// validateACRDefinition checks that the acr can be used in the space delimited `acr_values` parameter
+// and that the definition requires something.
+func validateACRDefinition(definition *domain.ACRDefinition) error {
+	if definition == nil || definition.ACR == "" || len(definition.ACR) > 200 || strings.ContainsAny(definition.ACR, " \t\n\r") {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vt2eXc", "Errors.ACRDefinition.Invalid")
+	}
+	if definition.MaxAge < 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Jq9wHs", "Errors.ACRDefinition.Invalid")
+	}
+	if !definition.PhishingResistant && !definition.MultiFactor && definition.MaxAge == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ny5kAm", "Errors.ACRDefinition.NoRequirement")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/acrdefinition"
+)
+
+type ACRDefinitionWriteModel struct {
+	eventstore.WriteModel
+
+	ACR               string
+	PhishingResistant bool
+	MultiFactor       bool
+	MaxAge            time.Duration
+	State             domain.ACRDefinitionState
+}
+
+func NewACRDefinitionWriteModel(acr, resourceOwner string) *ACRDefinitionWriteModel {
+	return &ACRDefinitionWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   acrdefinition.AggregateID(resourceOwner, acr),
+			ResourceOwner: resourceOwner,
+		},
+		ACR: acr,
+	}
+}
+
+func (wm *ACRDefinitionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *acrdefinition.SetEvent:
+			wm.PhishingResistant = e.PhishingResistant
+			wm.MultiFactor = e.MultiFactor
+			wm.MaxAge = e.MaxAge
+			wm.State = domain.ACRDefinitionStateActive
+		case *acrdefinition.RemovedEvent:
+			wm.PhishingResistant = false
+			wm.MultiFactor = false
+			wm.MaxAge = 0
+			wm.State = domain.ACRDefinitionStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ACRDefinitionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(acrdefinition.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			acrdefinition.SetEventType,
+			acrdefinition.RemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *ACRDefinitionWriteModel) isEqual(definition *domain.ACRDefinition) bool {
+	return wm.State.Exists() &&
+		wm.PhishingResistant == definition.PhishingResistant &&
+		wm.MultiFactor == definition.MultiFactor &&
+		wm.MaxAge == definition.MaxAge
+}
@@ -0,0 +1,305 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/acrdefinition"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetACRDefinition(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		definition    *domain.ACRDefinition
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no resource owner, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:        authz.WithInstanceID(context.Background(), "instance"),
+				definition: &domain.ACRDefinition{ACR: "mfa", MultiFactor: true},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"acr with whitespace, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "instance",
+				definition:    &domain.ACRDefinition{ACR: "m fa", MultiFactor: true},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"no requirement, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "instance",
+				definition:    &domain.ACRDefinition{ACR: "mfa"},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"unchanged, no push",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							acrdefinition.NewSetEvent(context.Background(),
+								acrdefinition.NewAggregate("mfa", "org1", "instance"),
+								"mfa",
+								false,
+								true,
+								10*time.Minute,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				definition:    &domain.ACRDefinition{ACR: "mfa", MultiFactor: true, MaxAge: 10 * time.Minute},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"new definition, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						acrdefinition.NewSetEvent(context.Background(),
+							acrdefinition.NewAggregate("phr", "instance", "instance"),
+							"phr",
+							true,
+							false,
+							0,
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "instance",
+				definition:    &domain.ACRDefinition{ACR: "phr", PhishingResistant: true},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+				},
+			},
+		},
+		{
+			"changed definition, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							acrdefinition.NewSetEvent(context.Background(),
+								acrdefinition.NewAggregate("mfa", "org1", "instance"),
+								"mfa",
+								false,
+								true,
+								0,
+							),
+						),
+					),
+					expectPush(
+						acrdefinition.NewSetEvent(context.Background(),
+							acrdefinition.NewAggregate("mfa", "org1", "instance"),
+							"mfa",
+							false,
+							true,
+							5*time.Minute,
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				definition:    &domain.ACRDefinition{ACR: "mfa", MultiFactor: true, MaxAge: 5 * time.Minute},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetACRDefinition(tt.args.ctx, tt.args.resourceOwner, tt.args.definition)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveACRDefinition(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		acr           string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no acr, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"already removed, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							acrdefinition.NewSetEvent(context.Background(),
+								acrdefinition.NewAggregate("mfa", "org1", "instance"),
+								"mfa",
+								false,
+								true,
+								0,
+							),
+						),
+						eventFromEventPusher(
+							acrdefinition.NewRemovedEvent(context.Background(),
+								acrdefinition.NewAggregate("mfa", "org1", "instance"),
+								"mfa",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				acr:           "mfa",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							acrdefinition.NewSetEvent(context.Background(),
+								acrdefinition.NewAggregate("mfa", "org1", "instance"),
+								"mfa",
+								false,
+								true,
+								0,
+							),
+						),
+					),
+					expectPush(
+						acrdefinition.NewRemovedEvent(context.Background(),
+							acrdefinition.NewAggregate("mfa", "org1", "instance"),
+							"mfa",
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				acr:           "mfa",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveACRDefinition(tt.args.ctx, tt.args.resourceOwner, tt.args.acr)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	exec "github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/repository/execution"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -116,6 +117,9 @@ func (c *Commands) SetExecutionFunction(ctx context.Context, cond ExecutionFunct
 	if err := cond.IsValid(); err != nil {
 		return nil, err
 	}
+	if err := set.validateNoCondition(); err != nil {
+		return nil, err
+	}
 	for _, target := range set.Targets {
 		if err = target.Validate(); err != nil {
 			return nil, err
@@ -203,6 +207,17 @@ type SetExecution struct {
 	models.ObjectRoot
 
 	Targets []*execution.Target
+	// Condition is an optional CEL expression, the targets are only called if it evaluates to true
+	Condition string
+}
+
+// validateNoCondition rejects conditions on function executions, which never evaluate them,
+// as the conditions would never be checked
+func (t SetExecution) validateNoCondition() error {
+	if t.Condition != "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-c7rq2n0v5h", "Errors.Execution.ConditionNotSupported")
+	}
+	return nil
 }
 
 func (t SetExecution) GetIncludes() []string {
@@ -243,12 +258,17 @@ func (c *Commands) setExecution(ctx context.Context, set *SetExecution, resource
 	if resourceOwner == "" || set.AggregateID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-gg3a6ol4om", "Errors.IDMissing")
 	}
+	if set.Condition != "" {
+		if err := exec.ValidateCondition(set.Condition); err != nil {
+			return nil, err
+		}
+	}
 	wm, err := c.getExecutionWriteModelByID(ctx, set.AggregateID, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
 	// Check if targets and includes for execution are existing
-	if wm.ExecutionTargetsEqual(set.Targets) {
+	if wm.ExecutionTargetsEqual(set.Targets) && wm.Condition == set.Condition {
 		return writeModelToObjectDetails(&wm.WriteModel), err
 	}
 	if err := set.Existing(c, ctx, resourceOwner); err != nil {
@@ -258,6 +278,7 @@ func (c *Commands) setExecution(ctx context.Context, set *SetExecution, resource
 		ctx,
 		ExecutionAggregateFromWriteModel(&wm.WriteModel),
 		set.Targets,
+		set.Condition,
 	)); err != nil {
 		return nil, err
 	}
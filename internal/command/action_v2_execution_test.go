@@ -179,6 +179,9 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -188,6 +191,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -234,6 +238,9 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -243,6 +250,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -289,6 +297,9 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -298,6 +309,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -362,6 +374,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -372,6 +385,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -381,6 +395,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -446,6 +461,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -456,6 +472,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -465,6 +482,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -529,6 +547,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -539,6 +558,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -548,6 +568,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -573,6 +594,213 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			"condition invalid, error",
+			fields{
+				eventstore:       expectEventstore(),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "request.userId ==",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"condition unknown variable, error",
+			fields{
+				eventstore:       expectEventstore(),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "unknown == 'value'",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"condition not boolean, error",
+			fields{
+				eventstore:       expectEventstore(),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "orgID + 'suffix'",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok, method target with condition",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(), // execution doesn't exist yet
+					expectFilter(
+						targetAddEvent("target", "instance"),
+					),
+					expectPush(
+						execution.NewSetEventV2(context.Background(),
+							execution.NewAggregate("request/method", "instance"),
+							[]*execution.Target{
+								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+							},
+							"user.orgID == 'org' && user.metadata['tier'] == 'premium'",
+						),
+					),
+				),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "user.orgID == 'org' && user.metadata['tier'] == 'premium'",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "request/method",
+				},
+			},
+		},
+		{
+			"push ok, condition changed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter( // execution has targets
+						eventFromEventPusher(
+							execution.NewSetEventV2(context.Background(),
+								execution.NewAggregate("request", "instance"),
+								[]*execution.Target{
+									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+								},
+								"",
+							),
+						),
+					),
+					expectFilter(
+						targetAddEvent("target", "instance"),
+					),
+					expectPush(
+						execution.NewSetEventV2(context.Background(),
+							execution.NewAggregate("request", "instance"),
+							[]*execution.Target{
+								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+							},
+							"has(request.userId)",
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"",
+					"",
+					true,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "has(request.userId)",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "request",
+				},
+			},
+		},
+		{
+			"push ok, unchanged execution with condition",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter( // execution has targets
+						eventFromEventPusher(
+							execution.NewSetEventV2(context.Background(),
+								execution.NewAggregate("request", "instance"),
+								[]*execution.Target{
+									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+								},
+								"has(request.userId)",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"",
+					"",
+					true,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "has(request.userId)",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "request",
+				},
+			},
+		},
 		{
 			"push ok, remove all targets",
 			fields{
@@ -584,6 +812,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -591,6 +820,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("request", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -625,6 +855,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -663,6 +894,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -670,6 +902,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("request", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -703,6 +936,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -861,6 +1095,9 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							nil,
+							nil,
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -870,6 +1107,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -961,6 +1199,9 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -970,6 +1211,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1010,6 +1252,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1050,6 +1293,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1086,6 +1330,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1093,6 +1338,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("response", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1127,6 +1373,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1165,6 +1412,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1172,6 +1420,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("response", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1205,6 +1454,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1379,6 +1629,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1464,6 +1715,73 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
+						),
+					),
+				),
+				eventExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionEventCondition{
+					"event",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "event/event",
+				},
+			},
+		},
+		{
+			"condition invalid, error",
+			fields{
+				eventstore:  expectEventstore(),
+				eventExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionEventCondition{
+					"event",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Condition: "event.payload.",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok, event target with condition",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(), // execution doesn't exist yet
+					expectFilter(
+						targetAddEvent("target", "instance"),
+					),
+					expectPush(
+						execution.NewSetEventV2(context.Background(),
+							execution.NewAggregate("event/event", "instance"),
+							[]*execution.Target{
+								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+							},
+							"event.payload.email.endsWith('@example.com')",
 						),
 					),
 				),
@@ -1480,6 +1798,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 					Targets: []*execution.Target{
 						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 					},
+					Condition: "event.payload.email.endsWith('@example.com')",
 				},
 				resourceOwner: "instance",
 			},
@@ -1504,6 +1823,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1544,6 +1864,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1580,6 +1901,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1587,6 +1909,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("event", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1621,6 +1944,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1659,6 +1983,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1666,6 +1991,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("event", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1699,6 +2025,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1799,6 +2126,25 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"condition not supported, error",
+			fields{
+				eventstore:           expectEventstore(),
+				actionFunctionExists: existsMock(true),
+			},
+			args{
+				ctx:  context.Background(),
+				cond: "function",
+				set: &SetExecution{
+					Targets:   []*execution.Target{{Type: domain.ExecutionTargetTypeTarget, Target: "target"}},
+					Condition: "user.orgID == 'org'",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			"empty target, error",
 			fields{
@@ -1830,6 +2176,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1905,6 +2252,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1939,6 +2287,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1946,6 +2295,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("function/function", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1977,6 +2327,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2012,6 +2363,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2019,6 +2371,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("function/function", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -2049,6 +2402,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2367,6 +2721,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2394,6 +2749,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 								},
+								"",
 							),
 						),
 					),
@@ -2477,6 +2833,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2513,6 +2870,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 								},
+								"",
 							),
 						),
 					),
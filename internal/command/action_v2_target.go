@@ -2,9 +2,12 @@ package command
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/url"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/actions"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -21,6 +24,10 @@ type AddTarget struct {
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	TLS              *domain.TargetTLS
+	OAuth2           *domain.TargetOAuth2
+	// Module is the WebAssembly module run by targets of type [domain.TargetTypeWASM]
+	Module []byte
 
 	SigningKey string
 }
@@ -32,12 +39,21 @@ func (a *AddTarget) IsValid() error {
 	if a.Timeout == 0 {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-39f35d8uri", "Errors.Target.NoTimeout")
 	}
-	_, err := url.Parse(a.Endpoint)
-	if err != nil || a.Endpoint == "" {
-		return zerrors.ThrowInvalidArgument(err, "COMMAND-1r2k6qo6wg", "Errors.Target.InvalidURL")
+	if a.TargetType == domain.TargetTypeWASM {
+		// the module is run in the sandbox instead of calling an endpoint
+		if err := actions.ValidateWASMModule(a.Module); err != nil {
+			return err
+		}
+	} else {
+		_, err := url.Parse(a.Endpoint)
+		if err != nil || a.Endpoint == "" {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-1r2k6qo6wg", "Errors.Target.InvalidURL")
+		}
 	}
-
-	return nil
+	if err := validateTargetTLS(a.TLS); err != nil {
+		return err
+	}
+	return validateTargetOAuth2(a.OAuth2)
 }
 
 func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner string) (_ *domain.ObjectDetails, err error) {
@@ -67,6 +83,14 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		return nil, err
 	}
 	add.SigningKey = code.PlainCode()
+	tlsConfig, err := encryptTargetTLS(add.TLS, c.targetEncryption)
+	if err != nil {
+		return nil, err
+	}
+	oauth2Config, err := encryptTargetOAuth2(add.OAuth2, c.targetEncryption)
+	if err != nil {
+		return nil, err
+	}
 	pushedEvents, err := c.eventstore.Push(ctx, target.NewAddedEvent(
 		ctx,
 		TargetAggregateFromWriteModel(&wm.WriteModel),
@@ -76,6 +100,9 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		add.Timeout,
 		add.InterruptOnError,
 		code.Crypted,
+		tlsConfig,
+		oauth2Config,
+		add.Module,
 	))
 	if err != nil {
 		return nil, err
@@ -94,6 +121,12 @@ type ChangeTarget struct {
 	Endpoint         *string
 	Timeout          *time.Duration
 	InterruptOnError *bool
+	// TLS is only changed if set, an empty configuration removes it
+	TLS *domain.TargetTLS
+	// OAuth2 is only changed if set, an empty configuration removes it
+	OAuth2 *domain.TargetOAuth2
+	// Module is only changed if set
+	Module []byte
 
 	ExpirationSigningKey bool
 	SigningKey           *string
@@ -109,12 +142,42 @@ func (a *ChangeTarget) IsValid() error {
 	if a.Timeout != nil && *a.Timeout == 0 {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-08b39vdi57", "Errors.Target.NoTimeout")
 	}
+	// an empty endpoint is only allowed for WebAssembly targets, which is checked against the existing target
 	if a.Endpoint != nil {
-		_, err := url.Parse(*a.Endpoint)
-		if err != nil || *a.Endpoint == "" {
+		if _, err := url.Parse(*a.Endpoint); err != nil {
 			return zerrors.ThrowInvalidArgument(err, "COMMAND-jsbaera7b6", "Errors.Target.InvalidURL")
 		}
 	}
+	if a.Module != nil {
+		if err := actions.ValidateWASMModule(a.Module); err != nil {
+			return err
+		}
+	}
+	if err := validateTargetTLS(a.TLS); err != nil {
+		return err
+	}
+	return validateTargetOAuth2(a.OAuth2)
+}
+
+// isValidFor checks that the target has a module or an endpoint, depending on its type after the change
+func (a *ChangeTarget) isValidFor(existing *TargetWriteModel) error {
+	targetType := existing.TargetType
+	if a.TargetType != nil {
+		targetType = *a.TargetType
+	}
+	if targetType == domain.TargetTypeWASM {
+		if a.Module == nil && len(existing.Module) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Zk5vHn", "Errors.Target.InvalidModule")
+		}
+		return nil
+	}
+	endpoint := existing.Endpoint
+	if a.Endpoint != nil {
+		endpoint = *a.Endpoint
+	}
+	if endpoint == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Qe2wLb", "Errors.Target.InvalidURL")
+	}
 	return nil
 }
 
@@ -132,6 +195,9 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 	if !existing.State.Exists() {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-xj14f2cccn", "Errors.Target.NotFound")
 	}
+	if err := change.isValidFor(existing); err != nil {
+		return nil, err
+	}
 
 	var changedSigningKey *crypto.CryptoValue
 	if change.ExpirationSigningKey {
@@ -143,6 +209,15 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.SigningKey = &code.Plain
 	}
 
+	tlsConfig, err := encryptTargetTLS(change.TLS, c.targetEncryption)
+	if err != nil {
+		return nil, err
+	}
+	oauth2Config, err := encryptTargetOAuth2(change.OAuth2, c.targetEncryption)
+	if err != nil {
+		return nil, err
+	}
+
 	changedEvent := existing.NewChangedEvent(
 		ctx,
 		TargetAggregateFromWriteModel(&existing.WriteModel),
@@ -152,6 +227,9 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.Timeout,
 		change.InterruptOnError,
 		changedSigningKey,
+		tlsConfig,
+		oauth2Config,
+		change.Module,
 	)
 	if changedEvent == nil {
 		return writeModelToObjectDetails(&existing.WriteModel), nil
@@ -213,3 +291,74 @@ func (c *Commands) getTargetWriteModelByID(ctx context.Context, id string, resou
 func (c *Commands) newSigningKey(ctx context.Context, filter preparation.FilterToQueryReducer, alg crypto.EncryptionAlgorithm) (*EncryptedCode, error) {
 	return c.newEncryptedCodeWithDefault(ctx, filter, domain.SecretGeneratorTypeSigningKey, alg, c.defaultSecretGenerators.SigningKey)
 }
+
+// validateTargetTLS checks that the client certificate and key form a valid key pair
+// and that the CA bundle contains at least one certificate.
+// An empty configuration is valid as it removes the configuration on change.
+func validateTargetTLS(config *domain.TargetTLS) error {
+	if config == nil {
+		return nil
+	}
+	if len(config.ClientCertificate) > 0 || len(config.ClientKey) > 0 {
+		if _, err := tls.X509KeyPair(config.ClientCertificate, config.ClientKey); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-u8wUq3", "Errors.Target.InvalidTLS")
+		}
+	}
+	if len(config.CACertificates) > 0 && !x509.NewCertPool().AppendCertsFromPEM(config.CACertificates) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ok1Dm9", "Errors.Target.InvalidTLS")
+	}
+	return nil
+}
+
+// validateTargetOAuth2 checks that the client credentials are complete.
+// An empty configuration is valid as it removes the configuration on change.
+func validateTargetOAuth2(config *domain.TargetOAuth2) error {
+	if config == nil || (config.TokenEndpoint == "" && config.ClientID == "" && config.ClientSecret == "") {
+		return nil
+	}
+	if config.ClientID == "" || config.ClientSecret == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq8fa2", "Errors.Target.InvalidOAuth2")
+	}
+	tokenEndpoint, err := url.Parse(config.TokenEndpoint)
+	if err != nil || tokenEndpoint.Scheme == "" || tokenEndpoint.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-P2xvL5", "Errors.Target.InvalidOAuth2")
+	}
+	return nil
+}
+
+func encryptTargetTLS(config *domain.TargetTLS, alg crypto.EncryptionAlgorithm) (*target.TLS, error) {
+	if config == nil {
+		return nil, nil
+	}
+	encrypted := &target.TLS{
+		ClientCertificate: config.ClientCertificate,
+		CACertificates:    config.CACertificates,
+	}
+	if len(config.ClientKey) > 0 {
+		key, err := crypto.Encrypt(config.ClientKey, alg)
+		if err != nil {
+			return nil, err
+		}
+		encrypted.ClientKey = key
+	}
+	return encrypted, nil
+}
+
+func encryptTargetOAuth2(config *domain.TargetOAuth2, alg crypto.EncryptionAlgorithm) (*target.OAuth2, error) {
+	if config == nil {
+		return nil, nil
+	}
+	encrypted := &target.OAuth2{
+		TokenEndpoint: config.TokenEndpoint,
+		ClientID:      config.ClientID,
+		Scopes:        config.Scopes,
+	}
+	if config.ClientSecret != "" {
+		secret, err := crypto.Encrypt([]byte(config.ClientSecret), alg)
+		if err != nil {
+			return nil, err
+		}
+		encrypted.ClientSecret = secret
+	}
+	return encrypted, nil
+}
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"bytes"
 	"context"
 	"slices"
 	"time"
@@ -20,6 +21,9 @@ type TargetWriteModel struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       *crypto.CryptoValue
+	TLS              *target.TLS
+	OAuth2           *target.OAuth2
+	Module           []byte
 
 	State domain.TargetState
 }
@@ -44,6 +48,9 @@ func (wm *TargetWriteModel) Reduce() error {
 			wm.Timeout = e.Timeout
 			wm.State = domain.TargetActive
 			wm.SigningKey = e.SigningKey
+			wm.TLS = e.TLS
+			wm.OAuth2 = e.OAuth2
+			wm.Module = e.Module
 		case *target.ChangedEvent:
 			if e.Name != nil {
 				wm.Name = *e.Name
@@ -63,6 +70,15 @@ func (wm *TargetWriteModel) Reduce() error {
 			if e.SigningKey != nil {
 				wm.SigningKey = e.SigningKey
 			}
+			if e.TLS != nil {
+				wm.TLS = e.TLS
+			}
+			if e.OAuth2 != nil {
+				wm.OAuth2 = e.OAuth2
+			}
+			if e.Module != nil {
+				wm.Module = e.Module
+			}
 		case *target.RemovedEvent:
 			wm.State = domain.TargetRemoved
 		}
@@ -91,6 +107,9 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	timeout *time.Duration,
 	interruptOnError *bool,
 	signingKey *crypto.CryptoValue,
+	tls *target.TLS,
+	oauth2 *target.OAuth2,
+	module []byte,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -112,6 +131,17 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if signingKey != nil {
 		changes = append(changes, target.ChangeSigningKey(signingKey))
 	}
+	// the tls and oauth2 configurations contain encrypted secrets, so they are updated if set,
+	// except for removing configurations which don't exist
+	if tls != nil && !(tls.IsEmpty() && wm.TLS.IsEmpty()) {
+		changes = append(changes, target.ChangeTLS(tls))
+	}
+	if oauth2 != nil && !(oauth2.IsEmpty() && wm.OAuth2.IsEmpty()) {
+		changes = append(changes, target.ChangeOAuth2(oauth2))
+	}
+	if module != nil && !bytes.Equal(wm.Module, module) {
+		changes = append(changes, target.ChangeModule(module))
+	}
 	if len(changes) == 0 {
 		return nil
 	}
@@ -27,6 +27,9 @@ func targetAddEvent(aggID, resourceOwner string) *target.AddedEvent {
 			KeyID:      "id",
 			Crypted:    []byte("12345678"),
 		},
+		nil,
+		nil,
+		nil,
 	)
 }
 
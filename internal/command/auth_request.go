@@ -2,11 +2,14 @@ package command
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/userconsent"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -29,6 +32,7 @@ type AuthRequest struct {
 	LoginHint        *string
 	HintUserID       *string
 	NeedRefreshToken bool
+	ACRValues        []string
 }
 
 type CurrentAuthRequest struct {
@@ -37,6 +41,7 @@ type CurrentAuthRequest struct {
 	UserID      string
 	AuthMethods []domain.UserAuthMethodType
 	AuthTime    time.Time
+	ACR         string
 }
 
 const IDPrefixV2 = "V2_"
@@ -73,6 +78,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.LoginHint,
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
+		authRequest.ACRValues,
 	))
 	if err != nil {
 		return nil, err
@@ -80,7 +86,29 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 	return authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
-func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
+// AuthRequestConsent describes the consent of the user for the scopes of an auth request
+type AuthRequestConsent struct {
+	// Required is set if the application requires consent
+	Required bool
+	// Granted is set if the user consented to the requested scopes
+	Granted   bool
+	ProjectID string
+}
+
+// AuthRequestACR describes the authentication context class references (acr) to be checked for an auth request
+type AuthRequestACR struct {
+	// Required is the minimum acr of the application
+	Required string
+	// Definitions returns the acr definitions effective for the organization of the user
+	Definitions func(ctx context.Context, orgID string) ([]*domain.ACRDefinition, error)
+}
+
+// LinkSessionToAuthRequest links the session to the auth request.
+// If consent is required by the application or prompted by the client,
+// the user must have granted consent to the requested scopes, either previously or with this request.
+// If an acr is required by the application or requested by the client, the session must satisfy it.
+// The risk assessment of the session must allow the authentication, see [SessionWriteModel.CheckRisk].
+func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, consent *AuthRequestConsent, acr *AuthRequestACR) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
 		return nil, nil, err
@@ -105,19 +133,85 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err = sessionWriteModel.CheckRisk(); err != nil {
+		return nil, nil, err
+	}
+	satisfiedACR, err := authRequestACR(ctx, writeModel, sessionWriteModel, acr)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
-		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
-		sessionID,
-		sessionWriteModel.UserID,
-		sessionWriteModel.AuthenticationTime(),
-		sessionWriteModel.AuthMethodTypes(),
-	)); err != nil {
+	cmds := []eventstore.Command{
+		authrequest.NewSessionLinkedEvent(
+			ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+			sessionID,
+			sessionWriteModel.UserID,
+			sessionWriteModel.AuthenticationTime(),
+			sessionWriteModel.AuthMethodTypes(),
+			satisfiedACR,
+		),
+	}
+	consentGranted, err := c.authRequestConsent(ctx, writeModel, sessionWriteModel.UserID, sessionWriteModel.UserResourceOwner, consent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if consentGranted != nil {
+		cmds = append(cmds, consentGranted)
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
 		return nil, nil, err
 	}
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// authRequestConsent returns the event to store the consent of the user, if it was granted with the request.
+// An error is returned if consent is required, but the user has not granted it for the requested scopes.
+// A denied consent never reaches this point: the login fails the auth request with
+// [domain.OIDCErrorReasonAccessDenied] instead (see [Commands.FailAuthRequest]), so no consent is stored.
+func (c *Commands) authRequestConsent(ctx context.Context, writeModel *AuthRequestWriteModel, userID, resourceOwner string, consent *AuthRequestConsent) (eventstore.Command, error) {
+	prompted := slices.Contains(writeModel.Prompt, domain.PromptConsent)
+	if consent == nil || (!consent.Required && !prompted) {
+		return nil, nil
+	}
+	consentWriteModel, err := c.getUserConsentWriteModel(ctx, userID, resourceOwner, writeModel.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if consent.Granted {
+		scopes := normalizeConsentScopes(writeModel.Scope)
+		if consentWriteModel.Granted && consentWriteModel.ProjectID == consent.ProjectID && slices.Equal(consentWriteModel.Scopes, scopes) {
+			return nil, nil
+		}
+		return userconsent.NewGrantedEvent(ctx,
+			userconsent.NewAggregate(userID, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			writeModel.ClientID,
+			consent.ProjectID,
+			scopes,
+		), nil
+	}
+	if prompted || !consentWriteModel.Covers(writeModel.Scope) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rk2mWs", "Errors.AuthRequest.ConsentRequired")
+	}
+	return nil, nil
+}
+
+// authRequestACR returns the acr satisfied by the session.
+// An error is returned if the session does not satisfy the acr required by the application or requested by the client.
+func authRequestACR(ctx context.Context, writeModel *AuthRequestWriteModel, session *SessionWriteModel, acr *AuthRequestACR) (string, error) {
+	if acr == nil || acr.Definitions == nil || (acr.Required == "" && len(writeModel.ACRValues) == 0) {
+		return "", nil
+	}
+	definitions, err := acr.Definitions(ctx, session.UserResourceOwner)
+	if err != nil {
+		return "", err
+	}
+	value, unsatisfied := domain.SelectACR(definitions, writeModel.ACRValues, acr.Required, session.AuthMethodTypes(), session.AuthenticationTime())
+	if unsatisfied != nil {
+		return "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-Xe8hTn", "Errors.AuthRequest.ACRUnsatisfiable")
+	}
+	return value, nil
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -171,11 +265,13 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			MaxAge:        writeModel.MaxAge,
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
+			ACRValues:     writeModel.ACRValues,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
 		AuthMethods: writeModel.AuthMethods,
 		AuthTime:    writeModel.AuthTime,
+		ACR:         writeModel.ACR,
 	}
 }
 
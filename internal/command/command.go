@@ -26,6 +26,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
@@ -61,6 +62,7 @@ type Commands struct {
 	applicationKeySize              int
 	domainVerificationAlg           crypto.EncryptionAlgorithm
 	domainVerificationGenerator     crypto.Generator
+	smtpWebhookTokenGenerator       crypto.Generator
 	domainVerificationValidator     func(domain, token, verifier string, checkType api_http.CheckType) error
 	sessionTokenCreator             func(sessionID string) (id string, token string, err error)
 	sessionTokenVerifier            func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
@@ -68,6 +70,7 @@ type Commands struct {
 	defaultRefreshTokenLifetime     time.Duration
 	defaultRefreshTokenIdleLifetime time.Duration
 	phoneCodeVerifier               func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	riskEngine                      *risk.Engine
 
 	multifactors            domain.MultifactorConfigs
 	webauthnConfig          *webauthn_helper.Config
@@ -137,6 +140,10 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("caches: %w", err)
 	}
+	riskEngine, err := risk.NewEngine(defaults.Risk)
+	if err != nil {
+		return nil, fmt.Errorf("risk engine: %w", err)
+	}
 	repo = &Commands{
 		eventstore:                      es,
 		static:                          staticStore,
@@ -161,6 +168,7 @@ func StartCommands(
 		applicationKeySize:              int(defaults.SecretGenerators.ApplicationKeySize),
 		domainVerificationAlg:           domainVerificationEncryption,
 		domainVerificationGenerator:     crypto.NewEncryptionGenerator(defaults.DomainVerification.VerificationGenerator, domainVerificationEncryption),
+		smtpWebhookTokenGenerator:       crypto.NewEncryptionGenerator(defaults.SMTPWebhook.TokenGenerator, smtpEncryption),
 		domainVerificationValidator:     api_http.ValidateDomain,
 		keyAlgorithm:                    oidcEncryption,
 		certificateAlgorithm:            samlEncryption,
@@ -177,6 +185,7 @@ func StartCommands(
 		defaultSecretGenerators:         defaultSecretGenerators,
 		samlCertificateAndKeyGenerator:  samlCertificateAndKeyGenerator(defaults.KeyConfig.CertificateSize, defaults.KeyConfig.CertificateLifetime),
 		webKeyGenerator:                 crypto.GenerateEncryptedWebKey,
+		riskEngine:                      riskEngine,
 		// always true for now until we can check with an eventlist
 		EventExisting: func(event string) bool { return true },
 		// always true for now until we can check with an eventlist
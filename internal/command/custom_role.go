@@ -0,0 +1,194 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddCustomRole defines a manager role with a subset of the permissions of the configured roles.
+// The resource owner is either the instance or an organization.
+func (c *Commands) AddCustomRole(ctx context.Context, resourceOwner string, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	if err := c.validateCustomRole(ctx, resourceOwner, role); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, role.Key, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Cr4aLx", "Errors.CustomRole.AlreadyExists")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		customrole.NewAddedEvent(ctx,
+			customrole.NewAggregate(role.Key, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			role.Key,
+			role.DisplayName,
+			role.Permissions,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeCustomRole updates the display name and permissions of a custom role.
+// Members with the role are granted the changed permissions on their next request.
+func (c *Commands) ChangeCustomRole(ctx context.Context, resourceOwner string, role *domain.CustomRole) (*domain.ObjectDetails, error) {
+	if err := c.validateCustomRole(ctx, resourceOwner, role); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, role.Key, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cr4bQm", "Errors.CustomRole.NotFound")
+	}
+	changedEvent := writeModel.NewChangedEvent(ctx, role.DisplayName, role.Permissions)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveCustomRole removes the custom role of the resource owner.
+// Members still having the role assigned are no longer granted any permission by it.
+func (c *Commands) RemoveCustomRole(ctx context.Context, resourceOwner, key string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" || key == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr4cWe", "Errors.IDMissing")
+	}
+	writeModel, err := c.getCustomRoleWriteModel(ctx, key, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cr4dHs", "Errors.CustomRole.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		customrole.NewRemovedEvent(ctx,
+			customrole.NewAggregate(key, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			key,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getCustomRoleWriteModel(ctx context.Context, key, resourceOwner string) (*CustomRoleWriteModel, error) {
+	writeModel := NewCustomRoleWriteModel(key, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// validateCustomRole checks the key of the role and that all permissions are known for the level of the role.
+// The creator must be granted every permission of the role, so nobody can create a role more powerful than themselves.
+func (c *Commands) validateCustomRole(ctx context.Context, resourceOwner string, role *domain.CustomRole) error {
+	if resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr4eNa", "Errors.ResourceOwnerMissing")
+	}
+	if !role.IsValid(resourceOwner == authz.GetInstance(ctx).InstanceID()) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr4fPo", "Errors.CustomRole.Invalid")
+	}
+	prefix := domain.OrgRolePrefix
+	if strings.HasPrefix(role.Key, domain.IAMCustomRolePrefix) {
+		prefix = domain.IAMRolePrefix
+	}
+	known := c.knownPermissions(prefix)
+	for _, permission := range role.Permissions {
+		if !slices.Contains(known, permission) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr4gUk", "Errors.CustomRole.PermissionInvalid")
+		}
+		if err := c.checkPermission(ctx, permission, resourceOwner, ""); err != nil {
+			return zerrors.ThrowPermissionDenied(err, "COMMAND-Cr4hTz", "Errors.CustomRole.PermissionExceeded")
+		}
+	}
+	return nil
+}
+
+// knownPermissions returns the permissions of the configured roles with the prefix
+func (c *Commands) knownPermissions(rolePrefix string) []string {
+	permissions := make([]string, 0)
+	for _, mapping := range c.zitadelRoles {
+		if !strings.HasPrefix(mapping.Role, rolePrefix) {
+			continue
+		}
+		for _, permission := range mapping.Permissions {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+// splitCustomRoles separates the roles defined at runtime from the configured ones
+func splitCustomRoles(roles []string) (configured, custom []string) {
+	for _, role := range roles {
+		if domain.IsCustomRole(role) {
+			custom = append(custom, role)
+			continue
+		}
+		configured = append(configured, role)
+	}
+	return configured, custom
+}
+
+// existsCustomRoles checks that all custom roles are defined on the instance or, if set, on the organization
+// and can be assigned to members with the role prefix.
+func existsCustomRoles(ctx context.Context, filter preparation.FilterToQueryReducer, orgID, customRolePrefix string, roles []string) (bool, error) {
+	if len(roles) == 0 {
+		return true, nil
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	aggregateIDs := make([]string, 0, len(roles)*2)
+	for _, role := range roles {
+		if !strings.HasPrefix(role, customRolePrefix) {
+			return false, nil
+		}
+		aggregateIDs = append(aggregateIDs, customrole.AggregateID(instanceID, role))
+		if orgID != "" && orgID != instanceID {
+			aggregateIDs = append(aggregateIDs, customrole.AggregateID(orgID, role))
+		}
+	}
+	events, err := filter(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderAsc().
+		AddQuery().
+		AggregateTypes(customrole.AggregateType).
+		AggregateIDs(aggregateIDs...).
+		EventTypes(
+			customrole.AddedEventType,
+			customrole.RemovedEventType,
+		).Builder())
+	if err != nil {
+		return false, err
+	}
+	existing := make(map[string]bool, len(aggregateIDs))
+	for _, event := range events {
+		switch e := event.(type) {
+		case *customrole.AddedEvent:
+			existing[e.Aggregate().ID] = true
+		case *customrole.RemovedEvent:
+			existing[e.Aggregate().ID] = false
+		}
+	}
+	for _, role := range roles {
+		if !existing[customrole.AggregateID(instanceID, role)] && !existing[customrole.AggregateID(orgID, role)] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
+)
+
+type CustomRoleWriteModel struct {
+	eventstore.WriteModel
+
+	Key         string
+	DisplayName string
+	Permissions []string
+	State       domain.CustomRoleState
+}
+
+func NewCustomRoleWriteModel(key, resourceOwner string) *CustomRoleWriteModel {
+	return &CustomRoleWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   customrole.AggregateID(resourceOwner, key),
+			ResourceOwner: resourceOwner,
+		},
+		Key: key,
+	}
+}
+
+func (wm *CustomRoleWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *customrole.AddedEvent:
+			wm.DisplayName = e.DisplayName
+			wm.Permissions = e.Permissions
+			wm.State = domain.CustomRoleStateActive
+		case *customrole.ChangedEvent:
+			if e.DisplayName != nil {
+				wm.DisplayName = *e.DisplayName
+			}
+			if e.Permissions != nil {
+				wm.Permissions = e.Permissions
+			}
+		case *customrole.RemovedEvent:
+			wm.DisplayName = ""
+			wm.Permissions = nil
+			wm.State = domain.CustomRoleStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *CustomRoleWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(customrole.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			customrole.AddedEventType,
+			customrole.ChangedEventType,
+			customrole.RemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *CustomRoleWriteModel) NewChangedEvent(ctx context.Context, displayName string, permissions []string) *customrole.ChangedEvent {
+	changes := make([]customrole.Changes, 0, 2)
+	if wm.DisplayName != displayName {
+		changes = append(changes, customrole.ChangeDisplayName(displayName))
+	}
+	if !slices.Equal(wm.Permissions, permissions) {
+		changes = append(changes, customrole.ChangePermissions(permissions))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return customrole.NewChangedEvent(ctx,
+		customrole.NewAggregate(wm.Key, wm.ResourceOwner, wm.InstanceID),
+		wm.Key,
+		changes,
+	)
+}
@@ -0,0 +1,502 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var customRoleTestRoles = []authz.RoleMapping{
+	{
+		Role:        "IAM_OWNER",
+		Permissions: []string{"iam.read", "iam.write", "org.read"},
+	},
+	{
+		Role:        "ORG_OWNER",
+		Permissions: []string{"org.read", "user.read", "user.write", "user.credential.write"},
+	},
+}
+
+func TestCommands_AddCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		role          *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no resource owner, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:  authz.WithInstanceID(context.Background(), "instance"),
+				role: &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"key without prefix, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"instance role on organization, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "IAM_CUSTOM_HELPDESK", Permissions: []string{"iam.read"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"unknown permission, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.unknown"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"instance permission on organization role, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"iam.write"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"permission exceeds creator, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"already exists, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"user.read"},
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"organization role, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						customrole.NewAddedEvent(context.Background(),
+							customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+							"ORG_CUSTOM_HELPDESK",
+							"Helpdesk",
+							[]string{"user.write", "user.credential.write"},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role: &domain.CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.write", "user.credential.write"},
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"instance role, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						customrole.NewAddedEvent(context.Background(),
+							customrole.NewAggregate("IAM_CUSTOM_AUDITOR", "instance", "instance"),
+							"IAM_CUSTOM_AUDITOR",
+							"",
+							[]string{"iam.read", "org.read"},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "instance",
+				role: &domain.CustomRole{
+					Key:         "IAM_CUSTOM_AUDITOR",
+					Permissions: []string{"iam.read", "org.read"},
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				zitadelRoles:    customRoleTestRoles,
+			}
+			got, err := c.AddCustomRole(tt.args.ctx, tt.args.resourceOwner, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		role          *domain.CustomRole
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"permission exceeds creator, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"unchanged, no push",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"user.write"},
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role:          &domain.CustomRole{Key: "ORG_CUSTOM_HELPDESK", DisplayName: "Helpdesk", Permissions: []string{"user.write"}},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"permissions changed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"user.write"},
+							),
+						),
+					),
+					expectPush(
+						customrole.NewChangedEvent(context.Background(),
+							customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+							"ORG_CUSTOM_HELPDESK",
+							[]customrole.Changes{
+								customrole.ChangePermissions([]string{"user.read", "user.credential.write"}),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				role: &domain.CustomRole{
+					Key:         "ORG_CUSTOM_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				zitadelRoles:    customRoleTestRoles,
+			}
+			got, err := c.ChangeCustomRole(tt.args.ctx, tt.args.resourceOwner, tt.args.role)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveCustomRole(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		key           string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no key, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"already removed, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"user.write"},
+							),
+						),
+						eventFromEventPusher(
+							customrole.NewRemovedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				key:           "ORG_CUSTOM_HELPDESK",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+								"ORG_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"user.write"},
+							),
+						),
+					),
+					expectPush(
+						customrole.NewRemovedEvent(context.Background(),
+							customrole.NewAggregate("ORG_CUSTOM_HELPDESK", "org1", "instance"),
+							"ORG_CUSTOM_HELPDESK",
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				key:           "ORG_CUSTOM_HELPDESK",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveCustomRole(tt.args.ctx, tt.args.resourceOwner, tt.args.key)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
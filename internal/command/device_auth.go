@@ -159,6 +159,7 @@ func (c *Commands) CreateOIDCSessionFromDeviceAuth(ctx context.Context, deviceCo
 		deviceAuthModel.UserAuthMethods,
 		deviceAuthModel.AuthTime,
 		"",
+		"",
 		deviceAuthModel.PreferredLanguage,
 		deviceAuthModel.UserAgent,
 	)
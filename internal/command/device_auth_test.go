@@ -699,7 +699,7 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 					expectPush(
 						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
-							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", &language.Afrikaans, &domain.UserAgent{
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", "", &language.Afrikaans, &domain.UserAgent{
 								FingerprintID: gu.Ptr("fp1"),
 								IP:            net.ParseIP("1.2.3.4"),
 								Description:   gu.Ptr("firefox"),
@@ -796,7 +796,7 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 					expectPush(
 						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
-							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", &language.Afrikaans, &domain.UserAgent{
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", "", &language.Afrikaans, &domain.UserAgent{
 								FingerprintID: gu.Ptr("fp1"),
 								IP:            net.ParseIP("1.2.3.4"),
 								Description:   gu.Ptr("firefox"),
@@ -18,9 +18,10 @@ func writeModelToObjectRoot(writeModel eventstore.WriteModel) models.ObjectRoot
 
 func memberWriteModelToMember(writeModel *MemberWriteModel) *domain.Member {
 	return &domain.Member{
-		ObjectRoot: writeModelToObjectRoot(writeModel.WriteModel),
-		Roles:      writeModel.Roles,
-		UserID:     writeModel.UserID,
+		ObjectRoot:     writeModelToObjectRoot(writeModel.WriteModel),
+		Roles:          writeModel.Roles,
+		UserID:         writeModel.UserID,
+		AccessValidity: writeModel.AccessValidity,
 	}
 }
 
@@ -42,6 +43,8 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		TrustedDeviceLifetime:      wm.TrustedDeviceLifetime,
+		RiskThresholds:             wm.RiskThresholds,
 	}
 }
 
@@ -116,14 +119,15 @@ func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolic
 
 func writeModelToPrivacyPolicy(wm *PrivacyPolicyWriteModel) *domain.PrivacyPolicy {
 	return &domain.PrivacyPolicy{
-		ObjectRoot:     writeModelToObjectRoot(wm.WriteModel),
-		TOSLink:        wm.TOSLink,
-		PrivacyLink:    wm.PrivacyLink,
-		HelpLink:       wm.HelpLink,
-		SupportEmail:   wm.SupportEmail,
-		DocsLink:       wm.DocsLink,
-		CustomLink:     wm.CustomLink,
-		CustomLinkText: wm.CustomLinkText,
+		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
+		TOSLink:             wm.TOSLink,
+		PrivacyLink:         wm.PrivacyLink,
+		HelpLink:            wm.HelpLink,
+		SupportEmail:        wm.SupportEmail,
+		DocsLink:            wm.DocsLink,
+		CustomLink:          wm.CustomLink,
+		CustomLinkText:      wm.CustomLinkText,
+		DeletionGracePeriod: wm.DeletionGracePeriod,
 	}
 }
 
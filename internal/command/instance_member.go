@@ -18,10 +18,14 @@ func (c *Commands) AddInstanceMemberCommand(a *instance.Aggregate, userID string
 		if userID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTA-SDSfs", "Errors.Invalid.Argument")
 		}
-		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
+		configuredRoles, customRoles := splitCustomRoles(roles)
+		if len(domain.CheckForInvalidRoles(configuredRoles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+				if exists, err := existsCustomRoles(ctx, filter, "", domain.IAMCustomRolePrefix, customRoles); err != nil || !exists {
+					return nil, zerrors.ThrowInvalidArgument(err, "INSTANCE-Cr6aWq", "Errors.IAM.MemberInvalid")
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, zerrors.ThrowPreconditionFailed(err, "INSTA-GSXOn", "Errors.User.NotFound")
 				}
@@ -92,9 +96,13 @@ func (c *Commands) ChangeInstanceMember(ctx context.Context, member *domain.Memb
 	if !member.IsIAMValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-LiaZi", "Errors.IAM.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
+	configuredRoles, customRoles := splitCustomRoles(member.Roles)
+	if len(domain.CheckForInvalidRoles(configuredRoles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-3m9fs", "Errors.IAM.MemberInvalid")
 	}
+	if exists, err := existsCustomRoles(ctx, c.eventstore.Filter, "", domain.IAMCustomRolePrefix, customRoles); err != nil || !exists { //nolint
+		return nil, zerrors.ThrowInvalidArgument(err, "INSTANCE-Cr6bTe", "Errors.IAM.MemberInvalid")
+	}
 
 	existingMember, err := c.instanceMemberWriteModelByID(ctx, member.UserID)
 	if err != nil {
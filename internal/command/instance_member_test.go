@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -178,6 +179,102 @@ func TestCommandSide_AddIAMMember(t *testing.T) {
 				err: zerrors.IsErrorAlreadyExists,
 			},
 		},
+		{
+			name: "custom role not existing, error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("IAM_CUSTOM_HELPDESK", "INSTANCE", "INSTANCE"),
+								"IAM_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"iam.read"},
+							),
+						),
+						eventFromEventPusher(
+							customrole.NewRemovedEvent(context.Background(),
+								customrole.NewAggregate("IAM_CUSTOM_HELPDESK", "INSTANCE", "INSTANCE"),
+								"IAM_CUSTOM_HELPDESK",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "INSTANCE"),
+				userID: "user1",
+				roles:  []string{"IAM_CUSTOM_HELPDESK"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "member add custom role, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							customrole.NewAddedEvent(context.Background(),
+								customrole.NewAggregate("IAM_CUSTOM_HELPDESK", "INSTANCE", "INSTANCE"),
+								"IAM_CUSTOM_HELPDESK",
+								"Helpdesk",
+								[]string{"iam.read"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusherWithInstanceID(
+							"INSTANCE",
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						instance.NewMemberAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"user1",
+							[]string{"IAM_OWNER_VIEWER", "IAM_CUSTOM_HELPDESK"}...,
+						),
+					),
+				),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "IAM_OWNER_VIEWER",
+					},
+				},
+			},
+			args: args{
+				ctx:    authz.WithInstanceID(context.Background(), "INSTANCE"),
+				userID: "user1",
+				roles:  []string{"IAM_OWNER_VIEWER", "IAM_CUSTOM_HELPDESK"},
+			},
+			res: res{
+				want: &domain.Member{
+					ObjectRoot: models.ObjectRoot{
+						InstanceID:    "INSTANCE",
+						ResourceOwner: "INSTANCE",
+						AggregateID:   "INSTANCE",
+					},
+					UserID: "user1",
+					Roles:  []string{"IAM_OWNER_VIEWER", "IAM_CUSTOM_HELPDESK"},
+				},
+			},
+		},
 		{
 			name: "member add, ok",
 			fields: fields{
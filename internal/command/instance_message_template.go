@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultMessageTemplate validates and compiles the uploaded template
+// and uses it for all emails of the message type and language of the instance
+func (c *Commands) SetDefaultMessageTemplate(ctx context.Context, upload *MessageTemplateUpload) (*domain.ObjectDetails, error) {
+	mailhtml, err := upload.compile()
+	if err != nil {
+		return nil, err
+	}
+	existing, err := c.defaultMessageTemplateWriteModelByID(ctx, upload.MessageType, upload.Language)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	asset, err := c.uploadMessageTemplate(ctx, instanceID, upload)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.removeReplacedMessageTemplate(ctx, instanceID, &existing.MessageTemplateWriteModel, asset.Name); err != nil {
+		return nil, err
+	}
+	instanceAgg := instance.NewAggregate(instanceID)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateSetEvent(ctx, &instanceAgg.Aggregate, upload.MessageType, upload.Language, upload.Format, asset.Name, mailhtml))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// RemoveDefaultMessageTemplate removes the template of the message type and language,
+// the emails will be rendered using the mail template of the instance
+func (c *Commands) RemoveDefaultMessageTemplate(ctx context.Context, messageType string, lang language.Tag) (*domain.ObjectDetails, error) {
+	if err := validateMessageTemplateKey(messageType, lang); err != nil {
+		return nil, err
+	}
+	existing, err := c.defaultMessageTemplateWriteModelByID(ctx, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-Mt6fVa", "Errors.MessageTemplate.NotFound")
+	}
+	if err = c.removeAsset(ctx, authz.GetInstance(ctx).InstanceID(), existing.StoreKey); err != nil {
+		return nil, err
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMessageTemplateRemovedEvent(ctx, instanceAgg, messageType, lang, existing.StoreKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) defaultMessageTemplateWriteModelByID(ctx context.Context, messageType string, lang language.Tag) (*InstanceMessageTemplateWriteModel, error) {
+	writeModel := NewInstanceMessageTemplateWriteModel(ctx, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceMessageTemplateWriteModel struct {
+	MessageTemplateWriteModel
+}
+
+func NewInstanceMessageTemplateWriteModel(ctx context.Context, messageType string, lang language.Tag) *InstanceMessageTemplateWriteModel {
+	return &InstanceMessageTemplateWriteModel{
+		MessageTemplateWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+			MessageType: messageType,
+			Language:    lang,
+		},
+	}
+}
+
+func (wm *InstanceMessageTemplateWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.MessageTemplateSetEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateSetEvent)
+		case *instance.MessageTemplateRemovedEvent:
+			wm.MessageTemplateWriteModel.AppendEvents(&e.MessageTemplateRemovedEvent)
+		}
+	}
+}
+
+func (wm *InstanceMessageTemplateWriteModel) Reduce() error {
+	return wm.MessageTemplateWriteModel.Reduce()
+}
+
+func (wm *InstanceMessageTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.MessageTemplateWriteModel.AggregateID).
+		EventTypes(instance.MessageTemplateSetEventType, instance.MessageTemplateRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,313 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetDefaultMessageTemplate(t *testing.T) {
+	const mjml = `<mjml><mj-body><mj-section><mj-column><mj-text>{{.Greeting}}</mj-text></mj-column></mj-section></mj-body></mjml>`
+	compiledMJML, err := templates.CompileMJML(mjml)
+	assert.NoError(t, err)
+
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		storage    func(t *testing.T) static.Storage
+	}
+	type args struct {
+		upload *MessageTemplateUpload
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "unknown message type, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				storage:    func(t *testing.T) static.Storage { return mock.NewStorage(t) },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: "Unknown",
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatHTML,
+					Template:    []byte("<html></html>"),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unknown field, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				storage:    func(t *testing.T) static.Storage { return mock.NewStorage(t) },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatHTML,
+					Template:    []byte("<html>{{.Unknown}}</html>"),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid mjml, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				storage:    func(t *testing.T) static.Storage { return mock.NewStorage(t) },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatMJML,
+					Template:    []byte("<mjml><mj-body><mj-text>text</mj-text></mj-body></mjml>"),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unsupported mjml tag, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				storage:    func(t *testing.T) static.Storage { return mock.NewStorage(t) },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatMJML,
+					Template:    []byte("<mjml><mj-body><mj-section><mj-column><mj-carousel></mj-carousel></mj-column></mj-section></mj-body></mjml>"),
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mt3cSc", "Errors.MessageTemplate.UnsupportedTag"))
+				},
+			},
+		},
+		{
+			name: "upload failed, internal error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				storage: func(t *testing.T) static.Storage { return mock.NewStorage(t).ExpectPutObjectError() },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatHTML,
+					Template:    []byte("<html>{{.Greeting}}</html>"),
+				},
+			},
+			res: res{
+				err: zerrors.IsInternal,
+			},
+		},
+		{
+			name: "html template set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewMessageTemplateSetEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType,
+							language.English,
+							domain.MessageTemplateFormatHTML,
+							"policy/mail/template/InitCode-en.html",
+							[]byte("<html>{{.Greeting}}</html>"),
+						),
+					),
+				),
+				storage: func(t *testing.T) static.Storage { return mock.NewStorage(t).ExpectPutObject() },
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatHTML,
+					Template:    []byte("<html>{{.Greeting}}</html>"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "html replaced by mjml, old source removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMessageTemplateSetEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								domain.InitCodeMessageType,
+								language.English,
+								domain.MessageTemplateFormatHTML,
+								"policy/mail/template/InitCode-en.html",
+								[]byte("<html>{{.Greeting}}</html>"),
+							),
+						),
+					),
+					expectPush(
+						instance.NewMessageTemplateSetEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType,
+							language.English,
+							domain.MessageTemplateFormatMJML,
+							"policy/mail/template/InitCode-en.mjml",
+							[]byte(compiledMJML),
+						),
+					),
+				),
+				storage: func(t *testing.T) static.Storage {
+					return mock.NewStorage(t).ExpectPutObject().ExpectRemoveObjectNoError()
+				},
+			},
+			args: args{
+				upload: &MessageTemplateUpload{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					Format:      domain.MessageTemplateFormatMJML,
+					Template:    []byte(mjml),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage(t),
+			}
+			got, err := r.SetDefaultMessageTemplate(authz.WithInstanceID(context.Background(), "INSTANCE"), tt.args.upload)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveDefaultMessageTemplate(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		storage    func(t *testing.T) static.Storage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				storage: func(t *testing.T) static.Storage { return mock.NewStorage(t) },
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMessageTemplateSetEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								domain.InitCodeMessageType,
+								language.English,
+								domain.MessageTemplateFormatHTML,
+								"policy/mail/template/InitCode-en.html",
+								[]byte("<html>{{.Greeting}}</html>"),
+							),
+						),
+					),
+					expectPush(
+						instance.NewMessageTemplateRemovedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType,
+							language.English,
+							"policy/mail/template/InitCode-en.html",
+						),
+					),
+				),
+				storage: func(t *testing.T) static.Storage { return mock.NewStorage(t).ExpectRemoveObjectNoError() },
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.storage(t),
+			}
+			got, err := r.RemoveDefaultMessageTemplate(authz.WithInstanceID(context.Background(), "INSTANCE"), domain.InitCodeMessageType, language.English)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
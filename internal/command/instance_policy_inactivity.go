@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultInactivityPolicy adds the inactivity policy of the instance or changes it, if it already exists.
+func (c *Commands) SetDefaultInactivityPolicy(ctx context.Context, policy *domain.InactivityPolicy) (*domain.InactivityPolicy, error) {
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	existingPolicy, err := c.defaultInactivityPolicyWriteModelByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := &instance.NewAggregate(authz.GetInstance(ctx).InstanceID()).Aggregate
+	var event eventstore.Command
+	if existingPolicy.State == domain.PolicyStateActive {
+		changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy)
+		if !hasChanged {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-In2aAa", "Errors.IAM.InactivityPolicy.NotChanged")
+		}
+		event = changedEvent
+	} else {
+		event = instance.NewInactivityPolicyAddedEvent(ctx, instanceAgg,
+			policy.WarnBeforeDays,
+			policy.HumanDeactivateAfterDays,
+			policy.HumanDeleteAfterDays,
+			policy.MachineDeactivateAfterDays,
+			policy.MachineDeleteAfterDays,
+		)
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToInactivityPolicy(&existingPolicy.InactivityPolicyWriteModel), nil
+}
+
+func (c *Commands) defaultInactivityPolicyWriteModelByID(ctx context.Context) (policy *InstanceInactivityPolicyWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewInstanceInactivityPolicyWriteModel(ctx)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
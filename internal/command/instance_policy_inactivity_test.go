@@ -0,0 +1,184 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetDefaultInactivityPolicy(t *testing.T) {
+	type fields struct {
+		eventstore *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		policy *domain.InactivityPolicy
+	}
+	type res struct {
+		want *domain.InactivityPolicy
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "deletion before deactivation, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				policy: &domain.InactivityPolicy{
+					MachineDeactivateAfterDays: 90,
+					MachineDeleteAfterDays:     90,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						instance.NewInactivityPolicyAddedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							10, 90, 365, 0, 0,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				policy: &domain.InactivityPolicy{
+					WarnBeforeDays:           10,
+					HumanDeactivateAfterDays: 90,
+					HumanDeleteAfterDays:     365,
+				},
+			},
+			res: res{
+				want: &domain.InactivityPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+						InstanceID:    "INSTANCE",
+					},
+					WarnBeforeDays:           10,
+					HumanDeactivateAfterDays: 90,
+					HumanDeleteAfterDays:     365,
+				},
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewInactivityPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								10, 90, 365, 0, 0,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				policy: &domain.InactivityPolicy{
+					WarnBeforeDays:           10,
+					HumanDeactivateAfterDays: 90,
+					HumanDeleteAfterDays:     365,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "change, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewInactivityPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								10, 90, 365, 0, 0,
+							),
+						),
+					),
+					expectPush(
+						newDefaultInactivityPolicyChangedEvent(context.Background(), 0, 180),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "INSTANCE"),
+				policy: &domain.InactivityPolicy{
+					WarnBeforeDays:         10,
+					HumanDeleteAfterDays:   365,
+					MachineDeleteAfterDays: 180,
+				},
+			},
+			res: res{
+				want: &domain.InactivityPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "INSTANCE",
+						ResourceOwner: "INSTANCE",
+						InstanceID:    "INSTANCE",
+					},
+					WarnBeforeDays:         10,
+					HumanDeleteAfterDays:   365,
+					MachineDeleteAfterDays: 180,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore,
+			}
+			got, err := r.SetDefaultInactivityPolicy(tt.args.ctx, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func newDefaultInactivityPolicyChangedEvent(ctx context.Context, humanDeactivateAfterDays, machineDeleteAfterDays uint64) *instance.InactivityPolicyChangedEvent {
+	event, _ := instance.NewInactivityPolicyChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		[]policy.InactivityPolicyChanges{
+			policy.ChangeHumanDeactivateAfterDays(humanDeactivateAfterDays),
+			policy.ChangeMachineDeleteAfterDays(machineDeleteAfterDays),
+		},
+	)
+	return event
+}
@@ -76,7 +76,9 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	externalLoginCheckLifetime,
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
-	multiFactorCheckLifetime time.Duration,
+	multiFactorCheckLifetime,
+	trustedDeviceLifetime time.Duration,
+	riskThresholds domain.RiskThresholds,
 ) (*instance.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -125,6 +127,12 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.MultiFactorCheckLifetime != multiFactorCheckLifetime {
 		changes = append(changes, policy.ChangeMultiFactorCheckLifetime(multiFactorCheckLifetime))
 	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.RiskThresholds != riskThresholds {
+		changes = append(changes, policy.ChangeRiskThresholds(riskThresholds))
+	}
 	if wm.DisableLoginWithEmail != disableLoginWithEmail {
 		changes = append(changes, policy.ChangeDisableLoginWithEmail(disableLoginWithEmail))
 	}
@@ -79,6 +79,8 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -137,6 +139,8 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -158,7 +162,8 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 							time.Hour*20,
 							time.Hour*30,
 							time.Hour*40,
-							time.Hour*50),
+							time.Hour*50,
+							domain.RiskThresholds{Notify: 20, RequireMFA: 40, Block: 80}),
 					),
 				),
 			},
@@ -182,6 +187,7 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 					MFAInitSkipLifetime:        time.Hour * 30,
 					SecondFactorCheckLifetime:  time.Hour * 40,
 					MultiFactorCheckLifetime:   time.Hour * 50,
+					RiskThresholds:             domain.RiskThresholds{Notify: 20, RequireMFA: 40, Block: 80},
 				},
 			},
 			res: res{
@@ -287,6 +293,8 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -329,6 +337,8 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -391,6 +401,8 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -529,6 +541,8 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -571,6 +585,8 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -626,6 +642,8 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -682,6 +700,8 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1407,7 +1427,8 @@ func newDefaultLoginPolicyChangedEvent(ctx context.Context, allowRegister, allow
 	hidePasswordReset, ignoreUnknownUsernames, allowDomainDiscovery, disableLoginWithEmail, disableLoginWithPhone bool,
 	passwordlessType domain.PasswordlessType,
 	redirectURI string,
-	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime time.Duration) *instance.LoginPolicyChangedEvent {
+	passwordLifetime, externalLoginLifetime, mfaInitSkipLifetime, secondFactorLifetime, multiFactorLifetime time.Duration,
+	riskThresholds domain.RiskThresholds) *instance.LoginPolicyChangedEvent {
 	event, _ := instance.NewLoginPolicyChangedEvent(ctx,
 		&instance.NewAggregate("INSTANCE").Aggregate,
 		[]policy.LoginPolicyChanges{
@@ -1428,6 +1449,7 @@ func newDefaultLoginPolicyChangedEvent(ctx context.Context, allowRegister, allow
 			policy.ChangeMFAInitSkipLifetime(mfaInitSkipLifetime),
 			policy.ChangeSecondFactorCheckLifetime(secondFactorLifetime),
 			policy.ChangeMultiFactorCheckLifetime(multiFactorLifetime),
+			policy.ChangeRiskThresholds(riskThresholds),
 		},
 	)
 	return event
@@ -131,9 +131,10 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		resourceOwner  string
-		passwordChange bool
+		ctx                   context.Context
+		resourceOwner         string
+		passwordChange        bool
+		securityNotifications *domain.SecurityNotifications
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -217,13 +218,57 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change security notifications, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewNotificationPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								true,
+							),
+						),
+					),
+					expectPush(
+						func() *instance.NotificationPolicyChangedEvent {
+							event, _ := instance.NewNotificationPolicyChangedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								[]policy.NotificationPolicyChanges{
+									policy.ChangeSecurityNotifications(domain.SecurityNotifications{
+										MFAAdded:     true,
+										EmailChanged: true,
+									}),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				resourceOwner:  "INSTANCE",
+				passwordChange: true,
+				securityNotifications: &domain.SecurityNotifications{
+					MFAAdded:     true,
+					EmailChanged: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange)
+			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange, tt.args.securityNotifications)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -60,6 +61,7 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	helpLink string,
 	supportEmail domain.EmailAddress,
 	docsLink, customLink, customLinkText string,
+	deletionGracePeriod time.Duration,
 ) (*instance.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -84,6 +86,9 @@ func (wm *InstancePrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.CustomLinkText != customLinkText {
 		changes = append(changes, policy.ChangeCustomLinkText(customLinkText))
 	}
+	if wm.DeletionGracePeriod != deletionGracePeriod {
+		changes = append(changes, policy.ChangeDeletionGracePeriod(deletionGracePeriod))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
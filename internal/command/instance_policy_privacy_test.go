@@ -55,6 +55,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"Custom",
+								0,
 							),
 						),
 					),
@@ -90,6 +91,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 							"DocsLink",
 							"CustomLink",
 							"Custom",
+							0,
 						),
 					),
 				),
@@ -147,6 +149,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 							"",
 							"",
 							"",
+							0,
 						),
 					),
 				),
@@ -170,7 +173,7 @@ func TestCommandSide_AddDefaultPrivacyPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail, tt.args.docsLink, tt.args.customLink, tt.args.customLinkText)
+			got, err := r.AddDefaultPrivacyPolicy(tt.args.ctx, tt.args.tosLink, tt.args.privacyLink, tt.args.helpLink, tt.args.supportEmail, tt.args.docsLink, tt.args.customLink, tt.args.customLinkText, 0)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -242,6 +245,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
@@ -302,6 +306,7 @@ func TestCommandSide_ChangeDefaultPrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
@@ -128,6 +128,8 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			nil,
 			false,
 			"",
+			false,
+			"",
 		),
 	}
 }
@@ -207,11 +209,11 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
-		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
+		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour, 0, domain.RiskThresholds{}),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeU2F),
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
-		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
+		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", "", 0),
 		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
 		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
 		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
@@ -255,19 +257,22 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MfaInitSkipLifetime        time.Duration
 			SecondFactorCheckLifetime  time.Duration
 			MultiFactorCheckLifetime   time.Duration
-		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
+			TrustedDeviceLifetime      time.Duration
+			RiskThresholds             domain.RiskThresholds
+		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour, 0, domain.RiskThresholds{}},
 		NotificationPolicy: struct {
 			PasswordChange bool
 		}{true},
 		PrivacyPolicy: struct {
-			TOSLink        string
-			PrivacyLink    string
-			HelpLink       string
-			SupportEmail   domain.EmailAddress
-			DocsLink       string
-			CustomLink     string
-			CustomLinkText string
-		}{"", "", "", "", "", "", ""},
+			TOSLink             string
+			PrivacyLink         string
+			HelpLink            string
+			SupportEmail        domain.EmailAddress
+			DocsLink            string
+			CustomLink          string
+			CustomLinkText      string
+			DeletionGracePeriod time.Duration
+		}{"", "", "", "", "", "", "", 0},
 		LabelPolicy: struct {
 			PrimaryColor        string
 			BackgroundColor     string
@@ -441,6 +446,8 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				nil,
 				false,
 				"",
+				false,
+				"",
 			),
 		),
 		expectFilter(
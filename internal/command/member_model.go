@@ -11,6 +11,7 @@ type MemberWriteModel struct {
 
 	UserID string
 	Roles  []string
+	domain.AccessValidity
 
 	State domain.MemberState
 }
@@ -32,6 +33,14 @@ func (wm *MemberWriteModel) Reduce() error {
 			wm.Roles = e.Roles
 		case *member.MemberRemovedEvent:
 			wm.Roles = nil
+			wm.AccessValidity = domain.AccessValidity{}
+			wm.State = domain.MemberStateRemoved
+		case *member.MemberValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *member.MemberExpiredEvent:
+			wm.Roles = nil
+			wm.AccessValidity = domain.AccessValidity{}
 			wm.State = domain.MemberStateRemoved
 		}
 	}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMemberValidity restricts the membership of the user on the organization to the provided period of time.
+// A zero validity removes the restriction.
+func (c *Commands) SetOrgMemberValidity(ctx context.Context, orgID, userID string, validity domain.AccessValidity) (_ *domain.ObjectDetails, err error) {
+	if orgID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Mv5aQa", "Errors.Org.MemberInvalid")
+	}
+	if !validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Mv5bRb", "Errors.Member.ValidityInvalid")
+	}
+	existingMember, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existingMember.AccessValidity.Equal(validity) {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, existingMember, org.NewMemberValiditySetEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&existingMember.WriteModel),
+		userID,
+		validity.ValidFrom,
+		validity.ValidUntil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+// ExpireOrgMember removes the membership of the user on the organization after the end of its validity.
+// Memberships which do not exist anymore or which are still valid are ignored.
+func (c *Commands) ExpireOrgMember(ctx context.Context, orgID, userID string) (err error) {
+	if orgID == "" || userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "Org-Mv5cSc", "Errors.Org.MemberInvalid")
+	}
+	existingMember := NewOrgMemberWriteModel(orgID, userID)
+	err = c.eventstore.FilterToQueryReducer(ctx, existingMember)
+	if err != nil {
+		return err
+	}
+	if existingMember.State != domain.MemberStateActive || !existingMember.ExpiredAt(time.Now()) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, org.NewMemberExpiredEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&existingMember.WriteModel),
+		userID,
+		existingMember.ValidUntil,
+	))
+	return err
+}
+
+// SetProjectMemberValidity restricts the membership of the user on the project to the provided period of time.
+// A zero validity removes the restriction.
+func (c *Commands) SetProjectMemberValidity(ctx context.Context, projectID, userID, resourceOwner string, validity domain.AccessValidity) (_ *domain.ObjectDetails, err error) {
+	if projectID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Mv5dTd", "Errors.Project.Member.Invalid")
+	}
+	if !validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Mv5eUe", "Errors.Member.ValidityInvalid")
+	}
+	existingMember, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingMember.AccessValidity.Equal(validity) {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, existingMember, project.NewMemberValiditySetEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&existingMember.WriteModel),
+		userID,
+		validity.ValidFrom,
+		validity.ValidUntil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+// ExpireProjectMember removes the membership of the user on the project after the end of its validity.
+// Memberships which do not exist anymore or which are still valid are ignored.
+func (c *Commands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (err error) {
+	if projectID == "" || userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Mv5fVf", "Errors.Project.Member.Invalid")
+	}
+	existingMember := NewProjectMemberWriteModel(projectID, userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, existingMember)
+	if err != nil {
+		return err
+	}
+	if existingMember.State != domain.MemberStateActive || !existingMember.ExpiredAt(time.Now()) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, project.NewMemberExpiredEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&existingMember.WriteModel),
+		userID,
+		existingMember.ValidUntil,
+	))
+	return err
+}
@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetOrgMemberValidity(t *testing.T) {
+	validUntil := time.Now().Add(24 * time.Hour).UTC()
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		userID   string
+		validity domain.AccessValidity
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing user, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "ends before start, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:   "user1",
+				validity: domain.AccessValidity{ValidFrom: validUntil, ValidUntil: validUntil.Add(-time.Hour)},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "member not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				validity: domain.AccessValidity{ValidUntil: validUntil},
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								"ORG_OWNER",
+							),
+						),
+					),
+					expectPush(
+						org.NewMemberValiditySetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							time.Time{},
+							validUntil,
+						),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				validity: domain.AccessValidity{ValidUntil: validUntil},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetOrgMemberValidity(context.Background(), "org1", tt.args.userID, tt.args.validity)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ExpireOrgMember(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).UTC()
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "not member, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+		},
+		{
+			name: "still valid, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								"ORG_OWNER",
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberValiditySetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								time.Time{},
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "expired, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								"ORG_OWNER",
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberValiditySetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								time.Time{},
+								expired,
+							),
+						),
+					),
+					expectPush(
+						org.NewMemberExpiredEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							expired,
+						),
+					),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.ExpireOrgMember(context.Background(), "org1", "user1")
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommands_ExpireProjectMember(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).UTC()
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					project.NewProjectMemberAddedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"user1",
+						"PROJECT_OWNER",
+					),
+				),
+				eventFromEventPusher(
+					project.NewMemberValiditySetEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"user1",
+						time.Time{},
+						expired,
+					),
+				),
+			),
+			expectPush(
+				project.NewMemberExpiredEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"user1",
+					expired,
+				),
+			),
+		)(t),
+	}
+	err := c.ExpireProjectMember(context.Background(), "project1", "user1", "org1")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const maxMessageTemplateSize = 1 << 19
+
+// MessageTemplateUpload is a HTML or MJML template used for the emails of a single message type and language
+type MessageTemplateUpload struct {
+	MessageType string
+	Language    language.Tag
+	Format      domain.MessageTemplateFormat
+	Template    []byte
+}
+
+func (u *MessageTemplateUpload) validate() error {
+	if err := validateMessageTemplateKey(u.MessageType, u.Language); err != nil {
+		return err
+	}
+	if !u.Format.Valid() || len(u.Template) == 0 || len(u.Template) > maxMessageTemplateSize {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mt3cSa", "Errors.MessageTemplate.Invalid")
+	}
+	return nil
+}
+
+// compile returns the HTML rendered for the messages, MJML templates are compiled first.
+// It's called before the template is stored, so templates using tags unsupported by the MJML compiler are rejected on save.
+func (u *MessageTemplateUpload) compile() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+	mailhtml, err := templates.CompileMessageTemplate(string(u.Template), u.Format)
+	var unsupported *templates.UnsupportedTagError
+	if errors.As(err, &unsupported) {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Mt3cSc", "Errors.MessageTemplate.UnsupportedTag")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Mt3cSb", "Errors.MessageTemplate.Invalid")
+	}
+	return []byte(mailhtml), nil
+}
+
+func validateMessageTemplateKey(messageType string, lang language.Tag) error {
+	if !domain.IsMessageTextType(messageType) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mt4dTa", "Errors.MessageTemplate.Invalid")
+	}
+	if err := domain.LanguageIsDefined(lang); err != nil {
+		return err
+	}
+	return domain.LanguagesAreSupported(i18n.SupportedLanguages(), lang)
+}
+
+// uploadMessageTemplate stores the uploaded source of the template as asset
+func (c *Commands) uploadMessageTemplate(ctx context.Context, resourceOwner string, upload *MessageTemplateUpload) (*static.Asset, error) {
+	asset, err := c.uploadAsset(ctx, &AssetUpload{
+		ResourceOwner: resourceOwner,
+		ObjectName:    domain.MessageTemplateObjectName(upload.MessageType, upload.Language, upload.Format),
+		ContentType:   upload.Format.ContentType(),
+		ObjectType:    static.ObjectTypeStyling,
+		File:          bytes.NewReader(upload.Template),
+		Size:          int64(len(upload.Template)),
+	})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-Mt5eUa", "Errors.Assets.Object.PutFailed")
+	}
+	return asset, nil
+}
+
+// removeReplacedMessageTemplate removes the previously uploaded source if it is stored under another name,
+// e.g. because a HTML template is replaced by a MJML template
+func (c *Commands) removeReplacedMessageTemplate(ctx context.Context, resourceOwner string, existing *MessageTemplateWriteModel, storeKey string) error {
+	if existing.State != domain.PolicyStateActive || existing.StoreKey == "" || existing.StoreKey == storeKey {
+		return nil
+	}
+	return c.removeAsset(ctx, resourceOwner, existing.StoreKey)
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type MessageTemplateWriteModel struct {
+	eventstore.WriteModel
+
+	MessageType string
+	Language    language.Tag
+	Format      domain.MessageTemplateFormat
+	StoreKey    string
+
+	State domain.PolicyState
+}
+
+func (wm *MessageTemplateWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.MessageTemplateSetEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.Format = e.Format
+			wm.StoreKey = e.StoreKey
+			wm.State = domain.PolicyStateActive
+		case *policy.MessageTemplateRemovedEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.Format = domain.MessageTemplateFormatUnspecified
+			wm.StoreKey = ""
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
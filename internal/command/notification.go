@@ -30,6 +30,13 @@ type NotificationRequest struct {
 	RequiresPreviousDomain        bool
 }
 
+// NotificationDelivery describes how a notification was handed over to the provider
+type NotificationDelivery struct {
+	ProviderID        string
+	ProviderMessageID string
+	RecipientHash     string
+}
+
 type NotificationRetryRequest struct {
 	NotificationRequest
 	BackOff    time.Duration
@@ -129,8 +136,15 @@ func (c *Commands) NotificationCanceled(ctx context.Context, tx *sql.Tx, id, res
 }
 
 // NotificationSent writes a new notification.SentEvent with the notification.Aggregate to the eventstore
-func (c *Commands) NotificationSent(ctx context.Context, tx *sql.Tx, id, resourceOwner string) error {
-	_, err := c.eventstore.PushWithClient(ctx, tx, notification.NewSentEvent(ctx, &notification.NewAggregate(id, resourceOwner).Aggregate))
+func (c *Commands) NotificationSent(ctx context.Context, tx *sql.Tx, id, resourceOwner string, delivery *NotificationDelivery) error {
+	if delivery == nil {
+		delivery = new(NotificationDelivery)
+	}
+	_, err := c.eventstore.PushWithClient(ctx, tx, notification.NewSentEvent(ctx, &notification.NewAggregate(id, resourceOwner).Aggregate,
+		delivery.ProviderID,
+		delivery.ProviderMessageID,
+		delivery.RecipientHash,
+	))
 	return err
 }
 
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ChangeNotificationDeliveryStatus sets the status reported by the provider for a sent notification.
+// The providerID and providerMessageID must match the ones the notification was sent with,
+// repeated reports of the current status are ignored.
+func (c *Commands) ChangeNotificationDeliveryStatus(ctx context.Context, id, resourceOwner, providerID, providerMessageID string, status domain.NotificationDeliveryStatus, errorMessage string) (*domain.ObjectDetails, error) {
+	if id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl4vRa", "Errors.IDMissing")
+	}
+	if !status.IsProviderStatus() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl4vRb", "Errors.Notification.Delivery.InvalidStatus")
+	}
+	writeModel := NewNotificationDeliveryWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.ProviderMessageID == "" ||
+		writeModel.ProviderID != providerID ||
+		writeModel.ProviderMessageID != providerMessageID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dl4vRc", "Errors.Notification.Delivery.NotFound")
+	}
+	if writeModel.Status == status {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	err := c.pushAppendAndReduce(ctx, writeModel, notification.NewDeliveryStatusChangedEvent(
+		ctx,
+		&notification.NewAggregate(id, resourceOwner).Aggregate,
+		status,
+		errorMessage,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	ProviderID        string
+	ProviderMessageID string
+	Status            domain.NotificationDeliveryStatus
+}
+
+func NewNotificationDeliveryWriteModel(id, resourceOwner string) *NotificationDeliveryWriteModel {
+	return &NotificationDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *NotificationDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.RequestedEvent:
+			wm.Status = domain.NotificationDeliveryStatusRequested
+		case *notification.RetryRequestedEvent:
+			wm.Status = domain.NotificationDeliveryStatusRetrying
+		case *notification.CanceledEvent:
+			wm.Status = domain.NotificationDeliveryStatusFailed
+		case *notification.SentEvent:
+			wm.ProviderID = e.ProviderID
+			wm.ProviderMessageID = e.ProviderMessageID
+			wm.Status = domain.NotificationDeliveryStatusSent
+		case *notification.DeliveryStatusChangedEvent:
+			wm.Status = e.Status
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.RequestedType,
+			notification.RetryRequestedType,
+			notification.CanceledType,
+			notification.SentType,
+			notification.DeliveryStatusType,
+		).
+		Builder()
+}
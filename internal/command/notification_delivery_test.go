@@ -0,0 +1,188 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_ChangeNotificationDeliveryStatus(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		id                string
+		providerID        string
+		providerMessageID string
+		status            domain.NotificationDeliveryStatus
+		errorMessage      string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				status: domain.NotificationDeliveryStatusDelivered,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl4vRa", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "status not reported by provider, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				id:     "notification1",
+				status: domain.NotificationDeliveryStatusSent,
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl4vRb", "Errors.Notification.Delivery.InvalidStatus"),
+			},
+		},
+		{
+			name: "notification not sent, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				id:                "notification1",
+				providerID:        "provider1",
+				providerMessageID: "message1",
+				status:            domain.NotificationDeliveryStatusDelivered,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Dl4vRc", "Errors.Notification.Delivery.NotFound"),
+			},
+		},
+		{
+			name: "message id of other provider, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							notification.NewSentEvent(context.Background(),
+								&notification.NewAggregate("notification1", "instance1").Aggregate,
+								"provider2",
+								"message1",
+								"hash",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				id:                "notification1",
+				providerID:        "provider1",
+				providerMessageID: "message1",
+				status:            domain.NotificationDeliveryStatusDelivered,
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Dl4vRc", "Errors.Notification.Delivery.NotFound"),
+			},
+		},
+		{
+			name: "status already set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							notification.NewSentEvent(context.Background(),
+								&notification.NewAggregate("notification1", "instance1").Aggregate,
+								"provider1",
+								"message1",
+								"hash",
+							),
+						),
+						eventFromEventPusher(
+							notification.NewDeliveryStatusChangedEvent(context.Background(),
+								&notification.NewAggregate("notification1", "instance1").Aggregate,
+								domain.NotificationDeliveryStatusDelivered,
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				id:                "notification1",
+				providerID:        "provider1",
+				providerMessageID: "message1",
+				status:            domain.NotificationDeliveryStatusDelivered,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			name: "bounced, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							notification.NewSentEvent(context.Background(),
+								&notification.NewAggregate("notification1", "instance1").Aggregate,
+								"provider1",
+								"message1",
+								"hash",
+							),
+						),
+					),
+					expectPush(
+						notification.NewDeliveryStatusChangedEvent(context.Background(),
+							&notification.NewAggregate("notification1", "instance1").Aggregate,
+							domain.NotificationDeliveryStatusBounced,
+							"mailbox full",
+						),
+					),
+				),
+			},
+			args: args{
+				id:                "notification1",
+				providerID:        "provider1",
+				providerMessageID: "message1",
+				status:            domain.NotificationDeliveryStatusBounced,
+				errorMessage:      "mailbox full",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ChangeNotificationDeliveryStatus(context.Background(), tt.args.id, "instance1", tt.args.providerID, tt.args.providerMessageID, tt.args.status, tt.args.errorMessage)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
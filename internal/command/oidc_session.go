@@ -42,6 +42,7 @@ type OIDCSession struct {
 	Scope             []string
 	AuthMethods       []domain.UserAuthMethodType
 	AuthTime          time.Time
+	ACR               string
 	Nonce             string
 	PreferredLanguage *language.Tag
 	UserAgent         *domain.UserAgent
@@ -104,6 +105,7 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(ctx context.Context, authReq
 		authReqModel.Scope,
 		authReqModel.AuthMethods,
 		authReqModel.AuthTime,
+		authReqModel.ACR,
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
@@ -140,6 +142,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	audience []string,
 	authMethods []domain.UserAuthMethodType,
 	authTime time.Time,
+	acr,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
@@ -163,7 +166,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, acr, nonce, preferredLanguage, userAgent)
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
 		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
@@ -403,6 +406,7 @@ func (c *OIDCSessionEvents) AddSession(
 	scope []string,
 	authMethods []domain.UserAuthMethodType,
 	authTime time.Time,
+	acr,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
@@ -418,6 +422,7 @@ func (c *OIDCSessionEvents) AddSession(
 		scope,
 		authMethods,
 		authTime,
+		acr,
 		nonce,
 		preferredLanguage,
 		userAgent,
@@ -526,6 +531,7 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		Scope:             c.oidcSessionWriteModel.Scope,
 		AuthMethods:       c.oidcSessionWriteModel.AuthMethods,
 		AuthTime:          c.oidcSessionWriteModel.AuthTime,
+		ACR:               c.oidcSessionWriteModel.ACR,
 		Nonce:             c.oidcSessionWriteModel.Nonce,
 		PreferredLanguage: c.oidcSessionWriteModel.PreferredLanguage,
 		UserAgent:         c.oidcSessionWriteModel.UserAgent,
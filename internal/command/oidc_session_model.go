@@ -23,6 +23,7 @@ type OIDCSessionWriteModel struct {
 	Scope                      []string
 	AuthMethods                []domain.UserAuthMethodType
 	AuthTime                   time.Time
+	ACR                        string
 	Nonce                      string
 	UserAgent                  *domain.UserAgent
 	State                      domain.OIDCSessionState
@@ -98,6 +99,7 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Scope = e.Scope
 	wm.AuthMethods = e.AuthMethods
 	wm.AuthTime = e.AuthTime
+	wm.ACR = e.ACR
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
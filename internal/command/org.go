@@ -417,6 +417,139 @@ func (c *Commands) ReactivateOrg(ctx context.Context, orgID string) (*domain.Obj
 	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
 }
 
+// SetOrgParent attaches the organization to a parent organization.
+// The parent must be writable by the caller, as its members might gain access to the organization.
+func (c *Commands) SetOrgParent(ctx context.Context, orgID string, parent *domain.OrgParent) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || !parent.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Hr1aQp", "Errors.Org.Invalid")
+	}
+	if orgID == parent.ParentOrgID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Hr1bVs", "Errors.Org.Hierarchy.Cycle")
+	}
+	writeModel, err := c.getOrgParentWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(writeModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Hr1cKw", "Errors.Org.NotFound")
+	}
+	if writeModel.ParentOrgID == parent.ParentOrgID &&
+		writeModel.InheritIDPs == parent.InheritIDPs &&
+		writeModel.InheritProjectGrants == parent.InheritProjectGrants &&
+		writeModel.InheritMembers == parent.InheritMembers {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.checkPermission(ctx, domain.PermissionOrgWrite, parent.ParentOrgID, parent.ParentOrgID); err != nil {
+		return nil, err
+	}
+	if err := c.checkOrgParentChain(ctx, orgID, parent.ParentOrgID); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewOrgParentSetEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		parent.ParentOrgID,
+		parent.InheritIDPs,
+		parent.InheritProjectGrants,
+		parent.InheritMembers,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// checkOrgParentChain walks up the ancestors of the new parent,
+// so the organization never becomes its own ancestor and the hierarchy stays within [domain.OrgHierarchyMaxDepth].
+func (c *Commands) checkOrgParentChain(ctx context.Context, orgID, parentOrgID string) error {
+	current := parentOrgID
+	for depth := 1; current != ""; depth++ {
+		if current == orgID {
+			return zerrors.ThrowPreconditionFailed(nil, "ORG-Hr1dCy", "Errors.Org.Hierarchy.Cycle")
+		}
+		if depth > domain.OrgHierarchyMaxDepth {
+			return zerrors.ThrowPreconditionFailed(nil, "ORG-Hr1eDp", "Errors.Org.Hierarchy.TooDeep")
+		}
+		ancestor, err := c.getOrgParentWriteModel(ctx, current)
+		if err != nil {
+			return err
+		}
+		if !isOrgStateExists(ancestor.State) {
+			if depth == 1 {
+				return zerrors.ThrowPreconditionFailed(nil, "ORG-Hr1fNf", "Errors.Org.Hierarchy.ParentNotFound")
+			}
+			return nil
+		}
+		current = ancestor.ParentOrgID
+	}
+	return nil
+}
+
+// RemoveOrgParent detaches the organization from its parent,
+// policies are then resolved from the instance again.
+func (c *Commands) RemoveOrgParent(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Hr1gRm", "Errors.Org.Invalid")
+	}
+	writeModel, err := c.getOrgParentWriteModel(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(writeModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Hr1hKq", "Errors.Org.NotFound")
+	}
+	if writeModel.ParentOrgID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Hr1iPz", "Errors.Org.Hierarchy.ParentNotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, org.NewOrgParentRemovedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.ParentOrgID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// orgInheritsProjectGrants checks if the project grants of the granted organization
+// are passed down the hierarchy to the organization.
+func (c *Commands) orgInheritsProjectGrants(ctx context.Context, orgID, grantedOrgID string) (bool, error) {
+	current := orgID
+	for depth := 0; depth < domain.OrgHierarchyMaxDepth; depth++ {
+		writeModel, err := c.getOrgParentWriteModel(ctx, current)
+		if err != nil {
+			return false, err
+		}
+		if !isOrgStateExists(writeModel.State) || writeModel.ParentOrgID == "" || !writeModel.InheritProjectGrants {
+			return false, nil
+		}
+		if writeModel.ParentOrgID == grantedOrgID {
+			return true, nil
+		}
+		current = writeModel.ParentOrgID
+	}
+	return false, nil
+}
+
+func (c *Commands) getOrgParentWriteModel(ctx context.Context, orgID string) (_ *OrgParentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOrgParentWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
 func (c *Commands) RemoveOrg(ctx context.Context, id string) (*domain.ObjectDetails, error) {
 	orgAgg := org.NewAggregate(id)
 
@@ -21,13 +21,17 @@ func (c *Commands) AddOrgMemberCommand(a *org.Aggregate, userID string, roles ..
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-PfYhb", "Errors.Invalid.Argument")
 		}
 
-		if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+		configuredRoles, customRoles := splitCustomRoles(roles)
+		if len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 				ctx, span := tracing.NewSpan(ctx)
 				defer func() { span.EndWithError(err) }()
 
+				if exists, err := existsCustomRoles(ctx, filter, a.ID, domain.OrgCustomRolePrefix, customRoles); err != nil || !exists {
+					return nil, zerrors.ThrowInvalidArgument(err, "ORG-Cr7aPk", "Errors.Org.MemberInvalid")
+				}
 				if exists, err := ExistsUser(ctx, filter, userID, ""); err != nil || !exists {
 					return nil, zerrors.ThrowPreconditionFailed(err, "ORG-GoXOn", "Errors.User.NotFound")
 				}
@@ -51,6 +55,7 @@ func IsOrgMember(ctx context.Context, filter preparation.FilterToQueryReducer, o
 			org.MemberAddedEventType,
 			org.MemberRemovedEventType,
 			org.MemberCascadeRemovedEventType,
+			org.MemberExpiredEventType,
 		).Builder())
 	if err != nil {
 		return false, err
@@ -70,6 +75,10 @@ func IsOrgMember(ctx context.Context, filter preparation.FilterToQueryReducer, o
 			if e.UserID == userID {
 				isMember = false
 			}
+		case *org.MemberExpiredEvent:
+			if e.UserID == userID {
+				isMember = false
+			}
 		}
 	}
 
@@ -101,9 +110,13 @@ func (c *Commands) addOrgMember(ctx context.Context, orgAgg *eventstore.Aggregat
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-W8m4l", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(member.Roles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
+	configuredRoles, customRoles := splitCustomRoles(member.Roles)
+	if len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(configuredRoles, domain.RoleSelfManagementGlobal, c.zitadelRoles)) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 	}
+	if exists, err := existsCustomRoles(ctx, c.eventstore.Filter, orgAgg.ID, domain.OrgCustomRolePrefix, customRoles); err != nil || !exists { //nolint
+		return nil, zerrors.ThrowInvalidArgument(err, "Org-Cr7bMs", "Errors.Org.MemberInvalid")
+	}
 	err := c.eventstore.FilterToQueryReducer(ctx, addedMember)
 	if err != nil {
 		return nil, err
@@ -120,9 +133,13 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *domain.Member) (
 	if !member.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-LiaZi", "Errors.Org.MemberInvalid")
 	}
-	if len(domain.CheckForInvalidRoles(member.Roles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
+	configuredRoles, customRoles := splitCustomRoles(member.Roles)
+	if len(domain.CheckForInvalidRoles(configuredRoles, domain.OrgRolePrefix, c.zitadelRoles)) > 0 {
 		return nil, zerrors.ThrowInvalidArgument(nil, "IAM-m9fG8", "Errors.Org.MemberInvalid")
 	}
+	if exists, err := existsCustomRoles(ctx, c.eventstore.Filter, member.AggregateID, domain.OrgCustomRolePrefix, customRoles); err != nil || !exists { //nolint
+		return nil, zerrors.ThrowInvalidArgument(err, "Org-Cr7cVn", "Errors.Org.MemberInvalid")
+	}
 
 	existingMember, err := c.orgMemberWriteModelByID(ctx, member.AggregateID, member.UserID)
 	if err != nil {
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMessageTemplate validates and compiles the uploaded template
+// and uses it for all emails of the message type and language of the organization
+func (c *Commands) SetOrgMessageTemplate(ctx context.Context, resourceOwner string, upload *MessageTemplateUpload) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Mt7gWa", "Errors.ResourceOwnerMissing")
+	}
+	mailhtml, err := upload.compile()
+	if err != nil {
+		return nil, err
+	}
+	existing, err := c.orgMessageTemplateWriteModelByID(ctx, resourceOwner, upload.MessageType, upload.Language)
+	if err != nil {
+		return nil, err
+	}
+	asset, err := c.uploadMessageTemplate(ctx, resourceOwner, upload)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.removeReplacedMessageTemplate(ctx, resourceOwner, &existing.MessageTemplateWriteModel, asset.Name); err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateSetEvent(ctx, orgAgg, upload.MessageType, upload.Language, upload.Format, asset.Name, mailhtml))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// RemoveOrgMessageTemplate removes the template of the message type and language,
+// the emails will fall back to the template of the instance
+func (c *Commands) RemoveOrgMessageTemplate(ctx context.Context, resourceOwner, messageType string, lang language.Tag) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Mt8hXa", "Errors.ResourceOwnerMissing")
+	}
+	if err := validateMessageTemplateKey(messageType, lang); err != nil {
+		return nil, err
+	}
+	existing, err := c.orgMessageTemplateWriteModelByID(ctx, resourceOwner, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Mt8hXb", "Errors.MessageTemplate.NotFound")
+	}
+	if err = c.removeAsset(ctx, resourceOwner, existing.StoreKey); err != nil {
+		return nil, err
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMessageTemplateRemovedEvent(ctx, orgAgg, messageType, lang, existing.StoreKey))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) orgMessageTemplateWriteModelByID(ctx context.Context, orgID, messageType string, lang language.Tag) (*OrgMessageTemplateWriteModel, error) {
+	writeModel := NewOrgMessageTemplateWriteModel(orgID, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
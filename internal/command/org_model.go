@@ -63,3 +63,59 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 func OrgAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, org.AggregateType, org.AggregateVersion)
 }
+
+type OrgParentWriteModel struct {
+	eventstore.WriteModel
+
+	State                domain.OrgState
+	ParentOrgID          string
+	InheritIDPs          bool
+	InheritProjectGrants bool
+	InheritMembers       bool
+}
+
+func NewOrgParentWriteModel(orgID string) *OrgParentWriteModel {
+	return &OrgParentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgParentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgAddedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgRemovedEvent:
+			wm.State = domain.OrgStateRemoved
+			wm.ParentOrgID = ""
+		case *org.OrgParentSetEvent:
+			wm.ParentOrgID = e.ParentOrgID
+			wm.InheritIDPs = e.InheritIDPs
+			wm.InheritProjectGrants = e.InheritProjectGrants
+			wm.InheritMembers = e.InheritMembers
+		case *org.OrgParentRemovedEvent:
+			wm.ParentOrgID = ""
+			wm.InheritIDPs = false
+			wm.InheritProjectGrants = false
+			wm.InheritMembers = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgParentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.OrgAddedEventType,
+			org.OrgRemovedEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddInactivityPolicy(ctx context.Context, resourceOwner string, policy *domain.InactivityPolicy) (*domain.InactivityPolicy, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-In3aAa", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	addedPolicy := NewOrgInactivityPolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if addedPolicy.State == domain.PolicyStateActive {
+		return nil, zerrors.ThrowAlreadyExists(nil, "ORG-In3bBb", "Errors.Org.InactivityPolicy.AlreadyExists")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&addedPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewInactivityPolicyAddedEvent(ctx, orgAgg,
+		policy.WarnBeforeDays,
+		policy.HumanDeactivateAfterDays,
+		policy.HumanDeleteAfterDays,
+		policy.MachineDeactivateAfterDays,
+		policy.MachineDeleteAfterDays,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(addedPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToInactivityPolicy(&addedPolicy.InactivityPolicyWriteModel), nil
+}
+
+func (c *Commands) ChangeInactivityPolicy(ctx context.Context, resourceOwner string, policy *domain.InactivityPolicy) (*domain.InactivityPolicy, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-In3cCc", "Errors.ResourceOwnerMissing")
+	}
+	if err := policy.IsValid(); err != nil {
+		return nil, err
+	}
+	existingPolicy := NewOrgInactivityPolicyWriteModel(resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-In3dDd", "Errors.Org.InactivityPolicy.NotFound")
+	}
+
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.InactivityPolicyWriteModel.WriteModel)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy)
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-In3eEe", "Errors.Org.InactivityPolicy.NotChanged")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToInactivityPolicy(&existingPolicy.InactivityPolicyWriteModel), nil
+}
+
+func (c *Commands) RemoveInactivityPolicy(ctx context.Context, orgID string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-In3fFf", "Errors.ResourceOwnerMissing")
+	}
+	existingPolicy := NewOrgInactivityPolicyWriteModel(orgID)
+	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if existingPolicy.State == domain.PolicyStateUnspecified || existingPolicy.State == domain.PolicyStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-In3gGg", "Errors.Org.InactivityPolicy.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewInactivityPolicyRemovedEvent(ctx, orgAgg))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPolicy, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPolicy.InactivityPolicyWriteModel.WriteModel), nil
+}
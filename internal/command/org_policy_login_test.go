@@ -69,6 +69,8 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -124,6 +126,8 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							0,
+							domain.RiskThresholds{},
 						),
 					),
 				),
@@ -218,6 +222,8 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							0,
+							domain.RiskThresholds{},
 						),
 						org.NewLoginPolicySecondFactorAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -343,6 +349,8 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							0,
+							domain.RiskThresholds{},
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -425,6 +433,8 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							0,
+							domain.RiskThresholds{},
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -563,6 +573,8 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -621,6 +633,8 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -770,6 +784,8 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -910,6 +926,8 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -955,6 +973,8 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1020,6 +1040,8 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1183,6 +1205,8 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1228,6 +1252,8 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1285,6 +1311,8 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1345,6 +1373,8 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -61,6 +62,7 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	helpLink string,
 	supportEmail domain.EmailAddress,
 	docsLink, customLink, customLinkText string,
+	deletionGracePeriod time.Duration,
 ) (*org.PrivacyPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PrivacyPolicyChanges, 0)
@@ -85,6 +87,9 @@ func (wm *OrgPrivacyPolicyWriteModel) NewChangedEvent(
 	if wm.CustomLinkText != customLinkText {
 		changes = append(changes, policy.ChangeCustomLinkText(customLinkText))
 	}
+	if wm.DeletionGracePeriod != deletionGracePeriod {
+		changes = append(changes, policy.ChangeDeletionGracePeriod(deletionGracePeriod))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
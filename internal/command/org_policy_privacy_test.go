@@ -71,7 +71,8 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 								"support@example.com",
 								"DocsLink",
 								"CustomLink",
-								"CustomLinkText"),
+								"CustomLinkText",
+								0),
 						),
 					),
 				),
@@ -109,6 +110,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 							"DocsLink",
 							"CustomLink",
 							"CustomLinkText",
+							0,
 						),
 					),
 				),
@@ -182,6 +184,7 @@ func TestCommandSide_AddPrivacyPolicy(t *testing.T) {
 							"",
 							"",
 							"",
+							0,
 						),
 					),
 				),
@@ -318,6 +321,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
@@ -375,6 +379,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
@@ -428,6 +433,7 @@ func TestCommandSide_ChangePrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
@@ -549,6 +555,7 @@ func TestCommandSide_RemovePrivacyPolicy(t *testing.T) {
 								"DocsLink",
 								"CustomLink",
 								"CustomLinkText",
+								0,
 							),
 						),
 					),
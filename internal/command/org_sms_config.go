@@ -0,0 +1,299 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMSConfigTwilio adds a Twilio SMS configuration to the organization of config.ResourceOwner.
+// The verify service is not supported, as codes verified by the provider are bound to the instance configuration.
+func (c *Commands) AddOrgSMSConfigTwilio(ctx context.Context, config *AddTwilioConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq9wGf", "Errors.ResourceOwnerMissing")
+	}
+	if config.VerifyServiceSID != "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq9wGg", "Errors.SMSConfig.VerifyServiceOnOrg")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	var token *crypto.CryptoValue
+	if config.Token != "" {
+		token, err = crypto.Encrypt([]byte(config.Token), c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigTwilioAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.SID,
+			config.SenderNumber,
+			token,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilio(ctx context.Context, config *ChangeTwilioConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lm2eHa", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lm2eHb", "Errors.IDMissing")
+	}
+	if config.VerifyServiceSID != nil && *config.VerifyServiceSID != "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Lm2eHc", "Errors.SMSConfig.VerifyServiceOnOrg")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Lm2eHd", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewTwilioChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.SID,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigTwilioToken(ctx context.Context, orgID, id, token string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xc7rNa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xc7rNb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Twilio == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Xc7rNc", "Errors.SMSConfig.NotFound")
+	}
+	newToken, err := crypto.Encrypt([]byte(token), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigTokenChangedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+			newToken,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddOrgSMSConfigHTTP(ctx context.Context, config *AddSMSHTTP) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gh4kVa", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigHTTPAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.Endpoint,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMSConfigHTTP(ctx context.Context, config *ChangeSMSHTTP) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Yd8pMa", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Yd8pMb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTP == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Yd8pMc", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.Endpoint,
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+// ActivateOrgSMSConfig activates the configuration, which is then used instead of the one of the instance.
+// A previously active configuration of the organization is deactivated by the projection.
+func (c *Commands) ActivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk3bTa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk3bTb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rk3bTc", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State == domain.SMSConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rk3bTd", "Errors.SMSConfig.AlreadyActive")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigActivatedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nw6sJa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Nw6sJb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Nw6sJc", "Errors.SMSConfig.NotFound")
+	}
+	if smsConfigWriteModel.State == domain.SMSConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Nw6sJd", "Errors.SMSConfig.AlreadyDeactivated")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigDeactivatedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMSConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fb1zQa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fb1zQb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getOrgSMSConfig(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Fb1zQc", "Errors.SMSConfig.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		org.NewSMSConfigRemovedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) getOrgSMSConfig(ctx context.Context, orgID, id string) (*OrgSMSConfigWriteModel, error) {
+	writeModel := NewOrgSMSConfigWriteModel(orgID, id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// hasActiveOrgSMSConfig returns true if the organization uses its own sms provider instead of the one of the instance
+func (c *Commands) hasActiveOrgSMSConfig(ctx context.Context, orgID string) (bool, error) {
+	writeModel := NewOrgSMSActiveConfigWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return false, err
+	}
+	return writeModel.activeID != "", nil
+}
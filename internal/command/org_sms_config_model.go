@@ -0,0 +1,217 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgSMSConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	Description string
+	Twilio      *TwilioConfig
+	HTTP        *HTTPConfig
+	State       domain.SMSConfigState
+}
+
+func NewOrgSMSConfigWriteModel(orgID, id string) *OrgSMSConfigWriteModel {
+	return &OrgSMSConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		ID: id,
+	}
+}
+
+func (wm *OrgSMSConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMSConfigTwilioAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Twilio = &TwilioConfig{
+				SID:          e.SID,
+				Token:        e.Token,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *org.SMSConfigTwilioChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.SID != nil {
+				wm.Twilio.SID = *e.SID
+			}
+			if e.SenderNumber != nil {
+				wm.Twilio.SenderNumber = *e.SenderNumber
+			}
+		case *org.SMSConfigTwilioTokenChangedEvent:
+			if wm.ID != e.ID || wm.Twilio == nil {
+				continue
+			}
+			wm.Twilio.Token = e.Token
+		case *org.SMSConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTP = &HTTPConfig{
+				Endpoint: e.Endpoint,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *org.SMSConfigHTTPChangedEvent:
+			if wm.ID != e.ID || wm.HTTP == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Endpoint != nil {
+				wm.HTTP.Endpoint = *e.Endpoint
+			}
+		case *org.SMSConfigActivatedEvent:
+			if wm.ID != e.ID {
+				if wm.State == domain.SMSConfigStateActive {
+					wm.State = domain.SMSConfigStateInactive
+				}
+				continue
+			}
+			wm.State = domain.SMSConfigStateActive
+		case *org.SMSConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMSConfigStateInactive
+		case *org.SMSConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Twilio = nil
+			wm.HTTP = nil
+			wm.State = domain.SMSConfigStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMSConfigTwilioAddedEventType,
+			org.SMSConfigTwilioChangedEventType,
+			org.SMSConfigTwilioTokenChangedEventType,
+			org.SMSConfigHTTPAddedEventType,
+			org.SMSConfigHTTPChangedEventType,
+			org.SMSConfigActivatedEventType,
+			org.SMSConfigDeactivatedEventType,
+			org.SMSConfigRemovedEventType).
+		Builder()
+}
+
+// OrgSMSActiveConfigWriteModel reduces the id of the active sms provider of the organization,
+// activating a provider replaces the previously active one
+type OrgSMSActiveConfigWriteModel struct {
+	eventstore.WriteModel
+
+	activeID string
+}
+
+func NewOrgSMSActiveConfigWriteModel(orgID string) *OrgSMSActiveConfigWriteModel {
+	return &OrgSMSActiveConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgSMSActiveConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMSConfigActivatedEvent:
+			wm.activeID = e.ID
+		case *org.SMSConfigDeactivatedEvent:
+			if wm.activeID == e.ID {
+				wm.activeID = ""
+			}
+		case *org.SMSConfigRemovedEvent:
+			if wm.activeID == e.ID {
+				wm.activeID = ""
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMSActiveConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMSConfigActivatedEventType,
+			org.SMSConfigDeactivatedEventType,
+			org.SMSConfigRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMSConfigWriteModel) NewTwilioChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, sid, senderNumber *string) (*org.SMSConfigTwilioChangedEvent, bool, error) {
+	if wm.Twilio == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigTwilioChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigTwilioDescription(*description))
+	}
+	if sid != nil && wm.Twilio.SID != *sid {
+		changes = append(changes, instance.ChangeSMSConfigTwilioSID(*sid))
+	}
+	if senderNumber != nil && wm.Twilio.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigTwilioSenderNumber(*senderNumber))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *OrgSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, endpoint *string) (*org.SMSConfigHTTPChangedEvent, bool, error) {
+	if wm.HTTP == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigHTTPChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigHTTPDescription(*description))
+	}
+	if endpoint != nil && wm.HTTP.Endpoint != *endpoint {
+		changes = append(changes, instance.ChangeSMSConfigHTTPEndpoint(*endpoint))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
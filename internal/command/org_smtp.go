@@ -0,0 +1,375 @@
+package command
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddOrgSMTPConfig adds an SMTP configuration to the organization of config.ResourceOwner.
+// Other than on the instance the sender address must always use a verified domain of the organization.
+func (c *Commands) AddOrgSMTPConfig(ctx context.Context, config *AddSMTPConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oS8mQe", "Errors.ResourceOwnerMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oS8mQf", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oS8mQg", "Errors.Invalid.Argument")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if config.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.Password), c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID, senderDomain(from))
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.domainVerified {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-oS8mQi", "Errors.SMTPConfig.SenderAdressNotOrgDomain")
+	}
+
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			config.ID,
+			strings.TrimSpace(config.Description),
+			config.Tls,
+			from,
+			config.FromName,
+			strings.TrimSpace(config.ReplyToAddress),
+			hostAndPort,
+			config.User,
+			smtpPassword,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfig(ctx context.Context, config *ChangeSMTPConfig) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq3nVd", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq3nVe", "Errors.IDMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq3nVf", "Errors.Invalid.Argument")
+	}
+	hostAndPort := strings.TrimSpace(config.Host)
+	if _, _, err := net.SplitHostPort(hostAndPort); err != nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq3nVg", "Errors.Invalid.Argument")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if config.Password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(config.Password), c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID, senderDomain(from))
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.SMTPConfig == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Wq3nVh", "Errors.SMTPConfig.NotFound")
+	}
+	if !smtpConfigWriteModel.domainVerified {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wq3nVi", "Errors.SMTPConfig.SenderAdressNotOrgDomain")
+	}
+
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		config.ID,
+		strings.TrimSpace(config.Description),
+		config.Tls,
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		hostAndPort,
+		config.User,
+		smtpPassword,
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, smtpConfigWriteModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfigPassword(ctx context.Context, orgID, id, password string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ht7cLp", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ht7cLq", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.SMTPConfig == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ht7cLr", "Errors.SMTPConfig.NotFound")
+	}
+
+	var smtpPassword *crypto.CryptoValue
+	if password != "" {
+		smtpPassword, err = crypto.Encrypt([]byte(password), c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigPasswordChangedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+			smtpPassword,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// GenerateOrgSMTPConfigWebhookToken generates a new token for the delivery callbacks of the SMTP provider of the organization
+// and replaces a previously generated one.
+// The token is stored encrypted and only returned in plain on generation.
+func (c *Commands) GenerateOrgSMTPConfigWebhookToken(ctx context.Context, orgID, id string) (string, *domain.ObjectDetails, error) {
+	if orgID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wh8tLa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wh8tLb", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return "", nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.SMTPConfig == nil {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Wh8tLc", "Errors.SMTPConfig.NotFound")
+	}
+
+	token, plain, err := crypto.NewCode(c.smtpWebhookTokenGenerator)
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigWebhookTokenGeneratedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+			token,
+		),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	return plain, writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) AddOrgSMTPConfigHTTP(ctx context.Context, config *AddSMTPConfigHTTP) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Zr4xKa", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID, "")
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigHTTPAddedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			config.ID,
+			strings.TrimSpace(config.Description),
+			strings.TrimSpace(config.Endpoint),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeOrgSMTPConfigHTTP(ctx context.Context, config *ChangeSMTPConfigHTTP) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue2sPm", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ue2sPn", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, config.ResourceOwner, config.ID, "")
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.HTTPConfig == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ue2sPo", "Errors.SMTPConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewHTTPChangedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		config.ID,
+		strings.TrimSpace(config.Description),
+		strings.TrimSpace(config.Endpoint),
+	)
+	if err != nil {
+		return err
+	}
+	if hasChanged {
+		if err = c.pushAppendAndReduce(ctx, smtpConfigWriteModel, changedEvent); err != nil {
+			return err
+		}
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+// ActivateOrgSMTPConfig activates the configuration, which is then used instead of the one of the instance.
+// A previously active configuration of the organization is deactivated by the projection.
+func (c *Commands) ActivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bv5dRt", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bv5dRu", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bv5dRv", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bv5dRw", "Errors.SMTPConfig.AlreadyActive")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigActivatedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) DeactivateOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jk8wEa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jk8wEb", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Jk8wEc", "Errors.SMTPConfig.NotFound")
+	}
+	if smtpConfigWriteModel.State == domain.SMTPConfigStateInactive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Jk8wEd", "Errors.SMTPConfig.AlreadyDeactivated")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigDeactivatedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgSMTPConfig(ctx context.Context, orgID, id string) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pf6yHn", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pf6yHo", "Errors.IDMissing")
+	}
+	smtpConfigWriteModel, err := c.getOrgSMTPConfig(ctx, orgID, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pf6yHp", "Errors.SMTPConfig.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		org.NewSMTPConfigRemovedEvent(
+			ctx,
+			OrgAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+func (c *Commands) getOrgSMTPConfig(ctx context.Context, orgID, id, domain string) (*OrgSMTPConfigWriteModel, error) {
+	writeModel := NewOrgSMTPConfigWriteModel(orgID, id, domain)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func senderDomain(address string) string {
+	parts := strings.Split(address, "@")
+	return parts[len(parts)-1]
+}
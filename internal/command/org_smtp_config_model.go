@@ -0,0 +1,245 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgSMTPConfigWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	Description string
+
+	SMTPConfig *SMTPConfig
+	HTTPConfig *HTTPConfig
+
+	State domain.SMTPConfigState
+
+	domain         string
+	domainVerified bool
+}
+
+func NewOrgSMTPConfigWriteModel(orgID, id, domain string) *OrgSMTPConfigWriteModel {
+	return &OrgSMTPConfigWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		ID:     id,
+		domain: domain,
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.DomainVerifiedEvent:
+			if e.Domain != wm.domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.DomainRemovedEvent:
+			if e.Domain != wm.domain {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.SMTPConfigAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = e.Description
+			wm.SMTPConfig = &SMTPConfig{
+				TLS:            e.TLS,
+				Host:           e.Host,
+				User:           e.User,
+				Password:       e.Password,
+				SenderName:     e.SenderName,
+				SenderAddress:  e.SenderAddress,
+				ReplyToAddress: e.ReplyToAddress,
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.SMTPConfigChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigChangedEvent(e)
+		case *org.SMTPConfigPasswordChangedEvent:
+			if wm.ID != e.ID || wm.SMTPConfig == nil {
+				continue
+			}
+			if e.Password != nil {
+				wm.SMTPConfig.Password = e.Password
+			}
+		case *org.SMTPConfigHTTPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = e.Description
+			wm.HTTPConfig = &HTTPConfig{
+				Endpoint: e.Endpoint,
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.SMTPConfigHTTPChangedEvent:
+			if wm.ID != e.ID || wm.HTTPConfig == nil {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Endpoint != nil {
+				wm.HTTPConfig.Endpoint = *e.Endpoint
+			}
+		case *org.SMTPConfigRemovedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Description = ""
+			wm.HTTPConfig = nil
+			wm.SMTPConfig = nil
+			wm.State = domain.SMTPConfigStateRemoved
+		case *org.SMTPConfigActivatedEvent:
+			if wm.ID != e.ID {
+				if wm.State == domain.SMTPConfigStateActive {
+					wm.State = domain.SMTPConfigStateInactive
+				}
+				continue
+			}
+			wm.State = domain.SMTPConfigStateActive
+		case *org.SMTPConfigDeactivatedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.State = domain.SMTPConfigStateInactive
+		case *org.DomainVerifiedEvent:
+			wm.domainVerified = true
+		case *org.DomainRemovedEvent:
+			wm.domainVerified = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgSMTPConfigWriteModel) reduceSMTPConfigChangedEvent(e *org.SMTPConfigChangedEvent) {
+	if wm.SMTPConfig == nil {
+		return
+	}
+	if e.Description != nil {
+		wm.Description = *e.Description
+	}
+	if e.TLS != nil {
+		wm.SMTPConfig.TLS = *e.TLS
+	}
+	if e.Host != nil {
+		wm.SMTPConfig.Host = *e.Host
+	}
+	if e.User != nil {
+		wm.SMTPConfig.User = *e.User
+	}
+	if e.Password != nil {
+		wm.SMTPConfig.Password = e.Password
+	}
+	if e.FromAddress != nil {
+		wm.SMTPConfig.SenderAddress = *e.FromAddress
+	}
+	if e.FromName != nil {
+		wm.SMTPConfig.SenderName = *e.FromName
+	}
+	if e.ReplyToAddress != nil {
+		wm.SMTPConfig.ReplyToAddress = *e.ReplyToAddress
+	}
+}
+
+func (wm *OrgSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.SMTPConfigAddedEventType,
+			org.SMTPConfigChangedEventType,
+			org.SMTPConfigPasswordChangedEventType,
+			org.SMTPConfigHTTPAddedEventType,
+			org.SMTPConfigHTTPChangedEventType,
+			org.SMTPConfigActivatedEventType,
+			org.SMTPConfigDeactivatedEventType,
+			org.SMTPConfigRemovedEventType,
+			org.OrgDomainVerifiedEventType,
+			org.OrgDomainRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description string, tls bool, fromAddress, fromName, replyToAddress, smtpHost, smtpUser string, smtpPassword *crypto.CryptoValue) (*org.SMTPConfigChangedEvent, bool, error) {
+	if wm.SMTPConfig == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMTPConfigChanges, 0)
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigDescription(description))
+	}
+	if wm.SMTPConfig.TLS != tls {
+		changes = append(changes, instance.ChangeSMTPConfigTLS(tls))
+	}
+	if wm.SMTPConfig.SenderAddress != fromAddress {
+		changes = append(changes, instance.ChangeSMTPConfigFromAddress(fromAddress))
+	}
+	if wm.SMTPConfig.SenderName != fromName {
+		changes = append(changes, instance.ChangeSMTPConfigFromName(fromName))
+	}
+	if wm.SMTPConfig.ReplyToAddress != replyToAddress {
+		changes = append(changes, instance.ChangeSMTPConfigReplyToAddress(replyToAddress))
+	}
+	if wm.SMTPConfig.Host != smtpHost {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPHost(smtpHost))
+	}
+	if wm.SMTPConfig.User != smtpUser {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPUser(smtpUser))
+	}
+	if smtpPassword != nil {
+		changes = append(changes, instance.ChangeSMTPConfigSMTPPassword(smtpPassword))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *OrgSMTPConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id, description, endpoint string) (*org.SMTPConfigHTTPChangedEvent, bool, error) {
+	if wm.HTTPConfig == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMTPConfigHTTPChanges, 0)
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPDescription(description))
+	}
+	if wm.HTTPConfig.Endpoint != endpoint {
+		changes = append(changes, instance.ChangeSMTPConfigHTTPEndpoint(endpoint))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := org.NewSMTPConfigHTTPChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
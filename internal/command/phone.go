@@ -18,8 +18,9 @@ type Phone struct {
 
 // newPhoneCode generates a new code to be sent out to via SMS or
 // returns the ID of the external code provider (e.g. when using Twilio verification API)
-func (c *Commands) newPhoneCode(ctx context.Context, filter preparation.FilterToQueryReducer, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
-	externalID, err := c.activeSMSProvider(ctx)
+// if the organization of the user (resourceOwner) has no provider of its own
+func (c *Commands) newPhoneCode(ctx context.Context, filter preparation.FilterToQueryReducer, resourceOwner string, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
+	externalID, err := c.activeSMSProvider(ctx, resourceOwner)
 	if err != nil {
 		return nil, "", err
 	}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type InactivityPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	WarnBeforeDays             uint64
+	HumanDeactivateAfterDays   uint64
+	HumanDeleteAfterDays       uint64
+	MachineDeactivateAfterDays uint64
+	MachineDeleteAfterDays     uint64
+	State                      domain.PolicyState
+}
+
+func (wm *InactivityPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.InactivityPolicyAddedEvent:
+			wm.WarnBeforeDays = e.WarnBeforeDays
+			wm.HumanDeactivateAfterDays = e.HumanDeactivateAfterDays
+			wm.HumanDeleteAfterDays = e.HumanDeleteAfterDays
+			wm.MachineDeactivateAfterDays = e.MachineDeactivateAfterDays
+			wm.MachineDeleteAfterDays = e.MachineDeleteAfterDays
+			wm.State = domain.PolicyStateActive
+		case *policy.InactivityPolicyChangedEvent:
+			if e.WarnBeforeDays != nil {
+				wm.WarnBeforeDays = *e.WarnBeforeDays
+			}
+			if e.HumanDeactivateAfterDays != nil {
+				wm.HumanDeactivateAfterDays = *e.HumanDeactivateAfterDays
+			}
+			if e.HumanDeleteAfterDays != nil {
+				wm.HumanDeleteAfterDays = *e.HumanDeleteAfterDays
+			}
+			if e.MachineDeactivateAfterDays != nil {
+				wm.MachineDeactivateAfterDays = *e.MachineDeactivateAfterDays
+			}
+			if e.MachineDeleteAfterDays != nil {
+				wm.MachineDeleteAfterDays = *e.MachineDeleteAfterDays
+			}
+		case *policy.InactivityPolicyRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InactivityPolicyWriteModel) changes(inactivityPolicy *domain.InactivityPolicy) []policy.InactivityPolicyChanges {
+	changes := make([]policy.InactivityPolicyChanges, 0, 5)
+	if wm.WarnBeforeDays != inactivityPolicy.WarnBeforeDays {
+		changes = append(changes, policy.ChangeInactivityWarnBeforeDays(inactivityPolicy.WarnBeforeDays))
+	}
+	if wm.HumanDeactivateAfterDays != inactivityPolicy.HumanDeactivateAfterDays {
+		changes = append(changes, policy.ChangeHumanDeactivateAfterDays(inactivityPolicy.HumanDeactivateAfterDays))
+	}
+	if wm.HumanDeleteAfterDays != inactivityPolicy.HumanDeleteAfterDays {
+		changes = append(changes, policy.ChangeHumanDeleteAfterDays(inactivityPolicy.HumanDeleteAfterDays))
+	}
+	if wm.MachineDeactivateAfterDays != inactivityPolicy.MachineDeactivateAfterDays {
+		changes = append(changes, policy.ChangeMachineDeactivateAfterDays(inactivityPolicy.MachineDeactivateAfterDays))
+	}
+	if wm.MachineDeleteAfterDays != inactivityPolicy.MachineDeleteAfterDays {
+		changes = append(changes, policy.ChangeMachineDeleteAfterDays(inactivityPolicy.MachineDeleteAfterDays))
+	}
+	return changes
+}
+
+func writeModelToInactivityPolicy(wm *InactivityPolicyWriteModel) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		ObjectRoot:                 writeModelToObjectRoot(wm.WriteModel),
+		WarnBeforeDays:             wm.WarnBeforeDays,
+		HumanDeactivateAfterDays:   wm.HumanDeactivateAfterDays,
+		HumanDeleteAfterDays:       wm.HumanDeleteAfterDays,
+		MachineDeactivateAfterDays: wm.MachineDeactivateAfterDays,
+		MachineDeleteAfterDays:     wm.MachineDeleteAfterDays,
+	}
+}
@@ -28,6 +28,8 @@ type LoginPolicyWriteModel struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
+	RiskThresholds             domain.RiskThresholds
 	State                      domain.PolicyState
 }
 
@@ -52,6 +54,8 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.MFAInitSkipLifetime = e.MFAInitSkipLifetime
 			wm.SecondFactorCheckLifetime = e.SecondFactorCheckLifetime
 			wm.MultiFactorCheckLifetime = e.MultiFactorCheckLifetime
+			wm.TrustedDeviceLifetime = e.TrustedDeviceLifetime
+			wm.RiskThresholds = e.RiskThresholds
 			wm.State = domain.PolicyStateActive
 		case *policy.LoginPolicyChangedEvent:
 			if e.AllowRegister != nil {
@@ -99,6 +103,12 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.MultiFactorCheckLifetime != nil {
 				wm.MultiFactorCheckLifetime = *e.MultiFactorCheckLifetime
 			}
+			if e.TrustedDeviceLifetime != nil {
+				wm.TrustedDeviceLifetime = *e.TrustedDeviceLifetime
+			}
+			if e.RiskThresholds != nil {
+				wm.RiskThresholds = *e.RiskThresholds
+			}
 			if e.DisableLoginWithEmail != nil {
 				wm.DisableLoginWithEmail = *e.DisableLoginWithEmail
 			}
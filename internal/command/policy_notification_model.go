@@ -9,8 +9,9 @@ import (
 type NotificationPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	PasswordChange bool
-	State          domain.PolicyState
+	PasswordChange        bool
+	SecurityNotifications domain.SecurityNotifications
+	State                 domain.PolicyState
 }
 
 func (wm *NotificationPolicyWriteModel) Reduce() error {
@@ -23,6 +24,9 @@ func (wm *NotificationPolicyWriteModel) Reduce() error {
 			if e.PasswordChange != nil {
 				wm.PasswordChange = *e.PasswordChange
 			}
+			if e.SecurityNotifications != nil {
+				wm.SecurityNotifications = *e.SecurityNotifications
+			}
 		case *policy.NotificationPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
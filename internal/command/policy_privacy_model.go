@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -9,14 +11,15 @@ import (
 type PrivacyPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	TOSLink        string
-	PrivacyLink    string
-	HelpLink       string
-	SupportEmail   domain.EmailAddress
-	State          domain.PolicyState
-	DocsLink       string
-	CustomLink     string
-	CustomLinkText string
+	TOSLink             string
+	PrivacyLink         string
+	HelpLink            string
+	SupportEmail        domain.EmailAddress
+	State               domain.PolicyState
+	DocsLink            string
+	CustomLink          string
+	CustomLinkText      string
+	DeletionGracePeriod time.Duration
 }
 
 func (wm *PrivacyPolicyWriteModel) Reduce() error {
@@ -31,6 +34,7 @@ func (wm *PrivacyPolicyWriteModel) Reduce() error {
 			wm.DocsLink = e.DocsLink
 			wm.CustomLink = e.CustomLink
 			wm.CustomLinkText = e.CustomLinkText
+			wm.DeletionGracePeriod = e.DeletionGracePeriod
 		case *policy.PrivacyPolicyChangedEvent:
 			if e.PrivacyLink != nil {
 				wm.PrivacyLink = *e.PrivacyLink
@@ -53,6 +57,9 @@ func (wm *PrivacyPolicyWriteModel) Reduce() error {
 			if e.CustomLinkText != nil {
 				wm.CustomLinkText = *e.CustomLinkText
 			}
+			if e.DeletionGracePeriod != nil {
+				wm.DeletionGracePeriod = *e.DeletionGracePeriod
+			}
 		case *policy.PrivacyPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
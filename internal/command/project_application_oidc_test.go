@@ -176,6 +176,8 @@ func TestAddOIDCApp(t *testing.T) {
 						[]string{"https://sub.test.ch"},
 						false,
 						"",
+						false,
+						"",
 					),
 				},
 			},
@@ -242,6 +244,8 @@ func TestAddOIDCApp(t *testing.T) {
 						nil,
 						false,
 						"",
+						false,
+						"",
 					),
 				},
 			},
@@ -308,6 +312,8 @@ func TestAddOIDCApp(t *testing.T) {
 						nil,
 						false,
 						"",
+						false,
+						"",
 					),
 				},
 			},
@@ -374,6 +380,8 @@ func TestAddOIDCApp(t *testing.T) {
 						nil,
 						false,
 						"",
+						false,
+						"",
 					),
 				},
 			},
@@ -521,6 +529,8 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							[]string{"https://sub.test.ch"},
 							true,
 							"https://test.ch/backchannel",
+							false,
+							"",
 						),
 					),
 				),
@@ -622,6 +632,8 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							[]string{"https://sub.test.ch"},
 							true,
 							"https://test.ch/backchannel",
+							false,
+							"",
 						),
 					),
 				),
@@ -858,6 +870,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								[]string{"https://sub.test.ch"},
 								true,
 								"https://test.ch/backchannel",
+								false,
+								"",
 							),
 						),
 					),
@@ -929,6 +943,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								[]string{"https://sub.test.ch"},
 								true,
 								"https://test.ch/backchannel",
+								false,
+								"",
 							),
 						),
 					),
@@ -1000,6 +1016,8 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								[]string{"https://sub.test.ch"},
 								true,
 								"https://test.ch/backchannel",
+								false,
+								"",
 							),
 						),
 					),
@@ -1035,6 +1053,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
 					BackChannelLogoutURI:     "https://test.ch/backchannel",
+					RequireConsent:           true,
 				},
 				resourceOwner: "org1",
 			},
@@ -1063,6 +1082,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: true,
 					BackChannelLogoutURI:     "https://test.ch/backchannel",
+					RequireConsent:           true,
 					Compliance:               &domain.Compliance{},
 					State:                    domain.AppStateActive,
 				},
@@ -1188,6 +1208,8 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								[]string{"https://sub.test.ch"},
 								false,
 								"",
+								false,
+								"",
 							),
 						),
 					),
@@ -1270,6 +1292,7 @@ func newOIDCAppChangedEvent(ctx context.Context, appID, projectID, resourceOwner
 		project.ChangeIDTokenRoleAssertion(false),
 		project.ChangeIDTokenUserinfoAssertion(false),
 		project.ChangeClockSkew(time.Second * 2),
+		project.ChangeRequireConsent(true),
 	}
 	event, _ := project.NewOIDCConfigChangedEvent(ctx,
 		&project.NewAggregate(projectID, resourceOwner).Aggregate,
@@ -1347,6 +1370,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							[]string{"https://sub.test.ch"},
 							false,
 							"",
+							false,
+							"",
 						),
 					),
 				),
@@ -1383,6 +1408,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							[]string{"https://sub.test.ch"},
 							false,
 							"",
+							false,
+							"",
 						),
 					),
 				),
@@ -1418,6 +1445,8 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							[]string{"https://sub.test.ch"},
 							false,
 							"",
+							false,
+							"",
 						),
 					),
 				),
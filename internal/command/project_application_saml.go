@@ -81,6 +81,7 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 			string(entity.EntityID),
 			samlApp.Metadata,
 			samlApp.MetadataURL,
+			samlApp.Options,
 		),
 	}, nil
 }
@@ -114,6 +115,10 @@ func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SA
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-3fk2b", "Errors.Project.App.SAMLMetadataFormat")
 	}
+	options := samlApp.Options
+	if options == nil {
+		options = existingSAML.Options
+	}
 
 	changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
 		ctx,
@@ -121,7 +126,9 @@ func (c *Commands) ChangeSAMLApplication(ctx context.Context, samlApp *domain.SA
 		samlApp.AppID,
 		string(entity.EntityID),
 		samlApp.Metadata,
-		samlApp.MetadataURL)
+		samlApp.MetadataURL,
+		options,
+	)
 	if err != nil {
 		return nil, err
 	}
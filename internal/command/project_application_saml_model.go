@@ -17,6 +17,7 @@ type SAMLApplicationWriteModel struct {
 	EntityID    string
 	Metadata    []byte
 	MetadataURL string
+	Options     *domain.SAMLAppOptions
 
 	State domain.AppState
 	saml  bool
@@ -121,6 +122,7 @@ func (wm *SAMLApplicationWriteModel) appendAddSAMLEvent(e *project.SAMLConfigAdd
 	wm.Metadata = e.Metadata
 	wm.MetadataURL = e.MetadataURL
 	wm.EntityID = e.EntityID
+	wm.Options = e.Options
 }
 
 func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfigChangedEvent) {
@@ -134,6 +136,9 @@ func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfig
 	if e.EntityID != "" {
 		wm.EntityID = e.EntityID
 	}
+	if e.Options != nil {
+		wm.Options = e.Options
+	}
 }
 
 func (wm *SAMLApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -161,6 +166,7 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	entityID string,
 	metadata []byte,
 	metadataURL string,
+	options *domain.SAMLAppOptions,
 ) (*project.SAMLConfigChangedEvent, bool, error) {
 	changes := make([]project.SAMLConfigChanges, 0)
 	var err error
@@ -173,6 +179,9 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	if wm.EntityID != entityID {
 		changes = append(changes, project.ChangeEntityID(entityID))
 	}
+	if options != nil && !reflect.DeepEqual(wm.Options, options) {
+		changes = append(changes, project.ChangeOptions(options))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
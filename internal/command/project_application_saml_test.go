@@ -48,6 +48,28 @@ var testMetadataChangedEntityID = []byte(`<?xml version="1.0"?>
 </md:EntityDescriptor>
 `)
 
+var testMetadataEncryption = []byte(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"
+                     validUntil="2022-08-26T14:08:16Z"
+                     cacheDuration="PT604800S"
+                     entityID="https://test.com/saml/metadata">
+    <md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+        <md:KeyDescriptor use="encryption">
+            <ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">
+                <ds:X509Data>
+                    <ds:X509Certificate>MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA</ds:X509Certificate>
+                </ds:X509Data>
+            </ds:KeyInfo>
+        </md:KeyDescriptor>
+        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>
+        <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+                                     Location="https://test.com/saml/acs"
+                                     index="1" />
+        
+    </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`)
+
 func TestCommandSide_AddSAMLApplication(t *testing.T) {
 	type fields struct {
 		eventstore  *eventstore.Eventstore
@@ -196,6 +218,7 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"https://test.com/saml/metadata",
 							testMetadata,
 							"",
+							nil,
 						),
 					),
 				),
@@ -254,6 +277,7 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"https://test.com/saml/metadata",
 							testMetadata,
 							"http://localhost:8080/saml/metadata",
+							nil,
 						),
 					),
 				),
@@ -322,6 +346,100 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "create saml app, invalid options, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:  "app",
+					Metadata: testMetadata,
+					Options: &domain.SAMLAppOptions{
+						AttributeMapping: &domain.SAMLAttributeMapping{
+							UserAttributes: []domain.SAMLUserAttribute{domain.SAMLUserAttributeUnspecified},
+						},
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "create saml app with options, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectPush(
+						project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						),
+						project.NewSAMLConfigAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"https://test.com/saml/metadata",
+							testMetadataEncryption,
+							"",
+							testSAMLAppOptions(),
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:  "app",
+					Metadata: testMetadataEncryption,
+					Options:  testSAMLAppOptions(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:    "app1",
+					AppName:  "app",
+					EntityID: "https://test.com/saml/metadata",
+					Metadata: testMetadataEncryption,
+					Options:  testSAMLAppOptions(),
+					State:    domain.AppStateActive,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -474,6 +592,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"http://localhost:8080/saml/metadata",
+								nil,
 							),
 						),
 					),
@@ -519,6 +638,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"",
+								nil,
 							),
 						),
 					),
@@ -564,6 +684,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"http://localhost:8080/saml/metadata",
+								nil,
 							),
 						),
 					),
@@ -630,6 +751,7 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"https://test.com/saml/metadata",
 								testMetadata,
 								"",
+								nil,
 							),
 						),
 					),
@@ -676,6 +798,69 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change saml app options, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadataEncryption,
+								"",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						newSAMLAppChangedEventOptions(context.Background(),
+							"app1",
+							"project1",
+							"org1",
+							"https://test.com/saml/metadata",
+							testSAMLAppOptions(),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:    "app1",
+					Metadata: testMetadataEncryption,
+					Options:  testSAMLAppOptions(),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:    "app1",
+					AppName:  "app",
+					EntityID: "https://test.com/saml/metadata",
+					Metadata: testMetadataEncryption,
+					Options:  testSAMLAppOptions(),
+					State:    domain.AppStateActive,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -746,3 +931,26 @@ func newTestClient(httpStatus int, metadata []byte) *http.Client {
 		Transport: fn,
 	}
 }
+
+func newSAMLAppChangedEventOptions(ctx context.Context, appID, projectID, resourceOwner, entityID string, options *domain.SAMLAppOptions) *project.SAMLConfigChangedEvent {
+	event, _ := project.NewSAMLConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		entityID,
+		[]project.SAMLConfigChanges{
+			project.ChangeOptions(options),
+		},
+	)
+	return event
+}
+
+func testSAMLAppOptions() *domain.SAMLAppOptions {
+	return &domain.SAMLAppOptions{
+		NameIDFormat: domain.SAMLNameIDFormatPersistent,
+		AttributeMapping: &domain.SAMLAttributeMapping{
+			UserAttributes:     []domain.SAMLUserAttribute{domain.SAMLUserAttributeEmail, domain.SAMLUserAttributeUsername},
+			MetadataKeys:       []string{"department"},
+			RolesAttributeName: "roles",
+		},
+	}
+}
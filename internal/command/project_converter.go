@@ -48,6 +48,8 @@ func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.O
 		AdditionalOrigins:        writeModel.AdditionalOrigins,
 		SkipNativeAppSuccessPage: writeModel.SkipNativeAppSuccessPage,
 		BackChannelLogoutURI:     writeModel.BackChannelLogoutURI,
+		RequireConsent:           writeModel.RequireConsent,
+		RequiredACR:              writeModel.RequiredACR,
 	}
 }
 
@@ -60,6 +62,7 @@ func samlWriteModelToSAMLConfig(writeModel *SAMLApplicationWriteModel) *domain.S
 		Metadata:    writeModel.Metadata,
 		MetadataURL: writeModel.MetadataURL,
 		EntityID:    writeModel.EntityID,
+		Options:     writeModel.Options,
 	}
 }
 
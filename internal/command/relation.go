@@ -0,0 +1,150 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/relation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetRelationSchema replaces the schema defining the object types, relations and permissions of the project.
+// Existing tuples which are no longer allowed by the schema are kept, but ignored by checks.
+func (c *Commands) SetRelationSchema(ctx context.Context, projectID, resourceOwner string, schema *domain.RelationSchema) (*domain.ObjectDetails, error) {
+	if projectID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4aSd", "Errors.IDMissing")
+	}
+	if !schema.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4bFg", "Errors.Relation.SchemaInvalid")
+	}
+	writeModel, err := c.getRelationSchemaWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.ProjectExists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rl4cHj", "Errors.Project.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		project.NewRelationSchemaSetEvent(ctx,
+			&project.NewAggregate(projectID, writeModel.ResourceOwner).Aggregate,
+			schema,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// WriteRelationTuples adds the tuples allowed by the schema of the project.
+// Tuples which already exist are ignored.
+// The returned consistency token can be used by checks to include the written tuples.
+func (c *Commands) WriteRelationTuples(ctx context.Context, projectID, resourceOwner string, tuples []*domain.RelationTuple) (*domain.RelationDetails, error) {
+	if projectID == "" || resourceOwner == "" || len(tuples) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4dKl", "Errors.IDMissing")
+	}
+	schemaWriteModel, err := c.getRelationSchemaWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !schemaWriteModel.ProjectExists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rl4eZx", "Errors.Project.NotFound")
+	}
+	if schemaWriteModel.Schema == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rl4fCv", "Errors.Relation.SchemaNotFound")
+	}
+	for _, tuple := range tuples {
+		if !tuple.IsValid() || !schemaWriteModel.Schema.Allows(tuple) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4gBn", "Errors.Relation.NotAllowed")
+		}
+	}
+	writeModel, err := c.getRelationTuplesWriteModel(ctx, projectID, resourceOwner, tuples)
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]eventstore.Command, 0, len(tuples))
+	for _, tuple := range tuples {
+		if writeModel.Exists(tuple) {
+			continue
+		}
+		// mark the tuple as written to ignore duplicates of the request
+		writeModel.Tuples[tuple.String()] = true
+		cmds = append(cmds, relation.NewTupleWrittenEvent(ctx,
+			relation.NewAggregate(projectID, tuple.ObjectType, tuple.ObjectID, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			projectID,
+			tuple,
+		))
+	}
+	return c.pushRelationTuples(ctx, writeModel, cmds)
+}
+
+// DeleteRelationTuples deletes the tuples of the project.
+// Tuples which do not exist are ignored.
+func (c *Commands) DeleteRelationTuples(ctx context.Context, projectID, resourceOwner string, tuples []*domain.RelationTuple) (*domain.RelationDetails, error) {
+	if projectID == "" || resourceOwner == "" || len(tuples) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4hMq", "Errors.IDMissing")
+	}
+	for _, tuple := range tuples {
+		if !tuple.IsValid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rl4iWe", "Errors.Relation.Invalid")
+		}
+	}
+	writeModel, err := c.getRelationTuplesWriteModel(ctx, projectID, resourceOwner, tuples)
+	if err != nil {
+		return nil, err
+	}
+	cmds := make([]eventstore.Command, 0, len(tuples))
+	for _, tuple := range tuples {
+		if !writeModel.Exists(tuple) {
+			continue
+		}
+		delete(writeModel.Tuples, tuple.String())
+		cmds = append(cmds, relation.NewTupleDeletedEvent(ctx,
+			relation.NewAggregate(projectID, tuple.ObjectType, tuple.ObjectID, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			projectID,
+			tuple,
+		))
+	}
+	return c.pushRelationTuples(ctx, writeModel, cmds)
+}
+
+// pushRelationTuples pushes the commands and returns the consistency token of the last event.
+// If there is nothing to push, the token of the latest event of the objects is returned.
+func (c *Commands) pushRelationTuples(ctx context.Context, writeModel *RelationTuplesWriteModel, cmds []eventstore.Command) (*domain.RelationDetails, error) {
+	if len(cmds) == 0 {
+		return &domain.RelationDetails{
+			ObjectDetails:    *writeModelToObjectDetails(&writeModel.WriteModel),
+			ConsistencyToken: domain.NewRelationConsistencyToken(writeModel.Position),
+		}, nil
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, events...); err != nil {
+		return nil, err
+	}
+	details := pushedEventsToObjectDetails(events)
+	return &domain.RelationDetails{
+		ObjectDetails:    *details,
+		ConsistencyToken: domain.NewRelationConsistencyToken(events[len(events)-1].Position()),
+	}, nil
+}
+
+func (c *Commands) getRelationSchemaWriteModel(ctx context.Context, projectID, resourceOwner string) (*RelationSchemaWriteModel, error) {
+	writeModel := NewRelationSchemaWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getRelationTuplesWriteModel(ctx context.Context, projectID, resourceOwner string, tuples []*domain.RelationTuple) (*RelationTuplesWriteModel, error) {
+	writeModel := NewRelationTuplesWriteModel(projectID, resourceOwner, tuples)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/relation"
+)
+
+type RelationSchemaWriteModel struct {
+	eventstore.WriteModel
+
+	Schema       *domain.RelationSchema
+	ProjectState domain.ProjectState
+}
+
+func NewRelationSchemaWriteModel(projectID, resourceOwner string) *RelationSchemaWriteModel {
+	return &RelationSchemaWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *RelationSchemaWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.ProjectState = domain.ProjectStateActive
+		case *project.ProjectRemovedEvent:
+			wm.ProjectState = domain.ProjectStateRemoved
+			wm.Schema = nil
+		case *project.RelationSchemaSetEvent:
+			wm.Schema = e.Schema
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *RelationSchemaWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.RelationSchemaSetType,
+		).
+		Builder()
+}
+
+func (wm *RelationSchemaWriteModel) ProjectExists() bool {
+	return wm.ProjectState != domain.ProjectStateUnspecified && wm.ProjectState != domain.ProjectStateRemoved
+}
+
+// RelationTuplesWriteModel reduces the existing tuples of the objects of the project.
+type RelationTuplesWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID    string
+	aggregateIDs []string
+	// Tuples contains the string representation of the existing tuples
+	Tuples map[string]bool
+	// Position of the latest event of the objects
+	Position float64
+}
+
+func NewRelationTuplesWriteModel(projectID, resourceOwner string, tuples []*domain.RelationTuple) *RelationTuplesWriteModel {
+	aggregateIDs := make([]string, 0, len(tuples))
+	for _, tuple := range tuples {
+		aggregateIDs = append(aggregateIDs, relation.AggregateID(projectID, tuple.ObjectType, tuple.ObjectID))
+	}
+	return &RelationTuplesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		ProjectID:    projectID,
+		aggregateIDs: aggregateIDs,
+		Tuples:       make(map[string]bool),
+	}
+}
+
+func (wm *RelationTuplesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *relation.TupleWrittenEvent:
+			wm.Tuples[e.Domain().String()] = true
+		case *relation.TupleDeletedEvent:
+			delete(wm.Tuples, e.Domain().String())
+		}
+		if event.Position() > wm.Position {
+			wm.Position = event.Position()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *RelationTuplesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(relation.AggregateType).
+		AggregateIDs(wm.aggregateIDs...).
+		EventTypes(
+			relation.TupleWrittenEventType,
+			relation.TupleDeletedEventType,
+		).
+		Builder()
+}
+
+func (wm *RelationTuplesWriteModel) Exists(tuple *domain.RelationTuple) bool {
+	return wm.Tuples[tuple.String()]
+}
@@ -0,0 +1,508 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/relation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func relationTestSchema() *domain.RelationSchema {
+	return &domain.RelationSchema{
+		Types: []*domain.RelationObjectType{
+			{
+				Name: "group",
+				Relations: []*domain.RelationDefinition{
+					{Name: "member", Subjects: []string{"user"}},
+				},
+			},
+			{
+				Name: "document",
+				Relations: []*domain.RelationDefinition{
+					{Name: "editor", Subjects: []string{"user", "group#member"}},
+				},
+				Permissions: []*domain.PermissionDefinition{
+					{Name: "edit", Union: []string{"editor", "role:admin"}},
+				},
+			},
+		},
+	}
+}
+
+func relationTestTuple(t *testing.T, tuple string) *domain.RelationTuple {
+	parsed, err := domain.ParseRelationTuple(tuple)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestCommands_SetRelationSchema(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		resourceOwner string
+		schema        *domain.RelationSchema
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no project id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				resourceOwner: "org1",
+				schema:        relationTestSchema(),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid schema, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				schema: &domain.RelationSchema{
+					Types: []*domain.RelationObjectType{
+						{
+							Name: "document",
+							Relations: []*domain.RelationDefinition{
+								{Name: "editor", Subjects: []string{"group#member"}},
+							},
+						},
+					},
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"project not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				schema:        relationTestSchema(),
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"set, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						project.NewRelationSchemaSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							relationTestSchema(),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				schema:        relationTestSchema(),
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "project1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetRelationSchema(tt.args.ctx, tt.args.projectID, tt.args.resourceOwner, tt.args.schema)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_WriteRelationTuples(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		resourceOwner string
+		tuples        []string
+	}
+	type res struct {
+		want *domain.RelationDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no tuples, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"no schema, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []string{"document:1#editor@user:user1"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"not allowed by schema, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRelationSchemaSetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								relationTestSchema(),
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []string{"document:1#edit@user:user1"},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"write, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRelationSchemaSetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								relationTestSchema(),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							relation.NewTupleWrittenEvent(context.Background(),
+								relation.NewAggregate("project1", "document", "1", "org1", "instance"),
+								"project1",
+								relationTestTuple(t, "document:1#editor@user:user1"),
+							),
+						),
+					),
+					expectPush(
+						relation.NewTupleWrittenEvent(context.Background(),
+							relation.NewAggregate("project1", "document", "1", "org1", "instance"),
+							"project1",
+							relationTestTuple(t, "document:1#editor@group:eng#member"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples: []string{
+					"document:1#editor@user:user1",
+					"document:1#editor@group:eng#member",
+					"document:1#editor@group:eng#member",
+				},
+			},
+			res{
+				want: &domain.RelationDetails{
+					ObjectDetails: domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ConsistencyToken: domain.NewRelationConsistencyToken(0),
+				},
+			},
+		},
+		{
+			"already written, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRelationSchemaSetEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								relationTestSchema(),
+							),
+						),
+					),
+					expectFilter(
+						func() eventstore.Event {
+							event := eventFromEventPusher(
+								relation.NewTupleWrittenEvent(context.Background(),
+									relation.NewAggregate("project1", "document", "1", "org1", "instance"),
+									"project1",
+									relationTestTuple(t, "document:1#editor@user:user1"),
+								),
+							)
+							event.Pos = 42.5
+							return event
+						}(),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []string{"document:1#editor@user:user1"},
+			},
+			res{
+				want: &domain.RelationDetails{
+					ObjectDetails: domain.ObjectDetails{
+						ResourceOwner: "org1",
+						ID:            relation.AggregateID("project1", "document", "1"),
+					},
+					ConsistencyToken: domain.NewRelationConsistencyToken(42.5),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			tuples := make([]*domain.RelationTuple, len(tt.args.tuples))
+			for i, tuple := range tt.args.tuples {
+				tuples[i] = relationTestTuple(t, tuple)
+			}
+			got, err := c.WriteRelationTuples(tt.args.ctx, tt.args.projectID, tt.args.resourceOwner, tuples)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_DeleteRelationTuples(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		projectID     string
+		resourceOwner string
+		tuples        []*domain.RelationTuple
+	}
+	type res struct {
+		want *domain.RelationDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid tuple, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []*domain.RelationTuple{{ObjectType: "document"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not existing, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []*domain.RelationTuple{relationTestTuple(t, "document:1#editor@user:user1")},
+			},
+			res{
+				want: &domain.RelationDetails{
+					ObjectDetails: domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ConsistencyToken: domain.NewRelationConsistencyToken(0),
+				},
+			},
+		},
+		{
+			"delete, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							relation.NewTupleWrittenEvent(context.Background(),
+								relation.NewAggregate("project1", "document", "1", "org1", "instance"),
+								"project1",
+								relationTestTuple(t, "document:1#editor@user:user1"),
+							),
+						),
+					),
+					expectPush(
+						relation.NewTupleDeletedEvent(context.Background(),
+							relation.NewAggregate("project1", "document", "1", "org1", "instance"),
+							"project1",
+							relationTestTuple(t, "document:1#editor@user:user1"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				projectID:     "project1",
+				resourceOwner: "org1",
+				tuples:        []*domain.RelationTuple{relationTestTuple(t, "document:1#editor@user:user1")},
+			},
+			res{
+				want: &domain.RelationDetails{
+					ObjectDetails: domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ConsistencyToken: domain.NewRelationConsistencyToken(0),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.DeleteRelationTuples(tt.args.ctx, tt.args.projectID, tt.args.resourceOwner, tt.args.tuples)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
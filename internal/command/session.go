@@ -19,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -40,6 +41,8 @@ type SessionCommands struct {
 	createPhoneCode encryptedCodeGeneratorWithDefaultFunc
 	createToken     func(sessionID string) (id string, token string, err error)
 	getCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	getLoginPolicy  func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)
+	riskEngine      *risk.Engine
 	now             func() time.Time
 }
 
@@ -56,6 +59,8 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		createPhoneCode:   c.newPhoneCode,
 		createToken:       c.sessionTokenCreator,
 		getCodeVerifier:   c.phoneCodeVerifierFromConfig,
+		getLoginPolicy:    c.getOrgLoginPolicy,
+		riskEngine:        c.riskEngine,
 		now:               time.Now,
 	}
 }
@@ -141,17 +146,20 @@ func CheckTOTP(code string) SessionCommand {
 
 // Exec will execute the commands specified and returns an error on the first occurrence.
 // In case of an error there might be specific commands returned, e.g. a failed pw check will have to be stored.
+// After all commands succeeded, the risk of the session will be evaluated, which might also return an error.
 func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error) {
 	for _, cmd := range s.sessionCommands {
 		if cmds, err := cmd(ctx, s); err != nil {
-			return cmds, err
+			return s.riskCheckFailed(ctx, cmds), err
 		}
 	}
-	return nil, nil
+	return s.evaluateRisk(ctx)
 }
 
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so other checks can use it
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -41,22 +41,27 @@ func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAs
 type SessionWriteModel struct {
 	eventstore.WriteModel
 
-	TokenID              string
-	UserID               string
-	UserResourceOwner    string
-	PreferredLanguage    *language.Tag
-	UserCheckedAt        time.Time
-	PasswordCheckedAt    time.Time
-	IntentCheckedAt      time.Time
-	WebAuthNCheckedAt    time.Time
-	TOTPCheckedAt        time.Time
-	OTPSMSCheckedAt      time.Time
-	OTPEmailCheckedAt    time.Time
-	WebAuthNUserVerified bool
-	Metadata             map[string][]byte
-	State                domain.SessionState
-	UserAgent            *domain.UserAgent
-	Expiration           time.Time
+	TokenID                string
+	UserID                 string
+	UserResourceOwner      string
+	PreferredLanguage      *language.Tag
+	UserCheckedAt          time.Time
+	PasswordCheckedAt      time.Time
+	IntentCheckedAt        time.Time
+	WebAuthNCheckedAt      time.Time
+	TOTPCheckedAt          time.Time
+	OTPSMSCheckedAt        time.Time
+	OTPEmailCheckedAt      time.Time
+	TrustedDeviceCheckedAt time.Time
+	WebAuthNUserVerified   bool
+	Metadata               map[string][]byte
+	State                  domain.SessionState
+	UserAgent              *domain.UserAgent
+	Expiration             time.Time
+	RiskScore              int
+	RiskSignals            []domain.RiskSignal
+	RiskAction             domain.RiskAction
+	RiskEvaluatedAt        time.Time
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
@@ -102,6 +107,10 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceOTPEmailChallenged(e)
 		case *session.OTPEmailCheckedEvent:
 			wm.reduceOTPEmailChecked(e)
+		case *session.TrustedDeviceCheckedEvent:
+			wm.reduceTrustedDeviceChecked(e)
+		case *session.RiskEvaluatedEvent:
+			wm.reduceRiskEvaluated(e)
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.LifetimeSetEvent:
@@ -131,6 +140,8 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPSMSCheckedType,
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
+			session.TrustedDeviceCheckedType,
+			session.RiskEvaluatedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
@@ -215,6 +226,17 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reduceTrustedDeviceChecked(e *session.TrustedDeviceCheckedEvent) {
+	wm.TrustedDeviceCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceRiskEvaluated(e *session.RiskEvaluatedEvent) {
+	wm.RiskScore = e.Score
+	wm.RiskSignals = e.Signals
+	wm.RiskAction = e.Action
+	wm.RiskEvaluatedAt = e.CreationDate()
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -292,3 +314,44 @@ func (wm *SessionWriteModel) CheckIsActive() error {
 	}
 	return wm.CheckNotInvalidated()
 }
+
+// CheckRisk checks that the risk assessment of the session allows it to be used for authentication.
+// Blocked sessions are denied and sessions requiring MFA must have a second factor checked.
+func (wm *SessionWriteModel) CheckRisk() error {
+	switch wm.RiskAction {
+	case domain.RiskActionBlock:
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bMr", "Errors.Session.Risk.Blocked")
+	case domain.RiskActionRequireMFA:
+		if wm.TOTPCheckedAt.IsZero() &&
+			wm.OTPSMSCheckedAt.IsZero() &&
+			wm.OTPEmailCheckedAt.IsZero() &&
+			wm.WebAuthNCheckedAt.IsZero() {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rk7bNs", "Errors.Session.Risk.MFARequired")
+		}
+	case domain.RiskActionUnspecified,
+		domain.RiskActionAllow,
+		domain.RiskActionNotify:
+	}
+	return nil
+}
+
+func (wm *SessionWriteModel) deviceID() string {
+	if wm.UserAgent == nil || wm.UserAgent.FingerprintID == nil {
+		return ""
+	}
+	return *wm.UserAgent.FingerprintID
+}
+
+func (wm *SessionWriteModel) userAgentDescription() string {
+	if wm.UserAgent == nil || wm.UserAgent.Description == nil {
+		return ""
+	}
+	return *wm.UserAgent.Description
+}
+
+func (wm *SessionWriteModel) userAgentIP() string {
+	if wm.UserAgent == nil || len(wm.UserAgent.IP) == 0 {
+		return ""
+	}
+	return wm.UserAgent.IP.String()
+}
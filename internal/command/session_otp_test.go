@@ -912,7 +912,7 @@ func TestCheckOTPSMS(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPSMSCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockedByLockoutEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
 				},
 			},
 		},
@@ -1200,7 +1200,7 @@ func TestCheckOTPEmail(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPEmailCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockedByLockoutEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
 				},
 			},
 		},
@@ -0,0 +1,150 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// evaluateRisk assesses the first authentication check of the session for anomalies
+// based on the previous sign-ins of the user and failed checks from the same IP.
+// The resulting action is defined by the risk thresholds of the login policy of the user's organization.
+// If the assessment results in a block, only the risk event is returned to be stored together with the error.
+func (s *SessionCommands) evaluateRisk(ctx context.Context) ([]eventstore.Command, error) {
+	if s.riskEngine == nil ||
+		!s.sessionWriteModel.RiskEvaluatedAt.IsZero() ||
+		s.sessionWriteModel.UserID == "" ||
+		!s.authenticationChecked() {
+		return nil, nil
+	}
+	attempt := &risk.Attempt{
+		DeviceID: s.sessionWriteModel.deviceID(),
+		Time:     s.now(),
+	}
+	if s.sessionWriteModel.UserAgent != nil {
+		attempt.IP = s.sessionWriteModel.UserAgent.IP
+	}
+	ip := s.sessionWriteModel.userAgentIP()
+	assessment, err := assessRisk(ctx, s.eventstore, s.riskEngine, s.getLoginPolicy, s.sessionWriteModel.UserID, s.sessionWriteModel.UserResourceOwner, ip, attempt)
+	if err != nil {
+		return nil, err
+	}
+	evaluated := s.riskEvaluatedEvent(ctx, ip, attempt.DeviceID, assessment)
+	if assessment.Action == domain.RiskActionBlock {
+		return []eventstore.Command{evaluated}, zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bLq", "Errors.Session.Risk.Blocked")
+	}
+	s.eventCommands = append(s.eventCommands, evaluated)
+	return nil, nil
+}
+
+// assessRisk evaluates the attempt of the user based on the previous sign-ins of the user and failed checks from the same IP,
+// using the risk thresholds of the login policy of the user's organization.
+func assessRisk(
+	ctx context.Context,
+	es *eventstore.Eventstore,
+	engine *risk.Engine,
+	getLoginPolicy func(ctx context.Context, orgID string) (*domain.LoginPolicy, error),
+	userID, resourceOwner, ip string,
+	attempt *risk.Attempt,
+) (*risk.Assessment, error) {
+	config := engine.Config()
+	history := newSessionRiskHistoryReadModel(userID, sinceWindow(attempt.Time, config.HistoryWindow), config.HistoryLimit)
+	if err := es.FilterToQueryReducer(ctx, history); err != nil {
+		return nil, err
+	}
+	var failedAccounts int
+	if ip != "" && config.FailureAccounts > 0 {
+		failures := newSessionRiskFailuresReadModel(ip, sinceWindow(attempt.Time, config.FailureWindow))
+		if err := es.FilterToQueryReducer(ctx, failures); err != nil {
+			return nil, err
+		}
+		failedAccounts = failures.Accounts()
+	}
+	policy, err := getLoginPolicy(ctx, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return engine.Evaluate(attempt, history.Attempts, failedAccounts, policy.RiskThresholds), nil
+}
+
+func (s *SessionCommands) riskEvaluatedEvent(ctx context.Context, ip, deviceID string, assessment *risk.Assessment) *session.RiskEvaluatedEvent {
+	var (
+		country, asn        string
+		latitude, longitude float64
+	)
+	if assessment.Location != nil {
+		country = assessment.Location.Country
+		asn = assessment.Location.ASN
+		latitude = assessment.Location.Latitude
+		longitude = assessment.Location.Longitude
+	}
+	return session.NewRiskEvaluatedEvent(ctx, s.sessionWriteModel.aggregate,
+		s.sessionWriteModel.UserID,
+		ip,
+		deviceID,
+		country,
+		asn,
+		latitude,
+		longitude,
+		assessment.Score,
+		assessment.Signals,
+		assessment.Action,
+	)
+}
+
+// riskCheckFailed adds the failed check of the current user and IP to the commands,
+// so the IPFailures signal can be detected on later sign-ins.
+func (s *SessionCommands) riskCheckFailed(ctx context.Context, cmds []eventstore.Command) []eventstore.Command {
+	if s.riskEngine == nil || len(cmds) == 0 {
+		return cmds
+	}
+	ip := s.sessionWriteModel.userAgentIP()
+	if ip == "" {
+		return cmds
+	}
+	return append(cmds, session.NewRiskCheckFailedEvent(ctx, s.sessionWriteModel.aggregate, s.sessionWriteModel.UserID, ip))
+}
+
+// authenticationChecked returns true if the current request contains a successful authentication check
+func (s *SessionCommands) authenticationChecked() bool {
+	for _, cmd := range s.eventCommands {
+		switch cmd.(type) {
+		case *session.PasswordCheckedEvent,
+			*session.IntentCheckedEvent,
+			*session.WebAuthNCheckedEvent,
+			*session.TOTPCheckedEvent,
+			*session.OTPSMSCheckedEvent,
+			*session.OTPEmailCheckedEvent:
+			return true
+		}
+	}
+	return false
+}
+
+// sinceWindow returns the start of the window, or the zero time if no window is defined
+func sinceWindow(now time.Time, window time.Duration) time.Time {
+	if window <= 0 {
+		return time.Time{}
+	}
+	return now.Add(-window)
+}
+
+// RiskNotificationSent marks the notification about the sign-in from an unrecognized device as sent.
+func (c *Commands) RiskNotificationSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.RiskEvaluatedAt.IsZero() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rk7bOt", "Errors.Session.Risk.NotEvaluated")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewRiskNotificationSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
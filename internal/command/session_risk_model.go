@@ -0,0 +1,133 @@
+package command
+
+import (
+	"net"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+)
+
+// sessionRiskHistoryReadModel collects the previous (evaluated) sign-ins of a user,
+// of sessions as well as of the login UI
+type sessionRiskHistoryReadModel struct {
+	eventstore.WriteModel
+
+	userID string
+	since  time.Time
+	limit  uint16
+
+	Attempts []*risk.Attempt
+}
+
+func newSessionRiskHistoryReadModel(userID string, since time.Time, limit uint16) *sessionRiskHistoryReadModel {
+	return &sessionRiskHistoryReadModel{
+		userID: userID,
+		since:  since,
+		limit:  limit,
+	}
+}
+
+func (rm *sessionRiskHistoryReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *session.RiskEvaluatedEvent:
+			rm.Attempts = append(rm.Attempts, riskAttempt(e.IP, e.DeviceID, e.Country, e.ASN, e.Latitude, e.Longitude, e.CreationDate()))
+		case *user.HumanRiskEvaluatedEvent:
+			rm.Attempts = append(rm.Attempts, riskAttempt(e.IP, e.DeviceID, e.Country, e.ASN, e.Latitude, e.Longitude, e.CreationDate()))
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func riskAttempt(ip, deviceID, country, asn string, latitude, longitude float64, creationDate time.Time) *risk.Attempt {
+	attempt := &risk.Attempt{
+		IP:       net.ParseIP(ip),
+		DeviceID: deviceID,
+		Time:     creationDate,
+	}
+	if country != "" || asn != "" {
+		attempt.Location = &risk.Location{
+			Country:   country,
+			ASN:       asn,
+			Latitude:  latitude,
+			Longitude: longitude,
+		}
+	}
+	return attempt
+}
+
+func (rm *sessionRiskHistoryReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderDesc().
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.RiskEvaluatedType).
+		EventData(map[string]interface{}{"userId": rm.userID}).
+		Or().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.userID).
+		EventTypes(user.HumanRiskEvaluatedType).
+		Builder()
+	if !rm.since.IsZero() {
+		query.CreationDateAfter(rm.since)
+	}
+	if rm.limit > 0 {
+		query.Limit(uint64(rm.limit))
+	}
+	return query
+}
+
+// sessionRiskFailuresReadModel counts the distinct users with failed checks from an IP
+type sessionRiskFailuresReadModel struct {
+	eventstore.WriteModel
+
+	ip    string
+	since time.Time
+
+	users map[string]struct{}
+}
+
+func newSessionRiskFailuresReadModel(ip string, since time.Time) *sessionRiskFailuresReadModel {
+	return &sessionRiskFailuresReadModel{
+		ip:    ip,
+		since: since,
+		users: make(map[string]struct{}),
+	}
+}
+
+func (rm *sessionRiskFailuresReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *session.RiskCheckFailedEvent:
+			rm.users[e.UserID] = struct{}{}
+		case *user.HumanRiskCheckFailedEvent:
+			rm.users[e.Aggregate().ID] = struct{}{}
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *sessionRiskFailuresReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.RiskCheckFailedType).
+		EventData(map[string]interface{}{"ip": rm.ip}).
+		Or().
+		AggregateTypes(user.AggregateType).
+		EventTypes(user.HumanRiskCheckFailedType).
+		EventData(map[string]interface{}{"ip": rm.ip}).
+		Builder()
+	if !rm.since.IsZero() {
+		query.CreationDateAfter(rm.since)
+	}
+	return query
+}
+
+// Accounts returns the amount of distinct users with failed checks
+func (rm *sessionRiskFailuresReadModel) Accounts() int {
+	return len(rm.users)
+}
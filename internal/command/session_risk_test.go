@@ -0,0 +1,295 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSessionCommands_evaluateRisk(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+
+	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	otherSessAgg := &session.NewAggregate("session2", "instance1").Aggregate
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.IPv4(192, 0, 2, 1),
+	}
+	engine, err := risk.NewEngine(risk.Config{
+		Enabled:         true,
+		FailureAccounts: 2,
+		Weights: risk.Weights{
+			NewDevice:  20,
+			IPFailures: 80,
+		},
+	})
+	require.NoError(t, err)
+	thresholds := domain.RiskThresholds{
+		Notify:     20,
+		RequireMFA: 40,
+		Block:      80,
+	}
+
+	type fields struct {
+		sessionWriteModel *SessionWriteModel
+		eventCommands     []eventstore.Command
+		eventstore        func(*testing.T) *eventstore.Eventstore
+		riskEngine        *risk.Engine
+		riskThresholds    domain.RiskThresholds
+	}
+	tests := []struct {
+		name              string
+		fields            fields
+		want              []eventstore.Command
+		wantEventCommands []eventstore.Command
+		wantErr           error
+	}{
+		{
+			name: "disabled",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(),
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
+		{
+			name: "no authentication check",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewUserCheckedEvent(ctx, sessAgg, "user1", "org1", testNow, nil),
+				},
+				eventstore: expectEventstore(),
+				riskEngine: engine,
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewUserCheckedEvent(ctx, sessAgg, "user1", "org1", testNow, nil),
+			},
+		},
+		{
+			name: "already evaluated",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:          "user1",
+					UserAgent:       userAgent,
+					RiskEvaluatedAt: testNow,
+					aggregate:       sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewTOTPCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(),
+				riskEngine: engine,
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewTOTPCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
+		{
+			name: "first sign-in, allow",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+				riskEngine:     engine,
+				riskThresholds: thresholds,
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				session.NewRiskEvaluatedEvent(ctx, sessAgg, "user1", "192.0.2.1", "fp1", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+			},
+		},
+		{
+			name: "new device, notify",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewRiskEvaluatedEvent(ctx, otherSessAgg, "user1", "192.0.2.1", "fp2", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+						),
+					),
+					expectFilter(),
+				),
+				riskEngine:     engine,
+				riskThresholds: thresholds,
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				session.NewRiskEvaluatedEvent(ctx, sessAgg, "user1", "192.0.2.1", "fp1", "", "", 0, 0,
+					20, []domain.RiskSignal{domain.RiskSignalNewDevice}, domain.RiskActionNotify),
+			},
+		},
+		{
+			name: "new device, no thresholds in login policy, allow",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewRiskEvaluatedEvent(ctx, otherSessAgg, "user1", "192.0.2.1", "fp2", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+						),
+					),
+					expectFilter(),
+				),
+				riskEngine: engine,
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				session.NewRiskEvaluatedEvent(ctx, sessAgg, "user1", "192.0.2.1", "fp1", "", "", 0, 0,
+					20, []domain.RiskSignal{domain.RiskSignalNewDevice}, domain.RiskActionAllow),
+			},
+		},
+		{
+			name: "failures from ip, block",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    "user1",
+					UserAgent: userAgent,
+					aggregate: sessAgg,
+				},
+				eventCommands: []eventstore.Command{
+					session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewRiskCheckFailedEvent(ctx, otherSessAgg, "user2", "192.0.2.1"),
+						),
+						eventFromEventPusher(
+							session.NewRiskCheckFailedEvent(ctx, otherSessAgg, "user3", "192.0.2.1"),
+						),
+						eventFromEventPusher(
+							session.NewRiskCheckFailedEvent(ctx, otherSessAgg, "user3", "192.0.2.1"),
+						),
+					),
+				),
+				riskEngine:     engine,
+				riskThresholds: thresholds,
+			},
+			want: []eventstore.Command{
+				session.NewRiskEvaluatedEvent(ctx, sessAgg, "user1", "192.0.2.1", "fp1", "", "", 0, 0,
+					80, []domain.RiskSignal{domain.RiskSignalIPFailures}, domain.RiskActionBlock),
+			},
+			wantEventCommands: []eventstore.Command{
+				session.NewPasswordCheckedEvent(ctx, sessAgg, testNow),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bLq", "Errors.Session.Risk.Blocked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: tt.fields.sessionWriteModel,
+				eventCommands:     tt.fields.eventCommands,
+				eventstore:        tt.fields.eventstore(t),
+				riskEngine:        tt.fields.riskEngine,
+				getLoginPolicy: func(context.Context, string) (*domain.LoginPolicy, error) {
+					return &domain.LoginPolicy{RiskThresholds: tt.fields.riskThresholds}, nil
+				},
+				now: func() time.Time { return testNow },
+			}
+			got, err := cmd.evaluateRisk(ctx)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantEventCommands, cmd.eventCommands)
+		})
+	}
+}
+
+func TestSessionWriteModel_CheckRisk(t *testing.T) {
+	tests := []struct {
+		name    string
+		wm      *SessionWriteModel
+		wantErr error
+	}{
+		{
+			name: "not evaluated",
+			wm:   &SessionWriteModel{},
+		},
+		{
+			name: "notify",
+			wm: &SessionWriteModel{
+				RiskAction: domain.RiskActionNotify,
+			},
+		},
+		{
+			name: "require mfa, missing",
+			wm: &SessionWriteModel{
+				RiskAction:        domain.RiskActionRequireMFA,
+				PasswordCheckedAt: testNow,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rk7bNs", "Errors.Session.Risk.MFARequired"),
+		},
+		{
+			name: "require mfa, checked",
+			wm: &SessionWriteModel{
+				RiskAction:        domain.RiskActionRequireMFA,
+				PasswordCheckedAt: testNow,
+				OTPEmailCheckedAt: testNow,
+			},
+		},
+		{
+			name: "block",
+			wm: &SessionWriteModel{
+				RiskAction: domain.RiskActionBlock,
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bMr", "Errors.Session.Risk.Blocked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wm.CheckRisk()
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -1042,7 +1042,7 @@ func TestCheckTOTP(t *testing.T) {
 			},
 			wantErrorCommands: []eventstore.Command{
 				user.NewHumanOTPCheckFailedEvent(ctx, userAgg, nil),
-				user.NewUserLockedEvent(ctx, userAgg),
+				user.NewUserLockedByLockoutEvent(ctx, userAgg),
 			},
 			wantErr: zerrors.ThrowInvalidArgument(nil, "EVENT-8isk2", "Errors.User.MFA.OTP.InvalidCode"),
 		},
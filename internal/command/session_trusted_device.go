@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CheckTrustedDevice defines a check, if the user agent (fingerprint) of the session is a trusted device of the user,
+// which replaces the verification of a second factor for the trusted device lifetime of the login policy.
+// If trust is set, the device will be (re-)trusted instead, which requires a second factor to be checked on the session.
+func CheckTrustedDevice(trust bool) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eBv", "Errors.User.UserIDMissing")
+		}
+		deviceID := cmd.sessionWriteModel.deviceID()
+		if deviceID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eCw", "Errors.Session.TrustedDevice.FingerprintMissing")
+		}
+		policy, err := cmd.getLoginPolicy(ctx, cmd.sessionWriteModel.UserResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if policy.TrustedDeviceLifetime <= 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eDx", "Errors.Session.TrustedDevice.NotAllowed")
+		}
+		writeModel := NewHumanTrustedDeviceWriteModel(cmd.sessionWriteModel.UserID, cmd.sessionWriteModel.UserResourceOwner, deviceID)
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+		if trust {
+			if !cmd.secondFactorChecked() {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eEy", "Errors.Session.TrustedDevice.SecondFactorMissing")
+			}
+			cmd.eventCommands = append(cmd.eventCommands,
+				user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, deviceID, cmd.sessionWriteModel.userAgentDescription(), cmd.sessionWriteModel.userAgentIP()),
+			)
+			return nil, nil
+		}
+		if !writeModel.Trusted || !domain.TrustedDeviceValid(writeModel.TrustedAt, policy.TrustedDeviceLifetime) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eFz", "Errors.User.TrustedDevice.NotFound")
+		}
+		cmd.eventCommands = append(cmd.eventCommands,
+			user.NewHumanTrustedDeviceSeenEvent(ctx, userAgg, deviceID, cmd.sessionWriteModel.userAgentIP()),
+		)
+		cmd.TrustedDeviceChecked(ctx, deviceID, cmd.now())
+		return nil, nil
+	}
+}
+
+func (s *SessionCommands) TrustedDeviceChecked(ctx context.Context, deviceID string, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewTrustedDeviceCheckedEvent(ctx, s.sessionWriteModel.aggregate, deviceID, checkedAt))
+}
+
+// secondFactorChecked returns true if a second factor was already checked on the session
+// or is checked in the current request.
+func (s *SessionCommands) secondFactorChecked() bool {
+	if !s.sessionWriteModel.TOTPCheckedAt.IsZero() ||
+		!s.sessionWriteModel.OTPSMSCheckedAt.IsZero() ||
+		!s.sessionWriteModel.OTPEmailCheckedAt.IsZero() ||
+		!s.sessionWriteModel.WebAuthNCheckedAt.IsZero() {
+		return true
+	}
+	for _, cmd := range s.eventCommands {
+		switch cmd.(type) {
+		case *session.TOTPCheckedEvent,
+			*session.OTPSMSCheckedEvent,
+			*session.OTPEmailCheckedEvent,
+			*session.WebAuthNCheckedEvent:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCheckTrustedDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+
+	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.IPv4(127, 0, 0, 1),
+		Description:   gu.Ptr("firefox"),
+	}
+	policy := func(lifetime time.Duration) func(context.Context, string) (*domain.LoginPolicy, error) {
+		return func(context.Context, string) (*domain.LoginPolicy, error) {
+			return &domain.LoginPolicy{TrustedDeviceLifetime: lifetime}, nil
+		}
+	}
+
+	type fields struct {
+		sessionWriteModel *SessionWriteModel
+		eventstore        func(*testing.T) *eventstore.Eventstore
+		getLoginPolicy    func(context.Context, string) (*domain.LoginPolicy, error)
+	}
+
+	tests := []struct {
+		name              string
+		trust             bool
+		fields            fields
+		wantEventCommands []eventstore.Command
+		wantErr           error
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					aggregate: sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eBv", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "missing fingerprint",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					aggregate:         sessAgg,
+				},
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eCw", "Errors.Session.TrustedDevice.FingerprintMissing"),
+		},
+		{
+			name: "not allowed by policy",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					aggregate:         sessAgg,
+				},
+				eventstore:     expectEventstore(),
+				getLoginPolicy: policy(0),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eDx", "Errors.Session.TrustedDevice.NotAllowed"),
+		},
+		{
+			name: "not trusted",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					aggregate:         sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				getLoginPolicy: policy(time.Hour),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eFz", "Errors.User.TrustedDevice.NotFound"),
+		},
+		{
+			name: "trusted, checked",
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					aggregate:         sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "fp1", "firefox", "127.0.0.1"),
+						),
+					),
+				),
+				getLoginPolicy: policy(time.Hour),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewHumanTrustedDeviceSeenEvent(ctx, userAgg, "fp1", "127.0.0.1"),
+				session.NewTrustedDeviceCheckedEvent(ctx, sessAgg, "fp1", testNow),
+			},
+		},
+		{
+			name:  "trust without second factor",
+			trust: true,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					aggregate:         sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				getLoginPolicy: policy(time.Hour),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tq4eEy", "Errors.Session.TrustedDevice.SecondFactorMissing"),
+		},
+		{
+			name:  "trust, ok",
+			trust: true,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            "user1",
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					TOTPCheckedAt:     testNow,
+					aggregate:         sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				getLoginPolicy: policy(time.Hour),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "fp1", "firefox", "127.0.0.1"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: tt.fields.sessionWriteModel,
+				eventstore:        tt.fields.eventstore(t),
+				getLoginPolicy:    tt.fields.getLoginPolicy,
+				now:               func() time.Time { return testNow },
+			}
+			_, err := CheckTrustedDevice(tt.trust)(ctx, cmd)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantEventCommands, cmd.eventCommands)
+		})
+	}
+}
@@ -16,6 +16,10 @@ type IAMSMSConfigWriteModel struct {
 	Description string
 	Twilio      *TwilioConfig
 	HTTP        *HTTPConfig
+	Vonage      *VonageConfig
+	SNS         *SNSConfig
+	MessageBird *MessageBirdConfig
+	SMPP        *SMPPConfig
 	State       domain.SMSConfigState
 }
 
@@ -30,6 +34,37 @@ type HTTPConfig struct {
 	Endpoint string
 }
 
+type VonageConfig struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+	VerifyBrand  string
+}
+
+type SNSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey *crypto.CryptoValue
+	SenderID        string
+}
+
+type MessageBirdConfig struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+	Verify     bool
+}
+
+type SMPPConfig struct {
+	Host          string
+	TLS           bool
+	TLSCA         string
+	TLSServerName string
+	SystemID      string
+	Password      *crypto.CryptoValue
+	SystemType    string
+	SourceAddress string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -96,6 +131,150 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			if e.Endpoint != nil {
 				wm.HTTP.Endpoint = *e.Endpoint
 			}
+		case *instance.SMSConfigVonageAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage = &VonageConfig{
+				APIKey:       e.APIKey,
+				APISecret:    e.APISecret,
+				SenderNumber: e.SenderNumber,
+				VerifyBrand:  e.VerifyBrand,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigVonageChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.APIKey != nil {
+				wm.Vonage.APIKey = *e.APIKey
+			}
+			if e.SenderNumber != nil {
+				wm.Vonage.SenderNumber = *e.SenderNumber
+			}
+			if e.VerifyBrand != nil {
+				wm.Vonage.VerifyBrand = *e.VerifyBrand
+			}
+		case *instance.SMSConfigVonageAPISecretChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage.APISecret = e.APISecret
+		case *instance.SMSConfigSNSAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SNS = &SNSConfig{
+				Region:          e.Region,
+				AccessKeyID:     e.AccessKeyID,
+				SecretAccessKey: e.SecretAccessKey,
+				SenderID:        e.SenderID,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigSNSChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Region != nil {
+				wm.SNS.Region = *e.Region
+			}
+			if e.AccessKeyID != nil {
+				wm.SNS.AccessKeyID = *e.AccessKeyID
+			}
+			if e.SenderID != nil {
+				wm.SNS.SenderID = *e.SenderID
+			}
+		case *instance.SMSConfigSNSSecretAccessKeyChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SNS.SecretAccessKey = e.SecretAccessKey
+		case *instance.SMSConfigMessageBirdAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.MessageBird = &MessageBirdConfig{
+				AccessKey:  e.AccessKey,
+				Originator: e.Originator,
+				Verify:     e.Verify,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigMessageBirdChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Originator != nil {
+				wm.MessageBird.Originator = *e.Originator
+			}
+			if e.Verify != nil {
+				wm.MessageBird.Verify = *e.Verify
+			}
+		case *instance.SMSConfigMessageBirdAccessKeyChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.MessageBird.AccessKey = e.AccessKey
+		case *instance.SMSConfigSMPPAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SMPP = &SMPPConfig{
+				Host:          e.Host,
+				TLS:           e.TLS,
+				TLSCA:         e.TLSCA,
+				TLSServerName: e.TLSServerName,
+				SystemID:      e.SystemID,
+				Password:      e.Password,
+				SystemType:    e.SystemType,
+				SourceAddress: e.SourceAddress,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigSMPPChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Host != nil {
+				wm.SMPP.Host = *e.Host
+			}
+			if e.TLS != nil {
+				wm.SMPP.TLS = *e.TLS
+			}
+			if e.TLSCA != nil {
+				wm.SMPP.TLSCA = *e.TLSCA
+			}
+			if e.TLSServerName != nil {
+				wm.SMPP.TLSServerName = *e.TLSServerName
+			}
+			if e.SystemID != nil {
+				wm.SMPP.SystemID = *e.SystemID
+			}
+			if e.SystemType != nil {
+				wm.SMPP.SystemType = *e.SystemType
+			}
+			if e.SourceAddress != nil {
+				wm.SMPP.SourceAddress = *e.SourceAddress
+			}
+		case *instance.SMSConfigSMPPPasswordChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SMPP.Password = e.Password
 		case *instance.SMSConfigTwilioActivatedEvent:
 			if wm.ID != e.ID {
 				wm.State = domain.SMSConfigStateInactive
@@ -113,6 +292,10 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.Vonage = nil
+			wm.SNS = nil
+			wm.MessageBird = nil
+			wm.SMPP = nil
 			wm.State = domain.SMSConfigStateRemoved
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
@@ -131,12 +314,30 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.Vonage = nil
+			wm.SNS = nil
+			wm.MessageBird = nil
+			wm.SMPP = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
 	return wm.WriteModel.Reduce()
 }
 
+// hasExternalVerification returns true if the provider generates and verifies the codes itself
+func (wm *IAMSMSConfigWriteModel) hasExternalVerification() bool {
+	switch {
+	case wm.Twilio != nil:
+		return wm.Twilio.VerifyServiceSID != ""
+	case wm.Vonage != nil:
+		return wm.Vonage.VerifyBrand != ""
+	case wm.MessageBird != nil:
+		return wm.MessageBird.Verify
+	default:
+		return false
+	}
+}
+
 func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(wm.ResourceOwner).
@@ -149,6 +350,18 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioTokenChangedEventType,
 			instance.SMSConfigHTTPAddedEventType,
 			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigVonageAddedEventType,
+			instance.SMSConfigVonageChangedEventType,
+			instance.SMSConfigVonageAPISecretChangedEventType,
+			instance.SMSConfigSNSAddedEventType,
+			instance.SMSConfigSNSChangedEventType,
+			instance.SMSConfigSNSSecretAccessKeyChangedEventType,
+			instance.SMSConfigMessageBirdAddedEventType,
+			instance.SMSConfigMessageBirdChangedEventType,
+			instance.SMSConfigMessageBirdAccessKeyChangedEventType,
+			instance.SMSConfigSMPPAddedEventType,
+			instance.SMSConfigSMPPChangedEventType,
+			instance.SMSConfigSMPPPasswordChangedEventType,
 			instance.SMSConfigTwilioActivatedEventType,
 			instance.SMSConfigTwilioDeactivatedEventType,
 			instance.SMSConfigTwilioRemovedEventType,
@@ -214,6 +427,123 @@ func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggre
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMSConfigWriteModel) NewVonageChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, apiKey, senderNumber, verifyBrand *string) (*instance.SMSConfigVonageChangedEvent, bool, error) {
+	if wm.Vonage == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigVonageChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigVonageDescription(*description))
+	}
+	if apiKey != nil && wm.Vonage.APIKey != *apiKey {
+		changes = append(changes, instance.ChangeSMSConfigVonageAPIKey(*apiKey))
+	}
+	if senderNumber != nil && wm.Vonage.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigVonageSenderNumber(*senderNumber))
+	}
+	if verifyBrand != nil && wm.Vonage.VerifyBrand != *verifyBrand {
+		changes = append(changes, instance.ChangeSMSConfigVonageVerifyBrand(*verifyBrand))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigVonageChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewSNSChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, region, accessKeyID, senderID *string) (*instance.SMSConfigSNSChangedEvent, bool, error) {
+	if wm.SNS == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigSNSChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigSNSDescription(*description))
+	}
+	if region != nil && wm.SNS.Region != *region {
+		changes = append(changes, instance.ChangeSMSConfigSNSRegion(*region))
+	}
+	if accessKeyID != nil && wm.SNS.AccessKeyID != *accessKeyID {
+		changes = append(changes, instance.ChangeSMSConfigSNSAccessKeyID(*accessKeyID))
+	}
+	if senderID != nil && wm.SNS.SenderID != *senderID {
+		changes = append(changes, instance.ChangeSMSConfigSNSSenderID(*senderID))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigSNSChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewMessageBirdChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, originator *string, verify *bool) (*instance.SMSConfigMessageBirdChangedEvent, bool, error) {
+	if wm.MessageBird == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigMessageBirdChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdDescription(*description))
+	}
+	if originator != nil && wm.MessageBird.Originator != *originator {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdOriginator(*originator))
+	}
+	if verify != nil && wm.MessageBird.Verify != *verify {
+		changes = append(changes, instance.ChangeSMSConfigMessageBirdVerify(*verify))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigMessageBirdChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewSMPPChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, host *string, tls *bool, tlsCA, tlsServerName, systemID, systemType, sourceAddress *string) (*instance.SMSConfigSMPPChangedEvent, bool, error) {
+	if wm.SMPP == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMSConfigSMPPChanges, 0)
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigSMPPDescription(*description))
+	}
+	if host != nil && wm.SMPP.Host != *host {
+		changes = append(changes, instance.ChangeSMSConfigSMPPHost(*host))
+	}
+	if tls != nil && wm.SMPP.TLS != *tls {
+		changes = append(changes, instance.ChangeSMSConfigSMPPTLS(*tls))
+	}
+	if tlsCA != nil && wm.SMPP.TLSCA != *tlsCA {
+		changes = append(changes, instance.ChangeSMSConfigSMPPTLSCA(*tlsCA))
+	}
+	if tlsServerName != nil && wm.SMPP.TLSServerName != *tlsServerName {
+		changes = append(changes, instance.ChangeSMSConfigSMPPTLSServerName(*tlsServerName))
+	}
+	if systemID != nil && wm.SMPP.SystemID != *systemID {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSystemID(*systemID))
+	}
+	if systemType != nil && wm.SMPP.SystemType != *systemType {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSystemType(*systemType))
+	}
+	if sourceAddress != nil && wm.SMPP.SourceAddress != *sourceAddress {
+		changes = append(changes, instance.ChangeSMSConfigSMPPSourceAddress(*sourceAddress))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigSMPPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 type IAMSMSLastActivatedConfigWriteModel struct {
 	eventstore.WriteModel
 
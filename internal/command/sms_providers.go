@@ -0,0 +1,353 @@
+package command
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddSMSVonage struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  string
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+	VerifyBrand  string
+}
+
+func (c *Commands) AddSMSConfigVonage(ctx context.Context, config *AddSMSVonage) (err error) {
+	if config.APIKey == "" || config.APISecret == "" || config.SenderNumber == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn1aQa", "Errors.SMSConfig.Invalid")
+	}
+	return c.addSMSConfig(ctx, config.ResourceOwner, &config.ID, &config.Details, config.APISecret,
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigVonageAddedEvent(ctx, aggregate, config.ID, config.Description, config.APIKey, secret, config.SenderNumber, config.VerifyBrand)
+		},
+	)
+}
+
+type ChangeSMSVonage struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  *string
+	APIKey       *string
+	SenderNumber *string
+	VerifyBrand  *string
+}
+
+func (c *Commands) ChangeSMSConfigVonage(ctx context.Context, config *ChangeSMSVonage) (err error) {
+	return c.changeSMSConfig(ctx, config.ResourceOwner, config.ID, &config.Details,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.Vonage != nil },
+		func(wm *IAMSMSConfigWriteModel, aggregate *eventstore.Aggregate) (eventstore.Command, bool, error) {
+			return wm.NewVonageChangedEvent(ctx, aggregate, config.ID, config.Description, config.APIKey, config.SenderNumber, config.VerifyBrand)
+		},
+	)
+}
+
+func (c *Commands) ChangeSMSConfigVonageAPISecret(ctx context.Context, resourceOwner, id, apiSecret string) (*domain.ObjectDetails, error) {
+	return c.changeSMSConfigSecret(ctx, resourceOwner, id, apiSecret,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.Vonage != nil },
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigVonageAPISecretChangedEvent(ctx, aggregate, id, secret)
+		},
+	)
+}
+
+type AddSMSSNS struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description     string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SenderID        string
+}
+
+func (c *Commands) AddSMSConfigSNS(ctx context.Context, config *AddSMSSNS) (err error) {
+	if config.Region == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn2bRa", "Errors.SMSConfig.Invalid")
+	}
+	return c.addSMSConfig(ctx, config.ResourceOwner, &config.ID, &config.Details, config.SecretAccessKey,
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigSNSAddedEvent(ctx, aggregate, config.ID, config.Description, config.Region, config.AccessKeyID, secret, config.SenderID)
+		},
+	)
+}
+
+type ChangeSMSSNS struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description *string
+	Region      *string
+	AccessKeyID *string
+	SenderID    *string
+}
+
+func (c *Commands) ChangeSMSConfigSNS(ctx context.Context, config *ChangeSMSSNS) (err error) {
+	return c.changeSMSConfig(ctx, config.ResourceOwner, config.ID, &config.Details,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.SNS != nil },
+		func(wm *IAMSMSConfigWriteModel, aggregate *eventstore.Aggregate) (eventstore.Command, bool, error) {
+			return wm.NewSNSChangedEvent(ctx, aggregate, config.ID, config.Description, config.Region, config.AccessKeyID, config.SenderID)
+		},
+	)
+}
+
+func (c *Commands) ChangeSMSConfigSNSSecretAccessKey(ctx context.Context, resourceOwner, id, secretAccessKey string) (*domain.ObjectDetails, error) {
+	return c.changeSMSConfigSecret(ctx, resourceOwner, id, secretAccessKey,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.SNS != nil },
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigSNSSecretAccessKeyChangedEvent(ctx, aggregate, id, secret)
+		},
+	)
+}
+
+type AddSMSMessageBird struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description string
+	AccessKey   string
+	Originator  string
+	Verify      bool
+}
+
+func (c *Commands) AddSMSConfigMessageBird(ctx context.Context, config *AddSMSMessageBird) (err error) {
+	if config.AccessKey == "" || config.Originator == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mb3cSa", "Errors.SMSConfig.Invalid")
+	}
+	return c.addSMSConfig(ctx, config.ResourceOwner, &config.ID, &config.Details, config.AccessKey,
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigMessageBirdAddedEvent(ctx, aggregate, config.ID, config.Description, secret, config.Originator, config.Verify)
+		},
+	)
+}
+
+type ChangeSMSMessageBird struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description *string
+	Originator  *string
+	Verify      *bool
+}
+
+func (c *Commands) ChangeSMSConfigMessageBird(ctx context.Context, config *ChangeSMSMessageBird) (err error) {
+	return c.changeSMSConfig(ctx, config.ResourceOwner, config.ID, &config.Details,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.MessageBird != nil },
+		func(wm *IAMSMSConfigWriteModel, aggregate *eventstore.Aggregate) (eventstore.Command, bool, error) {
+			return wm.NewMessageBirdChangedEvent(ctx, aggregate, config.ID, config.Description, config.Originator, config.Verify)
+		},
+	)
+}
+
+func (c *Commands) ChangeSMSConfigMessageBirdAccessKey(ctx context.Context, resourceOwner, id, accessKey string) (*domain.ObjectDetails, error) {
+	return c.changeSMSConfigSecret(ctx, resourceOwner, id, accessKey,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.MessageBird != nil },
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigMessageBirdAccessKeyChangedEvent(ctx, aggregate, id, secret)
+		},
+	)
+}
+
+type AddSMSSMPP struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description   string
+	Host          string
+	TLS           bool
+	TLSCA         string
+	TLSServerName string
+	SystemID      string
+	Password      string
+	SystemType    string
+	SourceAddress string
+}
+
+func (c *Commands) AddSMSConfigSMPP(ctx context.Context, config *AddSMSSMPP) (err error) {
+	if config.Host == "" || config.SystemID == "" || config.SourceAddress == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp4dTa", "Errors.SMSConfig.Invalid")
+	}
+	if !isValidTLSCA(config.TLSCA) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp4dTb", "Errors.SMSConfig.Invalid")
+	}
+	return c.addSMSConfig(ctx, config.ResourceOwner, &config.ID, &config.Details, config.Password,
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigSMPPAddedEvent(ctx, aggregate, config.ID, config.Description, config.Host, config.TLS, config.TLSCA, config.TLSServerName, config.SystemID, secret, config.SystemType, config.SourceAddress)
+		},
+	)
+}
+
+type ChangeSMSSMPP struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description   *string
+	Host          *string
+	TLS           *bool
+	TLSCA         *string
+	TLSServerName *string
+	SystemID      *string
+	SystemType    *string
+	SourceAddress *string
+}
+
+func (c *Commands) ChangeSMSConfigSMPP(ctx context.Context, config *ChangeSMSSMPP) (err error) {
+	if config.TLSCA != nil && !isValidTLSCA(*config.TLSCA) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp4dTc", "Errors.SMSConfig.Invalid")
+	}
+	return c.changeSMSConfig(ctx, config.ResourceOwner, config.ID, &config.Details,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.SMPP != nil },
+		func(wm *IAMSMSConfigWriteModel, aggregate *eventstore.Aggregate) (eventstore.Command, bool, error) {
+			return wm.NewSMPPChangedEvent(ctx, aggregate, config.ID, config.Description, config.Host, config.TLS, config.TLSCA, config.TLSServerName, config.SystemID, config.SystemType, config.SourceAddress)
+		},
+	)
+}
+
+func (c *Commands) ChangeSMSConfigSMPPPassword(ctx context.Context, resourceOwner, id, password string) (*domain.ObjectDetails, error) {
+	return c.changeSMSConfigSecret(ctx, resourceOwner, id, password,
+		func(wm *IAMSMSConfigWriteModel) bool { return wm.SMPP != nil },
+		func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command {
+			return instance.NewSMSConfigSMPPPasswordChangedEvent(ctx, aggregate, id, secret)
+		},
+	)
+}
+
+// isValidTLSCA checks that the optional CA contains at least one PEM encoded certificate
+func isValidTLSCA(ca string) bool {
+	return ca == "" || x509.NewCertPool().AppendCertsFromPEM([]byte(ca))
+}
+
+// addSMSConfig encrypts the secret of the provider and pushes the added event created by newEvent
+func (c *Commands) addSMSConfig(
+	ctx context.Context,
+	resourceOwner string,
+	id *string,
+	details **domain.ObjectDetails,
+	secret string,
+	newEvent func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command,
+) (err error) {
+	if resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv5eUa", "Errors.ResourceOwnerMissing")
+	}
+	if *id == "" {
+		*id, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, *id)
+	if err != nil {
+		return err
+	}
+	if smsConfigWriteModel.State.Exists() {
+		return zerrors.ThrowAlreadyExists(nil, "COMMAND-Pv5eUb", "Errors.SMSConfig.AlreadyExists")
+	}
+	var encryptedSecret *crypto.CryptoValue
+	if secret != "" {
+		encryptedSecret, err = crypto.Encrypt([]byte(secret), c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		newEvent(InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel), encryptedSecret),
+	)
+	if err != nil {
+		return err
+	}
+	*details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+// changeSMSConfig pushes the changed event of the provider, if the config is of the provider (isProvider) and anything changed
+func (c *Commands) changeSMSConfig(
+	ctx context.Context,
+	resourceOwner,
+	id string,
+	details **domain.ObjectDetails,
+	isProvider func(wm *IAMSMSConfigWriteModel) bool,
+	newEvent func(wm *IAMSMSConfigWriteModel, aggregate *eventstore.Aggregate) (eventstore.Command, bool, error),
+) (err error) {
+	if resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv6fVa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv6fVb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, id)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || !isProvider(smsConfigWriteModel) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Pv6fVc", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := newEvent(smsConfigWriteModel, InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel))
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		*details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	if err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent); err != nil {
+		return err
+	}
+	*details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+// changeSMSConfigSecret encrypts the new secret of the provider and pushes the event created by newEvent
+func (c *Commands) changeSMSConfigSecret(
+	ctx context.Context,
+	resourceOwner,
+	id,
+	secret string,
+	isProvider func(wm *IAMSMSConfigWriteModel) bool,
+	newEvent func(aggregate *eventstore.Aggregate, secret *crypto.CryptoValue) eventstore.Command,
+) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv7gWa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv7gWb", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || !isProvider(smsConfigWriteModel) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pv7gWc", "Errors.SMSConfig.NotFound")
+	}
+	encryptedSecret, err := crypto.Encrypt([]byte(secret), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		newEvent(InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel), encryptedSecret),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
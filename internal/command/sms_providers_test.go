@@ -0,0 +1,628 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddSMSConfigVonage(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSVonage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing api secret, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{
+					ResourceOwner: "INSTANCE",
+					APIKey:        "key",
+					SenderNumber:  "+41000000000",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn1aQa", "Errors.SMSConfig.Invalid"))
+				},
+			},
+		},
+		{
+			name: "missing resourceowner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{
+					APIKey:       "key",
+					APISecret:    "secret",
+					SenderNumber: "+41000000000",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv5eUa", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config vonage, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigVonageAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"key",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("secret"),
+							},
+							"+41000000000",
+							"ZITADEL",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{
+					ResourceOwner: "INSTANCE",
+					Description:   "description",
+					APIKey:        "key",
+					APISecret:     "secret",
+					SenderNumber:  "+41000000000",
+					VerifyBrand:   "ZITADEL",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigVonage(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigSNS(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		sms *ChangeSMSSNS
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	snsAddedEvent := func() eventstore.Command {
+		return instance.NewSMSConfigSNSAddedEvent(
+			context.Background(),
+			&instance.NewAggregate("INSTANCE").Aggregate,
+			"providerid",
+			"description",
+			"eu-central-1",
+			"accessKeyID",
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("secret"),
+			},
+			"",
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv6fVb", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "other provider, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"endpoint",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Region:        gu.Ptr("us-east-1"),
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Pv6fVc", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(snsAddedEvent()),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Region:        gu.Ptr("eu-central-1"),
+					AccessKeyID:   gu.Ptr("accessKeyID"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "sms config sns change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(snsAddedEvent()),
+					),
+					expectPush(
+						newSMSConfigSNSChangedEvent(
+							context.Background(),
+							"providerid",
+							"us-east-1",
+							"ZITADEL",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Region:        gu.Ptr("us-east-1"),
+					SenderID:      gu.Ptr("ZITADEL"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeSMSConfigSNS(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+const testSMPPTLSCA = `-----BEGIN CERTIFICATE-----
+MIIBjDCCATOgAwIBAgIUKbV054nls1RVR5F69emuiqnJTmEwCgYIKoZIzj0EAwIw
+GzEZMBcGA1UEAwwQc21zYy5leGFtcGxlLmNvbTAgFw0yNjEwMTkwMTM5MzhaGA8y
+MTI2MDkyNTAxMzkzOFowGzEZMBcGA1UEAwwQc21zYy5leGFtcGxlLmNvbTBZMBMG
+ByqGSM49AgEGCCqGSM49AwEHA0IABBqVqvMc0orS8rrx+D+pLUL8nSvZ+cxC+J8L
+n4gBuUz068tCEFKeiXLQWRRe4iUoAVTX6dAiYveizMZ0FLo6QpijUzBRMB0GA1Ud
+DgQWBBSItMqpgF2R/rJE7EcJuSU5PRti9DAfBgNVHSMEGDAWgBSItMqpgF2R/rJE
+7EcJuSU5PRti9DAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49BAMCA0cAMEQCIDMg
+6SBaAzRFlrn4MEkOvvXd3elwXdNETPczb9D2qDCpAiA1Eekmsf0ow04PwWnYsT6K
+ro14QtCRbaDhnrIZKoshNw==
+-----END CERTIFICATE-----`
+
+func TestCommandSide_AddSMSConfigSMPP(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSSMPP
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid tls ca, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSSMPP{
+					ResourceOwner: "INSTANCE",
+					Host:          "smsc.example.com:2775",
+					TLS:           true,
+					TLSCA:         "no certificate",
+					SystemID:      "systemID",
+					SourceAddress: "ZITADEL",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp4dTb", "Errors.SMSConfig.Invalid"))
+				},
+			},
+		},
+		{
+			name: "add sms config smpp with tls ca, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigSMPPAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"10.0.0.1:2775",
+							true,
+							testSMPPTLSCA,
+							"smsc.example.com",
+							"systemID",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("password"),
+							},
+							"",
+							"ZITADEL",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSSMPP{
+					ResourceOwner: "INSTANCE",
+					Description:   "description",
+					Host:          "10.0.0.1:2775",
+					TLS:           true,
+					TLSCA:         testSMPPTLSCA,
+					TLSServerName: "smsc.example.com",
+					SystemID:      "systemID",
+					Password:      "password",
+					SourceAddress: "ZITADEL",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigSMPP(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigSMPPPassword(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+		password      string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				password:      "password",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Pv7gWc", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "sms config smpp password change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigSMPPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"smsc.example.com:2775",
+								true,
+								"",
+								"",
+								"systemID",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("password"),
+								},
+								"",
+								"ZITADEL",
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMSConfigSMPPPasswordChangedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("newpassword"),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				password:      "newpassword",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				smsEncryption: tt.fields.alg,
+			}
+			got, err := r.ChangeSMSConfigSMPPPassword(tt.args.ctx, tt.args.resourceOwner, tt.args.id, tt.args.password)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_phoneCodeVerifierFromConfig_vonage(t *testing.T) {
+	vonageAddedEvent := func(verifyBrand string) eventstore.Command {
+		return instance.NewSMSConfigVonageAddedEvent(
+			context.Background(),
+			&instance.NewAggregate("INSTANCE").Aggregate,
+			"providerid",
+			"description",
+			"key",
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("secret"),
+			},
+			"+41000000000",
+			verifyBrand,
+		)
+	}
+	activatedEvent := func() eventstore.Command {
+		return instance.NewSMSConfigActivatedEvent(
+			context.Background(),
+			&instance.NewAggregate("INSTANCE").Aggregate,
+			"providerid",
+		)
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       senders.CodeGenerator
+		wantErr    error
+	}{
+		{
+			name: "without verify brand, precondition failed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(vonageAddedEvent("")),
+					eventFromEventPusher(activatedEvent()),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vn8hXa", "Errors.SMSConfig.NotExternalVerification"),
+		},
+		{
+			name: "with verify brand, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(vonageAddedEvent("ZITADEL")),
+					eventFromEventPusher(activatedEvent()),
+				),
+			),
+			want: &vonage.Config{
+				APIKey:       "key",
+				APISecret:    "secret",
+				SenderNumber: "+41000000000",
+				VerifyBrand:  "ZITADEL",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:    tt.eventstore(t),
+				smsEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.phoneCodeVerifierFromConfig(authz.WithInstanceID(context.Background(), "INSTANCE"), "providerid")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newSMSConfigSNSChangedEvent(ctx context.Context, id, region, senderID string) *instance.SMSConfigSNSChangedEvent {
+	event, _ := instance.NewSMSConfigSNSChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		[]instance.SMSConfigSNSChanges{
+			instance.ChangeSMSConfigSNSRegion(region),
+			instance.ChangeSMSConfigSNSSenderID(senderID),
+		},
+	)
+	return event
+}
@@ -232,6 +232,44 @@ type AddSMTPConfigHTTP struct {
 	Endpoint    string
 }
 
+// GenerateSMTPConfigWebhookToken generates a new token for the delivery callbacks of the SMTP provider
+// and replaces a previously generated one.
+// The token is stored encrypted and only returned in plain on generation.
+func (c *Commands) GenerateSMTPConfigWebhookToken(ctx context.Context, resourceOwner, id string) (string, *domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wh8tKa", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wh8tKb", "Errors.IDMissing")
+	}
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, resourceOwner, id, "")
+	if err != nil {
+		return "", nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.SMTPConfig == nil {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Wh8tKc", "Errors.SMTPConfig.NotFound")
+	}
+
+	token, plain, err := crypto.NewCode(c.smtpWebhookTokenGenerator)
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		instance.NewSMTPConfigWebhookTokenGeneratedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+			token,
+		),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	return plain, writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) AddSMTPConfigHTTP(ctx context.Context, config *AddSMTPConfigHTTP) (err error) {
 	if config.ResourceOwner == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-FTNDXc8ACS", "Errors.ResourceOwnerMissing")
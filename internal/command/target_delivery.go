@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const idempotencyKeyField = "idempotencyKey"
+
+// RequestTargetDelivery writes a new targetdelivery.RequestedEvent, the call to the target is then handled by the delivery worker.
+// The ID of the delivery is used as idempotency key and added to the payload, so it's part of the signature.
+// The payload is stored encrypted with the key of the targets.
+func (c *Commands) RequestTargetDelivery(ctx context.Context, targetID, executionID string, payload []byte) error {
+	if targetID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-s8d2fk1m0x", "Errors.IDMissing")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	payload, err = payloadWithIdempotencyKey(payload, id)
+	if err != nil {
+		return err
+	}
+	encryptedPayload, err := crypto.Encrypt(payload, c.targetEncryption)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, targetdelivery.NewRequestedEvent(ctx,
+		targetdelivery.NewAggregate(id, authz.GetInstance(ctx).InstanceID()),
+		targetID,
+		executionID,
+		id,
+		encryptedPayload,
+		"",
+	))
+	return err
+}
+
+func payloadWithIdempotencyKey(payload []byte, key string) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-p2v8kx7d1q", "Errors.TargetDelivery.PayloadInvalid")
+	}
+	// the key is marshalled as JSON string
+	value, err := json.Marshal(key)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "COMMAND-j3n5b0w9zr", "Errors.Internal")
+	}
+	fields[idempotencyKeyField] = value
+	return json.Marshal(fields)
+}
+
+// TargetDeliverySucceeded writes a new targetdelivery.SucceededEvent with the targetdelivery.Aggregate to the eventstore
+func (c *Commands) TargetDeliverySucceeded(ctx context.Context, tx *sql.Tx, id, instanceID string, attempts uint16) error {
+	_, err := c.eventstore.PushWithClient(ctx, tx, targetdelivery.NewSucceededEvent(ctx, targetdelivery.NewAggregate(id, instanceID), attempts))
+	return err
+}
+
+// TargetDeliveryRetryRequested writes a new targetdelivery.RetryRequestedEvent with the targetdelivery.Aggregate to the eventstore
+func (c *Commands) TargetDeliveryRetryRequested(ctx context.Context, tx *sql.Tx, id, instanceID string, request targetdelivery.Request, backOff time.Duration, attempts uint16, requestError error) error {
+	var errorMessage string
+	if requestError != nil {
+		errorMessage = requestError.Error()
+	}
+	_, err := c.eventstore.PushWithClient(ctx, tx, targetdelivery.NewRetryRequestedEvent(ctx, targetdelivery.NewAggregate(id, instanceID), request, backOff, attempts, errorMessage))
+	return err
+}
+
+// TargetDeliveryFailed writes a new targetdelivery.FailedEvent with the targetdelivery.Aggregate to the eventstore,
+// the delivery is then kept as dead letter until it's replayed
+func (c *Commands) TargetDeliveryFailed(ctx context.Context, tx *sql.Tx, id, instanceID string, attempts uint16, requestError error) error {
+	var errorMessage string
+	if requestError != nil {
+		errorMessage = requestError.Error()
+	}
+	_, err := c.eventstore.PushWithClient(ctx, tx, targetdelivery.NewFailedEvent(ctx, targetdelivery.NewAggregate(id, instanceID), attempts, errorMessage))
+	return err
+}
+
+// ReplayTargetDelivery requests a new delivery with the payload and idempotency key of a failed delivery
+func (c *Commands) ReplayTargetDelivery(ctx context.Context, id, resourceOwner string) (*domain.ObjectDetails, error) {
+	if id == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-m1x7c4hz0e", "Errors.IDMissing")
+	}
+	wm := NewTargetDeliveryWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.State == domain.TargetDeliveryStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-y9f3qg6d2k", "Errors.TargetDelivery.NotFound")
+	}
+	if wm.State != domain.TargetDeliveryStateFailed {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-u5k0v2n8wb", "Errors.TargetDelivery.NotFailed")
+	}
+	replayID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	replayWM := NewTargetDeliveryWriteModel(replayID, resourceOwner)
+	if err := c.pushAppendAndReduce(ctx, replayWM,
+		targetdelivery.NewReplayedEvent(ctx, targetdelivery.NewAggregate(id, resourceOwner), replayID),
+		targetdelivery.NewRequestedEvent(ctx,
+			targetdelivery.NewAggregate(replayID, resourceOwner),
+			wm.Request.TargetID,
+			wm.Request.ExecutionID,
+			wm.Request.IdempotencyKey,
+			wm.Request.Payload,
+			id,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&replayWM.WriteModel), nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+)
+
+type TargetDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	Request  targetdelivery.Request
+	Attempts uint16
+	State    domain.TargetDeliveryState
+}
+
+func NewTargetDeliveryWriteModel(id string, resourceOwner string) *TargetDeliveryWriteModel {
+	return &TargetDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+			InstanceID:    resourceOwner,
+		},
+	}
+}
+
+func (wm *TargetDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *targetdelivery.RequestedEvent:
+			wm.Request = e.Request
+			wm.State = domain.TargetDeliveryStateRequested
+		case *targetdelivery.RetryRequestedEvent:
+			wm.Attempts = e.Attempts
+			wm.State = domain.TargetDeliveryStateRetrying
+		case *targetdelivery.SucceededEvent:
+			wm.Attempts = e.Attempts
+			wm.State = domain.TargetDeliveryStateSucceeded
+		case *targetdelivery.FailedEvent:
+			wm.Attempts = e.Attempts
+			wm.State = domain.TargetDeliveryStateFailed
+		case *targetdelivery.ReplayedEvent:
+			wm.State = domain.TargetDeliveryStateReplayed
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *TargetDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(targetdelivery.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			targetdelivery.RequestedType,
+			targetdelivery.RetryRequestedType,
+			targetdelivery.SucceededType,
+			targetdelivery.FailedType,
+			targetdelivery.ReplayedType,
+		).Builder()
+}
@@ -0,0 +1,287 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RequestTargetDelivery(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx         context.Context
+		targetID    string
+		executionID string
+		payload     []byte
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no target, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"payload not a json object, error",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: mock.ExpectID(t, "id1"),
+			},
+			args{
+				ctx:         authz.WithInstanceID(context.Background(), "instance"),
+				targetID:    "target",
+				executionID: "event",
+				payload:     []byte(`["not", "an", "object"]`),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						targetdelivery.NewRequestedEvent(context.Background(),
+							targetdelivery.NewAggregate("id1", "instance"),
+							"target",
+							"event",
+							"id1",
+							targetDeliveryPayload(`{"event":"user.added","idempotencyKey":"id1"}`),
+							"",
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "id1"),
+			},
+			args{
+				ctx:         authz.WithInstanceID(context.Background(), "instance"),
+				targetID:    "target",
+				executionID: "event",
+				payload:     []byte(`{"event":"user.added"}`),
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				idGenerator:      tt.fields.idGenerator,
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			err := c.RequestTargetDelivery(tt.args.ctx, tt.args.targetID, tt.args.executionID, tt.args.payload)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func targetDeliveryPayload(payload string) *crypto.CryptoValue {
+	return &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte(payload),
+	}
+}
+
+func targetDeliveryRequestedEvent(id, instanceID string) *targetdelivery.RequestedEvent {
+	return targetdelivery.NewRequestedEvent(context.Background(),
+		targetdelivery.NewAggregate(id, instanceID),
+		"target",
+		"event",
+		id,
+		targetDeliveryPayload(`{"idempotencyKey":"`+id+`"}`),
+		"",
+	)
+}
+
+func TestCommands_ReplayTargetDelivery(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"not failed, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryRequestedEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							targetdelivery.NewSucceededEvent(context.Background(), targetdelivery.NewAggregate("id1", "instance"), 1),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"already replayed, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryRequestedEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							targetdelivery.NewFailedEvent(context.Background(), targetdelivery.NewAggregate("id1", "instance"), 5, "timeout"),
+						),
+						eventFromEventPusher(
+							targetdelivery.NewReplayedEvent(context.Background(), targetdelivery.NewAggregate("id1", "instance"), "id2"),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"replay, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetDeliveryRequestedEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							targetdelivery.NewFailedEvent(context.Background(), targetdelivery.NewAggregate("id1", "instance"), 5, "timeout"),
+						),
+					),
+					expectPush(
+						targetdelivery.NewReplayedEvent(context.Background(), targetdelivery.NewAggregate("id1", "instance"), "id2"),
+						targetdelivery.NewRequestedEvent(context.Background(),
+							targetdelivery.NewAggregate("id2", "instance"),
+							"target",
+							"event",
+							"id1",
+							targetDeliveryPayload(`{"idempotencyKey":"id1"}`),
+							"id1",
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "id2"),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "id2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			details, err := c.ReplayTargetDelivery(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/userconsent"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GrantUserConsent stores the scopes the user consented to for the client (application).
+// A previous consent of the user for the client is replaced.
+func (c *Commands) GrantUserConsent(ctx context.Context, userID, resourceOwner, clientID, projectID string, scopes []string) (*domain.ObjectDetails, error) {
+	if userID == "" || resourceOwner == "" || clientID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Qc3nTf", "Errors.IDMissing")
+	}
+	writeModel, err := c.getUserConsentWriteModel(ctx, userID, resourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	scopes = normalizeConsentScopes(scopes)
+	if writeModel.Granted && writeModel.ProjectID == projectID && slices.Equal(writeModel.Scopes, scopes) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		userconsent.NewGrantedEvent(ctx,
+			userconsent.NewAggregate(userID, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			clientID,
+			projectID,
+			scopes,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RevokeUserConsent removes the consent of the user for the client (application),
+// the user is asked again for consent on the next login to the application, if the application requires consent.
+func (c *Commands) RevokeUserConsent(ctx context.Context, userID, resourceOwner, clientID string) (*domain.ObjectDetails, error) {
+	if userID == "" || resourceOwner == "" || clientID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hn8vWd", "Errors.IDMissing")
+	}
+	writeModel, err := c.getUserConsentWriteModel(ctx, userID, resourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Granted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Xk5rBe", "Errors.User.Consent.NotFound")
+	}
+	if err := c.pushAppendAndReduce(ctx, writeModel,
+		userconsent.NewRevokedEvent(ctx,
+			userconsent.NewAggregate(userID, resourceOwner, authz.GetInstance(ctx).InstanceID()),
+			clientID,
+		),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getUserConsentWriteModel(ctx context.Context, userID, resourceOwner, clientID string) (*UserConsentWriteModel, error) {
+	writeModel := NewUserConsentWriteModel(userID, resourceOwner, clientID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// normalizeConsentScopes returns the sorted scopes without duplicates
+func normalizeConsentScopes(scopes []string) []string {
+	scopes = slices.Clone(scopes)
+	slices.Sort(scopes)
+	return slices.Compact(scopes)
+}
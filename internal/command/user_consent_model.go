@@ -0,0 +1,86 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/userconsent"
+)
+
+type UserConsentWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID  string
+	ProjectID string
+	Scopes    []string
+	Granted   bool
+}
+
+func NewUserConsentWriteModel(userID, resourceOwner, clientID string) *UserConsentWriteModel {
+	return &UserConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ClientID: clientID,
+	}
+}
+
+func (wm *UserConsentWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *userconsent.GrantedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *userconsent.RevokedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *UserConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *userconsent.GrantedEvent:
+			wm.ProjectID = e.ProjectID
+			wm.Scopes = e.Scopes
+			wm.Granted = true
+		case *userconsent.RevokedEvent:
+			wm.ProjectID = ""
+			wm.Scopes = nil
+			wm.Granted = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// Covers checks if the user consented to all the scopes
+func (wm *UserConsentWriteModel) Covers(scopes []string) bool {
+	if !wm.Granted {
+		return false
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(wm.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func (wm *UserConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(userconsent.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			userconsent.GrantedType,
+			userconsent.RevokedType,
+		).
+		Builder()
+}
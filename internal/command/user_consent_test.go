@@ -0,0 +1,295 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/userconsent"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_GrantUserConsent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		clientID      string
+		projectID     string
+		scopes        []string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no client, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"same consent, no push",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							userconsent.NewGrantedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client1",
+								"project1",
+								[]string{"email", "openid"},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"openid", "email", "openid"},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"consent of other client, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							userconsent.NewGrantedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client2",
+								"project1",
+								[]string{"email", "openid"},
+							),
+						),
+					),
+					expectPush(
+						userconsent.NewGrantedEvent(context.Background(),
+							userconsent.NewAggregate("user1", "org1", "instance"),
+							"client1",
+							"project1",
+							[]string{"email", "openid"},
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"openid", "email"},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"additional scopes, push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							userconsent.NewGrantedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						userconsent.NewGrantedEvent(context.Background(),
+							userconsent.NewAggregate("user1", "org1", "instance"),
+							"client1",
+							"project1",
+							[]string{"email", "openid"},
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+				projectID:     "project1",
+				scopes:        []string{"openid", "email"},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.GrantUserConsent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.clientID, tt.args.projectID, tt.args.scopes)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RevokeUserConsent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		clientID      string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no user, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance"),
+				clientID: "client1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not granted, not found error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							userconsent.NewGrantedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+						eventFromEventPusher(
+							userconsent.NewRevokedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"revoke, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							userconsent.NewGrantedEvent(context.Background(),
+								userconsent.NewAggregate("user1", "org1", "instance"),
+								"client1",
+								"project1",
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						userconsent.NewRevokedEvent(context.Background(),
+							userconsent.NewAggregate("user1", "org1", "instance"),
+							"client1",
+						),
+					),
+				),
+			},
+			args{
+				ctx:           authz.WithInstanceID(context.Background(), "instance"),
+				userID:        "user1",
+				resourceOwner: "org1",
+				clientID:      "client1",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RevokeUserConsent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.clientID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
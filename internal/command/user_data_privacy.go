@@ -0,0 +1,255 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	userDataExportContentType = "application/json"
+	// userDeletionReauthenticationMaxAge is the maximum age of the last check of the session,
+	// which is used to confirm the deletion of the user.
+	userDeletionReauthenticationMaxAge = 5 * time.Minute
+)
+
+// RequestUserDataExport records the request of the user for an export of all its data.
+// The archive is generated asynchronously and can be downloaded once, as soon as it's ready.
+func (c *Commands) RequestUserDataExport(ctx context.Context, orgID, userID string) (_ string, _ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dp1aAa", "Errors.User.UserIDMissing")
+	}
+	exportID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	wm, err := c.userDataExportWriteModel(ctx, userID, orgID, exportID)
+	if err != nil {
+		return "", nil, err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Dp1bBb", "Errors.User.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewDataExportRequestedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), exportID)); err != nil {
+		return "", nil, err
+	}
+	return exportID, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// CompleteUserDataExport stores the generated archive of the export, so that it can be downloaded by the user.
+func (c *Commands) CompleteUserDataExport(ctx context.Context, orgID, userID, exportID string, data []byte) (err error) {
+	wm, err := c.existingUserDataExportWriteModel(ctx, orgID, userID, exportID)
+	if err != nil {
+		return err
+	}
+	if wm.State != domain.UserDataExportStateRequested {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1cCc", "Errors.User.DataExport.NotRequested")
+	}
+	_, err = c.uploadAsset(ctx, &AssetUpload{
+		ResourceOwner: wm.ResourceOwner,
+		ObjectName:    wm.AssetPath(),
+		ContentType:   userDataExportContentType,
+		ObjectType:    static.ObjectTypeUserDataExport,
+		File:          bytes.NewReader(data),
+		Size:          int64(len(data)),
+	})
+	if err != nil {
+		return zerrors.ThrowInternal(err, "COMMAND-Dp1dDd", "Errors.Assets.Object.PutFailed")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewDataExportCompletedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), exportID))
+	return err
+}
+
+// DownloadUserDataExport returns the archive of the export.
+// The archive is removed afterwards and can't be downloaded again.
+func (c *Commands) DownloadUserDataExport(ctx context.Context, orgID, userID, exportID string) (_ []byte, _ *domain.ObjectDetails, err error) {
+	wm, err := c.existingUserDataExportWriteModel(ctx, orgID, userID, exportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch wm.State {
+	case domain.UserDataExportStateReady:
+	case domain.UserDataExportStateDownloaded:
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1eEe", "Errors.User.DataExport.AlreadyDownloaded")
+	default:
+		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1fFf", "Errors.User.DataExport.NotReady")
+	}
+	data, _, err := c.static.GetObject(ctx, authz.GetInstance(ctx).InstanceID(), wm.ResourceOwner, wm.AssetPath())
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "COMMAND-Dp1gGg", "Errors.Assets.Object.GetFailed")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewDataExportDownloadedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), exportID)); err != nil {
+		return nil, nil, err
+	}
+	logging.OnError(c.removeAsset(ctx, wm.ResourceOwner, wm.AssetPath())).
+		WithField("exportID", exportID).Warn("could not remove downloaded user data export")
+	return data, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) existingUserDataExportWriteModel(ctx context.Context, orgID, userID, exportID string) (*UserDataExportWriteModel, error) {
+	if userID == "" || exportID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dp1hHh", "Errors.IDMissing")
+	}
+	wm, err := c.userDataExportWriteModel(ctx, userID, orgID, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dp1iIi", "Errors.User.NotFound")
+	}
+	if wm.State == domain.UserDataExportStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Dp1jJj", "Errors.User.DataExport.NotFound")
+	}
+	return wm, nil
+}
+
+func (c *Commands) userDataExportWriteModel(ctx context.Context, userID, resourceOwner, exportID string) (writeModel *UserDataExportWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewUserDataExportWriteModel(userID, resourceOwner, exportID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// ScheduleUserDeletion schedules the deletion of the user after the grace period of the privacy policy.
+// The user has to confirm the deletion with a session, which was checked within the last minutes.
+// The deletion can be canceled until the deletion date.
+func (c *Commands) ScheduleUserDeletion(ctx context.Context, orgID, userID, sessionID, sessionToken string) (_ time.Time, _ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return time.Time{}, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1aAa", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.userDeletionWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return time.Time{}, nil, zerrors.ThrowNotFound(nil, "COMMAND-Dl1bBb", "Errors.User.NotFound")
+	}
+	if wm.Scheduled() {
+		return time.Time{}, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1cCc", "Errors.User.Deletion.AlreadyScheduled")
+	}
+	policy, err := c.getOrgPrivacyPolicy(ctx, wm.ResourceOwner)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if policy.DeletionGracePeriod <= 0 {
+		return time.Time{}, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1dDd", "Errors.User.Deletion.NoGracePeriod")
+	}
+	if err = c.checkUserReauthentication(ctx, userID, sessionID, sessionToken); err != nil {
+		return time.Time{}, nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewDeletionScheduledEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), policy.DeletionGracePeriod)); err != nil {
+		return time.Time{}, nil, err
+	}
+	return wm.DeletionDate, writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// checkUserReauthentication checks that the session belongs to the user
+// and that the user authenticated within the [userDeletionReauthenticationMaxAge].
+func (c *Commands) checkUserReauthentication(ctx context.Context, userID, sessionID, sessionToken string) error {
+	if sessionID == "" || sessionToken == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1eEe", "Errors.User.Deletion.ReauthenticationRequired")
+	}
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return err
+	}
+	if err := sessionWriteModel.CheckIsActive(); err != nil {
+		return err
+	}
+	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+		return err
+	}
+	if sessionWriteModel.UserID != userID ||
+		sessionWriteModel.AuthenticationTime().Before(time.Now().Add(-userDeletionReauthenticationMaxAge)) {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Dl1fFf", "Errors.User.Deletion.ReauthenticationRequired")
+	}
+	return nil
+}
+
+// CancelUserDeletion cancels the scheduled deletion of the user.
+func (c *Commands) CancelUserDeletion(ctx context.Context, orgID, userID string) (_ *domain.ObjectDetails, err error) {
+	wm, err := c.scheduledUserDeletionWriteModel(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Scheduled() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1gGg", "Errors.User.Deletion.NotScheduled")
+	}
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewDeletionCanceledEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel))); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// AddUserDeletionReminder records that the user will be deleted at the deletion date,
+// so that the user gets reminded about it.
+// Nothing is recorded if the deletion was rescheduled, canceled or the user was already reminded.
+func (c *Commands) AddUserDeletionReminder(ctx context.Context, orgID, userID string, deletionDate time.Time) (err error) {
+	wm, err := c.scheduledUserDeletionWriteModel(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	// the deletion date is read from the projection, which only stores microseconds
+	if !wm.Scheduled() || wm.Reminded || wm.DeletionDate.UnixMicro() != deletionDate.UnixMicro() {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, user.NewDeletionReminderAddedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), wm.DeletionDate))
+	return err
+}
+
+// UserDeletionReminderSent marks the reminder about the scheduled deletion as sent to the user.
+func (c *Commands) UserDeletionReminderSent(ctx context.Context, orgID, userID string) (err error) {
+	wm, err := c.scheduledUserDeletionWriteModel(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1hHh", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewDeletionReminderSentEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel)))
+	return err
+}
+
+// RemoveScheduledUser removes the user, if its scheduled deletion is due.
+// Nothing happens if the deletion was canceled or is not due yet.
+func (c *Commands) RemoveScheduledUser(ctx context.Context, orgID, userID string, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) (err error) {
+	wm, err := c.scheduledUserDeletionWriteModel(ctx, orgID, userID)
+	if err != nil || !wm.Due(time.Now()) {
+		return err
+	}
+	_, err = c.RemoveUser(ctx, userID, orgID, cascadingUserMemberships, cascadingGrantIDs...)
+	return err
+}
+
+func (c *Commands) scheduledUserDeletionWriteModel(ctx context.Context, orgID, userID string) (*UserDeletionWriteModel, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1iIi", "Errors.User.UserIDMissing")
+	}
+	return c.userDeletionWriteModel(ctx, userID, orgID)
+}
+
+func (c *Commands) userDeletionWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *UserDeletionWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewUserDeletionWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,168 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// UserDataExportWriteModel keeps track of the state of a single export of the data of the user.
+type UserDataExportWriteModel struct {
+	eventstore.WriteModel
+
+	ExportID  string
+	UserState domain.UserState
+	State     domain.UserDataExportState
+}
+
+func NewUserDataExportWriteModel(userID, resourceOwner, exportID string) *UserDataExportWriteModel {
+	return &UserDataExportWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ExportID: exportID,
+	}
+}
+
+func (wm *UserDataExportWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.DataExportRequestedEvent:
+			if e.ExportID != wm.ExportID {
+				continue
+			}
+		case *user.DataExportCompletedEvent:
+			if e.ExportID != wm.ExportID {
+				continue
+			}
+		case *user.DataExportDownloadedEvent:
+			if e.ExportID != wm.ExportID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *UserDataExportWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		case *user.DataExportRequestedEvent:
+			wm.State = domain.UserDataExportStateRequested
+		case *user.DataExportCompletedEvent:
+			wm.State = domain.UserDataExportStateReady
+		case *user.DataExportDownloadedEvent:
+			wm.State = domain.UserDataExportStateDownloaded
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserDataExportWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.UserRemovedType,
+			user.DataExportRequestedType,
+			user.DataExportCompletedType,
+			user.DataExportDownloadedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// AssetPath returns the path of the archive in the static storage.
+func (wm *UserDataExportWriteModel) AssetPath() string {
+	return domain.GetUserDataExportAssetPath(wm.AggregateID, wm.ExportID)
+}
+
+// UserDeletionWriteModel keeps track of a deletion of the user requested by the user itself.
+type UserDeletionWriteModel struct {
+	eventstore.WriteModel
+
+	UserState    domain.UserState
+	DeletionDate time.Time
+	Reminded     bool
+}
+
+func NewUserDeletionWriteModel(userID, resourceOwner string) *UserDeletionWriteModel {
+	return &UserDeletionWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserDeletionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.DeletionDate = time.Time{}
+		case *user.DeletionScheduledEvent:
+			wm.DeletionDate = e.DeletionDate()
+			wm.Reminded = false
+		case *user.DeletionCanceledEvent:
+			wm.DeletionDate = time.Time{}
+			wm.Reminded = false
+		case *user.DeletionReminderAddedEvent:
+			wm.Reminded = true
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserDeletionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.UserRemovedType,
+			user.DeletionScheduledType,
+			user.DeletionCanceledType,
+			user.DeletionReminderAddedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Scheduled returns if the deletion of the user is scheduled.
+func (wm *UserDeletionWriteModel) Scheduled() bool {
+	return isUserStateExists(wm.UserState) && !wm.DeletionDate.IsZero()
+}
+
+// Due returns if the scheduled deletion of the user is due at the provided time.
+func (wm *UserDeletionWriteModel) Due(now time.Time) bool {
+	return wm.Scheduled() && !wm.DeletionDate.After(now)
+}
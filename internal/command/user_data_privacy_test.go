@@ -0,0 +1,878 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+	static_mock "github.com/zitadel/zitadel/internal/static/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RequestUserDataExport(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		args         args
+		wantExportID string
+		wantErr      error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{},
+			"",
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Dp1aAa", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "exportID"),
+			},
+			args{
+				orgID:  "org1",
+				userID: "userID",
+			},
+			"",
+			zerrors.ThrowNotFound(nil, "COMMAND-Dp1bBb", "Errors.User.NotFound"),
+		},
+		{
+			"request, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectPush(
+						user.NewDataExportRequestedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"exportID",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "exportID"),
+			},
+			args{
+				orgID:  "org1",
+				userID: "userID",
+			},
+			"exportID",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			exportID, details, err := c.RequestUserDataExport(context.Background(), tt.args.orgID, tt.args.userID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantExportID, exportID)
+				assert.Equal(t, "org1", details.ResourceOwner)
+			}
+		})
+	}
+}
+
+func TestCommands_CompleteUserDataExport(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			"export does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+				),
+			},
+			zerrors.ThrowNotFound(nil, "COMMAND-Dp1jJj", "Errors.User.DataExport.NotFound"),
+		},
+		{
+			"export already completed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportCompletedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+					),
+				),
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1cCc", "Errors.User.DataExport.NotRequested"),
+		},
+		{
+			"storage fails",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectPutObjectError(),
+			},
+			zerrors.ThrowInternal(nil, "COMMAND-Dp1dDd", "Errors.Assets.Object.PutFailed"),
+		},
+		{
+			"complete, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"otherExportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportCompletedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"otherExportID",
+							),
+						),
+					),
+					expectPush(
+						user.NewDataExportCompletedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"exportID",
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectPutObject(),
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			err := c.CompleteUserDataExport(context.Background(), "org1", "userID", "exportID", []byte(`{}`))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_DownloadUserDataExport(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		exportID string
+		wantData []byte
+		wantErr  error
+	}{
+		{
+			"missing export id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			"",
+			nil,
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Dp1hHh", "Errors.IDMissing"),
+		},
+		{
+			"export not ready",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+					),
+				),
+			},
+			"exportID",
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1fFf", "Errors.User.DataExport.NotReady"),
+		},
+		{
+			"export already downloaded",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportCompletedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportDownloadedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+					),
+				),
+			},
+			"exportID",
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dp1eEe", "Errors.User.DataExport.AlreadyDownloaded"),
+		},
+		{
+			"download, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDataExportRequestedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+						eventFromEventPusher(
+							user.NewDataExportCompletedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"exportID",
+							),
+						),
+					),
+					expectPush(
+						user.NewDataExportDownloadedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"exportID",
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObject([]byte(`{}`)).ExpectRemoveObjectNoError(),
+			},
+			"exportID",
+			[]byte(`{}`),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			data, _, err := c.DownloadUserDataExport(context.Background(), "org1", "userID", tt.exportID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantData, data)
+		})
+	}
+}
+
+func TestCommands_ScheduleUserDeletion(t *testing.T) {
+	type fields struct {
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		tokenVerifier func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+	}
+	type args struct {
+		userID    string
+		sessionID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1aAa", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			zerrors.ThrowNotFound(nil, "COMMAND-Dl1bBb", "Errors.User.NotFound"),
+		},
+		{
+			"already scheduled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+				),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1cCc", "Errors.User.Deletion.AlreadyScheduled"),
+		},
+		{
+			"no grace period",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newUserDeletionPrivacyPolicyAddedEvent(0),
+						),
+					),
+				),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1dDd", "Errors.User.Deletion.NoGracePeriod"),
+		},
+		{
+			"missing session",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newUserDeletionPrivacyPolicyAddedEvent(24*time.Hour),
+						),
+					),
+				),
+			},
+			args{
+				userID: "userID",
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl1eEe", "Errors.User.Deletion.ReauthenticationRequired"),
+		},
+		{
+			"session of other user",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newUserDeletionPrivacyPolicyAddedEvent(24*time.Hour),
+						),
+					),
+					expectFilter(
+						newUserDeletionSessionEvents("otherUserID", time.Now())...,
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			zerrors.ThrowPermissionDenied(nil, "COMMAND-Dl1fFf", "Errors.User.Deletion.ReauthenticationRequired"),
+		},
+		{
+			"session checked too long ago",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newUserDeletionPrivacyPolicyAddedEvent(24*time.Hour),
+						),
+					),
+					expectFilter(
+						newUserDeletionSessionEvents("userID", time.Now().Add(-time.Hour))...,
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			zerrors.ThrowPermissionDenied(nil, "COMMAND-Dl1fFf", "Errors.User.Deletion.ReauthenticationRequired"),
+		},
+		{
+			"schedule, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newUserDeletionPrivacyPolicyAddedEvent(24*time.Hour),
+						),
+					),
+					expectFilter(
+						newUserDeletionSessionEvents("userID", time.Now())...,
+					),
+					expectPush(
+						user.NewDeletionScheduledEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							24*time.Hour,
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				userID:    "userID",
+				sessionID: "sessionID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+			}
+			sessionToken := ""
+			if tt.args.sessionID != "" {
+				sessionToken = "token"
+			}
+			_, _, err := c.ScheduleUserDeletion(context.Background(), "org1", tt.args.userID, tt.args.sessionID, sessionToken)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_CancelUserDeletion(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			"not scheduled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+						eventFromEventPusher(
+							user.NewDeletionCanceledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl1gGg", "Errors.User.Deletion.NotScheduled"),
+		},
+		{
+			"cancel, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+					expectPush(
+						user.NewDeletionCanceledEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := c.CancelUserDeletion(context.Background(), "org1", "userID")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_AddUserDeletionReminder(t *testing.T) {
+	// events created by eventFromEventPusher have no creation date
+	deletionDate := time.Time{}.Add(24 * time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		deletionDate time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"not scheduled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+				),
+			},
+			args{
+				deletionDate: deletionDate,
+			},
+			nil,
+		},
+		{
+			"already reminded",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+						eventFromEventPusher(
+							user.NewDeletionReminderAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								deletionDate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				deletionDate: deletionDate,
+			},
+			nil,
+		},
+		{
+			"rescheduled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+				),
+			},
+			args{
+				deletionDate: deletionDate.Add(time.Hour),
+			},
+			nil,
+		},
+		{
+			"add, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+					expectPush(
+						user.NewDeletionReminderAddedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							deletionDate,
+						),
+					),
+				),
+			},
+			args{
+				deletionDate: deletionDate,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.AddUserDeletionReminder(context.Background(), "org1", "userID", tt.args.deletionDate)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RemoveScheduledUser(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			"not scheduled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+				),
+			},
+			nil,
+		},
+		{
+			"not due",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+				),
+			},
+			nil,
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewDeletionScheduledEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								24*time.Hour,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewUserRemovedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"username",
+							nil,
+							true,
+						),
+					),
+				),
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.RemoveScheduledUser(context.Background(), "org1", "userID", nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func newUserDeletionPrivacyPolicyAddedEvent(deletionGracePeriod time.Duration) *org.PrivacyPolicyAddedEvent {
+	return org.NewPrivacyPolicyAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"TOSLink",
+		"PrivacyLink",
+		"HelpLink",
+		"support@example.com",
+		"DocsLink",
+		"CustomLink",
+		"CustomLinkText",
+		deletionGracePeriod,
+	)
+}
+
+func newUserDeletionSessionEvents(userID string, checkedAt time.Time) []eventstore.Event {
+	return []eventstore.Event{
+		eventFromEventPusher(
+			session.NewAddedEvent(context.Background(),
+				&session.NewAggregate("sessionID", "instance1").Aggregate,
+				&domain.UserAgent{},
+			),
+		),
+		eventFromEventPusher(
+			session.NewUserCheckedEvent(context.Background(),
+				&session.NewAggregate("sessionID", "instance1").Aggregate,
+				userID, "org1", checkedAt, nil,
+			),
+		),
+		eventFromEventPusher(
+			session.NewPasswordCheckedEvent(context.Background(),
+				&session.NewAggregate("sessionID", "instance1").Aggregate,
+				checkedAt,
+			),
+		),
+		eventFromEventPusher(
+			session.NewTokenSetEvent(context.Background(),
+				&session.NewAggregate("sessionID", "instance1").Aggregate,
+				"tokenID",
+			),
+		),
+	}
+}
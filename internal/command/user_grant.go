@@ -23,7 +23,11 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	cmds := []eventstore.Command{event}
+	if !usergrant.AccessValidity.IsZero() {
+		cmds = append(cmds, c.setUserGrantValidity(ctx, addedUserGrant, usergrant.AccessValidity))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +43,9 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 	if !userGrant.IsValid() {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if !userGrant.AccessValidity.IsValid() {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vXh", "Errors.UserGrant.ValidityInvalid")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, resourceOwner)
 	if err != nil {
 		return nil, nil, err
@@ -386,9 +393,19 @@ func (c *Commands) searchUserGrantPreConditionState(ctx context.Context, userGra
 			case project.ProjectGrantGrantedOrgIDSearchField:
 				var orgID string
 				err := result.Value.Unmarshal(&orgID)
-				if err != nil || orgID != resourceOwner {
+				if err != nil {
 					return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-3m9gg", "Errors.Org.NotFound")
 				}
+				if orgID != resourceOwner {
+					// the grant might be inherited from an ancestor of the organization
+					inherited, err := c.orgInheritsProjectGrants(ctx, resourceOwner, orgID)
+					if err != nil {
+						return nil, err
+					}
+					if !inherited {
+						return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3m9gg", "Errors.Org.NotFound")
+					}
+				}
 			case project.ProjectGrantStateSearchField:
 				var state domain.ProjectGrantState
 				err := result.Value.Unmarshal(&state)
@@ -446,5 +463,15 @@ func (c *Commands) checkUserGrantPreConditionOld(ctx context.Context, usergrant
 	if usergrant.HasInvalidRoles(preConditions.ExistingRoleKeys) {
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-mm9F4", "Errors.Project.Role.NotFound")
 	}
+	if usergrant.ProjectGrantID != "" && preConditions.GrantedOrgID != resourceOwner {
+		// the grant might be inherited from an ancestor of the organization
+		inherited, err := c.orgInheritsProjectGrants(ctx, resourceOwner, preConditions.GrantedOrgID)
+		if err != nil {
+			return err
+		}
+		if !inherited {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-4m9ff", "Errors.Project.Grant.NotFound")
+		}
+	}
 	return nil
 }
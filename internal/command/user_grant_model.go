@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -16,6 +18,9 @@ type UserGrantWriteModel struct {
 	ProjectGrantID string
 	RoleKeys       []string
 	State          domain.UserGrantState
+	domain.AccessValidity
+	// ExpiryNotifiedFor is the end of the validity the grantee was last notified about
+	ExpiryNotifiedFor time.Time
 }
 
 func NewUserGrantWriteModel(userGrantID string, resourceOwner string) *UserGrantWriteModel {
@@ -54,6 +59,13 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.State = domain.UserGrantStateRemoved
 		case *usergrant.UserGrantCascadeRemovedEvent:
 			wm.State = domain.UserGrantStateRemoved
+		case *usergrant.UserGrantValiditySetEvent:
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
+		case *usergrant.UserGrantExpiredEvent:
+			wm.State = domain.UserGrantStateRemoved
+		case *usergrant.UserGrantExpiryNotificationAddedEvent:
+			wm.ExpiryNotifiedFor = e.ValidUntil
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -70,7 +82,10 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
-			usergrant.UserGrantCascadeRemovedType).
+			usergrant.UserGrantCascadeRemovedType,
+			usergrant.UserGrantValiditySetType,
+			usergrant.UserGrantExpiredType,
+			usergrant.UserGrantExpiryNotificationAddedType).
 		Builder()
 
 	if wm.ResourceOwner != "" {
@@ -93,6 +108,7 @@ type UserGrantPreConditionReadModel struct {
 	UserExists         bool
 	ProjectExists      bool
 	ProjectGrantExists bool
+	GrantedOrgID       string
 	ExistingRoleKeys   []string
 }
 
@@ -123,8 +139,9 @@ func (wm *UserGrantPreConditionReadModel) Reduce() error {
 		case *project.ProjectRemovedEvent:
 			wm.ProjectExists = false
 		case *project.GrantAddedEvent:
-			if wm.ProjectGrantID == e.GrantID && wm.ResourceOwner == e.GrantedOrgID {
+			if wm.ProjectGrantID == e.GrantID {
 				wm.ProjectGrantExists = true
+				wm.GrantedOrgID = e.GrantedOrgID
 				wm.ExistingRoleKeys = e.RoleKeys
 			}
 		case *project.GrantChangedEvent:
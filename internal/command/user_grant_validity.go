@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetUserGrantValidity restricts the user grant to the provided period of time.
+// A zero validity removes the restriction.
+func (c *Commands) SetUserGrantValidity(ctx context.Context, grantID, resourceOwner string, validity domain.AccessValidity) (_ *domain.ObjectDetails, err error) {
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vQa", "Errors.UserGrant.IDMissing")
+	}
+	if !validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vRb", "Errors.UserGrant.ValidityInvalid")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ug4vSc", "Errors.UserGrant.NotFound")
+	}
+	err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.AccessValidity.Equal(validity) {
+		return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, existingUserGrant, c.setUserGrantValidity(ctx, existingUserGrant, validity))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+func (c *Commands) setUserGrantValidity(ctx context.Context, writeModel *UserGrantWriteModel, validity domain.AccessValidity) eventstore.Command {
+	return usergrant.NewUserGrantValiditySetEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&writeModel.WriteModel),
+		validity.ValidFrom,
+		validity.ValidUntil,
+	)
+}
+
+// ExpireUserGrant removes the user grant after the end of its validity.
+// User grants which do not exist anymore or which are still valid are ignored,
+// so the scheduler can safely act on a projection which is not up to date.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (err error) {
+	if grantID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vTd", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil
+	}
+	if !existingUserGrant.ExpiredAt(time.Now()) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantExpiredEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.UserID,
+		existingUserGrant.ProjectID,
+		existingUserGrant.ProjectGrantID,
+		existingUserGrant.ValidUntil,
+	))
+	return err
+}
+
+// AddUserGrantExpiryNotification records that the end of the validity of the user grant approaches,
+// so that the grantee and the managers get notified about it.
+// Nothing is recorded if the notification about the current end of the validity was already added.
+func (c *Commands) AddUserGrantExpiryNotification(ctx context.Context, grantID, resourceOwner string) (err error) {
+	if grantID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vUe", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil
+	}
+	if existingUserGrant.ValidUntil.IsZero() || existingUserGrant.ExpiryNotifiedFor.Equal(existingUserGrant.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantExpiryNotificationAddedEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.UserID,
+		existingUserGrant.ProjectID,
+		existingUserGrant.ValidUntil,
+	))
+	return err
+}
+
+// UserGrantExpiryNotificationSent marks the expiry notification as sent to the recipient.
+func (c *Commands) UserGrantExpiryNotificationSent(ctx context.Context, grantID, resourceOwner, recipientID string) (err error) {
+	if grantID == "" || recipientID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vVf", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ug4vWg", "Errors.UserGrant.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, usergrant.NewUserGrantExpiryNotificationSentEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		recipientID,
+	))
+	return err
+}
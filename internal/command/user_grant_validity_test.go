@@ -0,0 +1,330 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func userGrantAddedTestEvent() eventstore.Event {
+	return eventFromEventPusher(
+		usergrant.NewUserGrantAddedEvent(context.Background(),
+			&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+			"user1",
+			"project1",
+			"", []string{"rolekey1"}),
+	)
+}
+
+func TestCommands_SetUserGrantValidity(t *testing.T) {
+	validFrom := time.Now().Add(time.Hour).UTC()
+	validUntil := validFrom.Add(24 * time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		grantID  string
+		validity domain.AccessValidity
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "ends before start, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				grantID:  "usergrant1",
+				validity: domain.AccessValidity{ValidFrom: validUntil, ValidUntil: validFrom},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				grantID:  "usergrant1",
+				validity: domain.AccessValidity{ValidUntil: validUntil},
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "no permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAddedTestEvent()),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				grantID:  "usergrant1",
+				validity: domain.AccessValidity{ValidUntil: validUntil},
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "unchanged, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								validFrom, validUntil),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				grantID:  "usergrant1",
+				validity: domain.AccessValidity{ValidFrom: validFrom, ValidUntil: validUntil},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAddedTestEvent()),
+					expectPush(
+						usergrant.NewUserGrantValiditySetEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							validFrom, validUntil),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				grantID:  "usergrant1",
+				validity: domain.AccessValidity{ValidFrom: validFrom, ValidUntil: validUntil},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetUserGrantValidity(tt.args.ctx, tt.args.grantID, "org1", tt.args.validity)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_ExpireUserGrant(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).UTC()
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		grantID string
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing id, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "removed, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantRemovedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1", "project1", ""),
+						),
+					),
+				),
+			},
+			grantID: "usergrant1",
+		},
+		{
+			name: "not restricted, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAddedTestEvent()),
+				),
+			},
+			grantID: "usergrant1",
+		},
+		{
+			name: "still valid, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Time{}, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			grantID: "usergrant1",
+		},
+		{
+			name: "expired, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Time{}, expired),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantExpiredEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1", "project1", "", expired),
+					),
+				),
+			},
+			grantID: "usergrant1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.ExpireUserGrant(context.Background(), tt.grantID, "org1")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommands_AddUserGrantExpiryNotification(t *testing.T) {
+	validUntil := time.Now().Add(time.Hour).UTC()
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name   string
+		fields fields
+	}{
+		{
+			name: "not restricted, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAddedTestEvent()),
+				),
+			},
+		},
+		{
+			name: "already notified, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Time{}, validUntil),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantExpiryNotificationAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1", "project1", validUntil),
+						),
+					),
+				),
+			},
+		},
+		{
+			name: "validity extended after notification, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						userGrantAddedTestEvent(),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Time{}, validUntil),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantExpiryNotificationAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1", "project1", validUntil),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantValiditySetEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								time.Time{}, validUntil.Add(time.Hour)),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantExpiryNotificationAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1", "project1", validUntil.Add(time.Hour)),
+					),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.AddUserGrantExpiryNotification(context.Background(), "usergrant1", "org1")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddHumanEmailChangeUndoCode creates a code to restore the previous email address
+// after the email of the user was changed to the provided email.
+// No code is created if the email was changed again in the meantime,
+// if the change itself restored the previous email address or if there was no previous email address.
+func (c *Commands) AddHumanEmailChangeUndoCode(ctx context.Context, orgID, userID string, email domain.EmailAddress) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kQa", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.emailChangeUndoWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kRb", "Errors.User.NotFound")
+	}
+	if wm.Email != email || wm.Undone || wm.PreviousEmail == "" || wm.Code != nil {
+		return nil
+	}
+	code, err := c.newEmailCode(ctx, c.eventstore.Filter, c.userEncryption)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanEmailChangeUndoCodeAddedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel),
+		wm.PreviousEmail,
+		wm.PreviousVerified,
+		code.Crypted,
+		code.Expiry,
+	))
+	return err
+}
+
+func (c *Commands) HumanEmailChangeUndoCodeSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kSc", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.emailChangeUndoWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kTd", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanEmailChangeUndoCodeSentEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel)))
+	return err
+}
+
+// UndoHumanEmailChange restores the email address the user had before the last change,
+// including its verification state, if the provided code is valid.
+func (c *Commands) UndoHumanEmailChange(ctx context.Context, userID, code string) (_ *domain.ObjectDetails, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kUe", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kVf", "Errors.User.Code.Empty")
+	}
+	wm, err := c.emailChangeUndoWriteModel(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kWg", "Errors.User.NotFound")
+	}
+	if wm.Code == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kXh", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel)
+	if err = crypto.VerifyCode(wm.CodeCreationDate, wm.CodeExpiry, wm.Code, code, c.userEncryption); err != nil {
+		_, pushErr := c.eventstore.Push(ctx, user.NewHumanEmailChangeUndoFailedEvent(ctx, userAgg))
+		logging.WithFields("userID", userAgg.ID).OnError(pushErr).Error("NewHumanEmailChangeUndoFailedEvent push failed")
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Ud9kYi", "Errors.User.Code.Invalid")
+	}
+	cmds := []eventstore.Command{
+		user.NewHumanEmailChangedEvent(ctx, userAgg, wm.CodeEmail),
+	}
+	if wm.CodeVerified {
+		cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, userAgg))
+	}
+	cmds = append(cmds, user.NewHumanEmailChangeUndoneEvent(ctx, userAgg))
+	if err = c.pushAppendAndReduce(ctx, wm, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) emailChangeUndoWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanEmailChangeUndoWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanEmailChangeUndoWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
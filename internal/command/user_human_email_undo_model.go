@@ -0,0 +1,112 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanEmailChangeUndoWriteModel keeps track of the last email change of a user
+// and the code to restore the previous email address.
+type HumanEmailChangeUndoWriteModel struct {
+	eventstore.WriteModel
+
+	Email           domain.EmailAddress
+	IsEmailVerified bool
+
+	// PreviousEmail and PreviousVerified are the state before the last email change.
+	PreviousEmail    domain.EmailAddress
+	PreviousVerified bool
+	// Undone is set if the last email change restored the previous email address.
+	Undone bool
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	CodeEmail        domain.EmailAddress
+	CodeVerified     bool
+
+	UserState domain.UserState
+}
+
+func NewHumanEmailChangeUndoWriteModel(userID, resourceOwner string) *HumanEmailChangeUndoWriteModel {
+	return &HumanEmailChangeUndoWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanEmailChangeUndoWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			wm.Email = e.EmailAddress
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRegisteredEvent:
+			wm.Email = e.EmailAddress
+			wm.UserState = domain.UserStateActive
+		case *user.HumanEmailChangedEvent:
+			wm.PreviousEmail = wm.Email
+			wm.PreviousVerified = wm.IsEmailVerified
+			wm.Email = e.EmailAddress
+			wm.IsEmailVerified = false
+			wm.Undone = false
+			wm.Code = nil
+		case *user.HumanEmailVerifiedEvent:
+			wm.IsEmailVerified = true
+		case *user.HumanEmailChangeUndoCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+			wm.CodeEmail = e.PreviousEmail
+			wm.CodeVerified = e.PreviousVerified
+		case *user.HumanEmailChangeUndoneEvent:
+			wm.Undone = true
+			wm.Code = nil
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+		case *user.UserReactivatedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanEmailChangeUndoWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1EmailChangedType,
+			user.HumanEmailChangedType,
+			user.UserV1EmailVerifiedType,
+			user.HumanEmailVerifiedType,
+			user.HumanEmailChangeUndoCodeAddedType,
+			user.HumanEmailChangeUndoneType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,318 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddHumanEmailChangeUndoCode(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+		email  domain.EmailAddress
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kQa", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				email:  "new@test.ch",
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kRb", "Errors.User.NotFound"),
+		},
+		{
+			"email changed again, no code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newEmailUndoHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"new@test.ch",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"newer@test.ch",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				email:  "new@test.ch",
+			},
+			nil,
+		},
+		{
+			"code already added, no code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newEmailUndoHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"new@test.ch",
+							),
+						),
+						eventFromEventPusher(
+							newEmailChangeUndoCodeAddedEvent(),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				email:  "new@test.ch",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.AddHumanEmailChangeUndoCode(tt.args.ctx, tt.args.orgID, tt.args.userID, tt.args.email)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_UndoHumanEmailChange(t *testing.T) {
+	type fields struct {
+		eventstore     func(*testing.T) *eventstore.Eventstore
+		userEncryption crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx    context.Context
+		userID string
+		code   string
+	}
+	type want struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		want   want
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:  context.Background(),
+				code: "code",
+			},
+			want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kUe", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			"missing code",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+			},
+			want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kVf", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			"code not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newEmailUndoHumanAddedEvent(),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				code:   "code",
+			},
+			want{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ud9kXh", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			"invalid code",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newEmailUndoHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"new@test.ch",
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							newEmailChangeUndoCodeAddedEvent(),
+						),
+					),
+					expectPush(
+						user.NewHumanEmailChangeUndoFailedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				code:   "wrong",
+			},
+			want{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud9kYi", "Errors.User.Code.Invalid"),
+			},
+		},
+		{
+			"undo ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newEmailUndoHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"new@test.ch",
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							newEmailChangeUndoCodeAddedEvent(),
+						),
+					),
+					expectPush(
+						user.NewHumanEmailChangedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"old@test.ch",
+						),
+						user.NewHumanEmailVerifiedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+						),
+						user.NewHumanEmailChangeUndoneEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				code:   "code",
+			},
+			want{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "userID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				userEncryption: tt.fields.userEncryption,
+			}
+			got, err := c.UndoHumanEmailChange(tt.args.ctx, tt.args.userID, tt.args.code)
+			assert.ErrorIs(t, err, tt.want.err)
+			assert.Equal(t, tt.want.details, got)
+		})
+	}
+}
+
+func newEmailUndoHumanAddedEvent() *user.HumanAddedEvent {
+	return user.NewHumanAddedEvent(context.Background(),
+		&user.NewAggregate("userID", "org1").Aggregate,
+		"username", "firstName",
+		"lastName",
+		"nickName",
+		"displayName",
+		language.English,
+		domain.GenderUnspecified,
+		"old@test.ch",
+		true,
+	)
+}
+
+func newEmailChangeUndoCodeAddedEvent() *user.HumanEmailChangeUndoCodeAddedEvent {
+	return user.NewHumanEmailChangeUndoCodeAddedEvent(context.Background(),
+		&user.NewAggregate("userID", "org1").Aggregate,
+		"old@test.ch",
+		true,
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("code"),
+		},
+		time.Hour,
+	)
+}
@@ -220,7 +220,7 @@ func checkTOTP(
 		return nil, err
 	}
 	if lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+		commands = append(commands, user.NewUserLockedByLockoutEvent(ctx, userAgg))
 	}
 	return commands, verifyErr
 }
@@ -459,7 +459,7 @@ func (c *Commands) HumanSendOTPEmail(ctx context.Context, userID, resourceOwner
 	codeAddedEvent := func(ctx context.Context, aggregate *eventstore.Aggregate, code *crypto.CryptoValue, expiry time.Duration, info *user.AuthRequestInfo, _ string) eventstore.Command {
 		return user.NewHumanOTPEmailCodeAddedEvent(ctx, aggregate, code, expiry, info)
 	}
-	generateCode := func(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
+	generateCode := func(ctx context.Context, filter preparation.FilterToQueryReducer, _ string, typ domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error) {
 		code, err := c.newEncryptedCodeWithDefault(ctx, filter, typ, alg, defaultConfig)
 		return code, "", err
 	}
@@ -525,7 +525,7 @@ func (c *Commands) sendHumanOTP(
 	secretGeneratorType domain.SecretGeneratorType,
 	defaultSecretGenerator *crypto.GeneratorConfig,
 	codeAddedEvent func(ctx context.Context, aggregate *eventstore.Aggregate, code *crypto.CryptoValue, expiry time.Duration, info *user.AuthRequestInfo, generatorID string) eventstore.Command,
-	generateCode func(ctx context.Context, filter preparation.FilterToQueryReducer, secretGeneratorType domain.SecretGeneratorType, alg crypto.EncryptionAlgorithm, defaultConfig *crypto.GeneratorConfig) (*EncryptedCode, string, error),
+	generateCode encryptedCodeGeneratorWithDefaultFunc,
 ) (err error) {
 	if userID == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-S3SF1", "Errors.User.UserIDMissing")
@@ -537,7 +537,7 @@ func (c *Commands) sendHumanOTP(
 	if !existingOTP.OTPAdded() {
 		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-SFD52", "Errors.User.MFA.OTP.NotReady")
 	}
-	code, generatorID, err := generateCode(ctx, c.eventstore.Filter, secretGeneratorType, c.userEncryption, defaultSecretGenerator) //nolint:staticcheck
+	code, generatorID, err := generateCode(ctx, c.eventstore.Filter, existingOTP.ResourceOwner(), secretGeneratorType, c.userEncryption, defaultSecretGenerator) //nolint:staticcheck
 	if err != nil {
 		return err
 	}
@@ -625,7 +625,7 @@ func checkOTP(
 	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, existingOTP.ResourceOwner(), queryReducer)
 	logging.OnError(lockoutErr).Error("unable to get lockout policy")
 	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount()+1 >= lockoutPolicy.MaxOTPAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+		commands = append(commands, user.NewUserLockedByLockoutEvent(ctx, userAgg))
 	}
 	return commands, verifyErr
 }
@@ -1606,6 +1606,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanOTPSMSCodeAddedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -2163,7 +2164,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 								},
 							},
 						),
-						user.NewUserLockedEvent(ctx,
+						user.NewUserLockedByLockoutEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
 						),
 					),
@@ -3336,7 +3337,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 								},
 							},
 						),
-						user.NewUserLockedEvent(ctx,
+						user.NewUserLockedByLockoutEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
 						),
 					),
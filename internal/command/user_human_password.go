@@ -269,7 +269,7 @@ func (c *Commands) RequestSetPassword(ctx context.Context, userID, resourceOwner
 	var passwordCode *EncryptedCode
 	var generatorID string
 	if notifyType == domain.NotificationTypeSms {
-		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
+		passwordCode, generatorID, err = c.newPhoneCode(ctx, c.eventstore.Filter, existingHuman.ResourceOwner, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption, c.defaultSecretGenerators.PasswordVerificationCode) //nolint:staticcheck
 	} else {
 		passwordCode, err = c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
 	}
@@ -399,7 +399,7 @@ func checkPassword(ctx context.Context, userID, password string, es *eventstore.
 	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, wm.ResourceOwner, es.FilterToQueryReducer)
 	logging.OnError(lockoutErr).Error("unable to get lockout policy")
 	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 && wm.PasswordCheckFailedCount+1 >= lockoutPolicy.MaxPasswordAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+		commands = append(commands, user.NewUserLockedByLockoutEvent(ctx, userAgg))
 	}
 	return commands, err
 }
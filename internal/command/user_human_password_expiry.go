@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddPasswordExpiryNotification records that the password of the user changed at the provided date reached the threshold,
+// so that the user gets notified about it.
+// Nothing is recorded if the user was already notified about the threshold of this password.
+func (c *Commands) AddPasswordExpiryNotification(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold, passwordChanged, expirationDate time.Time) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eQa", "Errors.User.UserIDMissing")
+	}
+	if threshold == domain.PasswordExpiryThresholdUnspecified {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eRb", "Errors.User.Password.ExpiryThresholdInvalid")
+	}
+	wm, err := c.passwordExpiryNotificationWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Px4eSc", "Errors.User.NotFound")
+	}
+	if wm.Notified(threshold, passwordChanged) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationAddedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel),
+		threshold,
+		passwordChanged,
+		expirationDate,
+	))
+	return err
+}
+
+// PasswordExpiryNotificationSent marks the notification about the threshold as sent to the user.
+// Nothing is recorded if no notification about the threshold is pending, e.g. if it was already marked as sent.
+func (c *Commands) PasswordExpiryNotificationSent(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eTd", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.passwordExpiryNotificationWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Px4eUe", "Errors.User.NotFound")
+	}
+	if !wm.Pending(threshold) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanPasswordExpiryNotificationSentEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), threshold))
+	return err
+}
+
+func (c *Commands) passwordExpiryNotificationWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPasswordExpiryNotificationWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanPasswordExpiryNotificationWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
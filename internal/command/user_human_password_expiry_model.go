@@ -0,0 +1,105 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanPasswordExpiryNotificationWriteModel keeps track of the thresholds of the password age policy,
+// the user was already notified about, and the notifications not yet sent.
+type HumanPasswordExpiryNotificationWriteModel struct {
+	eventstore.WriteModel
+
+	notified map[passwordExpiryNotification]struct{}
+	pending  map[domain.PasswordExpiryThreshold]struct{}
+
+	UserState domain.UserState
+}
+
+type passwordExpiryNotification struct {
+	threshold       domain.PasswordExpiryThreshold
+	passwordChanged int64
+}
+
+func newPasswordExpiryNotification(threshold domain.PasswordExpiryThreshold, passwordChanged time.Time) passwordExpiryNotification {
+	return passwordExpiryNotification{
+		threshold: threshold,
+		// the change date is read from the projection, which only stores microseconds
+		passwordChanged: passwordChanged.UnixMicro(),
+	}
+}
+
+func NewHumanPasswordExpiryNotificationWriteModel(userID, resourceOwner string) *HumanPasswordExpiryNotificationWriteModel {
+	return &HumanPasswordExpiryNotificationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		notified: make(map[passwordExpiryNotification]struct{}),
+		pending:  make(map[domain.PasswordExpiryThreshold]struct{}),
+	}
+}
+
+func (wm *HumanPasswordExpiryNotificationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanPasswordExpiryNotificationAddedEvent:
+			wm.notified[newPasswordExpiryNotification(e.Threshold, e.PasswordChanged)] = struct{}{}
+			wm.pending[e.Threshold] = struct{}{}
+		case *user.HumanPasswordExpiryNotificationSentEvent:
+			delete(wm.pending, e.Threshold)
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+		case *user.UserReactivatedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPasswordExpiryNotificationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.HumanPasswordExpiryNotificationAddedType,
+			user.HumanPasswordExpiryNotificationSentType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Notified returns if the user was already notified about the threshold of the password changed at the provided date.
+func (wm *HumanPasswordExpiryNotificationWriteModel) Notified(threshold domain.PasswordExpiryThreshold, passwordChanged time.Time) bool {
+	_, ok := wm.notified[newPasswordExpiryNotification(threshold, passwordChanged)]
+	return ok
+}
+
+// Pending returns if a notification about the threshold was added, but not yet sent.
+func (wm *HumanPasswordExpiryNotificationWriteModel) Pending(threshold domain.PasswordExpiryThreshold) bool {
+	_, ok := wm.pending[threshold]
+	return ok
+}
@@ -0,0 +1,292 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPasswordExpiryNotification(t *testing.T) {
+	passwordChanged := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expirationDate := passwordChanged.Add(30 * 24 * time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		orgID     string
+		userID    string
+		threshold domain.PasswordExpiryThreshold
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:       context.Background(),
+				threshold: domain.PasswordExpiryThresholdWarning,
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eQa", "Errors.User.UserIDMissing"),
+		},
+		{
+			"missing threshold",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eRb", "Errors.User.Password.ExpiryThresholdInvalid"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:       context.Background(),
+				orgID:     "org1",
+				userID:    "userID",
+				threshold: domain.PasswordExpiryThresholdWarning,
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Px4eSc", "Errors.User.NotFound"),
+		},
+		{
+			"already notified",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newPasswordExpiryHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordExpiryNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.PasswordExpiryThresholdWarning,
+								passwordChanged,
+								expirationDate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       context.Background(),
+				orgID:     "org1",
+				userID:    "userID",
+				threshold: domain.PasswordExpiryThresholdWarning,
+			},
+			nil,
+		},
+		{
+			"notified about other threshold, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newPasswordExpiryHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordExpiryNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.PasswordExpiryThresholdWarning,
+								passwordChanged,
+								expirationDate,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPasswordExpiryNotificationAddedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							domain.PasswordExpiryThresholdExpired,
+							passwordChanged,
+							expirationDate,
+						),
+					),
+				),
+			},
+			args{
+				ctx:       context.Background(),
+				orgID:     "org1",
+				userID:    "userID",
+				threshold: domain.PasswordExpiryThresholdExpired,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.AddPasswordExpiryNotification(tt.args.ctx, tt.args.orgID, tt.args.userID, tt.args.threshold, passwordChanged, expirationDate)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_PasswordExpiryNotificationSent(t *testing.T) {
+	passwordChanged := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Px4eTd", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Px4eUe", "Errors.User.NotFound"),
+		},
+		{
+			"no pending notification, ignored",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newPasswordExpiryHumanAddedEvent(),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"already sent, ignored",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newPasswordExpiryHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordExpiryNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.PasswordExpiryThresholdWarning,
+								passwordChanged,
+								passwordChanged.Add(30*24*time.Hour),
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordExpiryNotificationSentEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.PasswordExpiryThresholdWarning,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"sent ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newPasswordExpiryHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordExpiryNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.PasswordExpiryThresholdWarning,
+								passwordChanged,
+								passwordChanged.Add(30*24*time.Hour),
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPasswordExpiryNotificationSentEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							domain.PasswordExpiryThresholdWarning,
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.PasswordExpiryNotificationSent(tt.args.ctx, tt.args.orgID, tt.args.userID, domain.PasswordExpiryThresholdWarning)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func newPasswordExpiryHumanAddedEvent() *user.HumanAddedEvent {
+	return user.NewHumanAddedEvent(context.Background(),
+		&user.NewAggregate("userID", "org1").Aggregate,
+		"username", "firstName",
+		"lastName",
+		"nickName",
+		"displayName",
+		language.English,
+		domain.GenderUnspecified,
+		"email@test.ch",
+		false,
+	)
+}
@@ -1685,6 +1685,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1725,6 +1727,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1766,6 +1770,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1827,6 +1833,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1884,6 +1892,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -1973,6 +1983,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -2020,7 +2032,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								UserAgentID: "agent1",
 							},
 						),
-						user.NewUserLockedEvent(context.Background(),
+						user.NewUserLockedByLockoutEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
 						),
 					),
@@ -2066,6 +2078,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -2146,6 +2160,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -2231,6 +2247,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -2310,6 +2328,8 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								0,
+								domain.RiskThresholds{},
 							),
 						),
 					),
@@ -9,7 +9,9 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -43,7 +45,7 @@ func (c *Commands) ChangeHumanPhone(ctx context.Context, phone *domain.Phone, re
 	if phone.IsPhoneVerified {
 		events = append(events, user.NewHumanPhoneVerifiedEvent(ctx, userAgg))
 	} else {
-		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+		phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, userAgg.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		if err != nil {
 			return nil, err
 		}
@@ -132,18 +134,56 @@ func (c *Commands) phoneCodeVerifierFromConfig(ctx context.Context, id string) (
 			VerifyServiceSID: config.Twilio.VerifyServiceSID,
 		}, nil
 	}
+	if config.Vonage != nil {
+		if config.Vonage.VerifyBrand == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vn8hXa", "Errors.SMSConfig.NotExternalVerification")
+		}
+		apiSecret, err := crypto.DecryptString(config.Vonage.APISecret, c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+		return &vonage.Config{
+			APIKey:       config.Vonage.APIKey,
+			APISecret:    apiSecret,
+			SenderNumber: config.Vonage.SenderNumber,
+			VerifyBrand:  config.Vonage.VerifyBrand,
+		}, nil
+	}
+	if config.MessageBird != nil {
+		if !config.MessageBird.Verify {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mb9iYa", "Errors.SMSConfig.NotExternalVerification")
+		}
+		accessKey, err := crypto.DecryptString(config.MessageBird.AccessKey, c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+		return &messagebird.Config{
+			AccessKey:  accessKey,
+			Originator: config.MessageBird.Originator,
+			Verify:     config.MessageBird.Verify,
+		}, nil
+	}
 	return nil, nil
 }
 
-func (c *Commands) activeSMSProvider(ctx context.Context) (string, error) {
-	config, err := c.getActiveSMSConfig(ctx, authz.GetInstance(ctx).InstanceID())
+// activeSMSProvider returns the id of the active provider of the instance if it verifies the codes itself.
+// An active provider of the organization is used instead, which requires the code to be generated.
+func (c *Commands) activeSMSProvider(ctx context.Context, resourceOwner string) (string, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	config, err := c.getActiveSMSConfig(ctx, instanceID)
 	if err != nil {
 		return "", err
 	}
-	if config.State == domain.SMSConfigStateActive && config.Twilio != nil && config.Twilio.VerifyServiceSID != "" {
-		return config.ID, nil
+	if config.State != domain.SMSConfigStateActive || !config.hasExternalVerification() {
+		return "", nil
+	}
+	if resourceOwner != "" && resourceOwner != instanceID {
+		orgActive, err := c.hasActiveOrgSMSConfig(ctx, resourceOwner)
+		if err != nil || orgActive {
+			return "", err
+		}
 	}
-	return "", err
+	return config.ID, nil
 }
 
 func (c *Commands) CreateHumanPhoneVerificationCode(ctx context.Context, userID, resourceowner string) (*domain.ObjectDetails, error) {
@@ -165,7 +205,7 @@ func (c *Commands) CreateHumanPhoneVerificationCode(ctx context.Context, userID,
 	if existingPhone.IsPhoneVerified {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-2M9sf", "Errors.User.Phone.AlreadyVerified")
 	}
-	phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+	phoneCode, generatorID, err := c.newPhoneCode(ctx, c.eventstore.Filter, existingPhone.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 	if err != nil {
 		return nil, err
 	}
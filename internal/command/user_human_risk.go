@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// EvaluateLoginRisk assesses the first factor of a sign-in on the login UI for anomalies,
+// the same way as the first authentication check of a session.
+// The assessment is stored on the user, so it serves as history for later sign-ins of sessions and the login UI.
+// If the assessment results in a block, an error is returned after the assessment is stored.
+func (c *Commands) EvaluateLoginRisk(ctx context.Context, userID, resourceOwner, ip, deviceID string) (_ domain.RiskAction, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if c.riskEngine == nil {
+		return domain.RiskActionUnspecified, nil
+	}
+	if userID == "" {
+		return domain.RiskActionUnspecified, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk7bPu", "Errors.IDMissing")
+	}
+	attempt := &risk.Attempt{
+		IP:       net.ParseIP(ip),
+		DeviceID: deviceID,
+		Time:     time.Now(),
+	}
+	assessment, err := assessRisk(ctx, c.eventstore, c.riskEngine, c.getOrgLoginPolicy, userID, resourceOwner, ip, attempt)
+	if err != nil {
+		return domain.RiskActionUnspecified, err
+	}
+	var (
+		country, asn        string
+		latitude, longitude float64
+	)
+	if assessment.Location != nil {
+		country = assessment.Location.Country
+		asn = assessment.Location.ASN
+		latitude = assessment.Location.Latitude
+		longitude = assessment.Location.Longitude
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanRiskEvaluatedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate,
+		ip,
+		deviceID,
+		country,
+		asn,
+		latitude,
+		longitude,
+		assessment.Score,
+		assessment.Signals,
+		assessment.Action,
+	))
+	if err != nil {
+		return domain.RiskActionUnspecified, err
+	}
+	if assessment.Action == domain.RiskActionBlock {
+		return assessment.Action, zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bQv", "Errors.Session.Risk.Blocked")
+	}
+	return assessment.Action, nil
+}
+
+// LoginRiskCheckFailed records a failed check of the user on the login UI,
+// so the IPFailures signal can be detected on later sign-ins.
+func (c *Commands) LoginRiskCheckFailed(ctx context.Context, userID, resourceOwner, ip string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if c.riskEngine == nil || userID == "" || ip == "" {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanRiskCheckFailedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate, ip))
+	return err
+}
@@ -0,0 +1,233 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_EvaluateLoginRisk(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	otherUserAgg := &user.NewAggregate("user2", "org1").Aggregate
+	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	engine, err := risk.NewEngine(risk.Config{
+		Enabled:         true,
+		FailureAccounts: 2,
+		Weights: risk.Weights{
+			NewDevice:  20,
+			IPFailures: 80,
+		},
+	})
+	require.NoError(t, err)
+	loginPolicy := func() eventstore.Command {
+		return org.NewLoginPolicyAddedEvent(ctx, &org.NewAggregate("org1").Aggregate,
+			true, false, false, false, false, false, false, false, false, false,
+			domain.PasswordlessTypeNotAllowed, "",
+			time.Hour, time.Hour, time.Hour, time.Hour, time.Hour, 0,
+			domain.RiskThresholds{Notify: 20, RequireMFA: 40, Block: 80},
+		)
+	}
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		riskEngine *risk.Engine
+	}
+	type args struct {
+		userID   string
+		ip       string
+		deviceID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    domain.RiskAction
+		wantErr error
+	}{
+		{
+			name: "disabled",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:   "user1",
+				ip:       "192.0.2.1",
+				deviceID: "device1",
+			},
+			want: domain.RiskActionUnspecified,
+		},
+		{
+			name: "missing user, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				riskEngine: engine,
+			},
+			args: args{
+				ip:       "192.0.2.1",
+				deviceID: "device1",
+			},
+			want:    domain.RiskActionUnspecified,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk7bPu", "Errors.IDMissing"),
+		},
+		{
+			name: "first sign-in, allow",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(loginPolicy()),
+					),
+					expectPush(
+						user.NewHumanRiskEvaluatedEvent(ctx, userAgg, "192.0.2.1", "device1", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+					),
+				),
+				riskEngine: engine,
+			},
+			args: args{
+				userID:   "user1",
+				ip:       "192.0.2.1",
+				deviceID: "device1",
+			},
+			want: domain.RiskActionAllow,
+		},
+		{
+			name: "new device of sessions and login, notify",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewRiskEvaluatedEvent(ctx, sessAgg, "user1", "192.0.2.1", "device2", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+						),
+						eventFromEventPusher(
+							user.NewHumanRiskEvaluatedEvent(ctx, userAgg, "192.0.2.1", "device3", "", "", 0, 0, 0, nil, domain.RiskActionAllow),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(loginPolicy()),
+					),
+					expectPush(
+						user.NewHumanRiskEvaluatedEvent(ctx, userAgg, "192.0.2.1", "device1", "", "", 0, 0,
+							20, []domain.RiskSignal{domain.RiskSignalNewDevice}, domain.RiskActionNotify),
+					),
+				),
+				riskEngine: engine,
+			},
+			args: args{
+				userID:   "user1",
+				ip:       "192.0.2.1",
+				deviceID: "device1",
+			},
+			want: domain.RiskActionNotify,
+		},
+		{
+			name: "failures from ip, block",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRiskCheckFailedEvent(ctx, otherUserAgg, "192.0.2.1"),
+						),
+						eventFromEventPusher(
+							session.NewRiskCheckFailedEvent(ctx, sessAgg, "user3", "192.0.2.1"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(loginPolicy()),
+					),
+					expectPush(
+						user.NewHumanRiskEvaluatedEvent(ctx, userAgg, "192.0.2.1", "device1", "", "", 0, 0,
+							80, []domain.RiskSignal{domain.RiskSignalIPFailures}, domain.RiskActionBlock),
+					),
+				),
+				riskEngine: engine,
+			},
+			args: args{
+				userID:   "user1",
+				ip:       "192.0.2.1",
+				deviceID: "device1",
+			},
+			want:    domain.RiskActionBlock,
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-Rk7bQv", "Errors.Session.Risk.Blocked"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				riskEngine: tt.fields.riskEngine,
+			}
+			got, err := c.EvaluateLoginRisk(ctx, tt.args.userID, "org1", tt.args.ip, tt.args.deviceID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_LoginRiskCheckFailed(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	engine, err := risk.NewEngine(risk.Config{Enabled: true})
+	require.NoError(t, err)
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		riskEngine *risk.Engine
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		ip     string
+	}{
+		{
+			name: "disabled",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			ip: "192.0.2.1",
+		},
+		{
+			name: "no ip",
+			fields: fields{
+				eventstore: expectEventstore(),
+				riskEngine: engine,
+			},
+		},
+		{
+			name: "failed check",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						user.NewHumanRiskCheckFailedEvent(ctx, &user.NewAggregate("user1", "org1").Aggregate, "192.0.2.1"),
+					),
+				),
+				riskEngine: engine,
+			},
+			ip: "192.0.2.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				riskEngine: tt.fields.riskEngine,
+			}
+			err := c.LoginRiskCheckFailed(ctx, "user1", "org1", tt.ip)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SecurityNotificationSent marks the security notification of the provided message type as sent to the user.
+func (c *Commands) SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn4tQa", "Errors.User.UserIDMissing")
+	}
+	if !domain.IsMessageTextType(messageType) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn4tRb", "Errors.CustomMessageText.Invalid")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sn4tSc", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanSecurityNotificationSentEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &existingUser.WriteModel), messageType))
+	return err
+}
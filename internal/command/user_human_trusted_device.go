@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TrustDevice remembers the device of the user, so the multi-factor verification can be skipped
+// on the device for the trusted device lifetime of the login policy.
+// Trusting an already trusted device renews the trust.
+func (c *Commands) TrustDevice(ctx context.Context, userID, resourceOwner string, device *domain.TrustedDevice) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || device == nil || device.DeviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq3dVw", "Errors.IDMissing")
+	}
+	writeModel, err := c.getHumanTrustedDeviceWriteModel(ctx, userID, resourceOwner, device.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tq3dWx", "Errors.User.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanTrustedDeviceAddedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), device.DeviceID, device.Fingerprint, device.IP),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// TrustedDeviceSeen records the usage of a trusted device to skip the multi-factor verification.
+// The usage is only recorded once since the provided time, e.g. the creation of the auth request.
+func (c *Commands) TrustedDeviceSeen(ctx context.Context, userID, resourceOwner, deviceID, ip string, since time.Time) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq3dXy", "Errors.IDMissing")
+	}
+	writeModel, err := c.getHumanTrustedDeviceWriteModel(ctx, userID, resourceOwner, deviceID)
+	if err != nil {
+		return err
+	}
+	if !writeModel.Trusted {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Tq3dYz", "Errors.User.TrustedDevice.NotFound")
+	}
+	if !writeModel.LastSeen.Before(since) {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanTrustedDeviceSeenEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), deviceID, ip),
+	)
+}
+
+// RemoveTrustedDevice revokes the trust of the device,
+// the user will have to verify the multi-factor on the next login on the device.
+func (c *Commands) RemoveTrustedDevice(ctx context.Context, userID, resourceOwner, deviceID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq3dZa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getHumanTrustedDeviceWriteModel(ctx, userID, resourceOwner, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Trusted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tq3dAb", "Errors.User.TrustedDevice.NotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanTrustedDeviceRemovedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), deviceID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getHumanTrustedDeviceWriteModel(ctx context.Context, userID, resourceOwner, deviceID string) (*HumanTrustedDeviceWriteModel, error) {
+	writeModel := NewHumanTrustedDeviceWriteModel(userID, resourceOwner, deviceID)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
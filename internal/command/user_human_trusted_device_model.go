@@ -0,0 +1,94 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanTrustedDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	DeviceID  string
+	UserState domain.UserState
+	Trusted   bool
+	TrustedAt time.Time
+	LastSeen  time.Time
+}
+
+func NewHumanTrustedDeviceWriteModel(userID, resourceOwner, deviceID string) *HumanTrustedDeviceWriteModel {
+	return &HumanTrustedDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		DeviceID: deviceID,
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			if wm.DeviceID != e.DeviceID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanTrustedDeviceSeenEvent:
+			if wm.DeviceID != e.DeviceID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanTrustedDeviceRemovedEvent:
+			if wm.DeviceID != e.DeviceID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		default:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Trusted = false
+		case *user.HumanTrustedDeviceAddedEvent:
+			wm.Trusted = true
+			wm.TrustedAt = e.CreationDate()
+		case *user.HumanTrustedDeviceSeenEvent:
+			wm.LastSeen = e.CreationDate()
+		case *user.HumanTrustedDeviceRemovedEvent:
+			wm.Trusted = false
+			wm.TrustedAt = time.Time{}
+			wm.LastSeen = time.Time{}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserRemovedType,
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceSeenType,
+			user.HumanTrustedDeviceRemovedType,
+		).
+		Builder()
+}
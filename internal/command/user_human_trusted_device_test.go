@@ -0,0 +1,393 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_TrustDevice(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		userID string
+		orgID  string
+		device *domain.TrustedDevice
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing device, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				orgID:  "orgID",
+				device: &domain.TrustedDevice{},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"user not existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				orgID:  "orgID",
+				device: &domain.TrustedDevice{DeviceID: "deviceID"},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"trust, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+							&user.NewAggregate("userID", "orgID").Aggregate,
+							"deviceID",
+							"Firefox, Linux",
+							"127.0.0.1",
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				orgID:  "orgID",
+				device: &domain.TrustedDevice{
+					DeviceID:    "deviceID",
+					Fingerprint: "Firefox, Linux",
+					IP:          "127.0.0.1",
+				},
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "orgID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.TrustDevice(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.device)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_TrustedDeviceSeen(t *testing.T) {
+	since := time.Now().Add(-time.Minute)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		userID   string
+		orgID    string
+		deviceID string
+		since    time.Time
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing device, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+				orgID:  "orgID",
+				since:  since,
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"device not trusted, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      context.Background(),
+				userID:   "userID",
+				orgID:    "orgID",
+				deviceID: "deviceID",
+				since:    since,
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"already seen since, no event",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"Firefox, Linux",
+								"127.0.0.1",
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanTrustedDeviceSeenEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"127.0.0.1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      context.Background(),
+				userID:   "userID",
+				orgID:    "orgID",
+				deviceID: "deviceID",
+				since:    since,
+			},
+			res{},
+		},
+		{
+			"seen, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"Firefox, Linux",
+								"127.0.0.1",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceSeenEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"127.0.0.1",
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceSeenEvent(context.Background(),
+							&user.NewAggregate("userID", "orgID").Aggregate,
+							"deviceID",
+							"127.0.0.1",
+						),
+					),
+				),
+			},
+			args{
+				ctx:      context.Background(),
+				userID:   "userID",
+				orgID:    "orgID",
+				deviceID: "deviceID",
+				since:    since,
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.TrustedDeviceSeen(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.deviceID, "127.0.0.1", tt.args.since)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveTrustedDevice(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		userID   string
+		orgID    string
+		deviceID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing param, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"device not trusted, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceRemovedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      context.Background(),
+				userID:   "userID",
+				orgID:    "orgID",
+				deviceID: "deviceID",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "orgID").Aggregate,
+								"deviceID",
+								"",
+								"",
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceRemovedEvent(context.Background(),
+							&user.NewAggregate("userID", "orgID").Aggregate,
+							"deviceID",
+						),
+					),
+				),
+			},
+			args{
+				ctx:      context.Background(),
+				userID:   "userID",
+				orgID:    "orgID",
+				deviceID: "deviceID",
+			},
+			res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "orgID",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.RemoveTrustedDevice(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.deviceID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
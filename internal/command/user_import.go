@@ -0,0 +1,480 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const userImportDefaultBatchSize = 100
+
+// AddUserImportFile stores the file of a user import, which was uploaded through the assets API.
+// The id in the object name of the file is passed to [Commands.AddUserImportJob] and becomes the id of the job.
+func (c *Commands) AddUserImportFile(ctx context.Context, orgID string, upload *AssetUpload) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1iIi", "Errors.ResourceOwnerMissing")
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return err
+	}
+	if _, err = c.uploadAsset(ctx, upload); err != nil {
+		return zerrors.ThrowInternal(err, "COMMAND-Ui1eEe", "Errors.Assets.Object.PutFailed")
+	}
+	return nil
+}
+
+// AddUserImportJob queues the import of the users of the previously uploaded file into the organization.
+// The file is referenced by its id and processed asynchronously by [Commands.ProcessUserImportJob].
+// If dryRun is set, the rows are only validated and no user is changed.
+func (c *Commands) AddUserImportJob(ctx context.Context, orgID string, format domain.UserImportFormat, dryRun bool, fileID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1aAa", "Errors.ResourceOwnerMissing")
+	}
+	if !format.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1bBb", "Errors.UserImport.FormatInvalid")
+	}
+	if fileID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1jJj", "Errors.IDMissing")
+	}
+	writeModel, err := c.userImportJobWriteModelByID(ctx, orgID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.UserImportJobStateUnspecified {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ui1kKk", "Errors.UserImport.AlreadyExists")
+	}
+	// the file is only parsed while it is processed, only its existence is checked upfront
+	info, err := c.static.GetObjectInfo(ctx, authz.GetInstance(ctx).InstanceID(), orgID, writeModel.AssetPath())
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Ui1dDd", "Errors.Assets.Object.GetFailed")
+	}
+	if info.Size == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1cCc", "Errors.UserImport.Empty")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, userimport.NewAddedEvent(
+		ctx,
+		userimport.NewAggregate(fileID, orgID, authz.GetInstance(ctx).InstanceID()),
+		format,
+		dryRun,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CancelUserImportJob stops the processing of the job after the current batch.
+// Users, which were already imported, are kept.
+func (c *Commands) CancelUserImportJob(ctx context.Context, resourceOwner, jobID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingUserImportJobWriteModel(ctx, resourceOwner, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Done() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ui1fFf", "Errors.UserImport.AlreadyDone")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, userimport.NewCanceledEvent(ctx, UserImportJobAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	c.removeUserImportFile(ctx, writeModel)
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ProcessUserImportJob parses the file of the job and imports its rows in batches of batchSize rows.
+// The progress is stored with the byte offset of the next row after each batch,
+// so that an interrupted job continues with the next unprocessed row.
+// The rows are imported on behalf of the user, who added the job.
+func (c *Commands) ProcessUserImportJob(ctx context.Context, resourceOwner, jobID string, batchSize uint16) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingUserImportJobWriteModel(ctx, resourceOwner, jobID)
+	if err != nil {
+		return err
+	}
+	if writeModel.State.Done() {
+		return nil
+	}
+	if batchSize == 0 {
+		batchSize = userImportDefaultBatchSize
+	}
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: writeModel.CreatorID, OrgID: writeModel.ResourceOwner, ResourceOwner: writeModel.ResourceOwner})
+	if writeModel.State == domain.UserImportJobStateQueued {
+		err = c.pushAppendAndReduce(ctx, writeModel, userimport.NewStartedEvent(ctx, UserImportJobAggregateFromWriteModel(&writeModel.WriteModel)))
+		// the job was started by another worker in the meantime
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	data, _, err := c.static.GetObject(ctx, authz.GetInstance(ctx).InstanceID(), writeModel.ResourceOwner, writeModel.AssetPath())
+	if err != nil {
+		logging.WithFields("jobID", jobID).OnError(err).Warn("could not get file of user import")
+		return c.failUserImportJob(ctx, writeModel, "Errors.Assets.Object.GetFailed")
+	}
+	rows, err := newUserImportReader(writeModel.Format, data, writeModel.ProcessedOffset, writeModel.ProcessedRows)
+	if err != nil {
+		return c.failUserImportJob(ctx, writeModel, userImportErrorMessage(err))
+	}
+
+	for {
+		next := writeModel.ProcessedRows
+		batch, err := readUserImportBatch(rows, batchSize)
+		if err != nil {
+			return c.failUserImportJob(ctx, writeModel, userImportErrorMessage(err))
+		}
+		if len(batch) == 0 {
+			break
+		}
+		// the job is loaded again before each batch, so that a cancellation stops the processing
+		writeModel, err = c.existingUserImportJobWriteModel(ctx, resourceOwner, jobID)
+		if err != nil {
+			return err
+		}
+		if writeModel.State != domain.UserImportJobStateRunning || writeModel.ProcessedRows != next {
+			return nil
+		}
+		failed := writeModel.FailedRows
+		rowErrors := make([]*userimport.RowError, 0)
+		for _, row := range batch {
+			userID, err := c.importUserRow(ctx, writeModel.ResourceOwner, row, writeModel.DryRun)
+			if err != nil {
+				failed++
+				rowErrors = append(rowErrors, &userimport.RowError{
+					Row:     row.Number,
+					UserID:  userID,
+					Message: userImportErrorMessage(err),
+				})
+			}
+		}
+		err = c.pushAppendAndReduce(ctx, writeModel, userimport.NewRowsProcessedEvent(
+			ctx,
+			UserImportJobAggregateFromWriteModel(&writeModel.WriteModel),
+			next+uint64(len(batch)),
+			rows.Offset(),
+			failed,
+			rowErrors,
+		))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, userimport.NewCompletedEvent(
+		ctx,
+		UserImportJobAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.ProcessedRows,
+	))
+	if err != nil {
+		return err
+	}
+	c.removeUserImportFile(ctx, writeModel)
+	return nil
+}
+
+// readUserImportBatch reads up to batchSize rows.
+// An empty batch is returned if all rows were read.
+func readUserImportBatch(rows userImportReader, batchSize uint16) ([]*userImportParsedRow, error) {
+	batch := make([]*userImportParsedRow, 0, batchSize)
+	for len(batch) < int(batchSize) {
+		row, err := rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, row)
+	}
+	return batch, nil
+}
+
+func (c *Commands) failUserImportJob(ctx context.Context, writeModel *UserImportJobWriteModel, reason string) error {
+	err := c.pushAppendAndReduce(ctx, writeModel, userimport.NewFailedEvent(ctx, UserImportJobAggregateFromWriteModel(&writeModel.WriteModel), reason))
+	if err != nil {
+		return err
+	}
+	c.removeUserImportFile(ctx, writeModel)
+	return nil
+}
+
+func (c *Commands) removeUserImportFile(ctx context.Context, writeModel *UserImportJobWriteModel) {
+	logging.OnError(c.removeAsset(ctx, writeModel.ResourceOwner, writeModel.AssetPath())).
+		WithField("jobID", writeModel.AggregateID).Warn("could not remove file of user import")
+}
+
+// importUserRow validates the row and executes its operation, unless dryRun is set.
+// The returned user id is empty if the user could not be created.
+func (c *Commands) importUserRow(ctx context.Context, resourceOwner string, parsed *userImportParsedRow, dryRun bool) (string, error) {
+	if parsed.Err != nil {
+		return "", parsed.Err
+	}
+	row := parsed.Row
+	if row.Operation == "" {
+		row.Operation = domain.UserImportOperationCreate
+	}
+	if row.Type == "" {
+		row.Type = UserImportUserTypeHuman
+	}
+	if !row.Operation.Valid() {
+		return row.UserID, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3aAa", "Errors.UserImport.Row.OperationInvalid")
+	}
+	if row.Type != UserImportUserTypeHuman && row.Type != UserImportUserTypeMachine {
+		return row.UserID, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3bBb", "Errors.UserImport.Row.TypeInvalid")
+	}
+	if row.Operation != domain.UserImportOperationCreate {
+		if row.UserID == "" {
+			return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3cCc", "Errors.User.UserIDMissing")
+		}
+		if len(row.Grants) > 0 {
+			return row.UserID, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3dDd", "Errors.UserImport.Row.GrantsOnlyOnCreate")
+		}
+	}
+	metadata := userImportMetadata(row.Metadata)
+	for _, entry := range metadata {
+		if err := (&AddMetadataEntry{Key: entry.Key, Value: entry.Value}).Valid(); err != nil {
+			return row.UserID, err
+		}
+	}
+
+	switch {
+	case row.Operation == domain.UserImportOperationDeactivate:
+		if dryRun {
+			return row.UserID, c.checkUserExists(ctx, row.UserID, resourceOwner)
+		}
+		_, err := c.DeactivateUser(ctx, row.UserID, resourceOwner)
+		return row.UserID, err
+	case row.Type == UserImportUserTypeMachine:
+		return c.importMachineRow(ctx, resourceOwner, row, metadata, dryRun)
+	case row.Operation == domain.UserImportOperationCreate:
+		return c.importHumanCreateRow(ctx, resourceOwner, row, metadata, dryRun)
+	default:
+		return row.UserID, c.importHumanUpdateRow(ctx, resourceOwner, row, metadata, dryRun)
+	}
+}
+
+func (c *Commands) importHumanCreateRow(ctx context.Context, resourceOwner string, row *UserImportRow, metadata []*domain.Metadata, dryRun bool) (string, error) {
+	human := &AddHuman{
+		ID:                     row.UserID,
+		Username:               row.Username,
+		FirstName:              row.FirstName,
+		LastName:               row.LastName,
+		NickName:               row.NickName,
+		DisplayName:            row.DisplayName,
+		PreferredLanguage:      language.Make(row.PreferredLanguage),
+		Email:                  Email{Address: domain.EmailAddress(row.Email), Verified: row.EmailVerified},
+		Phone:                  Phone{Number: domain.PhoneNumber(row.Phone), Verified: row.PhoneVerified},
+		EncodedPasswordHash:    row.PasswordHash,
+		PasswordChangeRequired: row.PasswordChangeRequired,
+		Metadata:               make([]*AddMetadataEntry, len(metadata)),
+	}
+	for i, entry := range metadata {
+		human.Metadata[i] = &AddMetadataEntry{Key: entry.Key, Value: entry.Value}
+	}
+	if err := human.Validate(c.userPasswordHasher); err != nil {
+		return row.UserID, err
+	}
+	if dryRun {
+		return row.UserID, nil
+	}
+	if err := c.AddUserHuman(ctx, resourceOwner, human, false, c.userEncryption); err != nil {
+		return "", err
+	}
+	return human.ID, c.importUserGrants(ctx, resourceOwner, human.ID, row.Grants)
+}
+
+func (c *Commands) importHumanUpdateRow(ctx context.Context, resourceOwner string, row *UserImportRow, metadata []*domain.Metadata, dryRun bool) error {
+	human := &ChangeHuman{ID: row.UserID}
+	if row.Username != "" {
+		human.Username = &row.Username
+	}
+	if row.FirstName != "" || row.LastName != "" || row.NickName != "" || row.DisplayName != "" || row.PreferredLanguage != "" {
+		human.Profile = &Profile{
+			FirstName:   userImportOptionalString(row.FirstName),
+			LastName:    userImportOptionalString(row.LastName),
+			NickName:    userImportOptionalString(row.NickName),
+			DisplayName: userImportOptionalString(row.DisplayName),
+		}
+		if row.PreferredLanguage != "" {
+			preferredLanguage := language.Make(row.PreferredLanguage)
+			human.Profile.PreferredLanguage = &preferredLanguage
+		}
+	}
+	if row.Email != "" {
+		human.Email = &Email{Address: domain.EmailAddress(row.Email), Verified: row.EmailVerified}
+	}
+	if row.Phone != "" {
+		human.Phone = &Phone{Number: domain.PhoneNumber(row.Phone), Verified: row.PhoneVerified}
+	}
+	if row.PasswordHash != "" {
+		human.Password = &Password{EncodedPasswordHash: row.PasswordHash, ChangeRequired: row.PasswordChangeRequired}
+	}
+	if err := human.Validate(c.userPasswordHasher); err != nil {
+		return err
+	}
+	// the user is checked in the organization of the job, as ChangeUserHuman only checks the id
+	if err := c.checkUserExists(ctx, row.UserID, resourceOwner); err != nil || dryRun {
+		return err
+	}
+	if human.Changed() {
+		if err := c.ChangeUserHuman(ctx, human, c.userEncryption); err != nil {
+			return err
+		}
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	_, err := c.BulkSetUserMetadata(ctx, row.UserID, resourceOwner, metadata...)
+	return err
+}
+
+func (c *Commands) importMachineRow(ctx context.Context, resourceOwner string, row *UserImportRow, metadata []*domain.Metadata, dryRun bool) (_ string, err error) {
+	if row.PasswordHash != "" {
+		return row.UserID, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3eEe", "Errors.UserImport.Row.PasswordOnlyHuman")
+	}
+	machine := &Machine{
+		ObjectRoot:  models.ObjectRoot{AggregateID: row.UserID, ResourceOwner: resourceOwner},
+		Username:    row.Username,
+		Name:        row.Name,
+		Description: row.Description,
+	}
+	if row.Operation == domain.UserImportOperationCreate {
+		if machine.Username == "" || machine.Name == "" {
+			return row.UserID, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui3fFf", "Errors.User.Invalid")
+		}
+		if dryRun {
+			return row.UserID, nil
+		}
+		if _, err = c.AddMachine(ctx, machine); err != nil {
+			return "", err
+		}
+	} else {
+		existing, err := getMachineWriteModel(ctx, row.UserID, resourceOwner, c.eventstore.Filter) //nolint:staticcheck
+		if err != nil {
+			return row.UserID, err
+		}
+		if !isUserStateExists(existing.UserState) {
+			return row.UserID, zerrors.ThrowNotFound(nil, "COMMAND-Ui3gGg", "Errors.User.NotFound")
+		}
+		if dryRun {
+			return row.UserID, nil
+		}
+		// empty values keep the current ones
+		if machine.Name == "" {
+			machine.Name = existing.Name
+		}
+		if machine.Description == "" {
+			machine.Description = existing.Description
+		}
+		machine.AccessTokenType = existing.AccessTokenType
+		if machine.Name != existing.Name || machine.Description != existing.Description {
+			if _, err = c.ChangeMachine(ctx, machine); err != nil {
+				return row.UserID, err
+			}
+		}
+	}
+	if len(metadata) > 0 {
+		if _, err = c.BulkSetUserMetadata(ctx, machine.AggregateID, resourceOwner, metadata...); err != nil {
+			return machine.AggregateID, err
+		}
+	}
+	if row.Operation != domain.UserImportOperationCreate {
+		return machine.AggregateID, nil
+	}
+	return machine.AggregateID, c.importUserGrants(ctx, resourceOwner, machine.AggregateID, row.Grants)
+}
+
+func (c *Commands) importUserGrants(ctx context.Context, resourceOwner, userID string, grants []*UserImportGrant) error {
+	for _, grant := range grants {
+		_, err := c.AddUserGrant(ctx, &domain.UserGrant{
+			UserID:         userID,
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+			RoleKeys:       grant.RoleKeys,
+		}, resourceOwner)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Commands) existingUserImportJobWriteModel(ctx context.Context, resourceOwner, jobID string) (*UserImportJobWriteModel, error) {
+	if jobID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1gGg", "Errors.IDMissing")
+	}
+	writeModel, err := c.userImportJobWriteModelByID(ctx, resourceOwner, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.UserImportJobStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ui1hHh", "Errors.UserImport.NotFound")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) userImportJobWriteModelByID(ctx context.Context, resourceOwner, jobID string) (_ *UserImportJobWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewUserImportJobWriteModel(jobID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// userImportMetadata returns the metadata sorted by key, so that the events are pushed in a stable order.
+func userImportMetadata(metadata map[string]string) []*domain.Metadata {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	entries := make([]*domain.Metadata, len(keys))
+	for i, key := range keys {
+		entries[i] = &domain.Metadata{Key: key, Value: []byte(metadata[key])}
+	}
+	return entries
+}
+
+func userImportOptionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// userImportErrorMessage returns the translation key of the error, which is reported for the row.
+// Errors without key, e.g. from the storage, are not exposed.
+func userImportErrorMessage(err error) string {
+	zitadelErr := new(zerrors.ZitadelError)
+	if errors.As(err, &zitadelErr) {
+		return zitadelErr.GetMessage()
+	}
+	return "Errors.Internal"
+}
@@ -0,0 +1,81 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+type UserImportJobWriteModel struct {
+	eventstore.WriteModel
+
+	Format        domain.UserImportFormat
+	DryRun        bool
+	ProcessedRows uint64
+	// ProcessedOffset is the byte offset of the next unprocessed row in the file.
+	ProcessedOffset int64
+	FailedRows      uint64
+	// CreatorID is the user, which added the job.
+	// The rows are imported on their behalf.
+	CreatorID string
+	State     domain.UserImportJobState
+}
+
+func NewUserImportJobWriteModel(id, resourceOwner string) *UserImportJobWriteModel {
+	return &UserImportJobWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *UserImportJobWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *userimport.AddedEvent:
+			wm.Format = e.Format
+			wm.DryRun = e.DryRun
+			wm.CreatorID = e.Creator()
+			wm.State = domain.UserImportJobStateQueued
+		case *userimport.StartedEvent:
+			wm.State = domain.UserImportJobStateRunning
+		case *userimport.RowsProcessedEvent:
+			wm.ProcessedRows = e.ProcessedRows
+			wm.ProcessedOffset = e.Offset
+			wm.FailedRows = e.FailedRows
+		case *userimport.CompletedEvent:
+			wm.State = domain.UserImportJobStateCompleted
+		case *userimport.CanceledEvent:
+			wm.State = domain.UserImportJobStateCanceled
+		case *userimport.FailedEvent:
+			wm.State = domain.UserImportJobStateFailed
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserImportJobWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(userimport.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			userimport.AddedEventType,
+			userimport.StartedEventType,
+			userimport.RowsProcessedEventType,
+			userimport.CompletedEventType,
+			userimport.CanceledEventType,
+			userimport.FailedEventType,
+		).
+		Builder()
+}
+
+func (wm *UserImportJobWriteModel) AssetPath() string {
+	return domain.GetUserImportAssetPath(wm.AggregateID)
+}
+
+func UserImportJobAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModel(wm, userimport.AggregateType, userimport.AggregateVersion)
+}
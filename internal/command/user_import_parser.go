@@ -0,0 +1,302 @@
+package command
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserImportUserTypeHuman   = "human"
+	UserImportUserTypeMachine = "machine"
+
+	userImportMetadataColumnPrefix = "metadata."
+)
+
+// UserImportRow is a single row of a bulk import of users.
+// In NDJSON files each line is a JSON object with the keys of the json tags.
+// In CSV files the header row contains the same names as columns,
+// the metadata is set with one `metadata.<key>` column per key
+// and the grants column has the format `<project_id>:<role>|<role>;<project_id>:<role>`.
+type UserImportRow struct {
+	// Operation defaults to create if empty.
+	Operation domain.UserImportOperation `json:"operation"`
+	// Type is either human or machine and defaults to human if empty.
+	Type string `json:"type"`
+	// UserID is required for updates and deactivations and optional for creations.
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+
+	FirstName         string `json:"first_name"`
+	LastName          string `json:"last_name"`
+	NickName          string `json:"nick_name"`
+	DisplayName       string `json:"display_name"`
+	PreferredLanguage string `json:"preferred_language"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Phone             string `json:"phone"`
+	PhoneVerified     bool   `json:"phone_verified"`
+	// PasswordHash is an encoded hash in any format supported by the configured password verifiers.
+	PasswordHash           string `json:"password_hash"`
+	PasswordChangeRequired bool   `json:"password_change_required"`
+
+	// Name and Description are only used for machine users.
+	Name        string `json:"name"`
+	Description string `json:"description"`
+
+	Metadata map[string]string `json:"metadata"`
+	// Grants are only added to created users.
+	Grants []*UserImportGrant `json:"grants"`
+}
+
+type UserImportGrant struct {
+	ProjectID string `json:"project_id"`
+	// ProjectGrantID is required if the project is granted to the organization.
+	// It can't be set in CSV files.
+	ProjectGrantID string   `json:"project_grant_id"`
+	RoleKeys       []string `json:"role_keys"`
+}
+
+// userImportParsedRow is a row of the file with its 1-based number.
+// Err is set if the row itself could not be parsed, the remaining rows are parsed anyway.
+type userImportParsedRow struct {
+	Number uint64
+	Row    *UserImportRow
+	Err    error
+}
+
+// userImportReader reads the rows of a file one after another.
+// It starts at the byte offset of the next unprocessed row,
+// so that an interrupted job continues without parsing the already processed rows again.
+type userImportReader interface {
+	// Read returns the next row or [io.EOF] if there are no more rows.
+	// Any other error means the file can't be processed any further,
+	// errors of single rows are returned as part of the row.
+	Read() (*userImportParsedRow, error)
+	// Offset returns the byte offset after the last row read.
+	Offset() int64
+}
+
+// newUserImportReader returns a reader of the rows of the file starting at offset.
+// number is the amount of rows before the offset and is used to number the rows read.
+func newUserImportReader(format domain.UserImportFormat, data []byte, offset int64, number uint64) (userImportReader, error) {
+	offset = min(max(offset, 0), int64(len(data)))
+	switch format {
+	case domain.UserImportFormatCSV:
+		return newUserImportCSVReader(data, offset, number)
+	case domain.UserImportFormatNDJSON:
+		return &userImportNDJSONReader{data: data, offset: offset, number: number}, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2aAa", "Errors.UserImport.FormatInvalid")
+	}
+}
+
+type userImportNDJSONReader struct {
+	data   []byte
+	offset int64
+	number uint64
+}
+
+func (r *userImportNDJSONReader) Read() (*userImportParsedRow, error) {
+	for r.offset < int64(len(r.data)) {
+		line := r.data[r.offset:]
+		if i := bytes.IndexByte(line, '\n'); i >= 0 {
+			line = line[:i+1]
+		}
+		r.offset += int64(len(line))
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		r.number++
+		row := new(UserImportRow)
+		parsed := &userImportParsedRow{Number: r.number, Row: row}
+		if err := json.Unmarshal(line, row); err != nil {
+			parsed.Err = zerrors.ThrowInvalidArgument(err, "COMMAND-Ui2bBb", "Errors.UserImport.Row.Malformed")
+		}
+		return parsed, nil
+	}
+	return nil, io.EOF
+}
+
+func (r *userImportNDJSONReader) Offset() int64 {
+	return r.offset
+}
+
+type userImportCSVReader struct {
+	reader  *csv.Reader
+	setters []userImportColumnSetter
+	// base is the offset of the data read by reader in the file
+	base   int64
+	number uint64
+}
+
+// newUserImportCSVReader parses the header row and continues reading the records at offset,
+// if it is after the header.
+func newUserImportCSVReader(data []byte, offset int64, number uint64) (*userImportCSVReader, error) {
+	reader := newUserImportCSVRecordReader(data)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Ui2dDd", "Errors.UserImport.Invalid")
+	}
+	setters := make([]userImportColumnSetter, len(header))
+	for i, column := range header {
+		if setters[i] = userImportColumn(strings.TrimSpace(column)); setters[i] == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2eEe", "Errors.UserImport.Invalid")
+		}
+	}
+	r := &userImportCSVReader{
+		reader:  reader,
+		setters: setters,
+		number:  number,
+	}
+	if offset > reader.InputOffset() {
+		r.base = offset
+		r.reader = newUserImportCSVRecordReader(data[offset:])
+		r.reader.FieldsPerRecord = len(header)
+	}
+	return r, nil
+}
+
+func newUserImportCSVRecordReader(data []byte) *csv.Reader {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+	return reader
+}
+
+func (r *userImportCSVReader) Read() (*userImportParsedRow, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Ui2gGg", "Errors.UserImport.Invalid")
+	}
+	r.number++
+	parsed := &userImportParsedRow{Number: r.number, Row: new(UserImportRow)}
+	if err != nil {
+		parsed.Err = zerrors.ThrowInvalidArgument(err, "COMMAND-Ui2fFf", "Errors.UserImport.Row.Malformed")
+		return parsed, nil
+	}
+	for i, value := range record {
+		if err = r.setters[i](parsed.Row, value); err != nil {
+			parsed.Err = zerrors.ThrowInvalidArgument(err, "COMMAND-Ui2hHh", "Errors.UserImport.Row.Malformed")
+			break
+		}
+	}
+	return parsed, nil
+}
+
+func (r *userImportCSVReader) Offset() int64 {
+	return r.base + r.reader.InputOffset()
+}
+
+type userImportColumnSetter func(row *UserImportRow, value string) error
+
+// userImportColumn returns the setter of the CSV column or nil if the column is unknown.
+func userImportColumn(column string) userImportColumnSetter {
+	if key, ok := strings.CutPrefix(column, userImportMetadataColumnPrefix); ok && key != "" {
+		return func(row *UserImportRow, value string) error {
+			// empty cells are ignored, so that the keys can differ between the rows
+			if value == "" {
+				return nil
+			}
+			if row.Metadata == nil {
+				row.Metadata = make(map[string]string)
+			}
+			row.Metadata[key] = value
+			return nil
+		}
+	}
+	switch column {
+	case "operation":
+		return func(row *UserImportRow, value string) error {
+			row.Operation = domain.UserImportOperation(value)
+			return nil
+		}
+	case "type":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Type })
+	case "user_id":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.UserID })
+	case "username":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Username })
+	case "first_name":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.FirstName })
+	case "last_name":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.LastName })
+	case "nick_name":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.NickName })
+	case "display_name":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.DisplayName })
+	case "preferred_language":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.PreferredLanguage })
+	case "email":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Email })
+	case "email_verified":
+		return userImportBoolColumn(func(row *UserImportRow) *bool { return &row.EmailVerified })
+	case "phone":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Phone })
+	case "phone_verified":
+		return userImportBoolColumn(func(row *UserImportRow) *bool { return &row.PhoneVerified })
+	case "password_hash":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.PasswordHash })
+	case "password_change_required":
+		return userImportBoolColumn(func(row *UserImportRow) *bool { return &row.PasswordChangeRequired })
+	case "name":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Name })
+	case "description":
+		return userImportStringColumn(func(row *UserImportRow) *string { return &row.Description })
+	case "grants":
+		return func(row *UserImportRow, value string) (err error) {
+			row.Grants, err = parseUserImportGrants(value)
+			return err
+		}
+	default:
+		return nil
+	}
+}
+
+func userImportStringColumn(field func(row *UserImportRow) *string) userImportColumnSetter {
+	return func(row *UserImportRow, value string) error {
+		*field(row) = value
+		return nil
+	}
+}
+
+func userImportBoolColumn(field func(row *UserImportRow) *bool) userImportColumnSetter {
+	return func(row *UserImportRow, value string) (err error) {
+		if value == "" {
+			return nil
+		}
+		*field(row), err = strconv.ParseBool(value)
+		return err
+	}
+}
+
+// parseUserImportGrants parses grants in the format `<project_id>:<role>|<role>;<project_id>:<role>`.
+func parseUserImportGrants(value string) ([]*UserImportGrant, error) {
+	if value == "" {
+		return nil, nil
+	}
+	entries := strings.Split(value, ";")
+	grants := make([]*UserImportGrant, 0, len(entries))
+	for _, entry := range entries {
+		projectID, roles, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		if projectID == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2iIi", "Errors.UserImport.Row.Malformed")
+		}
+		grant := &UserImportGrant{ProjectID: projectID}
+		if roles != "" {
+			grant.RoleKeys = strings.Split(roles, "|")
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
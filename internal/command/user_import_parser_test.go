@@ -0,0 +1,222 @@
+package command
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_userImportReader(t *testing.T) {
+	type args struct {
+		format domain.UserImportFormat
+		data   string
+		offset int64
+		number uint64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       []*userImportParsedRow
+		wantRowErr []bool
+		wantOffset int64
+		wantErr    error
+	}{
+		{
+			name: "invalid format",
+			args: args{
+				format: domain.UserImportFormatUnspecified,
+				data:   "{}",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2aAa", "Errors.UserImport.FormatInvalid"),
+		},
+		{
+			name: "csv, unknown column",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data:   "username,unknown\nuser1,value\n",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2eEe", "Errors.UserImport.Invalid"),
+		},
+		{
+			name: "csv, invalid record",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data:   "username,email\nuser1,\"user1@example.com\n",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui2gGg", "Errors.UserImport.Invalid"),
+		},
+		{
+			name: "csv, continued at offset",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data: "username,email\n" +
+					"user1,user1@example.com\n" +
+					"user2,user2@example.com\n",
+				offset: 39,
+				number: 1,
+			},
+			want: []*userImportParsedRow{
+				{Number: 2, Row: &UserImportRow{Username: "user2", Email: "user2@example.com"}},
+			},
+			wantRowErr: []bool{false},
+			wantOffset: 63,
+		},
+		{
+			name: "csv, ok",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data: "operation,type,user_id,username,email,email_verified,password_hash,metadata.department,metadata.location,grants\n" +
+					"create,human,,user1,user1@example.com,true,$2a$14$hash,sales,,project1:role1|role2;project2:role3\n" +
+					"deactivate,,user2,,,,,,,\n",
+			},
+			wantOffset: 235,
+			want: []*userImportParsedRow{
+				{
+					Number: 1,
+					Row: &UserImportRow{
+						Operation:     domain.UserImportOperationCreate,
+						Type:          UserImportUserTypeHuman,
+						Username:      "user1",
+						Email:         "user1@example.com",
+						EmailVerified: true,
+						PasswordHash:  "$2a$14$hash",
+						Metadata:      map[string]string{"department": "sales"},
+						Grants: []*UserImportGrant{
+							{ProjectID: "project1", RoleKeys: []string{"role1", "role2"}},
+							{ProjectID: "project2", RoleKeys: []string{"role3"}},
+						},
+					},
+				},
+				{
+					Number: 2,
+					Row: &UserImportRow{
+						Operation: domain.UserImportOperationDeactivate,
+						UserID:    "user2",
+					},
+				},
+			},
+			wantRowErr: []bool{false, false},
+		},
+		{
+			name: "csv, row errors",
+			args: args{
+				format: domain.UserImportFormatCSV,
+				data: "username,email_verified\n" +
+					"user1,maybe\n" +
+					"user2\n" +
+					"user3,false\n",
+			},
+			want: []*userImportParsedRow{
+				{Number: 1},
+				{Number: 2},
+				{Number: 3, Row: &UserImportRow{Username: "user3"}},
+			},
+			wantRowErr: []bool{true, true, false},
+		},
+		{
+			name: "ndjson, ok",
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				data: `{"type":"machine","username":"machine1","name":"Machine","metadata":{"team":"ops"},"grants":[{"project_id":"project1","project_grant_id":"grant1","role_keys":["role1"]}]}` + "\n" +
+					"\n" +
+					`{"operation":"update","user_id":"user2","first_name":"First"}` + "\n",
+			},
+			want: []*userImportParsedRow{
+				{
+					Number: 1,
+					Row: &UserImportRow{
+						Type:     UserImportUserTypeMachine,
+						Username: "machine1",
+						Name:     "Machine",
+						Metadata: map[string]string{"team": "ops"},
+						Grants: []*UserImportGrant{
+							{ProjectID: "project1", ProjectGrantID: "grant1", RoleKeys: []string{"role1"}},
+						},
+					},
+				},
+				{
+					Number: 2,
+					Row: &UserImportRow{
+						Operation: domain.UserImportOperationUpdate,
+						UserID:    "user2",
+						FirstName: "First",
+					},
+				},
+			},
+			wantRowErr: []bool{false, false},
+		},
+		{
+			name: "ndjson, continued at offset",
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				data:   `{"username":"user1"}` + "\n\n" + `{"username":"user2"}` + "\n",
+				offset: 21,
+				number: 1,
+			},
+			want: []*userImportParsedRow{
+				{Number: 2, Row: &UserImportRow{Username: "user2"}},
+			},
+			wantRowErr: []bool{false},
+			wantOffset: 43,
+		},
+		{
+			name: "ndjson, malformed row",
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				data:   "{\n" + `{"username":"user2"}`,
+			},
+			wantOffset: 22,
+			want: []*userImportParsedRow{
+				{Number: 1},
+				{Number: 2, Row: &UserImportRow{Username: "user2"}},
+			},
+			wantRowErr: []bool{true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, offset, err := readAllUserImportRows(tt.args.format, []byte(tt.args.data), tt.args.offset, tt.args.number)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			require.Len(t, got, len(tt.want))
+			for i, want := range tt.want {
+				assert.Equal(t, want.Number, got[i].Number)
+				if tt.wantRowErr[i] {
+					assert.Error(t, got[i].Err)
+					continue
+				}
+				assert.NoError(t, got[i].Err)
+				assert.Equal(t, want.Row, got[i].Row)
+			}
+			if tt.wantOffset != 0 {
+				assert.Equal(t, tt.wantOffset, offset)
+			}
+		})
+	}
+}
+
+func readAllUserImportRows(format domain.UserImportFormat, data []byte, offset int64, number uint64) ([]*userImportParsedRow, int64, error) {
+	reader, err := newUserImportReader(format, data, offset, number)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows := make([]*userImportParsedRow, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, reader.Offset(), nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		rows = append(rows, row)
+	}
+}
@@ -0,0 +1,448 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+	"github.com/zitadel/zitadel/internal/static"
+	static_mock "github.com/zitadel/zitadel/internal/static/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddUserImportFile(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "org not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+		},
+		{
+			name: "storage fails",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectPutObjectError(),
+			},
+			wantErr: zerrors.ThrowInternal(nil, "COMMAND-Ui1eEe", "Errors.Assets.Object.PutFailed"),
+		},
+		{
+			name: "upload, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectPutObject(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			data := `{"username":"user1"}`
+			err := c.AddUserImportFile(context.Background(), "org1", &AssetUpload{
+				ResourceOwner: "org1",
+				ObjectName:    domain.GetUserImportAssetPath("job1"),
+				ContentType:   "application/x-ndjson",
+				ObjectType:    static.ObjectTypeUserImport,
+				File:          strings.NewReader(data),
+				Size:          int64(len(data)),
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_AddUserImportJob(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	type args struct {
+		format domain.UserImportFormat
+		dryRun bool
+		fileID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "invalid format",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				fileID: "job1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1bBb", "Errors.UserImport.FormatInvalid"),
+		},
+		{
+			name: "file id missing",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1jJj", "Errors.IDMissing"),
+		},
+		{
+			name: "job already exists",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(context.Background(), true)),
+					),
+				),
+			},
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				fileID: "job1",
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "COMMAND-Ui1kKk", "Errors.UserImport.AlreadyExists"),
+		},
+		{
+			name: "file not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObjectInfoError(),
+			},
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				fileID: "job1",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ui1dDd", "Errors.Assets.Object.GetFailed"),
+		},
+		{
+			name: "empty file",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObjectInfo(0),
+			},
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				fileID: "job1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ui1cCc", "Errors.UserImport.Empty"),
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						userimport.NewAddedEvent(context.Background(),
+							userimport.NewAggregate("job1", "org1", ""),
+							domain.UserImportFormatNDJSON,
+							true,
+						),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObjectInfo(41),
+			},
+			args: args{
+				format: domain.UserImportFormatNDJSON,
+				dryRun: true,
+				fileID: "job1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			details, err := c.AddUserImportJob(context.Background(), "org1", tt.args.format, tt.args.dryRun, tt.args.fileID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, "org1", details.ResourceOwner)
+			}
+		})
+	}
+}
+
+func TestCommands_CancelUserImportJob(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "job not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ui1hHh", "Errors.UserImport.NotFound"),
+		},
+		{
+			name: "job already completed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(context.Background(), false)),
+						eventFromEventPusher(userimport.NewStartedEvent(context.Background(), userImportTestAggregate())),
+						eventFromEventPusher(userimport.NewCompletedEvent(context.Background(), userImportTestAggregate(), 1)),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ui1fFf", "Errors.UserImport.AlreadyDone"),
+		},
+		{
+			name: "cancel, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(context.Background(), false)),
+						eventFromEventPusher(userimport.NewStartedEvent(context.Background(), userImportTestAggregate())),
+					),
+					expectPush(
+						userimport.NewCanceledEvent(context.Background(), userImportTestAggregate()),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectRemoveObjectNoError(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			_, err := c.CancelUserImportJob(context.Background(), "org1", "job1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_ProcessUserImportJob(t *testing.T) {
+	creatorCtx := authz.SetCtxData(context.Background(), authz.CtxData{UserID: "creator"})
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		static     static.Storage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "job not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ui1hHh", "Errors.UserImport.NotFound"),
+		},
+		{
+			name: "job already canceled",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, false)),
+						eventFromEventPusher(userimport.NewCanceledEvent(context.Background(), userImportTestAggregate())),
+					),
+				),
+			},
+		},
+		{
+			name: "file not found, failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, false)),
+					),
+					expectPush(
+						userimport.NewStartedEvent(creatorCtx, userImportTestAggregate()),
+					),
+					expectPush(
+						userimport.NewFailedEvent(creatorCtx, userImportTestAggregate(), "Errors.Assets.Object.GetFailed"),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObjectError().ExpectRemoveObjectNoError(),
+			},
+		},
+		{
+			name: "invalid file, failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, false)),
+					),
+					expectPush(
+						userimport.NewStartedEvent(creatorCtx, userImportTestAggregate()),
+					),
+					expectPush(
+						userimport.NewFailedEvent(creatorCtx, userImportTestAggregate(), "Errors.UserImport.Invalid"),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObject([]byte(
+					"operation,unknown\ndeactivate,userID\n",
+				)).ExpectRemoveObjectNoError(),
+			},
+		},
+		{
+			name: "dry run, rows validated in batches",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, true)),
+					),
+					expectPush(
+						userimport.NewStartedEvent(creatorCtx, userImportTestAggregate()),
+					),
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, true)),
+						eventFromEventPusher(userimport.NewStartedEvent(creatorCtx, userImportTestAggregate())),
+					),
+					expectPush(
+						userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 1, 58, 0, []*userimport.RowError{}),
+					),
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, true)),
+						eventFromEventPusher(userimport.NewStartedEvent(creatorCtx, userImportTestAggregate())),
+						eventFromEventPusher(userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 1, 58, 0, nil)),
+					),
+					expectPush(
+						userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 2, 98, 1, []*userimport.RowError{
+							{Row: 2, UserID: "user1", Message: "Errors.UserImport.Row.OperationInvalid"},
+						}),
+					),
+					expectPush(
+						userimport.NewCompletedEvent(creatorCtx, userImportTestAggregate(), 2),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObject([]byte(
+					`{"type":"machine","username":"machine1","name":"Machine"}` + "\n" +
+						`{"operation":"delete","user_id":"user1"}`,
+				)).ExpectRemoveObjectNoError(),
+			},
+		},
+		{
+			name: "canceled between batches",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, true)),
+						eventFromEventPusher(userimport.NewStartedEvent(creatorCtx, userImportTestAggregate())),
+						eventFromEventPusher(userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 1, 45, 0, nil)),
+					),
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, true)),
+						eventFromEventPusher(userimport.NewStartedEvent(creatorCtx, userImportTestAggregate())),
+						eventFromEventPusher(userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 1, 45, 0, nil)),
+						eventFromEventPusher(userimport.NewCanceledEvent(context.Background(), userImportTestAggregate())),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObject([]byte(
+					`{"operation":"deactivate","user_id":"user1"}` + "\n" +
+						`{"operation":"deactivate","user_id":"user2"}`,
+				)),
+			},
+		},
+		{
+			name: "user deactivated, completed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, false)),
+					),
+					expectPush(
+						userimport.NewStartedEvent(creatorCtx, userImportTestAggregate()),
+					),
+					expectFilter(
+						eventFromEventPusher(newUserImportAddedEvent(creatorCtx, false)),
+						eventFromEventPusher(userimport.NewStartedEvent(creatorCtx, userImportTestAggregate())),
+					),
+					expectFilter(
+						eventFromEventPusher(newInactivityHumanAddedEvent()),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(creatorCtx, &user.NewAggregate("userID", "org1").Aggregate),
+					),
+					expectPush(
+						userimport.NewRowsProcessedEvent(creatorCtx, userImportTestAggregate(), 1, 36, 0, []*userimport.RowError{}),
+					),
+					expectPush(
+						userimport.NewCompletedEvent(creatorCtx, userImportTestAggregate(), 1),
+					),
+				),
+				static: static_mock.NewStorage(t).ExpectGetObject([]byte(
+					"operation,user_id\ndeactivate,userID\n",
+				)).ExpectRemoveObjectNoError(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				static:     tt.fields.static,
+			}
+			err := c.ProcessUserImportJob(context.Background(), "org1", "job1", 1)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func userImportTestAggregate() *eventstore.Aggregate {
+	return userimport.NewAggregate("job1", "org1", "instance1")
+}
+
+// newUserImportAddedEvent returns the added event of a dry run of an NDJSON file
+// or of a job of a CSV file. The creator of the job is taken from the context.
+func newUserImportAddedEvent(ctx context.Context, dryRun bool) *userimport.AddedEvent {
+	format := domain.UserImportFormatNDJSON
+	if !dryRun {
+		format = domain.UserImportFormatCSV
+	}
+	return userimport.NewAddedEvent(ctx, userImportTestAggregate(), format, dryRun)
+}
@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddInactivityNotification records that the action of the inactivity policy will be applied to the user at the due date,
+// so that the user gets notified about it.
+// Nothing is recorded if the user was already notified about the action since the last activity.
+func (c *Commands) AddInactivityNotification(ctx context.Context, orgID, userID string, action domain.InactivityAction, lastActivity, dueDate time.Time) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-In4aAa", "Errors.User.UserIDMissing")
+	}
+	if !action.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-In4bBb", "Errors.User.Inactivity.ActionInvalid")
+	}
+	wm, err := c.userInactivityWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-In4cCc", "Errors.User.NotFound")
+	}
+	if wm.Notified(action, lastActivity) || wm.ActiveSince(lastActivity) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, user.NewInactivityNotificationAddedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel),
+		action,
+		lastActivity,
+		dueDate,
+	))
+	return err
+}
+
+// InactivityNotificationSent marks the notification about the action as sent to the user.
+func (c *Commands) InactivityNotificationSent(ctx context.Context, orgID, userID string, action domain.InactivityAction) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-In4dDd", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.userInactivityWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(wm.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-In4eEe", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewInactivityNotificationSentEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), action))
+	return err
+}
+
+// DeactivateInactiveUser deactivates the user, which was last active at the provided time, because of the inactivity policy.
+// Nothing happens if the user was active since or is already inactive.
+func (c *Commands) DeactivateInactiveUser(ctx context.Context, orgID, userID string, lastActivity time.Time) (err error) {
+	wm, err := c.inactiveUserWriteModel(ctx, orgID, userID, lastActivity)
+	if err != nil || wm == nil || isUserStateInactive(wm.UserState) {
+		return err
+	}
+	_, err = c.DeactivateUser(ctx, userID, orgID)
+	return err
+}
+
+// RemoveInactiveUser removes the user, which was last active at the provided time, because of the inactivity policy.
+// Nothing happens if the user was active since.
+func (c *Commands) RemoveInactiveUser(ctx context.Context, orgID, userID string, lastActivity time.Time, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs ...string) (err error) {
+	wm, err := c.inactiveUserWriteModel(ctx, orgID, userID, lastActivity)
+	if err != nil || wm == nil {
+		return err
+	}
+	_, err = c.RemoveUser(ctx, userID, orgID, cascadingUserMemberships, cascadingGrantIDs...)
+	return err
+}
+
+// inactiveUserWriteModel returns the write model of the user, if the user still exists and was not active since the provided time.
+func (c *Commands) inactiveUserWriteModel(ctx context.Context, orgID, userID string, lastActivity time.Time) (*UserInactivityWriteModel, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-In4fFf", "Errors.User.UserIDMissing")
+	}
+	wm, err := c.userInactivityWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(wm.UserState) || wm.ActiveSince(lastActivity) {
+		return nil, nil
+	}
+	return wm, nil
+}
+
+func (c *Commands) userInactivityWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *UserInactivityWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewUserInactivityWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// UserInactivityWriteModel keeps track of the last activity of the user
+// and the actions of the inactivity policy, the user was already notified about.
+type UserInactivityWriteModel struct {
+	eventstore.WriteModel
+
+	notified map[inactivityNotification]struct{}
+
+	UserState    domain.UserState
+	LastActivity time.Time
+}
+
+type inactivityNotification struct {
+	action       domain.InactivityAction
+	lastActivity int64
+}
+
+func newInactivityNotification(action domain.InactivityAction, lastActivity time.Time) inactivityNotification {
+	return inactivityNotification{
+		action: action,
+		// the last activity is read from the projection, which only stores microseconds
+		lastActivity: lastActivity.UnixMicro(),
+	}
+}
+
+func NewUserInactivityWriteModel(userID, resourceOwner string) *UserInactivityWriteModel {
+	return &UserInactivityWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		notified: make(map[inactivityNotification]struct{}),
+	}
+}
+
+func (wm *UserInactivityWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			wm.UserState = domain.UserStateActive
+			wm.LastActivity = event.CreatedAt()
+		case *user.InactivityNotificationAddedEvent:
+			wm.notified[newInactivityNotification(e.Action, e.LastActivity)] = struct{}{}
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+		case *user.UserReactivatedEvent:
+			wm.UserState = domain.UserStateActive
+			wm.LastActivity = event.CreatedAt()
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		default:
+			if slices.Contains(user.ActivityEventTypes, event.Type()) {
+				wm.LastActivity = event.CreatedAt()
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserInactivityWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(append([]eventstore.EventType{
+			user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.InactivityNotificationAddedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserRemovedType,
+		}, user.ActivityEventTypes...)...).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Notified returns if the user was already notified about the action since the provided activity.
+func (wm *UserInactivityWriteModel) Notified(action domain.InactivityAction, lastActivity time.Time) bool {
+	_, ok := wm.notified[newInactivityNotification(action, lastActivity)]
+	return ok
+}
+
+// ActiveSince returns if the user was active after the provided time,
+// in which case the evaluation of the inactivity policy is outdated.
+func (wm *UserInactivityWriteModel) ActiveSince(lastActivity time.Time) bool {
+	return wm.LastActivity.After(lastActivity)
+}
@@ -0,0 +1,494 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddInactivityNotification(t *testing.T) {
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := lastActivity.Add(90 * 24 * time.Hour)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+		action domain.InactivityAction
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				action: domain.InactivityActionDeactivate,
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-In4aAa", "Errors.User.UserIDMissing"),
+		},
+		{
+			"missing action",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				userID: "userID",
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-In4bBb", "Errors.User.Inactivity.ActionInvalid"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				action: domain.InactivityActionDeactivate,
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-In4cCc", "Errors.User.NotFound"),
+		},
+		{
+			"already notified",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewInactivityNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.InactivityActionDeactivate,
+								lastActivity,
+								dueDate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				action: domain.InactivityActionDeactivate,
+			},
+			nil,
+		},
+		{
+			"active since",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				action: domain.InactivityActionDeactivate,
+			},
+			nil,
+		},
+		{
+			"notified about other action, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewInactivityNotificationAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								domain.InactivityActionDeactivate,
+								lastActivity,
+								dueDate,
+							),
+						),
+					),
+					expectPush(
+						user.NewInactivityNotificationAddedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							domain.InactivityActionDelete,
+							lastActivity,
+							dueDate,
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+				action: domain.InactivityActionDelete,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.AddInactivityNotification(tt.args.ctx, tt.args.orgID, tt.args.userID, tt.args.action, lastActivity, dueDate)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_InactivityNotificationSent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-In4dDd", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-In4eEe", "Errors.User.NotFound"),
+		},
+		{
+			"sent ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectPush(
+						user.NewInactivityNotificationSentEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							domain.InactivityActionDelete,
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.InactivityNotificationSent(tt.args.ctx, tt.args.orgID, tt.args.userID, domain.InactivityActionDelete)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_DeactivateInactiveUser(t *testing.T) {
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-In4fFf", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"active since",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"already inactive",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewUserDeactivatedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"deactivate, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusher(
+							user.NewHumanInitializedCheckSucceededEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+							),
+						),
+					),
+					expectPush(
+						user.NewUserDeactivatedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.DeactivateInactiveUser(tt.args.ctx, tt.args.orgID, tt.args.userID, lastActivity)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RemoveInactiveUser(t *testing.T) {
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-In4fFf", "Errors.User.UserIDMissing"),
+		},
+		{
+			"active since",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewUserReactivatedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newInactivityHumanAddedEvent(),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&instance.NewAggregate("instanceID").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewUserRemovedEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"username",
+							nil,
+							true,
+						),
+					),
+				),
+			},
+			args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "userID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.RemoveInactiveUser(tt.args.ctx, tt.args.orgID, tt.args.userID, lastActivity, nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func newInactivityHumanAddedEvent() *user.HumanAddedEvent {
+	return user.NewHumanAddedEvent(context.Background(),
+		&user.NewAggregate("userID", "org1").Aggregate,
+		"username", "firstName",
+		"lastName",
+		"nickName",
+		"displayName",
+		language.English,
+		domain.GenderUnspecified,
+		"email@test.ch",
+		false,
+	)
+}
@@ -43,6 +43,9 @@ type ChangeUserSchema struct {
 	Type                   *string
 	Schema                 json.RawMessage
 	PossibleAuthenticators []domain.AuthenticatorType
+	// Migrations are applied to the data of the existing users when they are migrated to the new revision.
+	// They can only be passed if the schema changes.
+	Migrations []*domain_schema.Migration
 }
 
 func (s *ChangeUserSchema) Valid() error {
@@ -60,6 +63,11 @@ func (s *ChangeUserSchema) Valid() error {
 			return zerrors.ThrowInvalidArgument(nil, "COMMA-WF4hg", "Errors.UserSchema.Authenticator.Invalid")
 		}
 	}
+	for _, migration := range s.Migrations {
+		if err := migration.Valid(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -101,12 +109,16 @@ func (c *Commands) ChangeUserSchema(ctx context.Context, userSchema *ChangeUserS
 	if writeModel.State != domain.UserSchemaStateActive {
 		return zerrors.ThrowPreconditionFailed(nil, "COMMA-HB3e1", "Errors.UserSchema.NotActive")
 	}
+	if len(userSchema.Migrations) > 0 && bytes.Equal(writeModel.Schema, userSchema.Schema) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMA-Mi1aAa", "Errors.UserSchema.Migration.SchemaUnchanged")
+	}
 	updatedEvent := writeModel.NewUpdatedEvent(
 		ctx,
 		UserSchemaAggregateFromWriteModel(&writeModel.WriteModel),
 		userSchema.Type,
 		userSchema.Schema,
 		userSchema.PossibleAuthenticators,
+		userSchema.Migrations,
 	)
 	if updatedEvent == nil {
 		userSchema.Details = writeModelToObjectDetails(&writeModel.WriteModel)
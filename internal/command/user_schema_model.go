@@ -8,6 +8,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/user/schema"
 )
@@ -20,6 +21,8 @@ type UserSchemaWriteModel struct {
 	PossibleAuthenticators []domain.AuthenticatorType
 	State                  domain.UserSchemaState
 	SchemaRevision         uint64
+	// Migrations contains the migrations declared by a revision, mapped by the revision
+	Migrations map[uint64][]*domain_schema.Migration
 }
 
 func NewUserSchemaWriteModel(resourceOwner, schemaID string) *UserSchemaWriteModel {
@@ -46,6 +49,12 @@ func (wm *UserSchemaWriteModel) Reduce() error {
 			}
 			if e.SchemaRevision != nil {
 				wm.SchemaRevision = *e.SchemaRevision
+				if len(e.Migrations) > 0 {
+					if wm.Migrations == nil {
+						wm.Migrations = make(map[uint64][]*domain_schema.Migration)
+					}
+					wm.Migrations[*e.SchemaRevision] = e.Migrations
+				}
 			}
 			if len(e.Schema) > 0 {
 				wm.Schema = e.Schema
@@ -86,6 +95,7 @@ func (wm *UserSchemaWriteModel) NewUpdatedEvent(
 	schemaType *string,
 	userSchema json.RawMessage,
 	possibleAuthenticators []domain.AuthenticatorType,
+	migrations []*domain_schema.Migration,
 ) *schema.UpdatedEvent {
 	changes := make([]schema.Changes, 0)
 	if schemaType != nil && wm.SchemaType != *schemaType {
@@ -95,6 +105,9 @@ func (wm *UserSchemaWriteModel) NewUpdatedEvent(
 		changes = append(changes, schema.ChangeSchema(userSchema))
 		// change revision if the content of the schema changed
 		changes = append(changes, schema.IncreaseRevision(wm.SchemaRevision))
+		if len(migrations) > 0 {
+			changes = append(changes, schema.ChangeMigrations(migrations))
+		}
 	}
 	if len(possibleAuthenticators) > 0 && slices.Compare(wm.PossibleAuthenticators, possibleAuthenticators) != 0 {
 		changes = append(changes, schema.ChangePossibleAuthenticators(possibleAuthenticators))
@@ -105,6 +118,16 @@ func (wm *UserSchemaWriteModel) NewUpdatedEvent(
 	return schema.NewUpdatedEvent(ctx, agg, changes)
 }
 
+// MigrationsFrom returns the migrations, which need to be applied in the returned order
+// to migrate data of the passed revision to the current revision.
+func (wm *UserSchemaWriteModel) MigrationsFrom(revision uint64) []*domain_schema.Migration {
+	var migrations []*domain_schema.Migration
+	for r := revision + 1; r <= wm.SchemaRevision; r++ {
+		migrations = append(migrations, wm.Migrations[r]...)
+	}
+	return migrations
+}
+
 func UserSchemaAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return &eventstore.Aggregate{
 		ID:            wm.AggregateID,
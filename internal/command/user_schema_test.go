@@ -10,6 +10,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
@@ -615,6 +616,102 @@ func TestCommands_ChangeUserSchema(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid migration, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				userSchema: &ChangeUserSchema{
+					ID:     "id1",
+					Schema: json.RawMessage(`{}`),
+					Migrations: []*domain_schema.Migration{
+						{Type: domain_schema.MigrationTypeRename, Field: "/name"},
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2bBb", "Errors.UserSchema.Migration.Invalid"),
+			},
+		},
+		{
+			"migrations without schema change, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							schema.NewCreatedEvent(
+								context.Background(),
+								&schema.NewAggregate("id1", "instanceID").Aggregate,
+								"type",
+								json.RawMessage(`{}`),
+								[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				userSchema: &ChangeUserSchema{
+					ID:     "id1",
+					Schema: json.RawMessage(`{}`),
+					Migrations: []*domain_schema.Migration{
+						{Type: domain_schema.MigrationTypeRename, Field: "/name", To: "displayName"},
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMA-Mi1aAa", "Errors.UserSchema.Migration.SchemaUnchanged"),
+			},
+		},
+		{
+			"update schema with migrations",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							schema.NewCreatedEvent(
+								context.Background(),
+								&schema.NewAggregate("id1", "instanceID").Aggregate,
+								"type",
+								json.RawMessage(`{}`),
+								[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+							),
+						),
+					),
+					expectPush(
+						schema.NewUpdatedEvent(
+							context.Background(),
+							&schema.NewAggregate("id1", "instanceID").Aggregate,
+							[]schema.Changes{
+								schema.ChangeSchema(json.RawMessage(`{"type": "object"}`)),
+								schema.IncreaseRevision(1),
+								schema.ChangeMigrations([]*domain_schema.Migration{
+									{Type: domain_schema.MigrationTypeRename, Field: "/name", To: "displayName"},
+								}),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				userSchema: &ChangeUserSchema{
+					ID:     "id1",
+					Schema: json.RawMessage(`{"type": "object"}`),
+					Migrations: []*domain_schema.Migration{
+						{Type: domain_schema.MigrationTypeRename, Field: "/name", To: "displayName"},
+					},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instanceID",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
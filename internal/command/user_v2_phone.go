@@ -197,7 +197,7 @@ func (c *Commands) NewUserPhoneEvents(ctx context.Context, userID string) (*User
 		aggregate:  UserAggregateFromWriteModel(&model.WriteModel),
 		model:      model,
 		generateCode: func(ctx context.Context, filter preparation.FilterToQueryReducer) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode)
+			return c.newPhoneCode(ctx, filter, model.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode)
 		},
 		getCodeVerifier: c.phoneCodeVerifier,
 	}, nil
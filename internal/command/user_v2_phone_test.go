@@ -1277,6 +1277,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						user.NewHumanPhoneCodeAddedEventV2(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -115,7 +116,7 @@ func (c *Commands) CreateSchemaUser(ctx context.Context, user *CreateSchemaUser)
 			return c.newEmailCode(ctx, c.eventstore.Filter, c.userEncryption) //nolint:staticcheck
 		},
 		func(ctx context.Context) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+			return c.newPhoneCode(ctx, c.eventstore.Filter, writeModel.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		},
 	)
 	if err != nil {
@@ -134,7 +135,7 @@ func (c *Commands) DeleteSchemaUser(ctx context.Context, resourceOwner, id strin
 	if id == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vs4wJCME7T", "Errors.IDMissing")
 	}
-	writeModel, err := c.getSchemaUserExists(ctx, resourceOwner, id)
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +225,7 @@ func (c *Commands) ChangeSchemaUser(ctx context.Context, user *ChangeSchemaUser)
 			return c.newEmailCode(ctx, c.eventstore.Filter, c.userEncryption) //nolint:staticcheck
 		},
 		func(ctx context.Context) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+			return c.newPhoneCode(ctx, c.eventstore.Filter, writeModel.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		},
 	)
 	if err != nil {
@@ -363,3 +364,31 @@ func (c *Commands) getSchemaUserPhoneWriteModelByID(ctx context.Context, resourc
 	}
 	return writeModel, nil
 }
+
+func (c *Commands) getSchemaUserAuthenticatorWriteModelByID(ctx context.Context, resourceOwner, id string) (*UserV3WriteModel, error) {
+	writeModel := NewUserV3AuthenticatorWriteModel(resourceOwner, id, c.checkPermission)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// getSchemaUserAuthenticatorWriteModel returns the write model of an existing user,
+// whose schema allows the authenticator type.
+func (c *Commands) getSchemaUserAuthenticatorWriteModel(ctx context.Context, resourceOwner, id string, authenticator domain.AuthenticatorType) (*UserV3WriteModel, error) {
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Au1aAa", "Errors.User.NotFound")
+	}
+	schemaWriteModel, err := c.getSchemaWriteModelByID(ctx, "", writeModel.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(schemaWriteModel.PossibleAuthenticators, authenticator) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed")
+	}
+	return writeModel, nil
+}
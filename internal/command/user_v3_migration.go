@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SchemaUserRef references a user of a schema.
+type SchemaUserRef struct {
+	ID            string
+	ResourceOwner string
+}
+
+type MigrateSchemaUsersResult struct {
+	// Migrated contains the users which were migrated to the current revision of the schema.
+	Migrated []*SchemaUserRef
+	// Failed contains the users which could not be migrated, e.g. because their data is not valid for the current revision.
+	Failed []*SchemaUserMigrationError
+	// Remaining is the count of users which are still on an outdated revision and were not processed because of the limit.
+	Remaining uint64
+}
+
+type SchemaUserMigrationError struct {
+	User *SchemaUserRef
+	Err  error
+}
+
+// MigrateSchemaUser migrates the data of the user to the current revision of its schema
+// by applying the migrations declared by the newer revisions.
+// Users are also migrated lazily, as soon as their data is changed.
+func (c *Commands) MigrateSchemaUser(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mi4aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Mi4bBb", "Errors.User.NotFound")
+	}
+	schemaWriteModel, err := c.getSchemaWriteModelByID(ctx, "", writeModel.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	if !schemaWriteModel.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mi4cCc", "Errors.UserSchema.NotExists")
+	}
+	events, _, _, err := writeModel.NewUpdate(ctx,
+		schemaWriteModel,
+		&SchemaUser{SchemaID: writeModel.SchemaID},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel, events...)
+}
+
+// MigrateSchemaUsers migrates the users of the schema, which are not on its current revision yet.
+// At most limit users are processed, ordered by their ID, so the migration can be run in batches until no users remain.
+// Users which cannot be migrated are returned with the reason and can be fixed by patching their data.
+func (c *Commands) MigrateSchemaUsers(ctx context.Context, schemaID string, limit uint64) (*MigrateSchemaUsersResult, error) {
+	if schemaID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mi5aAa", "Errors.UserSchema.ID.Missing")
+	}
+	schemaWriteModel, err := c.getSchemaWriteModelByID(ctx, "", schemaID)
+	if err != nil {
+		return nil, err
+	}
+	if !schemaWriteModel.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mi5bBb", "Errors.UserSchema.NotExists")
+	}
+	usersWriteModel := NewSchemaUsersRevisionWriteModel(schemaID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, usersWriteModel); err != nil {
+		return nil, err
+	}
+	outdated := usersWriteModel.OutdatedUsers(schemaWriteModel.SchemaRevision)
+	result := &MigrateSchemaUsersResult{
+		Migrated: make([]*SchemaUserRef, 0),
+		Failed:   make([]*SchemaUserMigrationError, 0),
+	}
+	if limit > 0 && uint64(len(outdated)) > limit {
+		result.Remaining = uint64(len(outdated)) - limit
+		outdated = outdated[:limit]
+	}
+	for _, user := range outdated {
+		if _, err := c.MigrateSchemaUser(ctx, user.ResourceOwner, user.ID); err != nil {
+			result.Failed = append(result.Failed, &SchemaUserMigrationError{User: user, Err: err})
+			continue
+		}
+		result.Migrated = append(result.Migrated, user)
+	}
+	return result, nil
+}
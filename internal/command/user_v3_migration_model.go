@@ -0,0 +1,84 @@
+package command
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+)
+
+// SchemaUsersRevisionWriteModel contains the schema revisions of all users of a schema.
+type SchemaUsersRevisionWriteModel struct {
+	eventstore.WriteModel
+
+	SchemaID string
+	users    map[string]*schemaUserRevision
+}
+
+type schemaUserRevision struct {
+	resourceOwner string
+	schemaID      string
+	revision      uint64
+}
+
+func NewSchemaUsersRevisionWriteModel(schemaID string) *SchemaUsersRevisionWriteModel {
+	return &SchemaUsersRevisionWriteModel{
+		SchemaID: schemaID,
+		users:    make(map[string]*schemaUserRevision),
+	}
+}
+
+func (wm *SchemaUsersRevisionWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *schemauser.CreatedEvent:
+			wm.users[e.Aggregate().ID] = &schemaUserRevision{
+				resourceOwner: e.Aggregate().ResourceOwner,
+				schemaID:      e.SchemaID,
+				revision:      e.SchemaRevision,
+			}
+		case *schemauser.UpdatedEvent:
+			user, ok := wm.users[e.Aggregate().ID]
+			if !ok {
+				continue
+			}
+			if e.SchemaID != nil {
+				user.schemaID = *e.SchemaID
+			}
+			if e.SchemaRevision != nil {
+				user.revision = *e.SchemaRevision
+			}
+		case *schemauser.DeletedEvent:
+			delete(wm.users, e.Aggregate().ID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SchemaUsersRevisionWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(schemauser.AggregateType).
+		EventTypes(
+			schemauser.CreatedType,
+			schemauser.UpdatedType,
+			schemauser.DeletedType,
+		).
+		Builder()
+}
+
+// OutdatedUsers returns the users of the schema with a revision older than the passed one, ordered by their ID.
+func (wm *SchemaUsersRevisionWriteModel) OutdatedUsers(revision uint64) []*SchemaUserRef {
+	users := make([]*SchemaUserRef, 0)
+	for id, user := range wm.users {
+		if user.schemaID != wm.SchemaID || user.revision >= revision {
+			continue
+		}
+		users = append(users, &SchemaUserRef{ID: id, ResourceOwner: user.resourceOwner})
+	}
+	slices.SortFunc(users, func(a, b *SchemaUserRef) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return users
+}
@@ -0,0 +1,403 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schema"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func migrationSchemaEvents() []eventstore.Event {
+	return []eventstore.Event{
+		eventFromEventPusher(
+			schema.NewCreatedEvent(
+				context.Background(),
+				&schema.NewAggregate("schema1", "instanceID").Aggregate,
+				"type",
+				json.RawMessage(`{
+					"$schema": "urn:zitadel:schema:v1",
+					"type": "object",
+					"properties": {
+						"street": {
+							"type": "string"
+						}
+					}
+				}`),
+				[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+			),
+		),
+		eventFromEventPusher(
+			schema.NewUpdatedEvent(
+				context.Background(),
+				&schema.NewAggregate("schema1", "instanceID").Aggregate,
+				[]schema.Changes{
+					schema.IncreaseRevision(1),
+					schema.ChangeSchema(json.RawMessage(`{
+					"$schema": "urn:zitadel:schema:v1",
+					"type": "object",
+					"properties": {
+						"address": {
+							"type": "object",
+							"properties": {
+								"street": {
+									"type": "string"
+								},
+								"country": {
+									"type": "string"
+								}
+							},
+							"required": ["country"]
+						}
+					},
+					"additionalProperties": false
+				}`)),
+					schema.ChangeMigrations([]*domain_schema.Migration{
+						{Type: domain_schema.MigrationTypeMove, Field: "/street", To: "/address/street"},
+						{Type: domain_schema.MigrationTypeDefault, Field: "/address/country", Value: json.RawMessage(`"CH"`)},
+					}),
+				},
+			),
+		),
+	}
+}
+
+func schemaUserCreatedEvent(userID, schemaID string, revision uint64, data string) eventstore.Event {
+	return eventFromEventPusher(
+		schemauser.NewCreatedEvent(
+			context.Background(),
+			&schemauser.NewAggregate(userID, "org1").Aggregate,
+			schemaID,
+			revision,
+			json.RawMessage(data),
+		),
+	)
+}
+
+func TestCommands_MigrateSchemaUser(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Mi4aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Mi4bBb", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"schema not existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street"}`),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mi4cCc", "Errors.UserSchema.NotExists"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street"}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"migrated data invalid, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street", "city": "Zurich"}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid"),
+			},
+		},
+		{
+			"already current revision, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 2, `{"address": {"country": "CH"}}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"migrated, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street"}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+					expectPush(
+						schemauser.NewUpdatedEvent(
+							context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							[]schemauser.Changes{
+								schemauser.ChangeSchemaRevision(2),
+								schemauser.ChangeData(json.RawMessage(`{"address":{"country":"CH","street":"Main Street"}}`)),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.MigrateSchemaUser(tt.args.ctx, tt.args.resourceOwner, tt.args.id)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_MigrateSchemaUsers(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		schemaID string
+		limit    uint64
+	}
+	type res struct {
+		migrated  []string
+		failed    []string
+		remaining uint64
+		err       error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no schemaID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Mi5aAa", "Errors.UserSchema.ID.Missing"),
+			},
+		},
+		{
+			"schema not existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				schemaID: "schema1",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mi5bBb", "Errors.UserSchema.NotExists"),
+			},
+		},
+		{
+			"outdated users migrated with limit",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+					expectFilter(
+						schemaUserCreatedEvent("user4", "schema1", 1, `{"street": "Fourth Street"}`),
+						schemaUserCreatedEvent("user3", "schema1", 1, `{"street": "Third Street", "city": "Zurich"}`),
+						schemaUserCreatedEvent("user2", "schema1", 2, `{"address": {"country": "CH"}}`),
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street"}`),
+						schemaUserCreatedEvent("other", "schema2", 1, `{"street": "Other Street"}`),
+						schemaUserCreatedEvent("deleted", "schema1", 1, `{"street": "Deleted Street"}`),
+						eventFromEventPusher(
+							schemauser.NewDeletedEvent(context.Background(),
+								&schemauser.NewAggregate("deleted", "org1").Aggregate,
+							),
+						),
+					),
+					// user1
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{"street": "Main Street"}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+					expectPush(
+						schemauser.NewUpdatedEvent(
+							context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							[]schemauser.Changes{
+								schemauser.ChangeSchemaRevision(2),
+								schemauser.ChangeData(json.RawMessage(`{"address":{"country":"CH","street":"Main Street"}}`)),
+							},
+						),
+					),
+					// user3
+					expectFilter(
+						schemaUserCreatedEvent("user3", "schema1", 1, `{"street": "Third Street", "city": "Zurich"}`),
+					),
+					expectFilter(
+						migrationSchemaEvents()...,
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				schemaID: "schema1",
+				limit:    2,
+			},
+			res{
+				migrated:  []string{"user1"},
+				failed:    []string{"user3"},
+				remaining: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.MigrateSchemaUsers(tt.args.ctx, tt.args.schemaID, tt.args.limit)
+			require.ErrorIs(t, err, tt.res.err)
+			if tt.res.err != nil {
+				return
+			}
+			migrated := make([]string, len(got.Migrated))
+			for i, user := range got.Migrated {
+				migrated[i] = user.ID
+			}
+			failed := make([]string, len(got.Failed))
+			for i, failure := range got.Failed {
+				failed[i] = failure.User.ID
+				assert.Error(t, failure.Err)
+			}
+			assert.Equal(t, tt.res.migrated, migrated)
+			assert.Equal(t, tt.res.failed, failed)
+			assert.Equal(t, tt.res.remaining, got.Remaining)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -18,9 +19,10 @@ import (
 type UserV3WriteModel struct {
 	eventstore.WriteModel
 
-	PhoneWM bool
-	EmailWM bool
-	DataWM  bool
+	PhoneWM         bool
+	EmailWM         bool
+	DataWM          bool
+	AuthenticatorWM bool
 
 	SchemaID       string
 	SchemaRevision uint64
@@ -37,6 +39,14 @@ type UserV3WriteModel struct {
 
 	Data json.RawMessage
 
+	Usernames              map[string]*SchemaUserUsername
+	PasswordEncodedHash    string
+	PasswordChangeRequired bool
+	PasswordCode           *VerifyCode
+	PublicKeys             map[string]time.Time
+	PATs                   map[string]time.Time
+	WebAuthNs              map[string]*SchemaUserWebAuthN
+
 	Locked bool
 	State  domain.UserState
 
@@ -48,6 +58,20 @@ func (wm *UserV3WriteModel) GetWriteModel() *eventstore.WriteModel {
 	return &wm.WriteModel
 }
 
+type SchemaUserUsername struct {
+	Username               string
+	IsOrganizationSpecific bool
+}
+
+type SchemaUserWebAuthN struct {
+	Challenge        string
+	UserVerification domain.UserVerificationRequirement
+	RPID             string
+	Name             string
+	KeyID            []byte
+	State            domain.MFAState
+}
+
 type VerifyCode struct {
 	Code         *crypto.CryptoValue
 	CreationDate time.Time
@@ -80,6 +104,21 @@ func NewUserV3WriteModel(resourceOwner, userID string, checkPermission domain.Pe
 	}
 }
 
+func NewUserV3AuthenticatorWriteModel(resourceOwner, userID string, checkPermission domain.PermissionCheck) *UserV3WriteModel {
+	return &UserV3WriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		AuthenticatorWM: true,
+		Usernames:       make(map[string]*SchemaUserUsername),
+		PublicKeys:      make(map[string]time.Time),
+		PATs:            make(map[string]time.Time),
+		WebAuthNs:       make(map[string]*SchemaUserWebAuthN),
+		checkPermission: checkPermission,
+	}
+}
+
 func NewUserV3EmailWriteModel(resourceOwner, userID string, checkPermission domain.PermissionCheck) *UserV3WriteModel {
 	return &UserV3WriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -170,6 +209,49 @@ func (wm *UserV3WriteModel) Reduce() error {
 			wm.State = domain.UserStateInactive
 		case *schemauser.ActivatedEvent:
 			wm.State = domain.UserStateActive
+		case *schemauser.UsernameAddedEvent:
+			wm.Usernames[e.ID] = &SchemaUserUsername{
+				Username:               e.Username,
+				IsOrganizationSpecific: e.IsOrganizationSpecific,
+			}
+		case *schemauser.UsernameRemovedEvent:
+			delete(wm.Usernames, e.ID)
+		case *schemauser.PasswordChangedEvent:
+			wm.PasswordEncodedHash = e.EncodedHash
+			wm.PasswordChangeRequired = e.ChangeRequired
+			wm.PasswordCode = nil
+		case *schemauser.PasswordCodeAddedEvent:
+			wm.PasswordCode = &VerifyCode{
+				Code:         e.Code,
+				CreationDate: e.CreationDate(),
+				Expiry:       e.Expiry,
+			}
+		case *schemauser.PublicKeyAddedEvent:
+			wm.PublicKeys[e.ID] = e.ExpirationDate
+		case *schemauser.PublicKeyRemovedEvent:
+			delete(wm.PublicKeys, e.ID)
+		case *schemauser.PATAddedEvent:
+			wm.PATs[e.ID] = e.ExpirationDate
+		case *schemauser.PATRemovedEvent:
+			delete(wm.PATs, e.ID)
+		case *schemauser.WebAuthNAddedEvent:
+			wm.WebAuthNs[e.ID] = &SchemaUserWebAuthN{
+				Challenge:        e.Challenge,
+				UserVerification: e.UserVerification,
+				RPID:             e.RPID,
+				State:            domain.MFAStateNotReady,
+			}
+		case *schemauser.WebAuthNVerifiedEvent:
+			webAuthN, ok := wm.WebAuthNs[e.ID]
+			if !ok {
+				continue
+			}
+			webAuthN.Name = e.Name
+			webAuthN.KeyID = e.KeyID
+			webAuthN.RPID = e.RPID
+			webAuthN.State = domain.MFAStateReady
+		case *schemauser.WebAuthNRemovedEvent:
+			delete(wm.WebAuthNs, e.ID)
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -209,6 +291,21 @@ func (wm *UserV3WriteModel) Query() *eventstore.SearchQueryBuilder {
 			schemauser.PhoneVerificationFailedType,
 		)
 	}
+	if wm.AuthenticatorWM {
+		eventtypes = append(eventtypes,
+			schemauser.UsernameAddedType,
+			schemauser.UsernameRemovedType,
+			schemauser.PasswordChangedType,
+			schemauser.PasswordCodeAddedType,
+			schemauser.PublicKeyAddedType,
+			schemauser.PublicKeyRemovedType,
+			schemauser.PATAddedType,
+			schemauser.PATRemovedType,
+			schemauser.WebAuthNAddedType,
+			schemauser.WebAuthNVerifiedType,
+			schemauser.WebAuthNRemovedType,
+		)
+	}
 	return builder.AddQuery().
 		AggregateTypes(schemauser.AggregateType).
 		AggregateIDs(wm.AggregateID).
@@ -278,31 +375,44 @@ func (wm *UserV3WriteModel) getSchemaRoleForWrite(ctx context.Context, resourceO
 	return domain_schema.RoleOwner, nil
 }
 
-func (wm *UserV3WriteModel) validateData(ctx context.Context, data []byte, schemaWM *UserSchemaWriteModel) (string, uint64, error) {
+func (wm *UserV3WriteModel) validateData(ctx context.Context, data []byte, schemaWM *UserSchemaWriteModel) (string, uint64, json.RawMessage, error) {
 	// get role for permission check in schema through extension
 	role, err := wm.getSchemaRoleForWrite(ctx, wm.ResourceOwner, wm.AggregateID)
 	if err != nil {
-		return "", 0, err
+		return "", 0, nil, err
 	}
 
 	schema, err := domain_schema.NewSchema(role, bytes.NewReader(schemaWM.Schema))
 	if err != nil {
-		return "", 0, err
+		return "", 0, nil, err
 	}
 
 	// if data not changed but a new schema or revision should be used
 	if data == nil {
-		data = wm.Data
+		data, err = wm.migratedData(schemaWM)
+		if err != nil {
+			return "", 0, nil, err
+		}
 	}
 	var v interface{}
 	if err := json.Unmarshal(data, &v); err != nil {
-		return "", 0, zerrors.ThrowInvalidArgument(nil, "COMMAND-7o3ZGxtXUz", "Errors.User.Invalid")
+		return "", 0, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-7o3ZGxtXUz", "Errors.User.Invalid")
 	}
 
 	if err := schema.Validate(v); err != nil {
-		return "", 0, zerrors.ThrowPreconditionFailed(nil, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid")
+		return "", 0, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-SlKXqLSeL6", "Errors.UserSchema.Data.Invalid")
+	}
+	return schemaWM.AggregateID, schemaWM.SchemaRevision, data, nil
+}
+
+// migratedData returns the data of the user with the migrations of the schema applied,
+// which were declared after the revision of the user.
+// Data of users of another schema is returned as is.
+func (wm *UserV3WriteModel) migratedData(schemaWM *UserSchemaWriteModel) (json.RawMessage, error) {
+	if wm.SchemaID != schemaWM.AggregateID || wm.SchemaRevision >= schemaWM.SchemaRevision {
+		return wm.Data, nil
 	}
-	return schemaWM.AggregateID, schemaWM.SchemaRevision, nil
+	return domain_schema.Migrate(wm.Data, schemaWM.MigrationsFrom(wm.SchemaRevision))
 }
 
 func (wm *UserV3WriteModel) NewUpdate(
@@ -322,14 +432,14 @@ func (wm *UserV3WriteModel) NewUpdate(
 	}
 	events := make([]eventstore.Command, 0)
 	if user != nil {
-		schemaID, schemaRevision, err := wm.validateData(ctx, user.Data, schemaWM)
+		schemaID, schemaRevision, data, err := wm.validateData(ctx, user.Data, schemaWM)
 		if err != nil {
 			return nil, "", "", err
 		}
 		userEvents := wm.newUpdatedEvents(ctx,
 			schemaID,
 			schemaRevision,
-			user.Data,
+			data,
 		)
 		events = append(events, userEvents...)
 	}
@@ -395,8 +505,23 @@ func (wm *UserV3WriteModel) NewDelete(
 	if err := wm.checkPermissionDelete(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
 		return nil, err
 	}
-	return []eventstore.Command{schemauser.NewDeletedEvent(ctx, UserV3AggregateFromWriteModel(&wm.WriteModel))}, nil
-
+	events := make([]eventstore.Command, 0, len(wm.Usernames)+1)
+	// release the usernames, so they can be used by other users
+	usernameIDs := make([]string, 0, len(wm.Usernames))
+	for id := range wm.Usernames {
+		usernameIDs = append(usernameIDs, id)
+	}
+	slices.Sort(usernameIDs)
+	for _, id := range usernameIDs {
+		username := wm.Usernames[id]
+		events = append(events, schemauser.NewUsernameRemovedEvent(ctx,
+			UserV3AggregateFromWriteModel(&wm.WriteModel),
+			id,
+			username.Username,
+			username.IsOrganizationSpecific,
+		))
+	}
+	return append(events, schemauser.NewDeletedEvent(ctx, UserV3AggregateFromWriteModel(&wm.WriteModel))), nil
 }
 
 func UserV3AggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SetSchemaUserPassword struct {
+	ResourceOwner string
+	ID            string
+
+	// Either you have to have permission, a password code or the current password to change
+	Password *Password
+}
+
+func (s *SetSchemaUserPassword) Validate(c *Commands) error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pw1aAa", "Errors.IDMissing")
+	}
+	if s.Password == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pw1bBb", "Errors.User.Password.Empty")
+	}
+	return s.Password.Validate(c.userPasswordHasher)
+}
+
+func (c *Commands) SetSchemaUserPassword(ctx context.Context, set *SetSchemaUserPassword) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := set.Validate(c); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, set.ResourceOwner, set.ID, domain.AuthenticatorTypePassword)
+	if err != nil {
+		return nil, err
+	}
+	encodedPassword := set.Password.EncodedPasswordHash
+	newEncodedPassword, err := c.schemaUserPasswordVerification(writeModel, set.Password)(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// use the new hash from the verification in case there is one (e.g. existing pw check)
+	if newEncodedPassword != "" {
+		encodedPassword = newEncodedPassword
+	}
+	if set.Password.Password != "" {
+		if err := c.checkPasswordComplexity(ctx, set.Password.Password, writeModel.ResourceOwner); err != nil {
+			return nil, err
+		}
+	}
+	if encodedPassword == "" {
+		_, span := tracing.NewNamedSpan(ctx, "passwap.Hash")
+		encodedPassword, err = c.userPasswordHasher.Hash(set.Password.Password)
+		span.EndWithError(err)
+		if err = convertPasswapErr(err); err != nil {
+			return nil, err
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPasswordChangedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			encodedPassword,
+			set.Password.ChangeRequired,
+		),
+	)
+}
+
+// schemaUserPasswordVerification returns the [setPasswordVerification] depending on the provided password code or current password.
+// If none is provided, the caller needs the permission to change the user.
+func (c *Commands) schemaUserPasswordVerification(writeModel *UserV3WriteModel, password *Password) setPasswordVerification {
+	switch {
+	case password.PasswordCode != "":
+		if writeModel.PasswordCode == nil {
+			return func(context.Context) (string, error) {
+				return "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pw2aAa", "Errors.User.Code.NotFound")
+			}
+		}
+		return c.setPasswordWithVerifyCode(
+			writeModel.PasswordCode.CreationDate,
+			writeModel.PasswordCode.Expiry,
+			writeModel.PasswordCode.Code,
+			"",
+			"",
+			password.PasswordCode,
+		)
+	case password.OldPassword != "":
+		return c.checkCurrentPassword(password.Password, password.EncodedPasswordHash, password.OldPassword, writeModel.PasswordEncodedHash)
+	default:
+		return func(ctx context.Context) (string, error) {
+			return "", writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID)
+		}
+	}
+}
+
+type RequestSchemaUserPasswordReset struct {
+	ResourceOwner string
+	ID            string
+
+	NotificationType domain.NotificationType
+	URLTemplate      string
+	ReturnCode       bool
+
+	// PlainCode is set if ReturnCode was requested
+	PlainCode *string
+}
+
+func (s *RequestSchemaUserPasswordReset) Valid() error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pw3aAa", "Errors.IDMissing")
+	}
+	if s.URLTemplate != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, s.URLTemplate, s.ID, "code", "orgID"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RequestSchemaUserPasswordReset creates a code to set a new password of the user.
+// If the code is not returned, the user is notified with the requested notification type.
+func (c *Commands) RequestSchemaUserPasswordReset(ctx context.Context, request *RequestSchemaUserPasswordReset) (*domain.ObjectDetails, error) {
+	if err := request.Valid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, request.ResourceOwner, request.ID, domain.AuthenticatorTypePassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPasswordCodeAddedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			code.Crypted,
+			code.Expiry,
+			request.NotificationType,
+			request.URLTemplate,
+			request.ReturnCode,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if request.ReturnCode {
+		request.PlainCode = &code.Plain
+	}
+	return details, nil
+}
@@ -0,0 +1,526 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func passwordComplexityPolicyEvent() eventstore.Event {
+	return eventFromEventPusher(
+		org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+			&org.NewAggregate("org1").Aggregate,
+			1,
+			false,
+			false,
+			false,
+			false,
+		),
+	)
+}
+
+func TestCommands_SetSchemaUserPassword(t *testing.T) {
+	type fields struct {
+		eventstore         func(t *testing.T) *eventstore.Eventstore
+		checkPermission    domain.PermissionCheck
+		userPasswordHasher *crypto.Hasher
+		userEncryption     crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		set *SetSchemaUserPassword
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:         expectEventstore(),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{Password: &Password{Password: "password"}},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pw1aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"no password, error",
+			fields{
+				eventstore:         expectEventstore(),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{}},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-3klek4sbns", "Errors.User.Password.Empty"),
+			},
+		},
+		{
+			"authenticator not allowed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeUsername),
+					),
+				),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "password"}},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "password"}},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"password set with permission, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectFilter(
+						passwordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordChangedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							true,
+						),
+					),
+				),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "password", ChangeRequired: true}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"encoded password set, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectPush(
+						schemauser.NewPasswordChangedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+						),
+					),
+				),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{EncodedPasswordHash: "$plain$x$password"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"wrong current password, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewPasswordChangedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+							),
+						),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "newpassword", OldPassword: "wrong"}},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-3M0fs", "Errors.User.Password.Invalid"),
+			},
+		},
+		{
+			"password changed with current password, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewPasswordChangedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+							),
+						),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectFilter(
+						passwordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordChangedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$newpassword",
+							false,
+						),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "newpassword", OldPassword: "password"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"no password code, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "password", PasswordCode: "code"}},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pw2aAa", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			"password set with code, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusherWithCreationDateNow(
+							schemauser.NewPasswordCodeAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("code"),
+								},
+								time.Hour,
+								domain.NotificationTypeEmail,
+								"",
+								false,
+							),
+						),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectFilter(
+						passwordComplexityPolicyEvent(),
+					),
+					expectPush(
+						schemauser.NewPasswordChangedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+						),
+					),
+				),
+				checkPermission:    newMockPermissionCheckNotAllowed(),
+				userPasswordHasher: mockPasswordHasher("x"),
+				userEncryption:     crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				set: &SetSchemaUserPassword{ID: "user1", Password: &Password{Password: "password", PasswordCode: "code"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:         tt.fields.eventstore(t),
+				checkPermission:    tt.fields.checkPermission,
+				userPasswordHasher: tt.fields.userPasswordHasher,
+				userEncryption:     tt.fields.userEncryption,
+			}
+			details, err := c.SetSchemaUserPassword(tt.args.ctx, tt.args.set)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RequestSchemaUserPasswordReset(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		newCode         encrypedCodeFunc
+	}
+	type args struct {
+		ctx     context.Context
+		request *RequestSchemaUserPasswordReset
+	}
+	type res struct {
+		details   *domain.ObjectDetails
+		plainCode string
+		err       error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:     authz.NewMockContext("instanceID", "", ""),
+				request: &RequestSchemaUserPasswordReset{},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pw3aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"invalid url template, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:     authz.NewMockContext("instanceID", "", ""),
+				request: &RequestSchemaUserPasswordReset{ID: "user1", URLTemplate: "{{"},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:     authz.NewMockContext("instanceID", "", ""),
+				request: &RequestSchemaUserPasswordReset{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"code sent, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectPush(
+						schemauser.NewPasswordCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("code"),
+							},
+							time.Hour,
+							domain.NotificationTypeSms,
+							"https://example.com/password/changey?userID={{.UserID}}&code={{.Code}}",
+							false,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				newCode:         mockEncryptedCode("code", time.Hour),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				request: &RequestSchemaUserPasswordReset{
+					ID:               "user1",
+					NotificationType: domain.NotificationTypeSms,
+					URLTemplate:      "https://example.com/password/changey?userID={{.UserID}}&code={{.Code}}",
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			"code returned, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+					expectPush(
+						schemauser.NewPasswordCodeAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("code"),
+							},
+							time.Hour,
+							domain.NotificationTypeEmail,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				newCode:         mockEncryptedCode("code", time.Hour),
+			},
+			args{
+				ctx:     authz.NewMockContext("instanceID", "", ""),
+				request: &RequestSchemaUserPasswordReset{ID: "user1", ReturnCode: true},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				plainCode: "code",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				newEncryptedCode: tt.fields.newCode,
+			}
+			details, err := c.RequestSchemaUserPasswordReset(tt.args.ctx, tt.args.request)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+			if tt.res.plainCode != "" {
+				assert.Equal(t, tt.res.plainCode, *tt.args.request.PlainCode)
+			} else {
+				assert.Nil(t, tt.args.request.PlainCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddSchemaUserPAT struct {
+	ResourceOwner string
+	ID            string
+
+	ExpirationDate time.Time
+	Scopes         []string
+
+	// PATID and Token are set after the personal access token was added
+	PATID string
+	Token string
+}
+
+func (pat *AddSchemaUserPAT) Valid() (err error) {
+	if pat.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa1aAa", "Errors.IDMissing")
+	}
+	pat.ExpirationDate, err = domain.ValidateExpirationDate(pat.ExpirationDate)
+	return err
+}
+
+func (c *Commands) AddSchemaUserPAT(ctx context.Context, pat *AddSchemaUserPAT) (*domain.ObjectDetails, error) {
+	if err := pat.Valid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, pat.ResourceOwner, pat.ID, domain.AuthenticatorTypePersonalAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	patID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	token, err := createToken(c.keyAlgorithm, patID, writeModel.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPATAddedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			patID,
+			pat.ExpirationDate,
+			pat.Scopes,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pat.PATID = patID
+	pat.Token = token
+	return details, nil
+}
+
+func (c *Commands) RemoveSchemaUserPAT(ctx context.Context, resourceOwner, id, patID string) (*domain.ObjectDetails, error) {
+	if id == "" || patID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa2aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pa2bBb", "Errors.User.NotFound")
+	}
+	if _, ok := writeModel.PATs[patID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pa2cCc", "Errors.User.PAT.NotFound")
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPATRemovedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			patID,
+		),
+	)
+}
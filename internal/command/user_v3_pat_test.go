@@ -0,0 +1,285 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddSchemaUserPAT(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+		keyAlgorithm    crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		pat *AddSchemaUserPAT
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		patID   string
+		token   string
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				pat: &AddSchemaUserPAT{},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa1aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"authenticator not allowed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeAuthenticationKey),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				pat: &AddSchemaUserPAT{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePersonalAccessToken),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				pat: &AddSchemaUserPAT{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"pat added, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePersonalAccessToken),
+					),
+					expectPush(
+						schemauser.NewPATAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"token1",
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+							[]string{"openid"},
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "token1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+				keyAlgorithm:    crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				pat: &AddSchemaUserPAT{ID: "user1", Scopes: []string{"openid"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				patID: "token1",
+				token: base64.RawURLEncoding.EncodeToString([]byte("token1:user1")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+				keyAlgorithm:    tt.fields.keyAlgorithm,
+			}
+			details, err := c.AddSchemaUserPAT(tt.args.ctx, tt.args.pat)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+				assert.Equal(t, tt.res.patID, tt.args.pat.PATID)
+				assert.Equal(t, tt.res.token, tt.args.pat.Token)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveSchemaUserPAT(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx   context.Context
+		id    string
+		patID string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no patID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa2aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"pat not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:   authz.NewMockContext("instanceID", "", ""),
+				id:    "user1",
+				patID: "token1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Pa2cCc", "Errors.User.PAT.NotFound"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewPATAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+								[]string{"openid"},
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:   authz.NewMockContext("instanceID", "", ""),
+				id:    "user1",
+				patID: "token1",
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"pat removed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewPATAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"token1",
+								time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+								[]string{"openid"},
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewPATRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"token1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:   authz.NewMockContext("instanceID", "", ""),
+				id:    "user1",
+				patID: "token1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveSchemaUserPAT(tt.args.ctx, "", tt.args.id, tt.args.patID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
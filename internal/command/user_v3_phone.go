@@ -42,7 +42,7 @@ func (c *Commands) ChangeSchemaUserPhone(ctx context.Context, user *ChangeSchema
 	events, plainCode, err := writeModel.NewPhoneUpdate(ctx,
 		user.Phone,
 		func(ctx context.Context) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+			return c.newPhoneCode(ctx, c.eventstore.Filter, writeModel.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		},
 	)
 	if err != nil {
@@ -93,7 +93,7 @@ func (c *Commands) ResendSchemaUserPhoneCode(ctx context.Context, user *ResendSc
 
 	events, plainCode, err := writeModel.NewResendPhoneCode(ctx,
 		func(ctx context.Context) (*EncryptedCode, string, error) {
-			return c.newPhoneCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
+			return c.newPhoneCode(ctx, c.eventstore.Filter, writeModel.ResourceOwner, domain.SecretGeneratorTypeVerifyPhoneCode, c.userEncryption, c.defaultSecretGenerators.PhoneVerificationCode) //nolint:staticcheck
 		},
 		user.ReturnCode,
 	)
@@ -381,6 +381,7 @@ func TestCommands_ChangeSchemaUserPhone(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
@@ -1190,6 +1191,7 @@ func TestCommands_ResendSchemaUserPhoneCode(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						eventFromEventPusher(
 							schemauser.NewPhoneCodeAddedEvent(
@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddSchemaUserPublicKey struct {
+	ResourceOwner string
+	ID            string
+
+	Type           domain.AuthNKeyType
+	ExpirationDate time.Time
+	// PublicKey can be provided, otherwise a key pair is generated
+	PublicKey []byte
+
+	// PublicKeyID is set after the public key was added
+	PublicKeyID string
+	// PrivateKey is set if the key pair was generated
+	PrivateKey []byte
+}
+
+func (key *AddSchemaUserPublicKey) SetPublicKey(publicKey []byte) {
+	key.PublicKey = publicKey
+}
+
+func (key *AddSchemaUserPublicKey) SetPrivateKey(privateKey []byte) {
+	key.PrivateKey = privateKey
+}
+
+func (key *AddSchemaUserPublicKey) GetExpirationDate() time.Time {
+	return key.ExpirationDate
+}
+
+func (key *AddSchemaUserPublicKey) SetExpirationDate(t time.Time) {
+	key.ExpirationDate = t
+}
+
+// Detail returns the generated private key in the format of the key type.
+func (key *AddSchemaUserPublicKey) Detail() ([]byte, error) {
+	if len(key.PrivateKey) == 0 {
+		return nil, nil
+	}
+	if key.Type == domain.AuthNKeyTypeJSON {
+		return domain.MachineKeyMarshalJSON(key.PublicKeyID, key.PrivateKey, key.ExpirationDate, key.ID)
+	}
+	return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pk1aAa", "Errors.Internal")
+}
+
+func (key *AddSchemaUserPublicKey) Valid() (err error) {
+	if key.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk1bBb", "Errors.IDMissing")
+	}
+	if len(key.PublicKey) > 0 {
+		if _, err := crypto.BytesToPublicKey(key.PublicKey); err != nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk1cCc", "Errors.User.Machine.Key.Invalid")
+		}
+	}
+	if key.Type == domain.AuthNKeyTypeNONE {
+		key.Type = domain.AuthNKeyTypeJSON
+	}
+	key.ExpirationDate, err = domain.ValidateExpirationDate(key.ExpirationDate)
+	return err
+}
+
+func (c *Commands) AddSchemaUserPublicKey(ctx context.Context, key *AddSchemaUserPublicKey) (*domain.ObjectDetails, error) {
+	if err := key.Valid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, key.ResourceOwner, key.ID, domain.AuthenticatorTypeAuthenticationKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	if len(key.PublicKey) == 0 {
+		if err := domain.SetNewAuthNKeyPair(key, c.machineKeySize); err != nil {
+			return nil, err
+		}
+	}
+	publicKeyID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPublicKeyAddedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			publicKeyID,
+			key.Type,
+			key.ExpirationDate,
+			key.PublicKey,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	key.PublicKeyID = publicKeyID
+	return details, nil
+}
+
+func (c *Commands) RemoveSchemaUserPublicKey(ctx context.Context, resourceOwner, id, publicKeyID string) (*domain.ObjectDetails, error) {
+	if id == "" || publicKeyID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk2aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pk2bBb", "Errors.User.NotFound")
+	}
+	if _, ok := writeModel.PublicKeys[publicKeyID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pk2cCc", "Errors.User.Machine.Key.NotFound")
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewPublicKeyRemovedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			publicKeyID,
+		),
+	)
+}
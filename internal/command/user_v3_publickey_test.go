@@ -0,0 +1,302 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddSchemaUserPublicKey(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		key *AddSchemaUserPublicKey
+	}
+	type res struct {
+		details     *domain.ObjectDetails
+		publicKeyID string
+		err         error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{PublicKey: []byte(fakePubkey)},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk1bBb", "Errors.IDMissing"),
+			},
+		},
+		{
+			"invalid public key, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{ID: "user1", PublicKey: []byte("incorrect")},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk1cCc", "Errors.User.Machine.Key.Invalid"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{ID: "user1", PublicKey: []byte(fakePubkey)},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Au1aAa", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"authenticator not allowed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePersonalAccessToken),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{ID: "user1", PublicKey: []byte(fakePubkey)},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeAuthenticationKey),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{ID: "user1", PublicKey: []byte(fakePubkey)},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"public key added, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeAuthenticationKey),
+					),
+					expectPush(
+						schemauser.NewPublicKeyAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"key1",
+							domain.AuthNKeyTypeJSON,
+							time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+							[]byte(fakePubkey),
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "key1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				key: &AddSchemaUserPublicKey{
+					ID:             "user1",
+					ExpirationDate: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+					PublicKey:      []byte(fakePubkey),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				publicKeyID: "key1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddSchemaUserPublicKey(tt.args.ctx, tt.args.key)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+				assert.Equal(t, tt.res.publicKeyID, tt.args.key.PublicKeyID)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveSchemaUserPublicKey(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx         context.Context
+		id          string
+		publicKeyID string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no publicKeyID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pk2aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:         authz.NewMockContext("instanceID", "", ""),
+				id:          "user1",
+				publicKeyID: "key1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Pk2bBb", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"public key not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:         authz.NewMockContext("instanceID", "", ""),
+				id:          "user1",
+				publicKeyID: "key1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Pk2cCc", "Errors.User.Machine.Key.NotFound"),
+			},
+		},
+		{
+			"public key removed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewPublicKeyAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"key1",
+								domain.AuthNKeyTypeJSON,
+								time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC),
+								[]byte(fakePubkey),
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewPublicKeyRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"key1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:         authz.NewMockContext("instanceID", "", ""),
+				id:          "user1",
+				publicKeyID: "key1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveSchemaUserPublicKey(tt.args.ctx, "", tt.args.id, tt.args.publicKeyID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
@@ -924,6 +925,7 @@ func TestCommands_CreateSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewCreatedEvent(
 							context.Background(),
@@ -1205,6 +1207,68 @@ func TestCommandSide_DeleteSchemaUser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "remove user with usernames, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							schemauser.NewCreatedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"schema",
+								1,
+								json.RawMessage(`{
+						"name": "user"
+					}`),
+							),
+						),
+						eventFromEventPusher(
+							schemauser.NewUsernameAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username2",
+								"second",
+								false,
+							),
+						),
+						eventFromEventPusher(
+							schemauser.NewUsernameAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"first",
+								true,
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewUsernameRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"first",
+							true,
+						),
+						schemauser.NewUsernameRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username2",
+							"second",
+							false,
+						),
+						schemauser.NewDeletedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "remove user, no permission",
 			fields: fields{
@@ -2601,6 +2665,89 @@ func TestCommands_ChangeSchemaUser(t *testing.T) {
 				},
 			},
 		},
+		{
+			"user updated, migrated to new revision",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							schemauser.NewCreatedEvent(
+								context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"id1",
+								1,
+								json.RawMessage(`{
+						"name1": "user1"
+					}`),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							schema.NewCreatedEvent(
+								context.Background(),
+								&schema.NewAggregate("id1", "instanceID").Aggregate,
+								"type",
+								json.RawMessage(`{
+								"$schema": "urn:zitadel:schema:v1",
+								"type": "object",
+								"properties": {
+									"name1": {
+										"type": "string"
+									}
+								}
+							}`),
+								[]domain.AuthenticatorType{domain.AuthenticatorTypeUsername},
+							),
+						),
+						eventFromEventPusher(
+							schema.NewUpdatedEvent(
+								context.Background(),
+								&schema.NewAggregate("id1", "instanceID").Aggregate,
+								[]schema.Changes{
+									schema.IncreaseRevision(1),
+									schema.ChangeSchema(json.RawMessage(`{
+								"$schema": "urn:zitadel:schema:v1",
+								"type": "object",
+								"properties": {
+									"name2": {
+										"type": "string"
+									}
+								}
+							}`)),
+									schema.ChangeMigrations([]*domain_schema.Migration{
+										{Type: domain_schema.MigrationTypeRename, Field: "/name1", To: "name2"},
+									}),
+								},
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewUpdatedEvent(
+							context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							[]schemauser.Changes{
+								schemauser.ChangeSchemaRevision(2),
+								schemauser.ChangeData(json.RawMessage(`{"name2":"user1"}`)),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				user: &ChangeSchemaUser{
+					ID:         "user1",
+					SchemaUser: &SchemaUser{},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			"user updated, new schema and revision",
 			fields{
@@ -3408,6 +3555,7 @@ func TestCommands_ChangeSchemaUser(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 					expectPush(
 						schemauser.NewPhoneUpdatedEvent(context.Background(),
 							&schemauser.NewAggregate("user1", "org1").Aggregate,
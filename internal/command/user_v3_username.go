@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddSchemaUserUsername struct {
+	ResourceOwner string
+	ID            string
+
+	Username               string
+	IsOrganizationSpecific bool
+
+	// UsernameID is set after the username was added
+	UsernameID string
+}
+
+func (s *AddSchemaUserUsername) Valid() error {
+	if s.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Un1aAa", "Errors.IDMissing")
+	}
+	s.Username = strings.TrimSpace(s.Username)
+	if s.Username == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Un1bBb", "Errors.User.Username.Empty")
+	}
+	return nil
+}
+
+func (c *Commands) AddSchemaUserUsername(ctx context.Context, username *AddSchemaUserUsername) (*domain.ObjectDetails, error) {
+	if err := username.Valid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, username.ResourceOwner, username.ID, domain.AuthenticatorTypeUsername)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	usernameID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewUsernameAddedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			usernameID,
+			username.Username,
+			username.IsOrganizationSpecific,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	username.UsernameID = usernameID
+	return details, nil
+}
+
+func (c *Commands) RemoveSchemaUserUsername(ctx context.Context, resourceOwner, id, usernameID string) (*domain.ObjectDetails, error) {
+	if id == "" || usernameID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Un2aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Un2bBb", "Errors.User.NotFound")
+	}
+	username, ok := writeModel.Usernames[usernameID]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Un2cCc", "Errors.User.Username.NotFound")
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewUsernameRemovedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			usernameID,
+			username.Username,
+			username.IsOrganizationSpecific,
+		),
+	)
+}
@@ -0,0 +1,391 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user/schema"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func authenticatorSchemaCreatedEvent(authenticators ...domain.AuthenticatorType) eventstore.Event {
+	return eventFromEventPusher(
+		schema.NewCreatedEvent(
+			context.Background(),
+			&schema.NewAggregate("schema1", "instanceID").Aggregate,
+			"type",
+			json.RawMessage(`{}`),
+			authenticators,
+		),
+	)
+}
+
+func TestCommands_AddSchemaUserUsername(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		username *AddSchemaUserUsername
+	}
+	type res struct {
+		details    *domain.ObjectDetails
+		usernameID string
+		err        error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{Username: "username"},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Un1aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"empty username, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{ID: "user1", Username: " "},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Un1bBb", "Errors.User.Username.Empty"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{ID: "user1", Username: "username"},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Au1aAa", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"authenticator not allowed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{ID: "user1", Username: "username"},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeUsername),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{ID: "user1", Username: "username"},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"username added, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeUsername),
+					),
+					expectPush(
+						schemauser.NewUsernameAddedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							false,
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "username1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      authz.NewMockContext("instanceID", "", ""),
+				username: &AddSchemaUserUsername{ID: "user1", Username: " username "},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				usernameID: "username1",
+			},
+		},
+		{
+			"organization specific username added as self, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeUsername),
+					),
+					expectPush(
+						schemauser.NewUsernameAddedEvent(authz.NewMockContext("instanceID", "org1", "user1"),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							true,
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "username1"),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "org1", "user1"),
+				username: &AddSchemaUserUsername{
+					ID:                     "user1",
+					Username:               "username",
+					IsOrganizationSpecific: true,
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				usernameID: "username1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddSchemaUserUsername(tt.args.ctx, tt.args.username)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+				assert.Equal(t, tt.res.usernameID, tt.args.username.UsernameID)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveSchemaUserUsername(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx        context.Context
+		id         string
+		usernameID string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no usernameID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Un2aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				usernameID: "username1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Un2bBb", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"username not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewUsernameAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"username",
+								false,
+							),
+						),
+						eventFromEventPusher(
+							schemauser.NewUsernameRemovedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"username",
+								false,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				usernameID: "username1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Un2cCc", "Errors.User.Username.NotFound"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewUsernameAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"username",
+								false,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				usernameID: "username1",
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"username removed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewUsernameAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"username",
+								true,
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewUsernameRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"username1",
+							"username",
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				usernameID: "username1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveSchemaUserUsername(tt.args.ctx, "", tt.args.id, tt.args.usernameID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
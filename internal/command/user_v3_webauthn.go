@@ -0,0 +1,185 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type StartSchemaUserWebAuthNRegistration struct {
+	ResourceOwner string
+	ID            string
+
+	// RPID is the domain the WebAuthN authenticator is registered for
+	RPID                    string
+	AuthenticatorAttachment domain.AuthenticatorAttachment
+	UserVerification        domain.UserVerificationRequirement
+
+	// WebAuthNID and CredentialCreationData are set after the registration was started
+	WebAuthNID             string
+	CredentialCreationData []byte
+}
+
+func (c *Commands) StartSchemaUserWebAuthNRegistration(ctx context.Context, registration *StartSchemaUserWebAuthNRegistration) (*domain.ObjectDetails, error) {
+	if registration.ID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa1aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, registration.ResourceOwner, registration.ID, domain.AuthenticatorTypeWebAuthN)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	webAuthNID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	accountName := writeModel.webAuthNAccountName()
+	webAuthN, err := c.webauthnConfig.BeginRegistration(ctx,
+		schemaUserToWebAuthNHuman(writeModel.AggregateID, accountName),
+		accountName,
+		registration.AuthenticatorAttachment,
+		registration.UserVerification,
+		registration.RPID,
+		writeModel.webAuthNTokens()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewWebAuthNAddedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			webAuthNID,
+			webAuthN.Challenge,
+			webAuthN.UserVerification,
+			webAuthN.RPID,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	registration.WebAuthNID = webAuthNID
+	registration.CredentialCreationData = webAuthN.CredentialCreationData
+	return details, nil
+}
+
+type VerifySchemaUserWebAuthNRegistration struct {
+	ResourceOwner string
+	ID            string
+
+	WebAuthNID          string
+	Name                string
+	PublicKeyCredential []byte
+}
+
+func (c *Commands) VerifySchemaUserWebAuthNRegistration(ctx context.Context, verify *VerifySchemaUserWebAuthNRegistration) (*domain.ObjectDetails, error) {
+	if verify.ID == "" || verify.WebAuthNID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa2aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModel(ctx, verify.ResourceOwner, verify.ID, domain.AuthenticatorTypeWebAuthN)
+	if err != nil {
+		return nil, err
+	}
+	webAuthN, ok := writeModel.WebAuthNs[verify.WebAuthNID]
+	if !ok || webAuthN.State != domain.MFAStateNotReady {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Wa2bBb", "Errors.User.WebAuthN.NotFound")
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	accountName := writeModel.webAuthNAccountName()
+	verified, err := c.webauthnConfig.FinishRegistration(ctx,
+		schemaUserToWebAuthNHuman(writeModel.AggregateID, accountName),
+		&domain.WebAuthNToken{
+			ObjectRoot:       models.ObjectRoot{AggregateID: writeModel.AggregateID},
+			WebAuthNTokenID:  verify.WebAuthNID,
+			Challenge:        webAuthN.Challenge,
+			UserVerification: webAuthN.UserVerification,
+			RPID:             webAuthN.RPID,
+		},
+		verify.Name,
+		verify.PublicKeyCredential,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewWebAuthNVerifiedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			verify.WebAuthNID,
+			verified.KeyID,
+			verified.PublicKey,
+			verified.AttestationType,
+			verified.AAGUID,
+			verified.SignCount,
+			verified.WebAuthNTokenName,
+			verified.RPID,
+		),
+	)
+}
+
+func (c *Commands) RemoveSchemaUserWebAuthN(ctx context.Context, resourceOwner, id, webAuthNID string) (*domain.ObjectDetails, error) {
+	if id == "" || webAuthNID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa3aAa", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSchemaUserAuthenticatorWriteModelByID(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Wa3bBb", "Errors.User.NotFound")
+	}
+	if _, ok := writeModel.WebAuthNs[webAuthNID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Wa3cCc", "Errors.User.WebAuthN.NotFound")
+	}
+	if err := writeModel.checkPermissionWrite(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		schemauser.NewWebAuthNRemovedEvent(ctx,
+			UserV3AggregateFromWriteModel(&writeModel.WriteModel),
+			webAuthNID,
+		),
+	)
+}
+
+// webAuthNAccountName returns the username with the lowest ID or the ID of the user if it has no username.
+func (wm *UserV3WriteModel) webAuthNAccountName() string {
+	ids := make([]string, 0, len(wm.Usernames))
+	for id := range wm.Usernames {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return wm.AggregateID
+	}
+	slices.Sort(ids)
+	return wm.Usernames[ids[0]].Username
+}
+
+// webAuthNTokens returns the verified WebAuthN authenticators, which are excluded on a new registration.
+func (wm *UserV3WriteModel) webAuthNTokens() []*domain.WebAuthNToken {
+	tokens := make([]*domain.WebAuthNToken, 0, len(wm.WebAuthNs))
+	for id, webAuthN := range wm.WebAuthNs {
+		tokens = append(tokens, &domain.WebAuthNToken{
+			WebAuthNTokenID:   id,
+			KeyID:             webAuthN.KeyID,
+			RPID:              webAuthN.RPID,
+			State:             webAuthN.State,
+			WebAuthNTokenName: webAuthN.Name,
+		})
+	}
+	return tokens
+}
+
+func schemaUserToWebAuthNHuman(userID, accountName string) *domain.Human {
+	return &domain.Human{
+		ObjectRoot: models.ObjectRoot{AggregateID: userID},
+		Username:   accountName,
+		Profile:    &domain.Profile{DisplayName: accountName},
+	}
+}
@@ -0,0 +1,326 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user/schemauser"
+	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_StartSchemaUserWebAuthNRegistration(t *testing.T) {
+	ctx := http_util.WithRequestedHost(authz.NewMockContext("instanceID", "", ""), "example.com")
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx          context.Context
+		registration *StartSchemaUserWebAuthNRegistration
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no userID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:          ctx,
+				registration: &StartSchemaUserWebAuthNRegistration{},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa1aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"authenticator not allowed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypePassword),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:          ctx,
+				registration: &StartSchemaUserWebAuthNRegistration{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Au1bBb", "Errors.UserSchema.Authenticator.NotAllowed"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeWebAuthN),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:          ctx,
+				registration: &StartSchemaUserWebAuthNRegistration{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				webauthnConfig: &webauthn_helper.Config{
+					DisplayName:    "test",
+					ExternalSecure: true,
+				},
+			}
+			_, err := c.StartSchemaUserWebAuthNRegistration(tt.args.ctx, tt.args.registration)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
+func TestCommands_VerifySchemaUserWebAuthNRegistration(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx    context.Context
+		verify *VerifySchemaUserWebAuthNRegistration
+	}
+	type res struct {
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no webAuthNID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:    authz.NewMockContext("instanceID", "", ""),
+				verify: &VerifySchemaUserWebAuthNRegistration{ID: "user1"},
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa2aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"registration not started, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeWebAuthN),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:    authz.NewMockContext("instanceID", "", ""),
+				verify: &VerifySchemaUserWebAuthNRegistration{ID: "user1", WebAuthNID: "webauthn1"},
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Wa2bBb", "Errors.User.WebAuthN.NotFound"),
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewWebAuthNAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"webauthn1",
+								"challenge",
+								domain.UserVerificationRequirementRequired,
+								"example.com",
+							),
+						),
+					),
+					expectFilter(
+						authenticatorSchemaCreatedEvent(domain.AuthenticatorTypeWebAuthN),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:    authz.NewMockContext("instanceID", "", ""),
+				verify: &VerifySchemaUserWebAuthNRegistration{ID: "user1", WebAuthNID: "webauthn1"},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			_, err := c.VerifySchemaUserWebAuthNRegistration(tt.args.ctx, tt.args.verify)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
+
+func TestCommands_RemoveSchemaUserWebAuthN(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx        context.Context
+		id         string
+		webAuthNID string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no webAuthNID, error",
+			fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: authz.NewMockContext("instanceID", "", ""),
+				id:  "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa3aAa", "Errors.IDMissing"),
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				webAuthNID: "webauthn1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Wa3bBb", "Errors.User.NotFound"),
+			},
+		},
+		{
+			"webauthn not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				webAuthNID: "webauthn1",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Wa3cCc", "Errors.User.WebAuthN.NotFound"),
+			},
+		},
+		{
+			"webauthn removed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						schemaUserCreatedEvent("user1", "schema1", 1, `{}`),
+						eventFromEventPusher(
+							schemauser.NewWebAuthNAddedEvent(context.Background(),
+								&schemauser.NewAggregate("user1", "org1").Aggregate,
+								"webauthn1",
+								"challenge",
+								domain.UserVerificationRequirementRequired,
+								"example.com",
+							),
+						),
+					),
+					expectPush(
+						schemauser.NewWebAuthNRemovedEvent(context.Background(),
+							&schemauser.NewAggregate("user1", "org1").Aggregate,
+							"webauthn1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:        authz.NewMockContext("instanceID", "", ""),
+				id:         "user1",
+				webAuthNID: "webauthn1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveSchemaUserWebAuthN(tt.args.ctx, "", tt.args.id, tt.args.webAuthNID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/risk"
 )
 
 type SystemDefaults struct {
@@ -12,10 +13,12 @@ type SystemDefaults struct {
 	SecretHasher       crypto.HashConfig
 	Multifactors       MultifactorConfig
 	DomainVerification DomainVerification
+	SMTPWebhook        SMTPWebhook
 	Notifications      Notifications
 	KeyConfig          KeyConfig
 	DefaultQueryLimit  uint64
 	MaxQueryLimit      uint64
+	Risk               risk.Config
 }
 
 type SecretGenerators struct {
@@ -35,6 +38,10 @@ type DomainVerification struct {
 	VerificationGenerator crypto.GeneratorConfig
 }
 
+type SMTPWebhook struct {
+	TokenGenerator crypto.GeneratorConfig
+}
+
 type Notifications struct {
 	FileSystemPath string
 }
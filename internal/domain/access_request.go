@@ -0,0 +1,35 @@
+package domain
+
+type AccessRequestState int32
+
+const (
+	AccessRequestStateUnspecified AccessRequestState = iota
+	AccessRequestStatePending
+	AccessRequestStateApproved
+	AccessRequestStateDenied
+	AccessRequestStateCancelled
+	accessRequestStateCount
+)
+
+func (s AccessRequestState) Valid() bool {
+	return s >= 0 && s < accessRequestStateCount
+}
+
+// IsPending returns true as long as the access request was neither decided on nor cancelled.
+func (s AccessRequestState) IsPending() bool {
+	return s == AccessRequestStatePending
+}
+
+// AccessRequest is the request of a user for roles on a project,
+// which results in a user grant once approved.
+type AccessRequest struct {
+	UserID    string
+	ProjectID string
+	RoleKeys  []string
+	// Reason is the justification of the requester
+	Reason string
+}
+
+func (r *AccessRequest) IsValid() bool {
+	return r.UserID != "" && r.ProjectID != "" && len(r.RoleKeys) > 0
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type AccessReviewState int32
+
+const (
+	AccessReviewStateUnspecified AccessReviewState = iota
+	AccessReviewStateActive
+	AccessReviewStateCompleted
+	AccessReviewStateCancelled
+	accessReviewStateCount
+)
+
+func (s AccessReviewState) Valid() bool {
+	return s >= 0 && s < accessReviewStateCount
+}
+
+// IsActive returns true as long as the reviewers can decide on the items of the access review.
+func (s AccessReviewState) IsActive() bool {
+	return s == AccessReviewStateActive
+}
+
+type AccessReviewItemType int32
+
+const (
+	AccessReviewItemTypeUnspecified AccessReviewItemType = iota
+	AccessReviewItemTypeUserGrant
+	AccessReviewItemTypeOrgMember
+	AccessReviewItemTypeProjectMember
+	accessReviewItemTypeCount
+)
+
+func (t AccessReviewItemType) Valid() bool {
+	return t > AccessReviewItemTypeUnspecified && t < accessReviewItemTypeCount
+}
+
+type AccessReviewDecision int32
+
+const (
+	// AccessReviewDecisionUnspecified is the decision of items the reviewers did not decide on yet
+	AccessReviewDecisionUnspecified AccessReviewDecision = iota
+	AccessReviewDecisionKeep
+	AccessReviewDecisionRevoke
+	accessReviewDecisionCount
+)
+
+func (d AccessReviewDecision) Valid() bool {
+	return d > AccessReviewDecisionUnspecified && d < accessReviewDecisionCount
+}
+
+// AccessReview is a campaign to recertify the user grants and memberships
+// of an organization or, if the ProjectID is set, of a project.
+type AccessReview struct {
+	Name      string
+	ProjectID string
+	// RoleKeys restricts the review to the user grants and memberships with at least one of the roles
+	RoleKeys  []string
+	Reviewers []string
+	Deadline  time.Time
+	// AutoRevoke revokes the items the reviewers did not decide on until the deadline
+	AutoRevoke bool
+}
+
+func (r *AccessReview) IsValid() bool {
+	return r.Name != "" && len(r.Reviewers) > 0 && !r.Deadline.IsZero()
+}
+
+// AccessReviewItem is the snapshot of a user grant or membership at the start of the access review.
+type AccessReviewItem struct {
+	ID   string
+	Type AccessReviewItemType
+	// ObjectID is the id of the user grant, the organization or the project
+	ObjectID      string
+	ResourceOwner string
+	UserID        string
+	ProjectID     string
+	Roles         []string
+}
+
+// InScope returns true if the item has at least one of the role keys or no role keys are provided.
+func (i *AccessReviewItem) InScope(roleKeys []string) bool {
+	if len(roleKeys) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(i.Roles, func(role string) bool {
+		return slices.Contains(roleKeys, role)
+	})
+}
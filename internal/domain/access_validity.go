@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+)
+
+// AccessValidity restricts a user grant or a membership to a period of time.
+// A zero ValidFrom grants the access immediately, a zero ValidUntil never expires it.
+type AccessValidity struct {
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+// IsZero returns true if the access is not restricted in time.
+func (v AccessValidity) IsZero() bool {
+	return v.ValidFrom.IsZero() && v.ValidUntil.IsZero()
+}
+
+// IsValid returns false if the access would end before it starts.
+func (v AccessValidity) IsValid() bool {
+	return v.ValidFrom.IsZero() || v.ValidUntil.IsZero() || v.ValidUntil.After(v.ValidFrom)
+}
+
+// ActiveAt returns true if the access is granted at the provided time.
+func (v AccessValidity) ActiveAt(t time.Time) bool {
+	return !v.ValidFrom.After(t) && !v.ExpiredAt(t)
+}
+
+// ExpiredAt returns true if the access ended before or at the provided time.
+func (v AccessValidity) ExpiredAt(t time.Time) bool {
+	return !v.ValidUntil.IsZero() && !v.ValidUntil.After(t)
+}
+
+// Equal returns true if both validities restrict the access to the same period.
+func (v AccessValidity) Equal(other AccessValidity) bool {
+	return v.ValidFrom.Equal(other.ValidFrom) && v.ValidUntil.Equal(other.ValidUntil)
+}
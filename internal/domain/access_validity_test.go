@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessValidity_IsValid(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		validity AccessValidity
+		want     bool
+	}{
+		{
+			name: "unrestricted",
+			want: true,
+		},
+		{
+			name:     "from only",
+			validity: AccessValidity{ValidFrom: now},
+			want:     true,
+		},
+		{
+			name:     "until only",
+			validity: AccessValidity{ValidUntil: now},
+			want:     true,
+		},
+		{
+			name:     "until after from",
+			validity: AccessValidity{ValidFrom: now, ValidUntil: now.Add(time.Hour)},
+			want:     true,
+		},
+		{
+			name:     "until equals from",
+			validity: AccessValidity{ValidFrom: now, ValidUntil: now},
+			want:     false,
+		},
+		{
+			name:     "until before from",
+			validity: AccessValidity{ValidFrom: now, ValidUntil: now.Add(-time.Hour)},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.validity.IsValid())
+		})
+	}
+}
+
+func TestAccessValidity_ActiveAt(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		validity    AccessValidity
+		wantActive  bool
+		wantExpired bool
+	}{
+		{
+			name:       "unrestricted",
+			wantActive: true,
+		},
+		{
+			name:       "started",
+			validity:   AccessValidity{ValidFrom: now.Add(-time.Hour)},
+			wantActive: true,
+		},
+		{
+			name:     "not yet started",
+			validity: AccessValidity{ValidFrom: now.Add(time.Hour)},
+		},
+		{
+			name:       "not yet expired",
+			validity:   AccessValidity{ValidUntil: now.Add(time.Hour)},
+			wantActive: true,
+		},
+		{
+			name:        "expired now",
+			validity:    AccessValidity{ValidUntil: now},
+			wantExpired: true,
+		},
+		{
+			name:        "expired",
+			validity:    AccessValidity{ValidFrom: now.Add(-2 * time.Hour), ValidUntil: now.Add(-time.Hour)},
+			wantExpired: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantActive, tt.validity.ActiveAt(now))
+			assert.Equal(t, tt.wantExpired, tt.validity.ExpiredAt(now))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+type ACRDefinitionState int32
+
+const (
+	ACRDefinitionStateUnspecified ACRDefinitionState = iota
+	ACRDefinitionStateActive
+	ACRDefinitionStateRemoved
+	acrDefinitionStateCount
+)
+
+func (s ACRDefinitionState) Valid() bool {
+	return s >= 0 && s < acrDefinitionStateCount
+}
+
+func (s ACRDefinitionState) Exists() bool {
+	return s != ACRDefinitionStateUnspecified && s != ACRDefinitionStateRemoved
+}
+
+// ACRDefinition maps an Authentication Context Class Reference (acr) value
+// to the factors a user has to be authenticated with.
+type ACRDefinition struct {
+	ACR string
+	// PhishingResistant requires the user to be authenticated with a passkey or security key (U2F)
+	PhishingResistant bool
+	// MultiFactor requires the user to be authenticated with multiple factors
+	MultiFactor bool
+	// MaxAge is the maximum time since the authentication, zero means no restriction
+	MaxAge time.Duration
+}
+
+// SatisfiedBy checks if the authentication with the provided methods at the provided time fulfills the definition.
+func (d *ACRDefinition) SatisfiedBy(methods []UserAuthMethodType, authTime time.Time) bool {
+	if d.MaxAge > 0 && (authTime.IsZero() || time.Since(authTime) > d.MaxAge) {
+		return false
+	}
+	if d.PhishingResistant &&
+		!slices.Contains(methods, UserAuthMethodTypePasswordless) &&
+		!slices.Contains(methods, UserAuthMethodTypeU2F) {
+		return false
+	}
+	if d.MultiFactor && !HasMFA(methods) {
+		return false
+	}
+	return true
+}
+
+// SelectACR returns the acr value to be asserted for an authentication with the provided methods at the provided time.
+//
+// The required acr (e.g. the minimum of the application) always has to be satisfied.
+// From the requested acr values (e.g. the `acr_values` parameter), only the ones with a definition are taken into account
+// and the first satisfied one will be returned. If none of them is satisfied, the first one will be returned as unsatisfied.
+// If no acr is required or requested, an empty acr is returned.
+func SelectACR(definitions []*ACRDefinition, requested []string, required string, methods []UserAuthMethodType, authTime time.Time) (acr string, unsatisfied *ACRDefinition) {
+	if required != "" {
+		definition := acrDefinitionByValue(definitions, required)
+		if definition != nil && !definition.SatisfiedBy(methods, authTime) {
+			return "", definition
+		}
+		if definition != nil {
+			acr = definition.ACR
+		}
+	}
+	for _, value := range requested {
+		definition := acrDefinitionByValue(definitions, value)
+		if definition == nil {
+			continue
+		}
+		if definition.SatisfiedBy(methods, authTime) {
+			return definition.ACR, nil
+		}
+		if unsatisfied == nil {
+			unsatisfied = definition
+		}
+	}
+	if unsatisfied != nil {
+		return "", unsatisfied
+	}
+	return acr, nil
+}
+
+func acrDefinitionByValue(definitions []*ACRDefinition, acr string) *ACRDefinition {
+	for _, definition := range definitions {
+		if definition.ACR == acr {
+			return definition
+		}
+	}
+	return nil
+}
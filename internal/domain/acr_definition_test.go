@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACRDefinition_SatisfiedBy(t *testing.T) {
+	type args struct {
+		methods  []UserAuthMethodType
+		authTime time.Time
+	}
+	tests := []struct {
+		name       string
+		definition *ACRDefinition
+		args       args
+		want       bool
+	}{
+		{
+			name:       "no requirements",
+			definition: &ACRDefinition{ACR: "pwd"},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword}, authTime: time.Now()},
+			want:       true,
+		},
+		{
+			name:       "phishing resistant, password",
+			definition: &ACRDefinition{ACR: "phr", PhishingResistant: true},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}, authTime: time.Now()},
+			want:       false,
+		},
+		{
+			name:       "phishing resistant, passkey",
+			definition: &ACRDefinition{ACR: "phr", PhishingResistant: true},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePasswordless}, authTime: time.Now()},
+			want:       true,
+		},
+		{
+			name:       "multi factor, single factor",
+			definition: &ACRDefinition{ACR: "mfa", MultiFactor: true},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword}, authTime: time.Now()},
+			want:       false,
+		},
+		{
+			name:       "multi factor, password and otp",
+			definition: &ACRDefinition{ACR: "mfa", MultiFactor: true},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeOTPEmail}, authTime: time.Now()},
+			want:       true,
+		},
+		{
+			name:       "max age exceeded",
+			definition: &ACRDefinition{ACR: "mfa", MultiFactor: true, MaxAge: 5 * time.Minute},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}, authTime: time.Now().Add(-10 * time.Minute)},
+			want:       false,
+		},
+		{
+			name:       "max age, no auth time",
+			definition: &ACRDefinition{ACR: "mfa", MultiFactor: true, MaxAge: 5 * time.Minute},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}},
+			want:       false,
+		},
+		{
+			name:       "max age within",
+			definition: &ACRDefinition{ACR: "mfa", MultiFactor: true, MaxAge: 5 * time.Minute},
+			args:       args{methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}, authTime: time.Now().Add(-time.Minute)},
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.definition.SatisfiedBy(tt.args.methods, tt.args.authTime))
+		})
+	}
+}
+
+func TestSelectACR(t *testing.T) {
+	phr := &ACRDefinition{ACR: "phr", PhishingResistant: true}
+	mfa := &ACRDefinition{ACR: "mfa", MultiFactor: true}
+	definitions := []*ACRDefinition{phr, mfa}
+	type args struct {
+		requested []string
+		required  string
+		methods   []UserAuthMethodType
+	}
+	tests := []struct {
+		name            string
+		args            args
+		wantACR         string
+		wantUnsatisfied *ACRDefinition
+	}{
+		{
+			name: "nothing requested",
+			args: args{methods: []UserAuthMethodType{UserAuthMethodTypePassword}},
+		},
+		{
+			name: "unknown requested, ignored",
+			args: args{requested: []string{"unknown"}, methods: []UserAuthMethodType{UserAuthMethodTypePassword}},
+		},
+		{
+			name:            "requested, unsatisfied",
+			args:            args{requested: []string{"phr", "mfa"}, methods: []UserAuthMethodType{UserAuthMethodTypePassword}},
+			wantUnsatisfied: phr,
+		},
+		{
+			name:    "requested, second satisfied",
+			args:    args{requested: []string{"phr", "mfa"}, methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}},
+			wantACR: "mfa",
+		},
+		{
+			name:            "required, unsatisfied",
+			args:            args{required: "mfa", methods: []UserAuthMethodType{UserAuthMethodTypePassword}},
+			wantUnsatisfied: mfa,
+		},
+		{
+			name:    "required, satisfied",
+			args:    args{required: "mfa", methods: []UserAuthMethodType{UserAuthMethodTypePasswordless}},
+			wantACR: "mfa",
+		},
+		{
+			name:    "required and requested, satisfied",
+			args:    args{requested: []string{"phr"}, required: "mfa", methods: []UserAuthMethodType{UserAuthMethodTypePasswordless}},
+			wantACR: "phr",
+		},
+		{
+			name:            "required satisfied, requested unsatisfied",
+			args:            args{requested: []string{"phr"}, required: "mfa", methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP}},
+			wantUnsatisfied: phr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acr, unsatisfied := SelectACR(definitions, tt.args.requested, tt.args.required, tt.args.methods, time.Now())
+			assert.Equal(t, tt.wantACR, acr)
+			assert.Equal(t, tt.wantUnsatisfied, unsatisfied)
+		})
+	}
+}
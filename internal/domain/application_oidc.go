@@ -46,6 +46,9 @@ type OIDCApp struct {
 	AdditionalOrigins        []string
 	SkipNativeAppSuccessPage bool
 	BackChannelLogoutURI     string
+	RequireConsent           bool
+	// RequiredACR is the minimum acr every authentication for the app has to satisfy
+	RequiredACR string
 
 	State AppState
 }
@@ -12,6 +12,7 @@ type SAMLApp struct {
 	EntityID    string
 	Metadata    []byte
 	MetadataURL string
+	Options     *SAMLAppOptions
 
 	State AppState
 }
@@ -36,5 +37,79 @@ func (a *SAMLApp) IsValid() bool {
 	if a.MetadataURL == "" && a.Metadata == nil {
 		return false
 	}
+	return a.Options.IsValid()
+}
+
+// SAMLAppOptions define how responses for a SAML service provider are built.
+// Unspecified values fall back to the defaults of the identity provider.
+type SAMLAppOptions struct {
+	NameIDFormat     SAMLNameIDFormat      `json:"nameIdFormat,omitempty"`
+	AttributeMapping *SAMLAttributeMapping `json:"attributeMapping,omitempty"`
+}
+
+func (o *SAMLAppOptions) IsValid() bool {
+	if o == nil {
+		return true
+	}
+	return o.NameIDFormat <= SAMLNameIDFormatTransient &&
+		o.AttributeMapping.IsValid()
+}
+
+// SAMLAttributeMapping restricts and extends the attributes released to a SAML service provider.
+type SAMLAttributeMapping struct {
+	// UserAttributes are the user fields released, all fields are released if empty
+	UserAttributes []SAMLUserAttribute `json:"userAttributes,omitempty"`
+	// MetadataKeys are the keys of the user metadata released as attributes with the same name
+	MetadataKeys []string `json:"metadataKeys,omitempty"`
+	// RolesAttributeName is the name of the attribute containing the granted roles of the project,
+	// no roles are released if empty
+	RolesAttributeName string `json:"rolesAttributeName,omitempty"`
+}
+
+func (m *SAMLAttributeMapping) IsValid() bool {
+	if m == nil {
+		return true
+	}
+	for _, attribute := range m.UserAttributes {
+		if !attribute.Valid() {
+			return false
+		}
+	}
+	for _, key := range m.MetadataKeys {
+		if key == "" {
+			return false
+		}
+	}
 	return true
 }
+
+// ReleasesUserAttribute returns if the user field is released to the service provider.
+func (m *SAMLAttributeMapping) ReleasesUserAttribute(attribute SAMLUserAttribute) bool {
+	if m == nil || len(m.UserAttributes) == 0 {
+		return true
+	}
+	for _, a := range m.UserAttributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+type SAMLUserAttribute uint8
+
+const (
+	SAMLUserAttributeUnspecified SAMLUserAttribute = iota
+	SAMLUserAttributeEmail
+	SAMLUserAttributeFullName
+	SAMLUserAttributeGivenName
+	SAMLUserAttributeSurname
+	SAMLUserAttributeUsername
+	SAMLUserAttributeUserID
+
+	samlUserAttributeCount
+)
+
+func (a SAMLUserAttribute) Valid() bool {
+	return a > SAMLUserAttributeUnspecified && a < samlUserAttributeCount
+}
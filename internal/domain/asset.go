@@ -3,14 +3,17 @@ package domain
 import "time"
 
 const (
-	UsersAssetPath  = "users"
-	AvatarAssetPath = "/avatar"
+	UsersAssetPath      = "users"
+	AvatarAssetPath     = "/avatar"
+	DataExportAssetPath = "/exports"
+	UserImportAssetPath = "imports/users"
 
 	policyPrefix          = "policy"
 	LabelPolicyPrefix     = policyPrefix + "/label"
 	labelPolicyLogoPrefix = LabelPolicyPrefix + "/logo"
 	labelPolicyIconPrefix = LabelPolicyPrefix + "/icon"
 	labelPolicyFontPrefix = LabelPolicyPrefix + "/font"
+	messageTemplatePrefix = policyPrefix + "/mail/template"
 	Dark                  = "dark"
 
 	CssPath              = LabelPolicyPrefix + "/css"
@@ -19,6 +22,7 @@ const (
 	LabelPolicyLogoPath = labelPolicyLogoPrefix
 	LabelPolicyIconPath = labelPolicyIconPrefix
 	LabelPolicyFontPath = labelPolicyFontPrefix
+	MessageTemplatePath = messageTemplatePrefix
 )
 
 type AssetInfo struct {
@@ -38,6 +42,14 @@ func GetHumanAvatarAssetPath(userID string) string {
 	return UsersAssetPath + "/" + userID + AvatarAssetPath
 }
 
+func GetUserDataExportAssetPath(userID, exportID string) string {
+	return UsersAssetPath + "/" + userID + DataExportAssetPath + "/" + exportID
+}
+
+func GetUserImportAssetPath(jobID string) string {
+	return UserImportAssetPath + "/" + jobID
+}
+
 func AssetURL(prefix, resourceOwner, key string) string {
 	if prefix == "" || resourceOwner == "" || key == "" {
 		return ""
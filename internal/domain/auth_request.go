@@ -21,6 +21,7 @@ type AuthRequest struct {
 	TransferState string
 	Prompt        []Prompt
 	PossibleLOAs  []LevelOfAssurance
+	ACRValues     []string
 	UiLocales     []string
 	LoginHint     string
 	MaxAuthAge    *time.Duration
@@ -47,23 +48,32 @@ type AuthRequest struct {
 	PossibleSteps            []NextStep `json:"-"`
 	PasswordVerified         bool
 	IDPLoginChecked          bool
+	ConsentGranted           bool
+	ConsentDenied            bool
 	MFAsVerified             []MFAType
 	Audience                 []string
 	AuthTime                 time.Time
-	Code                     string
-	LoginPolicy              *LoginPolicy
-	AllowedExternalIDPs      []*IDPProvider
-	LabelPolicy              *LabelPolicy
-	PrivacyPolicy            *PrivacyPolicy
-	LockoutPolicy            *LockoutPolicy
-	PasswordAgePolicy        *PasswordAgePolicy
-	DefaultTranslations      []*CustomText
-	OrgTranslations          []*CustomText
-	SAMLRequestID            string
+	// ACR is the authentication context class reference satisfied by the authentication
+	ACR                 string
+	Code                string
+	LoginPolicy         *LoginPolicy
+	AllowedExternalIDPs []*IDPProvider
+	LabelPolicy         *LabelPolicy
+	PrivacyPolicy       *PrivacyPolicy
+	LockoutPolicy       *LockoutPolicy
+	PasswordAgePolicy   *PasswordAgePolicy
+	DefaultTranslations []*CustomText
+	OrgTranslations     []*CustomText
+	SAMLRequestID       string
 	// orgID the policies were last loaded with
 	policyOrgID string
 	// SessionID is set to the computed sessionID of the login session table
 	SessionID string
+	// TrustedDeviceUsed is set by the computation of the next steps, if a trusted device replaced the multi-factor verification
+	TrustedDeviceUsed bool `json:"-"`
+	// RiskMFARequiredAt is set to the time of the risk assessment of the sign-in, if it requires a multi-factor verification.
+	// Verifications before that time are not sufficient.
+	RiskMFARequiredAt time.Time
 }
 
 func (a *AuthRequest) SetPolicyOrgID(id string) {
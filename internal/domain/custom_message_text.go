@@ -8,23 +8,37 @@ import (
 )
 
 const (
-	InitCodeMessageType                 = "InitCode"
-	PasswordResetMessageType            = "PasswordReset"
-	VerifyEmailMessageType              = "VerifyEmail"
-	VerifyPhoneMessageType              = "VerifyPhone"
-	VerifySMSOTPMessageType             = "VerifySMSOTP"
-	VerifyEmailOTPMessageType           = "VerifyEmailOTP"
-	DomainClaimedMessageType            = "DomainClaimed"
-	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
-	PasswordChangeMessageType           = "PasswordChange"
-	InviteUserMessageType               = "InviteUser"
-	MessageTitle                        = "Title"
-	MessagePreHeader                    = "PreHeader"
-	MessageSubject                      = "Subject"
-	MessageGreeting                     = "Greeting"
-	MessageText                         = "Text"
-	MessageButtonText                   = "ButtonText"
-	MessageFooterText                   = "Footer"
+	InitCodeMessageType                      = "InitCode"
+	PasswordResetMessageType                 = "PasswordReset"
+	VerifyEmailMessageType                   = "VerifyEmail"
+	VerifyPhoneMessageType                   = "VerifyPhone"
+	VerifySMSOTPMessageType                  = "VerifySMSOTP"
+	VerifyEmailOTPMessageType                = "VerifyEmailOTP"
+	DomainClaimedMessageType                 = "DomainClaimed"
+	PasswordlessRegistrationMessageType      = "PasswordlessRegistration"
+	PasswordChangeMessageType                = "PasswordChange"
+	InviteUserMessageType                    = "InviteUser"
+	NewDeviceSignInMessageType               = "NewDeviceSignIn"
+	MFAAddedMessageType                      = "MFAAdded"
+	MFARemovedMessageType                    = "MFARemoved"
+	PasskeyAddedMessageType                  = "PasskeyAdded"
+	EmailChangedMessageType                  = "EmailChanged"
+	AccountLockedMessageType                 = "AccountLocked"
+	PATCreatedMessageType                    = "PATCreated"
+	PasswordExpiryWarningMessageType         = "PasswordExpiryWarning"
+	PasswordExpiredMessageType               = "PasswordExpired"
+	AccessExpiryWarningMessageType           = "AccessExpiryWarning"
+	AccessRequestedMessageType               = "AccessRequested"
+	InactivityDeactivationWarningMessageType = "InactivityDeactivationWarning"
+	InactivityDeletionWarningMessageType     = "InactivityDeletionWarning"
+	UserDeletionReminderMessageType          = "UserDeletionReminder"
+	MessageTitle                             = "Title"
+	MessagePreHeader                         = "PreHeader"
+	MessageSubject                           = "Subject"
+	MessageGreeting                          = "Greeting"
+	MessageText                              = "Text"
+	MessageButtonText                        = "ButtonText"
+	MessageFooterText                        = "Footer"
 )
 
 type CustomMessageText struct {
@@ -63,5 +77,19 @@ func IsMessageTextType(textType string) bool {
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
 		textType == PasswordChangeMessageType ||
-		textType == InviteUserMessageType
+		textType == InviteUserMessageType ||
+		textType == NewDeviceSignInMessageType ||
+		textType == MFAAddedMessageType ||
+		textType == MFARemovedMessageType ||
+		textType == PasskeyAddedMessageType ||
+		textType == EmailChangedMessageType ||
+		textType == AccountLockedMessageType ||
+		textType == PATCreatedMessageType ||
+		textType == PasswordExpiryWarningMessageType ||
+		textType == PasswordExpiredMessageType ||
+		textType == AccessExpiryWarningMessageType ||
+		textType == AccessRequestedMessageType ||
+		textType == InactivityDeactivationWarningMessageType ||
+		textType == InactivityDeletionWarningMessageType ||
+		textType == UserDeletionReminderMessageType
 }
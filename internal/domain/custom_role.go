@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+type CustomRoleState int32
+
+const (
+	CustomRoleStateUnspecified CustomRoleState = iota
+	CustomRoleStateActive
+	CustomRoleStateRemoved
+	customRoleStateCount
+)
+
+func (s CustomRoleState) Valid() bool {
+	return s >= 0 && s < customRoleStateCount
+}
+
+func (s CustomRoleState) Exists() bool {
+	return s != CustomRoleStateUnspecified && s != CustomRoleStateRemoved
+}
+
+const (
+	// IAMCustomRolePrefix is the prefix of custom roles assignable to instance members
+	IAMCustomRolePrefix = IAMRolePrefix + "_CUSTOM_"
+	// OrgCustomRolePrefix is the prefix of custom roles assignable to organization members
+	OrgCustomRolePrefix = OrgRolePrefix + "_CUSTOM_"
+)
+
+var customRoleKeyRegexp = regexp.MustCompile(`^[A-Z0-9_]+$`)
+
+// CustomRole is a manager role defined at runtime
+// with a subset of the permissions of the roles defined in the configuration.
+type CustomRole struct {
+	Key         string
+	DisplayName string
+	Permissions []string
+}
+
+// IsValid checks the key and permissions of the role.
+// Instances can define roles for instance and organization members, organizations only for their members.
+func (r *CustomRole) IsValid(onInstance bool) bool {
+	if r == nil || len(r.Key) > 200 || !customRoleKeyRegexp.MatchString(r.Key) || len(r.Permissions) == 0 {
+		return false
+	}
+	if strings.HasPrefix(r.Key, OrgCustomRolePrefix) {
+		return len(r.Key) > len(OrgCustomRolePrefix)
+	}
+	return onInstance && strings.HasPrefix(r.Key, IAMCustomRolePrefix) && len(r.Key) > len(IAMCustomRolePrefix)
+}
+
+// IsCustomRole checks if the role is defined at runtime and not in the configuration
+func IsCustomRole(role string) bool {
+	return strings.HasPrefix(role, IAMCustomRolePrefix) || strings.HasPrefix(role, OrgCustomRolePrefix)
+}
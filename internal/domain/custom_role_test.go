@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomRole_IsValid(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       *CustomRole
+		onInstance bool
+		want       bool
+	}{
+		{
+			name: "nil",
+			want: false,
+		},
+		{
+			name: "no permissions",
+			role: &CustomRole{Key: "ORG_CUSTOM_HELPDESK"},
+			want: false,
+		},
+		{
+			name: "without prefix",
+			role: &CustomRole{Key: "HELPDESK", Permissions: []string{"user.write"}},
+			want: false,
+		},
+		{
+			name: "prefix only",
+			role: &CustomRole{Key: "ORG_CUSTOM_", Permissions: []string{"user.write"}},
+			want: false,
+		},
+		{
+			name: "lowercase",
+			role: &CustomRole{Key: "ORG_CUSTOM_helpdesk", Permissions: []string{"user.write"}},
+			want: false,
+		},
+		{
+			name: "built-in role",
+			role: &CustomRole{Key: "ORG_OWNER", Permissions: []string{"user.write"}},
+			want: false,
+		},
+		{
+			name: "organization role",
+			role: &CustomRole{Key: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+			want: true,
+		},
+		{
+			name: "instance role on organization",
+			role: &CustomRole{Key: "IAM_CUSTOM_AUDITOR", Permissions: []string{"iam.read"}},
+			want: false,
+		},
+		{
+			name:       "instance role on instance",
+			role:       &CustomRole{Key: "IAM_CUSTOM_AUDITOR", Permissions: []string{"iam.read"}},
+			onInstance: true,
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.IsValid(tt.onInstance))
+		})
+	}
+}
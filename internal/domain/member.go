@@ -9,6 +9,7 @@ type Member struct {
 
 	UserID string
 	Roles  []string
+	AccessValidity
 }
 
 func NewMember(aggregateID, userID string, roles ...string) *Member {
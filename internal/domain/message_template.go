@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"golang.org/x/text/language"
+)
+
+type MessageTemplateFormat int32
+
+const (
+	MessageTemplateFormatUnspecified MessageTemplateFormat = iota
+	MessageTemplateFormatHTML
+	MessageTemplateFormatMJML
+)
+
+func (f MessageTemplateFormat) Valid() bool {
+	return f == MessageTemplateFormatHTML || f == MessageTemplateFormatMJML
+}
+
+func (f MessageTemplateFormat) FileExtension() string {
+	switch f {
+	case MessageTemplateFormatMJML:
+		return ".mjml"
+	case MessageTemplateFormatHTML:
+		return ".html"
+	case MessageTemplateFormatUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+func (f MessageTemplateFormat) ContentType() string {
+	switch f {
+	case MessageTemplateFormatMJML:
+		return "application/xml"
+	case MessageTemplateFormatHTML:
+		return "text/html"
+	case MessageTemplateFormatUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+// MessageTemplateObjectName returns the name of the asset the uploaded source of a message template is stored as
+func MessageTemplateObjectName(messageType string, lang language.Tag, format MessageTemplateFormat) string {
+	return MessageTemplatePath + "/" + messageType + "-" + lang.String() + format.FileExtension()
+}
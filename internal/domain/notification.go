@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"time"
 )
 
@@ -36,15 +39,64 @@ const (
 	notificationProviderTypeCount
 )
 
+// NotificationDeliveryStatus is the state of a requested notification,
+// it is updated by the notification worker and by status callbacks of the providers
+type NotificationDeliveryStatus int32
+
+const (
+	NotificationDeliveryStatusUnspecified NotificationDeliveryStatus = iota
+	NotificationDeliveryStatusRequested
+	NotificationDeliveryStatusRetrying
+	NotificationDeliveryStatusSent
+	NotificationDeliveryStatusFailed
+	NotificationDeliveryStatusDelivered
+	NotificationDeliveryStatusBounced
+	NotificationDeliveryStatusUndelivered
+
+	notificationDeliveryStatusCount
+)
+
+func (s NotificationDeliveryStatus) Valid() bool {
+	return s > NotificationDeliveryStatusUnspecified && s < notificationDeliveryStatusCount
+}
+
+// IsProviderStatus returns true for the states reported by the provider after the notification was handed over
+func (s NotificationDeliveryStatus) IsProviderStatus() bool {
+	return s == NotificationDeliveryStatusDelivered ||
+		s == NotificationDeliveryStatusBounced ||
+		s == NotificationDeliveryStatusUndelivered
+}
+
+// NotificationRecipientHash returns the hex encoded sha256 hash of the normalized recipient (email address or phone number),
+// so the delivery of a notification can be checked without persisting the recipient
+func NotificationRecipientHash(recipient string) string {
+	if recipient == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(recipient))))
+	return hex.EncodeToString(hash[:])
+}
+
 type NotificationArguments struct {
-	Origin          string        `json:"origin,omitempty"`
-	Domain          string        `json:"domain,omitempty"`
-	Expiry          time.Duration `json:"expiry,omitempty"`
-	TempUsername    string        `json:"tempUsername,omitempty"`
-	ApplicationName string        `json:"applicationName,omitempty"`
-	CodeID          string        `json:"codeID,omitempty"`
-	SessionID       string        `json:"sessionID,omitempty"`
-	AuthRequestID   string        `json:"authRequestID,omitempty"`
+	Origin           string                  `json:"origin,omitempty"`
+	Domain           string                  `json:"domain,omitempty"`
+	Expiry           time.Duration           `json:"expiry,omitempty"`
+	TempUsername     string                  `json:"tempUsername,omitempty"`
+	ApplicationName  string                  `json:"applicationName,omitempty"`
+	CodeID           string                  `json:"codeID,omitempty"`
+	SessionID        string                  `json:"sessionID,omitempty"`
+	AuthRequestID    string                  `json:"authRequestID,omitempty"`
+	PreviousEmail    string                  `json:"previousEmail,omitempty"`
+	IP               string                  `json:"ip,omitempty"`
+	Country          string                  `json:"country,omitempty"`
+	Factor           string                  `json:"factor,omitempty"`
+	ExpirationDate   time.Time               `json:"expirationDate,omitempty"`
+	ExpiryThreshold  PasswordExpiryThreshold `json:"expiryThreshold,omitempty"`
+	RecipientID      string                  `json:"recipientID,omitempty"`
+	ProjectName      string                  `json:"projectName,omitempty"`
+	GranteeName      string                  `json:"granteeName,omitempty"`
+	Roles            string                  `json:"roles,omitempty"`
+	InactivityAction InactivityAction        `json:"inactivityAction,omitempty"`
 }
 
 // ToMap creates a type safe map of the notification arguments.
@@ -62,5 +114,16 @@ func (n *NotificationArguments) ToMap() map[string]interface{} {
 	m["CodeID"] = n.CodeID
 	m["SessionID"] = n.SessionID
 	m["AuthRequestID"] = n.AuthRequestID
+	m["PreviousEmail"] = n.PreviousEmail
+	m["IP"] = n.IP
+	m["Country"] = n.Country
+	m["Factor"] = n.Factor
+	m["ExpirationDate"] = n.ExpirationDate
+	m["ExpiryThreshold"] = n.ExpiryThreshold
+	m["RecipientID"] = n.RecipientID
+	m["ProjectName"] = n.ProjectName
+	m["GranteeName"] = n.GranteeName
+	m["Roles"] = n.Roles
+	m["InactivityAction"] = n.InactivityAction
 	return m
 }
@@ -43,3 +43,20 @@ const (
 func (s OrgState) Valid() bool {
 	return s > OrgStateUnspecified && s < orgStateMax
 }
+
+// OrgHierarchyMaxDepth limits the number of ancestors an organization can have.
+const OrgHierarchyMaxDepth = 10
+
+// OrgParent links an organization to its parent.
+// Policies are always resolved along the chain of parents,
+// IdPs, project grants and memberships of the parent only if the flags are set.
+type OrgParent struct {
+	ParentOrgID          string
+	InheritIDPs          bool
+	InheritProjectGrants bool
+	InheritMembers       bool
+}
+
+func (p *OrgParent) IsValid() bool {
+	return p != nil && p.ParentOrgID != ""
+}
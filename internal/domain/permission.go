@@ -34,6 +34,7 @@ const (
 	PermissionSessionWrite        = "session.write"
 	PermissionSessionDelete       = "session.delete"
 	PermissionOrgRead             = "org.read"
+	PermissionOrgWrite            = "org.write"
 	PermissionIDPRead             = "iam.idp.read"
 	PermissionOrgIDPRead          = "org.idp.read"
 )
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InactivityPolicy defines after how many days without sign-in or token usage users are deactivated or deleted.
+// The actions are disabled if the days are 0.
+type InactivityPolicy struct {
+	models.ObjectRoot
+
+	// WarnBeforeDays is the period before each action, in which the user is notified about it
+	WarnBeforeDays             uint64
+	HumanDeactivateAfterDays   uint64
+	HumanDeleteAfterDays       uint64
+	MachineDeactivateAfterDays uint64
+	MachineDeleteAfterDays     uint64
+}
+
+func (p *InactivityPolicy) IsValid() error {
+	if !inactivityDeletionAfterDeactivation(p.HumanDeactivateAfterDays, p.HumanDeleteAfterDays) ||
+		!inactivityDeletionAfterDeactivation(p.MachineDeactivateAfterDays, p.MachineDeleteAfterDays) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-In4aAa", "Errors.InactivityPolicy.DeletionBeforeDeactivation")
+	}
+	return nil
+}
+
+func inactivityDeletionAfterDeactivation(deactivateAfterDays, deleteAfterDays uint64) bool {
+	return deactivateAfterDays == 0 || deleteAfterDays == 0 || deleteAfterDays > deactivateAfterDays
+}
+
+// InactivityAction is the action applied to users, which did not sign in for the period defined in the [InactivityPolicy].
+type InactivityAction int32
+
+const (
+	InactivityActionUnspecified InactivityAction = iota
+	InactivityActionDeactivate
+	InactivityActionDelete
+	inactivityActionCount
+)
+
+func (a InactivityAction) Valid() bool {
+	return a > InactivityActionUnspecified && a < inactivityActionCount
+}
+
+// MessageType returns the type of the message text used to warn the user about the action.
+func (a InactivityAction) MessageType() string {
+	switch a {
+	case InactivityActionDeactivate:
+		return InactivityDeactivationWarningMessageType
+	case InactivityActionDelete:
+		return InactivityDeletionWarningMessageType
+	default:
+		return ""
+	}
+}
+
+// InactivityDue is the next action of the [InactivityPolicy] for a user.
+type InactivityDue struct {
+	Action  InactivityAction
+	DueDate time.Time
+	// Warning is set if the action is not due yet, but the user has to be warned about it
+	Warning bool
+}
+
+// DueAction returns the action to apply to a user of the type and state, which was last active at the provided time.
+// Inactive users are only deleted. If nothing is due, the action is unspecified.
+func (p *InactivityPolicy) DueAction(userType UserType, state UserState, lastActivity, now time.Time) InactivityDue {
+	deactivateAfterDays, deleteAfterDays := p.HumanDeactivateAfterDays, p.HumanDeleteAfterDays
+	if userType == UserTypeMachine {
+		deactivateAfterDays, deleteAfterDays = p.MachineDeactivateAfterDays, p.MachineDeleteAfterDays
+	}
+	deleteDate := inactivityDate(lastActivity, deleteAfterDays)
+	if !deleteDate.IsZero() && !now.Before(deleteDate) {
+		return InactivityDue{Action: InactivityActionDelete, DueDate: deleteDate}
+	}
+	var deactivateDate time.Time
+	if state != UserStateInactive {
+		deactivateDate = inactivityDate(lastActivity, deactivateAfterDays)
+	}
+	if !deactivateDate.IsZero() && !now.Before(deactivateDate) {
+		return InactivityDue{Action: InactivityActionDeactivate, DueDate: deactivateDate}
+	}
+	next := InactivityDue{Action: InactivityActionDelete, DueDate: deleteDate, Warning: true}
+	if !deactivateDate.IsZero() {
+		next = InactivityDue{Action: InactivityActionDeactivate, DueDate: deactivateDate, Warning: true}
+	}
+	if p.WarnBeforeDays == 0 || next.DueDate.IsZero() || now.Before(next.DueDate.Add(-inactivityDuration(p.WarnBeforeDays))) {
+		return InactivityDue{}
+	}
+	return next
+}
+
+// MinAfterDays returns the lowest number of days of inactivity after which any action or warning is due.
+// It returns 0 if the policy has no action enabled.
+func (p *InactivityPolicy) MinAfterDays() uint64 {
+	var minDays uint64
+	for _, afterDays := range []uint64{p.HumanDeactivateAfterDays, p.HumanDeleteAfterDays, p.MachineDeactivateAfterDays, p.MachineDeleteAfterDays} {
+		if afterDays > 0 && (minDays == 0 || afterDays < minDays) {
+			minDays = afterDays
+		}
+	}
+	if minDays == 0 {
+		return 0
+	}
+	// ensure at least one day, so the policy stays enabled
+	return max(minDays-min(p.WarnBeforeDays, minDays-1), 1)
+}
+
+func inactivityDate(lastActivity time.Time, afterDays uint64) time.Time {
+	if afterDays == 0 || lastActivity.IsZero() {
+		return time.Time{}
+	}
+	return lastActivity.Add(inactivityDuration(afterDays))
+}
+
+func inactivityDuration(d uint64) time.Duration {
+	return time.Duration(d) * 24 * time.Hour
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestInactivityPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  InactivityPolicy
+		wantErr bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:   "deactivation only",
+			policy: InactivityPolicy{HumanDeactivateAfterDays: 90},
+		},
+		{
+			name:   "deletion only",
+			policy: InactivityPolicy{MachineDeleteAfterDays: 30},
+		},
+		{
+			name:   "deletion after deactivation",
+			policy: InactivityPolicy{HumanDeactivateAfterDays: 90, HumanDeleteAfterDays: 365, MachineDeactivateAfterDays: 30, MachineDeleteAfterDays: 60},
+		},
+		{
+			name:    "human deletion with deactivation",
+			policy:  InactivityPolicy{HumanDeactivateAfterDays: 90, HumanDeleteAfterDays: 90},
+			wantErr: true,
+		},
+		{
+			name:    "machine deletion before deactivation",
+			policy:  InactivityPolicy{MachineDeactivateAfterDays: 90, MachineDeleteAfterDays: 30},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.IsValid()
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInactivityPolicy_DueAction(t *testing.T) {
+	day := 24 * time.Hour
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := &InactivityPolicy{
+		WarnBeforeDays:             10,
+		HumanDeactivateAfterDays:   90,
+		HumanDeleteAfterDays:       365,
+		MachineDeactivateAfterDays: 30,
+	}
+	type args struct {
+		userType     UserType
+		state        UserState
+		lastActivity time.Time
+		now          time.Time
+	}
+	tests := []struct {
+		name   string
+		policy *InactivityPolicy
+		args   args
+		want   InactivityDue
+	}{
+		{
+			name:   "recently active",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateActive, lastActivity, lastActivity.Add(day)},
+			want:   InactivityDue{},
+		},
+		{
+			name:   "no activity",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateActive, time.Time{}, lastActivity.Add(1000 * day)},
+			want:   InactivityDue{},
+		},
+		{
+			name:   "deactivation warning",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateActive, lastActivity, lastActivity.Add(80 * day)},
+			want:   InactivityDue{Action: InactivityActionDeactivate, DueDate: lastActivity.Add(90 * day), Warning: true},
+		},
+		{
+			name:   "deactivation due",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateActive, lastActivity, lastActivity.Add(90 * day)},
+			want:   InactivityDue{Action: InactivityActionDeactivate, DueDate: lastActivity.Add(90 * day)},
+		},
+		{
+			name:   "inactive, before deletion warning",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateInactive, lastActivity, lastActivity.Add(100 * day)},
+			want:   InactivityDue{},
+		},
+		{
+			name:   "inactive, deletion warning",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateInactive, lastActivity, lastActivity.Add(355 * day)},
+			want:   InactivityDue{Action: InactivityActionDelete, DueDate: lastActivity.Add(365 * day), Warning: true},
+		},
+		{
+			name:   "deletion due",
+			policy: policy,
+			args:   args{UserTypeHuman, UserStateActive, lastActivity, lastActivity.Add(400 * day)},
+			want:   InactivityDue{Action: InactivityActionDelete, DueDate: lastActivity.Add(365 * day)},
+		},
+		{
+			name:   "machine deactivation due",
+			policy: policy,
+			args:   args{UserTypeMachine, UserStateActive, lastActivity, lastActivity.Add(31 * day)},
+			want:   InactivityDue{Action: InactivityActionDeactivate, DueDate: lastActivity.Add(30 * day)},
+		},
+		{
+			name:   "machine inactive, no deletion",
+			policy: policy,
+			args:   args{UserTypeMachine, UserStateInactive, lastActivity, lastActivity.Add(1000 * day)},
+			want:   InactivityDue{},
+		},
+		{
+			name:   "no warnings",
+			policy: &InactivityPolicy{HumanDeactivateAfterDays: 90},
+			args:   args{UserTypeHuman, UserStateActive, lastActivity, lastActivity.Add(89 * day)},
+			want:   InactivityDue{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.DueAction(tt.args.userType, tt.args.state, tt.args.lastActivity, tt.args.now))
+		})
+	}
+}
+
+func TestInactivityPolicy_MinAfterDays(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy InactivityPolicy
+		want   uint64
+	}{
+		{
+			name: "disabled",
+			want: 0,
+		},
+		{
+			name:   "lowest action",
+			policy: InactivityPolicy{HumanDeactivateAfterDays: 90, HumanDeleteAfterDays: 365, MachineDeleteAfterDays: 60},
+			want:   60,
+		},
+		{
+			name:   "with warning",
+			policy: InactivityPolicy{WarnBeforeDays: 10, HumanDeactivateAfterDays: 90},
+			want:   80,
+		},
+		{
+			name:   "warning longer than action",
+			policy: InactivityPolicy{WarnBeforeDays: 100, HumanDeactivateAfterDays: 90},
+			want:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.MinAfterDays())
+		})
+	}
+}
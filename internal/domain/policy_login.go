@@ -29,6 +29,8 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	TrustedDeviceLifetime      time.Duration
+	RiskThresholds             RiskThresholds
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
 }
@@ -0,0 +1,37 @@
+package domain
+
+// SecurityNotifications defines which security relevant account changes are notified to the affected user.
+type SecurityNotifications struct {
+	NewDeviceSignIn bool `json:"newDeviceSignIn,omitempty"`
+	MFAAdded        bool `json:"mfaAdded,omitempty"`
+	MFARemoved      bool `json:"mfaRemoved,omitempty"`
+	PasskeyAdded    bool `json:"passkeyAdded,omitempty"`
+	EmailChanged    bool `json:"emailChanged,omitempty"`
+	AccountLocked   bool `json:"accountLocked,omitempty"`
+	PATCreated      bool `json:"patCreated,omitempty"`
+}
+
+// Enabled returns whether the notification for the given message type is enabled.
+func (n *SecurityNotifications) Enabled(messageType string) bool {
+	if n == nil {
+		return false
+	}
+	switch messageType {
+	case NewDeviceSignInMessageType:
+		return n.NewDeviceSignIn
+	case MFAAddedMessageType:
+		return n.MFAAdded
+	case MFARemovedMessageType:
+		return n.MFARemoved
+	case PasskeyAddedMessageType:
+		return n.PasskeyAdded
+	case EmailChangedMessageType:
+		return n.EmailChanged
+	case AccountLockedMessageType:
+		return n.AccountLocked
+	case PATCreatedMessageType:
+		return n.PATCreated
+	default:
+		return false
+	}
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -10,3 +12,50 @@ type PasswordAgePolicy struct {
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
 }
+
+// PasswordExpiryThreshold is the point in the lifetime of a password at which the user is notified.
+type PasswordExpiryThreshold int32
+
+const (
+	PasswordExpiryThresholdUnspecified PasswordExpiryThreshold = iota
+	// PasswordExpiryThresholdWarning is reached ExpireWarnDays before the password expires.
+	PasswordExpiryThresholdWarning
+	// PasswordExpiryThresholdExpired is reached when the password is older than MaxAgeDays.
+	PasswordExpiryThresholdExpired
+)
+
+// PasswordExpirationDate returns the date the password changed at the provided time expires.
+// If the policy does not restrict the age of passwords, the zero time is returned.
+func PasswordExpirationDate(maxAgeDays uint64, changed time.Time) time.Time {
+	if maxAgeDays == 0 || changed.IsZero() {
+		return time.Time{}
+	}
+	return changed.Add(time.Duration(maxAgeDays) * 24 * time.Hour)
+}
+
+// PasswordExpiryThresholdReached returns the latest threshold reached at the provided time
+// for a password expiring at the expiration date.
+func PasswordExpiryThresholdReached(expirationDate time.Time, expireWarnDays uint64, now time.Time) PasswordExpiryThreshold {
+	if expirationDate.IsZero() {
+		return PasswordExpiryThresholdUnspecified
+	}
+	if !now.Before(expirationDate) {
+		return PasswordExpiryThresholdExpired
+	}
+	if expireWarnDays > 0 && !now.Before(expirationDate.Add(-time.Duration(expireWarnDays)*24*time.Hour)) {
+		return PasswordExpiryThresholdWarning
+	}
+	return PasswordExpiryThresholdUnspecified
+}
+
+// MessageType returns the type of the message text used to notify the user about the threshold.
+func (t PasswordExpiryThreshold) MessageType() string {
+	switch t {
+	case PasswordExpiryThresholdWarning:
+		return PasswordExpiryWarningMessageType
+	case PasswordExpiryThresholdExpired:
+		return PasswordExpiredMessageType
+	default:
+		return ""
+	}
+}
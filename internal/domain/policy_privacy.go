@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -17,4 +19,7 @@ type PrivacyPolicy struct {
 	DocsLink       string
 	CustomLink     string
 	CustomLinkText string
+	// DeletionGracePeriod is the period after which the self-service deletion of a user is executed.
+	// Users are deleted immediately if 0.
+	DeletionGracePeriod time.Duration
 }
@@ -0,0 +1,326 @@
+package domain
+
+import (
+	"encoding/base64"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// RelationSubjectTypeUser is the built-in subject type referencing users of ZITADEL.
+	// It must not be defined in the schema.
+	RelationSubjectTypeUser = "user"
+	// RelationRolePrefix marks a permission which is granted by a project role assigned through a user grant
+	RelationRolePrefix = "role:"
+	// relationArrow traverses a relation to the permission of the related object
+	relationArrow = "->"
+	// RelationMaxDepth limits the recursion of checks and expansions
+	RelationMaxDepth = 25
+	// RelationMaxObjects limits the objects and relations visited when listing the objects of a user
+	RelationMaxObjects = 10000
+	// RelationMaxListLimit is the default and maximum number of object ids returned per page
+	RelationMaxListLimit = 1000
+)
+
+var relationNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// RelationSubject is either a user or, if Relation is set, the set of subjects of the relation of an object (userset).
+type RelationSubject struct {
+	Type     string
+	ID       string
+	Relation string
+}
+
+func (s *RelationSubject) String() string {
+	if s.Relation == "" {
+		return s.Type + ":" + s.ID
+	}
+	return s.Type + ":" + s.ID + "#" + s.Relation
+}
+
+// RelationTuple states that the subject has the relation to the object:
+// object_type:object_id#relation@subject_type:subject_id[#subject_relation]
+type RelationTuple struct {
+	ObjectType string
+	ObjectID   string
+	Relation   string
+	Subject    RelationSubject
+}
+
+// ParseRelationTuple parses the tuple from its string representation, e.g. document:1#editor@group:eng#member
+func ParseRelationTuple(tuple string) (*RelationTuple, error) {
+	object, subject, ok := strings.Cut(tuple, "@")
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rl3aQe", "Errors.Relation.Invalid")
+	}
+	object, relation, ok := strings.Cut(object, "#")
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rl3bWs", "Errors.Relation.Invalid")
+	}
+	objectType, objectID, ok := strings.Cut(object, ":")
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rl3cEr", "Errors.Relation.Invalid")
+	}
+	subject, subjectRelation, _ := strings.Cut(subject, "#")
+	subjectType, subjectID, ok := strings.Cut(subject, ":")
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rl3dTz", "Errors.Relation.Invalid")
+	}
+	t := &RelationTuple{
+		ObjectType: objectType,
+		ObjectID:   objectID,
+		Relation:   relation,
+		Subject: RelationSubject{
+			Type:     subjectType,
+			ID:       subjectID,
+			Relation: subjectRelation,
+		},
+	}
+	if !t.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rl3eZu", "Errors.Relation.Invalid")
+	}
+	return t, nil
+}
+
+func (t *RelationTuple) String() string {
+	return t.ObjectType + ":" + t.ObjectID + "#" + t.Relation + "@" + t.Subject.String()
+}
+
+func (t *RelationTuple) IsValid() bool {
+	return t != nil &&
+		relationNameRegexp.MatchString(t.ObjectType) &&
+		isValidRelationObjectID(t.ObjectID) &&
+		relationNameRegexp.MatchString(t.Relation) &&
+		relationNameRegexp.MatchString(t.Subject.Type) &&
+		isValidRelationObjectID(t.Subject.ID) &&
+		(t.Subject.Relation == "" || relationNameRegexp.MatchString(t.Subject.Relation)) &&
+		(t.Subject.Type != RelationSubjectTypeUser || t.Subject.Relation == "")
+}
+
+func isValidRelationObjectID(id string) bool {
+	return id != "" && len(id) <= 200 && !strings.ContainsAny(id, "#@: ")
+}
+
+// RelationSchema defines the object types of a project with their relations and permissions.
+// Tuples can only be written if the schema of the project allows them.
+type RelationSchema struct {
+	Types []*RelationObjectType `json:"types"`
+}
+
+type RelationObjectType struct {
+	Name        string                  `json:"name"`
+	Relations   []*RelationDefinition   `json:"relations,omitempty"`
+	Permissions []*PermissionDefinition `json:"permissions,omitempty"`
+}
+
+// RelationDefinition lists the subjects allowed for the relation,
+// either an object type (e.g. user) or a relation of an object type (e.g. group#member)
+type RelationDefinition struct {
+	Name     string   `json:"name"`
+	Subjects []string `json:"subjects"`
+}
+
+// PermissionDefinition is granted if any of the entries of the union is granted.
+// An entry is either the name of a relation or permission of the same object type,
+// a traversal of a relation to the permission of the related objects (e.g. parent->view)
+// or a project role (e.g. role:admin) granted to the user through a user grant.
+type PermissionDefinition struct {
+	Name  string   `json:"name"`
+	Union []string `json:"union"`
+}
+
+func (s *RelationSchema) ObjectType(name string) *RelationObjectType {
+	if s == nil {
+		return nil
+	}
+	for _, objectType := range s.Types {
+		if objectType.Name == name {
+			return objectType
+		}
+	}
+	return nil
+}
+
+func (t *RelationObjectType) Relation(name string) *RelationDefinition {
+	for _, relation := range t.Relations {
+		if relation.Name == name {
+			return relation
+		}
+	}
+	return nil
+}
+
+func (t *RelationObjectType) Permission(name string) *PermissionDefinition {
+	for _, permission := range t.Permissions {
+		if permission.Name == name {
+			return permission
+		}
+	}
+	return nil
+}
+
+// IsValid checks the names and that all references of relations and permissions are defined in the schema
+func (s *RelationSchema) IsValid() bool {
+	if s == nil || len(s.Types) == 0 {
+		return false
+	}
+	types := make([]string, 0, len(s.Types))
+	for _, objectType := range s.Types {
+		if !relationNameRegexp.MatchString(objectType.Name) ||
+			objectType.Name == RelationSubjectTypeUser ||
+			slices.Contains(types, objectType.Name) {
+			return false
+		}
+		types = append(types, objectType.Name)
+		if !objectType.hasUniqueNames() {
+			return false
+		}
+	}
+	for _, objectType := range s.Types {
+		for _, relation := range objectType.Relations {
+			if !s.isValidRelation(relation) {
+				return false
+			}
+		}
+		for _, permission := range objectType.Permissions {
+			if !s.isValidPermission(objectType, permission) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *RelationObjectType) hasUniqueNames() bool {
+	names := make([]string, 0, len(t.Relations)+len(t.Permissions))
+	for _, relation := range t.Relations {
+		if !relationNameRegexp.MatchString(relation.Name) || slices.Contains(names, relation.Name) {
+			return false
+		}
+		names = append(names, relation.Name)
+	}
+	for _, permission := range t.Permissions {
+		if !relationNameRegexp.MatchString(permission.Name) || slices.Contains(names, permission.Name) {
+			return false
+		}
+		names = append(names, permission.Name)
+	}
+	return true
+}
+
+func (s *RelationSchema) isValidRelation(relation *RelationDefinition) bool {
+	if len(relation.Subjects) == 0 {
+		return false
+	}
+	for _, subject := range relation.Subjects {
+		subjectType, subjectRelation, isUserset := strings.Cut(subject, "#")
+		if subjectType == RelationSubjectTypeUser && !isUserset {
+			continue
+		}
+		objectType := s.ObjectType(subjectType)
+		if objectType == nil {
+			return false
+		}
+		if isUserset && !objectType.hasName(subjectRelation) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *RelationSchema) isValidPermission(objectType *RelationObjectType, permission *PermissionDefinition) bool {
+	if len(permission.Union) == 0 {
+		return false
+	}
+	for _, entry := range permission.Union {
+		if role, ok := strings.CutPrefix(entry, RelationRolePrefix); ok {
+			if role == "" {
+				return false
+			}
+			continue
+		}
+		relationName, target, isArrow := strings.Cut(entry, relationArrow)
+		if !isArrow {
+			if entry == permission.Name || !objectType.hasName(entry) {
+				return false
+			}
+			continue
+		}
+		relation := objectType.Relation(relationName)
+		if relation == nil {
+			return false
+		}
+		// the target must be defined on every object type the relation can point to
+		for _, subject := range relation.Subjects {
+			related := s.ObjectType(subject)
+			if related == nil || !related.hasName(target) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (t *RelationObjectType) hasName(name string) bool {
+	return t.Relation(name) != nil || t.Permission(name) != nil
+}
+
+// Allows checks if the tuple is defined by the schema
+func (s *RelationSchema) Allows(tuple *RelationTuple) bool {
+	objectType := s.ObjectType(tuple.ObjectType)
+	if objectType == nil {
+		return false
+	}
+	relation := objectType.Relation(tuple.Relation)
+	if relation == nil {
+		return false
+	}
+	subject := tuple.Subject.Type
+	if tuple.Subject.Relation != "" {
+		subject += "#" + tuple.Subject.Relation
+	}
+	return slices.Contains(relation.Subjects, subject)
+}
+
+// SplitRelationArrow returns the relation and the target permission of a traversal (e.g. parent->view)
+func SplitRelationArrow(entry string) (relation, target string, ok bool) {
+	return strings.Cut(entry, relationArrow)
+}
+
+// RelationDetails are returned when tuples are written or deleted.
+// The consistency token can be passed to checks so they are evaluated on data at least as fresh as the write.
+type RelationDetails struct {
+	ObjectDetails
+	ConsistencyToken string
+}
+
+// RelationConsistency defines the freshness of the data checks are evaluated on.
+// If neither is set, the current state of the projection is used.
+type RelationConsistency struct {
+	// AtLeastAsFresh is a token returned by a write
+	AtLeastAsFresh string
+	// FullyConsistent evaluates on all events written so far
+	FullyConsistent bool
+}
+
+// NewRelationConsistencyToken encodes the position of the eventstore
+func NewRelationConsistencyToken(position float64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatFloat(position, 'f', -1, 64)))
+}
+
+// ParseRelationConsistencyToken returns the position of the eventstore encoded in the token
+func ParseRelationConsistencyToken(token string) (float64, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, zerrors.ThrowInvalidArgument(err, "DOMAIN-Rl3fKo", "Errors.Relation.ConsistencyTokenInvalid")
+	}
+	position, err := strconv.ParseFloat(string(decoded), 64)
+	if err != nil {
+		return 0, zerrors.ThrowInvalidArgument(err, "DOMAIN-Rl3gPl", "Errors.Relation.ConsistencyTokenInvalid")
+	}
+	return position, nil
+}
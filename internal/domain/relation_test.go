@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseRelationTuple(t *testing.T) {
+	tests := []struct {
+		name    string
+		tuple   string
+		want    *RelationTuple
+		wantErr bool
+	}{
+		{
+			name:    "missing subject",
+			tuple:   "document:1#editor",
+			wantErr: true,
+		},
+		{
+			name:    "missing relation",
+			tuple:   "document:1@user:2",
+			wantErr: true,
+		},
+		{
+			name:    "missing object id",
+			tuple:   "document#editor@user:2",
+			wantErr: true,
+		},
+		{
+			name:    "uppercase relation",
+			tuple:   "document:1#Editor@user:2",
+			wantErr: true,
+		},
+		{
+			name:    "userset of user",
+			tuple:   "document:1#editor@user:2#member",
+			wantErr: true,
+		},
+		{
+			name:  "user",
+			tuple: "document:1#editor@user:2",
+			want: &RelationTuple{
+				ObjectType: "document",
+				ObjectID:   "1",
+				Relation:   "editor",
+				Subject:    RelationSubject{Type: "user", ID: "2"},
+			},
+		},
+		{
+			name:  "userset",
+			tuple: "document:1#editor@group:eng#member",
+			want: &RelationTuple{
+				ObjectType: "document",
+				ObjectID:   "1",
+				Relation:   "editor",
+				Subject:    RelationSubject{Type: "group", ID: "eng", Relation: "member"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRelationTuple(tt.tuple)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.tuple, got.String())
+		})
+	}
+}
+
+func testRelationSchema() *RelationSchema {
+	return &RelationSchema{
+		Types: []*RelationObjectType{
+			{
+				Name: "group",
+				Relations: []*RelationDefinition{
+					{Name: "member", Subjects: []string{"user", "group#member"}},
+				},
+			},
+			{
+				Name: "folder",
+				Relations: []*RelationDefinition{
+					{Name: "viewer", Subjects: []string{"user", "group#member"}},
+				},
+				Permissions: []*PermissionDefinition{
+					{Name: "view", Union: []string{"viewer", "role:admin"}},
+				},
+			},
+			{
+				Name: "document",
+				Relations: []*RelationDefinition{
+					{Name: "parent", Subjects: []string{"folder"}},
+					{Name: "editor", Subjects: []string{"user", "group#member"}},
+				},
+				Permissions: []*PermissionDefinition{
+					{Name: "view", Union: []string{"editor", "parent->view"}},
+				},
+			},
+		},
+	}
+}
+
+func TestRelationSchema_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema func() *RelationSchema
+		want   bool
+	}{
+		{
+			name:   "nil",
+			schema: func() *RelationSchema { return nil },
+			want:   false,
+		},
+		{
+			name: "user type defined",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[0].Name = RelationSubjectTypeUser
+				return s
+			},
+			want: false,
+		},
+		{
+			name: "duplicate name",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[1].Permissions[0].Name = "viewer"
+				return s
+			},
+			want: false,
+		},
+		{
+			name: "unknown subject type",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[0].Relations[0].Subjects = []string{"team"}
+				return s
+			},
+			want: false,
+		},
+		{
+			name: "unknown subject relation",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[0].Relations[0].Subjects = []string{"group#owner"}
+				return s
+			},
+			want: false,
+		},
+		{
+			name: "unknown arrow target",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[2].Permissions[0].Union = []string{"parent->edit"}
+				return s
+			},
+			want: false,
+		},
+		{
+			name: "self reference",
+			schema: func() *RelationSchema {
+				s := testRelationSchema()
+				s.Types[2].Permissions[0].Union = []string{"view"}
+				return s
+			},
+			want: false,
+		},
+		{
+			name:   "valid",
+			schema: testRelationSchema,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.schema().IsValid())
+		})
+	}
+}
+
+func TestRelationSchema_Allows(t *testing.T) {
+	schema := testRelationSchema()
+	tests := []struct {
+		tuple string
+		want  bool
+	}{
+		{tuple: "document:1#editor@user:2", want: true},
+		{tuple: "document:1#editor@group:eng#member", want: true},
+		{tuple: "document:1#parent@folder:a", want: true},
+		{tuple: "document:1#parent@user:2", want: false},
+		{tuple: "document:1#view@user:2", want: false},
+		{tuple: "report:1#editor@user:2", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tuple, func(t *testing.T) {
+			tuple, err := ParseRelationTuple(tt.tuple)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schema.Allows(tuple))
+		})
+	}
+}
+
+func TestRelationConsistencyToken(t *testing.T) {
+	position, err := ParseRelationConsistencyToken(NewRelationConsistencyToken(1700000000.123456))
+	require.NoError(t, err)
+	assert.Equal(t, 1700000000.123456, position)
+
+	_, err = ParseRelationConsistencyToken("not a token")
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+}
@@ -0,0 +1,46 @@
+package domain
+
+// RiskAction is the consequence of a risk assessment of a session check.
+type RiskAction int32
+
+const (
+	RiskActionUnspecified RiskAction = iota
+	RiskActionAllow
+	RiskActionNotify
+	RiskActionRequireMFA
+	RiskActionBlock
+)
+
+// RiskSignal is an anomaly detected during the risk assessment of a session check.
+type RiskSignal int32
+
+const (
+	RiskSignalUnspecified RiskSignal = iota
+	RiskSignalNewCountry
+	RiskSignalNewASN
+	RiskSignalImpossibleTravel
+	RiskSignalNewDevice
+	RiskSignalIPFailures
+)
+
+// RiskThresholds defines the minimal score of a risk assessment for an action to be taken.
+// A threshold of 0 disables the corresponding action.
+type RiskThresholds struct {
+	Notify     int `json:"notify,omitempty"`
+	RequireMFA int `json:"requireMfa,omitempty"`
+	Block      int `json:"block,omitempty"`
+}
+
+// Action returns the action of the highest threshold reached by the score
+func (t RiskThresholds) Action(score int) RiskAction {
+	switch {
+	case t.Block > 0 && score >= t.Block:
+		return RiskActionBlock
+	case t.RequireMFA > 0 && score >= t.RequireMFA:
+		return RiskActionRequireMFA
+	case t.Notify > 0 && score >= t.Notify:
+		return RiskActionNotify
+	default:
+		return RiskActionAllow
+	}
+}
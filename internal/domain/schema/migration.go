@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MigrationType defines how the data of a user is changed when it's migrated to a new revision of its schema.
+type MigrationType string
+
+const (
+	// MigrationTypeRename renames the field, the new name is passed in [Migration.To].
+	// The field stays in the same object.
+	MigrationTypeRename MigrationType = "rename"
+	// MigrationTypeMove moves the field to the path passed in [Migration.To].
+	// Missing objects on the path are created.
+	MigrationTypeMove MigrationType = "move"
+	// MigrationTypeDefault sets the field to [Migration.Value] if it isn't set yet.
+	MigrationTypeDefault MigrationType = "default"
+)
+
+// Migration is declared on a new revision of a schema and applied to the data of the users of the previous revision.
+// Fields are addressed by JSON pointers (RFC 6901), e.g. "/address/street", only objects can be traversed.
+type Migration struct {
+	Type  MigrationType   `json:"type"`
+	Field string          `json:"field"`
+	To    string          `json:"to,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+func (m *Migration) Valid() error {
+	if _, err := parsePointer(m.Field); err != nil {
+		return err
+	}
+	switch m.Type {
+	case MigrationTypeRename:
+		if m.To == "" || strings.Contains(m.To, "/") {
+			return zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2bBb", "Errors.UserSchema.Migration.Invalid")
+		}
+	case MigrationTypeMove:
+		if _, err := parsePointer(m.To); err != nil {
+			return err
+		}
+		if m.To == m.Field || strings.HasPrefix(m.To, m.Field+"/") {
+			return zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2cCc", "Errors.UserSchema.Migration.Invalid")
+		}
+	case MigrationTypeDefault:
+		if len(m.Value) == 0 || !json.Valid(m.Value) {
+			return zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2dDd", "Errors.UserSchema.Migration.Invalid")
+		}
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2eEe", "Errors.UserSchema.Migration.Invalid")
+	}
+	return nil
+}
+
+// Migrate applies the migrations in the passed order to the data and returns the migrated data.
+// Migrations of fields, which are not set, are skipped.
+func Migrate(data json.RawMessage, migrations []*Migration) (json.RawMessage, error) {
+	if len(migrations) == 0 {
+		return data, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// numbers are kept as they are, so that large integers don't lose precision
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil || object == nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SCHEMA-Mi3aAa", "Errors.User.Invalid")
+	}
+	for _, migration := range migrations {
+		if err := migration.apply(object); err != nil {
+			return nil, err
+		}
+	}
+	migrated, err := json.Marshal(object)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCHEMA-Mi3bBb", "Errors.Internal")
+	}
+	return migrated, nil
+}
+
+func (m *Migration) apply(object map[string]interface{}) error {
+	path, err := parsePointer(m.Field)
+	if err != nil {
+		return err
+	}
+	switch m.Type {
+	case MigrationTypeRename:
+		return moveField(object, path, append(path[:len(path)-1:len(path)-1], m.To))
+	case MigrationTypeMove:
+		to, err := parsePointer(m.To)
+		if err != nil {
+			return err
+		}
+		return moveField(object, path, to)
+	case MigrationTypeDefault:
+		parent, ok := lookupParent(object, path, true)
+		if !ok {
+			return zerrors.ThrowPreconditionFailed(nil, "SCHEMA-Mi3cCc", "Errors.UserSchema.Migration.NotApplicable")
+		}
+		if _, ok := parent[path[len(path)-1]]; ok {
+			return nil
+		}
+		decoder := json.NewDecoder(bytes.NewReader(m.Value))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "SCHEMA-Mi3dDd", "Errors.UserSchema.Migration.Invalid")
+		}
+		parent[path[len(path)-1]] = value
+		return nil
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi3eEe", "Errors.UserSchema.Migration.Invalid")
+	}
+}
+
+func moveField(object map[string]interface{}, from, to []string) error {
+	source, ok := lookupParent(object, from, false)
+	if !ok {
+		return nil
+	}
+	value, ok := source[from[len(from)-1]]
+	if !ok {
+		return nil
+	}
+	target, ok := lookupParent(object, to, true)
+	if !ok {
+		return zerrors.ThrowPreconditionFailed(nil, "SCHEMA-Mi3fFf", "Errors.UserSchema.Migration.NotApplicable")
+	}
+	if _, ok := target[to[len(to)-1]]; ok {
+		return zerrors.ThrowPreconditionFailed(nil, "SCHEMA-Mi3gGg", "Errors.UserSchema.Migration.NotApplicable")
+	}
+	delete(source, from[len(from)-1])
+	target[to[len(to)-1]] = value
+	return nil
+}
+
+// lookupParent returns the object containing the last segment of the path.
+// If create is set, missing objects on the path are created.
+func lookupParent(object map[string]interface{}, path []string, create bool) (map[string]interface{}, bool) {
+	current := object
+	for _, segment := range path[:len(path)-1] {
+		next, ok := current[segment]
+		if !ok {
+			if !create {
+				return nil, false
+			}
+			next = make(map[string]interface{})
+			current[segment] = next
+		}
+		nextObject, ok := next.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current = nextObject
+	}
+	return current, true
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if len(pointer) < 2 || pointer[0] != '/' {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2aAa", "Errors.UserSchema.Migration.Invalid")
+	}
+	segments := strings.Split(pointer[1:], "/")
+	for i, segment := range segments {
+		if segment == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2fFf", "Errors.UserSchema.Migration.Invalid")
+		}
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+	return segments, nil
+}
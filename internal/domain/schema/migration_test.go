@@ -0,0 +1,172 @@
+package schema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestMigration_Valid(t *testing.T) {
+	tests := []struct {
+		name      string
+		migration *Migration
+		wantErr   error
+	}{
+		{
+			"field missing",
+			&Migration{Type: MigrationTypeRename, To: "name"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2aAa", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"field empty segment",
+			&Migration{Type: MigrationTypeRename, Field: "/profile//name", To: "name"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2fFf", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"rename to path",
+			&Migration{Type: MigrationTypeRename, Field: "/name", To: "/profile/name"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2bBb", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"move into itself",
+			&Migration{Type: MigrationTypeMove, Field: "/profile", To: "/profile/old"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2cCc", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"default without value",
+			&Migration{Type: MigrationTypeDefault, Field: "/name"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2dDd", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"unknown type",
+			&Migration{Type: "copy", Field: "/name", To: "/other"},
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi2eEe", "Errors.UserSchema.Migration.Invalid"),
+		},
+		{
+			"rename ok",
+			&Migration{Type: MigrationTypeRename, Field: "/profile/name", To: "displayName"},
+			nil,
+		},
+		{
+			"move ok",
+			&Migration{Type: MigrationTypeMove, Field: "/name", To: "/profile/name"},
+			nil,
+		},
+		{
+			"default ok",
+			&Migration{Type: MigrationTypeDefault, Field: "/active", Value: json.RawMessage(`true`)},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.migration.Valid(), tt.wantErr)
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		migrations []*Migration
+		want       string
+		wantErr    error
+	}{
+		{
+			"no migrations",
+			`{"name": "user"}`,
+			nil,
+			`{"name": "user"}`,
+			nil,
+		},
+		{
+			"invalid data",
+			`["user"]`,
+			[]*Migration{{Type: MigrationTypeRename, Field: "/name", To: "username"}},
+			"",
+			zerrors.ThrowInvalidArgument(nil, "SCHEMA-Mi3aAa", "Errors.User.Invalid"),
+		},
+		{
+			"rename",
+			`{"profile": {"name": "user"}}`,
+			[]*Migration{{Type: MigrationTypeRename, Field: "/profile/name", To: "displayName"}},
+			`{"profile": {"displayName": "user"}}`,
+			nil,
+		},
+		{
+			"rename missing field skipped",
+			`{"profile": {}}`,
+			[]*Migration{{Type: MigrationTypeRename, Field: "/profile/name", To: "displayName"}},
+			`{"profile": {}}`,
+			nil,
+		},
+		{
+			"move creates objects",
+			`{"street": "Main Street", "number": 12345678901234567890}`,
+			[]*Migration{{Type: MigrationTypeMove, Field: "/street", To: "/address/street"}},
+			`{"address": {"street": "Main Street"}, "number": 12345678901234567890}`,
+			nil,
+		},
+		{
+			"move to existing field",
+			`{"street": "Main Street", "address": {"street": "Second Street"}}`,
+			[]*Migration{{Type: MigrationTypeMove, Field: "/street", To: "/address/street"}},
+			"",
+			zerrors.ThrowPreconditionFailed(nil, "SCHEMA-Mi3gGg", "Errors.UserSchema.Migration.NotApplicable"),
+		},
+		{
+			"move through non object",
+			`{"street": "Main Street", "address": "Second Street"}`,
+			[]*Migration{{Type: MigrationTypeMove, Field: "/street", To: "/address/street"}},
+			"",
+			zerrors.ThrowPreconditionFailed(nil, "SCHEMA-Mi3fFf", "Errors.UserSchema.Migration.NotApplicable"),
+		},
+		{
+			"default set",
+			`{"name": "user"}`,
+			[]*Migration{{Type: MigrationTypeDefault, Field: "/settings/language", Value: json.RawMessage(`"en"`)}},
+			`{"name": "user", "settings": {"language": "en"}}`,
+			nil,
+		},
+		{
+			"default keeps existing value",
+			`{"settings": {"language": "de"}}`,
+			[]*Migration{{Type: MigrationTypeDefault, Field: "/settings/language", Value: json.RawMessage(`"en"`)}},
+			`{"settings": {"language": "de"}}`,
+			nil,
+		},
+		{
+			"migrations applied in order",
+			`{"first": "user"}`,
+			[]*Migration{
+				{Type: MigrationTypeRename, Field: "/first", To: "given"},
+				{Type: MigrationTypeMove, Field: "/given", To: "/name/given"},
+				{Type: MigrationTypeDefault, Field: "/name/family", Value: json.RawMessage(`""`)},
+			},
+			`{"name": {"given": "user", "family": ""}}`,
+			nil,
+		},
+		{
+			"escaped pointer",
+			`{"a/b": 1}`,
+			[]*Migration{{Type: MigrationTypeRename, Field: "/a~1b", To: "ab"}},
+			`{"ab": 1}`,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Migrate(json.RawMessage(tt.data), tt.migrations)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -6,6 +6,7 @@ const (
 	TargetTypeWebhook TargetType = iota
 	TargetTypeCall
 	TargetTypeAsync
+	TargetTypeWASM
 )
 
 type TargetState int32
@@ -24,3 +25,40 @@ func (s TargetState) Valid() bool {
 func (s TargetState) Exists() bool {
 	return s != TargetUnspecified && s != TargetRemoved
 }
+
+type TargetDeliveryState int32
+
+const (
+	TargetDeliveryStateUnspecified TargetDeliveryState = iota
+	TargetDeliveryStateRequested
+	TargetDeliveryStateRetrying
+	TargetDeliveryStateSucceeded
+	TargetDeliveryStateFailed
+	TargetDeliveryStateReplayed
+	targetDeliveryStateCount
+)
+
+func (s TargetDeliveryState) Valid() bool {
+	return s >= 0 && s < targetDeliveryStateCount
+}
+
+// IsPending returns if the delivery is still handled by the worker
+func (s TargetDeliveryState) IsPending() bool {
+	return s == TargetDeliveryStateRequested || s == TargetDeliveryStateRetrying
+}
+
+// TargetTLS contains the PEM encoded client certificate and key used for mutual TLS
+// and an optional bundle of CA certificates used to verify the target.
+type TargetTLS struct {
+	ClientCertificate []byte
+	ClientKey         []byte
+	CACertificates    []byte
+}
+
+// TargetOAuth2 contains the client credentials used to request a bearer token for calls to the target.
+type TargetOAuth2 struct {
+	TokenEndpoint string
+	ClientID      string
+	ClientSecret  string
+	Scopes        []string
+}
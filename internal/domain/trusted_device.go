@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// TrustedDevice is a user agent the user chose to remember after a successful multi-factor verification.
+type TrustedDevice struct {
+	// DeviceID is the id of the user agent cookie
+	DeviceID string
+	// Fingerprint describes the device, e.g. the browser and operating system taken from the user agent header
+	Fingerprint string
+	IP          string
+}
+
+// TrustedDeviceValid checks if a device trusted at the provided time is still trusted
+// with the lifetime of the login policy. A lifetime of zero disables trusted devices.
+func TrustedDeviceValid(trustedAt time.Time, lifetime time.Duration) bool {
+	if lifetime <= 0 || trustedAt.IsZero() {
+		return false
+	}
+	return time.Now().Before(trustedAt.Add(lifetime))
+}
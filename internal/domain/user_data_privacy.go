@@ -0,0 +1,19 @@
+package domain
+
+// UserDataExportState is the state of an export of all data of a user, requested by the user itself.
+type UserDataExportState int32
+
+const (
+	UserDataExportStateUnspecified UserDataExportState = iota
+	// UserDataExportStateRequested is set until the archive is generated asynchronously.
+	UserDataExportStateRequested
+	// UserDataExportStateReady is set as soon as the archive can be downloaded.
+	UserDataExportStateReady
+	// UserDataExportStateDownloaded is set after the archive was downloaded and removed from the storage.
+	UserDataExportStateDownloaded
+	userDataExportStateCount
+)
+
+func (s UserDataExportState) Valid() bool {
+	return s > UserDataExportStateUnspecified && s < userDataExportStateCount
+}
@@ -10,6 +10,7 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	AccessValidity
 }
 
 type UserGrantState int32
@@ -0,0 +1,64 @@
+package domain
+
+// UserImportFormat is the format of the file of a bulk import of users.
+type UserImportFormat int32
+
+const (
+	UserImportFormatUnspecified UserImportFormat = iota
+	// UserImportFormatCSV expects a header row with the column names.
+	UserImportFormatCSV
+	// UserImportFormatNDJSON expects one JSON object per line.
+	UserImportFormatNDJSON
+	userImportFormatCount
+)
+
+func (f UserImportFormat) Valid() bool {
+	return f > UserImportFormatUnspecified && f < userImportFormatCount
+}
+
+// UserImportJobState is the state of an asynchronous bulk import of users.
+type UserImportJobState int32
+
+const (
+	UserImportJobStateUnspecified UserImportJobState = iota
+	// UserImportJobStateQueued is set until a worker starts processing the job.
+	UserImportJobStateQueued
+	UserImportJobStateRunning
+	// UserImportJobStateCompleted is set after all rows were processed, even if some of them failed.
+	UserImportJobStateCompleted
+	UserImportJobStateCanceled
+	// UserImportJobStateFailed is set if the job could not be processed at all.
+	UserImportJobStateFailed
+	userImportJobStateCount
+)
+
+func (s UserImportJobState) Valid() bool {
+	return s > UserImportJobStateUnspecified && s < userImportJobStateCount
+}
+
+// Done returns true if the job will not be processed anymore.
+func (s UserImportJobState) Done() bool {
+	return s == UserImportJobStateCompleted ||
+		s == UserImportJobStateCanceled ||
+		s == UserImportJobStateFailed
+}
+
+// UserImportOperation is the operation executed for a row of a bulk import of users.
+type UserImportOperation string
+
+const (
+	UserImportOperationCreate     UserImportOperation = "create"
+	UserImportOperationUpdate     UserImportOperation = "update"
+	UserImportOperationDeactivate UserImportOperation = "deactivate"
+)
+
+func (o UserImportOperation) Valid() bool {
+	switch o {
+	case UserImportOperationCreate,
+		UserImportOperationUpdate,
+		UserImportOperationDeactivate:
+		return true
+	default:
+		return false
+	}
+}
@@ -22,5 +22,6 @@ const (
 	AuthenticatorTypeOTPSMS
 	AuthenticatorTypeAuthenticationKey
 	AuthenticatorTypeIdentityProvider
+	AuthenticatorTypePersonalAccessToken
 	authenticatorTypeCount
 )
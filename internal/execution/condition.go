@@ -0,0 +1,143 @@
+package execution
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// conditionEnv declares the variables available in conditions of executions,
+// which are the fields of the body sent to the targets and the affected user.
+// The event is only set for event executions, the request and response only for API calls.
+var conditionEnv = mustConditionEnv()
+
+// conditionCostLimit bounds the evaluation cost of a condition,
+// so that expensive expressions (e.g. nested comprehensions over large lists) can not block the calls
+const conditionCostLimit = 10000
+
+// conditionPrograms caches the compiled conditions by their expression
+var conditionPrograms sync.Map
+
+func mustConditionEnv() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("fullMethod", cel.StringType),
+		cel.Variable("instanceID", cel.StringType),
+		cel.Variable("orgID", cel.StringType),
+		cel.Variable("projectID", cel.StringType),
+		cel.Variable("userID", cel.StringType),
+		cel.Variable("request", cel.DynType),
+		cel.Variable("response", cel.DynType),
+		cel.Variable("event", cel.DynType),
+		cel.Variable("user", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return env
+}
+
+// ConditionUser is the user affected by an execution, available as `user` in conditions.
+type ConditionUser struct {
+	ID       string            `json:"id"`
+	OrgID    string            `json:"orgID"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// ConditionUserInfo is implemented by the context infos which provide the affected user to the conditions.
+type ConditionUserInfo interface {
+	GetConditionUser() *ConditionUser
+}
+
+// ValidateCondition checks if the expression is a valid condition, which has to result in a boolean.
+func ValidateCondition(condition string) error {
+	_, err := compileCondition(condition)
+	return err
+}
+
+func compileCondition(condition string) (cel.Program, error) {
+	if program, ok := conditionPrograms.Load(condition); ok {
+		return program.(cel.Program), nil
+	}
+	ast, issues := conditionEnv.Compile(condition)
+	if issues != nil && issues.Err() != nil {
+		return nil, zerrors.ThrowInvalidArgument(issues.Err(), "EXEC-9g3kdmxw1p", "Errors.Execution.ConditionInvalid")
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXEC-t4nq0yc2ve", "Errors.Execution.ConditionInvalid")
+	}
+	program, err := conditionEnv.Program(ast, cel.CostLimit(conditionCostLimit))
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EXEC-w2o6hbqe7a", "Errors.Execution.ConditionInvalid")
+	}
+	conditionPrograms.Store(condition, program)
+	return program, nil
+}
+
+// MatchConditions returns if all conditions are fulfilled for the body which would be sent to the target,
+// if no conditions are provided it always matches.
+func MatchConditions(conditions []string, info ContextInfoRequest) (bool, error) {
+	if len(conditions) == 0 {
+		return true, nil
+	}
+	variables, err := conditionVariables(info)
+	if err != nil {
+		return false, err
+	}
+	for _, condition := range conditions {
+		matched, err := matchCondition(condition, variables)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func matchCondition(condition string, variables map[string]any) (bool, error) {
+	program, err := compileCondition(condition)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := program.Eval(variables)
+	if err != nil {
+		return false, zerrors.ThrowPreconditionFailed(err, "EXEC-1d4ymq0xhh", "Errors.Execution.ConditionFailed")
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, zerrors.ThrowPreconditionFailed(nil, "EXEC-x2bwgk8o2u", "Errors.Execution.ConditionFailed")
+	}
+	return matched, nil
+}
+
+// conditionVariables uses the body sent to the targets as variables,
+// so that the conditions can be written against the same structure the targets receive
+func conditionVariables(info ContextInfoRequest) (map[string]any, error) {
+	variables := map[string]any{
+		"fullMethod": "",
+		"instanceID": "",
+		"orgID":      "",
+		"projectID":  "",
+		"userID":     "",
+		"request":    map[string]any{},
+		"response":   map[string]any{},
+		"event":      map[string]any{},
+		"user":       map[string]any{"id": "", "orgID": "", "metadata": map[string]any{}},
+	}
+	if err := json.Unmarshal(info.GetHTTPRequestBody(), &variables); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-0hv9qyfz8b", "Errors.Execution.ConditionFailed")
+	}
+	userInfo, ok := info.(ConditionUserInfo)
+	if !ok {
+		return variables, nil
+	}
+	if user := userInfo.GetConditionUser(); user != nil {
+		metadata := make(map[string]any, len(user.Metadata))
+		for key, value := range user.Metadata {
+			metadata[key] = value
+		}
+		variables["user"] = map[string]any{"id": user.ID, "orgID": user.OrgID, "metadata": metadata}
+	}
+	return variables, nil
+}
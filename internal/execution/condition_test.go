@@ -0,0 +1,256 @@
+package execution_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type mockConditionInfo struct {
+	FullMethod string      `json:"fullMethod,omitempty"`
+	OrgID      string      `json:"orgID,omitempty"`
+	Request    interface{} `json:"request,omitempty"`
+
+	User *execution.ConditionUser `json:"-"`
+}
+
+func (c *mockConditionInfo) GetHTTPRequestBody() []byte {
+	data, _ := json.Marshal(c)
+	return data
+}
+
+func (c *mockConditionInfo) GetConditionUser() *execution.ConditionUser {
+	return c.User
+}
+
+func Test_ValidateCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		wantErr   func(error) bool
+	}{
+		{
+			"syntax error",
+			"request.userId ==",
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"unknown variable",
+			"unknown == 'value'",
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"not boolean",
+			"orgID + 'suffix'",
+			zerrors.IsErrorInvalidArgument,
+		},
+		{
+			"request field",
+			"request.userId == 'user'",
+			nil,
+		},
+		{
+			"user metadata",
+			"'tier' in user.metadata && user.metadata['tier'] == 'premium'",
+			nil,
+		},
+		{
+			"event payload",
+			"event.payload.email.endsWith('@example.com')",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := execution.ValidateCondition(tt.condition)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err))
+		})
+	}
+}
+
+func Test_MatchConditions(t *testing.T) {
+	type args struct {
+		conditions []string
+		info       execution.ContextInfoRequest
+	}
+	type res struct {
+		matched bool
+		wantErr func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"no conditions, matched",
+			args{
+				conditions: nil,
+				info:       &mockConditionInfo{},
+			},
+			res{
+				matched: true,
+			},
+		},
+		{
+			"request field, matched",
+			args{
+				conditions: []string{"request.userId == 'user'"},
+				info: &mockConditionInfo{
+					Request: map[string]string{"userId": "user"},
+				},
+			},
+			res{
+				matched: true,
+			},
+		},
+		{
+			"request field, not matched",
+			args{
+				conditions: []string{"request.userId == 'user'"},
+				info: &mockConditionInfo{
+					Request: map[string]string{"userId": "other"},
+				},
+			},
+			res{
+				matched: false,
+			},
+		},
+		{
+			"missing request field, error",
+			args{
+				conditions: []string{"request.userId == 'user'"},
+				info:       &mockConditionInfo{},
+			},
+			res{
+				matched: false,
+				wantErr: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"missing request field with has, not matched",
+			args{
+				conditions: []string{"has(request.userId) && request.userId == 'user'"},
+				info:       &mockConditionInfo{},
+			},
+			res{
+				matched: false,
+			},
+		},
+		{
+			"user metadata, matched",
+			args{
+				conditions: []string{"user.orgID == 'org'", "user.metadata['tier'] == 'premium'"},
+				info: &mockConditionInfo{
+					User: &execution.ConditionUser{
+						ID:       "user",
+						OrgID:    "org",
+						Metadata: map[string]string{"tier": "premium"},
+					},
+				},
+			},
+			res{
+				matched: true,
+			},
+		},
+		{
+			"user metadata, one condition not matched",
+			args{
+				conditions: []string{"user.orgID == 'org'", "user.metadata['tier'] == 'premium'"},
+				info: &mockConditionInfo{
+					User: &execution.ConditionUser{
+						ID:       "user",
+						OrgID:    "org",
+						Metadata: map[string]string{"tier": "free"},
+					},
+				},
+			},
+			res{
+				matched: false,
+			},
+		},
+		{
+			"event payload, matched",
+			args{
+				conditions: []string{"event.type == 'user.human.added' && event.payload.email.endsWith('@example.com')"},
+				info: &execution.ContextInfoEvent{
+					Event: &execution.EventInfo{
+						Type:    "user.human.added",
+						Payload: []byte(`{"email":"test@example.com"}`),
+					},
+				},
+			},
+			res{
+				matched: true,
+			},
+		},
+		{
+			"event payload, not matched",
+			args{
+				conditions: []string{"event.type == 'user.human.added' && event.payload.email.endsWith('@example.com')"},
+				info: &execution.ContextInfoEvent{
+					Event: &execution.EventInfo{
+						Type:    "user.human.added",
+						Payload: []byte(`{"email":"test@example.org"}`),
+					},
+				},
+			},
+			res{
+				matched: false,
+			},
+		},
+		{
+			"event of api call, error",
+			args{
+				conditions: []string{"event.payload.email.endsWith('@example.com')"},
+				info:       &mockConditionInfo{},
+			},
+			res{
+				matched: false,
+				wantErr: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"cost limit exceeded, error",
+			args{
+				conditions: []string{"request.values.all(a, request.values.all(b, request.values.all(c, a + b + c >= 0)))"},
+				info: &mockConditionInfo{
+					Request: map[string][]int{"values": make([]int, 100)},
+				},
+			},
+			res{
+				matched: false,
+				wantErr: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"no user, not matched",
+			args{
+				conditions: []string{"user.orgID == 'org'"},
+				info:       &mockConditionInfo{OrgID: "org"},
+			},
+			res{
+				matched: false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := execution.MatchConditions(tt.args.conditions, tt.args.info)
+			if tt.res.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, tt.res.wantErr(err))
+			}
+			assert.Equal(t, tt.res.matched, matched)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package execution
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var _ ContextInfo = &ContextInfoEvent{}
+
+// ContextInfoEvent is the body sent to the targets of event executions,
+// the event is available as `event` in the conditions
+type ContextInfoEvent struct {
+	InstanceID string     `json:"instanceID,omitempty"`
+	OrgID      string     `json:"orgID,omitempty"`
+	UserID     string     `json:"userID,omitempty"`
+	Event      *EventInfo `json:"event,omitempty"`
+}
+
+// EventInfo is the event which triggered the execution with its payload
+type EventInfo struct {
+	AggregateID   string          `json:"aggregateID"`
+	AggregateType string          `json:"aggregateType"`
+	ResourceOwner string          `json:"resourceOwner"`
+	Version       string          `json:"version"`
+	Sequence      uint64          `json:"sequence"`
+	Type          string          `json:"type"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Creator       string          `json:"creator"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+}
+
+func NewContextInfoEvent(event eventstore.Event) (*ContextInfoEvent, error) {
+	var payload json.RawMessage
+	if err := event.Unmarshal(&payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-v0k3fz8q1m", "Errors.Execution.Failed")
+	}
+	aggregate := event.Aggregate()
+	return &ContextInfoEvent{
+		InstanceID: aggregate.InstanceID,
+		OrgID:      aggregate.ResourceOwner,
+		UserID:     event.Creator(),
+		Event: &EventInfo{
+			AggregateID:   aggregate.ID,
+			AggregateType: string(aggregate.Type),
+			ResourceOwner: aggregate.ResourceOwner,
+			Version:       string(aggregate.Version),
+			Sequence:      event.Sequence(),
+			Type:          string(event.Type()),
+			CreatedAt:     event.CreatedAt(),
+			Creator:       event.Creator(),
+			Payload:       payload,
+		},
+	}, nil
+}
+
+func (c *ContextInfoEvent) GetHTTPRequestBody() []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// SetHTTPResponseBody ignores the response, as targets of event executions can't change the event
+func (c *ContextInfoEvent) SetHTTPResponseBody([]byte) error {
+	return nil
+}
+
+func (c *ContextInfoEvent) GetContent() interface{} {
+	return c.Event
+}
@@ -0,0 +1,81 @@
+package execution_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/execution"
+)
+
+func TestNewContextInfoEvent(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		event   eventstore.Event
+		want    string
+		wantErr bool
+	}{
+		{
+			"event with payload",
+			&eventstore.BaseEvent{
+				Agg: &eventstore.Aggregate{
+					ID:            "user",
+					Type:          "user",
+					ResourceOwner: "org",
+					InstanceID:    "instance",
+					Version:       "v2",
+				},
+				Seq:       2,
+				Creation:  createdAt,
+				EventType: "user.human.added",
+				User:      "creator",
+				Data:      []byte(`{"email":"test@example.com"}`),
+			},
+			`{"instanceID":"instance","orgID":"org","userID":"creator","event":{"aggregateID":"user","aggregateType":"user","resourceOwner":"org","version":"v2","sequence":2,"type":"user.human.added","createdAt":"2024-01-01T00:00:00Z","creator":"creator","payload":{"email":"test@example.com"}}}`,
+			false,
+		},
+		{
+			"event without payload",
+			&eventstore.BaseEvent{
+				Agg: &eventstore.Aggregate{
+					ID:            "user",
+					Type:          "user",
+					ResourceOwner: "org",
+					InstanceID:    "instance",
+					Version:       "v2",
+				},
+				Seq:       3,
+				Creation:  createdAt,
+				EventType: "user.removed",
+				User:      "creator",
+			},
+			`{"instanceID":"instance","orgID":"org","userID":"creator","event":{"aggregateID":"user","aggregateType":"user","resourceOwner":"org","version":"v2","sequence":3,"type":"user.removed","createdAt":"2024-01-01T00:00:00Z","creator":"creator"}}`,
+			false,
+		},
+		{
+			"invalid payload, error",
+			&eventstore.BaseEvent{
+				Agg:       &eventstore.Aggregate{ID: "user"},
+				EventType: "user.human.added",
+				Data:      []byte(`{`),
+			},
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := execution.NewContextInfoEvent(tt.event)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got.GetHTTPRequestBody()))
+		})
+	}
+}
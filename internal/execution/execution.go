@@ -1,7 +1,6 @@
 package execution
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -10,11 +9,11 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/actions"
 	zhttp "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
-	"github.com/zitadel/zitadel/pkg/actions"
 )
 
 type ContextInfo interface {
@@ -24,12 +23,22 @@ type ContextInfo interface {
 }
 
 type Target interface {
+	GetExecutionID() string
 	GetTargetID() string
 	IsInterruptOnError() bool
 	GetEndpoint() string
 	GetTargetType() domain.TargetType
 	GetTimeout() time.Duration
 	GetSigningKey() string
+	GetConditions() []string
+	GetTLS() *domain.TargetTLS
+	GetOAuth2() *domain.TargetOAuth2
+	GetModule() []byte
+}
+
+// Deliveries queues the calls of async targets, which are then delivered with retries by the Worker
+type Deliveries interface {
+	RequestTargetDelivery(ctx context.Context, targetID, executionID string, payload []byte) error
 }
 
 // CallTargets call a list of targets in order with handling of error and responses
@@ -37,13 +46,25 @@ func CallTargets(
 	ctx context.Context,
 	targets []Target,
 	info ContextInfo,
+	deliveries Deliveries,
 ) (_ interface{}, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer span.EndWithError(err)
 
 	for _, target := range targets {
+		// skip the target if the conditions of its executions are not fulfilled
+		matched, err := MatchConditions(target.GetConditions(), info)
+		if err != nil {
+			if target.IsInterruptOnError() {
+				return nil, err
+			}
+			logging.WithFields("target", target.GetTargetID()).WithError(err).Info("unable to evaluate conditions")
+		}
+		if !matched {
+			continue
+		}
 		// call the type of target
-		resp, err := CallTarget(ctx, target, info)
+		resp, err := CallTarget(ctx, target, info, deliveries)
 		// handle error if interrupt is set
 		if err != nil && target.IsInterruptOnError() {
 			return nil, err
@@ -67,6 +88,7 @@ func CallTarget(
 	ctx context.Context,
 	target Target,
 	info ContextInfoRequest,
+	deliveries Deliveries,
 ) (res []byte, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer span.EndWithError(err)
@@ -74,54 +96,39 @@ func CallTarget(
 	switch target.GetTargetType() {
 	// get request, ignore response and return request and error for handling in list of targets
 	case domain.TargetTypeWebhook:
-		return nil, webhook(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey())
+		return nil, webhook(ctx, transportFromTarget(target), info.GetHTTPRequestBody())
 	// get request, return response and error
 	case domain.TargetTypeCall:
-		return Call(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey())
+		return CallTransport(ctx, transportFromTarget(target), info.GetHTTPRequestBody())
+	// queue request, the delivery is retried by the worker until it succeeds
 	case domain.TargetTypeAsync:
-		go func(target Target, info ContextInfoRequest) {
-			if _, err := Call(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey()); err != nil {
-				logging.WithFields("target", target.GetTargetID()).OnError(err).Info(err)
-			}
-		}(target, info)
-		return nil, nil
+		return nil, deliveries.RequestTargetDelivery(ctx, target.GetTargetID(), target.GetExecutionID(), info.GetHTTPRequestBody())
+	// run the module in the sandbox, the output is handled like the response of a call
+	case domain.TargetTypeWASM:
+		return runWASM(ctx, target, info.GetHTTPRequestBody())
 	default:
 		return nil, zerrors.ThrowInternal(nil, "EXEC-auqnansr2m", "Errors.Execution.Unknown")
 	}
 }
 
 // webhook call a webhook, ignore the response but return the errror
-func webhook(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) error {
-	_, err := Call(ctx, url, timeout, body, signingKey)
+func webhook(ctx context.Context, transport *Transport, body []byte) error {
+	_, err := CallTransport(ctx, transport, body)
 	return err
 }
 
-// Call function to do a post HTTP request to a desired url with timeout
-func Call(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) (_ []byte, err error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	ctx, span := tracing.NewSpan(ctx)
-	defer func() {
-		cancel()
-		span.EndWithError(err)
-	}()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+// runWASM runs the module of the target with the request as payload
+func runWASM(ctx context.Context, target Target, body []byte) ([]byte, error) {
+	data, err := actions.RunWASM(ctx, target.GetModule(), body, target.GetTimeout())
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	if signingKey != "" {
-		req.Header.Set(actions.SigningHeader, actions.ComputeSignatureHeader(time.Now(), body, signingKey))
-	}
-
-	client := http.DefaultClient
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return handleResponseBody(data)
+}
 
-	return HandleResponse(resp)
+// Call function to do a post HTTP request to a desired url with timeout
+func Call(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) (_ []byte, err error) {
+	return CallTransport(ctx, &Transport{Endpoint: url, Timeout: timeout, SigningKey: signingKey}, body)
 }
 
 func HandleResponse(resp *http.Response) ([]byte, error) {
@@ -131,24 +138,29 @@ func HandleResponse(resp *http.Response) ([]byte, error) {
 	}
 	// Check for success between 200 and 299, redirect 300 to 399 is handled by the client, return error with statusCode >= 400
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
-		var errorBody ErrorBody
-		if err := json.Unmarshal(data, &errorBody); err != nil {
-			// if json unmarshal fails, body has no ErrorBody information, so will be taken as successful response
-			return data, nil
-		}
-		if errorBody.ForwardedStatusCode != 0 || errorBody.ForwardedErrorMessage != "" {
-			if errorBody.ForwardedStatusCode >= 400 && errorBody.ForwardedStatusCode < 500 {
-				return nil, zhttp.HTTPStatusCodeToZitadelError(nil, errorBody.ForwardedStatusCode, "EXEC-reUaUZCzCp", errorBody.ForwardedErrorMessage)
-			}
-			return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-bmhNhpcqpF", errorBody.ForwardedErrorMessage)
-		}
-		// no ErrorBody filled in response, so will be taken as successful response
-		return data, nil
+		return handleResponseBody(data)
 	}
 
 	return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-dra6yamk98", "Errors.Execution.Failed")
 }
 
+// handleResponseBody returns the error forwarded in the body, otherwise the body is taken as successful response
+func handleResponseBody(data []byte) ([]byte, error) {
+	var errorBody ErrorBody
+	if err := json.Unmarshal(data, &errorBody); err != nil {
+		// if json unmarshal fails, body has no ErrorBody information, so will be taken as successful response
+		return data, nil
+	}
+	if errorBody.ForwardedStatusCode != 0 || errorBody.ForwardedErrorMessage != "" {
+		if errorBody.ForwardedStatusCode >= 400 && errorBody.ForwardedStatusCode < 500 {
+			return nil, zhttp.HTTPStatusCodeToZitadelError(nil, errorBody.ForwardedStatusCode, "EXEC-reUaUZCzCp", errorBody.ForwardedErrorMessage)
+		}
+		return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-bmhNhpcqpF", errorBody.ForwardedErrorMessage)
+	}
+	// no ErrorBody filled in response, so will be taken as successful response
+	return data, nil
+}
+
 type ErrorBody struct {
 	ForwardedStatusCode   int    `json:"forwardedStatusCode,omitempty"`
 	ForwardedErrorMessage string `json:"forwardedErrorMessage,omitempty"`
@@ -457,8 +457,15 @@ type mockTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+	Conditions       []string
+	TLS              *domain.TargetTLS
+	OAuth2           *domain.TargetOAuth2
+	Module           []byte
 }
 
+func (e *mockTarget) GetExecutionID() string {
+	return e.ExecutionID
+}
 func (e *mockTarget) GetTargetID() string {
 	return e.TargetID
 }
@@ -477,6 +484,18 @@ func (e *mockTarget) GetTimeout() time.Duration {
 func (e *mockTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockTarget) GetConditions() []string {
+	return e.Conditions
+}
+func (e *mockTarget) GetTLS() *domain.TargetTLS {
+	return e.TLS
+}
+func (e *mockTarget) GetOAuth2() *domain.TargetOAuth2 {
+	return e.OAuth2
+}
+func (e *mockTarget) GetModule() []byte {
+	return e.Module
+}
 
 type callTestServer struct {
 	method      string
@@ -557,7 +576,7 @@ func testCallTarget(ctx context.Context,
 ) func(string) ([]byte, error) {
 	return func(url string) (r []byte, err error) {
 		target.Endpoint = url
-		return execution.CallTarget(ctx, target, info)
+		return execution.CallTarget(ctx, target, info, nil)
 	}
 }
 
@@ -571,7 +590,7 @@ func testCallTargets(ctx context.Context,
 			t.Endpoint = urls[i]
 			targets[i] = t
 		}
-		return execution.CallTargets(ctx, targets, info)
+		return execution.CallTargets(ctx, targets, info, nil)
 	}
 }
 
@@ -0,0 +1,144 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+)
+
+const (
+	EventExecutionsHandlerName = "projections.execution_handler"
+	// ExecutionUserID is the creator of the deliveries requested for event executions
+	ExecutionUserID  = "EXECUTION"
+	eventGroupSuffix = ".*"
+)
+
+// EventExecutionQueries are the queries used by the handler to get the targets of the event executions
+type EventExecutionQueries interface {
+	TargetsByExecutionID(ctx context.Context, ids []string) ([]*query.ExecutionTarget, error)
+}
+
+// eventExecutionsHandler queues the calls to the targets of event executions,
+// every target is called asynchronously by the Worker with retries, independent of its type
+type eventExecutionsHandler struct {
+	queries    EventExecutionQueries
+	deliveries Deliveries
+	eventTypes []string
+	maxAge     time.Duration
+	now        nowFunc
+}
+
+func NewEventExecutionsHandler(
+	ctx context.Context,
+	config handler.Config,
+	queries EventExecutionQueries,
+	deliveries Deliveries,
+	eventTypes []string,
+	maxAge time.Duration,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventExecutionsHandler{
+		queries:    queries,
+		deliveries: deliveries,
+		eventTypes: eventTypes,
+		maxAge:     maxAge,
+		now:        time.Now,
+	})
+}
+
+func (h *eventExecutionsHandler) Name() string {
+	return EventExecutionsHandlerName
+}
+
+// Reducers handle all registered events, except the ones of the deliveries,
+// as an execution on them would request new deliveries endlessly
+func (h *eventExecutionsHandler) Reducers() []handler.AggregateReducer {
+	reducers := make([]handler.AggregateReducer, 0)
+	indexes := make(map[eventstore.AggregateType]int)
+	for _, eventType := range h.eventTypes {
+		aggregateType := eventstore.AggregateTypeFromEventType(eventstore.EventType(eventType))
+		if aggregateType == "" || aggregateType == targetdelivery.AggregateType {
+			continue
+		}
+		i, ok := indexes[aggregateType]
+		if !ok {
+			i = len(reducers)
+			indexes[aggregateType] = i
+			reducers = append(reducers, handler.AggregateReducer{Aggregate: aggregateType})
+		}
+		reducers[i].EventReducers = append(reducers[i].EventReducers, handler.EventReducer{
+			Event:  eventstore.EventType(eventType),
+			Reduce: h.reduce,
+		})
+	}
+	return reducers
+}
+
+func (h *eventExecutionsHandler) reduce(event eventstore.Event) (*handler.Statement, error) {
+	// events created before the maximum age are not dispatched,
+	// so that past events are not delivered when the handler catches up for the first time
+	if h.maxAge > 0 && event.CreatedAt().Before(h.now().Add(-h.maxAge)) {
+		return handler.NewNoOpStatement(event), nil
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		targets, err := h.queries.TargetsByExecutionID(ctx, idsForEventType(string(event.Type())))
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		info, err := NewContextInfoEvent(event)
+		if err != nil {
+			return err
+		}
+		return h.requestDeliveries(ctx, targets, info)
+	}), nil
+}
+
+func (h *eventExecutionsHandler) requestDeliveries(ctx context.Context, targets []*query.ExecutionTarget, info *ContextInfoEvent) error {
+	for _, target := range targets {
+		// skip the target if the conditions of its executions are not fulfilled
+		matched, err := MatchConditions(target.GetConditions(), info)
+		if err != nil {
+			logging.WithFields("target", target.GetTargetID(), "executionID", target.GetExecutionID()).WithError(err).Info("unable to evaluate conditions")
+			continue
+		}
+		if !matched {
+			continue
+		}
+		if err := h.deliveries.RequestTargetDelivery(ctx, target.GetTargetID(), target.GetExecutionID(), info.GetHTTPRequestBody()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idsForEventType returns the IDs of the executions for the event type, its groups and all events, for example:
+// [ "event/user.human.added",
+// "event/user.human.*",
+// "event/user.*",
+// "event" ]
+func idsForEventType(eventType string) []string {
+	ids := []string{exec_repo.ID(domain.ExecutionTypeEvent, eventType)}
+	for i := len(eventType) - 1; i > 0; i-- {
+		if eventType[i] == '.' {
+			ids = append(ids, exec_repo.ID(domain.ExecutionTypeEvent, eventType[:i]+eventGroupSuffix))
+		}
+	}
+	return append(ids, exec_repo.IDAll(domain.ExecutionTypeEvent))
+}
+
+func HandlerContext(aggregate *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ExecutionUserID, OrgID: aggregate.ResourceOwner})
+}
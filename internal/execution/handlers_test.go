@@ -0,0 +1,188 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type mockEventExecutionQueries struct {
+	ids     []string
+	targets []*query.ExecutionTarget
+	err     error
+}
+
+func (m *mockEventExecutionQueries) TargetsByExecutionID(_ context.Context, ids []string) ([]*query.ExecutionTarget, error) {
+	m.ids = ids
+	return m.targets, m.err
+}
+
+type requestedDelivery struct {
+	instanceID  string
+	targetID    string
+	executionID string
+	payload     map[string]any
+}
+
+type mockDeliveries struct {
+	requested []requestedDelivery
+	err       error
+}
+
+func (m *mockDeliveries) RequestTargetDelivery(ctx context.Context, targetID, executionID string, payload []byte) error {
+	var body map[string]any
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return err
+	}
+	m.requested = append(m.requested, requestedDelivery{
+		instanceID:  authz.GetInstance(ctx).InstanceID(),
+		targetID:    targetID,
+		executionID: executionID,
+		payload:     body,
+	})
+	return m.err
+}
+
+func userAddedEvent(createdAt time.Time) eventstore.Event {
+	return eventstore.BaseEventFromRepo(&repository.Event{
+		InstanceID:    instanceID,
+		AggregateID:   "user",
+		AggregateType: "user",
+		ResourceOwner: sql.NullString{String: "org"},
+		CreationDate:  createdAt,
+		Seq:           1,
+		Typ:           "user.human.added",
+		Data:          []byte(`{"email":"test@example.com"}`),
+	})
+}
+
+func Test_idsForEventType(t *testing.T) {
+	assert.Equal(t,
+		[]string{"event/user.human.added", "event/user.human.*", "event/user.*", "event"},
+		idsForEventType("user.human.added"),
+	)
+	assert.Equal(t, []string{"event/user", "event"}, idsForEventType("user"))
+}
+
+func Test_eventExecutionsHandler_Reducers(t *testing.T) {
+	h := &eventExecutionsHandler{
+		eventTypes: []string{
+			string(targetdelivery.RequestedType),
+			"unknown.event",
+			string(user.HumanAddedType),
+			string(user.UserRemovedType),
+		},
+	}
+	reducers := h.Reducers()
+	require.Len(t, reducers, 1)
+	assert.Equal(t, eventstore.AggregateType(user.AggregateType), reducers[0].Aggregate)
+	require.Len(t, reducers[0].EventReducers, 2)
+	assert.Equal(t, user.HumanAddedType, reducers[0].EventReducers[0].Event)
+	assert.Equal(t, user.UserRemovedType, reducers[0].EventReducers[1].Event)
+}
+
+func Test_eventExecutionsHandler_reduce(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		event      eventstore.Event
+		queries    *mockEventExecutionQueries
+		deliveries *mockDeliveries
+		want       []requestedDelivery
+		wantErr    bool
+	}{
+		{
+			name:       "event too old, not delivered",
+			event:      userAddedEvent(now.Add(-2 * time.Hour)),
+			queries:    &mockEventExecutionQueries{},
+			deliveries: &mockDeliveries{},
+		},
+		{
+			name:       "no targets, not delivered",
+			event:      userAddedEvent(now),
+			queries:    &mockEventExecutionQueries{},
+			deliveries: &mockDeliveries{},
+		},
+		{
+			name:       "query failed, error",
+			event:      userAddedEvent(now),
+			queries:    &mockEventExecutionQueries{err: errors.New("query failed")},
+			deliveries: &mockDeliveries{},
+			wantErr:    true,
+		},
+		{
+			name:  "targets, delivered if conditions match",
+			event: userAddedEvent(now),
+			queries: &mockEventExecutionQueries{
+				targets: []*query.ExecutionTarget{
+					{ExecutionID: "event/user.human.added", TargetID: "target1"},
+					{ExecutionID: "event/user.*", TargetID: "target2", Conditions: []string{"event.payload.email.endsWith('@example.com')"}},
+					{ExecutionID: "event", TargetID: "target3", Conditions: []string{"event.payload.email.endsWith('@example.org')"}},
+					{ExecutionID: "event", TargetID: "target4", Conditions: []string{"event.payload.phone == '+41'"}},
+				},
+			},
+			deliveries: &mockDeliveries{},
+			want: []requestedDelivery{
+				{instanceID: instanceID, targetID: "target1", executionID: "event/user.human.added"},
+				{instanceID: instanceID, targetID: "target2", executionID: "event/user.*"},
+			},
+		},
+		{
+			name:  "delivery request failed, error",
+			event: userAddedEvent(now),
+			queries: &mockEventExecutionQueries{
+				targets: []*query.ExecutionTarget{
+					{ExecutionID: "event/user.human.added", TargetID: "target1"},
+				},
+			},
+			deliveries: &mockDeliveries{err: errors.New("push failed")},
+			want: []requestedDelivery{
+				{instanceID: instanceID, targetID: "target1", executionID: "event/user.human.added"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &eventExecutionsHandler{
+				queries:    tt.queries,
+				deliveries: tt.deliveries,
+				maxAge:     time.Hour,
+				now:        func() time.Time { return now },
+			}
+			stmt, err := h.reduce(tt.event)
+			require.NoError(t, err)
+			if stmt.Execute != nil {
+				err = stmt.Execute(nil, EventExecutionsHandlerName)
+				assert.Equal(t, idsForEventType("user.human.added"), tt.queries.ids)
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.Len(t, tt.deliveries.requested, len(tt.want))
+			for i, want := range tt.want {
+				got := tt.deliveries.requested[i]
+				assert.Equal(t, want.instanceID, got.instanceID)
+				assert.Equal(t, want.targetID, got.targetID)
+				assert.Equal(t, want.executionID, got.executionID)
+				// the event is sent with its payload
+				assert.Equal(t, map[string]any{"email": "test@example.com"}, got.payload["event"].(map[string]any)["payload"])
+			}
+		})
+	}
+}
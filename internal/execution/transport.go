@@ -0,0 +1,443 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	zhttp "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+	actions_pb "github.com/zitadel/zitadel/pkg/grpc/actions/v1"
+)
+
+const (
+	// schemeGRPC calls the target with gRPC without transport security
+	schemeGRPC = "grpc"
+	// schemeGRPCS calls the target with gRPC over TLS
+	schemeGRPCS = "grpcs"
+
+	// transportCacheSize is the maximum amount of cached clients, connections and tokens each
+	transportCacheSize = 1000
+	// transportCacheTTL evicts the cached clients, connections and tokens,
+	// so that the ones of changed or removed targets are not kept
+	transportCacheTTL = time.Hour
+	// evictedConnectionGracePeriod is the time evicted gRPC connections are kept open,
+	// so that running calls can finish, it is longer than the maximum timeout of a target
+	evictedConnectionGracePeriod = 5 * time.Minute
+)
+
+// Transport is the configuration used to call a target
+type Transport struct {
+	Endpoint    string
+	ExecutionID string
+	Timeout     time.Duration
+	SigningKey  string
+	TLS         *domain.TargetTLS
+	OAuth2      *domain.TargetOAuth2
+}
+
+func transportFromTarget(target Target) *Transport {
+	return &Transport{
+		Endpoint:    target.GetEndpoint(),
+		ExecutionID: target.GetExecutionID(),
+		Timeout:     target.GetTimeout(),
+		SigningKey:  target.GetSigningKey(),
+		TLS:         target.GetTLS(),
+		OAuth2:      target.GetOAuth2(),
+	}
+}
+
+var (
+	// httpClients are the clients of targets with mutual TLS, keyed by the hash of the TLS configuration
+	httpClients = expirable.NewLRU[string, *http.Client](transportCacheSize, func(_ string, client *http.Client) {
+		client.CloseIdleConnections()
+	}, transportCacheTTL)
+	httpClientsMu sync.Mutex
+	// grpcConnections are the connections to gRPC targets, keyed by the hash of the endpoint and TLS configuration
+	grpcConnections = expirable.NewLRU[string, *grpc.ClientConn](transportCacheSize, func(_ string, conn *grpc.ClientConn) {
+		time.AfterFunc(evictedConnectionGracePeriod, func() {
+			_ = conn.Close()
+		})
+	}, transportCacheTTL)
+	grpcConnectionsMu sync.Mutex
+	// tokens cache the tokens of targets with OAuth2 client credentials, keyed by the hash of the credentials
+	tokens = expirable.NewLRU[string, *oauth2.Token](transportCacheSize, nil, transportCacheTTL)
+)
+
+// CallTransport calls the target with the protocol defined by the scheme of the endpoint,
+// grpc:// and grpcs:// endpoints are called with gRPC, all others with a post HTTP request
+func CallTransport(ctx context.Context, transport *Transport, body []byte) (_ []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, transport.Timeout)
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		cancel()
+		span.EndWithError(err)
+	}()
+
+	endpoint, err := url.Parse(transport.Endpoint)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EXEC-Zq2rVb", "Errors.Target.InvalidURL")
+	}
+	client, err := httpClient(transport.TLS)
+	if err != nil {
+		return nil, err
+	}
+	token, err := bearerToken(ctx, transport.OAuth2, client)
+	if err != nil {
+		return nil, err
+	}
+	switch endpoint.Scheme {
+	case schemeGRPC, schemeGRPCS:
+		return callGRPC(ctx, endpoint, transport, token, body)
+	default:
+		return callHTTP(ctx, client, transport, token, body)
+	}
+}
+
+func callHTTP(ctx context.Context, client *http.Client, transport *Transport, token string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, transport.Endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if transport.SigningKey != "" {
+		req.Header.Set(actions.SigningHeader, actions.ComputeSignatureHeader(time.Now(), body, transport.SigningKey))
+	}
+	if token != "" {
+		req.Header.Set(zhttp.Authorization, authz.BearerPrefix+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return HandleResponse(resp)
+}
+
+func callGRPC(ctx context.Context, endpoint *url.URL, transport *Transport, token string, body []byte) ([]byte, error) {
+	conn, err := grpcConnection(endpoint, transport.TLS)
+	if err != nil {
+		return nil, err
+	}
+	md := metadata.MD{}
+	if transport.SigningKey != "" {
+		md.Set(strings.ToLower(actions.SigningHeader), actions.ComputeSignatureHeader(time.Now(), body, transport.SigningKey))
+	}
+	if token != "" {
+		md.Set(zhttp.Authorization, authz.BearerPrefix+token)
+	}
+	request, err := grpcCallRequest(transport.ExecutionID, body)
+	if err != nil {
+		return nil, err
+	}
+	response, err := actions_pb.NewTargetServiceClient(conn).Call(metadata.NewOutgoingContext(ctx, md), request)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return grpcCallResponseBody(response)
+}
+
+// grpcCallBody contains the fields of the JSON payloads of all execution types
+type grpcCallBody struct {
+	FullMethod string         `json:"fullMethod"`
+	InstanceID string         `json:"instanceID"`
+	OrgID      string         `json:"orgID"`
+	ProjectID  string         `json:"projectID"`
+	UserID     string         `json:"userID"`
+	Request    map[string]any `json:"request"`
+	Response   map[string]any `json:"response"`
+	Event      *EventInfo     `json:"event"`
+}
+
+// grpcCallRequest sends the JSON payload as fallback and as typed content depending on the execution type
+func grpcCallRequest(executionID string, body []byte) (*actions_pb.CallRequest, error) {
+	executionType := executionTypeFromID(executionID)
+	request := &actions_pb.CallRequest{
+		Payload:     body,
+		ExecutionId: executionID,
+		// the values of the execution types are the same in the API
+		ExecutionType: actions_pb.ExecutionType(executionType),
+	}
+	if executionType == domain.ExecutionTypeFunction {
+		functionContext, err := jsonToStruct(body)
+		if err != nil {
+			return nil, err
+		}
+		request.Content = &actions_pb.CallRequest_Function{
+			Function: &actions_pb.FunctionContent{
+				Function: strings.TrimPrefix(executionID, domain.ExecutionTypeFunction.String()+"/"),
+				Context:  functionContext,
+			},
+		}
+		return request, nil
+	}
+	content := new(grpcCallBody)
+	if err := json.Unmarshal(body, content); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-p3Wq7c", "Errors.Execution.Failed")
+	}
+	switch executionType {
+	case domain.ExecutionTypeRequest:
+		req, err := mapToStruct(content.Request)
+		if err != nil {
+			return nil, err
+		}
+		request.Content = &actions_pb.CallRequest_Request{
+			Request: &actions_pb.RequestContent{
+				FullMethod: content.FullMethod,
+				InstanceId: content.InstanceID,
+				OrgId:      content.OrgID,
+				ProjectId:  content.ProjectID,
+				UserId:     content.UserID,
+				Request:    req,
+			},
+		}
+	case domain.ExecutionTypeResponse:
+		req, err := mapToStruct(content.Request)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := mapToStruct(content.Response)
+		if err != nil {
+			return nil, err
+		}
+		request.Content = &actions_pb.CallRequest_Response{
+			Response: &actions_pb.ResponseContent{
+				FullMethod: content.FullMethod,
+				InstanceId: content.InstanceID,
+				OrgId:      content.OrgID,
+				ProjectId:  content.ProjectID,
+				UserId:     content.UserID,
+				Request:    req,
+				Response:   resp,
+			},
+		}
+	case domain.ExecutionTypeEvent:
+		if content.Event == nil {
+			return request, nil
+		}
+		payload, err := jsonToStruct(content.Event.Payload)
+		if err != nil {
+			return nil, err
+		}
+		request.Content = &actions_pb.CallRequest_Event{
+			Event: &actions_pb.EventContent{
+				InstanceId:    content.InstanceID,
+				OrgId:         content.OrgID,
+				UserId:        content.UserID,
+				AggregateId:   content.Event.AggregateID,
+				AggregateType: content.Event.AggregateType,
+				ResourceOwner: content.Event.ResourceOwner,
+				Version:       content.Event.Version,
+				Sequence:      content.Event.Sequence,
+				Type:          content.Event.Type,
+				CreatedAt:     timestamppb.New(content.Event.CreatedAt),
+				Creator:       content.Event.Creator,
+				Payload:       payload,
+			},
+		}
+	case domain.ExecutionTypeUnspecified, domain.ExecutionTypeFunction:
+		// only the JSON payload is sent, functions are handled above
+	}
+	return request, nil
+}
+
+// grpcCallResponseBody returns the typed content as JSON, the same as returned by REST targets,
+// the JSON payload is only used if the target returned no typed content
+func grpcCallResponseBody(response *actions_pb.CallResponse) ([]byte, error) {
+	var content *structpb.Struct
+	switch {
+	case response.GetRequest() != nil:
+		content = response.GetRequest()
+	case response.GetResponse() != nil:
+		content = response.GetResponse()
+	default:
+		return response.GetPayload(), nil
+	}
+	data, err := protojson.Marshal(content)
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "EXEC-Lr5xB2", "Errors.Execution.ResponseIsNotValidJSON")
+	}
+	return data, nil
+}
+
+func jsonToStruct(data []byte) (*structpb.Struct, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	content := make(map[string]any)
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Hc8tN4", "Errors.Execution.Failed")
+	}
+	return mapToStruct(content)
+}
+
+func mapToStruct(content map[string]any) (*structpb.Struct, error) {
+	if content == nil {
+		return nil, nil
+	}
+	s, err := structpb.NewStruct(content)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-f6GzQ1", "Errors.Execution.Failed")
+	}
+	return s, nil
+}
+
+// grpcError forwards the client errors returned by the target the same way as the forwarded errors of REST targets,
+// all other errors fail the execution
+func grpcError(err error) error {
+	s := status.Convert(err)
+	statusCode := runtime.HTTPStatusFromCode(s.Code())
+	if statusCode >= 400 && statusCode < 500 {
+		return zhttp.HTTPStatusCodeToZitadelError(nil, statusCode, "EXEC-Kd8bTn", s.Message())
+	}
+	return zerrors.ThrowPreconditionFailed(err, "EXEC-Nn3fWs", "Errors.Execution.Failed")
+}
+
+func httpClient(config *domain.TargetTLS) (*http.Client, error) {
+	if config == nil {
+		return http.DefaultClient, nil
+	}
+	key := configHash(config.ClientCertificate, config.ClientKey, config.CACertificates)
+	httpClientsMu.Lock()
+	defer httpClientsMu.Unlock()
+	if client, ok := httpClients.Get(key); ok {
+		return client, nil
+	}
+	tlsConfig, err := clientTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: transport}
+	httpClients.Add(key, client)
+	return client, nil
+}
+
+func grpcConnection(endpoint *url.URL, config *domain.TargetTLS) (*grpc.ClientConn, error) {
+	key := configHash([]byte(endpoint.Scheme), []byte(endpoint.Host))
+	if config != nil {
+		key = configHash([]byte(key), config.ClientCertificate, config.ClientKey, config.CACertificates)
+	}
+	grpcConnectionsMu.Lock()
+	defer grpcConnectionsMu.Unlock()
+	if conn, ok := grpcConnections.Get(key); ok {
+		return conn, nil
+	}
+	transportCredentials := insecure.NewCredentials()
+	if endpoint.Scheme == schemeGRPCS {
+		tlsConfig, err := clientTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(endpoint.Host, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EXEC-Tb5qLm", "Errors.Target.InvalidURL")
+	}
+	grpcConnections.Add(key, conn)
+	return conn, nil
+}
+
+// clientTLSConfig returns the TLS configuration with the client certificate for mutual TLS
+// and the CA certificates used to verify the target, if not set the system pool is used
+func clientTLSConfig(config *domain.TargetTLS) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if config == nil {
+		return tlsConfig, nil
+	}
+	if len(config.ClientCertificate) > 0 {
+		certificate, err := tls.X509KeyPair(config.ClientCertificate, config.ClientKey)
+		if err != nil {
+			return nil, zerrors.ThrowPreconditionFailed(err, "EXEC-Pm4sRc", "Errors.Target.InvalidTLS")
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	if len(config.CACertificates) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CACertificates) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-Jc6hVd", "Errors.Target.InvalidTLS")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// bearerToken returns the access token requested with the client credentials of the target,
+// the token is reused until it expires.
+// It is requested within the timeout of the call and with the client using the TLS configuration of the target.
+func bearerToken(ctx context.Context, config *domain.TargetOAuth2, client *http.Client) (string, error) {
+	if config == nil || config.ClientID == "" {
+		return "", nil
+	}
+	key := configHash([]byte(config.TokenEndpoint), []byte(config.ClientID), []byte(config.ClientSecret), []byte(strings.Join(config.Scopes, " ")))
+	if token, ok := tokens.Get(key); ok && token.Valid() {
+		return token.AccessToken, nil
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		TokenURL:     config.TokenEndpoint,
+		Scopes:       config.Scopes,
+	}
+	token, err := credentials.Token(context.WithValue(ctx, oauth2.HTTPClient, client))
+	if err != nil {
+		return "", zerrors.ThrowPreconditionFailed(err, "EXEC-Vr7cXk", "Errors.Target.InvalidOAuth2")
+	}
+	tokens.Add(key, token)
+	return token.AccessToken, nil
+}
+
+func configHash(values ...[]byte) string {
+	hash := sha256.New()
+	for _, value := range values {
+		hash.Write(value)
+		// separate the values, so that different splits don't result in the same hash
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// executionTypeFromID returns the type of the execution, the id is prefixed with it, e.g. "request/zitadel.session.v2.SessionService"
+func executionTypeFromID(id string) domain.ExecutionType {
+	for _, executionType := range []domain.ExecutionType{
+		domain.ExecutionTypeRequest,
+		domain.ExecutionTypeResponse,
+		domain.ExecutionTypeFunction,
+		domain.ExecutionTypeEvent,
+	} {
+		if strings.HasPrefix(id, executionType.String()) {
+			return executionType
+		}
+	}
+	return domain.ExecutionTypeUnspecified
+}
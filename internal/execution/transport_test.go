@@ -0,0 +1,219 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+	actions_pb "github.com/zitadel/zitadel/pkg/grpc/actions/v1"
+)
+
+func TestCallTransport_oauth2AndTLS(t *testing.T) {
+	var tokenRequests int
+	// the token endpoint is only trusted with the CA bundle of the target
+	tokenServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "token_type": "Bearer", "expires_in": 3600})
+	}))
+	defer tokenServer.Close()
+
+	target := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"response":"content"}`))
+	}))
+	defer target.Close()
+
+	transport := &Transport{
+		Endpoint: target.URL,
+		Timeout:  time.Minute,
+		TLS: &domain.TargetTLS{
+			CACertificates: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: target.Certificate().Raw}),
+		},
+		OAuth2: &domain.TargetOAuth2{
+			TokenEndpoint: tokenServer.URL,
+			ClientID:      "client",
+			ClientSecret:  "secret",
+		},
+	}
+	got, err := CallTransport(context.Background(), transport, []byte(`{"request":"content"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"response":"content"}`), got)
+
+	// the token is reused until it expires
+	_, err = CallTransport(context.Background(), transport, []byte(`{"request":"content"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests)
+
+	// without the CA bundle the certificate of the target is not trusted
+	transport.TLS = nil
+	_, err = CallTransport(context.Background(), transport, []byte(`{"request":"content"}`))
+	assert.Error(t, err)
+}
+
+func TestCallTransport_grpc(t *testing.T) {
+	tests := []struct {
+		name        string
+		executionID string
+		body        string
+		handler     func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error)
+		want        string
+		wantErr     func(error) bool
+	}{
+		{
+			name:        "call ok, payload",
+			executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			body:        `{"request":{"content":"value"}}`,
+			handler: func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error) {
+				if err := actions.ValidatePayload(request.GetPayload(), md.Get("zitadel-signature")[0], "signingkey"); err != nil {
+					return nil, status.Error(codes.Unauthenticated, err.Error())
+				}
+				if request.GetExecutionType() != actions_pb.ExecutionType_EXECUTION_TYPE_REQUEST {
+					return nil, status.Error(codes.Internal, request.GetExecutionType().String())
+				}
+				return &actions_pb.CallResponse{Payload: request.GetPayload()}, nil
+			},
+			want: `{"request":{"content":"value"}}`,
+		},
+		{
+			name:        "request, typed content",
+			executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			body:        `{"fullMethod":"/zitadel.session.v2.SessionService/SetSession","userID":"user","request":{"content":"value"}}`,
+			handler: func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error) {
+				content := request.GetRequest()
+				if content.GetFullMethod() != "/zitadel.session.v2.SessionService/SetSession" || content.GetUserId() != "user" {
+					return nil, status.Error(codes.InvalidArgument, "unexpected content")
+				}
+				req := content.GetRequest()
+				req.Fields["content"] = structpb.NewStringValue("changed")
+				return &actions_pb.CallResponse{Content: &actions_pb.CallResponse_Request{Request: req}}, nil
+			},
+			want: `{"content":"changed"}`,
+		},
+		{
+			name:        "response, typed content",
+			executionID: "response/zitadel.session.v2.SessionService/SetSession",
+			body:        `{"request":{"content":"value"},"response":{"sessionId":"id"}}`,
+			handler: func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error) {
+				content := request.GetResponse()
+				if content.GetResponse().GetFields()["sessionId"].GetStringValue() != "id" {
+					return nil, status.Error(codes.InvalidArgument, "unexpected content")
+				}
+				resp := content.GetResponse()
+				resp.Fields["sessionToken"] = structpb.NewStringValue("token")
+				return &actions_pb.CallResponse{Content: &actions_pb.CallResponse_Response{Response: resp}}, nil
+			},
+			want: `{"sessionId":"id","sessionToken":"token"}`,
+		},
+		{
+			name:        "function, typed content",
+			executionID: "function/preuserinfo",
+			body:        `{"function":"preuserinfo","userinfo":{"sub":"user"}}`,
+			handler: func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error) {
+				content := request.GetFunction()
+				if content.GetFunction() != "preuserinfo" || content.GetContext().GetFields()["userinfo"] == nil {
+					return nil, status.Error(codes.InvalidArgument, "unexpected content")
+				}
+				return &actions_pb.CallResponse{Payload: []byte(`{"set_user_metadata":[]}`)}, nil
+			},
+			want: `{"set_user_metadata":[]}`,
+		},
+		{
+			name:        "event, typed content",
+			executionID: "event/user.human.added",
+			body:        `{"instanceID":"instance","event":{"aggregateID":"user","sequence":2,"type":"user.human.added","createdAt":"2024-01-01T00:00:00Z","payload":{"email":"test@example.com"}}}`,
+			handler: func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error) {
+				content := request.GetEvent()
+				if content.GetType() != "user.human.added" ||
+					content.GetPayload().GetFields()["email"].GetStringValue() != "test@example.com" {
+					return nil, status.Error(codes.InvalidArgument, "unexpected content")
+				}
+				return &actions_pb.CallResponse{}, nil
+			},
+			want: "",
+		},
+		{
+			name:        "forwarded error",
+			executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			body:        `{"request":{"content":"value"}}`,
+			handler: func(*actions_pb.CallRequest, metadata.MD) (*actions_pb.CallResponse, error) {
+				return nil, status.Error(codes.PermissionDenied, "denied")
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name:        "internal error",
+			executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			body:        `{"request":{"content":"value"}}`,
+			handler: func(*actions_pb.CallRequest, metadata.MD) (*actions_pb.CallResponse, error) {
+				return nil, status.Error(codes.Internal, "internal")
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := testGRPCTarget(t, tt.handler)
+			got, err := CallTransport(context.Background(), &Transport{
+				Endpoint:    endpoint,
+				ExecutionID: tt.executionID,
+				Timeout:     time.Minute,
+				SigningKey:  "signingkey",
+			}, []byte(tt.body))
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Empty(t, got)
+				return
+			}
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+type testTargetService struct {
+	actions_pb.UnimplementedTargetServiceServer
+	handler func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error)
+}
+
+func (s *testTargetService) Call(ctx context.Context, request *actions_pb.CallRequest) (*actions_pb.CallResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return s.handler(request, md)
+}
+
+// testGRPCTarget starts a server implementing zitadel.actions.v1.TargetService
+func testGRPCTarget(t *testing.T, handler func(request *actions_pb.CallRequest, md metadata.MD) (*actions_pb.CallResponse, error)) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	actions_pb.RegisterTargetServiceServer(server, &testTargetService{handler: handler})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return "grpc://" + listener.Addr().String()
+}
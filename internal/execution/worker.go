@@ -0,0 +1,379 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"time"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/actions"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	deliverySucceededCounter = "target_delivery_succeeded"
+	deliveryRetriedCounter   = "target_delivery_retried"
+	deliveryFailedCounter    = "target_delivery_failed"
+)
+
+// WorkerCommands are the commands used by the Worker to write the outcome of a delivery
+type WorkerCommands interface {
+	TargetDeliverySucceeded(ctx context.Context, tx *sql.Tx, id, instanceID string, attempts uint16) error
+	TargetDeliveryRetryRequested(ctx context.Context, tx *sql.Tx, id, instanceID string, request targetdelivery.Request, backOff time.Duration, attempts uint16, requestError error) error
+	TargetDeliveryFailed(ctx context.Context, tx *sql.Tx, id, instanceID string, attempts uint16, requestError error) error
+}
+
+// WorkerQueries are the queries used by the Worker to get the current state of the targets
+type WorkerQueries interface {
+	ActiveInstances() []string
+	GetTargetByID(ctx context.Context, id string) (*query.Target, error)
+}
+
+// Worker delivers the queued calls of async targets,
+// failed calls are retried with an exponential backoff until MaxAttempts are reached,
+// after which the delivery is kept as failed (dead letter) and can be replayed.
+type Worker struct {
+	commands   WorkerCommands
+	queries    WorkerQueries
+	es         *eventstore.Eventstore
+	client     *database.DB
+	encryption crypto.EncryptionAlgorithm
+	config     WorkerConfig
+	now        nowFunc
+	backOff    func(current time.Duration) time.Duration
+	call       func(ctx context.Context, transport *Transport, body []byte) ([]byte, error)
+	runWASM    func(ctx context.Context, module, body []byte, timeout time.Duration) ([]byte, error)
+}
+
+type WorkerConfig struct {
+	Workers             uint8
+	BulkLimit           uint16
+	RequeueEvery        time.Duration
+	RetryWorkers        uint8
+	RetryBulkLimit      uint16
+	RetryRequeueEvery   time.Duration
+	TransactionDuration time.Duration
+	MaxAttempts         uint16
+	MinRetryDelay       time.Duration
+	MaxRetryDelay       time.Duration
+	RetryDelayFactor    float32
+	// EventMaxAge is the maximum age of events for which the targets of event executions are called
+	EventMaxAge time.Duration
+}
+
+// nowFunc makes [time.Now] mockable
+type nowFunc func() time.Time
+
+func NewWorker(
+	config WorkerConfig,
+	commands WorkerCommands,
+	queries WorkerQueries,
+	es *eventstore.Eventstore,
+	client *database.DB,
+	encryption crypto.EncryptionAlgorithm,
+) *Worker {
+	// make sure the delay does not get less
+	if config.RetryDelayFactor < 1 {
+		config.RetryDelayFactor = 1
+	}
+	registerCounter(deliverySucceededCounter, "Successfully delivered calls of async targets")
+	registerCounter(deliveryRetriedCounter, "Retried calls of async targets")
+	registerCounter(deliveryFailedCounter, "Failed calls of async targets, which will not be retried")
+	w := &Worker{
+		config:     config,
+		commands:   commands,
+		queries:    queries,
+		es:         es,
+		client:     client,
+		encryption: encryption,
+		now:        time.Now,
+		call:       CallTransport,
+		runWASM:    actions.RunWASM,
+	}
+	w.backOff = w.exponentialBackOff
+	return w
+}
+
+func registerCounter(counter, desc string) {
+	err := metrics.RegisterCounter(counter, desc)
+	logging.WithFields("metric", counter).OnError(err).Panic("unable to register counter")
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	for i := 0; i < int(w.config.Workers); i++ {
+		go w.schedule(ctx, i, false)
+	}
+	for i := 0; i < int(w.config.RetryWorkers); i++ {
+		go w.schedule(ctx, i, true)
+	}
+}
+
+func (w *Worker) reduceRequested(ctx, txCtx context.Context, tx *sql.Tx, event *targetdelivery.RequestedEvent) error {
+	err := w.deliver(ctx, event.Request)
+	if err == nil {
+		return w.succeeded(txCtx, tx, event, 1)
+	}
+	if w.config.MaxAttempts <= 1 || zerrors.IsNotFound(err) {
+		return w.failed(txCtx, tx, event, 1, err)
+	}
+	return w.retry(txCtx, tx, event, event.Request, w.backOff(0), 1, err)
+}
+
+func (w *Worker) reduceRetry(ctx, txCtx context.Context, tx *sql.Tx, event *targetdelivery.RetryRequestedEvent) error {
+	if event.CreatedAt().Add(event.BackOff).After(w.now()) {
+		return nil
+	}
+	attempts := event.Attempts + 1
+	err := w.deliver(ctx, event.Request)
+	if err == nil {
+		return w.succeeded(txCtx, tx, event, attempts)
+	}
+	if attempts >= w.config.MaxAttempts || zerrors.IsNotFound(err) {
+		return w.failed(txCtx, tx, event, attempts, err)
+	}
+	return w.retry(txCtx, tx, event, event.Request, w.backOff(event.BackOff), attempts, err)
+}
+
+// deliver calls the target with its current configuration,
+// so that changes of the endpoint, signing key or transport are applied to pending deliveries
+func (w *Worker) deliver(ctx context.Context, request targetdelivery.Request) error {
+	target, err := w.queries.GetTargetByID(ctx, request.TargetID)
+	if err != nil {
+		return err
+	}
+	payload, err := crypto.Decrypt(request.Payload, w.encryption)
+	if err != nil {
+		return err
+	}
+	// targets of event executions are queued independent of their type
+	if target.TargetType == domain.TargetTypeWASM {
+		output, err := w.runWASM(ctx, target.Module, payload, target.Timeout)
+		if err != nil {
+			return err
+		}
+		_, err = handleResponseBody(output)
+		return err
+	}
+	_, err = w.call(ctx, &Transport{
+		Endpoint:    target.Endpoint,
+		ExecutionID: request.ExecutionID,
+		Timeout:     target.Timeout,
+		SigningKey:  target.SigningKey,
+		TLS:         target.TLS,
+		OAuth2:      target.OAuth2,
+	}, payload)
+	return err
+}
+
+func (w *Worker) succeeded(ctx context.Context, tx *sql.Tx, event eventstore.Event, attempts uint16) error {
+	w.countDelivery(ctx, deliverySucceededCounter, event)
+	return w.commands.TargetDeliverySucceeded(ctx, tx, event.Aggregate().ID, event.Aggregate().InstanceID, attempts)
+}
+
+func (w *Worker) retry(ctx context.Context, tx *sql.Tx, event eventstore.Event, request targetdelivery.Request, backOff time.Duration, attempts uint16, err error) error {
+	w.countDelivery(ctx, deliveryRetriedCounter, event)
+	return w.commands.TargetDeliveryRetryRequested(ctx, tx, event.Aggregate().ID, event.Aggregate().InstanceID, request, backOff, attempts, err)
+}
+
+func (w *Worker) failed(ctx context.Context, tx *sql.Tx, event eventstore.Event, attempts uint16, err error) error {
+	w.countDelivery(ctx, deliveryFailedCounter, event)
+	return w.commands.TargetDeliveryFailed(ctx, tx, event.Aggregate().ID, event.Aggregate().InstanceID, attempts, err)
+}
+
+func (w *Worker) countDelivery(ctx context.Context, counter string, event eventstore.Event) {
+	err := metrics.AddCount(ctx, counter, 1, map[string]attribute.Value{
+		"instance": attribute.StringValue(event.Aggregate().InstanceID),
+	})
+	logging.WithFields("metric", counter).OnError(err).Info("unable to add count")
+}
+
+func (w *Worker) exponentialBackOff(current time.Duration) time.Duration {
+	if current >= w.config.MaxRetryDelay {
+		return w.config.MaxRetryDelay
+	}
+	if current < w.config.MinRetryDelay {
+		current = w.config.MinRetryDelay
+	}
+	t := time.Duration(rand.Int64N(int64(w.config.RetryDelayFactor*float32(current.Nanoseconds()))-current.Nanoseconds()+1) + current.Nanoseconds())
+	if t > w.config.MaxRetryDelay {
+		return w.config.MaxRetryDelay
+	}
+	return t
+}
+
+func (w *Worker) schedule(ctx context.Context, workerID int, retry bool) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			w.log(workerID, retry).Info("scheduler stopped")
+			return
+		case <-t.C:
+			w.triggerInstances(call.WithTimestamp(ctx), w.queries.ActiveInstances(), workerID, retry)
+			if retry {
+				t.Reset(w.config.RetryRequeueEvery)
+				continue
+			}
+			t.Reset(w.config.RequeueEvery)
+		}
+	}
+}
+
+func (w *Worker) log(workerID int, retry bool) *logging.Entry {
+	return logging.WithFields("target delivery worker", workerID, "retries", retry)
+}
+
+func (w *Worker) triggerInstances(ctx context.Context, instances []string, workerID int, retry bool) {
+	for _, instance := range instances {
+		instanceCtx := authz.WithInstanceID(ctx, instance)
+
+		err := w.trigger(instanceCtx, workerID, retry)
+		w.log(workerID, retry).WithField("instance", instance).OnError(err).Info("trigger failed")
+	}
+}
+
+func (w *Worker) trigger(ctx context.Context, workerID int, retry bool) (err error) {
+	txCtx := ctx
+	if w.config.TransactionDuration > 0 {
+		var cancel, cancelTx func()
+		txCtx, cancelTx = context.WithCancel(ctx)
+		defer cancelTx()
+		ctx, cancel = context.WithTimeout(ctx, w.config.TransactionDuration)
+		defer cancel()
+	}
+	tx, err := w.client.BeginTx(txCtx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = database.CloseTransaction(tx, err)
+	}()
+
+	if retry {
+		return w.triggerRetries(ctx, txCtx, tx, workerID)
+	}
+	events, err := w.searchEvents(txCtx, tx)
+	if err != nil {
+		return err
+	}
+	w.reduceEvents(ctx, txCtx, tx, events, workerID, retry)
+	return nil
+}
+
+// triggerRetries handles the retries page by page from the newest to the oldest,
+// so that only the latest retry of each delivery is handled, even if the previous ones are on a later page
+func (w *Worker) triggerRetries(ctx, txCtx context.Context, tx *sql.Tx, workerID int) error {
+	handled := make(map[string]struct{})
+	for offset := uint32(0); ; offset += uint32(w.config.RetryBulkLimit) {
+		events, err := w.searchRetryEvents(txCtx, tx, offset)
+		if err != nil {
+			return err
+		}
+		w.reduceEvents(ctx, txCtx, tx, latestRetries(events, handled), workerID, true)
+		// the page was not full, so there are no more retries
+		if w.config.RetryBulkLimit == 0 || len(events) < int(w.config.RetryBulkLimit) || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (w *Worker) reduceEvents(ctx, txCtx context.Context, tx *sql.Tx, events []eventstore.Event, workerID int, retry bool) {
+	for _, event := range events {
+		var err error
+		switch e := event.(type) {
+		case *targetdelivery.RequestedEvent:
+			w.savepoint(txCtx, tx, event, workerID, retry, "SAVEPOINT target_delivery")
+			err = w.reduceRequested(ctx, txCtx, tx, e)
+		case *targetdelivery.RetryRequestedEvent:
+			w.savepoint(txCtx, tx, event, workerID, retry, "SAVEPOINT target_delivery")
+			err = w.reduceRetry(ctx, txCtx, tx, e)
+		}
+		if err != nil {
+			w.log(workerID, retry).OnError(err).
+				WithField("instanceID", authz.GetInstance(ctx).InstanceID()).
+				WithField("deliveryID", event.Aggregate().ID).
+				WithField("sequence", event.Sequence()).
+				WithField("type", event.Type()).
+				Error("could not handle target delivery event")
+			// if we have an error, we rollback to the savepoint and continue with the next event
+			// we use the txCtx to make sure we can rollback the transaction in case the ctx is canceled
+			w.savepoint(txCtx, tx, event, workerID, retry, "ROLLBACK TO SAVEPOINT target_delivery")
+		}
+		// if the context is canceled, we stop the processing
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (w *Worker) savepoint(ctx context.Context, tx *sql.Tx, event eventstore.Event, workerID int, retry bool, stmt string) {
+	_, err := tx.ExecContext(ctx, stmt)
+	w.log(workerID, retry).OnError(err).
+		WithField("instanceID", authz.GetInstance(ctx).InstanceID()).
+		WithField("deliveryID", event.Aggregate().ID).
+		WithField("sequence", event.Sequence()).
+		WithField("type", event.Type()).
+		Errorf("could not execute %q for target delivery event", stmt)
+}
+
+func (w *Worker) searchEvents(ctx context.Context, tx *sql.Tx) ([]eventstore.Event, error) {
+	// query events and lock them for update (with skip locked)
+	searchQuery := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		LockRowsDuringTx(tx, eventstore.LockOptionSkipLocked).
+		Limit(uint64(w.config.BulkLimit)).
+		AddQuery().
+		AggregateTypes(targetdelivery.AggregateType).
+		EventTypes(targetdelivery.RequestedType).
+		Builder().
+		ExcludeAggregateIDs().
+		EventTypes(targetdelivery.RetryRequestedType, targetdelivery.SucceededType, targetdelivery.FailedType).
+		Builder()
+	//nolint:staticcheck
+	return w.es.Filter(ctx, searchQuery)
+}
+
+func (w *Worker) searchRetryEvents(ctx context.Context, tx *sql.Tx, offset uint32) ([]eventstore.Event, error) {
+	// query events and lock them for update (with skip locked)
+	searchQuery := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		LockRowsDuringTx(tx, eventstore.LockOptionSkipLocked).
+		OrderDesc().
+		Limit(uint64(w.config.RetryBulkLimit)).
+		Offset(offset).
+		AddQuery().
+		AggregateTypes(targetdelivery.AggregateType).
+		EventTypes(targetdelivery.RetryRequestedType).
+		Builder().
+		ExcludeAggregateIDs().
+		EventTypes(targetdelivery.SucceededType, targetdelivery.FailedType).
+		Builder()
+	//nolint:staticcheck
+	return w.es.Filter(ctx, searchQuery)
+}
+
+// latestRetries only keeps the last retry of each delivery, the events are ordered from the newest to the oldest,
+// the deliveries of the kept retries are added to handled, so that their previous retries are skipped on the next pages
+func latestRetries(events []eventstore.Event, handled map[string]struct{}) []eventstore.Event {
+	latest := make([]eventstore.Event, 0, len(events))
+	for _, event := range events {
+		if _, ok := handled[event.Aggregate().ID]; ok {
+			continue
+		}
+		handled[event.Aggregate().ID] = struct{}{}
+		latest = append(latest, event)
+	}
+	return latest
+}
@@ -0,0 +1,319 @@
+package execution
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	deliveryID = "deliveryID"
+	instanceID = "instanceID"
+	targetID   = "targetID"
+)
+
+type mockWorkerCommands struct {
+	succeeded []uint16
+	retried   []uint16
+	backOffs  []time.Duration
+	failed    []uint16
+}
+
+func (m *mockWorkerCommands) TargetDeliverySucceeded(_ context.Context, _ *sql.Tx, _, _ string, attempts uint16) error {
+	m.succeeded = append(m.succeeded, attempts)
+	return nil
+}
+
+func (m *mockWorkerCommands) TargetDeliveryRetryRequested(_ context.Context, _ *sql.Tx, _, _ string, _ targetdelivery.Request, backOff time.Duration, attempts uint16, _ error) error {
+	m.retried = append(m.retried, attempts)
+	m.backOffs = append(m.backOffs, backOff)
+	return nil
+}
+
+func (m *mockWorkerCommands) TargetDeliveryFailed(_ context.Context, _ *sql.Tx, _, _ string, attempts uint16, _ error) error {
+	m.failed = append(m.failed, attempts)
+	return nil
+}
+
+type mockWorkerQueries struct {
+	target *query.Target
+	err    error
+}
+
+func (m *mockWorkerQueries) ActiveInstances() []string {
+	return []string{instanceID}
+}
+
+func (m *mockWorkerQueries) GetTargetByID(context.Context, string) (*query.Target, error) {
+	return m.target, m.err
+}
+
+const deliveryPayload = `{"idempotencyKey":"deliveryID"}`
+
+func newTestWorker(t *testing.T, queries *mockWorkerQueries, commands *mockWorkerCommands, callErr error) *Worker {
+	return &Worker{
+		commands:   commands,
+		queries:    queries,
+		encryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+		config: WorkerConfig{
+			MaxAttempts: 3,
+		},
+		now: time.Now,
+		backOff: func(current time.Duration) time.Duration {
+			return current + time.Second
+		},
+		call: func(_ context.Context, _ *Transport, body []byte) ([]byte, error) {
+			// the payload is sent decrypted to the target
+			assert.Equal(t, deliveryPayload, string(body))
+			return nil, callErr
+		},
+	}
+}
+
+func deliveryBaseEvent(typ eventstore.EventType, sequence uint64, creationDate time.Time) *eventstore.BaseEvent {
+	return eventstore.BaseEventFromRepo(&repository.Event{
+		InstanceID:    instanceID,
+		AggregateID:   deliveryID,
+		AggregateType: targetdelivery.AggregateType,
+		ResourceOwner: sql.NullString{String: instanceID},
+		CreationDate:  creationDate,
+		Seq:           sequence,
+		Typ:           typ,
+	})
+}
+
+func encryptedDeliveryPayload(t *testing.T) *crypto.CryptoValue {
+	payload, err := crypto.Encrypt([]byte(deliveryPayload), crypto.CreateMockEncryptionAlg(gomock.NewController(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func TestWorker_reduceRequested(t *testing.T) {
+	tests := []struct {
+		name    string
+		queries *mockWorkerQueries
+		callErr error
+		want    *mockWorkerCommands
+	}{
+		{
+			name:    "delivered, succeeded",
+			queries: &mockWorkerQueries{target: &query.Target{Endpoint: "https://example.com"}},
+			want:    &mockWorkerCommands{succeeded: []uint16{1}},
+		},
+		{
+			name:    "call failed, retry",
+			queries: &mockWorkerQueries{target: &query.Target{Endpoint: "https://example.com"}},
+			callErr: errors.New("call failed"),
+			want:    &mockWorkerCommands{retried: []uint16{1}, backOffs: []time.Duration{time.Second}},
+		},
+		{
+			name:    "target removed, failed",
+			queries: &mockWorkerQueries{err: zerrors.ThrowNotFound(nil, "TEST", "not found")},
+			want:    &mockWorkerCommands{failed: []uint16{1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(mockWorkerCommands)
+			w := newTestWorker(t, tt.queries, commands, tt.callErr)
+			event := &targetdelivery.RequestedEvent{
+				BaseEvent: deliveryBaseEvent(targetdelivery.RequestedType, 1, time.Now()),
+				Request:   targetdelivery.Request{TargetID: targetID, IdempotencyKey: deliveryID, Payload: encryptedDeliveryPayload(t)},
+			}
+			err := w.reduceRequested(context.Background(), context.Background(), nil, event)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, commands)
+		})
+	}
+}
+
+func TestWorker_reduceRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts uint16
+		backOff  time.Duration
+		callErr  error
+		want     *mockWorkerCommands
+	}{
+		{
+			name:     "backoff not elapsed, skipped",
+			attempts: 1,
+			backOff:  time.Hour,
+			want:     &mockWorkerCommands{},
+		},
+		{
+			name:     "delivered, succeeded",
+			attempts: 1,
+			backOff:  time.Second,
+			want:     &mockWorkerCommands{succeeded: []uint16{2}},
+		},
+		{
+			name:     "call failed, retry",
+			attempts: 1,
+			backOff:  time.Second,
+			callErr:  errors.New("call failed"),
+			want:     &mockWorkerCommands{retried: []uint16{2}, backOffs: []time.Duration{2 * time.Second}},
+		},
+		{
+			name:     "max attempts reached, failed",
+			attempts: 2,
+			backOff:  time.Second,
+			callErr:  errors.New("call failed"),
+			want:     &mockWorkerCommands{failed: []uint16{3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(mockWorkerCommands)
+			w := newTestWorker(t, &mockWorkerQueries{target: &query.Target{Endpoint: "https://example.com"}}, commands, tt.callErr)
+			event := &targetdelivery.RetryRequestedEvent{
+				BaseEvent: deliveryBaseEvent(targetdelivery.RetryRequestedType, 2, time.Now().Add(-time.Minute)),
+				Request:   targetdelivery.Request{TargetID: targetID, IdempotencyKey: deliveryID, Payload: encryptedDeliveryPayload(t)},
+				BackOff:   tt.backOff,
+				Attempts:  tt.attempts,
+			}
+			err := w.reduceRetry(context.Background(), context.Background(), nil, event)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, commands)
+		})
+	}
+}
+
+func TestWorker_exponentialBackOff(t *testing.T) {
+	w := &Worker{
+		config: WorkerConfig{
+			MinRetryDelay:    time.Second,
+			MaxRetryDelay:    time.Minute,
+			RetryDelayFactor: 2,
+		},
+	}
+	got := w.exponentialBackOff(0)
+	assert.GreaterOrEqual(t, got, time.Second)
+	assert.LessOrEqual(t, got, 2*time.Second)
+	assert.Equal(t, time.Minute, w.exponentialBackOff(time.Minute))
+}
+
+func Test_latestRetries(t *testing.T) {
+	first := &targetdelivery.RetryRequestedEvent{BaseEvent: deliveryBaseEvent(targetdelivery.RetryRequestedType, 2, time.Now())}
+	second := &targetdelivery.RetryRequestedEvent{BaseEvent: deliveryBaseEvent(targetdelivery.RetryRequestedType, 3, time.Now())}
+	handled := make(map[string]struct{})
+	assert.Equal(t, []eventstore.Event{second}, latestRetries([]eventstore.Event{second, first}, handled))
+	// previous retries on the next page are skipped
+	assert.Empty(t, latestRetries([]eventstore.Event{first}, handled))
+}
+
+func TestWorker_reduceRetry_eventTarget(t *testing.T) {
+	const (
+		eventExecutionID = "event/user.human.added"
+		eventPayload     = `{"event":{"type":"user.human.added","payload":{"email":"test@example.com"}},"idempotencyKey":"deliveryID"}`
+	)
+	payload, err := crypto.Encrypt([]byte(eventPayload), crypto.CreateMockEncryptionAlg(gomock.NewController(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		attempts uint16
+		callErr  error
+		want     *mockWorkerCommands
+	}{
+		{
+			name:     "delivered, succeeded",
+			attempts: 1,
+			want:     &mockWorkerCommands{succeeded: []uint16{2}},
+		},
+		{
+			name:     "call failed, retry",
+			attempts: 1,
+			callErr:  errors.New("call failed"),
+			want:     &mockWorkerCommands{retried: []uint16{2}, backOffs: []time.Duration{2 * time.Second}},
+		},
+		{
+			name:     "max attempts reached, failed",
+			attempts: 2,
+			callErr:  errors.New("call failed"),
+			want:     &mockWorkerCommands{failed: []uint16{3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(mockWorkerCommands)
+			w := newTestWorker(t, &mockWorkerQueries{target: &query.Target{Endpoint: "https://example.com"}}, commands, nil)
+			w.call = func(_ context.Context, transport *Transport, body []byte) ([]byte, error) {
+				// the event is sent to the target of the event execution
+				assert.Equal(t, eventExecutionID, transport.ExecutionID)
+				assert.Equal(t, eventPayload, string(body))
+				return nil, tt.callErr
+			}
+			event := &targetdelivery.RetryRequestedEvent{
+				BaseEvent: deliveryBaseEvent(targetdelivery.RetryRequestedType, 2, time.Now().Add(-time.Minute)),
+				Request:   targetdelivery.Request{TargetID: targetID, ExecutionID: eventExecutionID, IdempotencyKey: deliveryID, Payload: payload},
+				BackOff:   time.Second,
+				Attempts:  tt.attempts,
+			}
+			err := w.reduceRetry(context.Background(), context.Background(), nil, event)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, commands)
+		})
+	}
+}
+
+func TestWorker_deliver_wasmTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  []byte
+		runErr  error
+		wantErr bool
+	}{
+		{
+			name:   "module run, ok",
+			output: []byte(`{}`),
+		},
+		{
+			name:    "module failed, error",
+			runErr:  errors.New("module failed"),
+			wantErr: true,
+		},
+		{
+			name:    "error forwarded by module, error",
+			output:  []byte(`{"forwardedStatusCode":400,"forwardedErrorMessage":"invalid"}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWorker(t, &mockWorkerQueries{target: &query.Target{TargetType: domain.TargetTypeWASM, Module: []byte("module"), Timeout: time.Second}}, new(mockWorkerCommands), nil)
+			w.call = func(context.Context, *Transport, []byte) ([]byte, error) {
+				t.Fatal("wasm targets must not be called over the network")
+				return nil, nil
+			}
+			w.runWASM = func(_ context.Context, module, body []byte, timeout time.Duration) ([]byte, error) {
+				assert.Equal(t, []byte("module"), module)
+				assert.Equal(t, deliveryPayload, string(body))
+				assert.Equal(t, time.Second, timeout)
+				return tt.output, tt.runErr
+			}
+			err := w.deliver(context.Background(), targetdelivery.Request{TargetID: targetID, Payload: encryptedDeliveryPayload(t)})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -15,3 +15,10 @@ type Provider struct {
 	ID          string `json:"id,omitempty"`
 	Description string `json:"description,omitempty"`
 }
+
+func (p *Provider) GetID() string {
+	if p == nil {
+		return ""
+	}
+	return p.ID
+}
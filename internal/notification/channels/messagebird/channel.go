@@ -0,0 +1,91 @@
+package messagebird
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type messageRequest struct {
+	Originator string   `json:"originator"`
+	Recipients []string `json:"recipients"`
+	Body       string   `json:"body"`
+	// Datacoding auto switches to unicode for messages with characters outside of the GSM 03.38 alphabet
+	Datacoding string `json:"datacoding"`
+}
+
+type messageResponse struct {
+	ID string `json:"id"`
+}
+
+type verifyRequest struct {
+	Recipient  string `json:"recipient"`
+	Originator string `json:"originator"`
+	Type       string `json:"type"`
+	Timeout    int    `json:"timeout"`
+}
+
+func InitChannel(config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized messagebird sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		birdMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "MSGBRD-Sm2mLa", "message is not SMS")
+		}
+		if config.Verify {
+			return startVerification(config, birdMsg)
+		}
+		return sendMessage(config, birdMsg)
+	})
+}
+
+// https://developers.messagebird.com/api/sms-messaging/#send-outbound-sms
+func sendMessage(config Config, msg *messages.SMS) error {
+	content, err := msg.GetContent()
+	if err != nil {
+		return err
+	}
+	resp := new(messageResponse)
+	err = config.do(http.MethodPost, "/messages", &messageRequest{
+		Originator: msg.SenderPhoneNumber,
+		Recipients: []string{normalizeNumber(msg.RecipientPhoneNumber)},
+		Body:       content,
+		Datacoding: "auto",
+	}, resp)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "MSGBRD-Sm2mLb", "could not send message")
+	}
+	logging.WithFields("message_id", resp.ID).Debug("sms sent")
+
+	msg.MessageID = &resp.ID
+	return nil
+}
+
+// https://developers.messagebird.com/api/verify/#request-a-verify
+func startVerification(config Config, msg *messages.SMS) error {
+	resp := new(verifyResponse)
+	err := config.do(http.MethodPost, "/verify", &verifyRequest{
+		Recipient:  normalizeNumber(msg.RecipientPhoneNumber),
+		Originator: msg.SenderPhoneNumber,
+		Type:       "sms",
+		Timeout:    300,
+	}, resp)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "MSGBRD-Vf3nMa", "could not send verification")
+	}
+	logging.WithFields("id", resp.ID, "status", resp.Status).Debug("verification sent")
+
+	msg.VerificationID = &resp.ID
+	return nil
+}
+
+// normalizeNumber removes the leading plus, as MessageBird expects the numbers in E.164 format without it.
+func normalizeNumber(number string) string {
+	return strings.TrimPrefix(strings.TrimSpace(number), "+")
+}
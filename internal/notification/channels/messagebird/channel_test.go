@@ -0,0 +1,163 @@
+package messagebird
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type fakeResponse struct {
+	status int
+	body   string
+}
+
+type fakeRequest struct {
+	method        string
+	uri           string
+	authorization string
+	body          string
+}
+
+// fakeMessageBird records the last request and answers with the configured response
+func fakeMessageBird(t *testing.T, response fakeResponse) (*httptest.Server, *fakeRequest) {
+	recorded := new(fakeRequest)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		*recorded = fakeRequest{
+			method:        r.Method,
+			uri:           r.URL.RequestURI(),
+			authorization: r.Header.Get("Authorization"),
+			body:          string(body),
+		}
+		w.WriteHeader(response.status)
+		_, err = w.Write([]byte(response.body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server, recorded
+}
+
+func TestInitChannel_sendMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      fakeResponse
+		wantMessageID *string
+		wantErr       bool
+	}{
+		{
+			name:     "unauthorized",
+			response: fakeResponse{http.StatusUnauthorized, `{"errors":[{"code":2,"description":"Request not allowed (incorrect access_key)"}]}`},
+			wantErr:  true,
+		},
+		{
+			name:          "sent",
+			response:      fakeResponse{http.StatusCreated, `{"id":"msg1"}`},
+			wantMessageID: gu.Ptr("msg1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, req := fakeMessageBird(t, tt.response)
+			channel := InitChannel(Config{
+				AccessKey:  "key",
+				Originator: "ZITADEL",
+				Endpoint:   server.URL,
+			})
+			msg := &messages.SMS{
+				SenderPhoneNumber:    "ZITADEL",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "code 123456",
+			}
+			err := channel.HandleMessage(msg)
+			assert.Equal(t, http.MethodPost, req.method)
+			assert.Equal(t, "/messages", req.uri)
+			assert.Equal(t, "AccessKey key", req.authorization)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMessageID, msg.MessageID)
+			sent := new(messageRequest)
+			require.NoError(t, json.Unmarshal([]byte(req.body), sent))
+			assert.Equal(t, &messageRequest{
+				Originator: "ZITADEL",
+				Recipients: []string{"41791234567"},
+				Body:       "code 123456",
+				Datacoding: "auto",
+			}, sent)
+		})
+	}
+}
+
+func TestInitChannel_startVerification(t *testing.T) {
+	server, req := fakeMessageBird(t, fakeResponse{http.StatusCreated, `{"id":"verify1","status":"sent"}`})
+	channel := InitChannel(Config{
+		AccessKey:  "key",
+		Originator: "ZITADEL",
+		Verify:     true,
+		Endpoint:   server.URL,
+	})
+	msg := &messages.SMS{
+		SenderPhoneNumber:    "ZITADEL",
+		RecipientPhoneNumber: "+41791234567",
+	}
+	require.NoError(t, channel.HandleMessage(msg))
+	assert.Equal(t, gu.Ptr("verify1"), msg.VerificationID)
+	assert.Equal(t, "/verify", req.uri)
+	sent := new(verifyRequest)
+	require.NoError(t, json.Unmarshal([]byte(req.body), sent))
+	assert.Equal(t, "41791234567", sent.Recipient)
+	assert.Equal(t, "sms", sent.Type)
+}
+
+func TestConfig_VerifyCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		response fakeResponse
+		wantErr  error
+	}{
+		{
+			name:     "verified",
+			response: fakeResponse{http.StatusOK, `{"id":"verify1","status":"verified"}`},
+		},
+		{
+			name:     "invalid token",
+			response: fakeResponse{http.StatusUnprocessableEntity, `{"errors":[{"code":10,"description":"The token is invalid."}]}`},
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOa", "Errors.User.Code.Invalid"),
+		},
+		{
+			name:     "expired",
+			response: fakeResponse{http.StatusOK, `{"id":"verify1","status":"expired"}`},
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOc", "Errors.User.Code.Expired"),
+		},
+		{
+			name:     "unknown verification",
+			response: fakeResponse{http.StatusNotFound, `{"errors":[{"code":20,"description":"verify not found"}]}`},
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOb", "Errors.User.Code.NotFound"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, req := fakeMessageBird(t, tt.response)
+			config := &Config{
+				AccessKey: "key",
+				Endpoint:  server.URL,
+			}
+			err := config.VerifyCode("verify1", "123456")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, http.MethodGet, req.method)
+			assert.Equal(t, "/verify/verify1?token=123456", req.uri)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package messagebird
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	defaultEndpoint = "https://rest.messagebird.com"
+
+	requestTimeout = 30 * time.Second
+
+	// errorCodeInvalidToken is returned by the verify API if the code does not match
+	errorCodeInvalidToken = 10
+)
+
+type Config struct {
+	AccessKey  string
+	Originator string
+	// Verify enables the MessageBird Verify API, which generates and checks the codes.
+	Verify bool
+
+	// Endpoint overrides the URL of the MessageBird API, it's only used for testing
+	Endpoint string
+}
+
+func (c *Config) IsValid() bool {
+	return c.AccessKey != "" && c.Originator != ""
+}
+
+func (c *Config) endpoint() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	return defaultEndpoint
+}
+
+type verifyResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type errorResponse struct {
+	Errors []struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"errors"`
+}
+
+func (e *errorResponse) Error() string {
+	descriptions := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		descriptions[i] = err.Description
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func (e *errorResponse) hasCode(code int) bool {
+	for _, err := range e.Errors {
+		if err.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyCode checks the code of a verification requested by the channel
+// https://developers.messagebird.com/api/verify/#verify-a-token
+func (c *Config) VerifyCode(verificationID, code string) error {
+	resp := new(verifyResponse)
+	err := c.do(http.MethodGet, "/verify/"+url.PathEscape(verificationID)+"?token="+url.QueryEscape(code), nil, resp)
+	var errResp *errorResponse
+	if errors.As(err, &errResp) && errResp.hasCode(errorCodeInvalidToken) {
+		return zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOa", "Errors.User.Code.Invalid")
+	}
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "MSGBRD-Cx3nOb", "Errors.User.Code.NotFound")
+	}
+	switch resp.Status {
+	case "verified":
+		return nil
+	case "expired":
+		return zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOc", "Errors.User.Code.Expired")
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "MSGBRD-Cx3nOd", "Errors.User.Code.Invalid")
+	}
+}
+
+func (c *Config) do(method, path string, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, c.endpoint()+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "AccessKey "+c.AccessKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	client := &http.Client{Timeout: requestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errResp := new(errorResponse)
+		if err = json.NewDecoder(resp.Body).Decode(errResp); err != nil || len(errResp.Errors) == 0 {
+			return zerrors.ThrowInternalf(err, "MSGBRD-Rs4oPa", "unexpected status code %d", resp.StatusCode)
+		}
+		return errResp
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
@@ -0,0 +1,121 @@
+package smpp
+
+import (
+	"crypto/tls"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func InitChannel(config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized smpp sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		smppMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SMPP-Sm4oLa", "message is not SMS")
+		}
+		content, err := smppMsg.GetContent()
+		if err != nil {
+			return err
+		}
+		messageID, err := submit(config, normalizeNumber(smppMsg.RecipientPhoneNumber), content)
+		if err != nil {
+			return err
+		}
+		logging.WithFields("message_id", messageID).Debug("sms sent")
+
+		smppMsg.MessageID = &messageID
+		return nil
+	})
+}
+
+// submit binds as transmitter, submits the message and unbinds again.
+// A connection per message keeps the channel stateless, which is fine for the volume of notifications.
+func submit(config Config, destination, content string) (string, error) {
+	body, err := submitSMBody(config.SourceAddress, destination, content)
+	if err != nil {
+		return "", zerrors.ThrowInvalidArgument(err, "SMPP-Sm4oLb", "could not create message")
+	}
+	conn, err := dial(config)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "SMPP-Sm4oLc", "could not connect to smsc")
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(requestTimeout)); err != nil {
+		return "", zerrors.ThrowInternal(err, "SMPP-Sm4oLd", "could not connect to smsc")
+	}
+
+	session := &session{conn: conn}
+	if _, err = session.request(commandBindTransmitter, commandBindTransmitterResp, bindTransmitterBody(config)); err != nil {
+		return "", zerrors.ThrowInternal(err, "SMPP-Sm4oLe", "could not bind to smsc")
+	}
+	resp, err := session.request(commandSubmitSM, commandSubmitSMResp, body)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "SMPP-Sm4oLf", "could not send message")
+	}
+	if _, err = session.request(commandUnbind, commandUnbindResp, nil); err != nil {
+		logging.WithError(err).Warn("could not unbind from smsc")
+	}
+	return resp.cString(), nil
+}
+
+func dial(config Config) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	if !config.TLS {
+		return dialer.Dial("tcp", config.Host)
+	}
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	return tls.DialWithDialer(dialer, "tcp", config.Host, tlsConfig)
+}
+
+type session struct {
+	conn     net.Conn
+	sequence uint32
+}
+
+func (s *session) request(commandID, respCommandID uint32, body []byte) (*pdu, error) {
+	s.sequence++
+	req := &pdu{
+		commandID:      commandID,
+		sequenceNumber: s.sequence,
+		body:           body,
+	}
+	if _, err := s.conn.Write(req.marshal()); err != nil {
+		return nil, err
+	}
+	for {
+		resp, err := readPDU(s.conn)
+		if err != nil {
+			return nil, err
+		}
+		// ignore requests of the smsc (e.g. enquire_link) and responses to other requests
+		if resp.sequenceNumber != s.sequence {
+			continue
+		}
+		if resp.commandID != respCommandID && resp.commandID != commandGenericNack {
+			continue
+		}
+		if resp.commandStatus != 0 {
+			return nil, statusError(resp.commandStatus)
+		}
+		if resp.commandID == commandGenericNack {
+			return nil, statusError(resp.commandStatus)
+		}
+		return resp, nil
+	}
+}
+
+// normalizeNumber removes the leading plus, the number type is set to international instead
+func normalizeNumber(number string) string {
+	return strings.TrimPrefix(strings.TrimSpace(number), "+")
+}
@@ -0,0 +1,362 @@
+package smpp
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+// fakeSMSC accepts a single session and answers the requests with the given submit_sm status,
+// the session is served over tls if tlsConfig is set
+func fakeSMSC(t *testing.T, submitStatus uint32, tlsConfig *tls.Config) (string, <-chan []*pdu) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	t.Cleanup(func() { listener.Close() })
+	received := make(chan []*pdu, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var requests []*pdu
+		defer func() { received <- requests }()
+		for {
+			req, err := readPDU(conn)
+			if err != nil {
+				return
+			}
+			requests = append(requests, req)
+			resp := &pdu{
+				commandID:      req.commandID | commandGenericNack,
+				sequenceNumber: req.sequenceNumber,
+			}
+			switch req.commandID {
+			case commandBindTransmitter:
+				resp.body = []byte("smsc\x00")
+			case commandSubmitSM:
+				resp.commandStatus = submitStatus
+				resp.body = []byte("msg1\x00")
+			}
+			if _, err = conn.Write(resp.marshal()); err != nil {
+				return
+			}
+			if req.commandID == commandUnbind {
+				return
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestInitChannel(t *testing.T) {
+	tests := []struct {
+		name          string
+		submitStatus  uint32
+		wantMessageID *string
+		wantCommands  []uint32
+		wantErr       bool
+	}{
+		{
+			name:         "rejected",
+			submitStatus: 0x45, // ESME_RSUBMITFAIL
+			wantCommands: []uint32{commandBindTransmitter, commandSubmitSM},
+			wantErr:      true,
+		},
+		{
+			name:          "sent",
+			wantMessageID: gu.Ptr("msg1"),
+			wantCommands:  []uint32{commandBindTransmitter, commandSubmitSM, commandUnbind},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, received := fakeSMSC(t, tt.submitStatus, nil)
+			channel := InitChannel(Config{
+				Host:          host,
+				SystemID:      "zitadel",
+				Password:      "password",
+				SourceAddress: "ZITADEL",
+			})
+			msg := &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "code 123456",
+			}
+			err := channel.HandleMessage(msg)
+			requests := <-received
+			commands := make([]uint32, len(requests))
+			for i, req := range requests {
+				commands[i] = req.commandID
+			}
+			assert.Equal(t, tt.wantCommands, commands)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMessageID, msg.MessageID)
+			assert.Equal(t, "zitadel", requests[0].cString())
+			assert.True(t, bytes.Contains(requests[1].body, []byte("41791234567\x00")))
+		})
+	}
+}
+
+// testCertificate creates a self-signed certificate for smsc.example.com
+func testCertificate(t *testing.T) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "smsc.example.com"},
+		DNSNames:              []string{"smsc.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestInitChannel_tls(t *testing.T) {
+	certificate, ca := testCertificate(t)
+	tests := []struct {
+		name          string
+		tlsCA         string
+		tlsServerName string
+		wantErr       bool
+	}{
+		{
+			name:          "unknown ca",
+			tlsServerName: "smsc.example.com",
+			wantErr:       true,
+		},
+		{
+			name:    "wrong server name",
+			tlsCA:   ca,
+			wantErr: true,
+		},
+		{
+			name:          "ca and server name",
+			tlsCA:         ca,
+			tlsServerName: "smsc.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, received := fakeSMSC(t, 0, &tls.Config{Certificates: []tls.Certificate{certificate}})
+			channel := InitChannel(Config{
+				Host:          host,
+				TLS:           true,
+				TLSCA:         tt.tlsCA,
+				TLSServerName: tt.tlsServerName,
+				SystemID:      "zitadel",
+				SourceAddress: "ZITADEL",
+			})
+			msg := &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "code 123456",
+			}
+			err := channel.HandleMessage(msg)
+			requests := <-received
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Empty(t, requests)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, gu.Ptr("msg1"), msg.MessageID)
+			assert.Len(t, requests, 3)
+		})
+	}
+}
+
+// Test_pdu_marshal checks the encoding against pdus assembled from the field tables of SMPP 3.4
+func Test_pdu_marshal(t *testing.T) {
+	tests := []struct {
+		name string
+		pdu  func(t *testing.T) *pdu
+		want string
+	}{
+		{
+			name: "bind_transmitter",
+			pdu: func(*testing.T) *pdu {
+				return &pdu{
+					commandID:      commandBindTransmitter,
+					sequenceNumber: 1,
+					body: bindTransmitterBody(Config{
+						SystemID: "zitadel",
+						Password: "password",
+					}),
+				}
+			},
+			// length, id, status, sequence, system_id, password, system_type, interface_version, addr_ton, addr_npi, address_range
+			want: "00000026" + "00000002" + "00000000" + "00000001" +
+				"7a69746164656c00" + "70617373776f726400" + "00" + "34" + "00" + "00" + "00",
+		},
+		{
+			name: "submit_sm, alphanumeric sender",
+			pdu: func(t *testing.T) *pdu {
+				body, err := submitSMBody("ZITADEL", "41791234567", "code 123456")
+				require.NoError(t, err)
+				return &pdu{commandID: commandSubmitSM, sequenceNumber: 2, body: body}
+			},
+			// length, id, status, sequence, service_type, source_addr_ton, source_addr_npi, source_addr,
+			// dest_addr_ton, dest_addr_npi, destination_addr, esm_class, protocol_id, priority_flag,
+			// schedule_delivery_time, validity_period, registered_delivery, replace_if_present_flag,
+			// data_coding, sm_default_msg_id, sm_length, short_message
+			want: "0000003e" + "00000004" + "00000000" + "00000002" +
+				"00" + "05" + "00" + "5a49544144454c00" +
+				"01" + "01" + "343137393132333435363700" + "00" + "00" + "00" +
+				"00" + "00" + "01" + "00" +
+				"00" + "00" + "0b" + "636f646520313233343536",
+		},
+		{
+			name: "submit_sm, number sender, ucs2",
+			pdu: func(t *testing.T) *pdu {
+				body, err := submitSMBody("41000000000", "41791234567", "✓a")
+				require.NoError(t, err)
+				return &pdu{commandID: commandSubmitSM, sequenceNumber: 2, body: body}
+			},
+			want: "0000003b" + "00000004" + "00000000" + "00000002" +
+				"00" + "01" + "01" + "343130303030303030303000" +
+				"01" + "01" + "343137393132333435363700" + "00" + "00" + "00" +
+				"00" + "00" + "01" + "00" +
+				"08" + "00" + "04" + "27130061",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hex.EncodeToString(tt.pdu(t).marshal()))
+		})
+	}
+}
+
+func Test_readPDU(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    *pdu
+		wantErr bool
+	}{
+		{
+			name: "bind_transmitter_resp",
+			data: "00000015" + "80000002" + "00000000" + "00000001" + "736d736300",
+			want: &pdu{
+				commandID:      commandBindTransmitterResp,
+				sequenceNumber: 1,
+				body:           []byte("smsc\x00"),
+			},
+		},
+		{
+			name: "submit_sm_resp, ESME_RSUBMITFAIL",
+			data: "00000010" + "80000004" + "00000045" + "00000002",
+			want: &pdu{
+				commandID:      commandSubmitSMResp,
+				commandStatus:  0x45,
+				sequenceNumber: 2,
+				body:           []byte{},
+			},
+		},
+		{
+			name:    "length shorter than header",
+			data:    "0000000f" + "80000004" + "00000000" + "00000002",
+			wantErr: true,
+		},
+		{
+			name:    "truncated body",
+			data:    "00000015" + "80000002" + "00000000" + "00000001" + "736d",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+			got, err := readPDU(bytes.NewReader(data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_submitSMBody(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         string
+		content        string
+		wantSourceTON  byte
+		wantDataCoding byte
+		wantPayload    bool
+	}{
+		{
+			name:           "alphanumeric sender",
+			source:         "ZITADEL",
+			content:        "code 123456",
+			wantSourceTON:  tonAlphanumeric,
+			wantDataCoding: dataCodingDefault,
+		},
+		{
+			name:           "number sender, unicode",
+			source:         "41000000000",
+			content:        "Ihr Code: 123456 ✓",
+			wantSourceTON:  tonInternational,
+			wantDataCoding: dataCodingUCS2,
+		},
+		{
+			name:           "long message",
+			source:         "ZITADEL",
+			content:        string(bytes.Repeat([]byte("a"), 300)),
+			wantSourceTON:  tonAlphanumeric,
+			wantDataCoding: dataCodingDefault,
+			wantPayload:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := submitSMBody(tt.source, "41791234567", tt.content)
+			require.NoError(t, err)
+			// service_type is empty, followed by the source address
+			assert.Equal(t, tt.wantSourceTON, body[1])
+			// the data coding follows the fixed length fields after the destination address
+			destinationEnd := bytes.Index(body, []byte("41791234567\x00")) + len("41791234567\x00")
+			assert.Equal(t, tt.wantDataCoding, body[destinationEnd+7])
+			smLength := body[destinationEnd+9]
+			if tt.wantPayload {
+				assert.Equal(t, byte(0), smLength)
+				assert.Equal(t, []byte{0x04, 0x24}, body[destinationEnd+10:destinationEnd+12])
+				return
+			}
+			assert.Equal(t, int(smLength), len(body)-destinationEnd-10)
+		})
+	}
+}
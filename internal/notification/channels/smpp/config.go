@@ -0,0 +1,59 @@
+package smpp
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"time"
+)
+
+const (
+	dialTimeout    = 10 * time.Second
+	requestTimeout = 30 * time.Second
+)
+
+type Config struct {
+	// Host of the SMSC including the port (host:port)
+	Host string
+	TLS  bool
+	// TLSCA is the PEM encoded certificate of the CA the certificate of the SMSC is verified with,
+	// the system roots are used if empty
+	TLSCA string
+	// TLSServerName is the host name the certificate of the SMSC is verified against,
+	// the host name of Host is used if empty
+	TLSServerName string
+
+	SystemID   string
+	Password   string
+	SystemType string
+	// SourceAddress is the number or alphanumeric sender id the messages are sent from
+	SourceAddress string
+}
+
+func (c *Config) IsValid() bool {
+	return c.Host != "" && c.SystemID != "" && c.SourceAddress != ""
+}
+
+// tlsConfig verifies the certificate of the SMSC against the configured CA and server name,
+// or the system roots and the host name of Host if they are empty
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.TLSServerName,
+	}
+	if tlsConfig.ServerName == "" {
+		host, _, err := net.SplitHostPort(c.Host)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ServerName = host
+	}
+	if c.TLSCA != "" {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(c.TLSCA)) {
+			return nil, errors.New("invalid smpp tls ca")
+		}
+	}
+	return tlsConfig, nil
+}
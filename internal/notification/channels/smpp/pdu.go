@@ -0,0 +1,188 @@
+package smpp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+	"unicode/utf16"
+)
+
+// command ids and constants of SMPP 3.4
+// https://smpp.org/SMPP_v3_4_Issue1_2.pdf
+//
+// Only the PDUs of a transmitter sending single messages are implemented,
+// receiving (deliver_sm), enquire_link and concatenated messages are not supported.
+const (
+	commandGenericNack         uint32 = 0x80000000
+	commandBindTransmitter     uint32 = 0x00000002
+	commandBindTransmitterResp uint32 = 0x80000002
+	commandSubmitSM            uint32 = 0x00000004
+	commandSubmitSMResp        uint32 = 0x80000004
+	commandUnbind              uint32 = 0x00000006
+	commandUnbindResp          uint32 = 0x80000006
+
+	interfaceVersion byte = 0x34
+
+	tonInternational  byte = 0x01
+	tonAlphanumeric   byte = 0x05
+	npiISDN           byte = 0x01
+	npiUnknown        byte = 0x00
+	dataCodingDefault byte = 0x00
+	dataCodingUCS2    byte = 0x08
+
+	tagMessagePayload uint16 = 0x0424
+
+	// maxShortMessageLength is the maximum length of the short_message field,
+	// longer messages are sent using the message_payload tlv
+	maxShortMessageLength = 254
+
+	headerLength = 16
+	// maxPDULength protects against invalid responses
+	maxPDULength = 64 * 1024
+)
+
+type pdu struct {
+	commandID      uint32
+	commandStatus  uint32
+	sequenceNumber uint32
+	body           []byte
+}
+
+type statusError uint32
+
+func (e statusError) Error() string {
+	return fmt.Sprintf("smpp command status 0x%08x", uint32(e))
+}
+
+func (p *pdu) marshal() []byte {
+	buf := make([]byte, headerLength, headerLength+len(p.body))
+	binary.BigEndian.PutUint32(buf[0:], uint32(headerLength+len(p.body)))
+	binary.BigEndian.PutUint32(buf[4:], p.commandID)
+	binary.BigEndian.PutUint32(buf[8:], p.commandStatus)
+	binary.BigEndian.PutUint32(buf[12:], p.sequenceNumber)
+	return append(buf, p.body...)
+}
+
+func readPDU(r io.Reader) (*pdu, error) {
+	header := make([]byte, headerLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header[0:])
+	if length < headerLength || length > maxPDULength {
+		return nil, fmt.Errorf("invalid smpp pdu length %d", length)
+	}
+	p := &pdu{
+		commandID:      binary.BigEndian.Uint32(header[4:]),
+		commandStatus:  binary.BigEndian.Uint32(header[8:]),
+		sequenceNumber: binary.BigEndian.Uint32(header[12:]),
+		body:           make([]byte, length-headerLength),
+	}
+	if _, err := io.ReadFull(r, p.body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// cString reads the first null terminated string of the body
+func (p *pdu) cString() string {
+	value, _, _ := bytes.Cut(p.body, []byte{0})
+	return string(value)
+}
+
+type bodyWriter struct {
+	bytes.Buffer
+}
+
+func (w *bodyWriter) cString(value string) {
+	w.WriteString(value)
+	w.WriteByte(0)
+}
+
+func bindTransmitterBody(config Config) []byte {
+	w := new(bodyWriter)
+	w.cString(config.SystemID)
+	w.cString(config.Password)
+	w.cString(config.SystemType)
+	w.WriteByte(interfaceVersion)
+	w.WriteByte(0) // addr_ton
+	w.WriteByte(0) // addr_npi
+	w.cString("")  // address_range
+	return w.Bytes()
+}
+
+func submitSMBody(source, destination, content string) ([]byte, error) {
+	sourceTON, sourceNPI := addressType(source)
+	dataCoding, message := encode(content)
+
+	w := new(bodyWriter)
+	w.cString("") // service_type
+	w.WriteByte(sourceTON)
+	w.WriteByte(sourceNPI)
+	w.cString(source)
+	w.WriteByte(tonInternational)
+	w.WriteByte(npiISDN)
+	w.cString(destination)
+	w.WriteByte(0) // esm_class
+	w.WriteByte(0) // protocol_id
+	w.WriteByte(0) // priority_flag
+	w.cString("")  // schedule_delivery_time
+	w.cString("")  // validity_period
+	w.WriteByte(1) // registered_delivery: request delivery receipts
+	w.WriteByte(0) // replace_if_present_flag
+	w.WriteByte(dataCoding)
+	w.WriteByte(0) // sm_default_msg_id
+	if len(message) <= maxShortMessageLength {
+		w.WriteByte(byte(len(message)))
+		w.Write(message)
+		return w.Bytes(), nil
+	}
+	if len(message) > 0xffff {
+		return nil, errors.New("message too long")
+	}
+	// sm_length must be 0 if the message_payload is used
+	w.WriteByte(0)
+	tlv := make([]byte, 4)
+	binary.BigEndian.PutUint16(tlv[0:], tagMessagePayload)
+	binary.BigEndian.PutUint16(tlv[2:], uint16(len(message)))
+	w.Write(tlv)
+	w.Write(message)
+	return w.Bytes(), nil
+}
+
+// addressType returns the type of number and numbering plan indicator of the source address,
+// which is either an international number or an alphanumeric sender id
+func addressType(address string) (ton, npi byte) {
+	for _, r := range address {
+		if !unicode.IsDigit(r) {
+			return tonAlphanumeric, npiUnknown
+		}
+	}
+	return tonInternational, npiISDN
+}
+
+// encode returns the message in the SMSC default alphabet if it only contains ascii characters
+// and UCS2 otherwise
+func encode(content string) (dataCoding byte, message []byte) {
+	if isASCII(content) {
+		return dataCodingDefault, []byte(content)
+	}
+	encoded := utf16.Encode([]rune(content))
+	message = make([]byte, 2*len(encoded))
+	for i, c := range encoded {
+		binary.BigEndian.PutUint16(message[2*i:], c)
+	}
+	return dataCodingUCS2, message
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
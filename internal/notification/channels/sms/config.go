@@ -1,17 +1,51 @@
 package sms
 
 import (
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/smpp"
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
 type Config struct {
-	ProviderConfig *Provider
-	TwilioConfig   *twilio.Config
-	WebhookConfig  *webhook.Config
+	ProviderConfig    *Provider
+	TwilioConfig      *twilio.Config
+	VonageConfig      *vonage.Config
+	SNSConfig         *sns.Config
+	MessageBirdConfig *messagebird.Config
+	SMPPConfig        *smpp.Config
+	WebhookConfig     *webhook.Config
+}
+
+// SenderNumber returns the number or sender id of the provider, which sends the SMS itself.
+// ok is false if no such provider is configured (e.g. the messages are sent to a webhook).
+func (c *Config) SenderNumber() (sender string, ok bool) {
+	switch {
+	case c.TwilioConfig != nil:
+		return c.TwilioConfig.SenderNumber, true
+	case c.VonageConfig != nil:
+		return c.VonageConfig.SenderNumber, true
+	case c.SNSConfig != nil:
+		return c.SNSConfig.SenderID, true
+	case c.MessageBirdConfig != nil:
+		return c.MessageBirdConfig.Originator, true
+	case c.SMPPConfig != nil:
+		return c.SMPPConfig.SourceAddress, true
+	default:
+		return "", false
+	}
 }
 
 type Provider struct {
 	ID          string `json:"id,omitempty"`
 	Description string `json:"description,omitempty"`
 }
+
+func (p *Provider) GetID() string {
+	if p == nil {
+		return ""
+	}
+	return p.ID
+}
@@ -1,10 +1,13 @@
 package smtp
 
 import (
+	"crypto/rand"
 	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"net"
 	"net/smtp"
+	"strings"
 
 	"github.com/zitadel/logging"
 
@@ -50,6 +53,11 @@ func (email *Email) HandleMessage(message channels.Message) error {
 	emailMsg.SenderEmail = email.senderAddress
 	emailMsg.SenderName = email.senderName
 	emailMsg.ReplyToAddress = email.replyToAddress
+	messageID, err := newMessageID(emailMsg.SenderEmail)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "EMAIL-Mi8dLq", "Errors.Internal")
+	}
+	emailMsg.MessageID = messageID
 	// To && From
 	if err := email.smtpClient.Mail(emailMsg.SenderEmail); err != nil {
 		return zerrors.ThrowInternal(err, "EMAIL-s3is3", "Errors.SMTP.CouldNotSetSender")
@@ -84,6 +92,19 @@ func (email *Email) HandleMessage(message channels.Message) error {
 	return email.smtpClient.Quit()
 }
 
+// newMessageID generates a unique id for the Message-ID header in the domain of the sender
+func newMessageID(senderAddress string) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	domain := "localhost"
+	if i := strings.LastIndex(senderAddress, "@"); i >= 0 && i < len(senderAddress)-1 {
+		domain = senderAddress[i+1:]
+	}
+	return hex.EncodeToString(random) + "@" + domain, nil
+}
+
 func (smtpConfig SMTP) connectToSMTP(tlsRequired bool) (client *smtp.Client, err error) {
 	host, _, err := net.SplitHostPort(smtpConfig.Host)
 	if err != nil {
@@ -0,0 +1,87 @@
+package sns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/smithy-go"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	attributeSMSType  = "AWS.SNS.SMS.SMSType"
+	attributeSenderID = "AWS.SNS.SMS.SenderID"
+)
+
+func InitChannel(config Config) channels.NotificationChannel {
+	client := newClient(config)
+
+	logging.Debug("successfully initialized aws sns sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		snsMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SNS-Sm3nLa", "message is not SMS")
+		}
+		return publish(context.Background(), client, config, snsMsg)
+	})
+}
+
+func newClient(config Config) *sns.Client {
+	return sns.New(sns.Options{
+		Region: config.Region,
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{
+				AccessKeyID:     config.AccessKeyID,
+				SecretAccessKey: config.SecretAccessKey,
+			}, nil
+		}),
+		BaseEndpoint: config.endpoint(),
+		HTTPClient:   &http.Client{Timeout: requestTimeout},
+	})
+}
+
+// https://docs.aws.amazon.com/sns/latest/api/API_Publish.html
+func publish(ctx context.Context, client *sns.Client, config Config, msg *messages.SMS) error {
+	content, err := msg.GetContent()
+	if err != nil {
+		return err
+	}
+	attributes := map[string]types.MessageAttributeValue{
+		attributeSMSType: {
+			DataType:    aws.String("String"),
+			StringValue: aws.String("Transactional"),
+		},
+	}
+	if config.SenderID != "" {
+		attributes[attributeSenderID] = types.MessageAttributeValue{
+			DataType:    aws.String("String"),
+			StringValue: aws.String(config.SenderID),
+		}
+	}
+	resp, err := client.Publish(ctx, &sns.PublishInput{
+		PhoneNumber:       aws.String(msg.RecipientPhoneNumber),
+		Message:           aws.String(content),
+		MessageAttributes: attributes,
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			logging.WithFields("code", apiErr.ErrorCode(), "error", apiErr.ErrorMessage()).Warn("aws sns publish error")
+			return zerrors.ThrowInternalf(err, "SNS-Sm3nLf", "could not send message: %s", apiErr.ErrorMessage())
+		}
+		return zerrors.ThrowInternal(err, "SNS-Sm3nLc", "could not send message")
+	}
+	logging.WithFields("message_id", aws.ToString(resp.MessageId)).Debug("sms sent")
+
+	msg.MessageID = resp.MessageId
+	return nil
+}
@@ -0,0 +1,104 @@
+package sns
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestInitChannel(t *testing.T) {
+	tests := []struct {
+		name          string
+		senderID      string
+		status        int
+		response      string
+		wantMessageID *string
+		wantErr       bool
+	}{
+		{
+			name:     "rejected",
+			status:   http.StatusForbidden,
+			response: `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidClientTokenId</Code><Message>The security token included in the request is invalid.</Message></Error></ErrorResponse>`,
+			wantErr:  true,
+		},
+		{
+			name:          "sent",
+			status:        http.StatusOK,
+			response:      `<PublishResponse><PublishResult><MessageId>msg1</MessageId></PublishResult></PublishResponse>`,
+			wantMessageID: gu.Ptr("msg1"),
+		},
+		{
+			name:          "sender id",
+			senderID:      "ZITADEL",
+			status:        http.StatusOK,
+			response:      `<PublishResponse><PublishResult><MessageId>msg2</MessageId></PublishResult></PublishResponse>`,
+			wantMessageID: gu.Ptr("msg2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				form          url.Values
+				authorization string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				form, err = url.ParseQuery(string(body))
+				require.NoError(t, err)
+				authorization = r.Header.Get("Authorization")
+				w.WriteHeader(tt.status)
+				_, err = w.Write([]byte(tt.response))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			channel := InitChannel(Config{
+				Region:          "eu-central-1",
+				AccessKeyID:     "key",
+				SecretAccessKey: "secret",
+				SenderID:        tt.senderID,
+				Endpoint:        server.URL,
+			})
+			msg := &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "code 123456",
+			}
+			err := channel.HandleMessage(msg)
+			assert.True(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=key/"))
+			assert.Contains(t, authorization, "/eu-central-1/sns/aws4_request")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMessageID, msg.MessageID)
+			assert.Equal(t, "Publish", form.Get("Action"))
+			assert.Equal(t, "+41791234567", form.Get("PhoneNumber"))
+			assert.Equal(t, "code 123456", form.Get("Message"))
+			attributes := messageAttributes(form)
+			assert.Equal(t, "Transactional", attributes[attributeSMSType])
+			assert.Equal(t, tt.senderID, attributes[attributeSenderID])
+		})
+	}
+}
+
+// messageAttributes maps the names of the message attributes of the form to their string values.
+func messageAttributes(form url.Values) map[string]string {
+	attributes := make(map[string]string)
+	for i := 1; form.Has(fmt.Sprintf("MessageAttributes.entry.%d.Name", i)); i++ {
+		name := form.Get(fmt.Sprintf("MessageAttributes.entry.%d.Name", i))
+		attributes[name] = form.Get(fmt.Sprintf("MessageAttributes.entry.%d.Value.StringValue", i))
+	}
+	return attributes
+}
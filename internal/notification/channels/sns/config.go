@@ -0,0 +1,33 @@
+package sns
+
+import (
+	"time"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+)
+
+type Config struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// SenderID is shown to the recipient instead of a number, if supported by the destination country
+	SenderID string
+
+	// Endpoint overrides the URL of the SNS API, it's only used for testing
+	Endpoint string
+}
+
+func (c *Config) IsValid() bool {
+	return c.Region != "" && c.AccessKeyID != "" && c.SecretAccessKey != ""
+}
+
+// endpoint returns the overridden URL of the SNS API,
+// nil lets the client resolve the regional endpoint.
+func (c *Config) endpoint() *string {
+	if c.Endpoint == "" {
+		return nil
+	}
+	return &c.Endpoint
+}
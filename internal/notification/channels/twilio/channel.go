@@ -57,11 +57,16 @@ func InitChannel(config Config) channels.NotificationChannel {
 		params.SetTo(twilioMsg.RecipientPhoneNumber)
 		params.SetFrom(twilioMsg.SenderPhoneNumber)
 		params.SetBody(content)
+		if config.StatusCallbackURL != "" {
+			params.SetStatusCallback(config.StatusCallbackURL)
+		}
 		m, err := client.Api.CreateMessage(params)
 		if err != nil {
 			return zerrors.ThrowInternal(err, "TWILI-osk3S", "could not send message")
 		}
 		logging.WithFields("message_sid", m.Sid, "status", m.Status).Debug("sms sent")
+
+		twilioMsg.MessageID = m.Sid
 		return nil
 	})
 }
@@ -12,6 +12,8 @@ type Config struct {
 	Token            string
 	SenderNumber     string
 	VerifyServiceSID string
+	// StatusCallbackURL is passed to Twilio to report the delivery status of the messages
+	StatusCallbackURL string
 }
 
 func (t *Config) IsValid() bool {
@@ -0,0 +1,122 @@
+package vonage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"unicode"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type smsResponse struct {
+	Messages []struct {
+		MessageID string `json:"message-id"`
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
+
+type verifyResponse struct {
+	RequestID string `json:"request_id"`
+	Status    string `json:"status"`
+	ErrorText string `json:"error_text"`
+}
+
+func InitChannel(config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized vonage sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		vonageMsg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "VONAGE-Sm1lKa", "message is not SMS")
+		}
+		if config.VerifyBrand != "" {
+			return startVerification(config, vonageMsg)
+		}
+		return sendMessage(config, vonageMsg)
+	})
+}
+
+// https://developer.vonage.com/en/api/sms#send-an-sms
+func sendMessage(config Config, msg *messages.SMS) error {
+	content, err := msg.GetContent()
+	if err != nil {
+		return err
+	}
+	values := url.Values{
+		"from": {normalizeNumber(msg.SenderPhoneNumber)},
+		"to":   {normalizeNumber(msg.RecipientPhoneNumber)},
+		"text": {content},
+	}
+	if !isASCII(content) {
+		values.Set("type", "unicode")
+	}
+	resp := new(smsResponse)
+	if err = config.post(config.smsEndpoint()+"/sms/json", values, resp); err != nil {
+		return zerrors.ThrowInternal(err, "VONAGE-Sm1lKb", "could not send message")
+	}
+	if len(resp.Messages) == 0 {
+		return zerrors.ThrowInternal(nil, "VONAGE-Sm1lKc", "could not send message")
+	}
+	// a message longer than one SMS is split into multiple parts, the first identifies it
+	first := resp.Messages[0]
+	if first.Status != "0" {
+		logging.WithFields("status", first.Status, "error", first.ErrorText).Warn("vonage send sms error")
+		return zerrors.ThrowInternalf(nil, "VONAGE-Sm1lKd", "could not send message: %s", first.ErrorText)
+	}
+	logging.WithFields("message_id", first.MessageID).Debug("sms sent")
+
+	msg.MessageID = &first.MessageID
+	return nil
+}
+
+// https://developer.vonage.com/en/api/verify#verifyRequest
+func startVerification(config Config, msg *messages.SMS) error {
+	resp := new(verifyResponse)
+	err := config.post(config.verifyEndpoint()+"/verify/json", url.Values{
+		"number":          {normalizeNumber(msg.RecipientPhoneNumber)},
+		"brand":           {config.VerifyBrand},
+		"workflow_id":     {"6"}, // SMS only
+		"pin_expiry":      {"300"},
+		"next_event_wait": {"300"},
+	}, resp)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "VONAGE-Vf2mLa", "could not send verification")
+	}
+	switch resp.Status {
+	case "0":
+	case "10":
+		// Concurrent verifications to the same number are not allowed.
+		// Retries would fail until the running verification expires,
+		// instead let the user initiate the verification again (e.g. using "resend code")
+		logging.WithFields("error", resp.ErrorText, "status", resp.Status).Warn("vonage create verification error")
+		return channels.NewCancelError(zerrors.ThrowPreconditionFailed(nil, "VONAGE-Vf2mLb", resp.ErrorText))
+	default:
+		return zerrors.ThrowInternalf(nil, "VONAGE-Vf2mLc", "could not send verification: %s", resp.ErrorText)
+	}
+	logging.WithFields("request_id", resp.RequestID).Debug("verification sent")
+
+	msg.VerificationID = &resp.RequestID
+	return nil
+}
+
+func decodeResponse(resp *http.Response, response any) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return zerrors.ThrowInternalf(nil, "VONAGE-Rs3nMa", "unexpected status code %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package vonage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// fakeVonage records the requests and answers with the configured responses by path
+func fakeVonage(t *testing.T, responses map[string]any) (*httptest.Server, map[string]url.Values) {
+	requests := make(map[string]url.Values)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		requests[r.URL.Path] = r.PostForm
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestInitChannel_sendMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		response      any
+		wantType      string
+		wantMessageID *string
+		wantErr       bool
+	}{
+		{
+			name:    "rejected",
+			content: "code 123456",
+			response: map[string]any{
+				"messages": []map[string]string{{"status": "4", "error-text": "Bad Credentials"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "sent",
+			content: "code 123456",
+			response: map[string]any{
+				"messages": []map[string]string{{"status": "0", "message-id": "msg1"}},
+			},
+			wantMessageID: gu.Ptr("msg1"),
+		},
+		{
+			name:    "unicode",
+			content: "Ihr Code: 123456 ✓",
+			response: map[string]any{
+				"messages": []map[string]string{{"status": "0", "message-id": "msg2"}},
+			},
+			wantType:      "unicode",
+			wantMessageID: gu.Ptr("msg2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := fakeVonage(t, map[string]any{"/sms/json": tt.response})
+			channel := InitChannel(Config{
+				APIKey:       "key",
+				APISecret:    "secret",
+				SenderNumber: "+41000000000",
+				Endpoint:     server.URL,
+			})
+			msg := &messages.SMS{
+				SenderPhoneNumber:    "+41000000000",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              tt.content,
+			}
+			err := channel.HandleMessage(msg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMessageID, msg.MessageID)
+			req := requests["/sms/json"]
+			assert.Equal(t, "key", req.Get("api_key"))
+			assert.Equal(t, "secret", req.Get("api_secret"))
+			assert.Equal(t, "41000000000", req.Get("from"))
+			assert.Equal(t, "41791234567", req.Get("to"))
+			assert.Equal(t, tt.content, req.Get("text"))
+			assert.Equal(t, tt.wantType, req.Get("type"))
+		})
+	}
+}
+
+func TestInitChannel_startVerification(t *testing.T) {
+	tests := []struct {
+		name               string
+		response           any
+		wantVerificationID *string
+		wantCancel         bool
+	}{
+		{
+			name:       "concurrent verification",
+			response:   map[string]string{"status": "10", "error_text": "Concurrent verifications to the same number are not allowed"},
+			wantCancel: true,
+		},
+		{
+			name:               "started",
+			response:           map[string]string{"status": "0", "request_id": "req1"},
+			wantVerificationID: gu.Ptr("req1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := fakeVonage(t, map[string]any{"/verify/json": tt.response})
+			channel := InitChannel(Config{
+				APIKey:       "key",
+				APISecret:    "secret",
+				SenderNumber: "+41000000000",
+				VerifyBrand:  "ZITADEL",
+				Endpoint:     server.URL,
+			})
+			msg := &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+			}
+			err := channel.HandleMessage(msg)
+			if tt.wantCancel {
+				require.ErrorIs(t, err, new(channels.CancelError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVerificationID, msg.VerificationID)
+			assert.Equal(t, "41791234567", requests["/verify/json"].Get("number"))
+			assert.Equal(t, "ZITADEL", requests["/verify/json"].Get("brand"))
+		})
+	}
+}
+
+func TestConfig_VerifyCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		response any
+		wantErr  error
+	}{
+		{
+			name:     "approved",
+			response: map[string]string{"status": "0", "request_id": "req1"},
+		},
+		{
+			name:     "wrong code",
+			response: map[string]string{"status": "16", "request_id": "req1"},
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "VONAGE-Cx2mNd", "Errors.User.Code.Invalid"),
+		},
+		{
+			name:     "expired",
+			response: map[string]string{"status": "6", "request_id": "req1"},
+			wantErr:  zerrors.ThrowInvalidArgument(nil, "VONAGE-Cx2mNb", "Errors.User.Code.Expired"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := fakeVonage(t, map[string]any{"/verify/check/json": tt.response})
+			config := &Config{
+				APIKey:    "key",
+				APISecret: "secret",
+				Endpoint:  server.URL,
+			}
+			err := config.VerifyCode("req1", "123456")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, "req1", requests["/verify/check/json"].Get("request_id"))
+			assert.Equal(t, "123456", requests["/verify/check/json"].Get("code"))
+		})
+	}
+}
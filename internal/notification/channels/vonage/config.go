@@ -0,0 +1,89 @@
+package vonage
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	defaultSMSEndpoint    = "https://rest.nexmo.com"
+	defaultVerifyEndpoint = "https://api.nexmo.com"
+
+	requestTimeout = 30 * time.Second
+)
+
+type Config struct {
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+	// VerifyBrand enables the Vonage Verify API, which generates and checks the codes.
+	// The brand is included in the messages sent by Vonage.
+	VerifyBrand string
+
+	// Endpoint overrides the URL of the Vonage APIs, it's only used for testing
+	Endpoint string
+}
+
+func (c *Config) IsValid() bool {
+	return c.APIKey != "" && c.APISecret != "" && c.SenderNumber != ""
+}
+
+func (c *Config) smsEndpoint() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	return defaultSMSEndpoint
+}
+
+func (c *Config) verifyEndpoint() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	return defaultVerifyEndpoint
+}
+
+// VerifyCode checks the code of a verification requested by the channel
+// https://developer.vonage.com/en/api/verify#verifyCheck
+func (c *Config) VerifyCode(verificationID, code string) error {
+	resp := new(verifyResponse)
+	err := c.post(c.verifyEndpoint()+"/verify/check/json", url.Values{
+		"request_id": {verificationID},
+		"code":       {code},
+	}, resp)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "VONAGE-Cx2mNa", "Errors.User.Code.NotFound")
+	}
+	switch resp.Status {
+	case "0":
+		return nil
+	case "6":
+		// the request was not found or is no longer active
+		return zerrors.ThrowInvalidArgument(nil, "VONAGE-Cx2mNb", "Errors.User.Code.Expired")
+	case "17":
+		// the wrong code was provided too many times
+		return zerrors.ThrowInvalidArgument(nil, "VONAGE-Cx2mNc", "Errors.User.Code.NotFound")
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "VONAGE-Cx2mNd", "Errors.User.Code.Invalid")
+	}
+}
+
+func (c *Config) post(endpoint string, values url.Values, response any) error {
+	values.Set("api_key", c.APIKey)
+	values.Set("api_secret", c.APISecret)
+	client := &http.Client{Timeout: requestTimeout}
+	resp, err := client.Post(endpoint, "application/x-www-form-urlencoded", strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeResponse(resp, response)
+}
+
+// normalizeNumber removes the leading plus, as Vonage expects the numbers in E.164 format without it.
+func normalizeNumber(number string) string {
+	return strings.TrimPrefix(strings.TrimSpace(number), "+")
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type AccessExpiryConfig struct {
+	CheckEvery time.Duration
+	BulkLimit  uint16
+	// NotifyBefore is the period before the end of the validity of a user grant, in which the grantee and the managers get notified.
+	// No notifications are sent if 0.
+	NotifyBefore time.Duration
+}
+
+// AccessExpiryScheduler periodically searches the user grants and memberships whose validity ended
+// and requests their expiry, so that the removal is reflected by the events.
+// It also completes the access reviews whose deadline passed and requests the notifications about user grants, whose validity ends soon.
+// The notifications themselves are sent by the [userNotifier].
+type AccessExpiryScheduler struct {
+	commands Commands
+	queries  *NotificationQueries
+	config   WorkerConfig
+	now      nowFunc
+}
+
+func NewAccessExpiryScheduler(
+	config WorkerConfig,
+	commands Commands,
+	queries *NotificationQueries,
+) *AccessExpiryScheduler {
+	if config.AccessExpiry.BulkLimit == 0 {
+		config.AccessExpiry.BulkLimit = 100
+	}
+	return &AccessExpiryScheduler{
+		commands: commands,
+		queries:  queries,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func (s *AccessExpiryScheduler) Start(ctx context.Context) {
+	if s.config.LegacyEnabled || s.config.AccessExpiry.CheckEvery <= 0 {
+		return
+	}
+	go s.schedule(ctx)
+}
+
+func (s *AccessExpiryScheduler) schedule(ctx context.Context) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			logging.Info("access expiry scheduler stopped")
+			return
+		case <-t.C:
+			for _, instance := range s.queries.ActiveInstances() {
+				err := s.trigger(authz.WithInstanceID(call.WithTimestamp(ctx), instance))
+				logging.WithFields("instance", instance).OnError(err).Info("access expiry check failed")
+			}
+			t.Reset(s.config.AccessExpiry.CheckEvery)
+		}
+	}
+}
+
+func (s *AccessExpiryScheduler) trigger(ctx context.Context) error {
+	now := s.now()
+	for _, accessType := range []query.ExpiringAccessType{
+		query.ExpiringAccessTypeUserGrant,
+		query.ExpiringAccessTypeOrgMember,
+		query.ExpiringAccessTypeProjectMember,
+	} {
+		err := s.search(ctx, &query.ExpiringAccessSearchQueries{Type: accessType, Before: now}, func(access *query.ExpiringAccess) error {
+			return s.expire(ctx, accessType, access)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.expireAccessReviews(ctx, now); err != nil {
+		return err
+	}
+	if s.config.AccessExpiry.NotifyBefore <= 0 {
+		return nil
+	}
+	return s.search(ctx,
+		&query.ExpiringAccessSearchQueries{
+			Type:   query.ExpiringAccessTypeUserGrant,
+			Before: now.Add(s.config.AccessExpiry.NotifyBefore),
+			After:  now,
+		},
+		func(access *query.ExpiringAccess) error {
+			// already notified user grants are ignored by the command
+			return s.commands.AddUserGrantExpiryNotification(ctx, access.ID, access.ResourceOwner)
+		},
+	)
+}
+
+// expire requests the expiry of the access, user grants and memberships which are still valid are ignored by the commands.
+func (s *AccessExpiryScheduler) expire(ctx context.Context, accessType query.ExpiringAccessType, access *query.ExpiringAccess) error {
+	switch accessType {
+	case query.ExpiringAccessTypeOrgMember:
+		return s.commands.ExpireOrgMember(ctx, access.ID, access.UserID)
+	case query.ExpiringAccessTypeProjectMember:
+		return s.commands.ExpireProjectMember(ctx, access.ID, access.UserID, access.ResourceOwner)
+	default:
+		return s.commands.ExpireUserGrant(ctx, access.ID, access.ResourceOwner)
+	}
+}
+
+// expireAccessReviews completes the access reviews whose deadline passed.
+// Only one bulk is handled per run, because the completed reviews are removed from the search result
+// as soon as the projection is updated, the remaining ones are handled by the next run.
+func (s *AccessExpiryScheduler) expireAccessReviews(ctx context.Context, now time.Time) error {
+	reviews, err := s.queries.SearchExpiredAccessReviews(ctx, now, uint64(s.config.AccessExpiry.BulkLimit))
+	if err != nil {
+		return err
+	}
+	for _, review := range reviews.AccessReviews {
+		if err = s.commands.ExpireAccessReview(ctx, review.ID, review.ResourceOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AccessExpiryScheduler) search(ctx context.Context, search *query.ExpiringAccessSearchQueries, handle func(*query.ExpiringAccess) error) error {
+	search.Limit = uint64(s.config.AccessExpiry.BulkLimit)
+	for {
+		accesses, err := s.queries.SearchExpiringAccesses(ctx, search)
+		if err != nil {
+			return err
+		}
+		for _, access := range accesses.ExpiringAccesses {
+			if err = handle(access); err != nil {
+				return err
+			}
+		}
+		search.Offset += uint64(len(accesses.ExpiringAccesses))
+		if len(accesses.ExpiringAccesses) < int(search.Limit) || search.Offset >= accesses.Count {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func TestAccessExpiryScheduler_trigger(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		notifyBefore time.Duration
+		accesses     map[query.ExpiringAccessType][]*query.ExpiringAccess
+		reviews      []*query.AccessReview
+		expect       func(commands *mock.MockCommands)
+	}{
+		{
+			name: "nothing expired",
+		},
+		{
+			name: "expired accesses",
+			accesses: map[query.ExpiringAccessType][]*query.ExpiringAccess{
+				query.ExpiringAccessTypeUserGrant:     {{ID: "grant1", UserID: "user1", ResourceOwner: "org1"}},
+				query.ExpiringAccessTypeOrgMember:     {{ID: "org1", UserID: "user2", ResourceOwner: "org1"}},
+				query.ExpiringAccessTypeProjectMember: {{ID: "project1", UserID: "user3", ResourceOwner: "org1"}},
+			},
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().ExpireUserGrant(gomock.Any(), "grant1", "org1").Return(nil)
+				commands.EXPECT().ExpireOrgMember(gomock.Any(), "org1", "user2").Return(nil)
+				commands.EXPECT().ExpireProjectMember(gomock.Any(), "project1", "user3", "org1").Return(nil)
+			},
+		},
+		{
+			name: "expired access reviews",
+			reviews: []*query.AccessReview{
+				{ID: "review1", ResourceOwner: "org1"},
+			},
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().ExpireAccessReview(gomock.Any(), "review1", "org1").Return(nil)
+			},
+		},
+		{
+			name:         "expiring user grants notified",
+			notifyBefore: 24 * time.Hour,
+			accesses: map[query.ExpiringAccessType][]*query.ExpiringAccess{
+				query.ExpiringAccessTypeUserGrant: {{ID: "grant1", UserID: "user1", ResourceOwner: "org1"}},
+			},
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().ExpireUserGrant(gomock.Any(), "grant1", "org1").Return(nil)
+				commands.EXPECT().AddUserGrantExpiryNotification(gomock.Any(), "grant1", "org1").Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			queries.EXPECT().SearchExpiringAccesses(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(_ context.Context, search *query.ExpiringAccessSearchQueries) (*query.ExpiringAccesses, error) {
+					accesses := tt.accesses[search.Type]
+					if !search.After.IsZero() {
+						assert.Equal(t, now, search.After)
+						assert.Equal(t, now.Add(tt.notifyBefore), search.Before)
+					} else {
+						assert.Equal(t, now, search.Before)
+					}
+					return &query.ExpiringAccesses{
+						SearchResponse:   query.SearchResponse{Count: uint64(len(accesses))},
+						ExpiringAccesses: accesses,
+					}, nil
+				},
+			)
+			queries.EXPECT().SearchExpiredAccessReviews(gomock.Any(), now, uint64(100)).Return(
+				&query.AccessReviews{
+					SearchResponse: query.SearchResponse{Count: uint64(len(tt.reviews))},
+					AccessReviews:  tt.reviews,
+				}, nil,
+			)
+			if tt.expect != nil {
+				tt.expect(commands)
+			}
+			scheduler := NewAccessExpiryScheduler(
+				WorkerConfig{AccessExpiry: AccessExpiryConfig{NotifyBefore: tt.notifyBefore}},
+				commands,
+				NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil),
+			)
+			scheduler.now = func() time.Time { return now }
+			assert.NoError(t, scheduler.trigger(context.Background()))
+		})
+	}
+}
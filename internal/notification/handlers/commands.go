@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
 	"github.com/zitadel/zitadel/internal/repository/quota"
@@ -14,7 +16,7 @@ type Commands interface {
 	RequestNotification(ctx context.Context, instanceID string, request *command.NotificationRequest) error
 	NotificationCanceled(ctx context.Context, tx *sql.Tx, id, resourceOwner string, err error) error
 	NotificationRetryRequested(ctx context.Context, tx *sql.Tx, id, resourceOwner string, request *command.NotificationRetryRequest, err error) error
-	NotificationSent(ctx context.Context, tx *sql.Tx, id, instanceID string) error
+	NotificationSent(ctx context.Context, tx *sql.Tx, id, instanceID string, delivery *command.NotificationDelivery) error
 	HumanInitCodeSent(ctx context.Context, orgID, userID string) error
 	HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string) error
 	PasswordCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
@@ -27,6 +29,27 @@ type Commands interface {
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	InviteCodeSent(ctx context.Context, orgID, userID string) error
+	AddHumanEmailChangeUndoCode(ctx context.Context, orgID, userID string, email domain.EmailAddress) error
+	HumanEmailChangeUndoCodeSent(ctx context.Context, orgID, userID string) error
+	SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string) error
+	RiskNotificationSent(ctx context.Context, sessionID, resourceOwner string) error
+	AddPasswordExpiryNotification(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold, passwordChanged, expirationDate time.Time) error
+	PasswordExpiryNotificationSent(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold) error
+	ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) error
+	ExpireOrgMember(ctx context.Context, orgID, userID string) error
+	ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) error
+	ExpireAccessReview(ctx context.Context, reviewID, resourceOwner string) error
+	AddUserGrantExpiryNotification(ctx context.Context, grantID, resourceOwner string) error
+	UserGrantExpiryNotificationSent(ctx context.Context, grantID, resourceOwner, recipientID string) error
+	AccessRequestNotificationSent(ctx context.Context, requestID, resourceOwner, recipientID string) error
+	AddInactivityNotification(ctx context.Context, orgID, userID string, action domain.InactivityAction, lastActivity, dueDate time.Time) error
+	InactivityNotificationSent(ctx context.Context, orgID, userID string, action domain.InactivityAction) error
+	DeactivateInactiveUser(ctx context.Context, orgID, userID string, lastActivity time.Time) error
+	RemoveInactiveUser(ctx context.Context, orgID, userID string, lastActivity time.Time, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) error
+	CompleteUserDataExport(ctx context.Context, orgID, userID, exportID string, data []byte) error
+	AddUserDeletionReminder(ctx context.Context, orgID, userID string, deletionDate time.Time) error
+	UserDeletionReminderSent(ctx context.Context, orgID, userID string) error
+	RemoveScheduledUser(ctx context.Context, orgID, userID string, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error
 }
@@ -9,12 +9,14 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetSMTPConfig reads the iam SMTP provider config
+// GetActiveEmailConfig reads the active email provider config of the organization
+// and falls back to the one of the instance
 func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.Config, error) {
-	config, err := n.SMTPConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+	config, err := n.activeSMTPConfig(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -57,3 +59,13 @@ func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.
 	}
 	return nil, zerrors.ThrowNotFound(err, "QUERY-KPQleOckOV", "Errors.SMTPConfig.NotFound")
 }
+
+func (n *NotificationQueries) activeSMTPConfig(ctx context.Context) (*query.SMTPConfig, error) {
+	if orgID := authz.GetCtxData(ctx).OrgID; orgID != "" {
+		config, err := n.SMTPConfigActive(ctx, orgID)
+		if !zerrors.IsNotFound(err) {
+			return config, err
+		}
+	}
+	return n.SMTPConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+}
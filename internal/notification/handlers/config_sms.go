@@ -5,16 +5,23 @@ import (
 	"net/http"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/delivery"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/smpp"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetActiveSMSConfig reads the active iam sms provider config
+// GetActiveSMSConfig reads the active sms provider config of the organization
+// and falls back to the one of the instance
 func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Config, error) {
-	config, err := n.SMSProviderConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+	config, err := n.activeSMSConfig(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +45,75 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 				Token:            token,
 				SenderNumber:     config.TwilioConfig.SenderNumber,
 				VerifyServiceSID: config.TwilioConfig.VerifyServiceSID,
+				// providers configured on a request without known origin do not report the delivery status
+				StatusCallbackURL: delivery.TwilioStatusCallbackURL(ctx, config.ID),
+			},
+		}, nil
+	}
+	if config.VonageConfig != nil {
+		apiSecret, err := crypto.DecryptString(config.VonageConfig.APISecret, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			VonageConfig: &vonage.Config{
+				APIKey:       config.VonageConfig.APIKey,
+				APISecret:    apiSecret,
+				SenderNumber: config.VonageConfig.SenderNumber,
+				VerifyBrand:  config.VonageConfig.VerifyBrand,
+			},
+		}, nil
+	}
+	if config.SNSConfig != nil {
+		secretAccessKey, err := crypto.DecryptString(config.SNSConfig.SecretAccessKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			SNSConfig: &sns.Config{
+				Region:          config.SNSConfig.Region,
+				AccessKeyID:     config.SNSConfig.AccessKeyID,
+				SecretAccessKey: secretAccessKey,
+				SenderID:        config.SNSConfig.SenderID,
+			},
+		}, nil
+	}
+	if config.MessageBirdConfig != nil {
+		accessKey, err := crypto.DecryptString(config.MessageBirdConfig.AccessKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			MessageBirdConfig: &messagebird.Config{
+				AccessKey:  accessKey,
+				Originator: config.MessageBirdConfig.Originator,
+				Verify:     config.MessageBirdConfig.Verify,
+			},
+		}, nil
+	}
+	if config.SMPPConfig != nil {
+		var password string
+		// the password is optional for SMSCs authenticating by the network
+		if config.SMPPConfig.Password != nil {
+			password, err = crypto.DecryptString(config.SMPPConfig.Password, n.SMSTokenCrypto)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			SMPPConfig: &smpp.Config{
+				Host:          config.SMPPConfig.Host,
+				TLS:           config.SMPPConfig.TLS,
+				TLSCA:         config.SMPPConfig.TLSCA,
+				TLSServerName: config.SMPPConfig.TLSServerName,
+				SystemID:      config.SMPPConfig.SystemID,
+				Password:      password,
+				SystemType:    config.SMPPConfig.SystemType,
+				SourceAddress: config.SMPPConfig.SourceAddress,
 			},
 		}, nil
 	}
@@ -54,3 +130,15 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 
 	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 }
+
+// activeSMSConfig returns the active provider of the organization,
+// the one of the instance is only used if the organization has none
+func (n *NotificationQueries) activeSMSConfig(ctx context.Context) (*query.SMSConfig, error) {
+	if orgID := authz.GetCtxData(ctx).OrgID; orgID != "" {
+		orgConfig, err := n.SMSProviderConfigActive(ctx, orgID)
+		if !zerrors.IsNotFound(err) {
+			return orgConfig, err
+		}
+	}
+	return n.SMSProviderConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+}
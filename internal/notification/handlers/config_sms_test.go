@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationQueries_activeSMSConfig(t *testing.T) {
+	instanceVerify := &query.SMSConfig{
+		ID:           "instance",
+		TwilioConfig: &query.Twilio{VerifyServiceSID: "verify"},
+	}
+	orgConfig := &query.SMSConfig{
+		ID:           "org",
+		TwilioConfig: &query.Twilio{SID: "sid"},
+	}
+	tests := []struct {
+		name    string
+		orgID   string
+		expect  func(queries *mock.MockQueries)
+		want    *query.SMSConfig
+		wantErr error
+	}{
+		{
+			name: "no organization, instance",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMSProviderConfigActive(gomock.Any(), "instance").Return(instanceVerify, nil)
+			},
+			want: instanceVerify,
+		},
+		{
+			name:  "organization without provider, instance",
+			orgID: "org",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMSProviderConfigActive(gomock.Any(), "org").Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Err", "Errors.SMSConfig.NotFound"))
+				queries.EXPECT().SMSProviderConfigActive(gomock.Any(), "instance").Return(instanceVerify, nil)
+			},
+			want: instanceVerify,
+		},
+		{
+			name:  "organization provider, wins over instance verification",
+			orgID: "org",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMSProviderConfigActive(gomock.Any(), "org").Return(orgConfig, nil)
+			},
+			want: orgConfig,
+		},
+		{
+			name:  "organization query fails, error",
+			orgID: "org",
+			expect: func(queries *mock.MockQueries) {
+				queries.EXPECT().SMSProviderConfigActive(gomock.Any(), "org").Return(nil, zerrors.ThrowInternal(nil, "QUERY-Err", "error"))
+			},
+			wantErr: zerrors.ThrowInternal(nil, "QUERY-Err", "error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			tt.expect(queries)
+			n := NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil)
+			got, err := n.activeSMSConfig(authz.NewMockContext("instance", tt.orgID, ""))
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type InactivityConfig struct {
+	CheckEvery time.Duration
+	BulkLimit  uint16
+}
+
+// InactivityScheduler periodically evaluates the inactivity policies against the last activity of the users.
+// It requests the warnings before an action is due and deactivates or deletes the users, for which the action is due.
+// The warnings themselves are sent by the [userNotifier].
+type InactivityScheduler struct {
+	commands Commands
+	queries  *NotificationQueries
+	config   WorkerConfig
+}
+
+func NewInactivityScheduler(
+	config WorkerConfig,
+	commands Commands,
+	queries *NotificationQueries,
+) *InactivityScheduler {
+	if config.Inactivity.BulkLimit == 0 {
+		config.Inactivity.BulkLimit = 100
+	}
+	return &InactivityScheduler{
+		commands: commands,
+		queries:  queries,
+		config:   config,
+	}
+}
+
+func (s *InactivityScheduler) Start(ctx context.Context) {
+	if s.config.LegacyEnabled || s.config.Inactivity.CheckEvery <= 0 {
+		return
+	}
+	go s.schedule(ctx)
+}
+
+func (s *InactivityScheduler) schedule(ctx context.Context) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			logging.Info("inactivity scheduler stopped")
+			return
+		case <-t.C:
+			for _, instance := range s.queries.ActiveInstances() {
+				err := s.trigger(authz.WithInstanceID(call.WithTimestamp(ctx), instance))
+				logging.WithFields("instance", instance).OnError(err).Info("inactivity check failed")
+			}
+			t.Reset(s.config.Inactivity.CheckEvery)
+		}
+	}
+}
+
+func (s *InactivityScheduler) trigger(ctx context.Context) error {
+	search := &query.InactiveUserSearchQueries{
+		SearchRequest: query.SearchRequest{Limit: uint64(s.config.Inactivity.BulkLimit)},
+	}
+	for {
+		users, err := s.queries.SearchInactiveUsers(ctx, search)
+		if err != nil {
+			return err
+		}
+		for _, user := range users.InactiveUsers {
+			if err = s.handle(ctx, user); err != nil {
+				return err
+			}
+		}
+		// the count contains all evaluated users, not only the ones with a due action
+		search.Offset += search.Limit
+		if search.Offset >= users.Count {
+			return nil
+		}
+	}
+}
+
+// handle requests the warning or executes the due action.
+// Users, which were active in the meantime or are already warned, are ignored by the commands.
+func (s *InactivityScheduler) handle(ctx context.Context, user *query.InactiveUser) error {
+	if user.Warning {
+		if user.Notified {
+			return nil
+		}
+		return s.commands.AddInactivityNotification(ctx, user.ResourceOwner, user.UserID, user.Action, user.LastActivity, user.DueDate)
+	}
+	switch user.Action {
+	case domain.InactivityActionDeactivate:
+		return s.commands.DeactivateInactiveUser(ctx, user.ResourceOwner, user.UserID, user.LastActivity)
+	case domain.InactivityActionDelete:
+		memberships, grantIDs, err := userDependencies(ctx, s.queries, user.UserID)
+		if err != nil {
+			return err
+		}
+		return s.commands.RemoveInactiveUser(ctx, user.ResourceOwner, user.UserID, user.LastActivity, memberships, grantIDs...)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func TestInactivityScheduler_trigger(t *testing.T) {
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		users  []*query.InactiveUser
+		expect func(queries *mock.MockQueries, commands *mock.MockCommands)
+	}{
+		{
+			name: "no inactive users",
+		},
+		{
+			name: "warning requested",
+			users: []*query.InactiveUser{
+				{UserID: "user1", ResourceOwner: "org1", LastActivity: lastActivity, Action: domain.InactivityActionDeactivate, DueDate: dueDate, Warning: true},
+			},
+			expect: func(_ *mock.MockQueries, commands *mock.MockCommands) {
+				commands.EXPECT().AddInactivityNotification(gomock.Any(), "org1", "user1", domain.InactivityActionDeactivate, lastActivity, dueDate).Return(nil)
+			},
+		},
+		{
+			name: "already warned",
+			users: []*query.InactiveUser{
+				{UserID: "user1", ResourceOwner: "org1", LastActivity: lastActivity, Action: domain.InactivityActionDeactivate, DueDate: dueDate, Warning: true, Notified: true},
+			},
+		},
+		{
+			name: "user deactivated",
+			users: []*query.InactiveUser{
+				{UserID: "user1", ResourceOwner: "org1", LastActivity: lastActivity, Action: domain.InactivityActionDeactivate, DueDate: dueDate},
+			},
+			expect: func(_ *mock.MockQueries, commands *mock.MockCommands) {
+				commands.EXPECT().DeactivateInactiveUser(gomock.Any(), "org1", "user1", lastActivity).Return(nil)
+			},
+		},
+		{
+			name: "user deleted",
+			users: []*query.InactiveUser{
+				{UserID: "user1", ResourceOwner: "org1", LastActivity: lastActivity, Action: domain.InactivityActionDelete, DueDate: dueDate},
+			},
+			expect: func(queries *mock.MockQueries, commands *mock.MockCommands) {
+				queries.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{{ID: "grant1"}},
+				}, nil)
+				queries.EXPECT().Memberships(gomock.Any(), gomock.Any(), false).Return(&query.Memberships{
+					Memberships: []*query.Membership{{UserID: "user1", ResourceOwner: "org1", Org: &query.OrgMembership{OrgID: "org1"}}},
+				}, nil)
+				commands.EXPECT().RemoveInactiveUser(gomock.Any(), "org1", "user1", lastActivity,
+					[]*command.CascadingMembership{{UserID: "user1", ResourceOwner: "org1", Org: &command.CascadingOrgMembership{OrgID: "org1"}}},
+					"grant1",
+				).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			queries.EXPECT().SearchInactiveUsers(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, search *query.InactiveUserSearchQueries) (*query.InactiveUsers, error) {
+					assert.Equal(t, uint64(100), search.Limit)
+					assert.Equal(t, uint64(0), search.Offset)
+					return &query.InactiveUsers{
+						SearchResponse: query.SearchResponse{Count: uint64(len(tt.users))},
+						InactiveUsers:  tt.users,
+					}, nil
+				},
+			)
+			if tt.expect != nil {
+				tt.expect(queries, commands)
+			}
+			scheduler := NewInactivityScheduler(
+				WorkerConfig{},
+				commands,
+				NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil),
+			)
+			assert.NoError(t, scheduler.trigger(context.Background()))
+		})
+	}
+}
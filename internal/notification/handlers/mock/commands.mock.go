@@ -13,8 +13,10 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	command "github.com/zitadel/zitadel/internal/command"
+	domain "github.com/zitadel/zitadel/internal/domain"
 	senders "github.com/zitadel/zitadel/internal/notification/senders"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
@@ -45,6 +47,188 @@ func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
 	return m.recorder
 }
 
+// AccessRequestNotificationSent mocks base method.
+func (m *MockCommands) AccessRequestNotificationSent(ctx context.Context, requestID, resourceOwner, recipientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessRequestNotificationSent", ctx, requestID, resourceOwner, recipientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AccessRequestNotificationSent indicates an expected call of AccessRequestNotificationSent.
+func (mr *MockCommandsMockRecorder) AccessRequestNotificationSent(ctx, requestID, resourceOwner, recipientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessRequestNotificationSent", reflect.TypeOf((*MockCommands)(nil).AccessRequestNotificationSent), ctx, requestID, resourceOwner, recipientID)
+}
+
+// AddHumanEmailChangeUndoCode mocks base method.
+func (m *MockCommands) AddHumanEmailChangeUndoCode(ctx context.Context, orgID, userID string, email domain.EmailAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHumanEmailChangeUndoCode", ctx, orgID, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHumanEmailChangeUndoCode indicates an expected call of AddHumanEmailChangeUndoCode.
+func (mr *MockCommandsMockRecorder) AddHumanEmailChangeUndoCode(ctx, orgID, userID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHumanEmailChangeUndoCode", reflect.TypeOf((*MockCommands)(nil).AddHumanEmailChangeUndoCode), ctx, orgID, userID, email)
+}
+
+// AddInactivityNotification mocks base method.
+func (m *MockCommands) AddInactivityNotification(ctx context.Context, orgID string, userID string, action domain.InactivityAction, lastActivity time.Time, dueDate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInactivityNotification", ctx, orgID, userID, action, lastActivity, dueDate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddInactivityNotification indicates an expected call of AddInactivityNotification.
+func (mr *MockCommandsMockRecorder) AddInactivityNotification(ctx, orgID, userID, action, lastActivity, dueDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInactivityNotification", reflect.TypeOf((*MockCommands)(nil).AddInactivityNotification), ctx, orgID, userID, action, lastActivity, dueDate)
+}
+
+// AddPasswordExpiryNotification mocks base method.
+func (m *MockCommands) AddPasswordExpiryNotification(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold, passwordChanged, expirationDate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPasswordExpiryNotification", ctx, orgID, userID, threshold, passwordChanged, expirationDate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPasswordExpiryNotification indicates an expected call of AddPasswordExpiryNotification.
+func (mr *MockCommandsMockRecorder) AddPasswordExpiryNotification(ctx, orgID, userID, threshold, passwordChanged, expirationDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPasswordExpiryNotification", reflect.TypeOf((*MockCommands)(nil).AddPasswordExpiryNotification), ctx, orgID, userID, threshold, passwordChanged, expirationDate)
+}
+
+// AddUserDeletionReminder mocks base method.
+func (m *MockCommands) AddUserDeletionReminder(ctx context.Context, orgID, userID string, deletionDate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserDeletionReminder", ctx, orgID, userID, deletionDate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserDeletionReminder indicates an expected call of AddUserDeletionReminder.
+func (mr *MockCommandsMockRecorder) AddUserDeletionReminder(ctx, orgID, userID, deletionDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserDeletionReminder", reflect.TypeOf((*MockCommands)(nil).AddUserDeletionReminder), ctx, orgID, userID, deletionDate)
+}
+
+// AddUserGrantExpiryNotification mocks base method.
+func (m *MockCommands) AddUserGrantExpiryNotification(ctx context.Context, grantID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserGrantExpiryNotification", ctx, grantID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserGrantExpiryNotification indicates an expected call of AddUserGrantExpiryNotification.
+func (mr *MockCommandsMockRecorder) AddUserGrantExpiryNotification(ctx, grantID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserGrantExpiryNotification", reflect.TypeOf((*MockCommands)(nil).AddUserGrantExpiryNotification), ctx, grantID, resourceOwner)
+}
+
+// CompleteUserDataExport mocks base method.
+func (m *MockCommands) CompleteUserDataExport(ctx context.Context, orgID, userID, exportID string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUserDataExport", ctx, orgID, userID, exportID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteUserDataExport indicates an expected call of CompleteUserDataExport.
+func (mr *MockCommandsMockRecorder) CompleteUserDataExport(ctx, orgID, userID, exportID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUserDataExport", reflect.TypeOf((*MockCommands)(nil).CompleteUserDataExport), ctx, orgID, userID, exportID, data)
+}
+
+// DeactivateInactiveUser mocks base method.
+func (m *MockCommands) DeactivateInactiveUser(ctx context.Context, orgID string, userID string, lastActivity time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateInactiveUser", ctx, orgID, userID, lastActivity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateInactiveUser indicates an expected call of DeactivateInactiveUser.
+func (mr *MockCommandsMockRecorder) DeactivateInactiveUser(ctx, orgID, userID, lastActivity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateInactiveUser", reflect.TypeOf((*MockCommands)(nil).DeactivateInactiveUser), ctx, orgID, userID, lastActivity)
+}
+
+// ExpireAccessReview mocks base method.
+func (m *MockCommands) ExpireAccessReview(ctx context.Context, reviewID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAccessReview", ctx, reviewID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireAccessReview indicates an expected call of ExpireAccessReview.
+func (mr *MockCommandsMockRecorder) ExpireAccessReview(ctx, reviewID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAccessReview", reflect.TypeOf((*MockCommands)(nil).ExpireAccessReview), ctx, reviewID, resourceOwner)
+}
+
+// ExpireOrgMember mocks base method.
+func (m *MockCommands) ExpireOrgMember(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOrgMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOrgMember indicates an expected call of ExpireOrgMember.
+func (mr *MockCommandsMockRecorder) ExpireOrgMember(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOrgMember", reflect.TypeOf((*MockCommands)(nil).ExpireOrgMember), ctx, orgID, userID)
+}
+
+// ExpireProjectMember mocks base method.
+func (m *MockCommands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireProjectMember", ctx, projectID, userID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireProjectMember indicates an expected call of ExpireProjectMember.
+func (mr *MockCommandsMockRecorder) ExpireProjectMember(ctx, projectID, userID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireProjectMember", reflect.TypeOf((*MockCommands)(nil).ExpireProjectMember), ctx, projectID, userID, resourceOwner)
+}
+
+// ExpireUserGrant mocks base method.
+func (m *MockCommands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireUserGrant", ctx, grantID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireUserGrant indicates an expected call of ExpireUserGrant.
+func (mr *MockCommandsMockRecorder) ExpireUserGrant(ctx, grantID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireUserGrant", reflect.TypeOf((*MockCommands)(nil).ExpireUserGrant), ctx, grantID, resourceOwner)
+}
+
+// HumanEmailChangeUndoCodeSent mocks base method.
+func (m *MockCommands) HumanEmailChangeUndoCodeSent(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HumanEmailChangeUndoCodeSent", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HumanEmailChangeUndoCodeSent indicates an expected call of HumanEmailChangeUndoCodeSent.
+func (mr *MockCommandsMockRecorder) HumanEmailChangeUndoCodeSent(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanEmailChangeUndoCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanEmailChangeUndoCodeSent), ctx, orgID, userID)
+}
+
 // HumanEmailVerificationCodeSent mocks base method.
 func (m *MockCommands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string) error {
 	m.ctrl.T.Helper()
@@ -129,6 +313,20 @@ func (mr *MockCommandsMockRecorder) HumanPhoneVerificationCodeSent(ctx, orgID, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanPhoneVerificationCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanPhoneVerificationCodeSent), ctx, orgID, userID, generatorInfo)
 }
 
+// InactivityNotificationSent mocks base method.
+func (m *MockCommands) InactivityNotificationSent(ctx context.Context, orgID string, userID string, action domain.InactivityAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InactivityNotificationSent", ctx, orgID, userID, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InactivityNotificationSent indicates an expected call of InactivityNotificationSent.
+func (mr *MockCommandsMockRecorder) InactivityNotificationSent(ctx, orgID, userID, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InactivityNotificationSent", reflect.TypeOf((*MockCommands)(nil).InactivityNotificationSent), ctx, orgID, userID, action)
+}
+
 // InviteCodeSent mocks base method.
 func (m *MockCommands) InviteCodeSent(ctx context.Context, orgID, userID string) error {
 	m.ctrl.T.Helper()
@@ -186,17 +384,17 @@ func (mr *MockCommandsMockRecorder) NotificationRetryRequested(ctx, tx, id, reso
 }
 
 // NotificationSent mocks base method.
-func (m *MockCommands) NotificationSent(ctx context.Context, tx *sql.Tx, id, instanceID string) error {
+func (m *MockCommands) NotificationSent(ctx context.Context, tx *sql.Tx, id, instanceID string, delivery *command.NotificationDelivery) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NotificationSent", ctx, tx, id, instanceID)
+	ret := m.ctrl.Call(m, "NotificationSent", ctx, tx, id, instanceID, delivery)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // NotificationSent indicates an expected call of NotificationSent.
-func (mr *MockCommandsMockRecorder) NotificationSent(ctx, tx, id, instanceID any) *gomock.Call {
+func (mr *MockCommandsMockRecorder) NotificationSent(ctx, tx, id, instanceID, delivery any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSent", reflect.TypeOf((*MockCommands)(nil).NotificationSent), ctx, tx, id, instanceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationSent", reflect.TypeOf((*MockCommands)(nil).NotificationSent), ctx, tx, id, instanceID, delivery)
 }
 
 // OTPEmailSent mocks base method.
@@ -255,6 +453,58 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(ctx, orgID, userID, generat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), ctx, orgID, userID, generatorInfo)
 }
 
+// PasswordExpiryNotificationSent mocks base method.
+func (m *MockCommands) PasswordExpiryNotificationSent(ctx context.Context, orgID, userID string, threshold domain.PasswordExpiryThreshold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PasswordExpiryNotificationSent", ctx, orgID, userID, threshold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PasswordExpiryNotificationSent indicates an expected call of PasswordExpiryNotificationSent.
+func (mr *MockCommandsMockRecorder) PasswordExpiryNotificationSent(ctx, orgID, userID, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordExpiryNotificationSent", reflect.TypeOf((*MockCommands)(nil).PasswordExpiryNotificationSent), ctx, orgID, userID, threshold)
+}
+
+// RemoveInactiveUser mocks base method.
+func (m *MockCommands) RemoveInactiveUser(ctx context.Context, orgID string, userID string, lastActivity time.Time, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, orgID, userID, lastActivity, cascadingUserMemberships}
+	for _, a := range cascadingGrantIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveInactiveUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveInactiveUser indicates an expected call of RemoveInactiveUser.
+func (mr *MockCommandsMockRecorder) RemoveInactiveUser(ctx, orgID, userID, lastActivity, cascadingUserMemberships any, cascadingGrantIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, orgID, userID, lastActivity, cascadingUserMemberships}, cascadingGrantIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInactiveUser", reflect.TypeOf((*MockCommands)(nil).RemoveInactiveUser), varargs...)
+}
+
+// RemoveScheduledUser mocks base method.
+func (m *MockCommands) RemoveScheduledUser(ctx context.Context, orgID, userID string, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, orgID, userID, cascadingUserMemberships}
+	for _, a := range cascadingGrantIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveScheduledUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveScheduledUser indicates an expected call of RemoveScheduledUser.
+func (mr *MockCommandsMockRecorder) RemoveScheduledUser(ctx, orgID, userID, cascadingUserMemberships any, cascadingGrantIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, orgID, userID, cascadingUserMemberships}, cascadingGrantIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveScheduledUser", reflect.TypeOf((*MockCommands)(nil).RemoveScheduledUser), varargs...)
+}
+
 // RequestNotification mocks base method.
 func (m *MockCommands) RequestNotification(ctx context.Context, instanceID string, request *command.NotificationRequest) error {
 	m.ctrl.T.Helper()
@@ -269,6 +519,34 @@ func (mr *MockCommandsMockRecorder) RequestNotification(ctx, instanceID, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestNotification", reflect.TypeOf((*MockCommands)(nil).RequestNotification), ctx, instanceID, request)
 }
 
+// RiskNotificationSent mocks base method.
+func (m *MockCommands) RiskNotificationSent(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RiskNotificationSent", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RiskNotificationSent indicates an expected call of RiskNotificationSent.
+func (mr *MockCommandsMockRecorder) RiskNotificationSent(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RiskNotificationSent", reflect.TypeOf((*MockCommands)(nil).RiskNotificationSent), ctx, sessionID, resourceOwner)
+}
+
+// SecurityNotificationSent mocks base method.
+func (m *MockCommands) SecurityNotificationSent(ctx context.Context, orgID, userID, messageType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityNotificationSent", ctx, orgID, userID, messageType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityNotificationSent indicates an expected call of SecurityNotificationSent.
+func (mr *MockCommandsMockRecorder) SecurityNotificationSent(ctx, orgID, userID, messageType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityNotificationSent", reflect.TypeOf((*MockCommands)(nil).SecurityNotificationSent), ctx, orgID, userID, messageType)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
@@ -283,6 +561,20 @@ func (mr *MockCommandsMockRecorder) UsageNotificationSent(ctx, dueEvent any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageNotificationSent", reflect.TypeOf((*MockCommands)(nil).UsageNotificationSent), ctx, dueEvent)
 }
 
+// UserDeletionReminderSent mocks base method.
+func (m *MockCommands) UserDeletionReminderSent(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDeletionReminderSent", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserDeletionReminderSent indicates an expected call of UserDeletionReminderSent.
+func (mr *MockCommandsMockRecorder) UserDeletionReminderSent(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDeletionReminderSent", reflect.TypeOf((*MockCommands)(nil).UserDeletionReminderSent), ctx, orgID, userID)
+}
+
 // UserDomainClaimedSent mocks base method.
 func (m *MockCommands) UserDomainClaimedSent(ctx context.Context, orgID, userID string) error {
 	m.ctrl.T.Helper()
@@ -296,3 +588,17 @@ func (mr *MockCommandsMockRecorder) UserDomainClaimedSent(ctx, orgID, userID any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDomainClaimedSent", reflect.TypeOf((*MockCommands)(nil).UserDomainClaimedSent), ctx, orgID, userID)
 }
+
+// UserGrantExpiryNotificationSent mocks base method.
+func (m *MockCommands) UserGrantExpiryNotificationSent(ctx context.Context, grantID, resourceOwner, recipientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGrantExpiryNotificationSent", ctx, grantID, resourceOwner, recipientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UserGrantExpiryNotificationSent indicates an expected call of UserGrantExpiryNotificationSent.
+func (mr *MockCommandsMockRecorder) UserGrantExpiryNotificationSent(ctx, grantID, resourceOwner, recipientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGrantExpiryNotificationSent", reflect.TypeOf((*MockCommands)(nil).UserGrantExpiryNotificationSent), ctx, grantID, resourceOwner, recipientID)
+}
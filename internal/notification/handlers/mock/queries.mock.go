@@ -105,6 +105,21 @@ func (mr *MockQueriesMockRecorder) CustomTextListByTemplate(ctx, aggregateID, te
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomTextListByTemplate", reflect.TypeOf((*MockQueries)(nil).CustomTextListByTemplate), ctx, aggregateID, template, withOwnerRemoved)
 }
 
+// DefaultNotificationPolicy mocks base method.
+func (m *MockQueries) DefaultNotificationPolicy(ctx context.Context, shouldTriggerBulk bool) (*query.NotificationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultNotificationPolicy", ctx, shouldTriggerBulk)
+	ret0, _ := ret[0].(*query.NotificationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DefaultNotificationPolicy indicates an expected call of DefaultNotificationPolicy.
+func (mr *MockQueriesMockRecorder) DefaultNotificationPolicy(ctx, shouldTriggerBulk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultNotificationPolicy", reflect.TypeOf((*MockQueries)(nil).DefaultNotificationPolicy), ctx, shouldTriggerBulk)
+}
+
 // GetActiveSigningWebKey mocks base method.
 func (m *MockQueries) GetActiveSigningWebKey(ctx context.Context) (*jose.JSONWebKey, error) {
 	m.ctrl.T.Helper()
@@ -179,19 +194,34 @@ func (mr *MockQueriesMockRecorder) InstanceByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceByID", reflect.TypeOf((*MockQueries)(nil).InstanceByID), ctx, id)
 }
 
-// MailTemplateByOrg mocks base method.
-func (m *MockQueries) MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error) {
+// Memberships mocks base method.
+func (m *MockQueries) Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MailTemplateByOrg", ctx, orgID, withOwnerRemoved)
+	ret := m.ctrl.Call(m, "Memberships", ctx, queries, shouldTrigger)
+	ret0, _ := ret[0].(*query.Memberships)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Memberships indicates an expected call of Memberships.
+func (mr *MockQueriesMockRecorder) Memberships(ctx, queries, shouldTrigger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Memberships", reflect.TypeOf((*MockQueries)(nil).Memberships), ctx, queries, shouldTrigger)
+}
+
+// MessageMailTemplateByOrg mocks base method.
+func (m *MockQueries) MessageMailTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MailTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MessageMailTemplateByOrg", ctx, orgID, messageType, lang)
 	ret0, _ := ret[0].(*query.MailTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MailTemplateByOrg indicates an expected call of MailTemplateByOrg.
-func (mr *MockQueriesMockRecorder) MailTemplateByOrg(ctx, orgID, withOwnerRemoved any) *gomock.Call {
+// MessageMailTemplateByOrg indicates an expected call of MessageMailTemplateByOrg.
+func (mr *MockQueriesMockRecorder) MessageMailTemplateByOrg(ctx, orgID, messageType, lang any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MailTemplateByOrg), ctx, orgID, withOwnerRemoved)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MessageMailTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MessageMailTemplateByOrg), ctx, orgID, messageType, lang)
 }
 
 // NotificationPolicyByOrg mocks base method.
@@ -224,6 +254,51 @@ func (mr *MockQueriesMockRecorder) NotificationProviderByIDAndType(ctx, aggID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationProviderByIDAndType", reflect.TypeOf((*MockQueries)(nil).NotificationProviderByIDAndType), ctx, aggID, providerType)
 }
 
+// OrgMembers mocks base method.
+func (m *MockQueries) OrgMembers(ctx context.Context, queries *query.OrgMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMembers", ctx, queries)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMembers indicates an expected call of OrgMembers.
+func (mr *MockQueriesMockRecorder) OrgMembers(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMembers", reflect.TypeOf((*MockQueries)(nil).OrgMembers), ctx, queries)
+}
+
+// ProjectByID mocks base method.
+func (m *MockQueries) ProjectByID(ctx context.Context, shouldTriggerBulk bool, id string) (*query.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectByID", ctx, shouldTriggerBulk, id)
+	ret0, _ := ret[0].(*query.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectByID indicates an expected call of ProjectByID.
+func (mr *MockQueriesMockRecorder) ProjectByID(ctx, shouldTriggerBulk, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectByID", reflect.TypeOf((*MockQueries)(nil).ProjectByID), ctx, shouldTriggerBulk, id)
+}
+
+// ProjectMembers mocks base method.
+func (m *MockQueries) ProjectMembers(ctx context.Context, queries *query.ProjectMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectMembers", ctx, queries)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectMembers indicates an expected call of ProjectMembers.
+func (mr *MockQueriesMockRecorder) ProjectMembers(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectMembers", reflect.TypeOf((*MockQueries)(nil).ProjectMembers), ctx, queries)
+}
+
 // SMSProviderConfigActive mocks base method.
 func (m *MockQueries) SMSProviderConfigActive(ctx context.Context, resourceOwner string) (*query.SMSConfig, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +329,66 @@ func (mr *MockQueriesMockRecorder) SMTPConfigActive(ctx, resourceOwner any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMTPConfigActive", reflect.TypeOf((*MockQueries)(nil).SMTPConfigActive), ctx, resourceOwner)
 }
 
+// SearchExpiredAccessReviews mocks base method.
+func (m *MockQueries) SearchExpiredAccessReviews(ctx context.Context, before time.Time, limit uint64) (*query.AccessReviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiredAccessReviews", ctx, before, limit)
+	ret0, _ := ret[0].(*query.AccessReviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiredAccessReviews indicates an expected call of SearchExpiredAccessReviews.
+func (mr *MockQueriesMockRecorder) SearchExpiredAccessReviews(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiredAccessReviews", reflect.TypeOf((*MockQueries)(nil).SearchExpiredAccessReviews), ctx, before, limit)
+}
+
+// SearchExpiringAccesses mocks base method.
+func (m *MockQueries) SearchExpiringAccesses(ctx context.Context, queries *query.ExpiringAccessSearchQueries) (*query.ExpiringAccesses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringAccesses", ctx, queries)
+	ret0, _ := ret[0].(*query.ExpiringAccesses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringAccesses indicates an expected call of SearchExpiringAccesses.
+func (mr *MockQueriesMockRecorder) SearchExpiringAccesses(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringAccesses", reflect.TypeOf((*MockQueries)(nil).SearchExpiringAccesses), ctx, queries)
+}
+
+// SearchExpiringPasswords mocks base method.
+func (m *MockQueries) SearchExpiringPasswords(ctx context.Context, queries *query.ExpiringPasswordSearchQueries) (*query.ExpiringPasswords, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchExpiringPasswords", ctx, queries)
+	ret0, _ := ret[0].(*query.ExpiringPasswords)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchExpiringPasswords indicates an expected call of SearchExpiringPasswords.
+func (mr *MockQueriesMockRecorder) SearchExpiringPasswords(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExpiringPasswords", reflect.TypeOf((*MockQueries)(nil).SearchExpiringPasswords), ctx, queries)
+}
+
+// SearchInactiveUsers mocks base method.
+func (m *MockQueries) SearchInactiveUsers(ctx context.Context, queries *query.InactiveUserSearchQueries) (*query.InactiveUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchInactiveUsers", ctx, queries)
+	ret0, _ := ret[0].(*query.InactiveUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchInactiveUsers indicates an expected call of SearchInactiveUsers.
+func (mr *MockQueriesMockRecorder) SearchInactiveUsers(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchInactiveUsers", reflect.TypeOf((*MockQueries)(nil).SearchInactiveUsers), ctx, queries)
+}
+
 // SearchInstanceDomains mocks base method.
 func (m *MockQueries) SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error) {
 	m.ctrl.T.Helper()
@@ -284,6 +419,36 @@ func (mr *MockQueriesMockRecorder) SearchMilestones(ctx, instanceIDs, queries an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMilestones", reflect.TypeOf((*MockQueries)(nil).SearchMilestones), ctx, instanceIDs, queries)
 }
 
+// SearchScheduledUserDeletions mocks base method.
+func (m *MockQueries) SearchScheduledUserDeletions(ctx context.Context, queries *query.ScheduledUserDeletionSearchQueries) (*query.ScheduledUserDeletions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchScheduledUserDeletions", ctx, queries)
+	ret0, _ := ret[0].(*query.ScheduledUserDeletions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchScheduledUserDeletions indicates an expected call of SearchScheduledUserDeletions.
+func (mr *MockQueriesMockRecorder) SearchScheduledUserDeletions(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchScheduledUserDeletions", reflect.TypeOf((*MockQueries)(nil).SearchScheduledUserDeletions), ctx, queries)
+}
+
+// SearchUserDataExports mocks base method.
+func (m *MockQueries) SearchUserDataExports(ctx context.Context, queries *query.UserDataExportSearchQueries) (*query.UserDataExports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserDataExports", ctx, queries)
+	ret0, _ := ret[0].(*query.UserDataExports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserDataExports indicates an expected call of SearchUserDataExports.
+func (mr *MockQueriesMockRecorder) SearchUserDataExports(ctx, queries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserDataExports", reflect.TypeOf((*MockQueries)(nil).SearchUserDataExports), ctx, queries)
+}
+
 // SessionByID mocks base method.
 func (m *MockQueries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string) (*query.Session, error) {
 	m.ctrl.T.Helper()
@@ -298,3 +463,53 @@ func (mr *MockQueriesMockRecorder) SessionByID(ctx, shouldTriggerBulk, id, sessi
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionByID", reflect.TypeOf((*MockQueries)(nil).SessionByID), ctx, shouldTriggerBulk, id, sessionToken)
 }
+
+// UserDataArchive mocks base method.
+func (m *MockQueries) UserDataArchive(ctx context.Context, userID string) (*query.UserDataArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDataArchive", ctx, userID)
+	ret0, _ := ret[0].(*query.UserDataArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDataArchive indicates an expected call of UserDataArchive.
+func (mr *MockQueriesMockRecorder) UserDataArchive(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDataArchive", reflect.TypeOf((*MockQueries)(nil).UserDataArchive), ctx, userID)
+}
+
+// UserGrant mocks base method.
+func (m *MockQueries) UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...query.SearchQuery) (*query.UserGrant, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, shouldTriggerBulk}
+	for _, a := range queries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UserGrant", varargs...)
+	ret0, _ := ret[0].(*query.UserGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGrant indicates an expected call of UserGrant.
+func (mr *MockQueriesMockRecorder) UserGrant(ctx, shouldTriggerBulk any, queries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, shouldTriggerBulk}, queries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGrant", reflect.TypeOf((*MockQueries)(nil).UserGrant), varargs...)
+}
+
+// UserGrants mocks base method.
+func (m *MockQueries) UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserGrants", ctx, queries, shouldTriggerBulk)
+	ret0, _ := ret[0].(*query.UserGrants)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserGrants indicates an expected call of UserGrants.
+func (mr *MockQueriesMockRecorder) UserGrants(ctx, queries, shouldTriggerBulk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserGrants", reflect.TypeOf((*MockQueries)(nil).UserGrants), ctx, queries, shouldTriggerBulk)
+}
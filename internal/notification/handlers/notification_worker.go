@@ -55,6 +55,10 @@ type WorkerConfig struct {
 	MinRetryDelay       time.Duration
 	MaxRetryDelay       time.Duration
 	RetryDelayFactor    float32
+	PasswordExpiry      PasswordExpiryConfig
+	AccessExpiry        AccessExpiryConfig
+	Inactivity          InactivityConfig
+	UserPrivacy         UserPrivacyConfig
 }
 
 // nowFunc makes [time.Now] mockable
@@ -210,16 +214,24 @@ func (w *NotificationWorker) sendNotification(ctx, txCtx context.Context, tx *sq
 	}
 
 	generatorInfo := new(senders.CodeGeneratorInfo)
+	deliveryInfo := new(senders.DeliveryInfo)
 	var notify types.Notify
 	switch request.NotificationType {
 	case domain.NotificationTypeEmail:
-		template, err := w.queries.MailTemplateByOrg(ctx, notifyUser.ResourceOwner, false)
+		template, err := w.queries.MessageMailTemplateByOrg(ctx, notifyUser.ResourceOwner, request.MessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
-		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, e)
+		// notifications about a changed email address are sent to the previous address
+		if request.Args != nil && request.Args.PreviousEmail != "" {
+			previous := *notifyUser
+			previous.LastEmail = request.Args.PreviousEmail
+			previous.VerifiedEmail = request.Args.PreviousEmail
+			notifyUser = &previous
+		}
+		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, e, deliveryInfo)
 	case domain.NotificationTypeSms:
-		notify = types.SendSMS(ctx, w.channels, translator, notifyUser, colors, e, generatorInfo)
+		notify = types.SendSMS(ctx, w.channels, translator, notifyUser, colors, e, generatorInfo, deliveryInfo)
 	}
 
 	args := request.Args.ToMap()
@@ -232,7 +244,11 @@ func (w *NotificationWorker) sendNotification(ctx, txCtx context.Context, tx *sq
 	if err := notify(request.URLTemplate, args, request.MessageType, request.UnverifiedNotificationChannel); err != nil {
 		return err
 	}
-	err = w.commands.NotificationSent(txCtx, tx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	err = w.commands.NotificationSent(txCtx, tx, e.Aggregate().ID, e.Aggregate().ResourceOwner, &command.NotificationDelivery{
+		ProviderID:        deliveryInfo.ProviderID,
+		ProviderMessageID: deliveryInfo.ProviderMessageID,
+		RecipientHash:     domain.NotificationRecipientHash(deliveryInfo.Recipient),
+	})
 	if err != nil {
 		// In case the notification event cannot be pushed, we most likely cannot create a retry or cancel event.
 		// Therefore, we'll only log the error and also do not need to try to push to the user / session.
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type PasswordExpiryConfig struct {
+	CheckEvery time.Duration
+	BulkLimit  uint16
+}
+
+// PasswordExpiryNotifier periodically searches the users whose password reached a threshold of the password age policy
+// and requests the reminder notification. The notification itself is sent by the [userNotifier].
+type PasswordExpiryNotifier struct {
+	commands Commands
+	queries  *NotificationQueries
+	config   WorkerConfig
+}
+
+func NewPasswordExpiryNotifier(
+	config WorkerConfig,
+	commands Commands,
+	queries *NotificationQueries,
+) *PasswordExpiryNotifier {
+	if config.PasswordExpiry.BulkLimit == 0 {
+		config.PasswordExpiry.BulkLimit = 100
+	}
+	return &PasswordExpiryNotifier{
+		commands: commands,
+		queries:  queries,
+		config:   config,
+	}
+}
+
+func (n *PasswordExpiryNotifier) Start(ctx context.Context) {
+	if n.config.LegacyEnabled || n.config.PasswordExpiry.CheckEvery <= 0 {
+		return
+	}
+	go n.schedule(ctx)
+}
+
+func (n *PasswordExpiryNotifier) schedule(ctx context.Context) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			logging.Info("password expiry notifier stopped")
+			return
+		case <-t.C:
+			for _, instance := range n.queries.ActiveInstances() {
+				err := n.trigger(authz.WithInstanceID(call.WithTimestamp(ctx), instance))
+				logging.WithFields("instance", instance).OnError(err).Info("password expiry check failed")
+			}
+			t.Reset(n.config.PasswordExpiry.CheckEvery)
+		}
+	}
+}
+
+// trigger requests the notification of all users, whose password reached a threshold they were not notified about.
+// The users are paged by their ID instead of an offset, because notified users drop out of the result.
+func (n *PasswordExpiryNotifier) trigger(ctx context.Context) error {
+	search := &query.ExpiringPasswordSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Limit:         uint64(n.config.PasswordExpiry.BulkLimit),
+			SortingColumn: query.UserIDCol,
+			Asc:           true,
+		},
+		NotNotified: true,
+	}
+	for {
+		passwords, err := n.queries.SearchExpiringPasswords(ctx, search)
+		if err != nil {
+			return err
+		}
+		for _, password := range passwords.ExpiringPasswords {
+			// users notified since the projection was updated are ignored by the command
+			err = n.commands.AddPasswordExpiryNotification(ctx, password.ResourceOwner, password.UserID, password.Threshold, password.PasswordChanged, password.ExpirationDate)
+			// a single user must not prevent the notification of the others
+			logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "user", password.UserID).OnError(err).Warn("unable to add password expiry notification")
+		}
+		if len(passwords.ExpiringPasswords) < int(search.Limit) {
+			return nil
+		}
+		search.AfterUserID = passwords.ExpiringPasswords[len(passwords.ExpiringPasswords)-1].UserID
+	}
+}
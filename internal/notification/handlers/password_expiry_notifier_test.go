@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestPasswordExpiryNotifier_trigger(t *testing.T) {
+	changed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := changed.Add(30 * 24 * time.Hour)
+	tests := []struct {
+		name      string
+		passwords []*query.ExpiringPassword
+		queryErr  error
+		expect    func(commands *mock.MockCommands)
+		wantErr   error
+	}{
+		{
+			name: "no expiring passwords",
+		},
+		{
+			name:     "query fails",
+			queryErr: zerrors.ThrowInternal(nil, "QUERY-Err", "error"),
+			wantErr:  zerrors.ThrowInternal(nil, "QUERY-Err", "error"),
+		},
+		{
+			name: "failed notification does not stop the others",
+			passwords: []*query.ExpiringPassword{
+				{UserID: "user1", ResourceOwner: "org1", PasswordChanged: changed, ExpirationDate: expiration, Threshold: domain.PasswordExpiryThresholdWarning},
+				{UserID: "user2", ResourceOwner: "org1", PasswordChanged: changed, ExpirationDate: expiration, Threshold: domain.PasswordExpiryThresholdExpired},
+			},
+			expect: func(commands *mock.MockCommands) {
+				commands.EXPECT().AddPasswordExpiryNotification(gomock.Any(), "org1", "user1", domain.PasswordExpiryThresholdWarning, changed, expiration).
+					Return(zerrors.ThrowPreconditionFailed(nil, "COMMAND-Px4eSc", "Errors.User.NotFound"))
+				commands.EXPECT().AddPasswordExpiryNotification(gomock.Any(), "org1", "user2", domain.PasswordExpiryThresholdExpired, changed, expiration).
+					Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			queries.EXPECT().SearchExpiringPasswords(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, search *query.ExpiringPasswordSearchQueries) (*query.ExpiringPasswords, error) {
+					assert.True(t, search.NotNotified)
+					if tt.queryErr != nil {
+						return nil, tt.queryErr
+					}
+					return &query.ExpiringPasswords{
+						SearchResponse:    query.SearchResponse{Count: uint64(len(tt.passwords))},
+						ExpiringPasswords: tt.passwords,
+					}, nil
+				},
+			)
+			if tt.expect != nil {
+				tt.expect(commands)
+			}
+			notifier := NewPasswordExpiryNotifier(
+				WorkerConfig{},
+				commands,
+				NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil),
+			)
+			assert.ErrorIs(t, notifier.trigger(context.Background()), tt.wantErr)
+		})
+	}
+}
+
+func TestPasswordExpiryNotifier_trigger_paging(t *testing.T) {
+	changed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := changed.Add(30 * 24 * time.Hour)
+	pages := [][]*query.ExpiringPassword{
+		{
+			{UserID: "user1", ResourceOwner: "org1", PasswordChanged: changed, ExpirationDate: expiration, Threshold: domain.PasswordExpiryThresholdWarning},
+			{UserID: "user2", ResourceOwner: "org1", PasswordChanged: changed, ExpirationDate: expiration, Threshold: domain.PasswordExpiryThresholdWarning},
+		},
+		{
+			{UserID: "user3", ResourceOwner: "org1", PasswordChanged: changed, ExpirationDate: expiration, Threshold: domain.PasswordExpiryThresholdWarning},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	queries := mock.NewMockQueries(ctrl)
+	commands := mock.NewMockCommands(ctrl)
+	gomock.InOrder(
+		queries.EXPECT().SearchExpiringPasswords(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, search *query.ExpiringPasswordSearchQueries) (*query.ExpiringPasswords, error) {
+				assert.Equal(t, query.UserIDCol, search.SortingColumn)
+				assert.Zero(t, search.Offset)
+				assert.Empty(t, search.AfterUserID)
+				// the count of not notified users does not include the already notified ones of the previous pages
+				return &query.ExpiringPasswords{SearchResponse: query.SearchResponse{Count: 3}, ExpiringPasswords: pages[0]}, nil
+			},
+		),
+		queries.EXPECT().SearchExpiringPasswords(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, search *query.ExpiringPasswordSearchQueries) (*query.ExpiringPasswords, error) {
+				assert.Zero(t, search.Offset)
+				assert.Equal(t, "user2", search.AfterUserID)
+				return &query.ExpiringPasswords{SearchResponse: query.SearchResponse{Count: 1}, ExpiringPasswords: pages[1]}, nil
+			},
+		),
+	)
+	for _, userID := range []string{"user1", "user2", "user3"} {
+		commands.EXPECT().AddPasswordExpiryNotification(gomock.Any(), "org1", userID, domain.PasswordExpiryThresholdWarning, changed, expiration)
+	}
+	notifier := NewPasswordExpiryNotifier(
+		WorkerConfig{PasswordExpiry: PasswordExpiryConfig{BulkLimit: 2}},
+		commands,
+		NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil),
+	)
+	assert.NoError(t, notifier.trigger(context.Background()))
+}
@@ -16,12 +16,26 @@ import (
 
 type Queries interface {
 	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
-	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+	MessageMailTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MailTemplate, error)
 	GetNotifyUserByID(ctx context.Context, shouldTriggered bool, userID string) (*query.NotifyUser, error)
 	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
 	SearchInstanceDomains(ctx context.Context, queries *query.InstanceDomainSearchQueries) (*query.InstanceDomains, error)
 	SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string) (*query.Session, error)
 	NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (*query.NotificationPolicy, error)
+	DefaultNotificationPolicy(ctx context.Context, shouldTriggerBulk bool) (*query.NotificationPolicy, error)
+	SearchExpiringPasswords(ctx context.Context, queries *query.ExpiringPasswordSearchQueries) (*query.ExpiringPasswords, error)
+	SearchExpiringAccesses(ctx context.Context, queries *query.ExpiringAccessSearchQueries) (*query.ExpiringAccesses, error)
+	SearchExpiredAccessReviews(ctx context.Context, before time.Time, limit uint64) (*query.AccessReviews, error)
+	SearchInactiveUsers(ctx context.Context, queries *query.InactiveUserSearchQueries) (*query.InactiveUsers, error)
+	SearchUserDataExports(ctx context.Context, queries *query.UserDataExportSearchQueries) (*query.UserDataExports, error)
+	SearchScheduledUserDeletions(ctx context.Context, queries *query.ScheduledUserDeletionSearchQueries) (*query.ScheduledUserDeletions, error)
+	UserDataArchive(ctx context.Context, userID string) (*query.UserDataArchive, error)
+	UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...query.SearchQuery) (*query.UserGrant, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error)
+	OrgMembers(ctx context.Context, queries *query.OrgMembersQuery) (*query.Members, error)
+	ProjectMembers(ctx context.Context, queries *query.ProjectMembersQuery) (*query.Members, error)
+	ProjectByID(ctx context.Context, shouldTriggerBulk bool, id string) (*query.Project, error)
 	SearchMilestones(ctx context.Context, instanceIDs []string, queries *query.MilestonesSearchQueries) (*query.Milestones, error)
 	NotificationProviderByIDAndType(ctx context.Context, aggID string, providerType domain.NotificationProviderType) (*query.DebugNotificationProvider, error)
 	SMSProviderConfigActive(ctx context.Context, resourceOwner string) (config *query.SMSConfig, err error)
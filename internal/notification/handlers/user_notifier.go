@@ -13,8 +13,10 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -118,7 +120,7 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 	return []handler.AggregateReducer{
 		{
 			Aggregate: user.AggregateType,
-			EventReducers: []handler.EventReducer{
+			EventReducers: append([]handler.EventReducer{
 				{
 					Event:  user.UserV1InitialCodeAddedType,
 					Reduce: u.reduceInitCodeAdded,
@@ -163,6 +165,18 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: u.reducePasswordChanged,
 				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationAddedType,
+					Reduce: u.reducePasswordExpiryNotificationAdded,
+				},
+				{
+					Event:  user.InactivityNotificationAddedType,
+					Reduce: u.reduceInactivityNotificationAdded,
+				},
+				{
+					Event:  user.DeletionReminderAddedType,
+					Reduce: u.reduceUserDeletionReminderAdded,
+				},
 				{
 					Event:  user.HumanOTPSMSCodeAddedType,
 					Reduce: u.reduceOTPSMSCodeAdded,
@@ -175,6 +189,24 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
+			}, u.securityEventReducers()...),
+		},
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.AddedEventType,
+					Reduce: u.reduceAccessRequestAdded,
+				},
+			},
+		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantExpiryNotificationAddedType,
+					Reduce: u.reduceUserGrantExpiryNotificationAdded,
+				},
 			},
 		},
 		{
@@ -188,6 +220,10 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: u.reduceSessionRiskEvaluated,
+				},
 			},
 		},
 	}
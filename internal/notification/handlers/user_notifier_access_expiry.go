@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// accessExpiryManagerRoles are the roles of the organization members, who are notified
+// about the upcoming expiry of the user grants of the organization.
+var accessExpiryManagerRoles = []string{domain.RoleOrgOwner, domain.RoleOrgUserManager}
+
+func init() {
+	RegisterSentHandler(usergrant.UserGrantExpiryNotificationAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			recipientID, _ := args["RecipientID"].(string)
+			return commands.UserGrantExpiryNotificationSent(ctx, id, orgID, recipientID)
+		},
+	)
+}
+
+func (u *userNotifier) reduceUserGrantExpiryNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantExpiryNotificationAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ax8cN1", "reduce.wrong.event.type %s", usergrant.UserGrantExpiryNotificationAddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		grantQuery, err := query.NewUserGrantIDSearchQuery(e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		grant, err := u.queries.UserGrant(ctx, true, grantQuery)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		recipients, err := u.accessExpiryRecipients(ctx, grant)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		for _, recipientID := range recipients {
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"recipientId": recipientID}, usergrant.UserGrantExpiryNotificationSentType)
+			if err != nil {
+				return err
+			}
+			if alreadyHandled {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, recipientID)
+			if zerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if notifyUser.LastEmail == "" {
+				continue
+			}
+			err = u.commands.RequestNotification(ctx,
+				e.Aggregate().ResourceOwner,
+				command.NewNotificationRequest(
+					notifyUser.ID,
+					notifyUser.ResourceOwner,
+					origin,
+					e.EventType,
+					domain.NotificationTypeEmail,
+					domain.AccessExpiryWarningMessageType,
+				).
+					WithAggregate(e.Aggregate().ID, e.Aggregate().ResourceOwner).
+					WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+					WithUnverifiedChannel().
+					WithArgs(&domain.NotificationArguments{
+						ExpirationDate: e.ValidUntil,
+						RecipientID:    recipientID,
+						ProjectName:    grant.ProjectName,
+						GranteeName:    grant.DisplayName,
+					}),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// accessExpiryRecipients returns the grantee and the managers of the organization owning the user grant.
+func (u *userNotifier) accessExpiryRecipients(ctx context.Context, grant *query.UserGrant) ([]string, error) {
+	members, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: grant.ResourceOwner})
+	if err != nil {
+		return nil, err
+	}
+	recipients := []string{grant.UserID}
+	for _, userID := range membersWithRoles(members, accessExpiryManagerRoles) {
+		if userID != grant.UserID {
+			recipients = append(recipients, userID)
+		}
+	}
+	return recipients, nil
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_userNotifier_reduceUserGrantExpiryNotificationAdded(t *testing.T) {
+	const (
+		grantID = "grant1"
+		ownerID = "owner1"
+	)
+	validUntil := time.Now().Add(24 * time.Hour).UTC()
+	origin := fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort)
+	expiryEvent := func() *usergrant.UserGrantExpiryNotificationAddedEvent {
+		return &usergrant.UserGrantExpiryNotificationAddedEvent{
+			BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   grantID,
+				AggregateType: usergrant.AggregateType,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           usergrant.UserGrantExpiryNotificationAddedType,
+			}),
+			UserID:     userID,
+			ProjectID:  "project1",
+			ValidUntil: validUntil,
+		}
+	}
+	expectRequest := func(commands *mock.MockCommands, recipientID string) {
+		commands.EXPECT().RequestNotification(gomock.Any(), orgID, &command.NotificationRequest{
+			UserID:                        recipientID,
+			UserResourceOwner:             orgID,
+			TriggerOrigin:                 origin,
+			URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+			EventType:                     usergrant.UserGrantExpiryNotificationAddedType,
+			NotificationType:              domain.NotificationTypeEmail,
+			MessageType:                   domain.AccessExpiryWarningMessageType,
+			UnverifiedNotificationChannel: true,
+			Args: &domain.NotificationArguments{
+				ExpirationDate: validUntil,
+				RecipientID:    recipientID,
+				ProjectName:    "project-name",
+				GranteeName:    "grantee",
+			},
+			AggregateID:            grantID,
+			AggregateResourceOwner: orgID,
+		}).Return(nil)
+	}
+	expectGrantAndMembers := func(queries *mock.MockQueries) {
+		queries.EXPECT().UserGrant(gomock.Any(), true, gomock.Any()).Return(&query.UserGrant{
+			ID:            grantID,
+			UserID:        userID,
+			DisplayName:   "grantee",
+			ResourceOwner: orgID,
+			ProjectName:   "project-name",
+		}, nil)
+		queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+			Members: []*query.Member{
+				{UserID: ownerID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+				{UserID: "creator1", Roles: database.TextArray[string]{domain.RoleOrgProjectCreator}},
+			},
+		}, nil)
+		queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+			Domains: []*query.InstanceDomain{{
+				Domain:    instancePrimaryDomain,
+				IsPrimary: true,
+			}},
+		}, nil)
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{
+		{
+			name: "grant removed, no notification",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				queries.EXPECT().UserGrant(gomock.Any(), true, gomock.Any()).Return(nil, zerrors.ThrowNotFound(nil, "QUERY-wIPkA", "Errors.UserGrant.NotFound"))
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).MockQuerier,
+					}),
+				}, args{
+					event: expiryEvent(),
+				}, w
+			},
+		},
+		{
+			name: "grantee and managers notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				expectGrantAndMembers(queries)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+					LastEmail:     lastEmail,
+				}, nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, ownerID).Return(&query.NotifyUser{
+					ID:            ownerID,
+					ResourceOwner: orgID,
+					LastEmail:     lastEmail,
+				}, nil)
+				expectRequest(commands, userID)
+				expectRequest(commands, ownerID)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: expiryEvent(),
+				}, w
+			},
+		},
+		{
+			name: "already notified grantee, only managers notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				expectGrantAndMembers(queries)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, ownerID).Return(&query.NotifyUser{
+					ID:            ownerID,
+					ResourceOwner: orgID,
+					LastEmail:     lastEmail,
+				}, nil)
+				expectRequest(commands, ownerID)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							&usergrant.UserGrantExpiryNotificationSentEvent{
+								BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   grantID,
+									AggregateType: usergrant.AggregateType,
+									ResourceOwner: sql.NullString{String: orgID},
+									Typ:           usergrant.UserGrantExpiryNotificationSentType,
+								}),
+								RecipientID: userID,
+							},
+						).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: expiryEvent(),
+				}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUserGrantExpiryNotificationAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
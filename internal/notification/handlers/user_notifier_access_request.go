@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// accessRequestApproverRoles are the roles of the project members, who are notified about new access requests.
+var accessRequestApproverRoles = []string{domain.RoleProjectOwner, domain.RoleProjectOwnerGlobal, domain.RoleProjectAccessApprover}
+
+func init() {
+	RegisterSentHandler(accessrequest.AddedEventType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			recipientID, _ := args["RecipientID"].(string)
+			return commands.AccessRequestNotificationSent(ctx, id, orgID, recipientID)
+		},
+	)
+}
+
+func (u *userNotifier) reduceAccessRequestAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*accessrequest.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ar3nQa", "reduce.wrong.event.type %s", accessrequest.AddedEventType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		requester, err := u.queries.GetNotifyUserByID(ctx, true, e.UserID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		project, err := u.queries.ProjectByID(ctx, true, e.ProjectID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		recipients, err := u.accessRequestApprovers(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		for _, recipientID := range recipients {
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"recipientId": recipientID}, accessrequest.NotificationSentEventType)
+			if err != nil {
+				return err
+			}
+			if alreadyHandled {
+				continue
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, recipientID)
+			if zerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if notifyUser.LastEmail == "" {
+				continue
+			}
+			err = u.commands.RequestNotification(ctx,
+				e.Aggregate().ResourceOwner,
+				command.NewNotificationRequest(
+					notifyUser.ID,
+					notifyUser.ResourceOwner,
+					origin,
+					e.EventType,
+					domain.NotificationTypeEmail,
+					domain.AccessRequestedMessageType,
+				).
+					WithAggregate(e.Aggregate().ID, e.Aggregate().ResourceOwner).
+					WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+					WithUnverifiedChannel().
+					WithArgs(&domain.NotificationArguments{
+						RecipientID: recipientID,
+						ProjectName: project.Name,
+						GranteeName: requester.DisplayName,
+						Roles:       strings.Join(e.RoleKeys, ", "),
+					}),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}), nil
+}
+
+// accessRequestApprovers returns the project members allowed to approve access requests.
+// If the project has none, the owners of the organization of the project are notified.
+func (u *userNotifier) accessRequestApprovers(ctx context.Context, project *query.Project) ([]string, error) {
+	projectMembers, err := u.queries.ProjectMembers(ctx, &query.ProjectMembersQuery{ProjectID: project.ID})
+	if err != nil {
+		return nil, err
+	}
+	recipients := membersWithRoles(projectMembers, accessRequestApproverRoles)
+	if len(recipients) > 0 {
+		return recipients, nil
+	}
+	orgMembers, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: project.ResourceOwner})
+	if err != nil {
+		return nil, err
+	}
+	return membersWithRoles(orgMembers, []string{domain.RoleOrgOwner}), nil
+}
+
+func membersWithRoles(members *query.Members, roles []string) []string {
+	userIDs := make([]string, 0, len(members.Members))
+	for _, member := range members.Members {
+		if slices.Contains(userIDs, member.UserID) {
+			continue
+		}
+		if slices.ContainsFunc(member.Roles, func(role string) bool {
+			return slices.Contains(roles, role)
+		}) {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs
+}
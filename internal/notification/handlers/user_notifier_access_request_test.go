@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+)
+
+func Test_userNotifier_reduceAccessRequestAdded(t *testing.T) {
+	const (
+		requestID  = "request1"
+		projectID  = "project1"
+		approverID = "approver1"
+	)
+	origin := fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort)
+	addedEvent := func() *accessrequest.AddedEvent {
+		return &accessrequest.AddedEvent{
+			BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   requestID,
+				AggregateType: accessrequest.AggregateType,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           accessrequest.AddedEventType,
+			}),
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  []string{"viewer", "editor"},
+		}
+	}
+	expectRequest := func(commands *mock.MockCommands, recipientID string) {
+		commands.EXPECT().RequestNotification(gomock.Any(), orgID, &command.NotificationRequest{
+			UserID:                        recipientID,
+			UserResourceOwner:             orgID,
+			TriggerOrigin:                 origin,
+			URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+			EventType:                     accessrequest.AddedEventType,
+			NotificationType:              domain.NotificationTypeEmail,
+			MessageType:                   domain.AccessRequestedMessageType,
+			UnverifiedNotificationChannel: true,
+			Args: &domain.NotificationArguments{
+				RecipientID: recipientID,
+				ProjectName: "project-name",
+				GranteeName: "requester",
+				Roles:       "viewer, editor",
+			},
+			AggregateID:            requestID,
+			AggregateResourceOwner: orgID,
+		}).Return(nil)
+	}
+	expectRequesterAndProject := func(queries *mock.MockQueries) {
+		queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+			ID:            userID,
+			ResourceOwner: orgID,
+			DisplayName:   "requester",
+		}, nil)
+		queries.EXPECT().ProjectByID(gomock.Any(), true, projectID).Return(&query.Project{
+			ID:            projectID,
+			ResourceOwner: orgID,
+			Name:          "project-name",
+		}, nil)
+	}
+	expectOrigin := func(queries *mock.MockQueries) {
+		queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+			Domains: []*query.InstanceDomain{{
+				Domain:    instancePrimaryDomain,
+				IsPrimary: true,
+			}},
+		}, nil)
+	}
+	expectApprover := func(queries *mock.MockQueries) {
+		queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, approverID).Return(&query.NotifyUser{
+			ID:            approverID,
+			ResourceOwner: orgID,
+			LastEmail:     lastEmail,
+		}, nil)
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{
+		{
+			name: "project approvers notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				expectRequesterAndProject(queries)
+				queries.EXPECT().ProjectMembers(gomock.Any(), &query.ProjectMembersQuery{ProjectID: projectID}).Return(&query.Members{
+					Members: []*query.Member{
+						{UserID: approverID, Roles: database.TextArray[string]{domain.RoleProjectAccessApprover}},
+						{UserID: "viewer1", Roles: database.TextArray[string]{"PROJECT_OWNER_VIEWER"}},
+					},
+				}, nil)
+				expectOrigin(queries)
+				expectApprover(queries)
+				expectRequest(commands, approverID)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: addedEvent(),
+				}, w
+			},
+		},
+		{
+			name: "no project approvers, org owners notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				expectRequesterAndProject(queries)
+				queries.EXPECT().ProjectMembers(gomock.Any(), &query.ProjectMembersQuery{ProjectID: projectID}).Return(&query.Members{}, nil)
+				queries.EXPECT().OrgMembers(gomock.Any(), &query.OrgMembersQuery{OrgID: orgID}).Return(&query.Members{
+					Members: []*query.Member{
+						{UserID: approverID, Roles: database.TextArray[string]{domain.RoleOrgOwner}},
+					},
+				}, nil)
+				expectOrigin(queries)
+				expectApprover(queries)
+				expectRequest(commands, approverID)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: addedEvent(),
+				}, w
+			},
+		},
+		{
+			name: "already notified, no notification",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				expectRequesterAndProject(queries)
+				queries.EXPECT().ProjectMembers(gomock.Any(), &query.ProjectMembersQuery{ProjectID: projectID}).Return(&query.Members{
+					Members: []*query.Member{
+						{UserID: approverID, Roles: database.TextArray[string]{domain.RoleProjectOwner}},
+					},
+				}, nil)
+				expectOrigin(queries)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							&accessrequest.NotificationSentEvent{
+								BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   requestID,
+									AggregateType: accessrequest.AggregateType,
+									ResourceOwner: sql.NullString{String: orgID},
+									Typ:           accessrequest.NotificationSentEventType,
+								}),
+								RecipientID: approverID,
+							},
+						).MockQuerier,
+					}),
+				}, args{
+					event: addedEvent(),
+				}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceAccessRequestAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
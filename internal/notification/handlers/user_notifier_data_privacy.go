@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func init() {
+	RegisterSentHandler(user.DeletionReminderAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, _ map[string]any) error {
+			return commands.UserDeletionReminderSent(ctx, orgID, id)
+		},
+	)
+}
+
+func (u *userNotifier) reduceUserDeletionReminderAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.DeletionReminderAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Dl3aAa", "reduce.wrong.event.type %s", user.DeletionReminderAddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		// no reminder is sent if the deletion was canceled in the meantime
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.DeletionReminderSentType, user.DeletionCanceledType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if notifyUser.LastEmail == "" {
+			return nil
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.commands.RequestNotification(ctx,
+			e.Aggregate().ResourceOwner,
+			command.NewNotificationRequest(
+				e.Aggregate().ID,
+				e.Aggregate().ResourceOwner,
+				origin,
+				e.EventType,
+				domain.NotificationTypeEmail,
+				domain.UserDeletionReminderMessageType,
+			).
+				WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+				WithUnverifiedChannel().
+				WithArgs(&domain.NotificationArguments{
+					ExpirationDate: e.DeletionDate,
+				}),
+		)
+	}), nil
+}
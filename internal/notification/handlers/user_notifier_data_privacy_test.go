@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func Test_userNotifier_reduceUserDeletionReminderAdded(t *testing.T) {
+	deletionDate := time.Now().Add(7 * 24 * time.Hour).UTC()
+	origin := fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort)
+	reminderEvent := func() *user.DeletionReminderAddedEvent {
+		return &user.DeletionReminderAddedEvent{
+			BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   userID,
+				AggregateType: user.AggregateType,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           user.DeletionReminderAddedType,
+			}),
+			DeletionDate: deletionDate,
+		}
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{
+		{
+			name: "already reminded",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							&user.DeletionReminderSentEvent{
+								BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   userID,
+									AggregateType: user.AggregateType,
+									ResourceOwner: sql.NullString{String: orgID},
+									Typ:           user.DeletionReminderSentType,
+								}),
+							},
+						).MockQuerier,
+					}),
+				}, args{
+					event: reminderEvent(),
+				}, w
+			},
+		},
+		{
+			name: "deletion canceled, no reminder",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							&user.DeletionCanceledEvent{
+								BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   userID,
+									AggregateType: user.AggregateType,
+									ResourceOwner: sql.NullString{String: orgID},
+									Typ:           user.DeletionCanceledType,
+								}),
+							},
+						).MockQuerier,
+					}),
+				}, args{
+					event: reminderEvent(),
+				}, w
+			},
+		},
+		{
+			name: "user without email, no reminder",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+				}, nil)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: reminderEvent(),
+				}, w
+			},
+		},
+		{
+			name: "user reminded",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+					LastEmail:     lastEmail,
+				}, nil)
+				queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+					Domains: []*query.InstanceDomain{{
+						Domain:    instancePrimaryDomain,
+						IsPrimary: true,
+					}},
+				}, nil)
+				commands.EXPECT().RequestNotification(gomock.Any(), orgID, &command.NotificationRequest{
+					UserID:                        userID,
+					UserResourceOwner:             orgID,
+					TriggerOrigin:                 origin,
+					URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+					EventType:                     user.DeletionReminderAddedType,
+					NotificationType:              domain.NotificationTypeEmail,
+					MessageType:                   domain.UserDeletionReminderMessageType,
+					UnverifiedNotificationChannel: true,
+					Args: &domain.NotificationArguments{
+						ExpirationDate: deletionDate,
+					},
+				}).Return(nil)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: reminderEvent(),
+				}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceUserDeletionReminderAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+func Test_userNotifier_reduceInactivityNotificationAdded(t *testing.T) {
+	dueDate := time.Now().Add(7 * 24 * time.Hour).UTC()
+	origin := fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort)
+	notificationEvent := func() *user.InactivityNotificationAddedEvent {
+		return &user.InactivityNotificationAddedEvent{
+			BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   userID,
+				AggregateType: user.AggregateType,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           user.InactivityNotificationAddedType,
+			}),
+			Action:       domain.InactivityActionDelete,
+			LastActivity: time.Now().Add(-358 * 24 * time.Hour).UTC(),
+			DueDate:      dueDate,
+		}
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockCommands) (fields, args, want)
+	}{
+		{
+			name: "already notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(
+							&user.InactivityNotificationSentEvent{
+								BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   userID,
+									AggregateType: user.AggregateType,
+									ResourceOwner: sql.NullString{String: orgID},
+									Typ:           user.InactivityNotificationSentType,
+								}),
+								Action: domain.InactivityActionDelete,
+							},
+						).MockQuerier,
+					}),
+				}, args{
+					event: notificationEvent(),
+				}, w
+			},
+		},
+		{
+			name: "user without email, no notification",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+				}, nil)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: notificationEvent(),
+				}, w
+			},
+		},
+		{
+			name: "user notified",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), true, userID).Return(&query.NotifyUser{
+					ID:            userID,
+					ResourceOwner: orgID,
+					LastEmail:     lastEmail,
+				}, nil)
+				queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+					Domains: []*query.InstanceDomain{{
+						Domain:    instancePrimaryDomain,
+						IsPrimary: true,
+					}},
+				}, nil)
+				commands.EXPECT().RequestNotification(gomock.Any(), orgID, &command.NotificationRequest{
+					UserID:                        userID,
+					UserResourceOwner:             orgID,
+					TriggerOrigin:                 origin,
+					URLTemplate:                   console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+					EventType:                     user.InactivityNotificationAddedType,
+					NotificationType:              domain.NotificationTypeEmail,
+					MessageType:                   domain.InactivityDeletionWarningMessageType,
+					UnverifiedNotificationChannel: true,
+					Args: &domain.NotificationArguments{
+						ExpirationDate:   dueDate,
+						InactivityAction: domain.InactivityActionDelete,
+					},
+				}).Return(nil)
+				return fields{
+					queries:  queries,
+					commands: commands,
+					es: eventstore.NewEventstore(&eventstore.Config{
+						Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+					}),
+				}, args{
+					event: notificationEvent(),
+				}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			f, a, w := tt.test(ctrl, queries, commands)
+			stmt, err := newUserNotifier(t, ctrl, queries, f, a, w).reduceInactivityNotificationAdded(a.event)
+			assert.NoError(t, err)
+			err = stmt.Execute(nil, "")
+			assert.NoError(t, err)
+		})
+	}
+}
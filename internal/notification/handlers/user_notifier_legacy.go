@@ -151,12 +151,11 @@ func (u *userNotifierLegacy) reduceInitCodeAdded(event eventstore.Event) (*handl
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.InitCodeMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -169,7 +168,7 @@ func (u *userNotifierLegacy) reduceInitCodeAdded(event eventstore.Event) (*handl
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil).
 			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -208,12 +207,11 @@ func (u *userNotifierLegacy) reduceEmailCodeAdded(event eventstore.Event) (*hand
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.VerifyEmailMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -226,7 +224,7 @@ func (u *userNotifierLegacy) reduceEmailCodeAdded(event eventstore.Event) (*hand
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil).
 			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
@@ -267,12 +265,11 @@ func (u *userNotifierLegacy) reducePasswordCodeAdded(event eventstore.Event) (*h
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.PasswordResetMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -286,9 +283,9 @@ func (u *userNotifierLegacy) reducePasswordCodeAdded(event eventstore.Event) (*h
 			return err
 		}
 		generatorInfo := new(senders.CodeGeneratorInfo)
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil)
 		if e.NotificationType == domain.NotificationTypeSms {
-			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, generatorInfo)
+			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, generatorInfo, nil)
 		}
 		err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
@@ -382,7 +379,7 @@ func (u *userNotifierLegacy) reduceOTPSMS(
 		return nil, err
 	}
 	generatorInfo := new(senders.CodeGeneratorInfo)
-	notify := types.SendSMS(ctx, u.channels, translator, notifyUser, colors, event, generatorInfo)
+	notify := types.SendSMS(ctx, u.channels, translator, notifyUser, colors, event, generatorInfo, nil)
 	err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
 	if err != nil {
 		return nil, err
@@ -483,12 +480,11 @@ func (u *userNotifierLegacy) reduceOTPEmail(
 		return nil, err
 	}
 
-	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
 	if err != nil {
 		return nil, err
 	}
-
-	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+	template, err := u.queries.MessageMailTemplateByOrg(ctx, resourceOwner, domain.VerifyEmailOTPMessageType, notifyUser.PreferredLanguage)
 	if err != nil {
 		return nil, err
 	}
@@ -504,7 +500,7 @@ func (u *userNotifierLegacy) reduceOTPEmail(
 	if err != nil {
 		return nil, err
 	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event)
+	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event, nil)
 	err = notify.SendOTPEmailCode(ctx, url, plainCode, expiry)
 	if err != nil {
 		return nil, err
@@ -536,12 +532,11 @@ func (u *userNotifierLegacy) reduceDomainClaimed(event eventstore.Event) (*handl
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.DomainClaimedMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -554,7 +549,7 @@ func (u *userNotifierLegacy) reduceDomainClaimed(event eventstore.Event) (*handl
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil).
 			SendDomainClaimed(ctx, notifyUser, e.UserName)
 		if err != nil {
 			return err
@@ -590,12 +585,11 @@ func (u *userNotifierLegacy) reducePasswordlessCodeRequested(event eventstore.Ev
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.PasswordlessRegistrationMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -608,7 +602,7 @@ func (u *userNotifierLegacy) reducePasswordlessCodeRequested(event eventstore.Ev
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil).
 			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
 		if err != nil {
 			return err
@@ -650,12 +644,11 @@ func (u *userNotifierLegacy) reducePasswordChanged(event eventstore.Event) (*han
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.PasswordChangeMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -667,7 +660,7 @@ func (u *userNotifierLegacy) reducePasswordChanged(event eventstore.Event) (*han
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil).
 			SendPasswordChange(ctx, notifyUser)
 		if err != nil {
 			return err
@@ -722,7 +715,7 @@ func (u *userNotifierLegacy) reducePhoneCodeAdded(event eventstore.Event) (*hand
 			return err
 		}
 		generatorInfo := new(senders.CodeGeneratorInfo)
-		if err = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, generatorInfo).
+		if err = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e, generatorInfo, nil).
 			SendPhoneVerificationCode(ctx, code); err != nil {
 			return err
 		}
@@ -758,12 +751,11 @@ func (u *userNotifierLegacy) reduceInviteCodeAdded(event eventstore.Event) (*han
 			return err
 		}
 
-		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
 		if err != nil {
 			return err
 		}
-
-		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		template, err := u.queries.MessageMailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, domain.InviteUserMessageType, notifyUser.PreferredLanguage)
 		if err != nil {
 			return err
 		}
@@ -776,7 +768,7 @@ func (u *userNotifierLegacy) reduceInviteCodeAdded(event eventstore.Event) (*han
 		if err != nil {
 			return err
 		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e)
+		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e, nil)
 		err = notify.SendInviteCode(ctx, notifyUser, code, e.ApplicationName, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			return err
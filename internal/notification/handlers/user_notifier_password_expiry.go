@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func init() {
+	RegisterSentHandler(user.HumanPasswordExpiryNotificationAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.PasswordExpiryNotificationSent(ctx, orgID, id, args["ExpiryThreshold"].(domain.PasswordExpiryThreshold))
+		},
+	)
+}
+
+func (u *userNotifier) reducePasswordExpiryNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordExpiryNotificationAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pe7xN1", "reduce.wrong.event.type %s", user.HumanPasswordExpiryNotificationAddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"threshold": e.Threshold}, user.HumanPasswordExpiryNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if zerrors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// users without email address are reminded by SMS
+		notificationType := domain.NotificationTypeEmail
+		if notifyUser.LastEmail == "" {
+			if notifyUser.LastPhone == "" {
+				return nil
+			}
+			notificationType = domain.NotificationTypeSms
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.commands.RequestNotification(ctx,
+			e.Aggregate().ResourceOwner,
+			command.NewNotificationRequest(
+				e.Aggregate().ID,
+				e.Aggregate().ResourceOwner,
+				origin,
+				e.EventType,
+				notificationType,
+				e.Threshold.MessageType(),
+			).
+				WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+				WithUnverifiedChannel().
+				WithArgs(&domain.NotificationArguments{
+					ExpirationDate:  e.ExpirationDate,
+					ExpiryThreshold: e.Threshold,
+				}),
+		)
+	}), nil
+}
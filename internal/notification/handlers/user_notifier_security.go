@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// securityNotification maps an event of the user aggregate to the security notification sent to the user.
+type securityNotification struct {
+	eventType   eventstore.EventType
+	messageType string
+	factor      string
+}
+
+var securityNotifications = []securityNotification{
+	{eventType: user.HumanMFAOTPVerifiedType, messageType: domain.MFAAddedMessageType, factor: "TOTP"},
+	{eventType: user.HumanU2FTokenVerifiedType, messageType: domain.MFAAddedMessageType, factor: "U2F"},
+	{eventType: user.HumanOTPSMSAddedType, messageType: domain.MFAAddedMessageType, factor: "OTP SMS"},
+	{eventType: user.HumanOTPEmailAddedType, messageType: domain.MFAAddedMessageType, factor: "OTP Email"},
+	{eventType: user.HumanMFAOTPRemovedType, messageType: domain.MFARemovedMessageType, factor: "TOTP"},
+	{eventType: user.HumanU2FTokenRemovedType, messageType: domain.MFARemovedMessageType, factor: "U2F"},
+	{eventType: user.HumanOTPSMSRemovedType, messageType: domain.MFARemovedMessageType, factor: "OTP SMS"},
+	{eventType: user.HumanOTPEmailRemovedType, messageType: domain.MFARemovedMessageType, factor: "OTP Email"},
+	{eventType: user.HumanPasswordlessTokenVerifiedType, messageType: domain.PasskeyAddedMessageType},
+	{eventType: user.UserLockedType, messageType: domain.AccountLockedMessageType},
+	{eventType: user.PersonalAccessTokenAddedType, messageType: domain.PATCreatedMessageType},
+}
+
+func init() {
+	for _, notification := range securityNotifications {
+		messageType := notification.messageType
+		RegisterSentHandler(notification.eventType,
+			func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+				return commands.SecurityNotificationSent(ctx, orgID, id, messageType)
+			},
+		)
+	}
+	RegisterSentHandler(user.HumanEmailChangeUndoCodeAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.HumanEmailChangeUndoCodeSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(session.RiskEvaluatedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.RiskNotificationSent(ctx, id, orgID)
+		},
+	)
+}
+
+func (u *userNotifier) securityEventReducers() []handler.EventReducer {
+	reducers := make([]handler.EventReducer, 0, len(securityNotifications)+4)
+	for _, notification := range securityNotifications {
+		reducers = append(reducers, handler.EventReducer{
+			Event:  notification.eventType,
+			Reduce: u.reduceSecurityEvent(notification.messageType, notification.factor),
+		})
+	}
+	return append(reducers,
+		handler.EventReducer{
+			Event:  user.UserV1EmailChangedType,
+			Reduce: u.reduceEmailChanged,
+		},
+		handler.EventReducer{
+			Event:  user.HumanEmailChangedType,
+			Reduce: u.reduceEmailChanged,
+		},
+		handler.EventReducer{
+			Event:  user.HumanEmailChangeUndoCodeAddedType,
+			Reduce: u.reduceEmailChangeUndoCodeAdded,
+		},
+	)
+}
+
+// securityNotificationEnabled checks the notification policy of the instance for the message type.
+func (u *userNotifier) securityNotificationEnabled(ctx context.Context, messageType string) (bool, error) {
+	policy, err := u.queries.DefaultNotificationPolicy(ctx, true)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return policy.SecurityNotifications.Enabled(messageType), nil
+}
+
+func (u *userNotifier) reduceSecurityEvent(messageType, factor string) handler.Reduce {
+	return func(event eventstore.Event) (*handler.Statement, error) {
+		if e, ok := event.(*user.UserLockedEvent); ok && !e.Lockout {
+			return handler.NewNoOpStatement(event), nil
+		}
+		return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+			ctx := HandlerContext(event.Aggregate())
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"messageType": messageType}, user.HumanSecurityNotificationSentType)
+			if err != nil {
+				return err
+			}
+			if alreadyHandled {
+				return nil
+			}
+			enabled, err := u.securityNotificationEnabled(ctx, messageType)
+			if err != nil || !enabled {
+				return err
+			}
+			notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, event.Aggregate().ID)
+			if zerrors.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// machine users (e.g. owners of personal access tokens) might not have an email address
+			if notifyUser.LastEmail == "" {
+				return nil
+			}
+
+			ctx, err = u.queries.Origin(ctx, event)
+			if err != nil {
+				return err
+			}
+			origin := http_util.DomainContext(ctx).Origin()
+
+			return u.commands.RequestNotification(ctx,
+				event.Aggregate().ResourceOwner,
+				command.NewNotificationRequest(
+					event.Aggregate().ID,
+					event.Aggregate().ResourceOwner,
+					origin,
+					event.Type(),
+					domain.NotificationTypeEmail,
+					messageType,
+				).
+					WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+					WithUnverifiedChannel().
+					WithArgs(&domain.NotificationArguments{
+						Factor: factor,
+					}),
+			)
+		}), nil
+	}
+}
+
+func (u *userNotifier) reduceEmailChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sn4tA1", "reduce.wrong.event.type %s", user.HumanEmailChangedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.HumanEmailChangeUndoCodeAddedType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		enabled, err := u.securityNotificationEnabled(ctx, domain.EmailChangedMessageType)
+		if err != nil || !enabled {
+			return err
+		}
+		return u.commands.AddHumanEmailChangeUndoCode(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID, e.EmailAddress)
+	}), nil
+}
+
+func (u *userNotifier) reduceEmailChangeUndoCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailChangeUndoCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sn4tB2", "reduce.wrong.event.type %s", user.HumanEmailChangeUndoCodeAddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanEmailChangeUndoCodeAddedType, user.HumanEmailChangeUndoCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.commands.RequestNotification(ctx,
+			e.Aggregate().ResourceOwner,
+			command.NewNotificationRequest(
+				e.Aggregate().ID,
+				e.Aggregate().ResourceOwner,
+				origin,
+				e.EventType,
+				domain.NotificationTypeEmail,
+				domain.EmailChangedMessageType,
+			).
+				WithURLTemplate(login.MailChangeUndoLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner)).
+				WithCode(e.Code, e.Expiry).
+				WithArgs(&domain.NotificationArguments{
+					PreviousEmail: string(e.PreviousEmail),
+				}).
+				WithUnverifiedChannel(),
+		)
+	}), nil
+}
+
+func (u *userNotifier) reduceSessionRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.RiskEvaluatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sn4tC3", "reduce.wrong.event.type %s", session.RiskEvaluatedType)
+	}
+	if !slices.Contains(e.Signals, domain.RiskSignalNewDevice) {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, session.RiskNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		enabled, err := u.securityNotificationEnabled(ctx, domain.NewDeviceSignInMessageType)
+		if err != nil || !enabled {
+			return err
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "")
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		return u.commands.RequestNotification(ctx,
+			s.UserFactor.ResourceOwner,
+			command.NewNotificationRequest(
+				e.UserID,
+				s.UserFactor.ResourceOwner,
+				origin,
+				e.EventType,
+				domain.NotificationTypeEmail,
+				domain.NewDeviceSignInMessageType,
+			).
+				WithAggregate(e.Aggregate().ID, e.Aggregate().ResourceOwner).
+				WithURLTemplate(console.LoginHintLink(origin, "{{.PreferredLoginName}}")).
+				WithUnverifiedChannel().
+				WithArgs(&domain.NotificationArguments{
+					IP:        e.IP,
+					Country:   e.Country,
+					SessionID: e.Aggregate().ID,
+				}),
+		)
+	}), nil
+}
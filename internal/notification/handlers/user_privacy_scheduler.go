@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type UserPrivacyConfig struct {
+	CheckEvery time.Duration
+	BulkLimit  uint16
+	// RemindBefore is the period before the scheduled deletion of a user, in which the user gets reminded.
+	// No reminders are sent if 0.
+	RemindBefore time.Duration
+}
+
+// UserPrivacyScheduler periodically generates the archives of the data exports requested by the users
+// and removes the users, whose scheduled deletion is due.
+// It also requests the reminders about the upcoming deletions, the reminders themselves are sent by the [userNotifier].
+type UserPrivacyScheduler struct {
+	commands Commands
+	queries  *NotificationQueries
+	config   WorkerConfig
+	now      nowFunc
+}
+
+func NewUserPrivacyScheduler(
+	config WorkerConfig,
+	commands Commands,
+	queries *NotificationQueries,
+) *UserPrivacyScheduler {
+	if config.UserPrivacy.BulkLimit == 0 {
+		config.UserPrivacy.BulkLimit = 100
+	}
+	return &UserPrivacyScheduler{
+		commands: commands,
+		queries:  queries,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func (s *UserPrivacyScheduler) Start(ctx context.Context) {
+	if s.config.LegacyEnabled || s.config.UserPrivacy.CheckEvery <= 0 {
+		return
+	}
+	go s.schedule(ctx)
+}
+
+func (s *UserPrivacyScheduler) schedule(ctx context.Context) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			logging.Info("user privacy scheduler stopped")
+			return
+		case <-t.C:
+			for _, instance := range s.queries.ActiveInstances() {
+				err := s.trigger(authz.WithInstanceID(call.WithTimestamp(ctx), instance))
+				logging.WithFields("instance", instance).OnError(err).Info("user privacy check failed")
+			}
+			t.Reset(s.config.UserPrivacy.CheckEvery)
+		}
+	}
+}
+
+// trigger handles one bulk of the requested exports, the due deletions and the reminders per run.
+// The handled entries are removed from the search results as soon as the projections are updated,
+// the remaining ones are handled by the next run.
+func (s *UserPrivacyScheduler) trigger(ctx context.Context) error {
+	now := s.now()
+	if err := s.completeDataExports(ctx); err != nil {
+		return err
+	}
+	if err := s.removeDueUsers(ctx, now); err != nil {
+		return err
+	}
+	if s.config.UserPrivacy.RemindBefore <= 0 {
+		return nil
+	}
+	return s.remindUsers(ctx, now.Add(s.config.UserPrivacy.RemindBefore))
+}
+
+func (s *UserPrivacyScheduler) completeDataExports(ctx context.Context) error {
+	stateQuery, err := query.NewUserDataExportStateSearchQuery(domain.UserDataExportStateRequested)
+	if err != nil {
+		return err
+	}
+	exports, err := s.queries.SearchUserDataExports(ctx, &query.UserDataExportSearchQueries{
+		SearchRequest: query.SearchRequest{Limit: uint64(s.config.UserPrivacy.BulkLimit)},
+		Queries:       []query.SearchQuery{stateQuery},
+	})
+	if err != nil {
+		return err
+	}
+	for _, export := range exports.Exports {
+		archive, err := s.queries.UserDataArchive(ctx, export.UserID)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(archive)
+		if err != nil {
+			return err
+		}
+		if err = s.commands.CompleteUserDataExport(ctx, export.ResourceOwner, export.UserID, export.ID, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeDueUsers removes the users, whose scheduled deletion is due.
+// Users, which canceled the deletion in the meantime, are ignored by the command.
+func (s *UserPrivacyScheduler) removeDueUsers(ctx context.Context, now time.Time) error {
+	deletions, err := s.searchDeletions(ctx, now, false)
+	if err != nil {
+		return err
+	}
+	for _, deletion := range deletions.Deletions {
+		memberships, grantIDs, err := userDependencies(ctx, s.queries, deletion.UserID)
+		if err != nil {
+			return err
+		}
+		if err = s.commands.RemoveScheduledUser(ctx, deletion.ResourceOwner, deletion.UserID, memberships, grantIDs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remindUsers requests the reminders for the users, whose scheduled deletion is due before the provided time.
+func (s *UserPrivacyScheduler) remindUsers(ctx context.Context, before time.Time) error {
+	deletions, err := s.searchDeletions(ctx, before, true)
+	if err != nil {
+		return err
+	}
+	for _, deletion := range deletions.Deletions {
+		if err = s.commands.AddUserDeletionReminder(ctx, deletion.ResourceOwner, deletion.UserID, deletion.DeletionDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *UserPrivacyScheduler) searchDeletions(ctx context.Context, before time.Time, notReminded bool) (*query.ScheduledUserDeletions, error) {
+	dueQuery, err := query.NewScheduledUserDeletionDeletionDateBeforeSearchQuery(before)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{dueQuery}
+	if notReminded {
+		remindedQuery, err := query.NewScheduledUserDeletionRemindedSearchQuery(false)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, remindedQuery)
+	}
+	return s.queries.SearchScheduledUserDeletions(ctx, &query.ScheduledUserDeletionSearchQueries{
+		SearchRequest: query.SearchRequest{Limit: uint64(s.config.UserPrivacy.BulkLimit)},
+		Queries:       queries,
+	})
+}
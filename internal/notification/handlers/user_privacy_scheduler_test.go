@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func TestUserPrivacyScheduler_trigger(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	deletionDate := now.Add(24 * time.Hour)
+	archive := &query.UserDataArchive{
+		ExportedAt: now,
+		User:       &query.User{ID: "user1", ResourceOwner: "org1", State: domain.UserStateActive},
+	}
+	archiveData, err := json.Marshal(archive)
+	assert.NoError(t, err)
+	tests := []struct {
+		name         string
+		remindBefore time.Duration
+		exports      []*query.UserDataExport
+		due          []*query.ScheduledUserDeletion
+		remind       []*query.ScheduledUserDeletion
+		expect       func(queries *mock.MockQueries, commands *mock.MockCommands)
+	}{
+		{
+			name: "nothing to do",
+		},
+		{
+			name: "data export completed",
+			exports: []*query.UserDataExport{
+				{ID: "export1", UserID: "user1", ResourceOwner: "org1", State: domain.UserDataExportStateRequested},
+			},
+			expect: func(queries *mock.MockQueries, commands *mock.MockCommands) {
+				queries.EXPECT().UserDataArchive(gomock.Any(), "user1").Return(archive, nil)
+				commands.EXPECT().CompleteUserDataExport(gomock.Any(), "org1", "user1", "export1", archiveData).Return(nil)
+			},
+		},
+		{
+			name: "due user removed",
+			due: []*query.ScheduledUserDeletion{
+				{UserID: "user1", ResourceOwner: "org1", DeletionDate: now},
+			},
+			expect: func(queries *mock.MockQueries, commands *mock.MockCommands) {
+				queries.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(&query.UserGrants{
+					UserGrants: []*query.UserGrant{{ID: "grant1"}},
+				}, nil)
+				queries.EXPECT().Memberships(gomock.Any(), gomock.Any(), false).Return(&query.Memberships{
+					Memberships: []*query.Membership{{UserID: "user1", ResourceOwner: "org1", Org: &query.OrgMembership{OrgID: "org1"}}},
+				}, nil)
+				commands.EXPECT().RemoveScheduledUser(gomock.Any(), "org1", "user1",
+					[]*command.CascadingMembership{{UserID: "user1", ResourceOwner: "org1", Org: &command.CascadingOrgMembership{OrgID: "org1"}}},
+					"grant1",
+				).Return(nil)
+			},
+		},
+		{
+			name:         "user reminded",
+			remindBefore: 48 * time.Hour,
+			remind: []*query.ScheduledUserDeletion{
+				{UserID: "user1", ResourceOwner: "org1", DeletionDate: deletionDate},
+			},
+			expect: func(_ *mock.MockQueries, commands *mock.MockCommands) {
+				commands.EXPECT().AddUserDeletionReminder(gomock.Any(), "org1", "user1", deletionDate).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			commands := mock.NewMockCommands(ctrl)
+			queries.EXPECT().SearchUserDataExports(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, search *query.UserDataExportSearchQueries) (*query.UserDataExports, error) {
+					assert.Equal(t, uint64(100), search.Limit)
+					return &query.UserDataExports{
+						SearchResponse: query.SearchResponse{Count: uint64(len(tt.exports))},
+						Exports:        tt.exports,
+					}, nil
+				},
+			)
+			calls := 1
+			if tt.remindBefore > 0 {
+				calls = 2
+			}
+			queries.EXPECT().SearchScheduledUserDeletions(gomock.Any(), gomock.Any()).Times(calls).DoAndReturn(
+				func(_ context.Context, search *query.ScheduledUserDeletionSearchQueries) (*query.ScheduledUserDeletions, error) {
+					deletions := tt.due
+					// the reminder search additionally filters the users, which were not yet reminded
+					if len(search.Queries) == 2 {
+						deletions = tt.remind
+					}
+					return &query.ScheduledUserDeletions{
+						SearchResponse: query.SearchResponse{Count: uint64(len(deletions))},
+						Deletions:      deletions,
+					}, nil
+				},
+			)
+			if tt.expect != nil {
+				tt.expect(queries, commands)
+			}
+			scheduler := NewUserPrivacyScheduler(
+				WorkerConfig{UserPrivacy: UserPrivacyConfig{RemindBefore: tt.remindBefore}},
+				commands,
+				NewNotificationQueries(queries, nil, externalDomain, externalPort, externalSecure, "", nil, nil, nil),
+			)
+			scheduler.now = func() time.Time { return now }
+			assert.NoError(t, scheduler.trigger(context.Background()))
+		})
+	}
+}
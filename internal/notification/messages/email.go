@@ -28,6 +28,9 @@ type Email struct {
 	Subject         string
 	Content         string
 	TriggeringEvent eventstore.Event
+
+	// MessageID is set by the sender and used to match bounces and delivery notifications of the relay
+	MessageID string
 }
 
 func (msg *Email) GetContent() (string, error) {
@@ -44,6 +47,9 @@ func (msg *Email) GetContent() (string, error) {
 	headers["To"] = strings.Join(msg.Recipients, ", ")
 	headers["Cc"] = strings.Join(msg.CC, ", ")
 	headers["Date"] = time.Now().Format(time.RFC1123Z)
+	if msg.MessageID != "" {
+		headers["Message-ID"] = "<" + msg.MessageID + ">"
+	}
 
 	message := ""
 	for k, v := range headers {
@@ -18,6 +18,10 @@ import (
 var (
 	projections []*handler.Handler
 	worker      *handlers.NotificationWorker
+	expiry      *handlers.PasswordExpiryNotifier
+	access      *handlers.AccessExpiryScheduler
+	inactivity  *handlers.InactivityScheduler
+	privacy     *handlers.UserPrivacyScheduler
 )
 
 func Register(
@@ -54,6 +58,10 @@ func Register(
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
 	worker = handlers.NewNotificationWorker(notificationWorkerConfig, commands, q, es, client, c)
+	expiry = handlers.NewPasswordExpiryNotifier(notificationWorkerConfig, commands, q)
+	access = handlers.NewAccessExpiryScheduler(notificationWorkerConfig, commands, q)
+	inactivity = handlers.NewInactivityScheduler(notificationWorkerConfig, commands, q)
+	privacy = handlers.NewUserPrivacyScheduler(notificationWorkerConfig, commands, q)
 }
 
 func Start(ctx context.Context) {
@@ -61,6 +69,10 @@ func Start(ctx context.Context) {
 		projection.Start(ctx)
 	}
 	worker.Start(ctx)
+	expiry.Start(ctx)
+	access.Start(ctx)
+	inactivity.Start(ctx)
+	privacy.Start(ctx)
 }
 
 func ProjectInstance(ctx context.Context) error {
@@ -0,0 +1,18 @@
+package senders
+
+// DeliveryInfo is filled by the notification types with the information about the handover to the provider
+type DeliveryInfo struct {
+	ProviderID        string
+	ProviderMessageID string
+	Recipient         string
+}
+
+// Set is a no-op on a nil DeliveryInfo, so callers not interested in the information can pass nil
+func (d *DeliveryInfo) Set(providerID, providerMessageID, recipient string) {
+	if d == nil {
+		return
+	}
+	d.ProviderID = providerID
+	d.ProviderMessageID = providerMessageID
+	d.Recipient = recipient
+}
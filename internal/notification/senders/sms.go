@@ -10,12 +10,22 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/messagebird"
+	"github.com/zitadel/zitadel/internal/notification/channels/smpp"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName      = "twilio.NotificationChannel"
+	vonageSpanName      = "vonage.NotificationChannel"
+	snsSpanName         = "sns.NotificationChannel"
+	messageBirdSpanName = "messagebird.NotificationChannel"
+	smppSpanName        = "smpp.NotificationChannel"
+)
 
 func SMSChannels(
 	ctx context.Context,
@@ -38,6 +48,54 @@ func SMSChannels(
 			),
 		)
 	}
+	if smsConfig.VonageConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				vonage.InitChannel(*smsConfig.VonageConfig),
+				vonageSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if smsConfig.SNSConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				sns.InitChannel(*smsConfig.SNSConfig),
+				snsSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if smsConfig.MessageBirdConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				messagebird.InitChannel(*smsConfig.MessageBirdConfig),
+				messageBirdSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if smsConfig.SMPPConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				smpp.InitChannel(*smsConfig.SMPPConfig),
+				smppSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
 	if smsConfig.WebhookConfig != nil {
 		webhookChannel, err := webhook.InitChannel(ctx, *smsConfig.WebhookConfig)
 		logging.WithFields(
@@ -0,0 +1,37 @@
+package templates
+
+import (
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// CompileMessageTemplate validates the uploaded template and returns the HTML used to render the messages.
+// MJML templates are compiled to HTML first.
+func CompileMessageTemplate(source string, format domain.MessageTemplateFormat) (string, error) {
+	mailhtml := source
+	switch format {
+	case domain.MessageTemplateFormatMJML:
+		compiled, err := CompileMJML(source)
+		if err != nil {
+			return "", err
+		}
+		mailhtml = compiled
+	case domain.MessageTemplateFormatHTML:
+	case domain.MessageTemplateFormatUnspecified:
+		fallthrough
+	default:
+		return "", errors.New("unsupported template format")
+	}
+	if err := ValidateTemplate(mailhtml); err != nil {
+		return "", err
+	}
+	return mailhtml, nil
+}
+
+// ValidateTemplate renders the template with empty data,
+// which reveals syntax errors as well as references to unknown fields
+func ValidateTemplate(mailhtml string) error {
+	_, err := GetParsedTemplate(mailhtml, TemplateData{})
+	return err
+}
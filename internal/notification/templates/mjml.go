@@ -0,0 +1,812 @@
+package templates
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The MJML compiler supports the subset of https://documentation.mjml.io used for notification mails.
+// Go template actions (e.g. {{if .IncludeFooter}}) can be placed between components and are kept as they are.
+
+const (
+	mjmlDefaultBodyWidth  = 600
+	mjmlDefaultBreakpoint = "480px"
+	mjmlDefaultFontFamily = "Ubuntu, Helvetica, Arial, sans-serif"
+)
+
+var mjmlDefaults = map[string]map[string]string{
+	"mj-body":    {"width": "600px"},
+	"mj-wrapper": {"padding": "20px 0", "text-align": "center"},
+	"mj-section": {"padding": "20px 0", "text-align": "center"},
+	"mj-group":   {},
+	"mj-column":  {},
+	"mj-text": {
+		"align":       "left",
+		"color":       "#000000",
+		"font-family": mjmlDefaultFontFamily,
+		"font-size":   "13px",
+		"line-height": "1",
+		"padding":     "10px 25px",
+	},
+	"mj-image": {
+		"align":   "center",
+		"height":  "auto",
+		"padding": "10px 25px",
+	},
+	"mj-button": {
+		"align":            "center",
+		"background-color": "#414141",
+		"border":           "none",
+		"border-radius":    "3px",
+		"color":            "#ffffff",
+		"font-family":      mjmlDefaultFontFamily,
+		"font-size":        "13px",
+		"font-weight":      "normal",
+		"inner-padding":    "10px 25px",
+		"line-height":      "120%",
+		"padding":          "10px 25px",
+		"target":           "_blank",
+		"text-decoration":  "none",
+		"text-transform":   "none",
+		"vertical-align":   "middle",
+	},
+	"mj-divider": {
+		"align":        "center",
+		"border-color": "#000000",
+		"border-style": "solid",
+		"border-width": "4px",
+		"padding":      "10px 25px",
+		"width":        "100%",
+	},
+	"mj-spacer": {"height": "20px"},
+	"mj-table": {
+		"align":        "left",
+		"border":       "none",
+		"cellpadding":  "0",
+		"cellspacing":  "0",
+		"color":        "#000000",
+		"font-family":  mjmlDefaultFontFamily,
+		"font-size":    "13px",
+		"line-height":  "22px",
+		"padding":      "10px 25px",
+		"table-layout": "auto",
+		"width":        "100%",
+	},
+	"mj-raw": {},
+}
+
+// mjmlChildren defines which components are allowed inside of a component
+var mjmlChildren = map[string]map[string]bool{
+	"mjml":       {"mj-head": true, "mj-body": true},
+	"mj-head":    {"mj-attributes": true, "mj-breakpoint": true, "mj-font": true, "mj-preview": true, "mj-raw": true, "mj-style": true, "mj-title": true},
+	"mj-body":    {"mj-raw": true, "mj-section": true, "mj-wrapper": true},
+	"mj-wrapper": {"mj-raw": true, "mj-section": true},
+	"mj-section": {"mj-column": true, "mj-group": true, "mj-raw": true},
+	"mj-group":   {"mj-column": true, "mj-raw": true},
+	"mj-column":  {"mj-button": true, "mj-divider": true, "mj-image": true, "mj-raw": true, "mj-spacer": true, "mj-table": true, "mj-text": true},
+}
+
+// mjmlEndingTags contain HTML or text instead of components
+var mjmlEndingTags = map[string]bool{
+	"mj-button":  true,
+	"mj-preview": true,
+	"mj-raw":     true,
+	"mj-style":   true,
+	"mj-table":   true,
+	"mj-text":    true,
+	"mj-title":   true,
+}
+
+// UnsupportedTagError is returned for tags which are not part of the supported subset of MJML
+type UnsupportedTagError struct {
+	Tag string
+}
+
+func (e *UnsupportedTagError) Error() string {
+	return fmt.Sprintf("mjml: <%s> is not supported", e.Tag)
+}
+
+// mjmlSupportedTag reports whether the tag is part of the supported subset of MJML
+func mjmlSupportedTag(name string) bool {
+	if _, ok := mjmlChildren[name]; ok {
+		return true
+	}
+	if _, ok := mjmlDefaults[name]; ok {
+		return true
+	}
+	return mjmlChildren["mj-head"][name] || name == "mj-all" || name == "mj-class"
+}
+
+type mjmlNode struct {
+	name     string
+	attrs    map[string]string
+	children []*mjmlNode
+	// content is the markup of ending tags or the text of text nodes (name is empty)
+	content string
+}
+
+// CompileMJML compiles the MJML document into HTML
+func CompileMJML(source string) (string, error) {
+	root, err := parseMJML(source)
+	if err != nil {
+		return "", err
+	}
+	return newMJMLRenderer().render(root)
+}
+
+func parseMJML(source string) (*mjmlNode, error) {
+	decoder := newMJMLDecoder(source)
+	var root *mjmlNode
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("mjml: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root != nil {
+				return nil, errors.New("mjml: only one root element is allowed")
+			}
+			if t.Name.Local != "mjml" {
+				return nil, fmt.Errorf("mjml: root element must be <mjml> but is <%s>", t.Name.Local)
+			}
+			root, err = parseMJMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+		case xml.CharData:
+			if strings.TrimSpace(string(t)) != "" {
+				return nil, errors.New("mjml: text outside of <mjml> is not allowed")
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New("mjml: <mjml> root element missing")
+	}
+	return root, nil
+}
+
+func newMJMLDecoder(source string) *xml.Decoder {
+	decoder := xml.NewDecoder(strings.NewReader(source))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+	return decoder
+}
+
+func parseMJMLElement(decoder *xml.Decoder, start xml.StartElement) (*mjmlNode, error) {
+	node := &mjmlNode{
+		name:  start.Name.Local,
+		attrs: make(map[string]string, len(start.Attr)),
+	}
+	for _, attr := range start.Attr {
+		node.attrs[attr.Name.Local] = attr.Value
+	}
+	if mjmlEndingTags[node.name] {
+		content, err := readInnerMarkup(decoder, node.name)
+		node.content = content
+		return node, err
+	}
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("mjml: <%s> is not closed: %w", node.name, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if !mjmlSupportedTag(t.Name.Local) {
+				return nil, &UnsupportedTagError{Tag: t.Name.Local}
+			}
+			if !mjmlChildAllowed(node.name, t.Name.Local) {
+				return nil, fmt.Errorf("mjml: <%s> is not allowed inside <%s>", t.Name.Local, node.name)
+			}
+			child, err := parseMJMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+		case xml.EndElement:
+			return node, nil
+		case xml.CharData:
+			if strings.TrimSpace(string(t)) != "" {
+				node.children = append(node.children, &mjmlNode{content: string(t)})
+			}
+		}
+	}
+}
+
+func mjmlChildAllowed(parent, child string) bool {
+	if parent == "mj-attributes" {
+		_, isComponent := mjmlDefaults[child]
+		return isComponent || child == "mj-all" || child == "mj-class" || child == "mj-font"
+	}
+	return mjmlChildren[parent][child]
+}
+
+// readInnerMarkup returns the content of an ending tag as HTML
+func readInnerMarkup(decoder *xml.Decoder, name string) (string, error) {
+	var b strings.Builder
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("mjml: <%s> is not closed: %w", name, err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			b.WriteString("<" + t.Name.Local)
+			for _, attr := range t.Attr {
+				b.WriteString(" " + attr.Name.Local + `="` + escapeMJMLAttr(attr.Value) + `"`)
+			}
+			b.WriteString(">")
+		case xml.EndElement:
+			if depth == 0 {
+				return b.String(), nil
+			}
+			depth--
+			if !isVoidElement(t.Name.Local) {
+				b.WriteString("</" + t.Name.Local + ">")
+			}
+		case xml.CharData:
+			if name == "mj-style" {
+				b.Write(t)
+				continue
+			}
+			b.WriteString(escapeMJMLText(string(t)))
+		case xml.Comment:
+			b.WriteString("<!--" + string(t) + "-->")
+		}
+	}
+}
+
+func isVoidElement(name string) bool {
+	for _, void := range xml.HTMLAutoClose {
+		if strings.EqualFold(void, name) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	mjmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	mjmlAttrEscaper = strings.NewReplacer("&", "&amp;", `"`, "&#34;")
+)
+
+func escapeMJMLText(s string) string {
+	return mjmlTextEscaper.Replace(s)
+}
+
+func escapeMJMLAttr(s string) string {
+	return mjmlAttrEscaper.Replace(s)
+}
+
+type mjmlRenderer struct {
+	// defaults are set by mj-attributes, mj-all is stored as its own component
+	defaults map[string]map[string]string
+	classes  map[string]map[string]string
+	fonts    map[string]string
+
+	title        string
+	preview      string
+	breakpoint   string
+	styles       []string
+	mediaQueries map[string]string
+	fontFamilies []string
+}
+
+func newMJMLRenderer() *mjmlRenderer {
+	return &mjmlRenderer{
+		defaults:     make(map[string]map[string]string),
+		classes:      make(map[string]map[string]string),
+		fonts:        make(map[string]string),
+		breakpoint:   mjmlDefaultBreakpoint,
+		mediaQueries: make(map[string]string),
+	}
+}
+
+func (r *mjmlRenderer) attr(node *mjmlNode, name string) string {
+	if value, ok := node.attrs[name]; ok {
+		return value
+	}
+	for _, class := range strings.Fields(node.attrs["mj-class"]) {
+		if value, ok := r.classes[class][name]; ok {
+			return value
+		}
+	}
+	if value, ok := r.defaults[node.name][name]; ok {
+		return value
+	}
+	if value, ok := r.defaults["mj-all"][name]; ok {
+		return value
+	}
+	return mjmlDefaults[node.name][name]
+}
+
+func (r *mjmlRenderer) render(root *mjmlNode) (string, error) {
+	var head, body *mjmlNode
+	for _, child := range root.children {
+		switch child.name {
+		case "mj-head":
+			head = child
+		case "mj-body":
+			body = child
+		}
+	}
+	if body == nil {
+		return "", errors.New("mjml: <mj-body> missing")
+	}
+	if head != nil {
+		r.readHead(head)
+	}
+	renderedBody := r.renderBody(body)
+
+	var b strings.Builder
+	b.WriteString(`<!doctype html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:v="urn:schemas-microsoft-com:vml" xmlns:o="urn:schemas-microsoft-com:office:office">
+<head>
+<title>` + r.title + `</title>
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta http-equiv="Content-Type" content="text/html; charset=UTF-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<style type="text/css">
+#outlook a { padding:0; }
+body { margin:0;padding:0;-webkit-text-size-adjust:100%;-ms-text-size-adjust:100%; }
+table, td { border-collapse:collapse;mso-table-lspace:0pt;mso-table-rspace:0pt; }
+img { border:0;height:auto;line-height:100%; outline:none;text-decoration:none;-ms-interpolation-mode:bicubic; }
+p { display:block;margin:13px 0; }
+</style>
+`)
+	r.writeFonts(&b)
+	r.writeMediaQueries(&b)
+	for _, style := range r.styles {
+		b.WriteString("<style type=\"text/css\">" + style + "</style>\n")
+	}
+	b.WriteString("</head>\n")
+	b.WriteString(renderedBody)
+	b.WriteString("</html>\n")
+	return b.String(), nil
+}
+
+func (r *mjmlRenderer) readHead(head *mjmlNode) {
+	for _, child := range head.children {
+		switch child.name {
+		case "mj-attributes":
+			r.readAttributes(child)
+		case "mj-breakpoint":
+			if width := child.attrs["width"]; width != "" {
+				r.breakpoint = width
+			}
+		case "mj-font":
+			r.fonts[child.attrs["name"]] = child.attrs["href"]
+		case "mj-preview":
+			r.preview = child.content
+		case "mj-style", "mj-raw":
+			r.styles = append(r.styles, child.content)
+		case "mj-title":
+			r.title = child.content
+		}
+	}
+}
+
+func (r *mjmlRenderer) readAttributes(attributes *mjmlNode) {
+	for _, child := range attributes.children {
+		switch child.name {
+		case "mj-class":
+			r.classes[child.attrs["name"]] = withoutAttr(child.attrs, "name")
+		case "mj-font":
+			r.fonts[child.attrs["name"]] = child.attrs["href"]
+		case "":
+		default:
+			if r.defaults[child.name] == nil {
+				r.defaults[child.name] = make(map[string]string, len(child.attrs))
+			}
+			for name, value := range child.attrs {
+				r.defaults[child.name][name] = value
+			}
+		}
+	}
+}
+
+func withoutAttr(attrs map[string]string, name string) map[string]string {
+	filtered := make(map[string]string, len(attrs))
+	for key, value := range attrs {
+		if key != name {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+func (r *mjmlRenderer) writeFonts(b *strings.Builder) {
+	names := make([]string, 0, len(r.fonts))
+	for name, href := range r.fonts {
+		if name == "" || href == "" || !r.fontUsed(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		href := escapeMJMLAttr(r.fonts[name])
+		b.WriteString(`<link href="` + href + `" rel="stylesheet" type="text/css">` + "\n")
+		b.WriteString(`<style type="text/css">@import url(` + href + `);</style>` + "\n")
+	}
+}
+
+func (r *mjmlRenderer) fontUsed(name string) bool {
+	for _, family := range r.fontFamilies {
+		if strings.Contains(family, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *mjmlRenderer) writeMediaQueries(b *strings.Builder) {
+	if len(r.mediaQueries) == 0 {
+		return
+	}
+	classes := make([]string, 0, len(r.mediaQueries))
+	for class := range r.mediaQueries {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	b.WriteString("<style type=\"text/css\">\n@media only screen and (min-width:" + r.breakpoint + ") {\n")
+	for _, class := range classes {
+		width := r.mediaQueries[class]
+		b.WriteString("." + class + " { width:" + width + " !important; max-width: " + width + "; }\n")
+	}
+	b.WriteString("}\n</style>\n")
+}
+
+func (r *mjmlRenderer) renderBody(body *mjmlNode) string {
+	width := pixels(r.attr(body, "width"), mjmlDefaultBodyWidth)
+	background := r.attr(body, "background-color")
+
+	var b strings.Builder
+	b.WriteString(`<body style="word-spacing:normal;` + css("background-color", background) + `">` + "\n")
+	if r.preview != "" {
+		b.WriteString(`<div style="display:none;font-size:1px;color:#ffffff;line-height:1px;max-height:0px;max-width:0px;opacity:0;overflow:hidden;">` + r.preview + "</div>\n")
+	}
+	b.WriteString(`<div class="` + escapeMJMLAttr(r.attr(body, "css-class")) + `" style="` + css("background-color", background) + css("border-radius", r.attr(body, "border-radius")) + `">` + "\n")
+	for _, child := range body.children {
+		switch child.name {
+		case "mj-wrapper", "mj-section":
+			b.WriteString(r.renderSection(child, width, true))
+		default:
+			b.WriteString(child.content)
+		}
+	}
+	b.WriteString("</div>\n</body>\n")
+	return b.String()
+}
+
+// renderSection renders mj-section and mj-wrapper, where a wrapper contains sections instead of columns
+func (r *mjmlRenderer) renderSection(section *mjmlNode, width int, allowFullWidth bool) string {
+	background := r.attr(section, "background-color")
+	radius := r.attr(section, "border-radius")
+	fullWidth := allowFullWidth && r.attr(section, "full-width") == "full-width"
+	paddings := r.padding(section, "padding")
+	innerWidth := width - pixels(paddings[1], 0) - pixels(paddings[3], 0)
+
+	var content strings.Builder
+	if section.name == "mj-wrapper" {
+		for _, child := range section.children {
+			if child.name == "mj-section" {
+				content.WriteString(r.renderSection(child, innerWidth, false))
+				continue
+			}
+			content.WriteString(child.content)
+		}
+	} else {
+		content.WriteString(r.renderColumns(section.children, innerWidth))
+	}
+
+	var b strings.Builder
+	if fullWidth {
+		b.WriteString(`<table align="center" border="0" cellpadding="0" cellspacing="0" role="presentation" style="` + css("background", background) + css("background-color", background) + `width:100%;` + css("border-radius", radius) + `"><tbody><tr><td>` + "\n")
+	}
+	divStyle := "margin:0px auto;max-width:" + strconv.Itoa(width) + "px;"
+	if !fullWidth {
+		divStyle += css("background", background) + css("background-color", background)
+	}
+	if radius != "" {
+		divStyle += css("border-radius", radius) + "overflow:hidden;"
+	}
+	b.WriteString(`<div class="` + escapeMJMLAttr(r.attr(section, "css-class")) + `" style="` + divStyle + `">` + "\n")
+	tableStyle := "width:100%;" + css("border-radius", radius)
+	if !fullWidth {
+		tableStyle = css("background", background) + css("background-color", background) + tableStyle
+	}
+	b.WriteString(`<table align="center" border="0" cellpadding="0" cellspacing="0" role="presentation" style="` + tableStyle + `"><tbody><tr>` + "\n")
+	b.WriteString(`<td style="direction:ltr;font-size:0px;` + r.paddingCSS(section) + css("text-align", r.attr(section, "text-align")) + `">` + "\n")
+	b.WriteString(content.String())
+	b.WriteString("</td>\n</tr></tbody></table>\n</div>\n")
+	if fullWidth {
+		b.WriteString("</td></tr></tbody></table>\n")
+	}
+	return b.String()
+}
+
+func (r *mjmlRenderer) renderColumns(children []*mjmlNode, width int) string {
+	count := 0
+	for _, child := range children {
+		if child.name == "mj-column" || child.name == "mj-group" {
+			count++
+		}
+	}
+	var b strings.Builder
+	for _, child := range children {
+		switch child.name {
+		case "mj-column":
+			b.WriteString(r.renderColumn(child, width, count, false))
+		case "mj-group":
+			b.WriteString(r.renderGroup(child, width, count))
+		default:
+			b.WriteString(child.content)
+		}
+	}
+	return b.String()
+}
+
+// columnWidth returns the css width, the width in pixels and the class used for the media query
+func (r *mjmlRenderer) columnWidth(column *mjmlNode, parentWidth, siblings int) (string, int, string) {
+	width := r.attr(column, "width")
+	if width == "" {
+		width = formatPercent(100 / float64(siblings))
+	}
+	if percent, ok := strings.CutSuffix(width, "%"); ok {
+		value, err := strconv.ParseFloat(percent, 64)
+		if err == nil {
+			return width, int(float64(parentWidth) * value / 100), "mj-column-per-" + strings.ReplaceAll(percent, ".", "-")
+		}
+	}
+	px := pixels(width, parentWidth)
+	return strconv.Itoa(px) + "px", px, "mj-column-px-" + strconv.Itoa(px)
+}
+
+func (r *mjmlRenderer) renderGroup(group *mjmlNode, width, siblings int) string {
+	cssWidth, groupWidth, class := r.columnWidth(group, width, siblings)
+	r.mediaQueries[class] = cssWidth
+
+	count := 0
+	for _, child := range group.children {
+		if child.name == "mj-column" {
+			count++
+		}
+	}
+	var b strings.Builder
+	b.WriteString(`<div class="` + class + ` mj-outlook-group-fix ` + escapeMJMLAttr(r.attr(group, "css-class")) + `" style="font-size:0;line-height:0;text-align:left;display:inline-block;width:100%;direction:ltr;` + css("background-color", r.attr(group, "background-color")) + `">` + "\n")
+	for _, child := range group.children {
+		if child.name == "mj-column" {
+			b.WriteString(r.renderColumn(child, groupWidth, count, true))
+			continue
+		}
+		b.WriteString(child.content)
+	}
+	b.WriteString("</div>\n")
+	return b.String()
+}
+
+func (r *mjmlRenderer) renderColumn(column *mjmlNode, width, siblings int, inGroup bool) string {
+	cssWidth, columnWidth, class := r.columnWidth(column, width, siblings)
+	displayWidth := "100%"
+	if inGroup {
+		displayWidth = cssWidth
+	} else {
+		r.mediaQueries[class] = cssWidth
+	}
+	paddings := r.padding(column, "padding")
+	contentWidth := columnWidth - pixels(paddings[1], 0) - pixels(paddings[3], 0)
+	tableStyle := css("background-color", r.attr(column, "background-color")) + css("border-radius", r.attr(column, "border-radius")) + "vertical-align:" + defaultString(r.attr(column, "vertical-align"), "top") + ";"
+
+	var b strings.Builder
+	b.WriteString(`<div class="` + class + ` mj-outlook-group-fix ` + escapeMJMLAttr(r.attr(column, "css-class")) + `" style="font-size:0px;text-align:left;direction:ltr;display:inline-block;vertical-align:top;width:` + displayWidth + `;">` + "\n")
+	hasPadding := r.paddingCSS(column) != ""
+	if hasPadding {
+		b.WriteString(`<table border="0" cellpadding="0" cellspacing="0" role="presentation" width="100%"><tbody><tr><td style="` + r.paddingCSS(column) + tableStyle + `">` + "\n")
+		tableStyle = ""
+	}
+	b.WriteString(`<table border="0" cellpadding="0" cellspacing="0" role="presentation" style="` + tableStyle + `" width="100%"><tbody>` + "\n")
+	for _, child := range column.children {
+		if child.name == "" || child.name == "mj-raw" {
+			b.WriteString(child.content)
+			continue
+		}
+		b.WriteString(`<tr><td align="` + escapeMJMLAttr(r.attr(child, "align")) + `" class="` + escapeMJMLAttr(r.attr(child, "css-class")) + `" style="` + css("background", r.attr(child, "container-background-color")) + `font-size:0px;` + r.paddingCSS(child) + `word-break:break-word;">` + "\n")
+		childPaddings := r.padding(child, "padding")
+		b.WriteString(r.renderContent(child, contentWidth-pixels(childPaddings[1], 0)-pixels(childPaddings[3], 0)))
+		b.WriteString("\n</td></tr>\n")
+	}
+	b.WriteString("</tbody></table>\n")
+	if hasPadding {
+		b.WriteString("</td></tr></tbody></table>\n")
+	}
+	b.WriteString("</div>\n")
+	return b.String()
+}
+
+func (r *mjmlRenderer) renderContent(node *mjmlNode, width int) string {
+	switch node.name {
+	case "mj-text":
+		return r.renderText(node)
+	case "mj-image":
+		return r.renderImage(node, width)
+	case "mj-button":
+		return r.renderButton(node)
+	case "mj-divider":
+		return r.renderDivider(node)
+	case "mj-spacer":
+		height := r.attr(node, "height")
+		return `<div style="height:` + height + `;line-height:` + height + `;">&#8202;</div>`
+	case "mj-table":
+		return r.renderTable(node)
+	default:
+		return node.content
+	}
+}
+
+func (r *mjmlRenderer) fontFamily(node *mjmlNode) string {
+	family := r.attr(node, "font-family")
+	r.fontFamilies = append(r.fontFamilies, family)
+	return family
+}
+
+func (r *mjmlRenderer) renderText(node *mjmlNode) string {
+	style := css("font-family", r.fontFamily(node)) +
+		css("font-size", r.attr(node, "font-size")) +
+		css("font-style", r.attr(node, "font-style")) +
+		css("font-weight", r.attr(node, "font-weight")) +
+		css("letter-spacing", r.attr(node, "letter-spacing")) +
+		css("line-height", r.attr(node, "line-height")) +
+		css("text-align", r.attr(node, "align")) +
+		css("text-decoration", r.attr(node, "text-decoration")) +
+		css("text-transform", r.attr(node, "text-transform")) +
+		css("color", r.attr(node, "color")) +
+		css("height", r.attr(node, "height"))
+	return `<div style="` + style + `">` + node.content + `</div>`
+}
+
+func (r *mjmlRenderer) renderImage(node *mjmlNode, boxWidth int) string {
+	width := boxWidth
+	if attrWidth := pixels(r.attr(node, "width"), 0); attrWidth > 0 && attrWidth < boxWidth {
+		width = attrWidth
+	}
+	height := r.attr(node, "height")
+	img := `<img alt="` + escapeMJMLAttr(r.attr(node, "alt")) + `" height="` + escapeMJMLAttr(strings.TrimSuffix(height, "px")) + `" src="` + escapeMJMLAttr(r.attr(node, "src")) + `" style="border:0;` + css("border-radius", r.attr(node, "border-radius")) + `display:block;outline:none;text-decoration:none;` + css("height", height) + `width:100%;font-size:13px;" title="` + escapeMJMLAttr(r.attr(node, "title")) + `" width="` + strconv.Itoa(width) + `">`
+	if href := r.attr(node, "href"); href != "" {
+		img = `<a href="` + escapeMJMLAttr(href) + `" target="` + escapeMJMLAttr(defaultString(r.attr(node, "target"), "_blank")) + `"` + optionalAttr("rel", r.attr(node, "rel")) + `>` + img + `</a>`
+	}
+	return `<table border="0" cellpadding="0" cellspacing="0" role="presentation" style="border-collapse:collapse;border-spacing:0px;"><tbody><tr><td style="width:` + strconv.Itoa(width) + `px;">` + img + `</td></tr></tbody></table>`
+}
+
+func (r *mjmlRenderer) renderButton(node *mjmlNode) string {
+	background := r.attr(node, "background-color")
+	radius := r.attr(node, "border-radius")
+	innerPadding := r.attr(node, "inner-padding")
+	linkStyle := "display:inline-block;" +
+		css("width", r.attr(node, "width")) +
+		css("background", background) +
+		css("color", r.attr(node, "color")) +
+		css("font-family", r.fontFamily(node)) +
+		css("font-size", r.attr(node, "font-size")) +
+		css("font-style", r.attr(node, "font-style")) +
+		css("font-weight", r.attr(node, "font-weight")) +
+		css("line-height", r.attr(node, "line-height")) +
+		css("letter-spacing", r.attr(node, "letter-spacing")) +
+		"margin:0;" +
+		css("text-decoration", r.attr(node, "text-decoration")) +
+		css("text-transform", r.attr(node, "text-transform")) +
+		css("padding", innerPadding) +
+		"mso-padding-alt:0px;" +
+		css("border-radius", radius)
+	tag := "p"
+	linkAttrs := ""
+	if href := r.attr(node, "href"); href != "" {
+		tag = "a"
+		linkAttrs = ` href="` + escapeMJMLAttr(href) + `"` + optionalAttr("rel", r.attr(node, "rel")) + optionalAttr("target", r.attr(node, "target"))
+	}
+	return `<table border="0" cellpadding="0" cellspacing="0" role="presentation" style="border-collapse:separate;` + css("width", r.attr(node, "width")) + `line-height:100%;"><tbody><tr>` +
+		`<td align="center" bgcolor="` + escapeMJMLAttr(background) + `" role="presentation" style="` + css("border", r.attr(node, "border")) + css("border-radius", radius) + `cursor:auto;` + css("mso-padding-alt", innerPadding) + css("background", background) + `" valign="` + escapeMJMLAttr(r.attr(node, "vertical-align")) + `">` +
+		`<` + tag + linkAttrs + ` style="` + linkStyle + `">` + node.content + `</` + tag + `>` +
+		`</td></tr></tbody></table>`
+}
+
+func (r *mjmlRenderer) renderDivider(node *mjmlNode) string {
+	border := r.attr(node, "border-style") + " " + r.attr(node, "border-width") + " " + r.attr(node, "border-color")
+	margin := "0px auto"
+	switch r.attr(node, "align") {
+	case "left":
+		margin = "0px"
+	case "right":
+		margin = "0px 0px 0px auto"
+	}
+	return `<p style="border-top:` + border + `;font-size:1px;margin:` + margin + `;` + css("width", r.attr(node, "width")) + `"></p>`
+}
+
+func (r *mjmlRenderer) renderTable(node *mjmlNode) string {
+	width := r.attr(node, "width")
+	style := css("color", r.attr(node, "color")) +
+		css("font-family", r.fontFamily(node)) +
+		css("font-size", r.attr(node, "font-size")) +
+		css("line-height", r.attr(node, "line-height")) +
+		css("table-layout", r.attr(node, "table-layout")) +
+		css("width", width) +
+		css("border", r.attr(node, "border"))
+	return `<table cellpadding="` + escapeMJMLAttr(r.attr(node, "cellpadding")) + `" cellspacing="` + escapeMJMLAttr(r.attr(node, "cellspacing")) + `" width="` + escapeMJMLAttr(strings.TrimSuffix(width, "px")) + `" border="0" style="` + style + `">` + node.content + `</table>`
+}
+
+// padding returns the top, right, bottom and left padding of the node, the single sides take precedence over the shorthand
+func (r *mjmlRenderer) padding(node *mjmlNode, attr string) [4]string {
+	var sides [4]string
+	values := strings.Fields(r.attr(node, attr))
+	switch len(values) {
+	case 1:
+		sides = [4]string{values[0], values[0], values[0], values[0]}
+	case 2:
+		sides = [4]string{values[0], values[1], values[0], values[1]}
+	case 3:
+		sides = [4]string{values[0], values[1], values[2], values[1]}
+	case 4:
+		sides = [4]string{values[0], values[1], values[2], values[3]}
+	}
+	for i, side := range []string{"top", "right", "bottom", "left"} {
+		if value := r.attr(node, attr+"-"+side); value != "" {
+			sides[i] = value
+		}
+	}
+	return sides
+}
+
+func (r *mjmlRenderer) paddingCSS(node *mjmlNode) string {
+	style := css("padding", r.attr(node, "padding"))
+	for _, side := range []string{"top", "right", "bottom", "left"} {
+		style += css("padding-"+side, r.attr(node, "padding-"+side))
+	}
+	return style
+}
+
+func css(property, value string) string {
+	if value == "" {
+		return ""
+	}
+	return property + ":" + escapeMJMLAttr(value) + ";"
+}
+
+func optionalAttr(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	return " " + name + `="` + escapeMJMLAttr(value) + `"`
+}
+
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// pixels parses values like 600px or 600, fallback is returned for any other unit
+func pixels(value string, fallback int) int {
+	px, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "px"))
+	if err != nil {
+		return fallback
+	}
+	return px
+}
+
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64) + "%"
+}
@@ -0,0 +1,174 @@
+package templates
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the MJML components in testdata/mjml")
+
+func TestCompileMJML(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		contains []string
+		wantErr  bool
+	}{
+		{
+			name:    "no mjml root",
+			source:  `<html><body></body></html>`,
+			wantErr: true,
+		},
+		{
+			name:    "body missing",
+			source:  `<mjml><mj-head></mj-head></mjml>`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown component",
+			source:  `<mjml><mj-body><mj-section><mj-column><mj-carousel></mj-carousel></mj-column></mj-section></mj-body></mjml>`,
+			wantErr: true,
+		},
+		{
+			name:    "html outside of ending tag",
+			source:  `<mjml><mj-body><mj-section><mj-column><div>text</div></mj-column></mj-section></mj-body></mjml>`,
+			wantErr: true,
+		},
+		{
+			name:    "column outside of section",
+			source:  `<mjml><mj-body><mj-column></mj-column></mj-body></mjml>`,
+			wantErr: true,
+		},
+		{
+			name:    "not closed",
+			source:  `<mjml><mj-body><mj-section>`,
+			wantErr: true,
+		},
+		{
+			name: "content and attributes",
+			source: `<mjml>
+  <mj-head>
+    <mj-title>{{.Title}}</mj-title>
+    <mj-attributes>
+      <mj-text color="#111111" />
+      <mj-class name="big" font-size="24px" />
+    </mj-attributes>
+    <mj-style>.footer > a { color: red; }</mj-style>
+  </mj-head>
+  <mj-body width="800px">
+    <mj-section background-color="{{.BackgroundColor}}">
+      <mj-column width="50%">
+        <mj-text mj-class="big">{{.Greeting}}<br>&amp; welcome</mj-text>
+      </mj-column>
+      <mj-column>
+        {{if .IncludeFooter}}
+        <mj-button href="{{.URL}}" background-color="{{.PrimaryColor}}">{{.ButtonText}}</mj-button>
+        {{end}}
+        <mj-image src="{{.LogoURL}}" width="1000px" />
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`,
+			contains: []string{
+				"<title>{{.Title}}</title>",
+				".footer > a { color: red; }",
+				"max-width:800px;background:{{.BackgroundColor}}",
+				".mj-column-per-50 { width:50% !important; max-width: 50%; }",
+				"color:#111111;",
+				"font-size:24px;",
+				"{{.Greeting}}<br>&amp; welcome",
+				`<a href="{{.URL}}" target="_blank" style="display:inline-block;background:{{.PrimaryColor}};`,
+				"{{if .IncludeFooter}}",
+				`src="{{.LogoURL}}"`,
+				`width="350"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompileMJML(tt.source)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, c := range tt.contains {
+				assert.Contains(t, got, c)
+			}
+		})
+	}
+}
+
+// TestCompileMJML_golden compiles a template per supported component and compares it with the HTML in the golden file.
+// Run the tests with -update to regenerate the golden files after a change of the compiler.
+func TestCompileMJML_golden(t *testing.T) {
+	components := make([]string, 0, len(mjmlDefaults)+len(mjmlChildren["mj-head"]))
+	for component := range mjmlDefaults {
+		components = append(components, component)
+	}
+	for component := range mjmlChildren["mj-head"] {
+		if _, ok := mjmlDefaults[component]; !ok {
+			components = append(components, component)
+		}
+	}
+	for _, component := range components {
+		t.Run(component, func(t *testing.T) {
+			source, err := os.ReadFile(filepath.Join("testdata", "mjml", component+".mjml"))
+			require.NoError(t, err)
+			got, err := CompileMJML(string(source))
+			require.NoError(t, err)
+
+			golden := filepath.Join("testdata", "mjml", component+".html")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(golden, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
+
+func TestCompileMJML_unsupportedTag(t *testing.T) {
+	_, err := CompileMJML(`<mjml><mj-body><mj-section><mj-column><mj-carousel></mj-carousel></mj-column></mj-section></mj-body></mjml>`)
+	var unsupported *UnsupportedTagError
+	require.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, "mj-carousel", unsupported.Tag)
+
+	// supported components at the wrong position are invalid, but not unsupported
+	_, err = CompileMJML(`<mjml><mj-body><mj-column></mj-column></mj-body></mjml>`)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &unsupported))
+}
+
+func TestCompileMessageTemplate_defaultTemplate(t *testing.T) {
+	source, err := os.ReadFile("../static/templates/template.mjml")
+	require.NoError(t, err)
+
+	mailhtml, err := CompileMessageTemplate(string(source), domain.MessageTemplateFormatMJML)
+	require.NoError(t, err)
+
+	got, err := GetParsedTemplate(mailhtml, TemplateData{
+		Greeting:   "Hello",
+		URL:        "https://example.com",
+		ButtonText: "Click",
+		LogoURL:    "https://example.com/logo.png",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, got, "Hello")
+	assert.Contains(t, got, `href="https://example.com"`)
+	assert.Contains(t, got, `src="https://example.com/logo.png"`)
+}
+
+func TestCompileMessageTemplate_unknownField(t *testing.T) {
+	_, err := CompileMessageTemplate(`<html><body>{{.Unknown}}</body></html>`, domain.MessageTemplateFormatHTML)
+	require.Error(t, err)
+}
@@ -40,6 +40,7 @@ func SendEmail(
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
 	triggeringEvent eventstore.Event,
+	deliveryInfo *senders.DeliveryInfo,
 ) Notify {
 	return func(
 		urlTmpl string,
@@ -67,6 +68,7 @@ func SendEmail(
 			args,
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
+			deliveryInfo,
 		)
 	}
 }
@@ -104,6 +106,7 @@ func SendSMS(
 	colors *query.LabelPolicy,
 	triggeringEvent eventstore.Event,
 	generatorInfo *senders.CodeGeneratorInfo,
+	deliveryInfo *senders.DeliveryInfo,
 ) Notify {
 	return func(
 		urlTmpl string,
@@ -126,6 +129,7 @@ func SendSMS(
 			allowUnverifiedNotificationChannel,
 			triggeringEvent,
 			generatorInfo,
+			deliveryInfo,
 		)
 	}
 }
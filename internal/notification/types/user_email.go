@@ -9,6 +9,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -23,6 +24,7 @@ func generateEmail(
 	args map[string]interface{},
 	lastEmail bool,
 	triggeringEvent eventstore.Event,
+	deliveryInfo *senders.DeliveryInfo,
 ) error {
 	emailChannels, config, err := channels.Email(ctx)
 	logging.OnError(err).Error("could not create email channel")
@@ -40,7 +42,11 @@ func generateEmail(
 			Content:         html.UnescapeString(template),
 			TriggeringEvent: triggeringEvent,
 		}
-		return emailChannels.HandleMessage(message)
+		if err := emailChannels.HandleMessage(message); err != nil {
+			return err
+		}
+		deliveryInfo.Set(config.ProviderConfig.GetID(), message.MessageID, recipient)
+		return nil
 	}
 	if config.WebhookConfig != nil {
 		caseArgs := make(map[string]interface{}, len(args))
@@ -65,7 +71,11 @@ func generateEmail(
 		if err != nil {
 			return err
 		}
-		return webhookChannels.HandleMessage(message)
+		if err := webhookChannels.HandleMessage(message); err != nil {
+			return err
+		}
+		deliveryInfo.Set(config.ProviderConfig.GetID(), "", recipient)
+		return nil
 	}
 	return zerrors.ThrowPreconditionFailed(nil, "MAIL-83nof", "Errors.Notification.Channels.NotPresent")
 }
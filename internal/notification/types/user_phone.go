@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/muhlemmer/gu"
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -29,6 +30,7 @@ func generateSms(
 	lastPhone bool,
 	triggeringEvent eventstore.Event,
 	generatorInfo *senders.CodeGeneratorInfo,
+	deliveryInfo *senders.DeliveryInfo,
 ) error {
 	smsChannels, config, err := channels.SMS(ctx)
 	logging.OnError(err).Error("could not create sms channel")
@@ -39,11 +41,7 @@ func generateSms(
 	if lastPhone {
 		recipient = user.LastPhone
 	}
-	if config.TwilioConfig != nil {
-		number := ""
-		if err == nil {
-			number = config.TwilioConfig.SenderNumber
-		}
+	if number, ok := config.SenderNumber(); ok {
 		message := &messages.SMS{
 			SenderPhoneNumber:    number,
 			RecipientPhoneNumber: recipient,
@@ -54,10 +52,12 @@ func generateSms(
 		if err != nil {
 			return err
 		}
-		if config.TwilioConfig.VerifyServiceSID != "" {
+		// the provider generated the code itself (e.g. Twilio Verify Service), which needs to be checked by it as well
+		if message.VerificationID != nil {
 			generatorInfo.ID = config.ProviderConfig.ID
 			generatorInfo.VerificationID = *message.VerificationID
 		}
+		deliveryInfo.Set(config.ProviderConfig.GetID(), gu.Value(message.MessageID), recipient)
 		return nil
 	}
 	if config.WebhookConfig != nil {
@@ -83,7 +83,11 @@ func generateSms(
 		if err != nil {
 			return err
 		}
-		return webhookChannels.HandleMessage(message)
+		if err := webhookChannels.HandleMessage(message); err != nil {
+			return err
+		}
+		deliveryInfo.Set(config.ProviderConfig.GetID(), "", recipient)
+		return nil
 	}
 	return zerrors.ThrowPreconditionFailed(nil, "PHONE-w8nfow", "Errors.Notification.Channels.NotPresent")
 }
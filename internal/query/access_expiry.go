@@ -0,0 +1,169 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ExpiringAccessType int
+
+const (
+	ExpiringAccessTypeUserGrant ExpiringAccessType = iota
+	ExpiringAccessTypeOrgMember
+	ExpiringAccessTypeProjectMember
+)
+
+type ExpiringAccesses struct {
+	SearchResponse
+	ExpiringAccesses []*ExpiringAccess
+}
+
+// ExpiringAccess is a user grant or a membership, whose validity ends before a point in time.
+type ExpiringAccess struct {
+	// ID is the id of the user grant, the organization or the project the membership belongs to
+	ID            string
+	UserID        string
+	ResourceOwner string
+	ValidUntil    time.Time
+}
+
+type ExpiringAccessSearchQueries struct {
+	SearchRequest
+	Type ExpiringAccessType
+	// Before returns the accesses whose validity ends before or at the point in time
+	Before time.Time
+	// After excludes the accesses whose validity ended before or at the point in time, if set
+	After time.Time
+}
+
+// expiringAccessColumns are the columns of the projection the [ExpiringAccessType] is stored in.
+type expiringAccessColumns struct {
+	table         table
+	id            Column
+	userID        Column
+	resourceOwner Column
+	instanceID    Column
+	validUntil    Column
+}
+
+func (t ExpiringAccessType) columns() expiringAccessColumns {
+	switch t {
+	case ExpiringAccessTypeOrgMember:
+		return expiringAccessColumns{
+			table:         orgMemberTable,
+			id:            OrgMemberOrgID,
+			userID:        OrgMemberUserID,
+			resourceOwner: OrgMemberResourceOwner,
+			instanceID:    OrgMemberInstanceID,
+			validUntil:    OrgMemberValidUntil,
+		}
+	case ExpiringAccessTypeProjectMember:
+		return expiringAccessColumns{
+			table:         projectMemberTable,
+			id:            ProjectMemberProjectID,
+			userID:        ProjectMemberUserID,
+			resourceOwner: ProjectMemberResourceOwner,
+			instanceID:    ProjectMemberInstanceID,
+			validUntil:    ProjectMemberValidUntil,
+		}
+	default:
+		return expiringAccessColumns{
+			table:         userGrantTable,
+			id:            UserGrantID,
+			userID:        UserGrantUserID,
+			resourceOwner: UserGrantResourceOwner,
+			instanceID:    UserGrantInstanceID,
+			validUntil:    UserGrantValidUntil,
+		}
+	}
+}
+
+// SearchExpiringAccesses returns the user grants or memberships of the instance, whose validity ends in the searched period.
+// The permission has to be checked by the caller.
+func (q *Queries) SearchExpiringAccesses(ctx context.Context, queries *ExpiringAccessSearchQueries) (accesses *ExpiringAccesses, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	columns := queries.Type.columns()
+	query, scan := prepareExpiringAccessesQuery(ctx, q.client, columns)
+	stmt, args, err := queries.toQuery(query, columns).Where(expiringAccessCondition(ctx, queries, columns)).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ax7bAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		accesses, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	accesses.State, err = q.latestState(ctx, columns.table)
+	return accesses, err
+}
+
+func (q *ExpiringAccessSearchQueries) toQuery(query sq.SelectBuilder, columns expiringAccessColumns) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	if q.SortingColumn.isZero() {
+		query = query.OrderBy(columns.validUntil.identifier())
+	}
+	return query
+}
+
+func expiringAccessCondition(ctx context.Context, queries *ExpiringAccessSearchQueries, columns expiringAccessColumns) sq.And {
+	condition := sq.And{
+		sq.Eq{columns.instanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+		sq.LtOrEq{columns.validUntil.identifier(): queries.Before},
+	}
+	if !queries.After.IsZero() {
+		condition = append(condition, sq.Gt{columns.validUntil.identifier(): queries.After})
+	}
+	return condition
+}
+
+func prepareExpiringAccessesQuery(ctx context.Context, db prepareDatabase, columns expiringAccessColumns) (sq.SelectBuilder, func(*sql.Rows) (*ExpiringAccesses, error)) {
+	return sq.Select(
+			columns.id.identifier(),
+			columns.userID.identifier(),
+			columns.resourceOwner.identifier(),
+			columns.validUntil.identifier(),
+			countColumn.identifier(),
+		).
+			From(columns.table.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ExpiringAccesses, error) {
+			accesses := make([]*ExpiringAccess, 0)
+			var count uint64
+			for rows.Next() {
+				access := new(ExpiringAccess)
+				err := rows.Scan(
+					&access.ID,
+					&access.UserID,
+					&access.ResourceOwner,
+					&access.ValidUntil,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				accesses = append(accesses, access)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ax7bBb", "Errors.Query.CloseRows")
+			}
+			return &ExpiringAccesses{
+				ExpiringAccesses: accesses,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
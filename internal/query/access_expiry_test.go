@@ -0,0 +1,175 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+var (
+	expiringUserGrantsQuery = `SELECT projections.user_grants5.id,` +
+		` projections.user_grants5.user_id,` +
+		` projections.user_grants5.resource_owner,` +
+		` projections.user_grants5.valid_until,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_grants5` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	expiringOrgMembersQuery = `SELECT members.org_id,` +
+		` members.user_id,` +
+		` members.resource_owner,` +
+		` members.valid_until,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.org_members4 AS members` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	expiringAccessesCols = []string{
+		"id",
+		"user_id",
+		"resource_owner",
+		"valid_until",
+		"count",
+	}
+)
+
+func Test_ExpiringAccessesPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name       string
+		accessType ExpiringAccessType
+		want       want
+		object     interface{}
+	}{
+		{
+			name:       "prepareExpiringAccessesQuery user grants no result",
+			accessType: ExpiringAccessTypeUserGrant,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiringUserGrantsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &ExpiringAccesses{ExpiringAccesses: []*ExpiringAccess{}},
+		},
+		{
+			name:       "prepareExpiringAccessesQuery org members multiple results",
+			accessType: ExpiringAccessTypeOrgMember,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiringOrgMembersQuery),
+					expiringAccessesCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							"user-id",
+							"ro",
+							testNow,
+						},
+						{
+							"org-id",
+							"user-id-2",
+							"ro",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &ExpiringAccesses{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				ExpiringAccesses: []*ExpiringAccess{
+					{
+						ID:            "org-id",
+						UserID:        "user-id",
+						ResourceOwner: "ro",
+						ValidUntil:    testNow,
+					},
+					{
+						ID:            "org-id",
+						UserID:        "user-id-2",
+						ResourceOwner: "ro",
+						ValidUntil:    testNow,
+					},
+				},
+			},
+		},
+		{
+			name:       "prepareExpiringAccessesQuery sql err",
+			accessType: ExpiringAccessTypeUserGrant,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(expiringUserGrantsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ExpiringAccesses)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prepare := func(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ExpiringAccesses, error)) {
+				return prepareExpiringAccessesQuery(ctx, db, tt.accessType.columns())
+			}
+			assertPrepare(t, prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_expiringAccessCondition(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	ctx := authz.WithInstanceID(context.Background(), "instance")
+	tests := []struct {
+		name     string
+		queries  *ExpiringAccessSearchQueries
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name: "expired user grants",
+			queries: &ExpiringAccessSearchQueries{
+				Type:   ExpiringAccessTypeUserGrant,
+				Before: now,
+			},
+			wantSQL:  "(projections.user_grants5.instance_id = ? AND projections.user_grants5.valid_until <= ?)",
+			wantArgs: []interface{}{"instance", now},
+		},
+		{
+			name: "project members expiring in period",
+			queries: &ExpiringAccessSearchQueries{
+				Type:   ExpiringAccessTypeProjectMember,
+				Before: now.Add(24 * time.Hour),
+				After:  now,
+			},
+			wantSQL:  "(members.instance_id = ? AND members.valid_until <= ? AND members.valid_until > ?)",
+			wantArgs: []interface{}{"instance", now.Add(24 * time.Hour), now},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := expiringAccessCondition(ctx, tt.queries, tt.queries.Type.columns()).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, gotSQL)
+			assert.Equal(t, tt.wantArgs, gotArgs)
+		})
+	}
+}
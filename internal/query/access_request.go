@@ -0,0 +1,281 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AccessRequests struct {
+	SearchResponse
+	AccessRequests []*AccessRequest
+}
+
+type AccessRequest struct {
+	ID            string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.AccessRequestState
+	UserID        string
+	ProjectID     string
+	RoleKeys      database.TextArray[string]
+	Reason        string
+	// DecidedBy is the user who approved or denied the request
+	DecidedBy     string
+	Justification string
+	// UserGrantID is the user grant created by the approval
+	UserGrantID string
+	ValidUntil  time.Time
+}
+
+type AccessRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	accessRequestTable = table{
+		name:          projection.AccessRequestProjectionTable,
+		instanceIDCol: projection.AccessRequestColumnInstanceID,
+	}
+	AccessRequestColumnID = Column{
+		name:  projection.AccessRequestColumnID,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnInstanceID = Column{
+		name:  projection.AccessRequestColumnInstanceID,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnResourceOwner = Column{
+		name:  projection.AccessRequestColumnResourceOwner,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnCreationDate = Column{
+		name:  projection.AccessRequestColumnCreationDate,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnChangeDate = Column{
+		name:  projection.AccessRequestColumnChangeDate,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnSequence = Column{
+		name:  projection.AccessRequestColumnSequence,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnState = Column{
+		name:  projection.AccessRequestColumnState,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnUserID = Column{
+		name:  projection.AccessRequestColumnUserID,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnProjectID = Column{
+		name:  projection.AccessRequestColumnProjectID,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnRoleKeys = Column{
+		name:  projection.AccessRequestColumnRoleKeys,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnReason = Column{
+		name:  projection.AccessRequestColumnReason,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnDecidedBy = Column{
+		name:  projection.AccessRequestColumnDecidedBy,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnJustification = Column{
+		name:  projection.AccessRequestColumnJustification,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnUserGrantID = Column{
+		name:  projection.AccessRequestColumnUserGrantID,
+		table: accessRequestTable,
+	}
+	AccessRequestColumnValidUntil = Column{
+		name:  projection.AccessRequestColumnValidUntil,
+		table: accessRequestTable,
+	}
+)
+
+// AccessRequestByID returns the access request, the resource owner is ignored if empty.
+func (q *Queries) AccessRequestByID(ctx context.Context, id, resourceOwner string) (request *AccessRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessRequestColumnID.identifier():         id,
+		AccessRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[AccessRequestColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareAccessRequestQuery(ctx, q.client)
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar9kQa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		request, err = scan(row)
+		return err
+	}, stmt, args...)
+	return request, err
+}
+
+// SearchAccessRequests returns the access requests matching the queries
+func (q *Queries) SearchAccessRequests(ctx context.Context, queries *AccessRequestSearchQueries) (requests *AccessRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAccessRequestsQuery(ctx, q.client)
+	eq := sq.Eq{
+		AccessRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar9kRb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		requests, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	requests.State, err = q.latestState(ctx, accessRequestTable)
+	return requests, err
+}
+
+func (q *AccessRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewAccessRequestResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnResourceOwner, value, TextEquals)
+}
+
+func NewAccessRequestUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnUserID, value, TextEquals)
+}
+
+func NewAccessRequestProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessRequestColumnProjectID, value, TextEquals)
+}
+
+func NewAccessRequestStateSearchQuery(value domain.AccessRequestState) (SearchQuery, error) {
+	return NewNumberQuery(AccessRequestColumnState, value, NumberEquals)
+}
+
+func accessRequestColumns() []string {
+	return []string{
+		AccessRequestColumnID.identifier(),
+		AccessRequestColumnResourceOwner.identifier(),
+		AccessRequestColumnCreationDate.identifier(),
+		AccessRequestColumnChangeDate.identifier(),
+		AccessRequestColumnSequence.identifier(),
+		AccessRequestColumnState.identifier(),
+		AccessRequestColumnUserID.identifier(),
+		AccessRequestColumnProjectID.identifier(),
+		AccessRequestColumnRoleKeys.identifier(),
+		AccessRequestColumnReason.identifier(),
+		AccessRequestColumnDecidedBy.identifier(),
+		AccessRequestColumnJustification.identifier(),
+		AccessRequestColumnUserGrantID.identifier(),
+		AccessRequestColumnValidUntil.identifier(),
+	}
+}
+
+type accessRequestScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessRequest(scanner accessRequestScanner, additional ...any) (*AccessRequest, error) {
+	request := new(AccessRequest)
+	var validUntil sql.NullTime
+	err := scanner.Scan(append([]any{
+		&request.ID,
+		&request.ResourceOwner,
+		&request.CreationDate,
+		&request.ChangeDate,
+		&request.Sequence,
+		&request.State,
+		&request.UserID,
+		&request.ProjectID,
+		&request.RoleKeys,
+		&request.Reason,
+		&request.DecidedBy,
+		&request.Justification,
+		&request.UserGrantID,
+		&validUntil,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	request.ValidUntil = validUntil.Time
+	return request, nil
+}
+
+func prepareAccessRequestQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*AccessRequest, error)) {
+	return sq.Select(accessRequestColumns()...).
+			From(accessRequestTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*AccessRequest, error) {
+			request, err := scanAccessRequest(row)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ar9kSc", "Errors.AccessRequest.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ar9kTd", "Errors.Internal")
+			}
+			return request, nil
+		}
+}
+
+func prepareAccessRequestsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*AccessRequests, error)) {
+	return sq.Select(append(accessRequestColumns(), countColumn.identifier())...).
+			From(accessRequestTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessRequests, error) {
+			requests := make([]*AccessRequest, 0)
+			var count uint64
+			for rows.Next() {
+				request, err := scanAccessRequest(rows, &count)
+				if err != nil {
+					return nil, err
+				}
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ar9kUe", "Errors.Query.CloseRows")
+			}
+
+			return &AccessRequests{
+				AccessRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
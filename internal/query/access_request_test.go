@@ -0,0 +1,196 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessRequestSelect = `SELECT projections.access_requests.id,` +
+		` projections.access_requests.resource_owner,` +
+		` projections.access_requests.creation_date,` +
+		` projections.access_requests.change_date,` +
+		` projections.access_requests.sequence,` +
+		` projections.access_requests.state,` +
+		` projections.access_requests.user_id,` +
+		` projections.access_requests.project_id,` +
+		` projections.access_requests.role_keys,` +
+		` projections.access_requests.reason,` +
+		` projections.access_requests.decided_by,` +
+		` projections.access_requests.justification,` +
+		` projections.access_requests.user_grant_id,` +
+		` projections.access_requests.valid_until`
+	accessRequestQuery = accessRequestSelect +
+		` FROM projections.access_requests AS OF SYSTEM TIME '-1 ms'`
+	accessRequestsQuery = accessRequestSelect +
+		`, COUNT(*) OVER ()` +
+		` FROM projections.access_requests AS OF SYSTEM TIME '-1 ms'`
+	accessRequestCols = []string{
+		"id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"user_id",
+		"project_id",
+		"role_keys",
+		"reason",
+		"decided_by",
+		"justification",
+		"user_grant_id",
+		"valid_until",
+	}
+	accessRequestsCols = append(accessRequestCols, "count")
+)
+
+func Test_AccessRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessRequestQuery no result",
+			prepare: prepareAccessRequestQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(accessRequestQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessRequest)(nil),
+		},
+		{
+			name:    "prepareAccessRequestQuery approved",
+			prepare: prepareAccessRequestQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(accessRequestQuery),
+					accessRequestCols,
+					[]driver.Value{
+						"request-id",
+						"org-id",
+						testNow,
+						testNow,
+						uint64(20211108),
+						domain.AccessRequestStateApproved,
+						"user-id",
+						"project-id",
+						database.TextArray[string]{"role"},
+						"reason",
+						"approver-id",
+						"justification",
+						"grant-id",
+						testNow,
+					},
+				),
+			},
+			object: &AccessRequest{
+				ID:            "request-id",
+				ResourceOwner: "org-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211108,
+				State:         domain.AccessRequestStateApproved,
+				UserID:        "user-id",
+				ProjectID:     "project-id",
+				RoleKeys:      database.TextArray[string]{"role"},
+				Reason:        "reason",
+				DecidedBy:     "approver-id",
+				Justification: "justification",
+				UserGrantID:   "grant-id",
+				ValidUntil:    testNow,
+			},
+		},
+		{
+			name:    "prepareAccessRequestsQuery pending",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(accessRequestsQuery),
+					accessRequestsCols,
+					[][]driver.Value{
+						{
+							"request-id",
+							"org-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							domain.AccessRequestStatePending,
+							"user-id",
+							"project-id",
+							database.TextArray[string]{"role"},
+							"reason",
+							"",
+							"",
+							"",
+							nil,
+						},
+					},
+				),
+			},
+			object: &AccessRequests{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				AccessRequests: []*AccessRequest{
+					{
+						ID:            "request-id",
+						ResourceOwner: "org-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						State:         domain.AccessRequestStatePending,
+						UserID:        "user-id",
+						ProjectID:     "project-id",
+						RoleKeys:      database.TextArray[string]{"role"},
+						Reason:        "reason",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessRequestsQuery sql err",
+			prepare: prepareAccessRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(accessRequestsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessRequests)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,503 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AccessReviews struct {
+	SearchResponse
+	AccessReviews []*AccessReview
+}
+
+type AccessReview struct {
+	ID            string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.AccessReviewState
+	Creator       string
+	Name          string
+	// ProjectID is empty if the review covers the whole organization
+	ProjectID  string
+	RoleKeys   database.TextArray[string]
+	Reviewers  database.TextArray[string]
+	Deadline   time.Time
+	AutoRevoke bool
+}
+
+type AccessReviewSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type AccessReviewItems struct {
+	SearchResponse
+	AccessReviewItems []*AccessReviewItem
+}
+
+// AccessReviewItem is a user grant or membership, which was part of the access review at its start.
+type AccessReviewItem struct {
+	ID       string
+	ReviewID string
+	Sequence uint64
+	Type     domain.AccessReviewItemType
+	// ObjectID is the id of the user grant, the organization or the project the membership belongs to
+	ObjectID            string
+	ObjectResourceOwner string
+	UserID              string
+	ProjectID           string
+	Roles               database.TextArray[string]
+	Decision            domain.AccessReviewDecision
+	DecidedBy           string
+	DecisionDate        time.Time
+	Comment             string
+	// Automatic is set if the decision was made by the system at the deadline
+	Automatic bool
+}
+
+type AccessReviewItemSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	accessReviewTable = table{
+		name:          projection.AccessReviewProjectionTable,
+		instanceIDCol: projection.AccessReviewColumnInstanceID,
+	}
+	AccessReviewColumnID = Column{
+		name:  projection.AccessReviewColumnID,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnInstanceID = Column{
+		name:  projection.AccessReviewColumnInstanceID,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnResourceOwner = Column{
+		name:  projection.AccessReviewColumnResourceOwner,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnCreationDate = Column{
+		name:  projection.AccessReviewColumnCreationDate,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnChangeDate = Column{
+		name:  projection.AccessReviewColumnChangeDate,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnSequence = Column{
+		name:  projection.AccessReviewColumnSequence,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnState = Column{
+		name:  projection.AccessReviewColumnState,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnCreator = Column{
+		name:  projection.AccessReviewColumnCreator,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnName = Column{
+		name:  projection.AccessReviewColumnName,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnProjectID = Column{
+		name:  projection.AccessReviewColumnProjectID,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnRoleKeys = Column{
+		name:  projection.AccessReviewColumnRoleKeys,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnReviewers = Column{
+		name:  projection.AccessReviewColumnReviewers,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnDeadline = Column{
+		name:  projection.AccessReviewColumnDeadline,
+		table: accessReviewTable,
+	}
+	AccessReviewColumnAutoRevoke = Column{
+		name:  projection.AccessReviewColumnAutoRevoke,
+		table: accessReviewTable,
+	}
+)
+
+var (
+	accessReviewItemTable = table{
+		name:          projection.AccessReviewItemTable,
+		instanceIDCol: projection.AccessReviewItemColumnInstanceID,
+	}
+	AccessReviewItemColumnID = Column{
+		name:  projection.AccessReviewItemColumnID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnInstanceID = Column{
+		name:  projection.AccessReviewItemColumnInstanceID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnReviewID = Column{
+		name:  projection.AccessReviewItemColumnReviewID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnResourceOwner = Column{
+		name:  projection.AccessReviewItemColumnResourceOwner,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnSequence = Column{
+		name:  projection.AccessReviewItemColumnSequence,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnType = Column{
+		name:  projection.AccessReviewItemColumnType,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnObjectID = Column{
+		name:  projection.AccessReviewItemColumnObjectID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnObjectResourceOwner = Column{
+		name:  projection.AccessReviewItemColumnObjectResourceOwner,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnUserID = Column{
+		name:  projection.AccessReviewItemColumnUserID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnProjectID = Column{
+		name:  projection.AccessReviewItemColumnProjectID,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnRoles = Column{
+		name:  projection.AccessReviewItemColumnRoles,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecision = Column{
+		name:  projection.AccessReviewItemColumnDecision,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecidedBy = Column{
+		name:  projection.AccessReviewItemColumnDecidedBy,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnDecisionDate = Column{
+		name:  projection.AccessReviewItemColumnDecisionDate,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnComment = Column{
+		name:  projection.AccessReviewItemColumnComment,
+		table: accessReviewItemTable,
+	}
+	AccessReviewItemColumnAutomatic = Column{
+		name:  projection.AccessReviewItemColumnAutomatic,
+		table: accessReviewItemTable,
+	}
+)
+
+// AccessReviewByID returns the access review, the resource owner is ignored if empty.
+func (q *Queries) AccessReviewByID(ctx context.Context, id, resourceOwner string) (review *AccessReview, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		AccessReviewColumnID.identifier():         id,
+		AccessReviewColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[AccessReviewColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareAccessReviewQuery(ctx, q.client)
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv4aAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		review, err = scan(row)
+		return err
+	}, stmt, args...)
+	return review, err
+}
+
+// SearchAccessReviews returns the access reviews matching the queries
+func (q *Queries) SearchAccessReviews(ctx context.Context, queries *AccessReviewSearchQueries) (reviews *AccessReviews, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAccessReviewsQuery(ctx, q.client)
+	eq := sq.Eq{
+		AccessReviewColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv4bBb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		reviews, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	reviews.State, err = q.latestState(ctx, accessReviewTable)
+	return reviews, err
+}
+
+// SearchExpiredAccessReviews returns the active access reviews of the instance, whose deadline passed before or at the point in time.
+// The permission has to be checked by the caller.
+func (q *Queries) SearchExpiredAccessReviews(ctx context.Context, before time.Time, limit uint64) (*AccessReviews, error) {
+	stateQuery, err := NewAccessReviewStateSearchQuery(domain.AccessReviewStateActive)
+	if err != nil {
+		return nil, err
+	}
+	deadlineQuery, err := NewAccessReviewDeadlineBeforeSearchQuery(before)
+	if err != nil {
+		return nil, err
+	}
+	return q.SearchAccessReviews(ctx, &AccessReviewSearchQueries{
+		SearchRequest: SearchRequest{
+			Limit:         limit,
+			SortingColumn: AccessReviewColumnDeadline,
+			Asc:           true,
+		},
+		Queries: []SearchQuery{stateQuery, deadlineQuery},
+	})
+}
+
+// SearchAccessReviewItems returns the items of the access review matching the queries
+func (q *Queries) SearchAccessReviewItems(ctx context.Context, reviewID string, queries *AccessReviewItemSearchQueries) (items *AccessReviewItems, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAccessReviewItemsQuery(ctx, q.client)
+	eq := sq.Eq{
+		AccessReviewItemColumnReviewID.identifier():   reviewID,
+		AccessReviewItemColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv4cCc", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		items, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	items.State, err = q.latestState(ctx, accessReviewTable)
+	return items, err
+}
+
+func (q *AccessReviewSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *AccessReviewItemSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewAccessReviewResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnResourceOwner, value, TextEquals)
+}
+
+func NewAccessReviewProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnProjectID, value, TextEquals)
+}
+
+func NewAccessReviewStateSearchQuery(value domain.AccessReviewState) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewColumnState, value, NumberEquals)
+}
+
+// NewAccessReviewReviewerSearchQuery returns the access reviews the user is assigned to as reviewer
+func NewAccessReviewReviewerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewColumnReviewers, value, TextListContains)
+}
+
+func NewAccessReviewDeadlineBeforeSearchQuery(value time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(AccessReviewColumnDeadline, value, TimestampLessOrEquals)
+}
+
+func NewAccessReviewItemUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccessReviewItemColumnUserID, value, TextEquals)
+}
+
+func NewAccessReviewItemDecisionSearchQuery(value domain.AccessReviewDecision) (SearchQuery, error) {
+	return NewNumberQuery(AccessReviewItemColumnDecision, value, NumberEquals)
+}
+
+func accessReviewColumns() []string {
+	return []string{
+		AccessReviewColumnID.identifier(),
+		AccessReviewColumnResourceOwner.identifier(),
+		AccessReviewColumnCreationDate.identifier(),
+		AccessReviewColumnChangeDate.identifier(),
+		AccessReviewColumnSequence.identifier(),
+		AccessReviewColumnState.identifier(),
+		AccessReviewColumnCreator.identifier(),
+		AccessReviewColumnName.identifier(),
+		AccessReviewColumnProjectID.identifier(),
+		AccessReviewColumnRoleKeys.identifier(),
+		AccessReviewColumnReviewers.identifier(),
+		AccessReviewColumnDeadline.identifier(),
+		AccessReviewColumnAutoRevoke.identifier(),
+	}
+}
+
+type accessReviewScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessReview(scanner accessReviewScanner, additional ...any) (*AccessReview, error) {
+	review := new(AccessReview)
+	err := scanner.Scan(append([]any{
+		&review.ID,
+		&review.ResourceOwner,
+		&review.CreationDate,
+		&review.ChangeDate,
+		&review.Sequence,
+		&review.State,
+		&review.Creator,
+		&review.Name,
+		&review.ProjectID,
+		&review.RoleKeys,
+		&review.Reviewers,
+		&review.Deadline,
+		&review.AutoRevoke,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func prepareAccessReviewQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*AccessReview, error)) {
+	return sq.Select(accessReviewColumns()...).
+			From(accessReviewTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*AccessReview, error) {
+			review, err := scanAccessReview(row)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Rv4dDd", "Errors.AccessReview.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rv4eEe", "Errors.Internal")
+			}
+			return review, nil
+		}
+}
+
+func prepareAccessReviewsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*AccessReviews, error)) {
+	return sq.Select(append(accessReviewColumns(), countColumn.identifier())...).
+			From(accessReviewTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviews, error) {
+			reviews := make([]*AccessReview, 0)
+			var count uint64
+			for rows.Next() {
+				review, err := scanAccessReview(rows, &count)
+				if err != nil {
+					return nil, err
+				}
+				reviews = append(reviews, review)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rv4fFf", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviews{
+				AccessReviews: reviews,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareAccessReviewItemsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*AccessReviewItems, error)) {
+	return sq.Select(
+			AccessReviewItemColumnID.identifier(),
+			AccessReviewItemColumnReviewID.identifier(),
+			AccessReviewItemColumnSequence.identifier(),
+			AccessReviewItemColumnType.identifier(),
+			AccessReviewItemColumnObjectID.identifier(),
+			AccessReviewItemColumnObjectResourceOwner.identifier(),
+			AccessReviewItemColumnUserID.identifier(),
+			AccessReviewItemColumnProjectID.identifier(),
+			AccessReviewItemColumnRoles.identifier(),
+			AccessReviewItemColumnDecision.identifier(),
+			AccessReviewItemColumnDecidedBy.identifier(),
+			AccessReviewItemColumnDecisionDate.identifier(),
+			AccessReviewItemColumnComment.identifier(),
+			AccessReviewItemColumnAutomatic.identifier(),
+			countColumn.identifier(),
+		).
+			From(accessReviewItemTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccessReviewItems, error) {
+			items := make([]*AccessReviewItem, 0)
+			var count uint64
+			for rows.Next() {
+				item := new(AccessReviewItem)
+				var decisionDate sql.NullTime
+				err := rows.Scan(
+					&item.ID,
+					&item.ReviewID,
+					&item.Sequence,
+					&item.Type,
+					&item.ObjectID,
+					&item.ObjectResourceOwner,
+					&item.UserID,
+					&item.ProjectID,
+					&item.Roles,
+					&item.Decision,
+					&item.DecidedBy,
+					&decisionDate,
+					&item.Comment,
+					&item.Automatic,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				item.DecisionDate = decisionDate.Time
+				items = append(items, item)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rv4gGg", "Errors.Query.CloseRows")
+			}
+
+			return &AccessReviewItems{
+				AccessReviewItems: items,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
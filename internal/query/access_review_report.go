@@ -0,0 +1,210 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AccessReviewReport contains the access review and the decisions on all its items.
+type AccessReviewReport struct {
+	Review *AccessReview
+	Items  []*AccessReviewItem
+}
+
+// AccessReviewReport returns the report of the access review, the resource owner is ignored if empty.
+func (q *Queries) AccessReviewReport(ctx context.Context, id, resourceOwner string) (report *AccessReviewReport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	review, err := q.AccessReviewByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	items, err := q.SearchAccessReviewItems(ctx, review.ID, &AccessReviewItemSearchQueries{
+		SearchRequest: SearchRequest{
+			SortingColumn: AccessReviewItemColumnID,
+			Asc:           true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AccessReviewReport{
+		Review: review,
+		Items:  items.AccessReviewItems,
+	}, nil
+}
+
+var accessReviewReportHeader = []string{
+	"review_id",
+	"review_name",
+	"item_id",
+	"type",
+	"object_id",
+	"object_resource_owner",
+	"user_id",
+	"project_id",
+	"roles",
+	"decision",
+	"decided_by",
+	"decision_date",
+	"comment",
+	"automatic",
+}
+
+// CSV returns the report with a line per item, the access review is repeated on every line.
+func (r *AccessReviewReport) CSV() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	if err := w.Write(accessReviewReportHeader); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv5aAa", "Errors.Internal")
+	}
+	for _, item := range r.Items {
+		err := w.Write([]string{
+			r.Review.ID,
+			r.Review.Name,
+			item.ID,
+			accessReviewItemTypeName(item.Type),
+			item.ObjectID,
+			item.ObjectResourceOwner,
+			item.UserID,
+			item.ProjectID,
+			strings.Join(item.Roles, " "),
+			accessReviewDecisionName(item.Decision),
+			item.DecidedBy,
+			formatReportTime(item.DecisionDate),
+			item.Comment,
+			strconv.FormatBool(item.Automatic),
+		})
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Rv5bBb", "Errors.Internal")
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv5cCc", "Errors.Internal")
+	}
+	return buf.Bytes(), nil
+}
+
+type accessReviewReportJSON struct {
+	ID         string                        `json:"id"`
+	Name       string                        `json:"name"`
+	OrgID      string                        `json:"orgId"`
+	ProjectID  string                        `json:"projectId,omitempty"`
+	RoleKeys   []string                      `json:"roleKeys,omitempty"`
+	State      string                        `json:"state"`
+	Creator    string                        `json:"creator"`
+	Created    string                        `json:"created"`
+	Reviewers  []string                      `json:"reviewers"`
+	Deadline   string                        `json:"deadline"`
+	AutoRevoke bool                          `json:"autoRevoke"`
+	Items      []*accessReviewReportItemJSON `json:"items"`
+}
+
+type accessReviewReportItemJSON struct {
+	ID                  string   `json:"id"`
+	Type                string   `json:"type"`
+	ObjectID            string   `json:"objectId"`
+	ObjectResourceOwner string   `json:"objectResourceOwner"`
+	UserID              string   `json:"userId"`
+	ProjectID           string   `json:"projectId,omitempty"`
+	Roles               []string `json:"roles"`
+	Decision            string   `json:"decision"`
+	DecidedBy           string   `json:"decidedBy,omitempty"`
+	DecisionDate        string   `json:"decisionDate,omitempty"`
+	Comment             string   `json:"comment,omitempty"`
+	Automatic           bool     `json:"automatic"`
+}
+
+// JSON returns the report as a single object containing the access review and its items.
+func (r *AccessReviewReport) JSON() ([]byte, error) {
+	report := &accessReviewReportJSON{
+		ID:         r.Review.ID,
+		Name:       r.Review.Name,
+		OrgID:      r.Review.ResourceOwner,
+		ProjectID:  r.Review.ProjectID,
+		RoleKeys:   r.Review.RoleKeys,
+		State:      accessReviewStateName(r.Review.State),
+		Creator:    r.Review.Creator,
+		Created:    formatReportTime(r.Review.CreationDate),
+		Reviewers:  r.Review.Reviewers,
+		Deadline:   formatReportTime(r.Review.Deadline),
+		AutoRevoke: r.Review.AutoRevoke,
+		Items:      make([]*accessReviewReportItemJSON, len(r.Items)),
+	}
+	for i, item := range r.Items {
+		report.Items[i] = &accessReviewReportItemJSON{
+			ID:                  item.ID,
+			Type:                accessReviewItemTypeName(item.Type),
+			ObjectID:            item.ObjectID,
+			ObjectResourceOwner: item.ObjectResourceOwner,
+			UserID:              item.UserID,
+			ProjectID:           item.ProjectID,
+			Roles:               item.Roles,
+			Decision:            accessReviewDecisionName(item.Decision),
+			DecidedBy:           item.DecidedBy,
+			DecisionDate:        formatReportTime(item.DecisionDate),
+			Comment:             item.Comment,
+			Automatic:           item.Automatic,
+		}
+	}
+	data, err := json.Marshal(report)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rv5dDd", "Errors.Internal")
+	}
+	return data, nil
+}
+
+func formatReportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func accessReviewStateName(state domain.AccessReviewState) string {
+	switch state {
+	case domain.AccessReviewStateActive:
+		return "active"
+	case domain.AccessReviewStateCompleted:
+		return "completed"
+	case domain.AccessReviewStateCancelled:
+		return "cancelled"
+	default:
+		return "unspecified"
+	}
+}
+
+func accessReviewItemTypeName(itemType domain.AccessReviewItemType) string {
+	switch itemType {
+	case domain.AccessReviewItemTypeUserGrant:
+		return "user_grant"
+	case domain.AccessReviewItemTypeOrgMember:
+		return "org_member"
+	case domain.AccessReviewItemTypeProjectMember:
+		return "project_member"
+	default:
+		return "unspecified"
+	}
+}
+
+func accessReviewDecisionName(decision domain.AccessReviewDecision) string {
+	switch decision {
+	case domain.AccessReviewDecisionKeep:
+		return "keep"
+	case domain.AccessReviewDecisionRevoke:
+		return "revoke"
+	default:
+		return "undecided"
+	}
+}
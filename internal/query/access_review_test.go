@@ -0,0 +1,395 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessReviewSelect = `SELECT projections.access_reviews.id,` +
+		` projections.access_reviews.resource_owner,` +
+		` projections.access_reviews.creation_date,` +
+		` projections.access_reviews.change_date,` +
+		` projections.access_reviews.sequence,` +
+		` projections.access_reviews.state,` +
+		` projections.access_reviews.creator,` +
+		` projections.access_reviews.name,` +
+		` projections.access_reviews.project_id,` +
+		` projections.access_reviews.role_keys,` +
+		` projections.access_reviews.reviewers,` +
+		` projections.access_reviews.deadline,` +
+		` projections.access_reviews.auto_revoke`
+	accessReviewQuery = accessReviewSelect +
+		` FROM projections.access_reviews AS OF SYSTEM TIME '-1 ms'`
+	accessReviewsQuery = accessReviewSelect +
+		`, COUNT(*) OVER ()` +
+		` FROM projections.access_reviews AS OF SYSTEM TIME '-1 ms'`
+	accessReviewCols = []string{
+		"id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"creator",
+		"name",
+		"project_id",
+		"role_keys",
+		"reviewers",
+		"deadline",
+		"auto_revoke",
+	}
+	accessReviewsCols = append(accessReviewCols, "count")
+
+	accessReviewItemsQuery = `SELECT projections.access_reviews_items.id,` +
+		` projections.access_reviews_items.review_id,` +
+		` projections.access_reviews_items.sequence,` +
+		` projections.access_reviews_items.type,` +
+		` projections.access_reviews_items.object_id,` +
+		` projections.access_reviews_items.object_resource_owner,` +
+		` projections.access_reviews_items.user_id,` +
+		` projections.access_reviews_items.project_id,` +
+		` projections.access_reviews_items.roles,` +
+		` projections.access_reviews_items.decision,` +
+		` projections.access_reviews_items.decided_by,` +
+		` projections.access_reviews_items.decision_date,` +
+		` projections.access_reviews_items.comment,` +
+		` projections.access_reviews_items.automatic,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.access_reviews_items AS OF SYSTEM TIME '-1 ms'`
+	accessReviewItemsCols = []string{
+		"id",
+		"review_id",
+		"sequence",
+		"type",
+		"object_id",
+		"object_resource_owner",
+		"user_id",
+		"project_id",
+		"roles",
+		"decision",
+		"decided_by",
+		"decision_date",
+		"comment",
+		"automatic",
+		"count",
+	}
+)
+
+func Test_AccessReviewPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccessReviewQuery no result",
+			prepare: prepareAccessReviewQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(accessReviewQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReview)(nil),
+		},
+		{
+			name:    "prepareAccessReviewQuery found",
+			prepare: prepareAccessReviewQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(accessReviewQuery),
+					accessReviewCols,
+					[]driver.Value{
+						"review-id",
+						"org-id",
+						testNow,
+						testNow,
+						uint64(20211108),
+						domain.AccessReviewStateActive,
+						"creator-id",
+						"name",
+						"project-id",
+						database.TextArray[string]{"role"},
+						database.TextArray[string]{"reviewer-id"},
+						testNow,
+						true,
+					},
+				),
+			},
+			object: &AccessReview{
+				ID:            "review-id",
+				ResourceOwner: "org-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211108,
+				State:         domain.AccessReviewStateActive,
+				Creator:       "creator-id",
+				Name:          "name",
+				ProjectID:     "project-id",
+				RoleKeys:      database.TextArray[string]{"role"},
+				Reviewers:     database.TextArray[string]{"reviewer-id"},
+				Deadline:      testNow,
+				AutoRevoke:    true,
+			},
+		},
+		{
+			name:    "prepareAccessReviewsQuery found",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(accessReviewsQuery),
+					accessReviewsCols,
+					[][]driver.Value{
+						{
+							"review-id",
+							"org-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							domain.AccessReviewStateCompleted,
+							"creator-id",
+							"name",
+							"",
+							database.TextArray[string]{},
+							database.TextArray[string]{"reviewer-id"},
+							testNow,
+							false,
+						},
+					},
+				),
+			},
+			object: &AccessReviews{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				AccessReviews: []*AccessReview{
+					{
+						ID:            "review-id",
+						ResourceOwner: "org-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						State:         domain.AccessReviewStateCompleted,
+						Creator:       "creator-id",
+						Name:          "name",
+						RoleKeys:      database.TextArray[string]{},
+						Reviewers:     database.TextArray[string]{"reviewer-id"},
+						Deadline:      testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessReviewsQuery sql err",
+			prepare: prepareAccessReviewsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(accessReviewsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReviews)(nil),
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery found",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(accessReviewItemsQuery),
+					accessReviewItemsCols,
+					[][]driver.Value{
+						{
+							"item-id",
+							"review-id",
+							uint64(20211108),
+							domain.AccessReviewItemTypeUserGrant,
+							"grant-id",
+							"org-id",
+							"user-id",
+							"project-id",
+							database.TextArray[string]{"role"},
+							domain.AccessReviewDecisionRevoke,
+							"reviewer-id",
+							testNow,
+							"comment",
+							false,
+						},
+						{
+							"item-id2",
+							"review-id",
+							uint64(20211108),
+							domain.AccessReviewItemTypeOrgMember,
+							"org-id",
+							"org-id",
+							"user-id2",
+							"",
+							database.TextArray[string]{"ORG_OWNER"},
+							domain.AccessReviewDecisionUnspecified,
+							"",
+							nil,
+							"",
+							false,
+						},
+					},
+				),
+			},
+			object: &AccessReviewItems{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				AccessReviewItems: []*AccessReviewItem{
+					{
+						ID:                  "item-id",
+						ReviewID:            "review-id",
+						Sequence:            20211108,
+						Type:                domain.AccessReviewItemTypeUserGrant,
+						ObjectID:            "grant-id",
+						ObjectResourceOwner: "org-id",
+						UserID:              "user-id",
+						ProjectID:           "project-id",
+						Roles:               database.TextArray[string]{"role"},
+						Decision:            domain.AccessReviewDecisionRevoke,
+						DecidedBy:           "reviewer-id",
+						DecisionDate:        testNow,
+						Comment:             "comment",
+					},
+					{
+						ID:                  "item-id2",
+						ReviewID:            "review-id",
+						Sequence:            20211108,
+						Type:                domain.AccessReviewItemTypeOrgMember,
+						ObjectID:            "org-id",
+						ObjectResourceOwner: "org-id",
+						UserID:              "user-id2",
+						Roles:               database.TextArray[string]{"ORG_OWNER"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccessReviewItemsQuery sql err",
+			prepare: prepareAccessReviewItemsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(accessReviewItemsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccessReviewItems)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestAccessReviewReport(t *testing.T) {
+	report := &AccessReviewReport{
+		Review: &AccessReview{
+			ID:            "review-id",
+			ResourceOwner: "org-id",
+			CreationDate:  testNow,
+			State:         domain.AccessReviewStateCompleted,
+			Creator:       "creator-id",
+			Name:          "Q1",
+			Reviewers:     database.TextArray[string]{"reviewer-id"},
+			Deadline:      testNow,
+			AutoRevoke:    true,
+		},
+		Items: []*AccessReviewItem{
+			{
+				ID:                  "item-id",
+				Type:                domain.AccessReviewItemTypeUserGrant,
+				ObjectID:            "grant-id",
+				ObjectResourceOwner: "org-id",
+				UserID:              "user-id",
+				ProjectID:           "project-id",
+				Roles:               database.TextArray[string]{"role1", "role2"},
+				Decision:            domain.AccessReviewDecisionKeep,
+				DecidedBy:           "reviewer-id",
+				DecisionDate:        testNow,
+				Comment:             "still, needed",
+			},
+			{
+				ID:                  "item-id2",
+				Type:                domain.AccessReviewItemTypeOrgMember,
+				ObjectID:            "org-id",
+				ObjectResourceOwner: "org-id",
+				UserID:              "user-id2",
+				Roles:               database.TextArray[string]{"ORG_OWNER"},
+				Decision:            domain.AccessReviewDecisionRevoke,
+				DecisionDate:        testNow,
+				Automatic:           true,
+			},
+		},
+	}
+	date := testNow.UTC().Format("2006-01-02T15:04:05Z07:00")
+
+	t.Run("csv", func(t *testing.T) {
+		got, err := report.CSV()
+		require.NoError(t, err)
+		assert.Equal(t,
+			"review_id,review_name,item_id,type,object_id,object_resource_owner,user_id,project_id,roles,decision,decided_by,decision_date,comment,automatic\n"+
+				"review-id,Q1,item-id,user_grant,grant-id,org-id,user-id,project-id,role1 role2,keep,reviewer-id,"+date+",\"still, needed\",false\n"+
+				"review-id,Q1,item-id2,org_member,org-id,org-id,user-id2,,ORG_OWNER,revoke,,"+date+",,true\n",
+			string(got),
+		)
+	})
+	t.Run("json", func(t *testing.T) {
+		got, err := report.JSON()
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"id": "review-id",
+			"name": "Q1",
+			"orgId": "org-id",
+			"state": "completed",
+			"creator": "creator-id",
+			"created": "`+date+`",
+			"reviewers": ["reviewer-id"],
+			"deadline": "`+date+`",
+			"autoRevoke": true,
+			"items": [
+				{"id": "item-id", "type": "user_grant", "objectId": "grant-id", "objectResourceOwner": "org-id", "userId": "user-id", "projectId": "project-id", "roles": ["role1", "role2"], "decision": "keep", "decidedBy": "reviewer-id", "decisionDate": "`+date+`", "comment": "still, needed", "automatic": false},
+				{"id": "item-id2", "type": "org_member", "objectId": "org-id", "objectResourceOwner": "org-id", "userId": "user-id2", "roles": ["ORG_OWNER"], "decision": "revoke", "decisionDate": "`+date+`", "automatic": true}
+			]
+		}`, string(got))
+	})
+}
@@ -0,0 +1,233 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ACRDefinitions struct {
+	SearchResponse
+	Definitions []*ACRDefinition
+}
+
+type ACRDefinition struct {
+	ResourceOwner     string
+	ACR               string
+	CreationDate      time.Time
+	ChangeDate        time.Time
+	Sequence          uint64
+	PhishingResistant bool
+	MultiFactor       bool
+	MaxAge            time.Duration
+	// IsDefault is set if the definition is defined on the instance
+	IsDefault bool
+}
+
+type ACRDefinitionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	acrDefinitionTable = table{
+		name:          projection.ACRDefinitionProjectionTable,
+		instanceIDCol: projection.ACRDefinitionColumnInstanceID,
+	}
+	ACRDefinitionColumnInstanceID = Column{
+		name:  projection.ACRDefinitionColumnInstanceID,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnResourceOwner = Column{
+		name:  projection.ACRDefinitionColumnResourceOwner,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnACR = Column{
+		name:  projection.ACRDefinitionColumnACR,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnCreationDate = Column{
+		name:  projection.ACRDefinitionColumnCreationDate,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnChangeDate = Column{
+		name:  projection.ACRDefinitionColumnChangeDate,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnSequence = Column{
+		name:  projection.ACRDefinitionColumnSequence,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnPhishingResistant = Column{
+		name:  projection.ACRDefinitionColumnPhishingResistant,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnMultiFactor = Column{
+		name:  projection.ACRDefinitionColumnMultiFactor,
+		table: acrDefinitionTable,
+	}
+	ACRDefinitionColumnMaxAge = Column{
+		name:  projection.ACRDefinitionColumnMaxAge,
+		table: acrDefinitionTable,
+	}
+)
+
+// SearchACRDefinitions returns the acr definitions matching the queries
+func (q *Queries) SearchACRDefinitions(ctx context.Context, queries *ACRDefinitionSearchQueries) (definitions *ACRDefinitions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	query, scan := prepareACRDefinitionsQuery(ctx, q.client)
+	eq := sq.Eq{
+		ACRDefinitionColumnInstanceID.identifier(): instanceID,
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hx8pWa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		definitions, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range definitions.Definitions {
+		definition.IsDefault = definition.ResourceOwner == instanceID
+	}
+	definitions.State, err = q.latestState(ctx, acrDefinitionTable)
+	return definitions, err
+}
+
+// ACRDefinitionsByOrg returns the acr definitions effective for the organization.
+// Definitions of the organization take precedence over the ones of the instance with the same acr.
+func (q *Queries) ACRDefinitionsByOrg(ctx context.Context, orgID string) (_ []*domain.ACRDefinition, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	owners := []string{instanceID}
+	if orgID != "" && orgID != instanceID {
+		owners = append(owners, orgID)
+	}
+	ownerQuery, err := NewACRDefinitionResourceOwnersSearchQuery(owners...)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := q.SearchACRDefinitions(ctx, &ACRDefinitionSearchQueries{Queries: []SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return definitions.effective(), nil
+}
+
+// effective maps the definitions to the domain, where org definitions override the default ones.
+func (d *ACRDefinitions) effective() []*domain.ACRDefinition {
+	result := make([]*domain.ACRDefinition, 0, len(d.Definitions))
+	index := make(map[string]int, len(d.Definitions))
+	for _, definition := range d.Definitions {
+		i, ok := index[definition.ACR]
+		if ok && definition.IsDefault {
+			continue
+		}
+		mapped := &domain.ACRDefinition{
+			ACR:               definition.ACR,
+			PhishingResistant: definition.PhishingResistant,
+			MultiFactor:       definition.MultiFactor,
+			MaxAge:            definition.MaxAge,
+		}
+		if ok {
+			result[i] = mapped
+			continue
+		}
+		index[definition.ACR] = len(result)
+		result = append(result, mapped)
+	}
+	return result
+}
+
+func (q *ACRDefinitionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewACRDefinitionResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ACRDefinitionColumnResourceOwner, value, TextEquals)
+}
+
+func NewACRDefinitionResourceOwnersSearchQuery(values ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+	return NewListQuery(ACRDefinitionColumnResourceOwner, list, ListIn)
+}
+
+func NewACRDefinitionACRSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ACRDefinitionColumnACR, value, TextEquals)
+}
+
+func prepareACRDefinitionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ACRDefinitions, error)) {
+	return sq.Select(
+			ACRDefinitionColumnResourceOwner.identifier(),
+			ACRDefinitionColumnACR.identifier(),
+			ACRDefinitionColumnCreationDate.identifier(),
+			ACRDefinitionColumnChangeDate.identifier(),
+			ACRDefinitionColumnSequence.identifier(),
+			ACRDefinitionColumnPhishingResistant.identifier(),
+			ACRDefinitionColumnMultiFactor.identifier(),
+			ACRDefinitionColumnMaxAge.identifier(),
+			countColumn.identifier(),
+		).
+			From(acrDefinitionTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ACRDefinitions, error) {
+			definitions := make([]*ACRDefinition, 0)
+			var count uint64
+			for rows.Next() {
+				definition := new(ACRDefinition)
+				var maxAge sql.NullInt64
+				err := rows.Scan(
+					&definition.ResourceOwner,
+					&definition.ACR,
+					&definition.CreationDate,
+					&definition.ChangeDate,
+					&definition.Sequence,
+					&definition.PhishingResistant,
+					&definition.MultiFactor,
+					&maxAge,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				definition.MaxAge = time.Duration(maxAge.Int64)
+				definitions = append(definitions, definition)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rk6vDn", "Errors.Query.CloseRows")
+			}
+
+			return &ACRDefinitions{
+				Definitions: definitions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
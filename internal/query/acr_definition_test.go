@@ -0,0 +1,160 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	acrDefinitionsQuery = `SELECT projections.acr_definitions.resource_owner,` +
+		` projections.acr_definitions.acr,` +
+		` projections.acr_definitions.creation_date,` +
+		` projections.acr_definitions.change_date,` +
+		` projections.acr_definitions.sequence,` +
+		` projections.acr_definitions.phishing_resistant,` +
+		` projections.acr_definitions.multi_factor,` +
+		` projections.acr_definitions.max_age,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.acr_definitions AS OF SYSTEM TIME '-1 ms'`
+	acrDefinitionsCols = []string{
+		"resource_owner",
+		"acr",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"phishing_resistant",
+		"multi_factor",
+		"max_age",
+		"count",
+	}
+)
+
+func Test_ACRDefinitionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareACRDefinitionsQuery no result",
+			prepare: prepareACRDefinitionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(acrDefinitionsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &ACRDefinitions{Definitions: []*ACRDefinition{}},
+		},
+		{
+			name:    "prepareACRDefinitionsQuery multiple results",
+			prepare: prepareACRDefinitionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(acrDefinitionsQuery),
+					acrDefinitionsCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"mfa",
+							testNow,
+							testNow,
+							uint64(20211108),
+							false,
+							true,
+							int64(10 * time.Minute),
+						},
+						{
+							"org-id",
+							"phr",
+							testNow,
+							testNow,
+							uint64(20211108),
+							true,
+							false,
+							nil,
+						},
+					},
+				),
+			},
+			object: &ACRDefinitions{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Definitions: []*ACRDefinition{
+					{
+						ResourceOwner: "instance-id",
+						ACR:           "mfa",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						MultiFactor:   true,
+						MaxAge:        10 * time.Minute,
+					},
+					{
+						ResourceOwner:     "org-id",
+						ACR:               "phr",
+						CreationDate:      testNow,
+						ChangeDate:        testNow,
+						Sequence:          20211108,
+						PhishingResistant: true,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareACRDefinitionsQuery sql err",
+			prepare: prepareACRDefinitionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(acrDefinitionsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ACRDefinitions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestACRDefinitions_effective(t *testing.T) {
+	definitions := &ACRDefinitions{
+		Definitions: []*ACRDefinition{
+			{ACR: "org", ResourceOwner: "org-id", MultiFactor: true},
+			{ACR: "mfa", ResourceOwner: "org-id", MultiFactor: true, MaxAge: time.Minute},
+			{ACR: "mfa", ResourceOwner: "instance-id", MultiFactor: true, IsDefault: true},
+			{ACR: "phr", ResourceOwner: "instance-id", PhishingResistant: true, IsDefault: true},
+			{ACR: "phr", ResourceOwner: "org-id", PhishingResistant: true, MaxAge: time.Hour},
+		},
+	}
+	assert.Equal(t, []*domain.ACRDefinition{
+		{ACR: "org", MultiFactor: true},
+		{ACR: "mfa", MultiFactor: true, MaxAge: time.Minute},
+		{ACR: "phr", PhishingResistant: true, MaxAge: time.Hour},
+	}, definitions.effective())
+}
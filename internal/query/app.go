@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -60,12 +61,15 @@ type OIDCApp struct {
 	AllowedOrigins           database.TextArray[string]
 	SkipNativeAppSuccessPage bool
 	BackChannelLogoutURI     string
+	RequireConsent           bool
+	RequiredACR              string
 }
 
 type SAMLApp struct {
 	Metadata    []byte
 	MetadataURL string
 	EntityID    string
+	Options     *domain.SAMLAppOptions
 }
 
 type APIApp struct {
@@ -150,6 +154,10 @@ var (
 		name:  projection.AppSAMLConfigColumnMetadataURL,
 		table: appSAMLConfigsTable,
 	}
+	AppSAMLConfigColumnOptions = Column{
+		name:  projection.AppSAMLConfigColumnOptions,
+		table: appSAMLConfigsTable,
+	}
 )
 
 var (
@@ -248,6 +256,14 @@ var (
 		name:  projection.AppOIDCConfigColumnBackChannelLogoutURI,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnRequireConsent = Column{
+		name:  projection.AppOIDCConfigColumnRequireConsent,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequiredACR = Column{
+		name:  projection.AppOIDCConfigColumnRequiredACR,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -542,11 +558,14 @@ func prepareAppQuery(ctx context.Context, db prepareDatabase, activeOnly bool) (
 		AppOIDCConfigColumnAdditionalOrigins.identifier(),
 		AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
 		AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+		AppOIDCConfigColumnRequireConsent.identifier(),
+		AppOIDCConfigColumnRequiredACR.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
 		AppSAMLConfigColumnMetadata.identifier(),
 		AppSAMLConfigColumnMetadataURL.identifier(),
+		AppSAMLConfigColumnOptions.identifier(),
 	).From(appsTable.identifier()).
 		PlaceholderFormat(sq.Dollar)
 
@@ -607,11 +626,14 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.additionalOrigins,
 		&oidcConfig.skipNativeAppSuccessPage,
 		&oidcConfig.backChannelLogoutURI,
+		&oidcConfig.requireConsent,
+		&oidcConfig.requiredACR,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
 		&samlConfig.metadata,
 		&samlConfig.metadataURL,
+		&samlConfig.options,
 	)
 
 	if err != nil {
@@ -623,7 +645,9 @@ func scanApp(row *sql.Row) (*App, error) {
 
 	apiConfig.set(app)
 	oidcConfig.set(app)
-	samlConfig.set(app)
+	if err = samlConfig.set(app); err != nil {
+		return nil, err
+	}
 
 	return app, nil
 }
@@ -657,6 +681,8 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequireConsent.identifier(),
+			AppOIDCConfigColumnRequiredACR.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -694,6 +720,8 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.additionalOrigins,
 				&oidcConfig.skipNativeAppSuccessPage,
 				&oidcConfig.backChannelLogoutURI,
+				&oidcConfig.requireConsent,
+				&oidcConfig.requiredACR,
 			)
 
 			if err != nil {
@@ -724,6 +752,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnOptions.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppSAMLConfigColumnAppID, AppColumnID)).
 			Join(join(ProjectColumnID, AppColumnProjectID)).
@@ -749,6 +778,7 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&samlConfig.entityID,
 				&samlConfig.metadata,
 				&samlConfig.metadataURL,
+				&samlConfig.options,
 			)
 
 			if err != nil {
@@ -758,7 +788,9 @@ func prepareSAMLAppQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				return nil, zerrors.ThrowInternal(err, "QUERY-NAtPg", "Errors.Internal")
 			}
 
-			samlConfig.set(app)
+			if err = samlConfig.set(app); err != nil {
+				return nil, err
+			}
 
 			return app, nil
 		}
@@ -906,11 +938,14 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 			AppOIDCConfigColumnAdditionalOrigins.identifier(),
 			AppOIDCConfigColumnSkipNativeAppSuccessPage.identifier(),
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
+			AppOIDCConfigColumnRequireConsent.identifier(),
+			AppOIDCConfigColumnRequiredACR.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
 			AppSAMLConfigColumnMetadata.identifier(),
 			AppSAMLConfigColumnMetadataURL.identifier(),
+			AppSAMLConfigColumnOptions.identifier(),
 			countColumn.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -959,11 +994,14 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 					&oidcConfig.additionalOrigins,
 					&oidcConfig.skipNativeAppSuccessPage,
 					&oidcConfig.backChannelLogoutURI,
+					&oidcConfig.requireConsent,
+					&oidcConfig.requiredACR,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
 					&samlConfig.metadata,
 					&samlConfig.metadataURL,
+					&samlConfig.options,
 
 					&apps.Count,
 				)
@@ -974,7 +1012,9 @@ func prepareAppsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder
 
 				apiConfig.set(app)
 				oidcConfig.set(app)
-				samlConfig.set(app)
+				if err = samlConfig.set(app); err != nil {
+					return nil, err
+				}
 
 				apps.Apps = append(apps.Apps, app)
 			}
@@ -1032,6 +1072,8 @@ type sqlOIDCConfig struct {
 	grantTypes               database.NumberArray[domain.OIDCGrantType]
 	skipNativeAppSuccessPage sql.NullBool
 	backChannelLogoutURI     sql.NullString
+	requireConsent           sql.NullBool
+	requiredACR              sql.NullString
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1056,6 +1098,8 @@ func (c sqlOIDCConfig) set(app *App) {
 		GrantTypes:               c.grantTypes,
 		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
 		BackChannelLogoutURI:     c.backChannelLogoutURI.String,
+		RequireConsent:           c.requireConsent.Bool,
+		RequiredACR:              c.requiredACR.String,
 	}
 	compliance := domain.GetOIDCCompliance(app.OIDCConfig.Version, app.OIDCConfig.AppType, app.OIDCConfig.GrantTypes, app.OIDCConfig.ResponseTypes, app.OIDCConfig.AuthMethodType, app.OIDCConfig.RedirectURIs)
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
@@ -1070,17 +1114,26 @@ type sqlSAMLConfig struct {
 	entityID    sql.NullString
 	metadataURL sql.NullString
 	metadata    []byte
+	options     []byte
 }
 
-func (c sqlSAMLConfig) set(app *App) {
+func (c sqlSAMLConfig) set(app *App) error {
 	if !c.appID.Valid {
-		return
+		return nil
 	}
 	app.SAMLConfig = &SAMLApp{
 		MetadataURL: c.metadataURL.String,
 		Metadata:    c.metadata,
 		EntityID:    c.entityID.String,
 	}
+	if len(c.options) == 0 {
+		return nil
+	}
+	app.SAMLConfig.Options = new(domain.SAMLAppOptions)
+	if err := json.Unmarshal(c.options, app.SAMLConfig.Options); err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-Sfk3n", "Errors.Internal")
+	}
+	return nil
 }
 
 type sqlAPIConfig struct {
@@ -49,11 +49,14 @@ var (
 		` projections.apps7_oidc_configs.additional_origins,` +
 		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps7_oidc_configs.require_consent,` +
+		` projections.apps7_oidc_configs.required_acr,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
 		` projections.apps7_saml_configs.metadata,` +
-		` projections.apps7_saml_configs.metadata_url` +
+		` projections.apps7_saml_configs.metadata_url,` +
+		` projections.apps7_saml_configs.options` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
 		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
@@ -93,11 +96,14 @@ var (
 		` projections.apps7_oidc_configs.additional_origins,` +
 		` projections.apps7_oidc_configs.skip_native_app_success_page,` +
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
+		` projections.apps7_oidc_configs.require_consent,` +
+		` projections.apps7_oidc_configs.required_acr,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
 		` projections.apps7_saml_configs.metadata,` +
 		` projections.apps7_saml_configs.metadata_url,` +
+		` projections.apps7_saml_configs.options,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
@@ -166,11 +172,14 @@ var (
 		"additional_origins",
 		"skip_native_app_success_page",
 		"back_channel_logout_uri",
+		"require_consent",
+		"required_acr",
 		//saml config
 		"app_id",
 		"entity_id",
 		"metadata",
 		"metadata_url",
+		"options",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -238,11 +247,14 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -305,11 +317,14 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -375,11 +390,14 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							nil,
 						},
 					},
 				),
@@ -447,11 +465,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -535,11 +556,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -623,11 +647,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -711,11 +738,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -799,11 +829,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -887,11 +920,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							true,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -935,6 +971,99 @@ func Test_AppsPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareAppsQuery oidc app require consent and acr",
+			prepare: prepareAppsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedAppsQuery,
+					appsCols,
+					[][]driver.Value{
+						{
+							"app-id",
+							"app-name",
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.AppStateActive,
+							uint64(20211109),
+							// api config
+							nil,
+							nil,
+							nil,
+							// oidc config
+							"app-id",
+							domain.OIDCVersionV1,
+							"oidc-client-id",
+							database.TextArray[string]{"https://redirect.to/me"},
+							database.NumberArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							database.NumberArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							domain.OIDCApplicationTypeNative,
+							domain.OIDCAuthMethodTypeNone,
+							database.TextArray[string]{"post.logout.ch"},
+							false,
+							domain.OIDCTokenTypeJWT,
+							false,
+							false,
+							true,
+							1 * time.Second,
+							database.TextArray[string]{"additional.origin"},
+							false,
+							"back.channel.logout.ch",
+							true,
+							"mfa",
+							// saml config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &Apps{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Apps: []*App{
+					{
+						ID:            "app-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.AppStateActive,
+						Sequence:      20211109,
+						Name:          "app-name",
+						ProjectID:     "project-id",
+						OIDCConfig: &OIDCApp{
+							Version:                  domain.OIDCVersionV1,
+							ClientID:                 "oidc-client-id",
+							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
+							ResponseTypes:            database.NumberArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:               database.NumberArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                  domain.OIDCApplicationTypeNative,
+							AuthMethodType:           domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:   database.TextArray[string]{"post.logout.ch"},
+							IsDevMode:                false,
+							AccessTokenType:          domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:    false,
+							AssertIDTokenRole:        false,
+							AssertIDTokenUserinfo:    true,
+							ClockSkew:                1 * time.Second,
+							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
+							ComplianceProblems:       nil,
+							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							SkipNativeAppSuccessPage: false,
+							BackChannelLogoutURI:     "back.channel.logout.ch",
+							RequireConsent:           true,
+							RequiredACR:              "mfa",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareAppsQuery multiple result",
 			prepare: prepareAppsQuery,
@@ -975,11 +1104,14 @@ func Test_AppsPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -1013,11 +1145,14 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 						{
 							"saml-app-id",
@@ -1051,11 +1186,14 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							nil,
 						},
 					},
 				),
@@ -1228,11 +1366,14 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
 						nil,
 						nil,
+						nil,
 					},
 				),
 			},
@@ -1289,11 +1430,14 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1355,11 +1499,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1438,11 +1585,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1521,11 +1671,14 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
 							[]byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							"https://test.com/saml/metadata",
+							[]byte(`{"nameIdFormat":2}`),
 						},
 					},
 				),
@@ -1543,6 +1696,9 @@ func Test_AppPrepare(t *testing.T) {
 					Metadata:    []byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 					MetadataURL: "https://test.com/saml/metadata",
 					EntityID:    "https://test.com/saml/metadata",
+					Options: &domain.SAMLAppOptions{
+						NameIDFormat: domain.SAMLNameIDFormatPersistent,
+					},
 				},
 			},
 		},
@@ -1588,11 +1744,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1671,11 +1830,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1754,11 +1916,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -1837,11 +2002,14 @@ func Test_AppPrepare(t *testing.T) {
 							database.TextArray[string]{"additional.origin"},
 							false,
 							"back.channel.logout.ch",
+							false,
+							"",
 							// saml config
 							nil,
 							nil,
 							nil,
 							nil,
+							nil,
 						},
 					},
 				),
@@ -0,0 +1,226 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type CustomRoles struct {
+	SearchResponse
+	Roles []*CustomRole
+}
+
+type CustomRole struct {
+	ResourceOwner string
+	Key           string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	DisplayName   string
+	Permissions   database.TextArray[string]
+	// IsDefault is set if the role is defined on the instance
+	IsDefault bool
+}
+
+type CustomRoleSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	customRoleTable = table{
+		name:          projection.CustomRoleProjectionTable,
+		instanceIDCol: projection.CustomRoleColumnInstanceID,
+	}
+	CustomRoleColumnInstanceID = Column{
+		name:  projection.CustomRoleColumnInstanceID,
+		table: customRoleTable,
+	}
+	CustomRoleColumnResourceOwner = Column{
+		name:  projection.CustomRoleColumnResourceOwner,
+		table: customRoleTable,
+	}
+	CustomRoleColumnKey = Column{
+		name:  projection.CustomRoleColumnKey,
+		table: customRoleTable,
+	}
+	CustomRoleColumnCreationDate = Column{
+		name:  projection.CustomRoleColumnCreationDate,
+		table: customRoleTable,
+	}
+	CustomRoleColumnChangeDate = Column{
+		name:  projection.CustomRoleColumnChangeDate,
+		table: customRoleTable,
+	}
+	CustomRoleColumnSequence = Column{
+		name:  projection.CustomRoleColumnSequence,
+		table: customRoleTable,
+	}
+	CustomRoleColumnDisplayName = Column{
+		name:  projection.CustomRoleColumnDisplayName,
+		table: customRoleTable,
+	}
+	CustomRoleColumnPermissions = Column{
+		name:  projection.CustomRoleColumnPermissions,
+		table: customRoleTable,
+	}
+)
+
+// SearchCustomRoles returns the custom roles matching the queries
+func (q *Queries) SearchCustomRoles(ctx context.Context, queries *CustomRoleSearchQueries) (roles *CustomRoles, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	query, scan := prepareCustomRolesQuery(ctx, q.client)
+	eq := sq.Eq{
+		CustomRoleColumnInstanceID.identifier(): instanceID,
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cr8aZu", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		roles, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles.Roles {
+		role.IsDefault = role.ResourceOwner == instanceID
+	}
+	roles.State, err = q.latestState(ctx, customRoleTable)
+	return roles, err
+}
+
+// CustomRoleMappingsByOrg returns the permissions of the custom roles assignable in the organization.
+// Roles of the organization take precedence over the ones of the instance with the same key.
+func (q *Queries) CustomRoleMappingsByOrg(ctx context.Context, orgID string) (_ []authz.RoleMapping, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	owners := []string{instanceID}
+	if orgID != "" && orgID != instanceID {
+		owners = append(owners, orgID)
+	}
+	ownerQuery, err := NewCustomRoleResourceOwnersSearchQuery(owners...)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := q.SearchCustomRoles(ctx, &CustomRoleSearchQueries{Queries: []SearchQuery{ownerQuery}})
+	if err != nil {
+		return nil, err
+	}
+	return roles.roleMappings(), nil
+}
+
+// roleMappings maps the roles to their permissions, where org roles override the default ones.
+func (r *CustomRoles) roleMappings() []authz.RoleMapping {
+	result := make([]authz.RoleMapping, 0, len(r.Roles))
+	index := make(map[string]int, len(r.Roles))
+	for _, role := range r.Roles {
+		i, ok := index[role.Key]
+		if ok && role.IsDefault {
+			continue
+		}
+		mapping := authz.RoleMapping{
+			Role:        role.Key,
+			Permissions: role.Permissions,
+		}
+		if ok {
+			result[i] = mapping
+			continue
+		}
+		index[role.Key] = len(result)
+		result = append(result, mapping)
+	}
+	return result
+}
+
+func (q *CustomRoleSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewCustomRoleResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(CustomRoleColumnResourceOwner, value, TextEquals)
+}
+
+func NewCustomRoleResourceOwnersSearchQuery(values ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(values))
+	for i, value := range values {
+		list[i] = value
+	}
+	return NewListQuery(CustomRoleColumnResourceOwner, list, ListIn)
+}
+
+func NewCustomRoleKeySearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(CustomRoleColumnKey, value, TextEquals)
+}
+
+func NewCustomRoleKeyPrefixSearchQuery(prefix string) (SearchQuery, error) {
+	return NewTextQuery(CustomRoleColumnKey, prefix, TextStartsWith)
+}
+
+func prepareCustomRolesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*CustomRoles, error)) {
+	return sq.Select(
+			CustomRoleColumnResourceOwner.identifier(),
+			CustomRoleColumnKey.identifier(),
+			CustomRoleColumnCreationDate.identifier(),
+			CustomRoleColumnChangeDate.identifier(),
+			CustomRoleColumnSequence.identifier(),
+			CustomRoleColumnDisplayName.identifier(),
+			CustomRoleColumnPermissions.identifier(),
+			countColumn.identifier(),
+		).
+			From(customRoleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*CustomRoles, error) {
+			roles := make([]*CustomRole, 0)
+			var count uint64
+			for rows.Next() {
+				role := new(CustomRole)
+				err := rows.Scan(
+					&role.ResourceOwner,
+					&role.Key,
+					&role.CreationDate,
+					&role.ChangeDate,
+					&role.Sequence,
+					&role.DisplayName,
+					&role.Permissions,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				roles = append(roles, role)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Cr8bXe", "Errors.Query.CloseRows")
+			}
+
+			return &CustomRoles{
+				Roles: roles,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
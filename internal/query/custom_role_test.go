@@ -0,0 +1,156 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	customRolesQuery = `SELECT projections.custom_roles.resource_owner,` +
+		` projections.custom_roles.role_key,` +
+		` projections.custom_roles.creation_date,` +
+		` projections.custom_roles.change_date,` +
+		` projections.custom_roles.sequence,` +
+		` projections.custom_roles.display_name,` +
+		` projections.custom_roles.permissions,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.custom_roles AS OF SYSTEM TIME '-1 ms'`
+	customRolesCols = []string{
+		"resource_owner",
+		"role_key",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"display_name",
+		"permissions",
+		"count",
+	}
+)
+
+func Test_CustomRolePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareCustomRolesQuery no result",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(customRolesQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &CustomRoles{Roles: []*CustomRole{}},
+		},
+		{
+			name:    "prepareCustomRolesQuery multiple results",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(customRolesQuery),
+					customRolesCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							"IAM_CUSTOM_AUDITOR",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"Auditor",
+							database.TextArray[string]{"iam.read"},
+						},
+						{
+							"org-id",
+							"ORG_CUSTOM_HELPDESK",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"",
+							database.TextArray[string]{"user.write", "user.credential.write"},
+						},
+					},
+				),
+			},
+			object: &CustomRoles{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Roles: []*CustomRole{
+					{
+						ResourceOwner: "instance-id",
+						Key:           "IAM_CUSTOM_AUDITOR",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						DisplayName:   "Auditor",
+						Permissions:   database.TextArray[string]{"iam.read"},
+					},
+					{
+						ResourceOwner: "org-id",
+						Key:           "ORG_CUSTOM_HELPDESK",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						Permissions:   database.TextArray[string]{"user.write", "user.credential.write"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareCustomRolesQuery sql err",
+			prepare: prepareCustomRolesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(customRolesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*CustomRoles)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func TestCustomRoles_roleMappings(t *testing.T) {
+	roles := &CustomRoles{
+		Roles: []*CustomRole{
+			{Key: "ORG_CUSTOM_HELPDESK", ResourceOwner: "org-id", Permissions: []string{"user.write"}},
+			{Key: "ORG_CUSTOM_HELPDESK", ResourceOwner: "instance-id", Permissions: []string{"user.read"}, IsDefault: true},
+			{Key: "IAM_CUSTOM_AUDITOR", ResourceOwner: "instance-id", Permissions: []string{"iam.read"}, IsDefault: true},
+			{Key: "ORG_CUSTOM_VIEWER", ResourceOwner: "instance-id", Permissions: []string{"org.read"}, IsDefault: true},
+			{Key: "ORG_CUSTOM_VIEWER", ResourceOwner: "org-id", Permissions: []string{"org.read", "user.read"}},
+		},
+	}
+	assert.Equal(t, []authz.RoleMapping{
+		{Role: "ORG_CUSTOM_HELPDESK", Permissions: []string{"user.write"}},
+		{Role: "IAM_CUSTOM_AUDITOR", Permissions: []string{"iam.read"}},
+		{Role: "ORG_CUSTOM_VIEWER", Permissions: []string{"org.read", "user.read"}},
+	}, roles.roleMappings())
+}
@@ -41,6 +41,10 @@ var (
 		name:  projection.ExecutionInstanceIDCol,
 		table: executionTable,
 	}
+	ExecutionColumnCondition = Column{
+		name:  projection.ExecutionConditionCol,
+		table: executionTable,
+	}
 	executionTargetsTable = table{
 		name:          projection.ExecutionTable + "_" + projection.ExecutionTargetSuffix,
 		instanceIDCol: projection.ExecutionTargetInstanceIDCol,
@@ -81,7 +85,8 @@ func (e *Executions) SetState(s *State) {
 type Execution struct {
 	domain.ObjectDetails
 
-	Targets []*exec.Target
+	Targets   []*exec.Target
+	Condition string
 }
 
 type ExecutionSearchQueries struct {
@@ -177,7 +182,7 @@ func (q *Queries) TargetsByExecutionID(ctx context.Context, ids []string) (execu
 		database.TextArray[string](ids),
 	)
 	for i := range execution {
-		if err := execution[i].decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
+		if err := execution[i].decryptSecrets(q.targetEncryptionAlgorithm); err != nil {
 			return nil, err
 		}
 	}
@@ -212,7 +217,7 @@ func (q *Queries) TargetsByExecutionIDs(ctx context.Context, ids1, ids2 []string
 		database.TextArray[string](ids2),
 	)
 	for i := range execution {
-		if err := execution[i].decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
+		if err := execution[i].decryptSecrets(q.targetEncryptionAlgorithm); err != nil {
 			return nil, err
 		}
 	}
@@ -226,6 +231,7 @@ func prepareExecutionQuery(context.Context, prepareDatabase) (sq.SelectBuilder,
 			ExecutionColumnCreationDate.identifier(),
 			ExecutionColumnChangeDate.identifier(),
 			executionTargetsListCol.identifier(),
+			ExecutionColumnCondition.identifier(),
 		).From(executionTable.identifier()).
 			Join("(" + executionTargetsQuery + ") AS " + executionTargetsTableAlias.alias + " ON " +
 				ExecutionTargetsColumnInstanceID.identifier() + " = " + ExecutionColumnInstanceID.identifier() + " AND " +
@@ -242,6 +248,7 @@ func prepareExecutionsQuery(context.Context, prepareDatabase) (sq.SelectBuilder,
 			ExecutionColumnCreationDate.identifier(),
 			ExecutionColumnChangeDate.identifier(),
 			executionTargetsListCol.identifier(),
+			ExecutionColumnCondition.identifier(),
 			countColumn.identifier(),
 		).From(executionTable.identifier()).
 			Join("(" + executionTargetsQuery + ") AS " + executionTargetsTableAlias.alias + " ON " +
@@ -261,6 +268,7 @@ type executionTarget struct {
 func scanExecution(row *sql.Row) (*Execution, error) {
 	execution := new(Execution)
 	targets := make([]byte, 0)
+	condition := sql.NullString{}
 
 	err := row.Scan(
 		&execution.ResourceOwner,
@@ -268,6 +276,7 @@ func scanExecution(row *sql.Row) (*Execution, error) {
 		&execution.CreationDate,
 		&execution.EventDate,
 		&targets,
+		&condition,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -281,6 +290,7 @@ func scanExecution(row *sql.Row) (*Execution, error) {
 		return nil, err
 	}
 
+	execution.Condition = condition.String
 	execution.Targets = make([]*exec.Target, len(executionTargets))
 	for i := range executionTargets {
 		if executionTargets[i].Target != "" {
@@ -320,6 +330,7 @@ func scanExecutions(rows *sql.Rows) (*Executions, error) {
 	for rows.Next() {
 		execution := new(Execution)
 		targets := make([]byte, 0)
+		condition := sql.NullString{}
 
 		err := rows.Scan(
 			&execution.ResourceOwner,
@@ -327,6 +338,7 @@ func scanExecutions(rows *sql.Rows) (*Executions, error) {
 			&execution.CreationDate,
 			&execution.EventDate,
 			&targets,
+			&condition,
 			&count,
 		)
 		if err != nil {
@@ -336,6 +348,7 @@ func scanExecutions(rows *sql.Rows) (*Executions, error) {
 			return nil, zerrors.ThrowInternal(err, "QUERY-tyw2ydsj84", "Errors.Internal")
 		}
 
+		execution.Condition = condition.String
 		execution.Targets, err = executionTargetsUnmarshal(targets)
 		if err != nil {
 			return nil, err
@@ -365,6 +378,13 @@ type ExecutionTarget struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	tls              []byte
+	TLS              *domain.TargetTLS
+	oauth2           []byte
+	OAuth2           *domain.TargetOAuth2
+	Module           []byte
+	// Conditions of the execution and the included executions the target is part of
+	Conditions []string
 }
 
 func (e *ExecutionTarget) GetExecutionID() string {
@@ -388,10 +408,23 @@ func (e *ExecutionTarget) GetTimeout() time.Duration {
 func (e *ExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *ExecutionTarget) GetConditions() []string {
+	return e.Conditions
+}
+func (e *ExecutionTarget) GetTLS() *domain.TargetTLS {
+	return e.TLS
+}
+func (e *ExecutionTarget) GetOAuth2() *domain.TargetOAuth2 {
+	return e.OAuth2
+}
+func (e *ExecutionTarget) GetModule() []byte {
+	return e.Module
+}
 
-func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
-	if t.signingKey == nil {
-		return nil
+func (t *ExecutionTarget) decryptSecrets(alg crypto.EncryptionAlgorithm) (err error) {
+	t.TLS, t.OAuth2, err = decryptTargetTransports(t.tls, t.oauth2, alg)
+	if err != nil || t.signingKey == nil {
+		return err
 	}
 	keyValue, err := crypto.DecryptString(t.signingKey, alg)
 	if err != nil {
@@ -415,6 +448,10 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout          = &sql.NullInt64{}
 			interruptOnError = &sql.NullBool{}
 			signingKey       = &crypto.CryptoValue{}
+			tlsConfig        []byte
+			oauth2Config     []byte
+			module           []byte
+			conditions       = database.TextArray[string]{}
 		)
 
 		err := rows.Scan(
@@ -426,6 +463,10 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout,
 			interruptOnError,
 			signingKey,
+			&tlsConfig,
+			&oauth2Config,
+			&module,
+			&conditions,
 		)
 
 		if err != nil {
@@ -440,6 +481,10 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 		target.Timeout = time.Duration(timeout.Int64)
 		target.InterruptOnError = interruptOnError.Bool
 		target.signingKey = signingKey
+		target.tls = tlsConfig
+		target.oauth2 = oauth2Config
+		target.Module = module
+		target.Conditions = conditions
 
 		targets = append(targets, target)
 	}
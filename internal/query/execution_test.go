@@ -19,6 +19,7 @@ var (
 		` projections.executions1.creation_date,` +
 		` projections.executions1.change_date,` +
 		` execution_targets.targets,` +
+		` projections.executions1.condition,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.executions1` +
 		` JOIN (` +
@@ -35,6 +36,7 @@ var (
 		"creation_date",
 		"change_date",
 		"targets",
+		"condition",
 		"count",
 	}
 
@@ -42,7 +44,8 @@ var (
 		` projections.executions1.id,` +
 		` projections.executions1.creation_date,` +
 		` projections.executions1.change_date,` +
-		` execution_targets.targets` +
+		` execution_targets.targets,` +
+		` projections.executions1.condition` +
 		` FROM projections.executions1` +
 		` JOIN (` +
 		`SELECT instance_id, execution_id, JSONB_AGG( JSON_OBJECT( 'position' : position, 'include' : include, 'target' : target_id ) ) as targets` +
@@ -58,6 +61,7 @@ var (
 		"creation_date",
 		"change_date",
 		"targets",
+		"condition",
 	}
 )
 
@@ -98,6 +102,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							testNow,
 							testNow,
 							[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
+							nil,
 						},
 					},
 				),
@@ -136,6 +141,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							testNow,
 							testNow,
 							[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
+							nil,
 						},
 						{
 							"ro",
@@ -143,6 +149,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							testNow,
 							testNow,
 							[]byte(`[{"position" : 2, "target" : "target"}, {"position" : 1, "include" : "include"}]`),
+							"has(request.userId)",
 						},
 					},
 				),
@@ -175,6 +182,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 							{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 						},
+						Condition: "has(request.userId)",
 					},
 				},
 			},
@@ -227,6 +235,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 						testNow,
 						testNow,
 						[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
+						nil,
 					},
 				),
 			},
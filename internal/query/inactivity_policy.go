@@ -0,0 +1,238 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type InactivityPolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.PolicyState
+
+	WarnBeforeDays             uint64
+	HumanDeactivateAfterDays   uint64
+	HumanDeleteAfterDays       uint64
+	MachineDeactivateAfterDays uint64
+	MachineDeleteAfterDays     uint64
+
+	IsDefault bool
+}
+
+func (p *InactivityPolicy) ToDomain() *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnBeforeDays:             p.WarnBeforeDays,
+		HumanDeactivateAfterDays:   p.HumanDeactivateAfterDays,
+		HumanDeleteAfterDays:       p.HumanDeleteAfterDays,
+		MachineDeactivateAfterDays: p.MachineDeactivateAfterDays,
+		MachineDeleteAfterDays:     p.MachineDeleteAfterDays,
+	}
+}
+
+var (
+	inactivityPolicyTable = table{
+		name:          projection.InactivityPolicyTable,
+		instanceIDCol: projection.InactivityPolicyInstanceIDCol,
+	}
+	InactivityPolicyColID = Column{
+		name:  projection.InactivityPolicyIDCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColSequence = Column{
+		name:  projection.InactivityPolicySequenceCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColCreationDate = Column{
+		name:  projection.InactivityPolicyCreationDateCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColChangeDate = Column{
+		name:  projection.InactivityPolicyChangeDateCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColResourceOwner = Column{
+		name:  projection.InactivityPolicyResourceOwnerCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColInstanceID = Column{
+		name:  projection.InactivityPolicyInstanceIDCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColWarnBeforeDays = Column{
+		name:  projection.InactivityPolicyWarnBeforeDaysCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColHumanDeactivateAfterDays = Column{
+		name:  projection.InactivityPolicyHumanDeactivateAfterDaysCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColHumanDeleteAfterDays = Column{
+		name:  projection.InactivityPolicyHumanDeleteAfterDaysCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColMachineDeactivateAfterDays = Column{
+		name:  projection.InactivityPolicyMachineDeactivateAfterDaysCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColMachineDeleteAfterDays = Column{
+		name:  projection.InactivityPolicyMachineDeleteAfterDaysCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColIsDefault = Column{
+		name:  projection.InactivityPolicyIsDefaultCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColState = Column{
+		name:  projection.InactivityPolicyStateCol,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColOwnerRemoved = Column{
+		name:  projection.InactivityPolicyOwnerRemovedCol,
+		table: inactivityPolicyTable,
+	}
+)
+
+// InactivityPolicyByOrg returns the inactivity policy of the organization, its closest ancestor or the instance.
+// If none is defined, a disabled default policy is returned.
+func (q *Queries) InactivityPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (policy *InactivityPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerInactivityPolicyProjection")
+		ctx, err = projection.InactivityPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+	eq := sq.Eq{InactivityPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if !withOwnerRemoved {
+		eq[InactivityPolicyColOwnerRemoved.identifier()] = false
+	}
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	stmt, scan := prepareInactivityPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			eq,
+			sq.Eq{InactivityPolicyColID.identifier(): chain},
+		}).
+		OrderByClause(policyChainOrder(InactivityPolicyColID, chain)).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-In6aAa", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return disabledInactivityPolicy(ctx), nil
+	}
+	return policy, err
+}
+
+// DefaultInactivityPolicy returns the inactivity policy of the instance.
+// If none is defined, a disabled policy is returned.
+func (q *Queries) DefaultInactivityPolicy(ctx context.Context, shouldTriggerBulk bool) (policy *InactivityPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerInactivityPolicyProjection")
+		ctx, err = projection.InactivityPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	stmt, scan := prepareInactivityPolicyQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		InactivityPolicyColID.identifier():         authz.GetInstance(ctx).InstanceID(),
+		InactivityPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-In6bBb", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return disabledInactivityPolicy(ctx), nil
+	}
+	return policy, err
+}
+
+// disabledInactivityPolicy is used as default policy of instances, which did not define one.
+func disabledInactivityPolicy(ctx context.Context) *InactivityPolicy {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return &InactivityPolicy{
+		ID:            instanceID,
+		ResourceOwner: instanceID,
+		State:         domain.PolicyStateActive,
+		IsDefault:     true,
+	}
+}
+
+func prepareInactivityPolicyQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*InactivityPolicy, error)) {
+	return sq.Select(
+			InactivityPolicyColID.identifier(),
+			InactivityPolicyColSequence.identifier(),
+			InactivityPolicyColCreationDate.identifier(),
+			InactivityPolicyColChangeDate.identifier(),
+			InactivityPolicyColResourceOwner.identifier(),
+			InactivityPolicyColWarnBeforeDays.identifier(),
+			InactivityPolicyColHumanDeactivateAfterDays.identifier(),
+			InactivityPolicyColHumanDeleteAfterDays.identifier(),
+			InactivityPolicyColMachineDeactivateAfterDays.identifier(),
+			InactivityPolicyColMachineDeleteAfterDays.identifier(),
+			InactivityPolicyColIsDefault.identifier(),
+			InactivityPolicyColState.identifier(),
+		).
+			From(inactivityPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*InactivityPolicy, error) {
+			policy := new(InactivityPolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.WarnBeforeDays,
+				&policy.HumanDeactivateAfterDays,
+				&policy.HumanDeleteAfterDays,
+				&policy.MachineDeactivateAfterDays,
+				&policy.MachineDeleteAfterDays,
+				&policy.IsDefault,
+				&policy.State,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-In6cCc", "Errors.InactivityPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-In6dDd", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -44,6 +45,8 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        database.Duration
 	SecondFactorCheckLifetime  database.Duration
 	MultiFactorCheckLifetime   database.Duration
+	TrustedDeviceLifetime      database.Duration
+	RiskThresholds             domain.RiskThresholds
 	IDPLinks                   []*IDPLoginPolicyLink
 }
 
@@ -162,6 +165,22 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskNotifyThreshold = Column{
+		name:  projection.RiskNotifyThresholdCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskRequireMFAThreshold = Column{
+		name:  projection.RiskRequireMFAThresholdCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnRiskBlockThreshold = Column{
+		name:  projection.RiskBlockThresholdCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnOwnerRemoved = Column{
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
@@ -183,15 +202,17 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 		eq[LoginPolicyColumnOwnerRemoved.identifier()] = false
 	}
 
+	ancestors, err := q.orgPolicyAncestors(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	chain := ancestors.PolicyChain(orgID, authz.GetInstance(ctx).InstanceID())
 	query, scan := prepareLoginPolicyQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+			sq.Eq{LoginPolicyColumnOrgID.identifier(): chain},
+		}).Limit(1).OrderByClause(policyChainOrder(LoginPolicyColumnOrgID, chain)).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -203,7 +224,10 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-SWgr3", "Errors.Internal")
 	}
-	return policy, q.addLinksToLoginPolicy(ctx, policy)
+	if err = q.addLinksToLoginPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, q.addInheritedLinksToLoginPolicy(ctx, policy, ancestors.IDPOrgIDs(policy.OrgID))
 }
 
 func (q *Queries) addLinksToLoginPolicy(ctx context.Context, policy *LoginPolicy) error {
@@ -215,6 +239,23 @@ func (q *Queries) addLinksToLoginPolicy(ctx context.Context, policy *LoginPolicy
 	return nil
 }
 
+// addInheritedLinksToLoginPolicy adds the identity providers of the ancestors,
+// which pass them down to the owner of the policy.
+func (q *Queries) addInheritedLinksToLoginPolicy(ctx context.Context, policy *LoginPolicy, orgIDs []string) error {
+	for _, orgID := range orgIDs {
+		links, err := q.IDPLoginPolicyLinks(ctx, orgID, &IDPLoginPolicyLinksSearchQuery{}, false)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-Hq1bIp", "Errors.Internal")
+		}
+		for _, link := range links.Links {
+			if !slices.ContainsFunc(policy.IDPLinks, func(existing *IDPLoginPolicyLink) bool { return existing.IDPID == link.IDPID }) {
+				policy.IDPLinks = append(policy.IDPLinks, link)
+			}
+		}
+	}
+	return nil
+}
+
 func (q *Queries) DefaultLoginPolicy(ctx context.Context) (policy *LoginPolicy, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -384,6 +425,10 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
+			LoginPolicyColumnRiskNotifyThreshold.identifier(),
+			LoginPolicyColumnRiskRequireMFAThreshold.identifier(),
+			LoginPolicyColumnRiskBlockThreshold.identifier(),
 		).From(loginPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -415,6 +460,10 @@ func prepareLoginPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.TrustedDeviceLifetime,
+					&p.RiskThresholds.Notify,
+					&p.RiskThresholds.RequireMFA,
+					&p.RiskThresholds.Block,
 				)
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
@@ -38,7 +38,11 @@ var (
 		` projections.login_policies5.external_login_check_lifetime,` +
 		` projections.login_policies5.mfa_init_skip_lifetime,` +
 		` projections.login_policies5.second_factor_check_lifetime,` +
-		` projections.login_policies5.multi_factor_check_lifetime` +
+		` projections.login_policies5.multi_factor_check_lifetime,` +
+		` projections.login_policies5.trusted_device_lifetime,` +
+		` projections.login_policies5.risk_notify_threshold,` +
+		` projections.login_policies5.risk_require_mfa_threshold,` +
+		` projections.login_policies5.risk_block_threshold` +
 		` FROM projections.login_policies5` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	loginPolicyCols = []string{
@@ -66,6 +70,10 @@ var (
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"trusted_device_lifetime",
+		"risk_notify_threshold",
+		"risk_require_mfa_threshold",
+		"risk_block_threshold",
 	}
 
 	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies5.second_factors` +
@@ -145,6 +153,10 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						&duration,
 						&duration,
 						&duration,
+						&duration,
+						20,
+						40,
+						80,
 					},
 				),
 			},
@@ -173,6 +185,8 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				MFAInitSkipLifetime:        database.Duration(duration),
 				SecondFactorCheckLifetime:  database.Duration(duration),
 				MultiFactorCheckLifetime:   database.Duration(duration),
+				TrustedDeviceLifetime:      database.Duration(duration),
+				RiskThresholds:             domain.RiskThresholds{Notify: 20, RequireMFA: 40, Block: 80},
 			},
 		},
 		{
@@ -0,0 +1,319 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MessageTemplate struct {
+	AggregateID  string
+	Sequence     uint64
+	CreationDate time.Time
+	ChangeDate   time.Time
+
+	MessageType string
+	Language    language.Tag
+	Format      domain.MessageTemplateFormat
+	StoreKey    string
+	Template    []byte
+	IsDefault   bool
+}
+
+var (
+	messageTemplateTable = table{
+		name:          projection.MessageTemplateTable,
+		instanceIDCol: projection.MessageTemplateInstanceIDCol,
+	}
+	MessageTemplateColAggregateID = Column{
+		name:  projection.MessageTemplateAggregateIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColInstanceID = Column{
+		name:  projection.MessageTemplateInstanceIDCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColSequence = Column{
+		name:  projection.MessageTemplateSequenceCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColCreationDate = Column{
+		name:  projection.MessageTemplateCreationDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColChangeDate = Column{
+		name:  projection.MessageTemplateChangeDateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColMessageType = Column{
+		name:  projection.MessageTemplateMessageTypeCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColLanguage = Column{
+		name:  projection.MessageTemplateLanguageCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColFormat = Column{
+		name:  projection.MessageTemplateFormatCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColStoreKey = Column{
+		name:  projection.MessageTemplateStoreKeyCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColTemplate = Column{
+		name:  projection.MessageTemplateTemplateCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColIsDefault = Column{
+		name:  projection.MessageTemplateIsDefaultCol,
+		table: messageTemplateTable,
+	}
+	MessageTemplateColOwnerRemoved = Column{
+		name:  projection.MessageTemplateOwnerRemovedCol,
+		table: messageTemplateTable,
+	}
+)
+
+// MessageTemplateByOrg returns the template uploaded for the message type.
+// Templates of the organization are preferred over the ones of the instance,
+// if there is none for the requested language, the default language of the instance is used.
+func (q *Queries) MessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (template *MessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instance := authz.GetInstance(ctx)
+	languages := []string{instance.DefaultLanguage().String()}
+	if !lang.IsRoot() && lang != instance.DefaultLanguage() {
+		languages = append(languages, lang.String())
+	}
+	stmt, scan := prepareMessageTemplateQuery(ctx, q.client)
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{
+				MessageTemplateColInstanceID.identifier():   instance.InstanceID(),
+				MessageTemplateColMessageType.identifier():  messageType,
+				MessageTemplateColLanguage.identifier():     languages,
+				MessageTemplateColOwnerRemoved.identifier(): false,
+			},
+			sq.Or{
+				sq.Eq{MessageTemplateColAggregateID.identifier(): orgID},
+				sq.Eq{MessageTemplateColAggregateID.identifier(): instance.InstanceID()},
+			},
+		}).
+		OrderByClause(MessageTemplateColLanguage.identifier()+" = ? DESC", lang.String()).
+		OrderBy(MessageTemplateColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Mt1aQa", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		template, err = scan(row)
+		return err
+	}, query, args...)
+	return template, err
+}
+
+// DefaultMessageTemplate returns the template of the instance for the message type and language
+func (q *Queries) DefaultMessageTemplate(ctx context.Context, messageType string, lang language.Tag) (template *MessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareMessageTemplateQuery(ctx, q.client)
+	query, args, err := stmt.Where(sq.Eq{
+		MessageTemplateColAggregateID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		MessageTemplateColInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+		MessageTemplateColMessageType.identifier(): messageType,
+		MessageTemplateColLanguage.identifier():    lang.String(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Mt1aQb", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		template, err = scan(row)
+		return err
+	}, query, args...)
+	return template, err
+}
+
+// MessageMailTemplateByOrg returns the HTML used for the mails of the message type.
+// If no template was uploaded for the message type, the mail template of the organization is returned.
+func (q *Queries) MessageMailTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (_ *MailTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	template, err := q.MessageTemplateByOrg(ctx, orgID, messageType, lang)
+	if zerrors.IsNotFound(err) {
+		return q.MailTemplateByOrg(ctx, orgID, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &MailTemplate{
+		AggregateID:  template.AggregateID,
+		Sequence:     template.Sequence,
+		CreationDate: template.CreationDate,
+		ChangeDate:   template.ChangeDate,
+		State:        domain.PolicyStateActive,
+		Template:     template.Template,
+		IsDefault:    template.IsDefault,
+	}, nil
+}
+
+// PreviewMessageTemplate renders the mail of the message type with sample data.
+// If no source is passed, the template currently used for the organization is rendered.
+func (q *Queries) PreviewMessageTemplate(ctx context.Context, orgID, messageType string, lang language.Tag, source []byte, format domain.MessageTemplateFormat) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !domain.IsMessageTextType(messageType) {
+		return "", zerrors.ThrowInvalidArgument(nil, "QUERY-Mt1aQc", "Errors.MessageTemplate.Invalid")
+	}
+	if lang.IsRoot() {
+		lang = authz.GetInstance(ctx).DefaultLanguage()
+	}
+	var mailhtml string
+	if len(source) > 0 {
+		mailhtml, err = templates.CompileMessageTemplate(string(source), format)
+		if err != nil {
+			return "", zerrors.ThrowInvalidArgument(err, "QUERY-Mt1aQd", "Errors.MessageTemplate.Invalid")
+		}
+	} else {
+		template, err := q.MessageMailTemplateByOrg(ctx, orgID, messageType, lang)
+		if err != nil {
+			return "", err
+		}
+		mailhtml = string(template.Template)
+	}
+	policy, err := q.ActiveLabelPolicyByOrg(ctx, orgID, false)
+	if err != nil {
+		return "", err
+	}
+	text, err := q.CustomMessageTextByTypeAndLanguage(ctx, orgID, messageType, lang.String(), false)
+	if err != nil {
+		return "", err
+	}
+	data, err := previewTemplateData(text, policy)
+	if err != nil {
+		return "", err
+	}
+	preview, err := templates.GetParsedTemplate(mailhtml, data)
+	if err != nil {
+		return "", zerrors.ThrowInvalidArgument(err, "QUERY-Mt1aQe", "Errors.MessageTemplate.Invalid")
+	}
+	return preview, nil
+}
+
+// previewArgs are the sample values used for the placeholders of the message texts
+var previewArgs = map[string]interface{}{
+	"UserName":           "john.doe",
+	"FirstName":          "John",
+	"LastName":           "Doe",
+	"NickName":           "Johnny",
+	"DisplayName":        "John Doe",
+	"PreferredLoginName": "john.doe@example.com",
+	"LoginNames":         "john.doe@example.com",
+	"Email":              "john.doe@example.com",
+	"Phone":              "+41 79 123 45 67",
+	"Code":               "ABC123",
+	"OTP":                "123456",
+	"Domain":             "example.com",
+	"Expiry":             "1h",
+	"OrgName":            "ACME",
+	"ApplicationName":    "Application",
+}
+
+func previewTemplateData(text *MessageText, policy *LabelPolicy) (templates.TemplateData, error) {
+	data := templates.TemplateData{
+		URL:             "https://example.com",
+		PrimaryColor:    templates.DefaultPrimaryColor,
+		BackgroundColor: templates.DefaultBackgroundColor,
+		FontColor:       templates.DefaultFontColor,
+		FontFamily:      templates.DefaultFontFamily,
+	}
+	texts := []struct {
+		text   string
+		target *string
+	}{
+		{text.Title, &data.Title},
+		{text.PreHeader, &data.PreHeader},
+		{text.Subject, &data.Subject},
+		{text.Greeting, &data.Greeting},
+		{text.Text, &data.Text},
+		{text.ButtonText, &data.ButtonText},
+		{text.Footer, &data.FooterText},
+	}
+	for _, t := range texts {
+		parsed, err := templates.ParseTemplateText(t.text, previewArgs)
+		if err != nil {
+			return data, zerrors.ThrowInternal(err, "QUERY-Mt1aQf", "Errors.Internal")
+		}
+		*t.target = parsed
+	}
+	data.IncludeFooter = data.FooterText != ""
+	if policy.Light.PrimaryColor != "" {
+		data.PrimaryColor = policy.Light.PrimaryColor
+	}
+	if policy.Light.BackgroundColor != "" {
+		data.BackgroundColor = policy.Light.BackgroundColor
+	}
+	if policy.Light.FontColor != "" {
+		data.FontColor = policy.Light.FontColor
+	}
+	return data, nil
+}
+
+func prepareMessageTemplateQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*MessageTemplate, error)) {
+	return sq.Select(
+			MessageTemplateColAggregateID.identifier(),
+			MessageTemplateColSequence.identifier(),
+			MessageTemplateColCreationDate.identifier(),
+			MessageTemplateColChangeDate.identifier(),
+			MessageTemplateColMessageType.identifier(),
+			MessageTemplateColLanguage.identifier(),
+			MessageTemplateColFormat.identifier(),
+			MessageTemplateColStoreKey.identifier(),
+			MessageTemplateColTemplate.identifier(),
+			MessageTemplateColIsDefault.identifier(),
+		).
+			From(messageTemplateTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*MessageTemplate, error) {
+			template := new(MessageTemplate)
+			var lang string
+			err := row.Scan(
+				&template.AggregateID,
+				&template.Sequence,
+				&template.CreationDate,
+				&template.ChangeDate,
+				&template.MessageType,
+				&lang,
+				&template.Format,
+				&template.StoreKey,
+				&template.Template,
+				&template.IsDefault,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Mt1aQg", "Errors.MessageTemplate.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Mt1aQh", "Errors.Internal")
+			}
+			template.Language = language.Make(lang)
+			return template, nil
+		}
+}
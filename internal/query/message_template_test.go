@@ -0,0 +1,154 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	messageTemplateQuery = `SELECT projections.message_templates.aggregate_id,` +
+		` projections.message_templates.sequence,` +
+		` projections.message_templates.creation_date,` +
+		` projections.message_templates.change_date,` +
+		` projections.message_templates.message_type,` +
+		` projections.message_templates.language,` +
+		` projections.message_templates.format,` +
+		` projections.message_templates.store_key,` +
+		` projections.message_templates.template,` +
+		` projections.message_templates.is_default` +
+		` FROM projections.message_templates AS OF SYSTEM TIME '-1 ms'`
+	messageTemplateCols = []string{
+		"aggregate_id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"message_type",
+		"language",
+		"format",
+		"store_key",
+		"template",
+		"is_default",
+	}
+)
+
+func Test_MessageTemplatePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareMessageTemplateQuery no result",
+			prepare: prepareMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					regexp.QuoteMeta(messageTemplateQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*MessageTemplate)(nil),
+		},
+		{
+			name:    "prepareMessageTemplateQuery found",
+			prepare: prepareMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(messageTemplateQuery),
+					messageTemplateCols,
+					[]driver.Value{
+						"agg-id",
+						uint64(20211108),
+						testNow,
+						testNow,
+						"InitCode",
+						"de",
+						domain.MessageTemplateFormatMJML,
+						"policy/mail/template/InitCode-de.mjml",
+						[]byte("<html></html>"),
+						false,
+					},
+				),
+			},
+			object: &MessageTemplate{
+				AggregateID:  "agg-id",
+				Sequence:     20211108,
+				CreationDate: testNow,
+				ChangeDate:   testNow,
+				MessageType:  "InitCode",
+				Language:     language.German,
+				Format:       domain.MessageTemplateFormatMJML,
+				StoreKey:     "policy/mail/template/InitCode-de.mjml",
+				Template:     []byte("<html></html>"),
+				IsDefault:    false,
+			},
+		},
+		{
+			name:    "prepareMessageTemplateQuery sql err",
+			prepare: prepareMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(messageTemplateQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*MessageTemplate)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_previewTemplateData(t *testing.T) {
+	data, err := previewTemplateData(
+		&MessageText{
+			Title:      "Hello {{.DisplayName}}",
+			Text:       "Your code is {{.Code}}",
+			ButtonText: "Verify",
+			Footer:     "",
+		},
+		&LabelPolicy{
+			Light: Theme{
+				PrimaryColor: "#ff0000",
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello John Doe", data.Title)
+	assert.Equal(t, "Your code is ABC123", data.Text)
+	assert.Equal(t, "Verify", data.ButtonText)
+	assert.False(t, data.IncludeFooter)
+	assert.Equal(t, "#ff0000", data.PrimaryColor)
+	assert.Equal(t, "#fafafa", data.BackgroundColor)
+}
@@ -24,16 +24,30 @@ import (
 )
 
 type MessageTexts struct {
-	InitCode                 MessageText
-	PasswordReset            MessageText
-	VerifyEmail              MessageText
-	VerifyPhone              MessageText
-	VerifySMSOTP             MessageText
-	VerifyEmailOTP           MessageText
-	DomainClaimed            MessageText
-	PasswordlessRegistration MessageText
-	PasswordChange           MessageText
-	InviteUser               MessageText
+	InitCode                      MessageText
+	PasswordReset                 MessageText
+	VerifyEmail                   MessageText
+	VerifyPhone                   MessageText
+	VerifySMSOTP                  MessageText
+	VerifyEmailOTP                MessageText
+	DomainClaimed                 MessageText
+	PasswordlessRegistration      MessageText
+	PasswordChange                MessageText
+	InviteUser                    MessageText
+	NewDeviceSignIn               MessageText
+	MFAAdded                      MessageText
+	MFARemoved                    MessageText
+	PasskeyAdded                  MessageText
+	EmailChanged                  MessageText
+	AccountLocked                 MessageText
+	PATCreated                    MessageText
+	PasswordExpiryWarning         MessageText
+	PasswordExpired               MessageText
+	AccessExpiryWarning           MessageText
+	AccessRequested               MessageText
+	InactivityDeactivationWarning MessageText
+	InactivityDeletionWarning     MessageText
+	UserDeletionReminder          MessageText
 }
 
 type MessageText struct {
@@ -349,6 +363,34 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordChange
 	case domain.InviteUserMessageType:
 		return &m.InviteUser
+	case domain.NewDeviceSignInMessageType:
+		return &m.NewDeviceSignIn
+	case domain.MFAAddedMessageType:
+		return &m.MFAAdded
+	case domain.MFARemovedMessageType:
+		return &m.MFARemoved
+	case domain.PasskeyAddedMessageType:
+		return &m.PasskeyAdded
+	case domain.EmailChangedMessageType:
+		return &m.EmailChanged
+	case domain.AccountLockedMessageType:
+		return &m.AccountLocked
+	case domain.PATCreatedMessageType:
+		return &m.PATCreated
+	case domain.PasswordExpiryWarningMessageType:
+		return &m.PasswordExpiryWarning
+	case domain.PasswordExpiredMessageType:
+		return &m.PasswordExpired
+	case domain.AccessExpiryWarningMessageType:
+		return &m.AccessExpiryWarning
+	case domain.AccessRequestedMessageType:
+		return &m.AccessRequested
+	case domain.InactivityDeactivationWarningMessageType:
+		return &m.InactivityDeactivationWarning
+	case domain.InactivityDeletionWarningMessageType:
+		return &m.InactivityDeletionWarning
+	case domain.UserDeletionReminderMessageType:
+		return &m.UserDeletionReminder
 	}
 	return nil
 }
@@ -0,0 +1,318 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type NotificationDeliveries struct {
+	SearchResponse
+	Deliveries []*NotificationDelivery
+}
+
+// NotificationDelivery is the delivery record of a notification requested for a user
+type NotificationDelivery struct {
+	ID string
+	// ResourceOwner is the instance the notification was requested on
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	UserID            string
+	UserResourceOwner string
+	MessageType       string
+	Channel           domain.NotificationType
+	// ProviderID is the id of the email or sms provider configuration which was used to send the notification
+	ProviderID        string
+	ProviderMessageID string
+	// RecipientHash is the sha256 hash of the email address or phone number, see [domain.NotificationRecipientHash]
+	RecipientHash string
+	Attempts      uint64
+	Status        domain.NotificationDeliveryStatus
+	Error         string
+}
+
+type NotificationDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	notificationDeliveryTable = table{
+		name:          projection.NotificationDeliveryProjectionTable,
+		instanceIDCol: projection.NotificationDeliveryColumnInstanceID,
+	}
+	NotificationDeliveryColumnInstanceID = Column{
+		name:  projection.NotificationDeliveryColumnInstanceID,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnID = Column{
+		name:  projection.NotificationDeliveryColumnID,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnResourceOwner = Column{
+		name:  projection.NotificationDeliveryColumnResourceOwner,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnCreationDate = Column{
+		name:  projection.NotificationDeliveryColumnCreationDate,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChangeDate = Column{
+		name:  projection.NotificationDeliveryColumnChangeDate,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnSequence = Column{
+		name:  projection.NotificationDeliveryColumnSequence,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnUserID = Column{
+		name:  projection.NotificationDeliveryColumnUserID,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnUserResourceOwner = Column{
+		name:  projection.NotificationDeliveryColumnUserResourceOwner,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnMessageType = Column{
+		name:  projection.NotificationDeliveryColumnMessageType,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChannel = Column{
+		name:  projection.NotificationDeliveryColumnChannel,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderID = Column{
+		name:  projection.NotificationDeliveryColumnProviderID,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderMessageID = Column{
+		name:  projection.NotificationDeliveryColumnProviderMessageID,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnRecipientHash = Column{
+		name:  projection.NotificationDeliveryColumnRecipientHash,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnAttempts = Column{
+		name:  projection.NotificationDeliveryColumnAttempts,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnStatus = Column{
+		name:  projection.NotificationDeliveryColumnStatus,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnError = Column{
+		name:  projection.NotificationDeliveryColumnError,
+		table: notificationDeliveryTable,
+	}
+)
+
+// NotificationDeliveryByProviderMessageID returns the delivery the provider assigned the message id to
+func (q *Queries) NotificationDeliveryByProviderMessageID(ctx context.Context, shouldTriggerBulk bool, providerID, providerMessageID string) (delivery *NotificationDelivery, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerNotificationDeliveryProjection")
+		ctx, err = projection.NotificationDeliveryProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareNotificationDeliveryQuery(ctx, q.client)
+	eq := sq.Eq{
+		NotificationDeliveryColumnProviderID.identifier():        providerID,
+		NotificationDeliveryColumnProviderMessageID.identifier(): providerMessageID,
+		NotificationDeliveryColumnInstanceID.identifier():        authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Nd7tBa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		delivery, err = scan(row)
+		return err
+	}, stmt, args...)
+	return delivery, err
+}
+
+// SearchNotificationDeliveries returns the deliveries matching the queries, the permission has to be checked by the caller
+func (q *Queries) SearchNotificationDeliveries(ctx context.Context, queries *NotificationDeliverySearchQueries) (deliveries *NotificationDeliveries, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareNotificationDeliveriesQuery(ctx, q.client)
+	eq := sq.Eq{
+		NotificationDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Nd7tBb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		deliveries, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	deliveries.State, err = q.latestState(ctx, notificationDeliveryTable)
+	return deliveries, err
+}
+
+func (q *NotificationDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewNotificationDeliveryUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnUserID, value, TextEquals)
+}
+
+func NewNotificationDeliveryUserResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnUserResourceOwner, value, TextEquals)
+}
+
+func NewNotificationDeliveryRecipientHashSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnRecipientHash, value, TextEquals)
+}
+
+func NewNotificationDeliveryStatusSearchQuery(value domain.NotificationDeliveryStatus) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnStatus, value, NumberEquals)
+}
+
+func NewNotificationDeliveryCreationDateSearchQuery(value time.Time, compare TimestampComparison) (SearchQuery, error) {
+	return NewTimestampQuery(NotificationDeliveryColumnCreationDate, value, compare)
+}
+
+func prepareNotificationDeliveryQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*NotificationDelivery, error)) {
+	return sq.Select(
+			NotificationDeliveryColumnID.identifier(),
+			NotificationDeliveryColumnResourceOwner.identifier(),
+			NotificationDeliveryColumnCreationDate.identifier(),
+			NotificationDeliveryColumnChangeDate.identifier(),
+			NotificationDeliveryColumnSequence.identifier(),
+			NotificationDeliveryColumnUserID.identifier(),
+			NotificationDeliveryColumnUserResourceOwner.identifier(),
+			NotificationDeliveryColumnMessageType.identifier(),
+			NotificationDeliveryColumnChannel.identifier(),
+			NotificationDeliveryColumnProviderID.identifier(),
+			NotificationDeliveryColumnProviderMessageID.identifier(),
+			NotificationDeliveryColumnRecipientHash.identifier(),
+			NotificationDeliveryColumnAttempts.identifier(),
+			NotificationDeliveryColumnStatus.identifier(),
+			NotificationDeliveryColumnError.identifier(),
+		).
+			From(notificationDeliveryTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*NotificationDelivery, error) {
+			delivery := new(NotificationDelivery)
+			err := row.Scan(
+				&delivery.ID,
+				&delivery.ResourceOwner,
+				&delivery.CreationDate,
+				&delivery.ChangeDate,
+				&delivery.Sequence,
+				&delivery.UserID,
+				&delivery.UserResourceOwner,
+				&delivery.MessageType,
+				&delivery.Channel,
+				&delivery.ProviderID,
+				&delivery.ProviderMessageID,
+				&delivery.RecipientHash,
+				&delivery.Attempts,
+				&delivery.Status,
+				&delivery.Error,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Nd7tBc", "Errors.Notification.Delivery.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nd7tBd", "Errors.Internal")
+			}
+			return delivery, nil
+		}
+}
+
+func prepareNotificationDeliveriesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*NotificationDeliveries, error)) {
+	return sq.Select(
+			NotificationDeliveryColumnID.identifier(),
+			NotificationDeliveryColumnResourceOwner.identifier(),
+			NotificationDeliveryColumnCreationDate.identifier(),
+			NotificationDeliveryColumnChangeDate.identifier(),
+			NotificationDeliveryColumnSequence.identifier(),
+			NotificationDeliveryColumnUserID.identifier(),
+			NotificationDeliveryColumnUserResourceOwner.identifier(),
+			NotificationDeliveryColumnMessageType.identifier(),
+			NotificationDeliveryColumnChannel.identifier(),
+			NotificationDeliveryColumnProviderID.identifier(),
+			NotificationDeliveryColumnProviderMessageID.identifier(),
+			NotificationDeliveryColumnRecipientHash.identifier(),
+			NotificationDeliveryColumnAttempts.identifier(),
+			NotificationDeliveryColumnStatus.identifier(),
+			NotificationDeliveryColumnError.identifier(),
+			countColumn.identifier(),
+		).
+			From(notificationDeliveryTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationDeliveries, error) {
+			deliveries := make([]*NotificationDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery := new(NotificationDelivery)
+				err := rows.Scan(
+					&delivery.ID,
+					&delivery.ResourceOwner,
+					&delivery.CreationDate,
+					&delivery.ChangeDate,
+					&delivery.Sequence,
+					&delivery.UserID,
+					&delivery.UserResourceOwner,
+					&delivery.MessageType,
+					&delivery.Channel,
+					&delivery.ProviderID,
+					&delivery.ProviderMessageID,
+					&delivery.RecipientHash,
+					&delivery.Attempts,
+					&delivery.Status,
+					&delivery.Error,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nd7tBe", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationDeliveries{
+				Deliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
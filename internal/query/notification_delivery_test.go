@@ -0,0 +1,247 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationDeliveryQuery = `SELECT projections.notification_deliveries.id,` +
+		` projections.notification_deliveries.resource_owner,` +
+		` projections.notification_deliveries.creation_date,` +
+		` projections.notification_deliveries.change_date,` +
+		` projections.notification_deliveries.sequence,` +
+		` projections.notification_deliveries.user_id,` +
+		` projections.notification_deliveries.user_resource_owner,` +
+		` projections.notification_deliveries.message_type,` +
+		` projections.notification_deliveries.channel,` +
+		` projections.notification_deliveries.provider_id,` +
+		` projections.notification_deliveries.provider_message_id,` +
+		` projections.notification_deliveries.recipient_hash,` +
+		` projections.notification_deliveries.attempts,` +
+		` projections.notification_deliveries.status,` +
+		` projections.notification_deliveries.error` +
+		` FROM projections.notification_deliveries` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	notificationDeliveryCols = []string{
+		"id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"user_id",
+		"user_resource_owner",
+		"message_type",
+		"channel",
+		"provider_id",
+		"provider_message_id",
+		"recipient_hash",
+		"attempts",
+		"status",
+		"error",
+	}
+	notificationDeliveriesQuery = `SELECT projections.notification_deliveries.id,` +
+		` projections.notification_deliveries.resource_owner,` +
+		` projections.notification_deliveries.creation_date,` +
+		` projections.notification_deliveries.change_date,` +
+		` projections.notification_deliveries.sequence,` +
+		` projections.notification_deliveries.user_id,` +
+		` projections.notification_deliveries.user_resource_owner,` +
+		` projections.notification_deliveries.message_type,` +
+		` projections.notification_deliveries.channel,` +
+		` projections.notification_deliveries.provider_id,` +
+		` projections.notification_deliveries.provider_message_id,` +
+		` projections.notification_deliveries.recipient_hash,` +
+		` projections.notification_deliveries.attempts,` +
+		` projections.notification_deliveries.status,` +
+		` projections.notification_deliveries.error,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_deliveries` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	notificationDeliveriesCols = append(notificationDeliveryCols, "count")
+)
+
+func Test_NotificationDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationDeliveryQuery no result",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					regexp.QuoteMeta(notificationDeliveryQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDelivery)(nil),
+		},
+		{
+			name:    "prepareNotificationDeliveryQuery found",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(notificationDeliveryQuery),
+					notificationDeliveryCols,
+					[]driver.Value{
+						"notification-id",
+						"instance-id",
+						testNow,
+						testNow,
+						uint64(20211108),
+						"user-id",
+						"org-id",
+						"InitCode",
+						domain.NotificationTypeEmail,
+						"provider-id",
+						"message-id",
+						"hash",
+						uint64(1),
+						domain.NotificationDeliveryStatusDelivered,
+						"",
+					},
+				),
+			},
+			object: &NotificationDelivery{
+				ID:                "notification-id",
+				ResourceOwner:     "instance-id",
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211108,
+				UserID:            "user-id",
+				UserResourceOwner: "org-id",
+				MessageType:       "InitCode",
+				Channel:           domain.NotificationTypeEmail,
+				ProviderID:        "provider-id",
+				ProviderMessageID: "message-id",
+				RecipientHash:     "hash",
+				Attempts:          1,
+				Status:            domain.NotificationDeliveryStatusDelivered,
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveryQuery sql err",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(notificationDeliveryQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDelivery)(nil),
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery no result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(notificationDeliveriesQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationDeliveries{Deliveries: []*NotificationDelivery{}},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery one result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(notificationDeliveriesQuery),
+					notificationDeliveriesCols,
+					[][]driver.Value{
+						{
+							"notification-id",
+							"instance-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"user-id",
+							"org-id",
+							"InitCode",
+							domain.NotificationTypeEmail,
+							"provider-id",
+							"message-id",
+							"hash",
+							uint64(1),
+							domain.NotificationDeliveryStatusDelivered,
+							"",
+						},
+					},
+				),
+			},
+			object: &NotificationDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Deliveries: []*NotificationDelivery{
+					{
+						ID:                "notification-id",
+						ResourceOwner:     "instance-id",
+						CreationDate:      testNow,
+						ChangeDate:        testNow,
+						Sequence:          20211108,
+						UserID:            "user-id",
+						UserResourceOwner: "org-id",
+						MessageType:       "InitCode",
+						Channel:           domain.NotificationTypeEmail,
+						ProviderID:        "provider-id",
+						ProviderMessageID: "message-id",
+						RecipientHash:     "hash",
+						Attempts:          1,
+						Status:            domain.NotificationDeliveryStatusDelivered,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery sql err",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(notificationDeliveriesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDeliveries)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
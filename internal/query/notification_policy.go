@@ -25,7 +25,8 @@ type NotificationPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	PasswordChange bool
+	PasswordChange        bool
+	SecurityNotifications domain.SecurityNotifications
 
 	IsDefault bool
 }
@@ -63,6 +64,34 @@ var (
 		name:  projection.NotificationPolicyColumnPasswordChange,
 		table: notificationPolicyTable,
 	}
+	NotificationPolicyColSecurityNewDeviceSignIn = Column{
+		name:  projection.NotificationPolicyColumnSecurityNewDeviceSignIn,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityMFAAdded = Column{
+		name:  projection.NotificationPolicyColumnSecurityMFAAdded,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityMFARemoved = Column{
+		name:  projection.NotificationPolicyColumnSecurityMFARemoved,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityPasskeyAdded = Column{
+		name:  projection.NotificationPolicyColumnSecurityPasskeyAdded,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityEmailChanged = Column{
+		name:  projection.NotificationPolicyColumnSecurityEmailChanged,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityAccountLocked = Column{
+		name:  projection.NotificationPolicyColumnSecurityAccountLocked,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColSecurityPATCreated = Column{
+		name:  projection.NotificationPolicyColumnSecurityPATCreated,
+		table: notificationPolicyTable,
+	}
 	NotificationPolicyColIsDefault = Column{
 		name:  projection.NotificationPolicyColumnIsDefault,
 		table: notificationPolicyTable,
@@ -93,16 +122,17 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	if !withOwnerRemoved {
 		eq[NotificationPolicyColOwnerRemoved.identifier()] = false
 	}
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	stmt, scan := prepareNotificationPolicyQuery(ctx, q.client)
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{NotificationPolicyColID.identifier(): orgID},
-				sq.Eq{NotificationPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{NotificationPolicyColID.identifier(): chain},
 		}).
-		OrderBy(NotificationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyChainOrder(NotificationPolicyColID, chain)).Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-Xuoapqm", "Errors.Query.SQLStatement")
 	}
@@ -153,6 +183,13 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 			NotificationPolicyColChangeDate.identifier(),
 			NotificationPolicyColResourceOwner.identifier(),
 			NotificationPolicyColPasswordChange.identifier(),
+			NotificationPolicyColSecurityNewDeviceSignIn.identifier(),
+			NotificationPolicyColSecurityMFAAdded.identifier(),
+			NotificationPolicyColSecurityMFARemoved.identifier(),
+			NotificationPolicyColSecurityPasskeyAdded.identifier(),
+			NotificationPolicyColSecurityEmailChanged.identifier(),
+			NotificationPolicyColSecurityAccountLocked.identifier(),
+			NotificationPolicyColSecurityPATCreated.identifier(),
 			NotificationPolicyColIsDefault.identifier(),
 			NotificationPolicyColState.identifier(),
 		).
@@ -167,6 +204,13 @@ func prepareNotificationPolicyQuery(ctx context.Context, db prepareDatabase) (sq
 				&policy.ChangeDate,
 				&policy.ResourceOwner,
 				&policy.PasswordChange,
+				&policy.SecurityNotifications.NewDeviceSignIn,
+				&policy.SecurityNotifications.MFAAdded,
+				&policy.SecurityNotifications.MFARemoved,
+				&policy.SecurityNotifications.PasskeyAdded,
+				&policy.SecurityNotifications.EmailChanged,
+				&policy.SecurityNotifications.AccountLocked,
+				&policy.SecurityNotifications.PATCreated,
 				&policy.IsDefault,
 				&policy.State,
 			)
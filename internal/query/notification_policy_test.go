@@ -19,6 +19,13 @@ var (
 		` projections.notification_policies.change_date,` +
 		` projections.notification_policies.resource_owner,` +
 		` projections.notification_policies.password_change,` +
+		` projections.notification_policies.security_new_device_sign_in,` +
+		` projections.notification_policies.security_mfa_added,` +
+		` projections.notification_policies.security_mfa_removed,` +
+		` projections.notification_policies.security_passkey_added,` +
+		` projections.notification_policies.security_email_changed,` +
+		` projections.notification_policies.security_account_locked,` +
+		` projections.notification_policies.security_pat_created,` +
 		` projections.notification_policies.is_default,` +
 		` projections.notification_policies.state` +
 		` FROM projections.notification_policies` +
@@ -30,6 +37,13 @@ var (
 		"change_date",
 		"resource_owner",
 		"password_change",
+		"security_new_device_sign_in",
+		"security_mfa_added",
+		"security_mfa_removed",
+		"security_passkey_added",
+		"security_email_changed",
+		"security_account_locked",
+		"security_pat_created",
 		"is_default",
 		"state",
 	}
@@ -79,6 +93,13 @@ func Test_NotificationPolicyPrepares(t *testing.T) {
 						"ro",
 						true,
 						true,
+						false,
+						false,
+						false,
+						true,
+						false,
+						false,
+						true,
 						domain.PolicyStateActive,
 					},
 				),
@@ -91,7 +112,11 @@ func Test_NotificationPolicyPrepares(t *testing.T) {
 				ResourceOwner:  "ro",
 				State:          domain.PolicyStateActive,
 				PasswordChange: true,
-				IsDefault:      true,
+				SecurityNotifications: domain.SecurityNotifications{
+					NewDeviceSignIn: true,
+					EmailChanged:    true,
+				},
+				IsDefault: true,
 			},
 		},
 		{
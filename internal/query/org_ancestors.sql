@@ -0,0 +1,15 @@
+with recursive ancestors as (
+    select org_id, parent_id, inherit_idps, inherit_project_grants, inherit_members, 1 as depth
+    from projections.org_hierarchy
+    where instance_id = $1
+    and org_id = $2
+    union all
+    select h.org_id, h.parent_id, h.inherit_idps, h.inherit_project_grants, h.inherit_members, a.depth + 1
+    from projections.org_hierarchy h
+    join ancestors a on h.org_id = a.parent_id
+    where h.instance_id = $1
+    and a.depth < $3
+)
+select org_id, parent_id, inherit_idps, inherit_project_grants, inherit_members
+from ancestors
+order by depth;
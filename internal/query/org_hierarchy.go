@@ -0,0 +1,138 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed org_ancestors.sql
+var orgAncestorsQuery string
+
+// OrgHierarchyLink is the link of an organization to its parent.
+type OrgHierarchyLink struct {
+	OrgID                string
+	ParentID             string
+	InheritIDPs          bool
+	InheritProjectGrants bool
+	InheritMembers       bool
+}
+
+// OrgAncestors are the links from an organization up to the root of its hierarchy.
+// The first link is the one of the organization itself.
+type OrgAncestors []*OrgHierarchyLink
+
+// ParentIDs returns the ids of the ancestors, starting with the parent.
+func (a OrgAncestors) ParentIDs() []string {
+	ids := make([]string, len(a))
+	for i, link := range a {
+		ids[i] = link.ParentID
+	}
+	return ids
+}
+
+// MemberOrgIDs returns the ancestors whose members are passed down to the organization.
+func (a OrgAncestors) MemberOrgIDs() []string {
+	ids := make([]string, 0, len(a))
+	for _, link := range a {
+		if !link.InheritMembers {
+			break
+		}
+		ids = append(ids, link.ParentID)
+	}
+	return ids
+}
+
+// IDPOrgIDs returns the ancestors above the owner of a policy,
+// whose identity providers are passed down to it.
+func (a OrgAncestors) IDPOrgIDs(ownerID string) []string {
+	ids := make([]string, 0, len(a))
+	inherit := false
+	for _, link := range a {
+		if link.OrgID == ownerID {
+			inherit = true
+		}
+		if !inherit {
+			continue
+		}
+		if !link.InheritIDPs {
+			break
+		}
+		ids = append(ids, link.ParentID)
+	}
+	return ids
+}
+
+// OrgAncestors returns the chain of parents of the organization.
+func (q *Queries) OrgAncestors(ctx context.Context, orgID string) (ancestors OrgAncestors, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		ancestors = make(OrgAncestors, 0)
+		for rows.Next() {
+			link := new(OrgHierarchyLink)
+			if err := rows.Scan(
+				&link.OrgID,
+				&link.ParentID,
+				&link.InheritIDPs,
+				&link.InheritProjectGrants,
+				&link.InheritMembers,
+			); err != nil {
+				return err
+			}
+			ancestors = append(ancestors, link)
+		}
+		return rows.Err()
+	},
+		orgAncestorsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		orgID,
+		domain.OrgHierarchyMaxDepth,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Hq1aLn", "Errors.Internal")
+	}
+	return ancestors, nil
+}
+
+// PolicyChain returns the organization, its ancestors and the instance
+// in the order their policies are resolved.
+func (a OrgAncestors) PolicyChain(orgID, instanceID string) []string {
+	if orgID == "" || orgID == instanceID {
+		return []string{instanceID}
+	}
+	chain := make([]string, 0, len(a)+2)
+	chain = append(chain, orgID)
+	chain = append(chain, a.ParentIDs()...)
+	return append(chain, instanceID)
+}
+
+func (q *Queries) orgPolicyAncestors(ctx context.Context, orgID string) (OrgAncestors, error) {
+	if orgID == "" || orgID == authz.GetInstance(ctx).InstanceID() {
+		return nil, nil
+	}
+	return q.OrgAncestors(ctx, orgID)
+}
+
+func (q *Queries) orgPolicyChain(ctx context.Context, orgID string) ([]string, error) {
+	ancestors, err := q.orgPolicyAncestors(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return ancestors.PolicyChain(orgID, authz.GetInstance(ctx).InstanceID()), nil
+}
+
+// policyChainOrder sorts the policies of the chain,
+// so the policy of the closest organization comes first.
+func policyChainOrder(col Column, chain []string) sq.Sqlizer {
+	return sq.Expr("array_position(?::TEXT[], "+col.identifier()+")", database.TextArray[string](chain))
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_OrgAncestors(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	expQuery := regexp.QuoteMeta(orgAncestorsQuery)
+	queryArgs := []driver.Value{"instance1", "org1", domain.OrgHierarchyMaxDepth}
+	cols := []string{"org_id", "parent_id", "inherit_idps", "inherit_project_grants", "inherit_members"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    OrgAncestors
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, queryArgs...),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Hq1aLn", "Errors.Internal"),
+		},
+		{
+			name: "no parent",
+			mock: mockQueries(expQuery, cols, nil, queryArgs...),
+			want: OrgAncestors{},
+		},
+		{
+			name: "ok",
+			mock: mockQueries(expQuery, cols, [][]driver.Value{
+				{"org1", "org2", true, false, true},
+				{"org2", "org3", false, true, false},
+			}, queryArgs...),
+			want: OrgAncestors{
+				{OrgID: "org1", ParentID: "org2", InheritIDPs: true, InheritMembers: true},
+				{OrgID: "org2", ParentID: "org3", InheritProjectGrants: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB:       db,
+						Database: &prepareDB{},
+					},
+				}
+				got, err := q.OrgAncestors(ctx, "org1")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestOrgAncestors(t *testing.T) {
+	ancestors := OrgAncestors{
+		{OrgID: "org1", ParentID: "org2", InheritIDPs: true, InheritMembers: true},
+		{OrgID: "org2", ParentID: "org3", InheritIDPs: true},
+		{OrgID: "org3", ParentID: "org4", InheritIDPs: false, InheritMembers: true},
+	}
+	t.Run("policy chain", func(t *testing.T) {
+		assert.Equal(t, []string{"org1", "org2", "org3", "org4", "instance1"}, ancestors.PolicyChain("org1", "instance1"))
+		assert.Equal(t, []string{"instance1"}, OrgAncestors(nil).PolicyChain("instance1", "instance1"))
+		assert.Equal(t, []string{"org1", "instance1"}, OrgAncestors(nil).PolicyChain("org1", "instance1"))
+	})
+	t.Run("member orgs stop at the first link without inheritance", func(t *testing.T) {
+		assert.Equal(t, []string{"org2"}, ancestors.MemberOrgIDs())
+	})
+	t.Run("idp orgs start at the owner of the policy", func(t *testing.T) {
+		assert.Equal(t, []string{"org2", "org3"}, ancestors.IDPOrgIDs("org1"))
+		assert.Equal(t, []string{"org3"}, ancestors.IDPOrgIDs("org2"))
+		assert.Empty(t, ancestors.IDPOrgIDs("org3"))
+		assert.Empty(t, ancestors.IDPOrgIDs("instance1"))
+	})
+}
@@ -52,6 +52,14 @@ var (
 		name:  projection.OrgMemberOrgIDCol,
 		table: orgMemberTable,
 	}
+	OrgMemberValidFrom = Column{
+		name:  projection.MemberValidFrom,
+		table: orgMemberTable,
+	}
+	OrgMemberValidUntil = Column{
+		name:  projection.MemberValidUntil,
+		table: orgMemberTable,
+	}
 )
 
 type OrgMembersQuery struct {
@@ -49,16 +49,17 @@ func (q *Queries) PasswordComplexityPolicyByOrg(ctx context.Context, shouldTrigg
 	if !withOwnerRemoved {
 		eq[PasswordComplexityColOwnerRemoved.identifier()] = false
 	}
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	stmt, scan := preparePasswordComplexityPolicyQuery(ctx, q.client)
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PasswordComplexityColID.identifier(): orgID},
-				sq.Eq{PasswordComplexityColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PasswordComplexityColID.identifier(): chain},
 		}).
-		OrderBy(PasswordComplexityColIsDefault.identifier()).
+		OrderByClause(policyChainOrder(PasswordComplexityColID, chain)).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-lDnrk", "Errors.Query.SQLStatement")
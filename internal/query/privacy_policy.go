@@ -34,6 +34,8 @@ type PrivacyPolicy struct {
 	CustomLink     string
 	CustomLinkText string
 
+	DeletionGracePeriod time.Duration
+
 	IsDefault bool
 }
 
@@ -106,6 +108,10 @@ var (
 		name:  projection.PrivacyPolicyCustomLinkTextCol,
 		table: privacyTable,
 	}
+	PrivacyColDeletionGracePeriod = Column{
+		name:  projection.PrivacyPolicyDeletionGracePeriodCol,
+		table: privacyTable,
+	}
 )
 
 func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string, withOwnerRemoved bool) (policy *PrivacyPolicy, err error) {
@@ -122,16 +128,17 @@ func (q *Queries) PrivacyPolicyByOrg(ctx context.Context, shouldTriggerBulk bool
 	if !withOwnerRemoved {
 		eq[PrivacyColOwnerRemoved.identifier()] = false
 	}
+	chain, err := q.orgPolicyChain(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
 	stmt, scan := preparePrivacyPolicyQuery(ctx, q.client)
 	query, args, err := stmt.Where(
 		sq.And{
 			eq,
-			sq.Or{
-				sq.Eq{PrivacyColID.identifier(): orgID},
-				sq.Eq{PrivacyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
+			sq.Eq{PrivacyColID.identifier(): chain},
 		}).
-		OrderBy(PrivacyColIsDefault.identifier()).Limit(1).ToSql()
+		OrderByClause(policyChainOrder(PrivacyColID, chain)).Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-UXuPI", "Errors.Query.SQLStatement")
 	}
@@ -186,6 +193,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 			PrivacyColDocsLink.identifier(),
 			PrivacyColCustomLink.identifier(),
 			PrivacyColCustomLinkText.identifier(),
+			PrivacyColDeletionGracePeriod.identifier(),
 			PrivacyColIsDefault.identifier(),
 			PrivacyColState.identifier(),
 		).
@@ -206,6 +214,7 @@ func preparePrivacyPolicyQuery(ctx context.Context, db prepareDatabase) (sq.Sele
 				&policy.DocsLink,
 				&policy.CustomLink,
 				&policy.CustomLinkText,
+				&policy.DeletionGracePeriod,
 				&policy.IsDefault,
 				&policy.State,
 			)
@@ -229,5 +238,7 @@ func (p *PrivacyPolicy) ToDomain() *domain.PrivacyPolicy {
 		DocsLink:       p.DocsLink,
 		CustomLink:     p.CustomLink,
 		CustomLinkText: p.CustomLinkText,
+
+		DeletionGracePeriod: p.DeletionGracePeriod,
 	}
 }
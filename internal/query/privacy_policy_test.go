@@ -7,27 +7,29 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var (
-	preparePrivacyPolicyStmt = `SELECT projections.privacy_policies4.id,` +
-		` projections.privacy_policies4.sequence,` +
-		` projections.privacy_policies4.creation_date,` +
-		` projections.privacy_policies4.change_date,` +
-		` projections.privacy_policies4.resource_owner,` +
-		` projections.privacy_policies4.privacy_link,` +
-		` projections.privacy_policies4.tos_link,` +
-		` projections.privacy_policies4.help_link,` +
-		` projections.privacy_policies4.support_email,` +
-		` projections.privacy_policies4.docs_link,` +
-		` projections.privacy_policies4.custom_link,` +
-		` projections.privacy_policies4.custom_link_text,` +
-		` projections.privacy_policies4.is_default,` +
-		` projections.privacy_policies4.state` +
-		` FROM projections.privacy_policies4` +
+	preparePrivacyPolicyStmt = `SELECT projections.privacy_policies5.id,` +
+		` projections.privacy_policies5.sequence,` +
+		` projections.privacy_policies5.creation_date,` +
+		` projections.privacy_policies5.change_date,` +
+		` projections.privacy_policies5.resource_owner,` +
+		` projections.privacy_policies5.privacy_link,` +
+		` projections.privacy_policies5.tos_link,` +
+		` projections.privacy_policies5.help_link,` +
+		` projections.privacy_policies5.support_email,` +
+		` projections.privacy_policies5.docs_link,` +
+		` projections.privacy_policies5.custom_link,` +
+		` projections.privacy_policies5.custom_link_text,` +
+		` projections.privacy_policies5.deletion_grace_period,` +
+		` projections.privacy_policies5.is_default,` +
+		` projections.privacy_policies5.state` +
+		` FROM projections.privacy_policies5` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	preparePrivacyPolicyCols = []string{
 		"id",
@@ -42,6 +44,7 @@ var (
 		"docs_link",
 		"custom_link",
 		"custom_link_text",
+		"deletion_grace_period",
 		"is_default",
 		"state",
 	}
@@ -96,26 +99,28 @@ func Test_PrivacyPolicyPrepares(t *testing.T) {
 						"zitadel.com/docs",
 						"zitadel.com",
 						"Zitadel",
+						int64(7 * 24 * time.Hour),
 						true,
 						domain.PolicyStateActive,
 					},
 				),
 			},
 			object: &PrivacyPolicy{
-				ID:             "pol-id",
-				CreationDate:   testNow,
-				ChangeDate:     testNow,
-				Sequence:       20211109,
-				ResourceOwner:  "ro",
-				State:          domain.PolicyStateActive,
-				PrivacyLink:    "privacy.ch",
-				TOSLink:        "tos.ch",
-				HelpLink:       "help.ch",
-				SupportEmail:   "support@example.com",
-				DocsLink:       "zitadel.com/docs",
-				CustomLink:     "zitadel.com",
-				CustomLinkText: "Zitadel",
-				IsDefault:      true,
+				ID:                  "pol-id",
+				CreationDate:        testNow,
+				ChangeDate:          testNow,
+				Sequence:            20211109,
+				ResourceOwner:       "ro",
+				State:               domain.PolicyStateActive,
+				PrivacyLink:         "privacy.ch",
+				TOSLink:             "tos.ch",
+				HelpLink:            "help.ch",
+				SupportEmail:        "support@example.com",
+				DocsLink:            "zitadel.com/docs",
+				CustomLink:          "zitadel.com",
+				CustomLinkText:      "Zitadel",
+				DeletionGracePeriod: 7 * 24 * time.Hour,
+				IsDefault:           true,
 			},
 		},
 		{
@@ -52,6 +52,14 @@ var (
 		name:  projection.ProjectMemberProjectIDCol,
 		table: projectMemberTable,
 	}
+	ProjectMemberValidFrom = Column{
+		name:  projection.MemberValidFrom,
+		table: projectMemberTable,
+	}
+	ProjectMemberValidUntil = Column{
+		name:  projection.MemberValidUntil,
+		table: projectMemberTable,
+	}
 )
 
 type ProjectMembersQuery struct {
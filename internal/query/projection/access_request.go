@@ -0,0 +1,247 @@
+package projection
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AccessRequestProjectionTable = "projections.access_requests"
+
+	AccessRequestColumnID            = "id"
+	AccessRequestColumnInstanceID    = "instance_id"
+	AccessRequestColumnResourceOwner = "resource_owner"
+	AccessRequestColumnCreationDate  = "creation_date"
+	AccessRequestColumnChangeDate    = "change_date"
+	AccessRequestColumnSequence      = "sequence"
+	AccessRequestColumnState         = "state"
+	AccessRequestColumnUserID        = "user_id"
+	AccessRequestColumnProjectID     = "project_id"
+	AccessRequestColumnRoleKeys      = "role_keys"
+	AccessRequestColumnReason        = "reason"
+	AccessRequestColumnDecidedBy     = "decided_by"
+	AccessRequestColumnJustification = "justification"
+	AccessRequestColumnUserGrantID   = "user_grant_id"
+	AccessRequestColumnValidUntil    = "valid_until"
+)
+
+type accessRequestProjection struct{}
+
+func newAccessRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessRequestProjection))
+}
+
+func (*accessRequestProjection) Name() string {
+	return AccessRequestProjectionTable
+}
+
+func (*accessRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessRequestColumnID, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessRequestColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessRequestColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessRequestColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(AccessRequestColumnRoleKeys, handler.ColumnTypeTextArray),
+			handler.NewColumn(AccessRequestColumnReason, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestColumnDecidedBy, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestColumnJustification, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestColumnUserGrantID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessRequestColumnValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(AccessRequestColumnInstanceID, AccessRequestColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{AccessRequestColumnUserID})),
+			handler.WithIndex(handler.NewIndex("project_id", []string{AccessRequestColumnProjectID})),
+		),
+	)
+}
+
+func (p *accessRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessrequest.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  accessrequest.ApprovedEventType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  accessrequest.DeniedEventType,
+					Reduce: p.reduceDenied,
+				},
+				{
+					Event:  accessrequest.CancelledEventType,
+					Reduce: p.reduceCancelled,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessRequestColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessRequestProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessRequestColumnID, e.Aggregate().ID),
+			handler.NewCol(AccessRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AccessRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessRequestColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AccessRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AccessRequestColumnSequence, e.Sequence()),
+			handler.NewCol(AccessRequestColumnState, domain.AccessRequestStatePending),
+			handler.NewCol(AccessRequestColumnUserID, e.UserID),
+			handler.NewCol(AccessRequestColumnProjectID, e.ProjectID),
+			handler.NewCol(AccessRequestColumnRoleKeys, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(AccessRequestColumnReason, e.Reason),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.ApprovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.decisionStatement(e, domain.AccessRequestStateApproved,
+		handler.NewCol(AccessRequestColumnDecidedBy, e.Creator()),
+		handler.NewCol(AccessRequestColumnJustification, e.Justification),
+		handler.NewCol(AccessRequestColumnUserGrantID, e.UserGrantID),
+		handler.NewCol(AccessRequestColumnValidUntil, &sql.NullTime{Time: e.ValidUntil, Valid: !e.ValidUntil.IsZero()}),
+	), nil
+}
+
+func (p *accessRequestProjection) reduceDenied(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.DeniedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.decisionStatement(e, domain.AccessRequestStateDenied,
+		handler.NewCol(AccessRequestColumnDecidedBy, e.Creator()),
+		handler.NewCol(AccessRequestColumnJustification, e.Justification),
+	), nil
+}
+
+func (p *accessRequestProjection) reduceCancelled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessrequest.CancelledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.decisionStatement(e, domain.AccessRequestStateCancelled), nil
+}
+
+func (p *accessRequestProjection) decisionStatement(event eventstore.Event, state domain.AccessRequestState, columns ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(AccessRequestColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(AccessRequestColumnSequence, event.Sequence()),
+			handler.NewCol(AccessRequestColumnState, state),
+		}, columns...),
+		[]handler.Condition{
+			handler.NewCond(AccessRequestColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestColumnID, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *accessRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestColumnProjectID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *accessRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccessRequestColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,240 @@
+package projection
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessrequest"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.AddedEventType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "roleKeys": ["role"], "reason": "reason"}`),
+					),
+					eventstore.GenericEventMapper[accessrequest.AddedEvent],
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_requests (id, instance_id, resource_owner, creation_date, change_date, sequence, state, user_id, project_id, role_keys, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStatePending,
+								"user-id",
+								"project-id",
+								database.TextArray[string]{"role"},
+								"reason",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApproved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.ApprovedEventType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "userGrantId": "grant-id", "justification": "ok", "validUntil": "2030-01-01T00:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[accessrequest.ApprovedEvent],
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceApproved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state, decided_by, justification, user_grant_id, valid_until) = ($1, $2, $3, $4, $5, $6, $7) WHERE (instance_id = $8) AND (id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateApproved,
+								"editor-user",
+								"ok",
+								"grant-id",
+								&sql.NullTime{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDenied",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.DeniedEventType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "justification": "no"}`),
+					),
+					eventstore.GenericEventMapper[accessrequest.DeniedEvent],
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceDenied,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state, decided_by, justification) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateDenied,
+								"editor-user",
+								"no",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCancelled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessrequest.CancelledEventType,
+						accessrequest.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id"}`),
+					),
+					eventstore.GenericEventMapper[accessrequest.CancelledEvent],
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceCancelled,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_requests SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessRequestStateCancelled,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&accessRequestProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_requests WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessRequestProjectionTable, tt.want)
+		})
+	}
+}
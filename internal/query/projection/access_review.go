@@ -0,0 +1,305 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	AccessReviewProjectionTable = "projections.access_reviews"
+	AccessReviewItemTable       = AccessReviewProjectionTable + "_" + AccessReviewItemSuffix
+
+	AccessReviewColumnID            = "id"
+	AccessReviewColumnInstanceID    = "instance_id"
+	AccessReviewColumnResourceOwner = "resource_owner"
+	AccessReviewColumnCreationDate  = "creation_date"
+	AccessReviewColumnChangeDate    = "change_date"
+	AccessReviewColumnSequence      = "sequence"
+	AccessReviewColumnState         = "state"
+	AccessReviewColumnCreator       = "creator"
+	AccessReviewColumnName          = "name"
+	AccessReviewColumnProjectID     = "project_id"
+	AccessReviewColumnRoleKeys      = "role_keys"
+	AccessReviewColumnReviewers     = "reviewers"
+	AccessReviewColumnDeadline      = "deadline"
+	AccessReviewColumnAutoRevoke    = "auto_revoke"
+
+	AccessReviewItemSuffix                    = "items"
+	AccessReviewItemColumnID                  = "id"
+	AccessReviewItemColumnInstanceID          = "instance_id"
+	AccessReviewItemColumnReviewID            = "review_id"
+	AccessReviewItemColumnResourceOwner       = "resource_owner"
+	AccessReviewItemColumnSequence            = "sequence"
+	AccessReviewItemColumnType                = "type"
+	AccessReviewItemColumnObjectID            = "object_id"
+	AccessReviewItemColumnObjectResourceOwner = "object_resource_owner"
+	AccessReviewItemColumnUserID              = "user_id"
+	AccessReviewItemColumnProjectID           = "project_id"
+	AccessReviewItemColumnRoles               = "roles"
+	AccessReviewItemColumnDecision            = "decision"
+	AccessReviewItemColumnDecidedBy           = "decided_by"
+	AccessReviewItemColumnDecisionDate        = "decision_date"
+	AccessReviewItemColumnComment             = "comment"
+	AccessReviewItemColumnAutomatic           = "automatic"
+)
+
+type accessReviewProjection struct{}
+
+func newAccessReviewProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accessReviewProjection))
+}
+
+func (*accessReviewProjection) Name() string {
+	return AccessReviewProjectionTable
+}
+
+func (*accessReviewProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewColumnID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessReviewColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewColumnCreator, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewColumnName, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewColumnProjectID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewColumnRoleKeys, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AccessReviewColumnReviewers, handler.ColumnTypeTextArray),
+			handler.NewColumn(AccessReviewColumnDeadline, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccessReviewColumnAutoRevoke, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(AccessReviewColumnInstanceID, AccessReviewColumnID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccessReviewColumnResourceOwner})),
+			handler.WithIndex(handler.NewIndex("deadline", []string{AccessReviewColumnState, AccessReviewColumnDeadline})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(AccessReviewItemColumnID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnReviewID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AccessReviewItemColumnType, handler.ColumnTypeEnum),
+			handler.NewColumn(AccessReviewItemColumnObjectID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnObjectResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(AccessReviewItemColumnProjectID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemColumnRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AccessReviewItemColumnDecision, handler.ColumnTypeEnum, handler.Default(domain.AccessReviewDecisionUnspecified)),
+			handler.NewColumn(AccessReviewItemColumnDecidedBy, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemColumnDecisionDate, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(AccessReviewItemColumnComment, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AccessReviewItemColumnAutomatic, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(AccessReviewItemColumnInstanceID, AccessReviewItemColumnReviewID, AccessReviewItemColumnID),
+			AccessReviewItemSuffix,
+			// items are removed together with their access review
+			handler.WithForeignKey(handler.NewForeignKey("review", []string{AccessReviewItemColumnInstanceID, AccessReviewItemColumnReviewID}, []string{AccessReviewColumnInstanceID, AccessReviewColumnID})),
+			handler.WithIndex(handler.NewIndex("user_id", []string{AccessReviewItemColumnUserID})),
+		),
+	)
+}
+
+func (p *accessReviewProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: accessreview.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  accessreview.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  accessreview.ReviewersSetEventType,
+					Reduce: p.reduceReviewersSet,
+				},
+				{
+					Event:  accessreview.ItemDecidedEventType,
+					Reduce: p.reduceItemDecided,
+				},
+				{
+					Event:  accessreview.CompletedEventType,
+					Reduce: p.reduceCompleted,
+				},
+				{
+					Event:  accessreview.CancelledEventType,
+					Reduce: p.reduceCancelled,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccessReviewColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *accessReviewProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, len(e.Items)+1)
+	stmts = append(stmts, handler.AddCreateStatement(
+		[]handler.Column{
+			handler.NewCol(AccessReviewColumnID, e.Aggregate().ID),
+			handler.NewCol(AccessReviewColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AccessReviewColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccessReviewColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AccessReviewColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AccessReviewColumnSequence, e.Sequence()),
+			handler.NewCol(AccessReviewColumnState, domain.AccessReviewStateActive),
+			handler.NewCol(AccessReviewColumnCreator, e.Creator()),
+			handler.NewCol(AccessReviewColumnName, e.Name),
+			handler.NewCol(AccessReviewColumnProjectID, e.ProjectID),
+			handler.NewCol(AccessReviewColumnRoleKeys, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(AccessReviewColumnReviewers, database.TextArray[string](e.Reviewers)),
+			handler.NewCol(AccessReviewColumnDeadline, e.Deadline),
+			handler.NewCol(AccessReviewColumnAutoRevoke, e.AutoRevoke),
+		},
+	))
+	for _, item := range e.Items {
+		stmts = append(stmts, handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewItemColumnID, item.ID),
+				handler.NewCol(AccessReviewItemColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(AccessReviewItemColumnReviewID, e.Aggregate().ID),
+				handler.NewCol(AccessReviewItemColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(AccessReviewItemColumnSequence, e.Sequence()),
+				handler.NewCol(AccessReviewItemColumnType, item.Type),
+				handler.NewCol(AccessReviewItemColumnObjectID, item.ObjectID),
+				handler.NewCol(AccessReviewItemColumnObjectResourceOwner, item.ResourceOwner),
+				handler.NewCol(AccessReviewItemColumnUserID, item.UserID),
+				handler.NewCol(AccessReviewItemColumnProjectID, item.ProjectID),
+				handler.NewCol(AccessReviewItemColumnRoles, database.TextArray[string](item.Roles)),
+			},
+			handler.WithTableSuffix(AccessReviewItemSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *accessReviewProjection) reduceReviewersSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ReviewersSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccessReviewColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AccessReviewColumnSequence, e.Sequence()),
+			handler.NewCol(AccessReviewColumnReviewers, database.TextArray[string](e.Reviewers)),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessReviewColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *accessReviewProjection) reduceItemDecided(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.ItemDecidedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewColumnChangeDate, e.CreationDate()),
+				handler.NewCol(AccessReviewColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(AccessReviewColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(AccessReviewColumnID, e.Aggregate().ID),
+			},
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(AccessReviewItemColumnSequence, e.Sequence()),
+				handler.NewCol(AccessReviewItemColumnDecision, e.Decision),
+				handler.NewCol(AccessReviewItemColumnDecidedBy, e.Creator()),
+				handler.NewCol(AccessReviewItemColumnDecisionDate, e.CreationDate()),
+				handler.NewCol(AccessReviewItemColumnComment, e.Comment),
+				handler.NewCol(AccessReviewItemColumnAutomatic, e.Automatic),
+			},
+			[]handler.Condition{
+				handler.NewCond(AccessReviewItemColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(AccessReviewItemColumnReviewID, e.Aggregate().ID),
+				handler.NewCond(AccessReviewItemColumnID, e.ItemID),
+			},
+			handler.WithTableSuffix(AccessReviewItemSuffix),
+		),
+	), nil
+}
+
+func (p *accessReviewProjection) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.CompletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.AccessReviewStateCompleted), nil
+}
+
+func (p *accessReviewProjection) reduceCancelled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*accessreview.CancelledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.AccessReviewStateCancelled), nil
+}
+
+func (p *accessReviewProjection) stateStatement(event eventstore.Event, state domain.AccessReviewState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(AccessReviewColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(AccessReviewColumnSequence, event.Sequence()),
+			handler.NewCol(AccessReviewColumnState, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccessReviewColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewColumnID, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *accessReviewProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// items are removed by the foreign key
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccessReviewColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccessReviewColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
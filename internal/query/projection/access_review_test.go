@@ -0,0 +1,234 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccessReviewProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.AddedEventType,
+						accessreview.AggregateType,
+						[]byte(`{"name": "review", "reviewers": ["reviewer-id"], "deadline": "2030-01-01T00:00:00Z", "autoRevoke": true, "items": [{"id": "item-id", "type": 1, "objectId": "grant-id", "resourceOwner": "ro-id", "userId": "user-id", "projectId": "project-id", "roles": ["role"]}]}`),
+					),
+					eventstore.GenericEventMapper[accessreview.AddedEvent],
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews (id, instance_id, resource_owner, creation_date, change_date, sequence, state, creator, name, project_id, role_keys, reviewers, deadline, auto_revoke) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateActive,
+								"editor-user",
+								"review",
+								"",
+								database.TextArray[string](nil),
+								database.TextArray[string]{"reviewer-id"},
+								anyArg{},
+								true,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.access_reviews_items (id, instance_id, review_id, resource_owner, sequence, type, object_id, object_resource_owner, user_id, project_id, roles) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"item-id",
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								uint64(15),
+								domain.AccessReviewItemTypeUserGrant,
+								"grant-id",
+								"ro-id",
+								"user-id",
+								"project-id",
+								database.TextArray[string]{"role"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceReviewersSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.ReviewersSetEventType,
+						accessreview.AggregateType,
+						[]byte(`{"reviewers": ["reviewer-id"]}`),
+					),
+					eventstore.GenericEventMapper[accessreview.ReviewersSetEvent],
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceReviewersSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence, reviewers) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								database.TextArray[string]{"reviewer-id"},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceItemDecided",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.ItemDecidedEventType,
+						accessreview.AggregateType,
+						[]byte(`{"itemId": "item-id", "decision": 2, "comment": "left the team"}`),
+					),
+					eventstore.GenericEventMapper[accessreview.ItemDecidedEvent],
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceItemDecided,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.access_reviews_items SET (sequence, decision, decided_by, decision_date, comment, automatic) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (review_id = $8) AND (id = $9)",
+							expectedArgs: []interface{}{
+								uint64(15),
+								domain.AccessReviewDecisionRevoke,
+								"editor-user",
+								anyArg{},
+								"left the team",
+								false,
+								"instance-id",
+								"agg-id",
+								"item-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCompleted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						accessreview.CompletedEventType,
+						accessreview.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[accessreview.CompletedEvent],
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceCompleted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("access_review"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.access_reviews SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccessReviewStateCompleted,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&accessReviewProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.access_reviews WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccessReviewProjectionTable, tt.want)
+		})
+	}
+}
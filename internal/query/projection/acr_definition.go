@@ -0,0 +1,145 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/acrdefinition"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	ACRDefinitionProjectionTable = "projections.acr_definitions"
+
+	ACRDefinitionColumnInstanceID        = "instance_id"
+	ACRDefinitionColumnResourceOwner     = "resource_owner"
+	ACRDefinitionColumnACR               = "acr"
+	ACRDefinitionColumnCreationDate      = "creation_date"
+	ACRDefinitionColumnChangeDate        = "change_date"
+	ACRDefinitionColumnSequence          = "sequence"
+	ACRDefinitionColumnPhishingResistant = "phishing_resistant"
+	ACRDefinitionColumnMultiFactor       = "multi_factor"
+	ACRDefinitionColumnMaxAge            = "max_age"
+)
+
+type acrDefinitionProjection struct{}
+
+func newACRDefinitionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(acrDefinitionProjection))
+}
+
+func (*acrDefinitionProjection) Name() string {
+	return ACRDefinitionProjectionTable
+}
+
+func (*acrDefinitionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ACRDefinitionColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(ACRDefinitionColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(ACRDefinitionColumnACR, handler.ColumnTypeText),
+			handler.NewColumn(ACRDefinitionColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ACRDefinitionColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ACRDefinitionColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(ACRDefinitionColumnPhishingResistant, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(ACRDefinitionColumnMultiFactor, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(ACRDefinitionColumnMaxAge, handler.ColumnTypeInt64, handler.Default(0)),
+		},
+			handler.NewPrimaryKey(ACRDefinitionColumnInstanceID, ACRDefinitionColumnResourceOwner, ACRDefinitionColumnACR),
+		),
+	)
+}
+
+func (p *acrDefinitionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: acrdefinition.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  acrdefinition.SetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  acrdefinition.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ACRDefinitionColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *acrDefinitionProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*acrdefinition.SetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ACRDefinitionColumnInstanceID, nil),
+			handler.NewCol(ACRDefinitionColumnResourceOwner, nil),
+			handler.NewCol(ACRDefinitionColumnACR, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(ACRDefinitionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(ACRDefinitionColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(ACRDefinitionColumnACR, e.ACR),
+			handler.NewCol(ACRDefinitionColumnCreationDate, handler.OnlySetValueOnInsert(ACRDefinitionProjectionTable, e.CreationDate())),
+			handler.NewCol(ACRDefinitionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ACRDefinitionColumnSequence, e.Sequence()),
+			handler.NewCol(ACRDefinitionColumnPhishingResistant, e.PhishingResistant),
+			handler.NewCol(ACRDefinitionColumnMultiFactor, e.MultiFactor),
+			handler.NewCol(ACRDefinitionColumnMaxAge, e.MaxAge),
+		},
+	), nil
+}
+
+func (p *acrDefinitionProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*acrdefinition.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ACRDefinitionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(ACRDefinitionColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(ACRDefinitionColumnACR, e.ACR),
+		},
+	), nil
+}
+
+func (p *acrDefinitionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ACRDefinitionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(ACRDefinitionColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
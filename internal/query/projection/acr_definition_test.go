@@ -0,0 +1,162 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/acrdefinition"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestACRDefinitionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						acrdefinition.SetEventType,
+						acrdefinition.AggregateType,
+						[]byte(`{"acr": "mfa", "multiFactor": true, "maxAge": 600000000000}`),
+					),
+					eventstore.GenericEventMapper[acrdefinition.SetEvent],
+				),
+			},
+			reduce: (&acrDefinitionProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("acr_definition"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.acr_definitions (instance_id, resource_owner, acr, creation_date, change_date, sequence, phishing_resistant, multi_factor, max_age) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, resource_owner, acr) DO UPDATE SET (creation_date, change_date, sequence, phishing_resistant, multi_factor, max_age) = (projections.acr_definitions.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.phishing_resistant, EXCLUDED.multi_factor, EXCLUDED.max_age)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"mfa",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								false,
+								true,
+								10 * time.Minute,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						acrdefinition.RemovedEventType,
+						acrdefinition.AggregateType,
+						[]byte(`{"acr": "mfa"}`),
+					),
+					eventstore.GenericEventMapper[acrdefinition.RemovedEvent],
+				),
+			},
+			reduce: (&acrDefinitionProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("acr_definition"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.acr_definitions WHERE (instance_id = $1) AND (resource_owner = $2) AND (acr = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"mfa",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&acrDefinitionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.acr_definitions WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(ACRDefinitionColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.acr_definitions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ACRDefinitionProjectionTable, tt.want)
+		})
+	}
+}
@@ -59,6 +59,8 @@ const (
 	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
 	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
 	AppOIDCConfigColumnBackChannelLogoutURI     = "back_channel_logout_uri"
+	AppOIDCConfigColumnRequireConsent           = "require_consent"
+	AppOIDCConfigColumnRequiredACR              = "required_acr"
 
 	appSAMLTableSuffix             = "saml_configs"
 	AppSAMLConfigColumnAppID       = "app_id"
@@ -66,6 +68,7 @@ const (
 	AppSAMLConfigColumnEntityID    = "entity_id"
 	AppSAMLConfigColumnMetadata    = "metadata"
 	AppSAMLConfigColumnMetadataURL = "metadata_url"
+	AppSAMLConfigColumnOptions     = "options"
 )
 
 type appProjection struct{}
@@ -127,6 +130,8 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnAdditionalOrigins, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnSkipNativeAppSuccessPage, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnRequireConsent, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequiredACR, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -139,6 +144,7 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppSAMLConfigColumnEntityID, handler.ColumnTypeText),
 			handler.NewColumn(AppSAMLConfigColumnMetadata, handler.ColumnTypeBytes),
 			handler.NewColumn(AppSAMLConfigColumnMetadataURL, handler.ColumnTypeText),
+			handler.NewColumn(AppSAMLConfigColumnOptions, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppSAMLConfigColumnInstanceID, AppSAMLConfigColumnAppID),
 			appSAMLTableSuffix,
@@ -503,6 +509,8 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
 				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
 				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+				handler.NewCol(AppOIDCConfigColumnRequireConsent, e.RequireConsent),
+				handler.NewCol(AppOIDCConfigColumnRequiredACR, e.RequiredACR),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -574,6 +582,12 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.BackChannelLogoutURI != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, *e.BackChannelLogoutURI))
 	}
+	if e.RequireConsent != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireConsent, *e.RequireConsent))
+	}
+	if e.RequiredACR != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequiredACR, *e.RequiredACR))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -682,16 +696,20 @@ func (p *appProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-GMHU1", "reduce.wrong.event.type")
 	}
+	cols := []handler.Column{
+		handler.NewCol(AppSAMLConfigColumnAppID, e.AppID),
+		handler.NewCol(AppSAMLConfigColumnInstanceID, e.Aggregate().InstanceID),
+		handler.NewCol(AppSAMLConfigColumnEntityID, e.EntityID),
+		handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata),
+		handler.NewCol(AppSAMLConfigColumnMetadataURL, e.MetadataURL),
+	}
+	if e.Options != nil {
+		cols = append(cols, handler.NewJSONCol(AppSAMLConfigColumnOptions, e.Options))
+	}
 	return handler.NewMultiStatement(
 		e,
 		handler.AddCreateStatement(
-			[]handler.Column{
-				handler.NewCol(AppSAMLConfigColumnAppID, e.AppID),
-				handler.NewCol(AppSAMLConfigColumnInstanceID, e.Aggregate().InstanceID),
-				handler.NewCol(AppSAMLConfigColumnEntityID, e.EntityID),
-				handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata),
-				handler.NewCol(AppSAMLConfigColumnMetadataURL, e.MetadataURL),
-			},
+			cols,
 			handler.WithTableSuffix(appSAMLTableSuffix),
 		),
 		handler.AddUpdateStatement(
@@ -713,7 +731,7 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-GMHU2", "reduce.wrong.event.type")
 	}
 
-	cols := make([]handler.Column, 0, 3)
+	cols := make([]handler.Column, 0, 4)
 	if e.Metadata != nil {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata))
 	}
@@ -723,6 +741,9 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 	if e.EntityID != "" {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnEntityID, e.EntityID))
 	}
+	if e.Options != nil {
+		cols = append(cols, handler.NewJSONCol(AppSAMLConfigColumnOptions, e.Options))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -559,7 +559,9 @@ func TestAppProjection_reduces(t *testing.T) {
                         "clockSkew": 1000,
                         "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
 						"skipNativeAppSuccessPage": true,
-						"backChannelLogoutURI": "back.channel.one.ch"
+						"backChannelLogoutURI": "back.channel.one.ch",
+						"requireConsent": true,
+						"requiredAcr": "mfa"
 		}`),
 					), project.OIDCConfigAddedEventMapper),
 			},
@@ -570,7 +572,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, require_consent, required_acr) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -592,6 +594,8 @@ func TestAppProjection_reduces(t *testing.T) {
 								database.TextArray[string]{"origin.one.ch", "origin.two.ch"},
 								true,
 								"back.channel.one.ch",
+								true,
+								"mfa",
 							},
 						},
 						{
@@ -633,7 +637,9 @@ func TestAppProjection_reduces(t *testing.T) {
                         "clockSkew": 1000,
                         "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
 						"skipNativeAppSuccessPage": true,
-						"backChannelLogoutURI": "back.channel.one.ch"
+						"backChannelLogoutURI": "back.channel.one.ch",
+						"requireConsent": true,
+						"requiredAcr": "mfa"
 		}`),
 					), project.OIDCConfigAddedEventMapper),
 			},
@@ -644,7 +650,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, require_consent, required_acr) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -666,6 +672,8 @@ func TestAppProjection_reduces(t *testing.T) {
 								database.TextArray[string]{"origin.one.ch", "origin.two.ch"},
 								true,
 								"back.channel.one.ch",
+								true,
+								"mfa",
 							},
 						},
 						{
@@ -705,7 +713,9 @@ func TestAppProjection_reduces(t *testing.T) {
                         "clockSkew": 1000,
                         "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
 						"skipNativeAppSuccessPage": true,
-						"backChannelLogoutURI": "back.channel.one.ch"
+						"backChannelLogoutURI": "back.channel.one.ch",
+						"requireConsent": true,
+						"requiredAcr": "mfa"
 		}`),
 					), project.OIDCConfigChangedEventMapper),
 			},
@@ -716,7 +726,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) WHERE (app_id = $17) AND (instance_id = $18)",
+							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, require_consent, required_acr) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) WHERE (app_id = $19) AND (instance_id = $20)",
 							expectedArgs: []interface{}{
 								domain.OIDCVersionV1,
 								database.TextArray[string]{"redirect.one.ch", "redirect.two.ch"},
@@ -734,6 +744,8 @@ func TestAppProjection_reduces(t *testing.T) {
 								database.TextArray[string]{"origin.one.ch", "origin.two.ch"},
 								true,
 								"back.channel.one.ch",
+								true,
+								"mfa",
 								"app-id",
 								"instance-id",
 							},
@@ -892,6 +904,92 @@ func TestAppProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "project reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.SAMLConfigAddedType,
+						project.AggregateType,
+						[]byte(`{
+					"appId": "app-id",
+					"entityId": "entity-id",
+					"metadata": "bWV0YWRhdGE=",
+					"metadata_url": "https://example.com/metadata",
+					"options": {"nameIdFormat": 2}
+				}`),
+					), project.SAMLConfigAddedEventMapper),
+			},
+			reduce: (&appProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.apps7_saml_configs (app_id, instance_id, entity_id, metadata, metadata_url, options) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"app-id",
+								"instance-id",
+								"entity-id",
+								[]byte("metadata"),
+								"https://example.com/metadata",
+								[]byte(`{"nameIdFormat":2}`),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.apps7 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceSAMLConfigChanged options",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.SAMLConfigChangedType,
+						project.AggregateType,
+						[]byte(`{
+					"appId": "app-id",
+					"options": {"nameIdFormat": 2, "attributeMapping": {"rolesAttributeName": "roles"}}
+				}`),
+					), project.SAMLConfigChangedEventMapper),
+			},
+			reduce: (&appProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.apps7_saml_configs SET options = $1 WHERE (app_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								[]byte(`{"nameIdFormat":2,"attributeMapping":{"rolesAttributeName":"roles"}}`),
+								"app-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.apps7 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "project.reduceOwnerRemoved",
 			args: args{
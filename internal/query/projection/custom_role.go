@@ -0,0 +1,168 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	CustomRoleProjectionTable = "projections.custom_roles"
+
+	CustomRoleColumnInstanceID    = "instance_id"
+	CustomRoleColumnResourceOwner = "resource_owner"
+	CustomRoleColumnKey           = "role_key"
+	CustomRoleColumnCreationDate  = "creation_date"
+	CustomRoleColumnChangeDate    = "change_date"
+	CustomRoleColumnSequence      = "sequence"
+	CustomRoleColumnDisplayName   = "display_name"
+	CustomRoleColumnPermissions   = "permissions"
+)
+
+type customRoleProjection struct{}
+
+func newCustomRoleProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(customRoleProjection))
+}
+
+func (*customRoleProjection) Name() string {
+	return CustomRoleProjectionTable
+}
+
+func (*customRoleProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(CustomRoleColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(CustomRoleColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(CustomRoleColumnKey, handler.ColumnTypeText),
+			handler.NewColumn(CustomRoleColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CustomRoleColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(CustomRoleColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(CustomRoleColumnDisplayName, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(CustomRoleColumnPermissions, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(CustomRoleColumnInstanceID, CustomRoleColumnResourceOwner, CustomRoleColumnKey),
+		),
+	)
+}
+
+func (p *customRoleProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: customrole.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  customrole.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  customrole.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  customrole.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(CustomRoleColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *customRoleProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*customrole.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(CustomRoleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(CustomRoleColumnKey, e.Key),
+			handler.NewCol(CustomRoleColumnCreationDate, e.CreationDate()),
+			handler.NewCol(CustomRoleColumnChangeDate, e.CreationDate()),
+			handler.NewCol(CustomRoleColumnSequence, e.Sequence()),
+			handler.NewCol(CustomRoleColumnDisplayName, e.DisplayName),
+			handler.NewCol(CustomRoleColumnPermissions, database.TextArray[string](e.Permissions)),
+		},
+	), nil
+}
+
+func (p *customRoleProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*customrole.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(CustomRoleColumnChangeDate, e.CreationDate()),
+		handler.NewCol(CustomRoleColumnSequence, e.Sequence()),
+	}
+	if e.DisplayName != nil {
+		values = append(values, handler.NewCol(CustomRoleColumnDisplayName, *e.DisplayName))
+	}
+	if e.Permissions != nil {
+		values = append(values, handler.NewCol(CustomRoleColumnPermissions, database.TextArray[string](e.Permissions)))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(CustomRoleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(CustomRoleColumnKey, e.Key),
+		},
+	), nil
+}
+
+func (p *customRoleProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*customrole.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(CustomRoleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCond(CustomRoleColumnKey, e.Key),
+		},
+	), nil
+}
+
+func (p *customRoleProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(CustomRoleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(CustomRoleColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
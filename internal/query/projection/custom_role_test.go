@@ -0,0 +1,194 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/customrole"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCustomRoleProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						customrole.AddedEventType,
+						customrole.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK", "displayName": "Helpdesk", "permissions": ["user.write", "user.credential.write"]}`),
+					),
+					eventstore.GenericEventMapper[customrole.AddedEvent],
+				),
+			},
+			reduce: (&customRoleProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("custom_role"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.custom_roles (instance_id, resource_owner, role_key, creation_date, change_date, sequence, display_name, permissions) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"ORG_CUSTOM_HELPDESK",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"Helpdesk",
+								database.TextArray[string]{"user.write", "user.credential.write"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						customrole.ChangedEventType,
+						customrole.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK", "permissions": ["user.read"]}`),
+					),
+					eventstore.GenericEventMapper[customrole.ChangedEvent],
+				),
+			},
+			reduce: (&customRoleProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("custom_role"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.custom_roles SET (change_date, sequence, permissions) = ($1, $2, $3) WHERE (instance_id = $4) AND (resource_owner = $5) AND (role_key = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								database.TextArray[string]{"user.read"},
+								"instance-id",
+								"ro-id",
+								"ORG_CUSTOM_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						customrole.RemovedEventType,
+						customrole.AggregateType,
+						[]byte(`{"key": "ORG_CUSTOM_HELPDESK"}`),
+					),
+					eventstore.GenericEventMapper[customrole.RemovedEvent],
+				),
+			},
+			reduce: (&customRoleProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("custom_role"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_roles WHERE (instance_id = $1) AND (resource_owner = $2) AND (role_key = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"ORG_CUSTOM_HELPDESK",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&customRoleProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_roles WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(CustomRoleColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.custom_roles WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, CustomRoleProjectionTable, tt.want)
+		})
+	}
+}
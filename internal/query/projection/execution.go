@@ -18,6 +18,7 @@ const (
 	ExecutionChangeDateCol   = "change_date"
 	ExecutionInstanceIDCol   = "instance_id"
 	ExecutionSequenceCol     = "sequence"
+	ExecutionConditionCol    = "condition"
 
 	ExecutionTargetSuffix         = "targets"
 	ExecutionTargetExecutionIDCol = "execution_id"
@@ -45,6 +46,7 @@ func (*executionProjection) Init() *old_handler.Check {
 			handler.NewColumn(ExecutionChangeDateCol, handler.ColumnTypeTimestamp),
 			handler.NewColumn(ExecutionSequenceCol, handler.ColumnTypeInt64),
 			handler.NewColumn(ExecutionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionConditionCol, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(ExecutionInstanceIDCol, ExecutionIDCol),
 		),
@@ -108,6 +110,7 @@ func (p *executionProjection) reduceExecutionSet(event eventstore.Event) (*handl
 				handler.NewCol(ExecutionCreationDateCol, handler.OnlySetValueOnInsert(ExecutionTable, e.CreationDate())),
 				handler.NewCol(ExecutionChangeDateCol, e.CreationDate()),
 				handler.NewCol(ExecutionSequenceCol, e.Sequence()),
+				handler.NewCol(ExecutionConditionCol, e.Condition),
 			},
 		),
 		// cleanup execution targets to re-insert them
@@ -27,7 +27,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 					testEvent(
 						exec.SetEventV2Type,
 						exec.AggregateType,
-						[]byte(`{"targets": [{"type":2,"target":"target"},{"type":1,"target":"include"}]}`),
+						[]byte(`{"targets": [{"type":2,"target":"target"},{"type":1,"target":"include"}], "condition": "has(request.userId)"}`),
 					),
 					eventstore.GenericEventMapper[exec.SetEventV2],
 				),
@@ -39,13 +39,14 @@ func TestExecutionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.executions1 (instance_id, id, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence) = (projections.executions1.creation_date, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedStmt: "INSERT INTO projections.executions1 (instance_id, id, creation_date, change_date, sequence, condition) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, condition) = (projections.executions1.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.condition)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
 								anyArg{},
 								anyArg{},
 								uint64(15),
+								"has(request.userId)",
 							},
 						},
 						{
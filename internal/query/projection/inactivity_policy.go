@@ -0,0 +1,208 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InactivityPolicyTable = "projections.inactivity_policies"
+
+	InactivityPolicyIDCol                         = "id"
+	InactivityPolicyCreationDateCol               = "creation_date"
+	InactivityPolicyChangeDateCol                 = "change_date"
+	InactivityPolicySequenceCol                   = "sequence"
+	InactivityPolicyStateCol                      = "state"
+	InactivityPolicyIsDefaultCol                  = "is_default"
+	InactivityPolicyResourceOwnerCol              = "resource_owner"
+	InactivityPolicyInstanceIDCol                 = "instance_id"
+	InactivityPolicyWarnBeforeDaysCol             = "warn_before_days"
+	InactivityPolicyHumanDeactivateAfterDaysCol   = "human_deactivate_after_days"
+	InactivityPolicyHumanDeleteAfterDaysCol       = "human_delete_after_days"
+	InactivityPolicyMachineDeactivateAfterDaysCol = "machine_deactivate_after_days"
+	InactivityPolicyMachineDeleteAfterDaysCol     = "machine_delete_after_days"
+	InactivityPolicyOwnerRemovedCol               = "owner_removed"
+)
+
+type inactivityPolicyProjection struct{}
+
+func newInactivityPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(inactivityPolicyProjection))
+}
+
+func (*inactivityPolicyProjection) Name() string {
+	return InactivityPolicyTable
+}
+
+func (*inactivityPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(InactivityPolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InactivityPolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InactivityPolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(InactivityPolicyIsDefaultCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(InactivityPolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyWarnBeforeDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyHumanDeactivateAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyHumanDeleteAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyMachineDeactivateAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyMachineDeleteAfterDaysCol, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(InactivityPolicyInstanceIDCol, InactivityPolicyIDCol),
+			handler.WithIndex(handler.NewIndex("owner_removed", []string{InactivityPolicyOwnerRemovedCol})),
+		),
+	)
+}
+
+func (p *inactivityPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.InactivityPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.InactivityPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.InactivityPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InactivityPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.InactivityPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(InactivityPolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *inactivityPolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.InactivityPolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.InactivityPolicyAddedEvent:
+		policyEvent = e.InactivityPolicyAddedEvent
+		isDefault = false
+	case *instance.InactivityPolicyAddedEvent:
+		policyEvent = e.InactivityPolicyAddedEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-In5aAa", "reduce.wrong.event.type %v", []eventstore.EventType{org.InactivityPolicyAddedEventType, instance.InactivityPolicyAddedEventType})
+	}
+	return handler.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(InactivityPolicyCreationDateCol, policyEvent.CreationDate()),
+			handler.NewCol(InactivityPolicyChangeDateCol, policyEvent.CreationDate()),
+			handler.NewCol(InactivityPolicySequenceCol, policyEvent.Sequence()),
+			handler.NewCol(InactivityPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCol(InactivityPolicyStateCol, domain.PolicyStateActive),
+			handler.NewCol(InactivityPolicyWarnBeforeDaysCol, policyEvent.WarnBeforeDays),
+			handler.NewCol(InactivityPolicyHumanDeactivateAfterDaysCol, policyEvent.HumanDeactivateAfterDays),
+			handler.NewCol(InactivityPolicyHumanDeleteAfterDaysCol, policyEvent.HumanDeleteAfterDays),
+			handler.NewCol(InactivityPolicyMachineDeactivateAfterDaysCol, policyEvent.MachineDeactivateAfterDays),
+			handler.NewCol(InactivityPolicyMachineDeleteAfterDaysCol, policyEvent.MachineDeleteAfterDays),
+			handler.NewCol(InactivityPolicyIsDefaultCol, isDefault),
+			handler.NewCol(InactivityPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
+			handler.NewCol(InactivityPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.InactivityPolicyChangedEvent
+	switch e := event.(type) {
+	case *org.InactivityPolicyChangedEvent:
+		policyEvent = e.InactivityPolicyChangedEvent
+	case *instance.InactivityPolicyChangedEvent:
+		policyEvent = e.InactivityPolicyChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-In5bBb", "reduce.wrong.event.type %v", []eventstore.EventType{org.InactivityPolicyChangedEventType, instance.InactivityPolicyChangedEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(InactivityPolicyChangeDateCol, policyEvent.CreationDate()),
+		handler.NewCol(InactivityPolicySequenceCol, policyEvent.Sequence()),
+	}
+	if policyEvent.WarnBeforeDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyWarnBeforeDaysCol, *policyEvent.WarnBeforeDays))
+	}
+	if policyEvent.HumanDeactivateAfterDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyHumanDeactivateAfterDaysCol, *policyEvent.HumanDeactivateAfterDays))
+	}
+	if policyEvent.HumanDeleteAfterDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyHumanDeleteAfterDaysCol, *policyEvent.HumanDeleteAfterDays))
+	}
+	if policyEvent.MachineDeactivateAfterDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyMachineDeactivateAfterDaysCol, *policyEvent.MachineDeactivateAfterDays))
+	}
+	if policyEvent.MachineDeleteAfterDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyMachineDeleteAfterDaysCol, *policyEvent.MachineDeleteAfterDays))
+	}
+	return handler.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(InactivityPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	policyEvent, ok := event.(*org.InactivityPolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-In5cCc", "reduce.wrong.event.type %s", org.InactivityPolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		policyEvent,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyIDCol, policyEvent.Aggregate().ID),
+			handler.NewCond(InactivityPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-In5dDd", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(InactivityPolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
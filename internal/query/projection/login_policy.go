@@ -40,6 +40,10 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
+	RiskNotifyThresholdCol              = "risk_notify_threshold"
+	RiskRequireMFAThresholdCol          = "risk_require_mfa_threshold"
+	RiskBlockThresholdCol               = "risk_block_threshold"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -81,6 +85,10 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(MFAInitSkipLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(SecondFactorCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(MultiFactorCheckLifetimeCol, handler.ColumnTypeInt64),
+			handler.NewColumn(TrustedDeviceLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(RiskNotifyThresholdCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(RiskRequireMFAThresholdCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(RiskBlockThresholdCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(LoginPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -202,6 +210,10 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
+		handler.NewCol(RiskNotifyThresholdCol, policyEvent.RiskThresholds.Notify),
+		handler.NewCol(RiskRequireMFAThresholdCol, policyEvent.RiskThresholds.RequireMFA),
+		handler.NewCol(RiskBlockThresholdCol, policyEvent.RiskThresholds.Block),
 	}), nil
 }
 
@@ -271,6 +283,16 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
+	if policyEvent.RiskThresholds != nil {
+		cols = append(cols,
+			handler.NewCol(RiskNotifyThresholdCol, policyEvent.RiskThresholds.Notify),
+			handler.NewCol(RiskRequireMFAThresholdCol, policyEvent.RiskThresholds.RequireMFA),
+			handler.NewCol(RiskBlockThresholdCol, policyEvent.RiskThresholds.Block),
+		)
+	}
 
 	return handler.NewUpdateStatement(
 		&policyEvent,
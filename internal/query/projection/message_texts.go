@@ -273,7 +273,21 @@ func isMessageTemplate(template string) bool {
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
 		template == domain.PasswordChangeMessageType ||
-		template == domain.InviteUserMessageType
+		template == domain.InviteUserMessageType ||
+		template == domain.NewDeviceSignInMessageType ||
+		template == domain.MFAAddedMessageType ||
+		template == domain.MFARemovedMessageType ||
+		template == domain.PasskeyAddedMessageType ||
+		template == domain.EmailChangedMessageType ||
+		template == domain.AccountLockedMessageType ||
+		template == domain.PATCreatedMessageType ||
+		template == domain.PasswordExpiryWarningMessageType ||
+		template == domain.PasswordExpiredMessageType ||
+		template == domain.AccessExpiryWarningMessageType ||
+		template == domain.AccessRequestedMessageType ||
+		template == domain.InactivityDeactivationWarningMessageType ||
+		template == domain.InactivityDeletionWarningMessageType ||
+		template == domain.UserDeletionReminderMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -0,0 +1,294 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationDeliveryProjectionTable = "projections.notification_deliveries"
+
+	NotificationDeliveryColumnInstanceID        = "instance_id"
+	NotificationDeliveryColumnID                = "id"
+	NotificationDeliveryColumnResourceOwner     = "resource_owner"
+	NotificationDeliveryColumnCreationDate      = "creation_date"
+	NotificationDeliveryColumnChangeDate        = "change_date"
+	NotificationDeliveryColumnSequence          = "sequence"
+	NotificationDeliveryColumnUserID            = "user_id"
+	NotificationDeliveryColumnUserResourceOwner = "user_resource_owner"
+	NotificationDeliveryColumnMessageType       = "message_type"
+	NotificationDeliveryColumnChannel           = "channel"
+	NotificationDeliveryColumnProviderID        = "provider_id"
+	NotificationDeliveryColumnProviderMessageID = "provider_message_id"
+	NotificationDeliveryColumnRecipientHash     = "recipient_hash"
+	NotificationDeliveryColumnAttempts          = "attempts"
+	NotificationDeliveryColumnStatus            = "status"
+	NotificationDeliveryColumnError             = "error"
+)
+
+// The events of the notification aggregate can't be imported here,
+// as the repository depends on the query package for the notify user of the retries.
+// Therefore, the payloads are unmarshalled directly.
+const (
+	notificationAggregateType      eventstore.AggregateType = "notification"
+	notificationRequestedType      eventstore.EventType     = "notification.requested"
+	notificationRetryRequestedType eventstore.EventType     = "notification.retry.requested"
+	notificationSentType           eventstore.EventType     = "notification.sent"
+	notificationCanceledType       eventstore.EventType     = "notification.canceled"
+	notificationDeliveryStatusType eventstore.EventType     = "notification.delivery.status.changed"
+)
+
+type notificationRequestedPayload struct {
+	Request struct {
+		UserID            string                  `json:"userID"`
+		UserResourceOwner string                  `json:"userResourceOwner"`
+		MessageType       string                  `json:"messageType"`
+		NotificationType  domain.NotificationType `json:"notificationType"`
+	} `json:"request"`
+}
+
+type notificationSentPayload struct {
+	ProviderID        string `json:"providerID"`
+	ProviderMessageID string `json:"providerMessageID"`
+	RecipientHash     string `json:"recipientHash"`
+}
+
+type notificationErrorPayload struct {
+	Error string `json:"error"`
+}
+
+type notificationDeliveryStatusPayload struct {
+	Status domain.NotificationDeliveryStatus `json:"status"`
+	Error  string                            `json:"error"`
+}
+
+type notificationDeliveryProjection struct{}
+
+func newNotificationDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationDeliveryProjection))
+}
+
+func (*notificationDeliveryProjection) Name() string {
+	return NotificationDeliveryProjectionTable
+}
+
+func (*notificationDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationDeliveryColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationDeliveryColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnUserResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnMessageType, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryColumnChannel, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryColumnProviderID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryColumnProviderMessageID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryColumnRecipientHash, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationDeliveryColumnAttempts, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationDeliveryColumnStatus, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryColumnError, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(NotificationDeliveryColumnInstanceID, NotificationDeliveryColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{NotificationDeliveryColumnUserID, NotificationDeliveryColumnCreationDate})),
+			handler.WithIndex(handler.NewIndex("user_owner", []string{NotificationDeliveryColumnUserResourceOwner})),
+			handler.WithIndex(handler.NewIndex("provider_message", []string{NotificationDeliveryColumnProviderID, NotificationDeliveryColumnProviderMessageID})),
+		),
+	)
+}
+
+func (p *notificationDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notificationAggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notificationRequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  notificationRetryRequestedType,
+					Reduce: p.reduceRetryRequested,
+				},
+				{
+					Event:  notificationSentType,
+					Reduce: p.reduceSent,
+				},
+				{
+					Event:  notificationCanceledType,
+					Reduce: p.reduceCanceled,
+				},
+				{
+					Event:  notificationDeliveryStatusType,
+					Reduce: p.reduceDeliveryStatusChanged,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationDeliveryColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func unmarshalNotificationPayload(event eventstore.Event, payload any) error {
+	if err := event.Unmarshal(payload); err != nil {
+		return zerrors.ThrowInternal(err, "HANDL-Nd5qWe", "unable to unmarshal notification event")
+	}
+	return nil
+}
+
+func (p *notificationDeliveryProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	payload := new(notificationRequestedPayload)
+	if err := unmarshalNotificationPayload(event, payload); err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(NotificationDeliveryColumnID, event.Aggregate().ID),
+			handler.NewCol(NotificationDeliveryColumnResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(NotificationDeliveryColumnCreationDate, event.CreatedAt()),
+			handler.NewCol(NotificationDeliveryColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(NotificationDeliveryColumnSequence, event.Sequence()),
+			handler.NewCol(NotificationDeliveryColumnUserID, payload.Request.UserID),
+			handler.NewCol(NotificationDeliveryColumnUserResourceOwner, payload.Request.UserResourceOwner),
+			handler.NewCol(NotificationDeliveryColumnMessageType, payload.Request.MessageType),
+			handler.NewCol(NotificationDeliveryColumnChannel, payload.Request.NotificationType),
+			handler.NewCol(NotificationDeliveryColumnStatus, domain.NotificationDeliveryStatusRequested),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	payload := new(notificationErrorPayload)
+	if err := unmarshalNotificationPayload(event, payload); err != nil {
+		return nil, err
+	}
+	return p.updateStatement(event,
+		handler.NewIncrementCol(NotificationDeliveryColumnAttempts, 1),
+		handler.NewCol(NotificationDeliveryColumnStatus, domain.NotificationDeliveryStatusRetrying),
+		handler.NewCol(NotificationDeliveryColumnError, payload.Error),
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceSent(event eventstore.Event) (*handler.Statement, error) {
+	payload := new(notificationSentPayload)
+	if err := unmarshalNotificationPayload(event, payload); err != nil {
+		return nil, err
+	}
+	return p.updateStatement(event,
+		handler.NewIncrementCol(NotificationDeliveryColumnAttempts, 1),
+		handler.NewCol(NotificationDeliveryColumnStatus, domain.NotificationDeliveryStatusSent),
+		handler.NewCol(NotificationDeliveryColumnProviderID, payload.ProviderID),
+		handler.NewCol(NotificationDeliveryColumnProviderMessageID, payload.ProviderMessageID),
+		handler.NewCol(NotificationDeliveryColumnRecipientHash, payload.RecipientHash),
+		handler.NewCol(NotificationDeliveryColumnError, ""),
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceCanceled(event eventstore.Event) (*handler.Statement, error) {
+	payload := new(notificationErrorPayload)
+	if err := unmarshalNotificationPayload(event, payload); err != nil {
+		return nil, err
+	}
+	// notifications canceled without an error (e.g. because they expired) were not attempted
+	if payload.Error == "" {
+		return p.updateStatement(event,
+			handler.NewCol(NotificationDeliveryColumnStatus, domain.NotificationDeliveryStatusFailed),
+		), nil
+	}
+	return p.updateStatement(event,
+		handler.NewIncrementCol(NotificationDeliveryColumnAttempts, 1),
+		handler.NewCol(NotificationDeliveryColumnStatus, domain.NotificationDeliveryStatusFailed),
+		handler.NewCol(NotificationDeliveryColumnError, payload.Error),
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceDeliveryStatusChanged(event eventstore.Event) (*handler.Statement, error) {
+	payload := new(notificationDeliveryStatusPayload)
+	if err := unmarshalNotificationPayload(event, payload); err != nil {
+		return nil, err
+	}
+	return p.updateStatement(event,
+		handler.NewCol(NotificationDeliveryColumnStatus, payload.Status),
+		handler.NewCol(NotificationDeliveryColumnError, payload.Error),
+	), nil
+}
+
+func (p *notificationDeliveryProjection) updateStatement(event eventstore.Event, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(NotificationDeliveryColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(NotificationDeliveryColumnSequence, event.Sequence()),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryColumnID, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *notificationDeliveryProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryColumnUserResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,271 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+func notificationEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return eventstore.BaseEventFromRepo(event), nil
+}
+
+func TestNotificationDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationRequestedType,
+						notificationAggregateType,
+						[]byte(`{"request": {"userID": "user-id", "userResourceOwner": "org-id", "messageType": "InitCode", "notificationType": 1}}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, id, resource_owner, creation_date, change_date, sequence, user_id, user_resource_owner, message_type, channel, status) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"org-id",
+								"InitCode",
+								domain.NotificationTypeSms,
+								domain.NotificationDeliveryStatusRequested,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRetryRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationRetryRequestedType,
+						notificationAggregateType,
+						[]byte(`{"error": "connection refused", "backOff": 1000}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceRetryRequested,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, attempts, status, error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								domain.NotificationDeliveryStatusRetrying,
+								"connection refused",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSent",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationSentType,
+						notificationAggregateType,
+						[]byte(`{"providerID": "provider-id", "providerMessageID": "message-id", "recipientHash": "hash"}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceSent,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, attempts, status, provider_id, provider_message_id, recipient_hash, error) = ($1, $2, attempts + $3, $4, $5, $6, $7, $8) WHERE (instance_id = $9) AND (id = $10)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								domain.NotificationDeliveryStatusSent,
+								"provider-id",
+								"message-id",
+								"hash",
+								"",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCanceled without error",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationCanceledType,
+						notificationAggregateType,
+						[]byte(`{"error": ""}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceCanceled,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, status) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationDeliveryStatusFailed,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCanceled with error",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationCanceledType,
+						notificationAggregateType,
+						[]byte(`{"error": "invalid recipient"}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceCanceled,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, attempts, status, error) = ($1, $2, attempts + $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								1,
+								domain.NotificationDeliveryStatusFailed,
+								"invalid recipient",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryStatusChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notificationDeliveryStatusType,
+						notificationAggregateType,
+						[]byte(`{"status": 6, "error": "mailbox full"}`),
+					),
+					notificationEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliveryStatusChanged,
+			want: wantReduce{
+				aggregateType: notificationAggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, status, error) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationDeliveryStatusBounced,
+								"mailbox full",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (user_resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.args.event(t)
+			got, err := tt.reduce(event)
+			assertReduce(t, got, err, NotificationDeliveryProjectionTable, tt.want)
+		})
+	}
+}
@@ -16,16 +16,23 @@ import (
 const (
 	NotificationPolicyProjectionTable = "projections.notification_policies"
 
-	NotificationPolicyColumnID             = "id"
-	NotificationPolicyColumnCreationDate   = "creation_date"
-	NotificationPolicyColumnChangeDate     = "change_date"
-	NotificationPolicyColumnResourceOwner  = "resource_owner"
-	NotificationPolicyColumnInstanceID     = "instance_id"
-	NotificationPolicyColumnSequence       = "sequence"
-	NotificationPolicyColumnStateCol       = "state"
-	NotificationPolicyColumnIsDefault      = "is_default"
-	NotificationPolicyColumnPasswordChange = "password_change"
-	NotificationPolicyColumnOwnerRemoved   = "owner_removed"
+	NotificationPolicyColumnID                      = "id"
+	NotificationPolicyColumnCreationDate            = "creation_date"
+	NotificationPolicyColumnChangeDate              = "change_date"
+	NotificationPolicyColumnResourceOwner           = "resource_owner"
+	NotificationPolicyColumnInstanceID              = "instance_id"
+	NotificationPolicyColumnSequence                = "sequence"
+	NotificationPolicyColumnStateCol                = "state"
+	NotificationPolicyColumnIsDefault               = "is_default"
+	NotificationPolicyColumnPasswordChange          = "password_change"
+	NotificationPolicyColumnOwnerRemoved            = "owner_removed"
+	NotificationPolicyColumnSecurityNewDeviceSignIn = "security_new_device_sign_in"
+	NotificationPolicyColumnSecurityMFAAdded        = "security_mfa_added"
+	NotificationPolicyColumnSecurityMFARemoved      = "security_mfa_removed"
+	NotificationPolicyColumnSecurityPasskeyAdded    = "security_passkey_added"
+	NotificationPolicyColumnSecurityEmailChanged    = "security_email_changed"
+	NotificationPolicyColumnSecurityAccountLocked   = "security_account_locked"
+	NotificationPolicyColumnSecurityPATCreated      = "security_pat_created"
 )
 
 type notificationPolicyProjection struct{}
@@ -51,6 +58,13 @@ func (*notificationPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(NotificationPolicyColumnIsDefault, handler.ColumnTypeBool),
 			handler.NewColumn(NotificationPolicyColumnPasswordChange, handler.ColumnTypeBool),
 			handler.NewColumn(NotificationPolicyColumnOwnerRemoved, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityNewDeviceSignIn, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityMFAAdded, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityMFARemoved, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityPasskeyAdded, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityEmailChanged, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityAccountLocked, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnSecurityPATCreated, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(NotificationPolicyColumnInstanceID, NotificationPolicyColumnID),
 		),
@@ -145,6 +159,17 @@ func (p *notificationPolicyProjection) reduceChanged(event eventstore.Event) (*h
 	if policyEvent.PasswordChange != nil {
 		cols = append(cols, handler.NewCol(NotificationPolicyColumnPasswordChange, *policyEvent.PasswordChange))
 	}
+	if policyEvent.SecurityNotifications != nil {
+		cols = append(cols,
+			handler.NewCol(NotificationPolicyColumnSecurityNewDeviceSignIn, policyEvent.SecurityNotifications.NewDeviceSignIn),
+			handler.NewCol(NotificationPolicyColumnSecurityMFAAdded, policyEvent.SecurityNotifications.MFAAdded),
+			handler.NewCol(NotificationPolicyColumnSecurityMFARemoved, policyEvent.SecurityNotifications.MFARemoved),
+			handler.NewCol(NotificationPolicyColumnSecurityPasskeyAdded, policyEvent.SecurityNotifications.PasskeyAdded),
+			handler.NewCol(NotificationPolicyColumnSecurityEmailChanged, policyEvent.SecurityNotifications.EmailChanged),
+			handler.NewCol(NotificationPolicyColumnSecurityAccountLocked, policyEvent.SecurityNotifications.AccountLocked),
+			handler.NewCol(NotificationPolicyColumnSecurityPATCreated, policyEvent.SecurityNotifications.PATCreated),
+		)
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
@@ -209,6 +209,47 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "instance reduceChanged security notifications",
+			reduce: (&notificationPolicyProjection{}).reduceChanged,
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.NotificationPolicyChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"securityNotifications": {
+							"mfaAdded": true,
+							"accountLocked": true
+						}
+					}`),
+					), instance.NotificationPolicyChangedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_policies SET (change_date, sequence, security_new_device_sign_in, security_mfa_added, security_mfa_removed, security_passkey_added, security_email_changed, security_account_locked, security_pat_created) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10) AND (instance_id = $11)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								false,
+								true,
+								false,
+								false,
+								false,
+								true,
+								false,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "org.reduceOwnerRemoved",
 			reduce: (&notificationPolicyProjection{}).reduceOwnerRemoved,
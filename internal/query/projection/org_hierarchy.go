@@ -0,0 +1,148 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	OrgHierarchyProjectionTable = "projections.org_hierarchy"
+
+	OrgHierarchyColumnInstanceID           = "instance_id"
+	OrgHierarchyColumnOrgID                = "org_id"
+	OrgHierarchyColumnParentID             = "parent_id"
+	OrgHierarchyColumnCreationDate         = "creation_date"
+	OrgHierarchyColumnChangeDate           = "change_date"
+	OrgHierarchyColumnSequence             = "sequence"
+	OrgHierarchyColumnInheritIDPs          = "inherit_idps"
+	OrgHierarchyColumnInheritProjectGrants = "inherit_project_grants"
+	OrgHierarchyColumnInheritMembers       = "inherit_members"
+)
+
+type orgHierarchyProjection struct{}
+
+func newOrgHierarchyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(orgHierarchyProjection))
+}
+
+func (*orgHierarchyProjection) Name() string {
+	return OrgHierarchyProjectionTable
+}
+
+func (*orgHierarchyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(OrgHierarchyColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(OrgHierarchyColumnOrgID, handler.ColumnTypeText),
+			handler.NewColumn(OrgHierarchyColumnParentID, handler.ColumnTypeText),
+			handler.NewColumn(OrgHierarchyColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgHierarchyColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(OrgHierarchyColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(OrgHierarchyColumnInheritIDPs, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OrgHierarchyColumnInheritProjectGrants, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OrgHierarchyColumnInheritMembers, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(OrgHierarchyColumnInstanceID, OrgHierarchyColumnOrgID),
+			handler.WithIndex(handler.NewIndex("parent", []string{OrgHierarchyColumnInstanceID, OrgHierarchyColumnParentID})),
+		),
+	)
+}
+
+func (p *orgHierarchyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgParentSetEventType,
+					Reduce: p.reduceParentSet,
+				},
+				{
+					Event:  org.OrgParentRemovedEventType,
+					Reduce: p.reduceParentRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(OrgHierarchyColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *orgHierarchyProjection) reduceParentSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgParentSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(OrgHierarchyColumnInstanceID, nil),
+			handler.NewCol(OrgHierarchyColumnOrgID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(OrgHierarchyColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(OrgHierarchyColumnOrgID, e.Aggregate().ID),
+			handler.NewCol(OrgHierarchyColumnParentID, e.ParentOrgID),
+			handler.NewCol(OrgHierarchyColumnCreationDate, handler.OnlySetValueOnInsert(OrgHierarchyProjectionTable, e.CreationDate())),
+			handler.NewCol(OrgHierarchyColumnChangeDate, e.CreationDate()),
+			handler.NewCol(OrgHierarchyColumnSequence, e.Sequence()),
+			handler.NewCol(OrgHierarchyColumnInheritIDPs, e.InheritIDPs),
+			handler.NewCol(OrgHierarchyColumnInheritProjectGrants, e.InheritProjectGrants),
+			handler.NewCol(OrgHierarchyColumnInheritMembers, e.InheritMembers),
+		},
+	), nil
+}
+
+func (p *orgHierarchyProjection) reduceParentRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgParentRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(OrgHierarchyColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgHierarchyColumnOrgID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+// reduceOrgRemoved removes the link to the parent
+// and turns the children of the organization into roots of their own hierarchy.
+func (p *orgHierarchyProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(OrgHierarchyColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgHierarchyColumnOrgID, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(OrgHierarchyColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgHierarchyColumnParentID, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
@@ -71,6 +71,14 @@ func (p *orgMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.MemberRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  org.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOrgRemoved,
@@ -138,6 +146,25 @@ func (p *orgMemberProjection) reduceRemoved(event eventstore.Event) (*handler.St
 	)
 }
 
+func (p *orgMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.MemberValiditySetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
+}
+
+func (p *orgMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.MemberExpiredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return reduceMemberRemoved(e,
+		withMemberCond(MemberUserIDCol, e.UserID),
+		withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID),
+	)
+}
+
 func (p *orgMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.UserRemovedEvent)
 	if !ok {
@@ -2,8 +2,10 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"golang.org/x/text/language"
 
@@ -258,6 +260,72 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org MemberValiditySetEventType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberValiditySetEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validUntil": "2030-01-01T00:00:00Z"
+				}`),
+					), org.MemberValiditySetEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceValiditySet,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_members4 SET (valid_from, valid_until, change_date, sequence) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (user_id = $6) AND (org_id = $7)",
+							expectedArgs: []interface{}{
+								&sql.NullTime{},
+								&sql.NullTime{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org MemberExpiredEventType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberExpiredEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validUntil": "2030-01-01T00:00:00Z"
+				}`),
+					), org.MemberExpiredEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceExpired,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_members4 WHERE (instance_id = $1) AND (user_id = $2) AND (org_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "user UserRemovedEventType",
 			args: args{
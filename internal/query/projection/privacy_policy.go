@@ -14,24 +14,25 @@ import (
 )
 
 const (
-	PrivacyPolicyTable = "projections.privacy_policies4"
+	PrivacyPolicyTable = "projections.privacy_policies5"
 
-	PrivacyPolicyIDCol             = "id"
-	PrivacyPolicyCreationDateCol   = "creation_date"
-	PrivacyPolicyChangeDateCol     = "change_date"
-	PrivacyPolicySequenceCol       = "sequence"
-	PrivacyPolicyStateCol          = "state"
-	PrivacyPolicyIsDefaultCol      = "is_default"
-	PrivacyPolicyResourceOwnerCol  = "resource_owner"
-	PrivacyPolicyInstanceIDCol     = "instance_id"
-	PrivacyPolicyPrivacyLinkCol    = "privacy_link"
-	PrivacyPolicyTOSLinkCol        = "tos_link"
-	PrivacyPolicyHelpLinkCol       = "help_link"
-	PrivacyPolicySupportEmailCol   = "support_email"
-	PrivacyPolicyDocsLinkCol       = "docs_link"
-	PrivacyPolicyCustomLinkCol     = "custom_link"
-	PrivacyPolicyCustomLinkTextCol = "custom_link_text"
-	PrivacyPolicyOwnerRemovedCol   = "owner_removed"
+	PrivacyPolicyIDCol                  = "id"
+	PrivacyPolicyCreationDateCol        = "creation_date"
+	PrivacyPolicyChangeDateCol          = "change_date"
+	PrivacyPolicySequenceCol            = "sequence"
+	PrivacyPolicyStateCol               = "state"
+	PrivacyPolicyIsDefaultCol           = "is_default"
+	PrivacyPolicyResourceOwnerCol       = "resource_owner"
+	PrivacyPolicyInstanceIDCol          = "instance_id"
+	PrivacyPolicyPrivacyLinkCol         = "privacy_link"
+	PrivacyPolicyTOSLinkCol             = "tos_link"
+	PrivacyPolicyHelpLinkCol            = "help_link"
+	PrivacyPolicySupportEmailCol        = "support_email"
+	PrivacyPolicyDocsLinkCol            = "docs_link"
+	PrivacyPolicyCustomLinkCol          = "custom_link"
+	PrivacyPolicyCustomLinkTextCol      = "custom_link_text"
+	PrivacyPolicyDeletionGracePeriodCol = "deletion_grace_period"
+	PrivacyPolicyOwnerRemovedCol        = "owner_removed"
 )
 
 type privacyPolicyProjection struct{}
@@ -62,6 +63,7 @@ func (*privacyPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(PrivacyPolicyDocsLinkCol, handler.ColumnTypeText, handler.Default("https://zitadel.com/docs")),
 			handler.NewColumn(PrivacyPolicyCustomLinkCol, handler.ColumnTypeText),
 			handler.NewColumn(PrivacyPolicyCustomLinkTextCol, handler.ColumnTypeText),
+			handler.NewColumn(PrivacyPolicyDeletionGracePeriodCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(PrivacyPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(PrivacyPolicyInstanceIDCol, PrivacyPolicyIDCol),
@@ -141,6 +143,7 @@ func (p *privacyPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(PrivacyPolicyDocsLinkCol, policyEvent.DocsLink),
 			handler.NewCol(PrivacyPolicyCustomLinkCol, policyEvent.CustomLink),
 			handler.NewCol(PrivacyPolicyCustomLinkTextCol, policyEvent.CustomLinkText),
+			handler.NewCol(PrivacyPolicyDeletionGracePeriodCol, policyEvent.DeletionGracePeriod),
 			handler.NewCol(PrivacyPolicyIsDefaultCol, isDefault),
 			handler.NewCol(PrivacyPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(PrivacyPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -182,6 +185,9 @@ func (p *privacyPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.CustomLinkText != nil {
 		cols = append(cols, handler.NewCol(PrivacyPolicyCustomLinkTextCol, *policyEvent.CustomLinkText))
 	}
+	if policyEvent.DeletionGracePeriod != nil {
+		cols = append(cols, handler.NewCol(PrivacyPolicyDeletionGracePeriodCol, *policyEvent.DeletionGracePeriod))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
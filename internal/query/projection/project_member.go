@@ -75,6 +75,14 @@ func (p *projectMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.MemberRemovedType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  project.MemberValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  project.MemberExpiredType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  project.ProjectRemovedType,
 					Reduce: p.reduceProjectRemoved,
@@ -161,6 +169,25 @@ func (p *projectMemberProjection) reduceRemoved(event eventstore.Event) (*handle
 	)
 }
 
+func (p *projectMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.MemberValiditySetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent, withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID))
+}
+
+func (p *projectMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.MemberExpiredEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return reduceMemberRemoved(e,
+		withMemberCond(MemberUserIDCol, e.UserID),
+		withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID),
+	)
+}
+
 func (p *projectMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.UserRemovedEvent)
 	if !ok {
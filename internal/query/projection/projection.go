@@ -39,6 +39,7 @@ var (
 	IDPLoginPolicyLinkProjection        *handler.Handler
 	IDPTemplateProjection               *handler.Handler
 	MailTemplateProjection              *handler.Handler
+	MessageTemplateProjection           *handler.Handler
 	MessageTextProjection               *handler.Handler
 	CustomTextProjection                *handler.Handler
 	UserProjection                      *handler.Handler
@@ -77,6 +78,21 @@ var (
 	InstanceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
+	TargetDeliveryProjection            *handler.Handler
+	UserConsentProjection               *handler.Handler
+	ACRDefinitionProjection             *handler.Handler
+	CustomRoleProjection                *handler.Handler
+	RelationProjection                  *handler.Handler
+	OrgHierarchyProjection              *handler.Handler
+	AccessRequestProjection             *handler.Handler
+	AccessReviewProjection              *handler.Handler
+	InactivityPolicyProjection          *handler.Handler
+	UserActivityProjection              *handler.Handler
+	UserDataExportProjection            *handler.Handler
+	UserScheduledDeletionProjection     *handler.Handler
+	UserImportJobProjection             *handler.Handler
+	UserTrustedDeviceProjection         *handler.Handler
+	NotificationDeliveryProjection      *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
 	DebugEventsProjection               *handler.Handler
@@ -130,6 +146,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	IDPLoginPolicyLinkProjection = newIDPLoginPolicyLinkProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_login_policy_links"]))
 	IDPTemplateProjection = newIDPTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_templates"]))
 	MailTemplateProjection = newMailTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["mail_templates"]))
+	MessageTemplateProjection = newMessageTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_templates"]))
 	MessageTextProjection = newMessageTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_texts"]))
 	CustomTextProjection = newCustomTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_texts"]))
 	UserProjection = newUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["users"]))
@@ -165,6 +182,21 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
+	TargetDeliveryProjection = newTargetDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["target_deliveries"]))
+	UserConsentProjection = newUserConsentProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_consents"]))
+	ACRDefinitionProjection = newACRDefinitionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["acr_definitions"]))
+	CustomRoleProjection = newCustomRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_roles"]))
+	RelationProjection = newRelationProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["relations"]))
+	OrgHierarchyProjection = newOrgHierarchyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_hierarchy"]))
+	AccessRequestProjection = newAccessRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_requests"]))
+	AccessReviewProjection = newAccessReviewProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["access_reviews"]))
+	InactivityPolicyProjection = newInactivityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["inactivity_policies"]))
+	UserActivityProjection = newUserActivityProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_activities"]))
+	UserDataExportProjection = newUserDataExportProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_data_exports"]))
+	UserScheduledDeletionProjection = newUserScheduledDeletionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_scheduled_deletions"]))
+	UserImportJobProjection = newUserImportJobProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_import_jobs"]))
+	UserTrustedDeviceProjection = newUserTrustedDeviceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_trusted_devices"]))
+	NotificationDeliveryProjection = newNotificationDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_deliveries"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
 	DebugEventsProjection = newDebugEventsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_events"]))
@@ -259,6 +291,7 @@ func newProjectionsList() {
 		IDPUserLinkProjection,
 		IDPLoginPolicyLinkProjection,
 		MailTemplateProjection,
+		MessageTemplateProjection,
 		MessageTextProjection,
 		CustomTextProjection,
 		UserProjection,
@@ -294,6 +327,21 @@ func newProjectionsList() {
 		InstanceFeatureProjection,
 		TargetProjection,
 		ExecutionProjection,
+		TargetDeliveryProjection,
+		UserConsentProjection,
+		ACRDefinitionProjection,
+		CustomRoleProjection,
+		RelationProjection,
+		OrgHierarchyProjection,
+		AccessRequestProjection,
+		AccessReviewProjection,
+		InactivityPolicyProjection,
+		UserActivityProjection,
+		UserDataExportProjection,
+		UserScheduledDeletionProjection,
+		UserImportJobProjection,
+		UserTrustedDeviceProjection,
+		NotificationDeliveryProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
 		DebugEventsProjection,
@@ -0,0 +1,272 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/relation"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	RelationProjectionTable = "projections.relations"
+
+	RelationSchemaColumnInstanceID    = "instance_id"
+	RelationSchemaColumnResourceOwner = "resource_owner"
+	RelationSchemaColumnProjectID     = "project_id"
+	RelationSchemaColumnCreationDate  = "creation_date"
+	RelationSchemaColumnChangeDate    = "change_date"
+	RelationSchemaColumnSequence      = "sequence"
+	RelationSchemaColumnSchema        = "schema"
+
+	RelationTupleSuffix                = "tuples"
+	RelationTupleColumnInstanceID      = "instance_id"
+	RelationTupleColumnResourceOwner   = "resource_owner"
+	RelationTupleColumnProjectID       = "project_id"
+	RelationTupleColumnObjectType      = "object_type"
+	RelationTupleColumnObjectID        = "object_id"
+	RelationTupleColumnRelation        = "relation"
+	RelationTupleColumnSubjectType     = "subject_type"
+	RelationTupleColumnSubjectID       = "subject_id"
+	RelationTupleColumnSubjectRelation = "subject_relation"
+	RelationTupleColumnCreationDate    = "creation_date"
+	RelationTupleColumnSequence        = "sequence"
+)
+
+type relationProjection struct{}
+
+func newRelationProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(relationProjection))
+}
+
+func (*relationProjection) Name() string {
+	return RelationProjectionTable
+}
+
+func (*relationProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(RelationSchemaColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(RelationSchemaColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(RelationSchemaColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(RelationSchemaColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(RelationSchemaColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(RelationSchemaColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(RelationSchemaColumnSchema, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(RelationSchemaColumnInstanceID, RelationSchemaColumnProjectID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{RelationSchemaColumnResourceOwner})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(RelationTupleColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnObjectType, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnObjectID, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnRelation, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnSubjectType, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnSubjectID, handler.ColumnTypeText),
+			handler.NewColumn(RelationTupleColumnSubjectRelation, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(RelationTupleColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(RelationTupleColumnSequence, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(
+				RelationTupleColumnInstanceID,
+				RelationTupleColumnProjectID,
+				RelationTupleColumnObjectType,
+				RelationTupleColumnObjectID,
+				RelationTupleColumnRelation,
+				RelationTupleColumnSubjectType,
+				RelationTupleColumnSubjectID,
+				RelationTupleColumnSubjectRelation,
+			),
+			RelationTupleSuffix,
+			// tuples can only be written if the schema of the project is set
+			handler.WithForeignKey(handler.NewForeignKey("schema", []string{RelationTupleColumnInstanceID, RelationTupleColumnProjectID}, []string{RelationSchemaColumnInstanceID, RelationSchemaColumnProjectID})),
+			handler.WithIndex(handler.NewIndex("subject", []string{RelationTupleColumnInstanceID, RelationTupleColumnSubjectType, RelationTupleColumnSubjectID})),
+		),
+	)
+}
+
+func (p *relationProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: relation.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  relation.TupleWrittenEventType,
+					Reduce: p.reduceTupleWritten,
+				},
+				{
+					Event:  relation.TupleDeletedEventType,
+					Reduce: p.reduceTupleDeleted,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.RelationSchemaSetType,
+					Reduce: p.reduceSchemaSet,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(RelationSchemaColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *relationProjection) reduceSchemaSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RelationSchemaSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := json.Marshal(e.Schema)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJE-Rl5aNm", "Errors.Internal")
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(RelationSchemaColumnInstanceID, nil),
+			handler.NewCol(RelationSchemaColumnProjectID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(RelationSchemaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(RelationSchemaColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(RelationSchemaColumnProjectID, e.Aggregate().ID),
+			handler.NewCol(RelationSchemaColumnCreationDate, handler.OnlySetValueOnInsert(RelationProjectionTable, e.CreationDate())),
+			handler.NewCol(RelationSchemaColumnChangeDate, e.CreationDate()),
+			handler.NewCol(RelationSchemaColumnSequence, e.Sequence()),
+			handler.NewCol(RelationSchemaColumnSchema, schema),
+		},
+	), nil
+}
+
+func (p *relationProjection) reduceTupleWritten(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*relation.TupleWrittenEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(RelationTupleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(RelationTupleColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(RelationTupleColumnProjectID, e.ProjectID),
+			handler.NewCol(RelationTupleColumnObjectType, e.ObjectType),
+			handler.NewCol(RelationTupleColumnObjectID, e.ObjectID),
+			handler.NewCol(RelationTupleColumnRelation, e.Relation),
+			handler.NewCol(RelationTupleColumnSubjectType, e.SubjectType),
+			handler.NewCol(RelationTupleColumnSubjectID, e.SubjectID),
+			handler.NewCol(RelationTupleColumnSubjectRelation, e.SubjectRelation),
+			handler.NewCol(RelationTupleColumnCreationDate, e.CreationDate()),
+			handler.NewCol(RelationTupleColumnSequence, e.Sequence()),
+		},
+		handler.WithTableSuffix(RelationTupleSuffix),
+	), nil
+}
+
+func (p *relationProjection) reduceTupleDeleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*relation.TupleDeletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RelationTupleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(RelationTupleColumnProjectID, e.ProjectID),
+			handler.NewCond(RelationTupleColumnObjectType, e.ObjectType),
+			handler.NewCond(RelationTupleColumnObjectID, e.ObjectID),
+			handler.NewCond(RelationTupleColumnRelation, e.Relation),
+			handler.NewCond(RelationTupleColumnSubjectType, e.SubjectType),
+			handler.NewCond(RelationTupleColumnSubjectID, e.SubjectID),
+			handler.NewCond(RelationTupleColumnSubjectRelation, e.SubjectRelation),
+		},
+		handler.WithTableSuffix(RelationTupleSuffix),
+	), nil
+}
+
+func (p *relationProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// tuples are removed by the foreign key
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RelationSchemaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(RelationSchemaColumnProjectID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *relationProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RelationTupleColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(RelationTupleColumnSubjectType, domain.RelationSubjectTypeUser),
+			handler.NewCond(RelationTupleColumnSubjectID, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(RelationTupleSuffix),
+	), nil
+}
+
+func (p *relationProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(RelationSchemaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(RelationSchemaColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
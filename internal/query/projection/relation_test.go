@@ -0,0 +1,263 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/relation"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestRelationProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSchemaSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.RelationSchemaSetType,
+						project.AggregateType,
+						[]byte(`{"schema": {"types": [{"name": "document", "relations": [{"name": "editor", "subjects": ["user"]}]}]}}`),
+					),
+					eventstore.GenericEventMapper[project.RelationSchemaSetEvent],
+				),
+			},
+			reduce: (&relationProjection{}).reduceSchemaSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.relations (instance_id, resource_owner, project_id, creation_date, change_date, sequence, schema) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, project_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, schema) = (EXCLUDED.resource_owner, projections.relations.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.schema)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								[]byte(`{"types":[{"name":"document","relations":[{"name":"editor","subjects":["user"]}]}]}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTupleWritten",
+			args: args{
+				event: getEvent(
+					testEvent(
+						relation.TupleWrittenEventType,
+						relation.AggregateType,
+						[]byte(`{"projectId": "project1", "objectType": "document", "objectId": "1", "relation": "editor", "subjectType": "group", "subjectId": "eng", "subjectRelation": "member"}`),
+					),
+					eventstore.GenericEventMapper[relation.TupleWrittenEvent],
+				),
+			},
+			reduce: (&relationProjection{}).reduceTupleWritten,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("relation"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.relations_tuples (instance_id, resource_owner, project_id, object_type, object_id, relation, subject_type, subject_id, subject_relation, creation_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"project1",
+								"document",
+								"1",
+								"editor",
+								"group",
+								"eng",
+								"member",
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTupleDeleted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						relation.TupleDeletedEventType,
+						relation.AggregateType,
+						[]byte(`{"projectId": "project1", "objectType": "document", "objectId": "1", "relation": "editor", "subjectType": "user", "subjectId": "user1"}`),
+					),
+					eventstore.GenericEventMapper[relation.TupleDeletedEvent],
+				),
+			},
+			reduce: (&relationProjection{}).reduceTupleDeleted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("relation"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.relations_tuples WHERE (instance_id = $1) AND (project_id = $2) AND (object_type = $3) AND (object_id = $4) AND (relation = $5) AND (subject_type = $6) AND (subject_id = $7) AND (subject_relation = $8)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"project1",
+								"document",
+								"1",
+								"editor",
+								"user",
+								"user1",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&relationProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.relations WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&relationProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.relations_tuples WHERE (instance_id = $1) AND (subject_type = $2) AND (subject_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"user",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&relationProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.relations WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(RelationSchemaColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.relations WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, RelationProjectionTable, tt.want)
+		})
+	}
+}
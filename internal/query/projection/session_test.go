@@ -6,6 +6,7 @@ import (
 
 	"github.com/muhlemmer/gu"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -232,6 +233,76 @@ func TestSessionProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceTrustedDeviceChecked",
+			args: args{
+				event: getEvent(testEvent(
+					session.TrustedDeviceCheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"deviceId": "device-id",
+						"checkedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), eventstore.GenericEventMapper[session.TrustedDeviceCheckedEvent]),
+			},
+			reduce: (&sessionProjection{}).reduceTrustedDeviceChecked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, trusted_device_checked_at) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								time.Date(2023, time.May, 4, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceRiskEvaluated",
+			args: args{
+				event: getEvent(testEvent(
+					session.RiskEvaluatedType,
+					session.AggregateType,
+					[]byte(`{
+						"userId": "user-id",
+						"ip": "192.0.2.1",
+						"score": 40,
+						"signals": [1, 2],
+						"action": 3
+					}`),
+				), eventstore.GenericEventMapper[session.RiskEvaluatedEvent]),
+			},
+			reduce: (&sessionProjection{}).reduceRiskEvaluated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, risk_score, risk_action, risk_signals, risk_evaluated_at) = ($1, $2, $3, $4, $5, $6) WHERE (id = $7) AND (instance_id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								40,
+								domain.RiskActionRequireMFA,
+								database.NumberArray[domain.RiskSignal]{domain.RiskSignalNewCountry, domain.RiskSignalNewASN},
+								anyArg{},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceTokenSet",
 			args: args{
@@ -8,6 +8,7 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 )
 
 const (
@@ -50,20 +51,7 @@ func (*smsConfigProjection) Name() string {
 }
 
 func (*smsConfigProjection) Init() *old_handler.Check {
-	return handler.NewMultiTableCheck(
-		handler.NewTable([]*handler.InitColumn{
-			handler.NewColumn(SMSColumnID, handler.ColumnTypeText),
-			handler.NewColumn(SMSColumnAggregateID, handler.ColumnTypeText),
-			handler.NewColumn(SMSColumnCreationDate, handler.ColumnTypeTimestamp),
-			handler.NewColumn(SMSColumnChangeDate, handler.ColumnTypeTimestamp),
-			handler.NewColumn(SMSColumnSequence, handler.ColumnTypeInt64),
-			handler.NewColumn(SMSColumnState, handler.ColumnTypeEnum),
-			handler.NewColumn(SMSColumnResourceOwner, handler.ColumnTypeText),
-			handler.NewColumn(SMSColumnInstanceID, handler.ColumnTypeText),
-			handler.NewColumn(SMSColumnDescription, handler.ColumnTypeText),
-		},
-			handler.NewPrimaryKey(SMSColumnInstanceID, SMSColumnID),
-		),
+	tables := append([]*handler.SuffixedTable{
 		handler.NewSuffixedTable([]*handler.InitColumn{
 			handler.NewColumn(SMSTwilioColumnSMSID, handler.ColumnTypeText),
 			handler.NewColumn(SMSTwilioColumnInstanceID, handler.ColumnTypeText),
@@ -85,6 +73,22 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsHTTPTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+	}, smsProviderTables()...)
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SMSColumnID, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnAggregateID, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SMSColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SMSColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(SMSColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(SMSColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnDescription, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSColumnInstanceID, SMSColumnID),
+		),
+		tables...,
 	)
 }
 
@@ -92,7 +96,7 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 	return []handler.AggregateReducer{
 		{
 			Aggregate: instance.AggregateType,
-			EventReducers: []handler.EventReducer{
+			EventReducers: append([]handler.EventReducer{
 				{
 					Event:  instance.SMSConfigTwilioAddedEventType,
 					Reduce: p.reduceSMSConfigTwilioAdded,
@@ -141,6 +145,47 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(SMSColumnInstanceID),
 				},
+			}, p.smsProviderReducers()...),
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMSConfigTwilioAddedEventType,
+					Reduce: p.reduceOrgSMSConfigTwilioAdded,
+				},
+				{
+					Event:  org.SMSConfigTwilioChangedEventType,
+					Reduce: p.reduceOrgSMSConfigTwilioChanged,
+				},
+				{
+					Event:  org.SMSConfigTwilioTokenChangedEventType,
+					Reduce: p.reduceOrgSMSConfigTwilioTokenChanged,
+				},
+				{
+					Event:  org.SMSConfigHTTPAddedEventType,
+					Reduce: p.reduceOrgSMSConfigHTTPAdded,
+				},
+				{
+					Event:  org.SMSConfigHTTPChangedEventType,
+					Reduce: p.reduceOrgSMSConfigHTTPChanged,
+				},
+				{
+					Event:  org.SMSConfigActivatedEventType,
+					Reduce: p.reduceOrgSMSConfigActivated,
+				},
+				{
+					Event:  org.SMSConfigDeactivatedEventType,
+					Reduce: p.reduceOrgSMSConfigDeactivated,
+				},
+				{
+					Event:  org.SMSConfigRemovedEventType,
+					Reduce: p.reduceOrgSMSConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
 			},
 		},
 	}
@@ -350,6 +395,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Ev
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -419,6 +465,7 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -469,3 +516,82 @@ func (p *smsConfigProjection) reduceSMSConfigRemoved(event eventstore.Event) (*h
 		},
 	), nil
 }
+
+func (p *smsConfigProjection) reduceOrgSMSConfigTwilioAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigTwilioAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigTwilioAdded(&e.SMSConfigTwilioAddedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigTwilioChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigTwilioChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigTwilioChanged(&e.SMSConfigTwilioChangedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigTwilioTokenChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigTwilioTokenChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigTwilioTokenChanged(&e.SMSConfigTwilioTokenChangedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigHTTPAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigHTTPAdded(&e.SMSConfigHTTPAddedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigHTTPChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigHTTPChanged(&e.SMSConfigHTTPChangedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigActivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigActivated(&e.SMSConfigActivatedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigDeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigDeactivated(&e.SMSConfigDeactivatedEvent)
+}
+
+func (p *smsConfigProjection) reduceOrgSMSConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMSConfigRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMSConfigRemoved(&e.SMSConfigRemovedEvent)
+}
+
+func (p *smsConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMSColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,435 @@
+package projection
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+const (
+	SMSVonageTable      = SMSConfigProjectionTable + "_" + smsVonageTableSuffix
+	SMSSNSTable         = SMSConfigProjectionTable + "_" + smsSNSTableSuffix
+	SMSMessageBirdTable = SMSConfigProjectionTable + "_" + smsMessageBirdTableSuffix
+	SMSSMPPTable        = SMSConfigProjectionTable + "_" + smsSMPPTableSuffix
+
+	smsVonageTableSuffix           = "vonage"
+	SMSVonageColumnSMSID           = "sms_id"
+	SMSVonageColumnInstanceID      = "instance_id"
+	SMSVonageColumnAPIKey          = "api_key"
+	SMSVonageColumnAPISecret       = "api_secret"
+	SMSVonageColumnSenderNumber    = "sender_number"
+	SMSVonageColumnVerifyBrand     = "verify_brand"
+	smsSNSTableSuffix              = "sns"
+	SMSSNSColumnSMSID              = "sms_id"
+	SMSSNSColumnInstanceID         = "instance_id"
+	SMSSNSColumnRegion             = "region"
+	SMSSNSColumnAccessKeyID        = "access_key_id"
+	SMSSNSColumnSecretAccessKey    = "secret_access_key"
+	SMSSNSColumnSenderID           = "sender_id"
+	smsMessageBirdTableSuffix      = "messagebird"
+	SMSMessageBirdColumnSMSID      = "sms_id"
+	SMSMessageBirdColumnInstanceID = "instance_id"
+	SMSMessageBirdColumnAccessKey  = "access_key"
+	SMSMessageBirdColumnOriginator = "originator"
+	SMSMessageBirdColumnVerify     = "verify"
+	smsSMPPTableSuffix             = "smpp"
+	SMSSMPPColumnSMSID             = "sms_id"
+	SMSSMPPColumnInstanceID        = "instance_id"
+	SMSSMPPColumnHost              = "host"
+	SMSSMPPColumnTLS               = "tls"
+	SMSSMPPColumnTLSCA             = "tls_ca"
+	SMSSMPPColumnTLSServerName     = "tls_server_name"
+	SMSSMPPColumnSystemID          = "system_id"
+	SMSSMPPColumnPassword          = "password"
+	SMSSMPPColumnSystemType        = "system_type"
+	SMSSMPPColumnSourceAddress     = "source_address"
+)
+
+func smsProviderTables() []*handler.SuffixedTable {
+	return []*handler.SuffixedTable{
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSVonageColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnAPIKey, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnAPISecret, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSVonageColumnSenderNumber, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnVerifyBrand, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSVonageColumnInstanceID, SMSVonageColumnSMSID),
+			smsVonageTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSSNSColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnRegion, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnAccessKeyID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnSecretAccessKey, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSSNSColumnSenderID, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSSNSColumnInstanceID, SMSSNSColumnSMSID),
+			smsSNSTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSMessageBirdColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdColumnAccessKey, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSMessageBirdColumnOriginator, handler.ColumnTypeText),
+			handler.NewColumn(SMSMessageBirdColumnVerify, handler.ColumnTypeBool),
+		},
+			handler.NewPrimaryKey(SMSMessageBirdColumnInstanceID, SMSMessageBirdColumnSMSID),
+			smsMessageBirdTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSSMPPColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSMPPColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSMPPColumnHost, handler.ColumnTypeText),
+			handler.NewColumn(SMSSMPPColumnTLS, handler.ColumnTypeBool),
+			handler.NewColumn(SMSSMPPColumnTLSCA, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SMSSMPPColumnTLSServerName, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(SMSSMPPColumnSystemID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSMPPColumnPassword, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSSMPPColumnSystemType, handler.ColumnTypeText),
+			handler.NewColumn(SMSSMPPColumnSourceAddress, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSSMPPColumnInstanceID, SMSSMPPColumnSMSID),
+			smsSMPPTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+	}
+}
+
+func (p *smsConfigProjection) smsProviderReducers() []handler.EventReducer {
+	return []handler.EventReducer{
+		{
+			Event:  instance.SMSConfigVonageAddedEventType,
+			Reduce: p.reduceSMSConfigVonageAdded,
+		},
+		{
+			Event:  instance.SMSConfigVonageChangedEventType,
+			Reduce: p.reduceSMSConfigVonageChanged,
+		},
+		{
+			Event:  instance.SMSConfigVonageAPISecretChangedEventType,
+			Reduce: p.reduceSMSConfigVonageAPISecretChanged,
+		},
+		{
+			Event:  instance.SMSConfigSNSAddedEventType,
+			Reduce: p.reduceSMSConfigSNSAdded,
+		},
+		{
+			Event:  instance.SMSConfigSNSChangedEventType,
+			Reduce: p.reduceSMSConfigSNSChanged,
+		},
+		{
+			Event:  instance.SMSConfigSNSSecretAccessKeyChangedEventType,
+			Reduce: p.reduceSMSConfigSNSSecretAccessKeyChanged,
+		},
+		{
+			Event:  instance.SMSConfigMessageBirdAddedEventType,
+			Reduce: p.reduceSMSConfigMessageBirdAdded,
+		},
+		{
+			Event:  instance.SMSConfigMessageBirdChangedEventType,
+			Reduce: p.reduceSMSConfigMessageBirdChanged,
+		},
+		{
+			Event:  instance.SMSConfigMessageBirdAccessKeyChangedEventType,
+			Reduce: p.reduceSMSConfigMessageBirdAccessKeyChanged,
+		},
+		{
+			Event:  instance.SMSConfigSMPPAddedEventType,
+			Reduce: p.reduceSMSConfigSMPPAdded,
+		},
+		{
+			Event:  instance.SMSConfigSMPPChangedEventType,
+			Reduce: p.reduceSMSConfigSMPPChanged,
+		},
+		{
+			Event:  instance.SMSConfigSMPPPasswordChangedEventType,
+			Reduce: p.reduceSMSConfigSMPPPasswordChanged,
+		},
+	}
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Description),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageColumnSMSID, e.ID),
+				handler.NewCol(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSVonageColumnAPIKey, e.APIKey),
+				handler.NewCol(SMSVonageColumnAPISecret, e.APISecret),
+				handler.NewCol(SMSVonageColumnSenderNumber, e.SenderNumber),
+				handler.NewCol(SMSVonageColumnVerifyBrand, e.VerifyBrand),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]handler.Column, 0, 3)
+	if e.APIKey != nil {
+		columns = append(columns, handler.NewCol(SMSVonageColumnAPIKey, *e.APIKey))
+	}
+	if e.SenderNumber != nil {
+		columns = append(columns, handler.NewCol(SMSVonageColumnSenderNumber, *e.SenderNumber))
+	}
+	if e.VerifyBrand != nil {
+		columns = append(columns, handler.NewCol(SMSVonageColumnVerifyBrand, *e.VerifyBrand))
+	}
+	return changeSMSConfigStatement(e, e.ID, e.Description, smsVonageTableSuffix, columns), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageAPISecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageAPISecretChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return changeSMSConfigSecretStatement(e, e.ID, smsVonageTableSuffix, SMSVonageColumnAPISecret, e.APISecret), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Description),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSNSColumnSMSID, e.ID),
+				handler.NewCol(SMSSNSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSSNSColumnRegion, e.Region),
+				handler.NewCol(SMSSNSColumnAccessKeyID, e.AccessKeyID),
+				handler.NewCol(SMSSNSColumnSecretAccessKey, e.SecretAccessKey),
+				handler.NewCol(SMSSNSColumnSenderID, e.SenderID),
+			},
+			handler.WithTableSuffix(smsSNSTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]handler.Column, 0, 3)
+	if e.Region != nil {
+		columns = append(columns, handler.NewCol(SMSSNSColumnRegion, *e.Region))
+	}
+	if e.AccessKeyID != nil {
+		columns = append(columns, handler.NewCol(SMSSNSColumnAccessKeyID, *e.AccessKeyID))
+	}
+	if e.SenderID != nil {
+		columns = append(columns, handler.NewCol(SMSSNSColumnSenderID, *e.SenderID))
+	}
+	return changeSMSConfigStatement(e, e.ID, e.Description, smsSNSTableSuffix, columns), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSSecretAccessKeyChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSSecretAccessKeyChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return changeSMSConfigSecretStatement(e, e.ID, smsSNSTableSuffix, SMSSNSColumnSecretAccessKey, e.SecretAccessKey), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigMessageBirdAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Description),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSMessageBirdColumnSMSID, e.ID),
+				handler.NewCol(SMSMessageBirdColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSMessageBirdColumnAccessKey, e.AccessKey),
+				handler.NewCol(SMSMessageBirdColumnOriginator, e.Originator),
+				handler.NewCol(SMSMessageBirdColumnVerify, e.Verify),
+			},
+			handler.WithTableSuffix(smsMessageBirdTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigMessageBirdChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]handler.Column, 0, 2)
+	if e.Originator != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdColumnOriginator, *e.Originator))
+	}
+	if e.Verify != nil {
+		columns = append(columns, handler.NewCol(SMSMessageBirdColumnVerify, *e.Verify))
+	}
+	return changeSMSConfigStatement(e, e.ID, e.Description, smsMessageBirdTableSuffix, columns), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigMessageBirdAccessKeyChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigMessageBirdAccessKeyChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return changeSMSConfigSecretStatement(e, e.ID, smsMessageBirdTableSuffix, SMSMessageBirdColumnAccessKey, e.AccessKey), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSMPPAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		addSMSConfigStatement(e, e.ID, e.Description),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSMPPColumnSMSID, e.ID),
+				handler.NewCol(SMSSMPPColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSSMPPColumnHost, e.Host),
+				handler.NewCol(SMSSMPPColumnTLS, e.TLS),
+				handler.NewCol(SMSSMPPColumnTLSCA, e.TLSCA),
+				handler.NewCol(SMSSMPPColumnTLSServerName, e.TLSServerName),
+				handler.NewCol(SMSSMPPColumnSystemID, e.SystemID),
+				handler.NewCol(SMSSMPPColumnPassword, e.Password),
+				handler.NewCol(SMSSMPPColumnSystemType, e.SystemType),
+				handler.NewCol(SMSSMPPColumnSourceAddress, e.SourceAddress),
+			},
+			handler.WithTableSuffix(smsSMPPTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSMPPChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]handler.Column, 0, 7)
+	if e.Host != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnHost, *e.Host))
+	}
+	if e.TLS != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnTLS, *e.TLS))
+	}
+	if e.TLSCA != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnTLSCA, *e.TLSCA))
+	}
+	if e.TLSServerName != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnTLSServerName, *e.TLSServerName))
+	}
+	if e.SystemID != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnSystemID, *e.SystemID))
+	}
+	if e.SystemType != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnSystemType, *e.SystemType))
+	}
+	if e.SourceAddress != nil {
+		columns = append(columns, handler.NewCol(SMSSMPPColumnSourceAddress, *e.SourceAddress))
+	}
+	return changeSMSConfigStatement(e, e.ID, e.Description, smsSMPPTableSuffix, columns), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSMPPPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSMPPPasswordChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return changeSMSConfigSecretStatement(e, e.ID, smsSMPPTableSuffix, SMSSMPPColumnPassword, e.Password), nil
+}
+
+// addSMSConfigStatement creates the common row of a newly added provider config
+func addSMSConfigStatement(e eventstore.Event, id, description string) func(eventstore.Event) handler.Exec {
+	return handler.AddCreateStatement(
+		[]handler.Column{
+			handler.NewCol(SMSColumnID, id),
+			handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+			handler.NewCol(SMSColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+			handler.NewCol(SMSColumnSequence, e.Sequence()),
+			handler.NewCol(SMSColumnDescription, description),
+		},
+	)
+}
+
+// changeSMSConfigStatement updates the common row and the provider specific columns in the table of the suffix
+func changeSMSConfigStatement(e eventstore.Event, id string, description *string, tableSuffix string, providerColumns []handler.Column) *handler.Statement {
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, id),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+	if len(providerColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			providerColumns,
+			[]handler.Condition{
+				handler.NewCond("sms_id", id),
+				handler.NewCond("instance_id", e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(tableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...)
+}
+
+// changeSMSConfigSecretStatement updates the encrypted secret column of the provider table
+func changeSMSConfigSecretStatement(e eventstore.Event, id, tableSuffix, secretColumn string, secret *crypto.CryptoValue) *handler.Statement {
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(secretColumn, secret),
+			},
+			[]handler.Condition{
+				handler.NewCond("sms_id", id),
+				handler.NewCond("instance_id", e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(tableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreatedAt()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, id),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	)
+}
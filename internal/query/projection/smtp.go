@@ -8,10 +8,11 @@ import (
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
 )
 
 const (
-	SMTPConfigProjectionTable = "projections.smtp_configs5"
+	SMTPConfigProjectionTable = "projections.smtp_configs6"
 	SMTPConfigTable           = SMTPConfigProjectionTable + "_" + smtpConfigSMTPTableSuffix
 	SMTPConfigHTTPTable       = SMTPConfigProjectionTable + "_" + smtpConfigHTTPTableSuffix
 
@@ -35,6 +36,7 @@ const (
 	SMTPConfigSMTPColumnHost           = "host"
 	SMTPConfigSMTPColumnUser           = "username"
 	SMTPConfigSMTPColumnPassword       = "password"
+	SMTPConfigSMTPColumnWebhookToken   = "webhook_token"
 
 	smtpConfigHTTPTableSuffix      = "http"
 	SMTPConfigHTTPColumnInstanceID = "instance_id"
@@ -77,6 +79,7 @@ func (*smtpConfigProjection) Init() *old_handler.Check {
 			handler.NewColumn(SMTPConfigSMTPColumnHost, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigSMTPColumnUser, handler.ColumnTypeText),
 			handler.NewColumn(SMTPConfigSMTPColumnPassword, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMTPConfigSMTPColumnWebhookToken, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SMTPConfigSMTPColumnInstanceID, SMTPConfigSMTPColumnID),
 			smtpConfigSMTPTableSuffix,
@@ -111,6 +114,10 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigWebhookTokenGeneratedEventType,
+					Reduce: p.reduceSMTPConfigWebhookTokenGenerated,
+				},
 				{
 					Event:  instance.SMTPConfigHTTPAddedEventType,
 					Reduce: p.reduceSMTPConfigHTTPAdded,
@@ -137,6 +144,51 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 				},
 			},
 		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.SMTPConfigAddedEventType,
+					Reduce: p.reduceOrgSMTPConfigAdded,
+				},
+				{
+					Event:  org.SMTPConfigChangedEventType,
+					Reduce: p.reduceOrgSMTPConfigChanged,
+				},
+				{
+					Event:  org.SMTPConfigPasswordChangedEventType,
+					Reduce: p.reduceOrgSMTPConfigPasswordChanged,
+				},
+				{
+					Event:  org.SMTPConfigWebhookTokenGeneratedEventType,
+					Reduce: p.reduceOrgSMTPConfigWebhookTokenGenerated,
+				},
+				{
+					Event:  org.SMTPConfigHTTPAddedEventType,
+					Reduce: p.reduceOrgSMTPConfigHTTPAdded,
+				},
+				{
+					Event:  org.SMTPConfigHTTPChangedEventType,
+					Reduce: p.reduceOrgSMTPConfigHTTPChanged,
+				},
+				{
+					Event:  org.SMTPConfigActivatedEventType,
+					Reduce: p.reduceOrgSMTPConfigActivated,
+				},
+				{
+					Event:  org.SMTPConfigDeactivatedEventType,
+					Reduce: p.reduceOrgSMTPConfigDeactivated,
+				},
+				{
+					Event:  org.SMTPConfigRemovedEventType,
+					Reduce: p.reduceOrgSMTPConfigRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
 	}
 }
 
@@ -350,6 +402,37 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigWebhookTokenGenerated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigWebhookTokenGeneratedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigSMTPColumnWebhookToken, e.Token),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigSMTPColumnID, getSMTPConfigID(e.ID, e.Aggregate())),
+				handler.NewCond(SMTPConfigSMTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigSMTPTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigColumnID, getSMTPConfigID(e.ID, e.Aggregate())),
+				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMTPConfigActivatedEvent](event)
 	if err != nil {
@@ -368,6 +451,7 @@ func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event)
 				handler.Not(handler.NewCond(SMTPConfigColumnID, getSMTPConfigID(e.ID, e.Aggregate()))),
 				handler.NewCond(SMTPConfigColumnState, domain.SMTPConfigStateActive),
 				handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
 			},
 		),
 		handler.AddUpdateStatement(
@@ -419,6 +503,93 @@ func (p *smtpConfigProjection) reduceSMTPConfigRemoved(event eventstore.Event) (
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceOrgSMTPConfigAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigAdded(&e.SMTPConfigAddedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigChanged(&e.SMTPConfigChangedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigPasswordChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigPasswordChanged(&e.SMTPConfigPasswordChangedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigWebhookTokenGenerated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigWebhookTokenGeneratedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigWebhookTokenGenerated(&e.SMTPConfigWebhookTokenGeneratedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigHTTPAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigHTTPAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigHTTPAdded(&e.SMTPConfigHTTPAddedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigHTTPChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigHTTPChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigHTTPChanged(&e.SMTPConfigHTTPChangedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigActivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigActivated(&e.SMTPConfigActivatedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigDeactivatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigDeactivated(&e.SMTPConfigDeactivatedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOrgSMTPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.SMTPConfigRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.reduceSMTPConfigRemoved(&e.SMTPConfigRemovedEvent)
+}
+
+func (p *smtpConfigProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(SMTPConfigColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
+
 func getSMTPConfigID(id string, aggregate *eventstore.Aggregate) string {
 	if id != "" {
 		return id
@@ -24,6 +24,9 @@ const (
 	TargetTimeoutCol          = "timeout"
 	TargetInterruptOnErrorCol = "interrupt_on_error"
 	TargetSigningKey          = "signing_key"
+	TargetTLSCol              = "tls"
+	TargetOAuth2Col           = "oauth2"
+	TargetModuleCol           = "module"
 )
 
 type targetProjection struct{}
@@ -51,6 +54,9 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetTimeoutCol, handler.ColumnTypeInt64),
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool),
 			handler.NewColumn(TargetSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetTLSCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetOAuth2Col, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetModuleCol, handler.ColumnTypeBytes, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -93,23 +99,30 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 	if err != nil {
 		return nil, err
 	}
-	return handler.NewCreateStatement(
-		e,
-		[]handler.Column{
-			handler.NewCol(TargetInstanceIDCol, e.Aggregate().InstanceID),
-			handler.NewCol(TargetResourceOwnerCol, e.Aggregate().ResourceOwner),
-			handler.NewCol(TargetIDCol, e.Aggregate().ID),
-			handler.NewCol(TargetCreationDateCol, handler.OnlySetValueOnInsert(TargetTable, e.CreationDate())),
-			handler.NewCol(TargetChangeDateCol, e.CreationDate()),
-			handler.NewCol(TargetSequenceCol, e.Sequence()),
-			handler.NewCol(TargetNameCol, e.Name),
-			handler.NewCol(TargetEndpointCol, e.Endpoint),
-			handler.NewCol(TargetTargetType, e.TargetType),
-			handler.NewCol(TargetTimeoutCol, e.Timeout),
-			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
-			handler.NewCol(TargetSigningKey, e.SigningKey),
-		},
-	), nil
+	columns := []handler.Column{
+		handler.NewCol(TargetInstanceIDCol, e.Aggregate().InstanceID),
+		handler.NewCol(TargetResourceOwnerCol, e.Aggregate().ResourceOwner),
+		handler.NewCol(TargetIDCol, e.Aggregate().ID),
+		handler.NewCol(TargetCreationDateCol, handler.OnlySetValueOnInsert(TargetTable, e.CreationDate())),
+		handler.NewCol(TargetChangeDateCol, e.CreationDate()),
+		handler.NewCol(TargetSequenceCol, e.Sequence()),
+		handler.NewCol(TargetNameCol, e.Name),
+		handler.NewCol(TargetEndpointCol, e.Endpoint),
+		handler.NewCol(TargetTargetType, e.TargetType),
+		handler.NewCol(TargetTimeoutCol, e.Timeout),
+		handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
+		handler.NewCol(TargetSigningKey, e.SigningKey),
+	}
+	if !e.TLS.IsEmpty() {
+		columns = append(columns, handler.NewJSONCol(TargetTLSCol, e.TLS))
+	}
+	if !e.OAuth2.IsEmpty() {
+		columns = append(columns, handler.NewJSONCol(TargetOAuth2Col, e.OAuth2))
+	}
+	if len(e.Module) > 0 {
+		columns = append(columns, handler.NewCol(TargetModuleCol, e.Module))
+	}
+	return handler.NewCreateStatement(e, columns), nil
 }
 
 func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler.Statement, error) {
@@ -140,6 +153,15 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.SigningKey != nil {
 		values = append(values, handler.NewCol(TargetSigningKey, e.SigningKey))
 	}
+	if e.TLS != nil {
+		values = append(values, targetTransportCol(TargetTLSCol, e.TLS.IsEmpty(), e.TLS))
+	}
+	if e.OAuth2 != nil {
+		values = append(values, targetTransportCol(TargetOAuth2Col, e.OAuth2.IsEmpty(), e.OAuth2))
+	}
+	if e.Module != nil {
+		values = append(values, handler.NewCol(TargetModuleCol, e.Module))
+	}
 	return handler.NewUpdateStatement(
 		e,
 		values,
@@ -163,3 +185,11 @@ func (p *targetProjection) reduceTargetRemoved(event eventstore.Event) (*handler
 		},
 	), nil
 }
+
+// targetTransportCol removes the configuration if empty
+func targetTransportCol(name string, empty bool, config any) handler.Column {
+	if empty {
+		return handler.NewCol(name, nil)
+	}
+	return handler.NewJSONCol(name, config)
+}
@@ -0,0 +1,180 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+)
+
+const (
+	TargetDeliveryTable             = "projections.target_deliveries"
+	TargetDeliveryIDCol             = "id"
+	TargetDeliveryCreationDateCol   = "creation_date"
+	TargetDeliveryChangeDateCol     = "change_date"
+	TargetDeliveryInstanceIDCol     = "instance_id"
+	TargetDeliverySequenceCol       = "sequence"
+	TargetDeliveryTargetIDCol       = "target_id"
+	TargetDeliveryExecutionIDCol    = "execution_id"
+	TargetDeliveryIdempotencyKeyCol = "idempotency_key"
+	TargetDeliveryStateCol          = "state"
+	TargetDeliveryAttemptsCol       = "attempts"
+	TargetDeliveryLastErrorCol      = "last_error"
+	TargetDeliveryReplayOfCol       = "replay_of"
+	TargetDeliveryReplayIDCol       = "replay_id"
+)
+
+type targetDeliveryProjection struct{}
+
+func newTargetDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(targetDeliveryProjection))
+}
+
+func (*targetDeliveryProjection) Name() string {
+	return TargetDeliveryTable
+}
+
+func (*targetDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(TargetDeliveryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TargetDeliveryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TargetDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(TargetDeliveryTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryExecutionIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(TargetDeliveryIdempotencyKeyCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(TargetDeliveryAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(TargetDeliveryLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(TargetDeliveryReplayOfCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(TargetDeliveryReplayIDCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(TargetDeliveryInstanceIDCol, TargetDeliveryIDCol),
+			handler.WithIndex(handler.NewIndex("target", []string{TargetDeliveryInstanceIDCol, TargetDeliveryTargetIDCol})),
+		),
+	)
+}
+
+func (p *targetDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: targetdelivery.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  targetdelivery.RequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  targetdelivery.RetryRequestedType,
+					Reduce: p.reduceRetryRequested,
+				},
+				{
+					Event:  targetdelivery.SucceededType,
+					Reduce: p.reduceSucceeded,
+				},
+				{
+					Event:  targetdelivery.FailedType,
+					Reduce: p.reduceFailed,
+				},
+				{
+					Event:  targetdelivery.ReplayedType,
+					Reduce: p.reduceReplayed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(TargetDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *targetDeliveryProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*targetdelivery.RequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TargetDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(TargetDeliveryIDCol, e.Aggregate().ID),
+			handler.NewCol(TargetDeliveryCreationDateCol, e.CreationDate()),
+			handler.NewCol(TargetDeliveryChangeDateCol, e.CreationDate()),
+			handler.NewCol(TargetDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(TargetDeliveryTargetIDCol, e.TargetID),
+			handler.NewCol(TargetDeliveryExecutionIDCol, e.ExecutionID),
+			handler.NewCol(TargetDeliveryIdempotencyKeyCol, e.IdempotencyKey),
+			handler.NewCol(TargetDeliveryStateCol, domain.TargetDeliveryStateRequested),
+			handler.NewCol(TargetDeliveryReplayOfCol, e.ReplayOf),
+		},
+	), nil
+}
+
+func (p *targetDeliveryProjection) reduceRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*targetdelivery.RetryRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.TargetDeliveryStateRetrying,
+		handler.NewCol(TargetDeliveryAttemptsCol, e.Attempts),
+		handler.NewCol(TargetDeliveryLastErrorCol, e.Error),
+	), nil
+}
+
+func (p *targetDeliveryProjection) reduceSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*targetdelivery.SucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.TargetDeliveryStateSucceeded,
+		handler.NewCol(TargetDeliveryAttemptsCol, e.Attempts),
+	), nil
+}
+
+func (p *targetDeliveryProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*targetdelivery.FailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.TargetDeliveryStateFailed,
+		handler.NewCol(TargetDeliveryAttemptsCol, e.Attempts),
+		handler.NewCol(TargetDeliveryLastErrorCol, e.Error),
+	), nil
+}
+
+func (p *targetDeliveryProjection) reduceReplayed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*targetdelivery.ReplayedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, domain.TargetDeliveryStateReplayed,
+		handler.NewCol(TargetDeliveryReplayIDCol, e.ReplayID),
+	), nil
+}
+
+func (p *targetDeliveryProjection) updateState(e eventstore.Event, state domain.TargetDeliveryState, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		append([]handler.Column{
+			handler.NewCol(TargetDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(TargetDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(TargetDeliveryStateCol, state),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(TargetDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(TargetDeliveryIDCol, e.Aggregate().ID),
+		},
+	)
+}
@@ -0,0 +1,237 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/targetdelivery"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestTargetDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						targetdelivery.RequestedType,
+						targetdelivery.AggregateType,
+						[]byte(`{"request": {"targetID": "target", "executionID": "event/user.added", "idempotencyKey": "key", "payload": {"cryptoType": 0, "algorithm": "enc", "keyID": "id", "crypted": "e30="}, "replayOf": "replayed"}}`),
+					),
+					eventstore.GenericEventMapper[targetdelivery.RequestedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.target_deliveries (instance_id, id, creation_date, change_date, sequence, target_id, execution_id, idempotency_key, state, replay_of) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"target",
+								"event/user.added",
+								"key",
+								domain.TargetDeliveryStateRequested,
+								"replayed",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRetryRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						targetdelivery.RetryRequestedType,
+						targetdelivery.AggregateType,
+						[]byte(`{"request": {"targetID": "target"}, "error": "timeout", "backOff": 5000000000, "attempts": 1}`),
+					),
+					eventstore.GenericEventMapper[targetdelivery.RetryRequestedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceRetryRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state, attempts, last_error) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateRetrying,
+								uint16(1),
+								"timeout",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						targetdelivery.SucceededType,
+						targetdelivery.AggregateType,
+						[]byte(`{"attempts": 2}`),
+					),
+					eventstore.GenericEventMapper[targetdelivery.SucceededEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceSucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state, attempts) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateSucceeded,
+								uint16(2),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						targetdelivery.FailedType,
+						targetdelivery.AggregateType,
+						[]byte(`{"error": "timeout", "attempts": 10}`),
+					),
+					eventstore.GenericEventMapper[targetdelivery.FailedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state, attempts, last_error) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateFailed,
+								uint16(10),
+								"timeout",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceReplayed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						targetdelivery.ReplayedType,
+						targetdelivery.AggregateType,
+						[]byte(`{"replayID": "replay"}`),
+					),
+					eventstore.GenericEventMapper[targetdelivery.ReplayedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceReplayed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target_delivery"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state, replay_id) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateReplayed,
+								"replay",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(TargetDeliveryInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.target_deliveries WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, TargetDeliveryTable, tt.want)
+		})
+	}
+}
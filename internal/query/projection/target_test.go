@@ -99,6 +99,155 @@ func TestTargetProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceTargetAdded, transports",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.AddedEventType,
+						target.AggregateType,
+						[]byte(`{"name": "name", "targetType":0, "endpoint":"grpcs://example.com", "timeout": 3000000000, "interruptOnError": true, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "tls": {"caCertificates": "Y2E="}, "oauth2": {"tokenEndpoint": "https://example.com/oauth/token", "clientId": "client", "clientSecret": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }}}`),
+					),
+					eventstore.GenericEventMapper[target.AddedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.targets2 (instance_id, resource_owner, id, creation_date, change_date, sequence, name, endpoint, target_type, timeout, interrupt_on_error, signing_key, tls, oauth2) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"name",
+								"grpcs://example.com",
+								domain.TargetTypeWebhook,
+								3 * time.Second,
+								true,
+								anyArg{},
+								[]byte(`{"caCertificates":"Y2E="}`),
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetChanged, remove transports",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.ChangedEventType,
+						target.AggregateType,
+						[]byte(`{"tls": {}, "oauth2": {}}`),
+					),
+					eventstore.GenericEventMapper[target.ChangedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.targets2 SET (change_date, sequence, resource_owner, tls, oauth2) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								nil,
+								nil,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetAdded, wasm",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.AddedEventType,
+						target.AggregateType,
+						[]byte(`{"name": "name", "targetType":3, "timeout": 3000000000, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "module": "AGFzbQEAAAA="}`),
+					),
+					eventstore.GenericEventMapper[target.AddedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.targets2 (instance_id, resource_owner, id, creation_date, change_date, sequence, name, endpoint, target_type, timeout, interrupt_on_error, signing_key, module) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"name",
+								"",
+								domain.TargetTypeWASM,
+								3 * time.Second,
+								false,
+								anyArg{},
+								[]byte("\x00asm\x01\x00\x00\x00"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetChanged, module",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.ChangedEventType,
+						target.AggregateType,
+						[]byte(`{"targetType":3, "module": "AGFzbQEAAAA="}`),
+					),
+					eventstore.GenericEventMapper[target.ChangedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.targets2 SET (change_date, sequence, resource_owner, target_type, module) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								domain.TargetTypeWASM,
+								[]byte("\x00asm\x01\x00\x00\x00"),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceTargetRemoved",
 			args: args{
@@ -36,6 +36,9 @@ const (
 	HumanUserInstanceIDCol      = "instance_id"
 	HumanPasswordChangeRequired = "password_change_required"
 	HumanPasswordChanged        = "password_changed"
+	// HumanPasswordExpiryNotified is the latest threshold of the password age policy the user was notified about
+	// for the current password
+	HumanPasswordExpiryNotified = "password_expiry_notified"
 
 	// profile
 	HumanFirstNameCol         = "first_name"
@@ -118,6 +121,7 @@ func (*userProjection) Init() *old_handler.Check {
 			handler.NewColumn(HumanIsPhoneVerifiedCol, handler.ColumnTypeBool, handler.Nullable()),
 			handler.NewColumn(HumanPasswordChangeRequired, handler.ColumnTypeBool),
 			handler.NewColumn(HumanPasswordChanged, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(HumanPasswordExpiryNotified, handler.ColumnTypeEnum, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(HumanUserInstanceIDCol, HumanUserIDCol),
 			UserHumanSuffix,
@@ -284,6 +288,10 @@ func (p *userProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: p.reduceHumanPasswordChanged,
 				},
+				{
+					Event:  user.HumanPasswordExpiryNotificationAddedType,
+					Reduce: p.reduceHumanPasswordExpiryNotificationAdded,
+				},
 				{
 					Event:  user.MachineSecretSetType,
 					Reduce: p.reduceMachineSecretSet,
@@ -925,6 +933,7 @@ func (p *userProjection) reduceHumanPasswordChanged(event eventstore.Event) (*ha
 			[]handler.Column{
 				handler.NewCol(HumanPasswordChangeRequired, e.ChangeRequired),
 				handler.NewCol(HumanPasswordChanged, &sql.NullTime{Time: e.CreatedAt(), Valid: true}),
+				handler.NewCol(HumanPasswordExpiryNotified, domain.PasswordExpiryThresholdUnspecified),
 			},
 			[]handler.Condition{
 				handler.NewCond(HumanUserIDCol, e.Aggregate().ID),
@@ -945,6 +954,24 @@ func (p *userProjection) reduceHumanPasswordChanged(event eventstore.Event) (*ha
 	), nil
 }
 
+func (p *userProjection) reduceHumanPasswordExpiryNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanPasswordExpiryNotificationAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(HumanPasswordExpiryNotified, e.Threshold),
+		},
+		[]handler.Condition{
+			handler.NewCond(HumanUserIDCol, e.Aggregate().ID),
+			handler.NewCond(HumanUserInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(UserHumanSuffix),
+	), nil
+}
+
 func (p *userProjection) reduceMachineSecretSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.MachineSecretSetEvent)
 	if !ok {
@@ -0,0 +1,235 @@
+package projection
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserActivityProjectionTable = "projections.user_activities"
+
+	UserActivityColumnInstanceID     = "instance_id"
+	UserActivityColumnUserID         = "user_id"
+	UserActivityColumnResourceOwner  = "resource_owner"
+	UserActivityColumnChangeDate     = "change_date"
+	UserActivityColumnSequence       = "sequence"
+	UserActivityColumnLastActivity   = "last_activity"
+	UserActivityColumnNotifiedAction = "notified_action"
+)
+
+// userActivityProjection keeps track of the last sign-in or token usage of each user,
+// which is evaluated against the inactivity policy.
+type userActivityProjection struct{}
+
+func newUserActivityProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userActivityProjection))
+}
+
+func (*userActivityProjection) Name() string {
+	return UserActivityProjectionTable
+}
+
+func (*userActivityProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserActivityColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserActivityColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserActivityColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserActivityColumnLastActivity, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserActivityColumnNotifiedAction, handler.ColumnTypeEnum, handler.Default(0)),
+		},
+			handler.NewPrimaryKey(UserActivityColumnInstanceID, UserActivityColumnUserID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserActivityColumnResourceOwner})),
+			handler.WithIndex(handler.NewIndex("last_activity", []string{UserActivityColumnLastActivity})),
+		),
+	)
+}
+
+func (p *userActivityProjection) Reducers() []handler.AggregateReducer {
+	userReducers := []handler.EventReducer{
+		{
+			Event:  user.UserV1AddedType,
+			Reduce: p.reduceUserAdded,
+		},
+		{
+			Event:  user.HumanAddedType,
+			Reduce: p.reduceUserAdded,
+		},
+		{
+			Event:  user.UserV1RegisteredType,
+			Reduce: p.reduceUserAdded,
+		},
+		{
+			Event:  user.HumanRegisteredType,
+			Reduce: p.reduceUserAdded,
+		},
+		{
+			Event:  user.MachineAddedEventType,
+			Reduce: p.reduceUserAdded,
+		},
+		{
+			Event:  user.InactivityNotificationAddedType,
+			Reduce: p.reduceNotificationAdded,
+		},
+		{
+			Event:  user.UserRemovedType,
+			Reduce: p.reduceUserRemoved,
+		},
+	}
+	for _, eventType := range user.ActivityEventTypes {
+		userReducers = append(userReducers, handler.EventReducer{
+			Event:  eventType,
+			Reduce: p.reduceActivity,
+		})
+	}
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     user.AggregateType,
+			EventReducers: userReducers,
+		},
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.AddedType,
+					Reduce: p.reduceOIDCSessionAdded,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserActivityColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userActivityProjection) reduceUserAdded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ua1aAa", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanAddedType, user.HumanRegisteredType, user.MachineAddedEventType})
+	}
+	return handler.NewCreateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCol(UserActivityColumnUserID, event.Aggregate().ID),
+			handler.NewCol(UserActivityColumnResourceOwner, event.Aggregate().ResourceOwner),
+			handler.NewCol(UserActivityColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(UserActivityColumnSequence, event.Sequence()),
+			handler.NewCol(UserActivityColumnLastActivity, event.CreatedAt()),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceActivity(event eventstore.Event) (*handler.Statement, error) {
+	if !slices.Contains(user.ActivityEventTypes, event.Type()) {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ua1bBb", "reduce.wrong.event.type %v", user.ActivityEventTypes)
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(UserActivityColumnSequence, event.Sequence()),
+			handler.NewCol(UserActivityColumnLastActivity, event.CreatedAt()),
+			handler.NewCol(UserActivityColumnNotifiedAction, domain.InactivityActionUnspecified),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnUserID, event.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceOIDCSessionAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*oidcsession.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserActivityColumnSequence, e.Sequence()),
+			handler.NewCol(UserActivityColumnLastActivity, e.CreatedAt()),
+			handler.NewCol(UserActivityColumnNotifiedAction, domain.InactivityActionUnspecified),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnUserID, e.UserID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceNotificationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.InactivityNotificationAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserActivityColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserActivityColumnSequence, e.Sequence()),
+			handler.NewCol(UserActivityColumnNotifiedAction, e.Action),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userActivityProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserActivityColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserActivityColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,228 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserActivityProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceUserAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.MachineAddedEventType,
+						user.AggregateType,
+						[]byte(`{"userName": "machine"}`),
+					),
+					user.MachineAddedEventMapper,
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceUserAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_activities (instance_id, user_id, resource_owner, change_date, sequence, last_activity) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceActivity",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordCheckSucceededType,
+						user.AggregateType,
+						nil,
+					),
+					user.HumanPasswordCheckSucceededEventMapper,
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceActivity,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_activity, notified_action) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (user_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								domain.InactivityActionUnspecified,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOIDCSessionAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						oidcsession.AddedType,
+						oidcsession.AggregateType,
+						[]byte(`{"userID": "user-id", "sessionID": "session-id"}`),
+					),
+					eventstore.GenericEventMapper[oidcsession.AddedEvent],
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceOIDCSessionAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("oidc_session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, last_activity, notified_action) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (user_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								domain.InactivityActionUnspecified,
+								"instance-id",
+								"user-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceNotificationAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.InactivityNotificationAddedType,
+						user.AggregateType,
+						[]byte(`{"action": 1, "lastActivity": "2024-01-01T00:00:00Z", "dueDate": "2024-03-31T00:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[user.InactivityNotificationAddedEvent],
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceNotificationAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_activities SET (change_date, sequence, notified_action) = ($1, $2, $3) WHERE (instance_id = $4) AND (user_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.InactivityActionDeactivate,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&userActivityProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_activities WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserActivityProjectionTable, tt.want)
+		})
+	}
+}
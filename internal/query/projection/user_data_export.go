@@ -0,0 +1,179 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	UserDataExportProjectionTable = "projections.user_data_exports"
+
+	UserDataExportColumnID            = "id"
+	UserDataExportColumnInstanceID    = "instance_id"
+	UserDataExportColumnUserID        = "user_id"
+	UserDataExportColumnResourceOwner = "resource_owner"
+	UserDataExportColumnCreationDate  = "creation_date"
+	UserDataExportColumnChangeDate    = "change_date"
+	UserDataExportColumnSequence      = "sequence"
+	UserDataExportColumnState         = "state"
+)
+
+// userDataExportProjection keeps track of the exports of their data requested by the users.
+type userDataExportProjection struct{}
+
+func newUserDataExportProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userDataExportProjection))
+}
+
+func (*userDataExportProjection) Name() string {
+	return UserDataExportProjectionTable
+}
+
+func (*userDataExportProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserDataExportColumnID, handler.ColumnTypeText),
+			handler.NewColumn(UserDataExportColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserDataExportColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserDataExportColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserDataExportColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserDataExportColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserDataExportColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserDataExportColumnState, handler.ColumnTypeEnum),
+		},
+			handler.NewPrimaryKey(UserDataExportColumnInstanceID, UserDataExportColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{UserDataExportColumnUserID})),
+			handler.WithIndex(handler.NewIndex("state", []string{UserDataExportColumnState})),
+		),
+	)
+}
+
+func (p *userDataExportProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.DataExportRequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  user.DataExportCompletedType,
+					Reduce: p.reduceCompleted,
+				},
+				{
+					Event:  user.DataExportDownloadedType,
+					Reduce: p.reduceDownloaded,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserDataExportColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userDataExportProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.DataExportRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserDataExportColumnID, e.ExportID),
+			handler.NewCol(UserDataExportColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserDataExportColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserDataExportColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserDataExportColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(UserDataExportColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserDataExportColumnSequence, e.Sequence()),
+			handler.NewCol(UserDataExportColumnState, domain.UserDataExportStateRequested),
+		},
+	), nil
+}
+
+func (p *userDataExportProjection) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.DataExportCompletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, e.ExportID, domain.UserDataExportStateReady), nil
+}
+
+func (p *userDataExportProjection) reduceDownloaded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.DataExportDownloadedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, e.ExportID, domain.UserDataExportStateDownloaded), nil
+}
+
+func (p *userDataExportProjection) updateState(event eventstore.Event, exportID string, state domain.UserDataExportState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserDataExportColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(UserDataExportColumnSequence, event.Sequence()),
+			handler.NewCol(UserDataExportColumnState, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserDataExportColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(UserDataExportColumnID, exportID),
+		},
+	)
+}
+
+func (p *userDataExportProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDataExportColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDataExportColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userDataExportProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDataExportColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDataExportColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
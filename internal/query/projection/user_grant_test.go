@@ -2,7 +2,9 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
@@ -336,6 +338,64 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceValiditySet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantValiditySetType,
+						usergrant.AggregateType,
+						[]byte(`{"validFrom": "2029-01-01T00:00:00Z", "validUntil": "2030-01-01T00:00:00Z"}`),
+					), eventstore.GenericEventMapper[usergrant.UserGrantValiditySetEvent]),
+			},
+			reduce: (&userGrantProjection{}).reduceValiditySet,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (change_date, valid_from, valid_until, sequence) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								&sql.NullTime{Time: time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								&sql.NullTime{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceExpired",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantExpiredType,
+						usergrant.AggregateType,
+						[]byte(`{"validUntil": "2030-01-01T00:00:00Z"}`),
+					), eventstore.GenericEventMapper[usergrant.UserGrantExpiredEvent]),
+			},
+			reduce: (&userGrantProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_grants5 WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceInstanceRemoved",
 			args: args{
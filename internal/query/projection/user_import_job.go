@@ -0,0 +1,268 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+)
+
+const (
+	UserImportJobProjectionTable = "projections.user_import_jobs"
+	UserImportJobErrorTable      = UserImportJobProjectionTable + "_" + UserImportJobErrorSuffix
+
+	UserImportJobColumnID            = "id"
+	UserImportJobColumnInstanceID    = "instance_id"
+	UserImportJobColumnResourceOwner = "resource_owner"
+	UserImportJobColumnCreationDate  = "creation_date"
+	UserImportJobColumnChangeDate    = "change_date"
+	UserImportJobColumnSequence      = "sequence"
+	UserImportJobColumnState         = "state"
+	UserImportJobColumnCreator       = "creator"
+	UserImportJobColumnFormat        = "format"
+	UserImportJobColumnDryRun        = "dry_run"
+	UserImportJobColumnTotalRows     = "total_rows"
+	UserImportJobColumnProcessedRows = "processed_rows"
+	UserImportJobColumnFailedRows    = "failed_rows"
+	UserImportJobColumnFailureReason = "failure_reason"
+
+	UserImportJobErrorSuffix           = "errors"
+	UserImportJobErrorColumnInstanceID = "instance_id"
+	UserImportJobErrorColumnJobID      = "job_id"
+	UserImportJobErrorColumnRow        = "row"
+	UserImportJobErrorColumnUserID     = "user_id"
+	UserImportJobErrorColumnMessage    = "message"
+)
+
+type userImportJobProjection struct{}
+
+func newUserImportJobProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userImportJobProjection))
+}
+
+func (*userImportJobProjection) Name() string {
+	return UserImportJobProjectionTable
+}
+
+func (*userImportJobProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserImportJobColumnID, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserImportJobColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserImportJobColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserImportJobColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(UserImportJobColumnCreator, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobColumnFormat, handler.ColumnTypeEnum),
+			handler.NewColumn(UserImportJobColumnDryRun, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(UserImportJobColumnTotalRows, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(UserImportJobColumnProcessedRows, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(UserImportJobColumnFailedRows, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(UserImportJobColumnFailureReason, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(UserImportJobColumnInstanceID, UserImportJobColumnID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserImportJobColumnResourceOwner})),
+			handler.WithIndex(handler.NewIndex("state", []string{UserImportJobColumnState, UserImportJobColumnChangeDate})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(UserImportJobErrorColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobErrorColumnJobID, handler.ColumnTypeText),
+			handler.NewColumn(UserImportJobErrorColumnRow, handler.ColumnTypeInt64),
+			handler.NewColumn(UserImportJobErrorColumnUserID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserImportJobErrorColumnMessage, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(UserImportJobErrorColumnInstanceID, UserImportJobErrorColumnJobID, UserImportJobErrorColumnRow),
+			UserImportJobErrorSuffix,
+			// errors are removed together with their job
+			handler.WithForeignKey(handler.NewForeignKey("job", []string{UserImportJobErrorColumnInstanceID, UserImportJobErrorColumnJobID}, []string{UserImportJobColumnInstanceID, UserImportJobColumnID})),
+		),
+	)
+}
+
+func (p *userImportJobProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: userimport.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  userimport.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  userimport.StartedEventType,
+					Reduce: p.reduceStarted,
+				},
+				{
+					Event:  userimport.RowsProcessedEventType,
+					Reduce: p.reduceRowsProcessed,
+				},
+				{
+					Event:  userimport.CompletedEventType,
+					Reduce: p.reduceCompleted,
+				},
+				{
+					Event:  userimport.CanceledEventType,
+					Reduce: p.reduceCanceled,
+				},
+				{
+					Event:  userimport.FailedEventType,
+					Reduce: p.reduceFailed,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserImportJobColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userImportJobProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnID, e.Aggregate().ID),
+			handler.NewCol(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserImportJobColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserImportJobColumnCreationDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			handler.NewCol(UserImportJobColumnState, domain.UserImportJobStateQueued),
+			handler.NewCol(UserImportJobColumnCreator, e.Creator()),
+			handler.NewCol(UserImportJobColumnFormat, e.Format),
+			handler.NewCol(UserImportJobColumnDryRun, e.DryRun),
+		},
+	), nil
+}
+
+func (p *userImportJobProjection) reduceStarted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.StartedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.UserImportJobStateRunning), nil
+}
+
+func (p *userImportJobProjection) reduceRowsProcessed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.RowsProcessedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, len(e.Errors)+1)
+	stmts = append(stmts, handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			handler.NewCol(UserImportJobColumnProcessedRows, e.ProcessedRows),
+			handler.NewCol(UserImportJobColumnFailedRows, e.FailedRows),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserImportJobColumnID, e.Aggregate().ID),
+		},
+	))
+	for _, rowError := range e.Errors {
+		stmts = append(stmts, handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(UserImportJobErrorColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(UserImportJobErrorColumnJobID, e.Aggregate().ID),
+				handler.NewCol(UserImportJobErrorColumnRow, rowError.Row),
+				handler.NewCol(UserImportJobErrorColumnUserID, rowError.UserID),
+				handler.NewCol(UserImportJobErrorColumnMessage, rowError.Message),
+			},
+			handler.WithTableSuffix(UserImportJobErrorSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *userImportJobProjection) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.CompletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.UserImportJobStateCompleted, handler.NewCol(UserImportJobColumnTotalRows, e.TotalRows)), nil
+}
+
+func (p *userImportJobProjection) reduceCanceled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.CanceledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateStatement(e, domain.UserImportJobStateCanceled), nil
+}
+
+func (p *userImportJobProjection) reduceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*userimport.FailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserImportJobColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserImportJobColumnSequence, e.Sequence()),
+			handler.NewCol(UserImportJobColumnState, domain.UserImportJobStateFailed),
+			handler.NewCol(UserImportJobColumnFailureReason, e.Reason),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserImportJobColumnID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userImportJobProjection) stateStatement(event eventstore.Event, state domain.UserImportJobState, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		append([]handler.Column{
+			handler.NewCol(UserImportJobColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(UserImportJobColumnSequence, event.Sequence()),
+			handler.NewCol(UserImportJobColumnState, state),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(UserImportJobColumnID, event.Aggregate().ID),
+		},
+	)
+}
+
+func (p *userImportJobProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// errors are removed by the foreign key
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserImportJobColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserImportJobColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
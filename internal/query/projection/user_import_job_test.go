@@ -0,0 +1,276 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/userimport"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserImportJobProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.AddedEventType,
+						userimport.AggregateType,
+						[]byte(`{"format": 1, "dryRun": true}`),
+					),
+					eventstore.GenericEventMapper[userimport.AddedEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_import_jobs (id, instance_id, resource_owner, creation_date, change_date, sequence, state, creator, format, dry_run) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateQueued,
+								"editor-user",
+								domain.UserImportFormatCSV,
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceStarted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.StartedEventType,
+						userimport.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[userimport.StartedEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceStarted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateRunning,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRowsProcessed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.RowsProcessedEventType,
+						userimport.AggregateType,
+						[]byte(`{"processedRows": 100, "offset": 12345, "failedRows": 3, "errors": [{"row": 42, "userId": "user-id", "message": "Errors.User.AlreadyExists"}]}`),
+					),
+					eventstore.GenericEventMapper[userimport.RowsProcessedEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceRowsProcessed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, processed_rows, failed_rows) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint64(100),
+								uint64(3),
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.user_import_jobs_errors (instance_id, job_id, row, user_id, message) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								uint64(42),
+								"user-id",
+								"Errors.User.AlreadyExists",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCompleted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.CompletedEventType,
+						userimport.AggregateType,
+						[]byte(`{"totalRows": 50000}`),
+					),
+					eventstore.GenericEventMapper[userimport.CompletedEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceCompleted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, state, total_rows) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateCompleted,
+								uint64(50000),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCanceled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.CanceledEventType,
+						userimport.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[userimport.CanceledEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceCanceled,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateCanceled,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						userimport.FailedEventType,
+						userimport.AggregateType,
+						[]byte(`{"reason": "Errors.Assets.Object.GetFailed"}`),
+					),
+					eventstore.GenericEventMapper[userimport.FailedEvent],
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user_import"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_import_jobs SET (change_date, sequence, state, failure_reason) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.UserImportJobStateFailed,
+								"Errors.Assets.Object.GetFailed",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&userImportJobProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_import_jobs WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserImportJobProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserScheduledDeletionProjectionTable = "projections.user_scheduled_deletions"
+
+	UserScheduledDeletionColumnInstanceID    = "instance_id"
+	UserScheduledDeletionColumnUserID        = "user_id"
+	UserScheduledDeletionColumnResourceOwner = "resource_owner"
+	UserScheduledDeletionColumnCreationDate  = "creation_date"
+	UserScheduledDeletionColumnChangeDate    = "change_date"
+	UserScheduledDeletionColumnSequence      = "sequence"
+	UserScheduledDeletionColumnDeletionDate  = "deletion_date"
+	UserScheduledDeletionColumnReminded      = "reminded"
+)
+
+// userScheduledDeletionProjection keeps track of the users, which requested the deletion of their account.
+// The row is removed as soon as the deletion is canceled or executed.
+type userScheduledDeletionProjection struct{}
+
+func newUserScheduledDeletionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userScheduledDeletionProjection))
+}
+
+func (*userScheduledDeletionProjection) Name() string {
+	return UserScheduledDeletionProjectionTable
+}
+
+func (*userScheduledDeletionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserScheduledDeletionColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserScheduledDeletionColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserScheduledDeletionColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserScheduledDeletionColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserScheduledDeletionColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserScheduledDeletionColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserScheduledDeletionColumnDeletionDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserScheduledDeletionColumnReminded, handler.ColumnTypeBool, handler.Default(false)),
+		},
+			handler.NewPrimaryKey(UserScheduledDeletionColumnInstanceID, UserScheduledDeletionColumnUserID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserScheduledDeletionColumnResourceOwner})),
+			handler.WithIndex(handler.NewIndex("deletion_date", []string{UserScheduledDeletionColumnDeletionDate})),
+		),
+	)
+}
+
+func (p *userScheduledDeletionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.DeletionScheduledType,
+					Reduce: p.reduceScheduled,
+				},
+				{
+					Event:  user.DeletionReminderAddedType,
+					Reduce: p.reduceReminderAdded,
+				},
+				{
+					Event:  user.DeletionCanceledType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserScheduledDeletionColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userScheduledDeletionProjection) reduceScheduled(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.DeletionScheduledEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserScheduledDeletionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserScheduledDeletionColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserScheduledDeletionColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserScheduledDeletionColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(UserScheduledDeletionColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserScheduledDeletionColumnSequence, e.Sequence()),
+			handler.NewCol(UserScheduledDeletionColumnDeletionDate, e.DeletionDate()),
+		},
+	), nil
+}
+
+func (p *userScheduledDeletionProjection) reduceReminderAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.DeletionReminderAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserScheduledDeletionColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserScheduledDeletionColumnSequence, e.Sequence()),
+			handler.NewCol(UserScheduledDeletionColumnReminded, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserScheduledDeletionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserScheduledDeletionColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userScheduledDeletionProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.DeletionCanceledEvent, *user.UserRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ud1aAa", "reduce.wrong.event.type %v", []eventstore.EventType{user.DeletionCanceledType, user.UserRemovedType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(UserScheduledDeletionColumnInstanceID, event.Aggregate().InstanceID),
+			handler.NewCond(UserScheduledDeletionColumnUserID, event.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userScheduledDeletionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserScheduledDeletionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserScheduledDeletionColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
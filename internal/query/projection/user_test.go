@@ -1428,10 +1428,11 @@ func TestUserProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.users13_humans SET (password_change_required, password_changed) = ($1, $2) WHERE (user_id = $3) AND (instance_id = $4)",
+							expectedStmt: "UPDATE projections.users13_humans SET (password_change_required, password_changed, password_expiry_notified) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
 							expectedArgs: []interface{}{
 								true,
 								&sql.NullTime{Time: testNow, Valid: true},
+								domain.PasswordExpiryThresholdUnspecified,
 								"agg-id",
 								"instance-id",
 							},
@@ -1468,10 +1469,11 @@ func TestUserProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.users13_humans SET (password_change_required, password_changed) = ($1, $2) WHERE (user_id = $3) AND (instance_id = $4)",
+							expectedStmt: "UPDATE projections.users13_humans SET (password_change_required, password_changed, password_expiry_notified) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
 							expectedArgs: []interface{}{
 								false,
 								&sql.NullTime{Time: testNow, Valid: true},
+								domain.PasswordExpiryThresholdUnspecified,
 								"agg-id",
 								"instance-id",
 							},
@@ -1488,6 +1490,36 @@ func TestUserProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceHumanPasswordExpiryNotificationAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordExpiryNotificationAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"threshold": 2
+					}`),
+					), eventstore.GenericEventMapper[user.HumanPasswordExpiryNotificationAddedEvent]),
+			},
+			reduce: (&userProjection{}).reduceHumanPasswordExpiryNotificationAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users13_humans SET password_expiry_notified = $1 WHERE (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								domain.PasswordExpiryThresholdExpired,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceMachineAddedEvent no description",
 			args: args{
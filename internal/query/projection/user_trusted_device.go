@@ -0,0 +1,195 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	UserTrustedDeviceProjectionTable = "projections.user_trusted_devices"
+
+	UserTrustedDeviceColumnInstanceID    = "instance_id"
+	UserTrustedDeviceColumnUserID        = "user_id"
+	UserTrustedDeviceColumnDeviceID      = "device_id"
+	UserTrustedDeviceColumnResourceOwner = "resource_owner"
+	UserTrustedDeviceColumnCreationDate  = "creation_date"
+	UserTrustedDeviceColumnChangeDate    = "change_date"
+	UserTrustedDeviceColumnSequence      = "sequence"
+	UserTrustedDeviceColumnTrustedAt     = "trusted_at"
+	UserTrustedDeviceColumnLastSeen      = "last_seen"
+	UserTrustedDeviceColumnFingerprint   = "fingerprint"
+	UserTrustedDeviceColumnIP            = "ip"
+)
+
+type userTrustedDeviceProjection struct{}
+
+func newUserTrustedDeviceProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userTrustedDeviceProjection))
+}
+
+func (*userTrustedDeviceProjection) Name() string {
+	return UserTrustedDeviceProjectionTable
+}
+
+func (*userTrustedDeviceProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserTrustedDeviceColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnDeviceID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserTrustedDeviceColumnTrustedAt, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnLastSeen, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnFingerprint, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserTrustedDeviceColumnIP, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(UserTrustedDeviceColumnInstanceID, UserTrustedDeviceColumnUserID, UserTrustedDeviceColumnDeviceID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserTrustedDeviceColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userTrustedDeviceProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanTrustedDeviceAddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  user.HumanTrustedDeviceSeenType,
+					Reduce: p.reduceSeen,
+				},
+				{
+					Event:  user.HumanTrustedDeviceRemovedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserTrustedDeviceColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userTrustedDeviceProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, nil),
+			handler.NewCol(UserTrustedDeviceColumnUserID, nil),
+			handler.NewCol(UserTrustedDeviceColumnDeviceID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserTrustedDeviceColumnDeviceID, e.DeviceID),
+			handler.NewCol(UserTrustedDeviceColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserTrustedDeviceColumnCreationDate, handler.OnlySetValueOnInsert(UserTrustedDeviceProjectionTable, e.CreationDate())),
+			handler.NewCol(UserTrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(UserTrustedDeviceColumnTrustedAt, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnLastSeen, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnFingerprint, e.Fingerprint),
+			handler.NewCol(UserTrustedDeviceColumnIP, e.IP),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceSeen(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceSeenEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(UserTrustedDeviceColumnLastSeen, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnIP, e.IP),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserTrustedDeviceColumnDeviceID, e.DeviceID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserTrustedDeviceColumnDeviceID, e.DeviceID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,197 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserTrustedDeviceProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceAddedType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "device-id", "fingerprint": "Firefox, Linux", "ip": "127.0.0.1"}`),
+					),
+					eventstore.GenericEventMapper[user.HumanTrustedDeviceAddedEvent],
+				),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_trusted_devices (instance_id, user_id, device_id, resource_owner, creation_date, change_date, sequence, trusted_at, last_seen, fingerprint, ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, user_id, device_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, trusted_at, last_seen, fingerprint, ip) = (EXCLUDED.resource_owner, projections.user_trusted_devices.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.trusted_at, EXCLUDED.last_seen, EXCLUDED.fingerprint, EXCLUDED.ip)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"device-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								anyArg{},
+								"Firefox, Linux",
+								"127.0.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSeen",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceSeenType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "device-id", "ip": "127.0.0.2"}`),
+					),
+					eventstore.GenericEventMapper[user.HumanTrustedDeviceSeenEvent],
+				),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceSeen,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_trusted_devices SET (change_date, sequence, last_seen, ip) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (user_id = $6) AND (device_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								"127.0.0.2",
+								"instance-id",
+								"agg-id",
+								"device-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceRemovedType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "device-id"}`),
+					),
+					eventstore.GenericEventMapper[user.HumanTrustedDeviceRemovedEvent],
+				),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (user_id = $2) AND (device_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"device-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserTrustedDeviceProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type RelationSchema struct {
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	Schema        *domain.RelationSchema
+}
+
+type RelationTuples struct {
+	SearchResponse
+	Tuples []*RelationTuple
+}
+
+type RelationObjects struct {
+	SearchResponse
+	ObjectIDs []string
+}
+
+type RelationTuple struct {
+	domain.RelationTuple
+
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	Sequence      uint64
+}
+
+type RelationTupleSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	relationSchemaTable = table{
+		name:          projection.RelationProjectionTable,
+		instanceIDCol: projection.RelationSchemaColumnInstanceID,
+	}
+	RelationSchemaColumnInstanceID = Column{
+		name:  projection.RelationSchemaColumnInstanceID,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnResourceOwner = Column{
+		name:  projection.RelationSchemaColumnResourceOwner,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnProjectID = Column{
+		name:  projection.RelationSchemaColumnProjectID,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnCreationDate = Column{
+		name:  projection.RelationSchemaColumnCreationDate,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnChangeDate = Column{
+		name:  projection.RelationSchemaColumnChangeDate,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnSequence = Column{
+		name:  projection.RelationSchemaColumnSequence,
+		table: relationSchemaTable,
+	}
+	RelationSchemaColumnSchema = Column{
+		name:  projection.RelationSchemaColumnSchema,
+		table: relationSchemaTable,
+	}
+
+	relationTupleTable = table{
+		name:          projection.RelationProjectionTable + "_" + projection.RelationTupleSuffix,
+		instanceIDCol: projection.RelationTupleColumnInstanceID,
+	}
+	RelationTupleColumnInstanceID = Column{
+		name:  projection.RelationTupleColumnInstanceID,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnResourceOwner = Column{
+		name:  projection.RelationTupleColumnResourceOwner,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnProjectID = Column{
+		name:  projection.RelationTupleColumnProjectID,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnObjectType = Column{
+		name:  projection.RelationTupleColumnObjectType,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnObjectID = Column{
+		name:  projection.RelationTupleColumnObjectID,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnRelation = Column{
+		name:  projection.RelationTupleColumnRelation,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnSubjectType = Column{
+		name:  projection.RelationTupleColumnSubjectType,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnSubjectID = Column{
+		name:  projection.RelationTupleColumnSubjectID,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnSubjectRelation = Column{
+		name:  projection.RelationTupleColumnSubjectRelation,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnCreationDate = Column{
+		name:  projection.RelationTupleColumnCreationDate,
+		table: relationTupleTable,
+	}
+	RelationTupleColumnSequence = Column{
+		name:  projection.RelationTupleColumnSequence,
+		table: relationTupleTable,
+	}
+)
+
+// RelationSchemaByProjectID returns the relation schema of the project
+func (q *Queries) RelationSchemaByProjectID(ctx context.Context, projectID string) (schema *RelationSchema, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareRelationSchemaQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		RelationSchemaColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		RelationSchemaColumnProjectID.identifier():  projectID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl8aXc", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		schema, err = scan(row)
+		return err
+	}, stmt, args...)
+	return schema, err
+}
+
+// SearchRelationTuples returns the tuples of the project matching the queries
+func (q *Queries) SearchRelationTuples(ctx context.Context, projectID string, queries *RelationTupleSearchQueries) (tuples *RelationTuples, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareRelationTuplesQuery(ctx, q.client)
+	eq := sq.Eq{
+		RelationTupleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		RelationTupleColumnProjectID.identifier():  projectID,
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl8bVb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		tuples, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	tuples.State, err = q.latestState(ctx, relationSchemaTable)
+	return tuples, err
+}
+
+// ensureRelationConsistency triggers the projections if the requested consistency is not yet reached
+func (q *Queries) ensureRelationConsistency(ctx context.Context, consistency *domain.RelationConsistency) (_ context.Context, err error) {
+	if consistency == nil {
+		return ctx, nil
+	}
+	if consistency.FullyConsistent {
+		ctx, err = projection.RelationProjection.Trigger(ctx, handler.WithAwaitRunning())
+		if err != nil {
+			return ctx, err
+		}
+		ctx, err = projection.UserGrantProjection.Trigger(ctx, handler.WithAwaitRunning())
+		return ctx, err
+	}
+	if consistency.AtLeastAsFresh == "" {
+		return ctx, nil
+	}
+	position, err := domain.ParseRelationConsistencyToken(consistency.AtLeastAsFresh)
+	if err != nil {
+		return ctx, err
+	}
+	state, err := q.latestState(ctx, relationSchemaTable)
+	if err != nil {
+		return ctx, err
+	}
+	if state.Position >= position {
+		return ctx, nil
+	}
+	_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerRelationProjection")
+	ctx, err = projection.RelationProjection.Trigger(ctx, handler.WithAwaitRunning())
+	logging.OnError(err).Debug("trigger failed")
+	traceSpan.EndWithError(err)
+	return ctx, err
+}
+
+func (q *RelationTupleSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewRelationTupleObjectTypeSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(RelationTupleColumnObjectType, value, TextEquals)
+}
+
+func NewRelationTupleObjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(RelationTupleColumnObjectID, value, TextEquals)
+}
+
+func NewRelationTupleRelationSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(RelationTupleColumnRelation, value, TextEquals)
+}
+
+func NewRelationTupleSubjectTypeSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(RelationTupleColumnSubjectType, value, TextEquals)
+}
+
+func NewRelationTupleSubjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(RelationTupleColumnSubjectID, value, TextEquals)
+}
+
+func prepareRelationSchemaQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*RelationSchema, error)) {
+	return sq.Select(
+			RelationSchemaColumnProjectID.identifier(),
+			RelationSchemaColumnResourceOwner.identifier(),
+			RelationSchemaColumnCreationDate.identifier(),
+			RelationSchemaColumnChangeDate.identifier(),
+			RelationSchemaColumnSequence.identifier(),
+			RelationSchemaColumnSchema.identifier(),
+		).
+			From(relationSchemaTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*RelationSchema, error) {
+			schema := new(RelationSchema)
+			var data []byte
+			err := row.Scan(
+				&schema.ProjectID,
+				&schema.ResourceOwner,
+				&schema.CreationDate,
+				&schema.ChangeDate,
+				&schema.Sequence,
+				&data,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Rl8cNm", "Errors.Relation.SchemaNotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8dQw", "Errors.Internal")
+			}
+			if err := json.Unmarshal(data, &schema.Schema); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8eEr", "Errors.Internal")
+			}
+			return schema, nil
+		}
+}
+
+func prepareRelationTuplesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*RelationTuples, error)) {
+	return sq.Select(
+			RelationTupleColumnProjectID.identifier(),
+			RelationTupleColumnResourceOwner.identifier(),
+			RelationTupleColumnCreationDate.identifier(),
+			RelationTupleColumnSequence.identifier(),
+			RelationTupleColumnObjectType.identifier(),
+			RelationTupleColumnObjectID.identifier(),
+			RelationTupleColumnRelation.identifier(),
+			RelationTupleColumnSubjectType.identifier(),
+			RelationTupleColumnSubjectID.identifier(),
+			RelationTupleColumnSubjectRelation.identifier(),
+			countColumn.identifier(),
+		).
+			From(relationTupleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*RelationTuples, error) {
+			tuples := make([]*RelationTuple, 0)
+			var count uint64
+			for rows.Next() {
+				tuple := new(RelationTuple)
+				err := rows.Scan(
+					&tuple.ProjectID,
+					&tuple.ResourceOwner,
+					&tuple.CreationDate,
+					&tuple.Sequence,
+					&tuple.ObjectType,
+					&tuple.ObjectID,
+					&tuple.Relation,
+					&tuple.Subject.Type,
+					&tuple.Subject.ID,
+					&tuple.Subject.Relation,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				tuples = append(tuples, tuple)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8fRt", "Errors.Query.CloseRows")
+			}
+
+			return &RelationTuples{
+				Tuples: tuples,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareRelationSubjectsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]domain.RelationSubject, error)) {
+	return sq.Select(
+			RelationTupleColumnSubjectType.identifier(),
+			RelationTupleColumnSubjectID.identifier(),
+			RelationTupleColumnSubjectRelation.identifier(),
+		).
+			From(relationTupleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]domain.RelationSubject, error) {
+			subjects := make([]domain.RelationSubject, 0)
+			for rows.Next() {
+				var subject domain.RelationSubject
+				if err := rows.Scan(&subject.Type, &subject.ID, &subject.Relation); err != nil {
+					return nil, err
+				}
+				subjects = append(subjects, subject)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8gTz", "Errors.Query.CloseRows")
+			}
+			return subjects, nil
+		}
+}
+
+func prepareRelationObjectsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*domain.RelationTuple, error)) {
+	return sq.Select(
+			RelationTupleColumnObjectType.identifier(),
+			RelationTupleColumnObjectID.identifier(),
+			RelationTupleColumnRelation.identifier(),
+			RelationTupleColumnSubjectType.identifier(),
+			RelationTupleColumnSubjectID.identifier(),
+			RelationTupleColumnSubjectRelation.identifier(),
+		).
+			From(relationTupleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*domain.RelationTuple, error) {
+			tuples := make([]*domain.RelationTuple, 0)
+			for rows.Next() {
+				tuple := new(domain.RelationTuple)
+				err := rows.Scan(
+					&tuple.ObjectType,
+					&tuple.ObjectID,
+					&tuple.Relation,
+					&tuple.Subject.Type,
+					&tuple.Subject.ID,
+					&tuple.Subject.Relation,
+				)
+				if err != nil {
+					return nil, err
+				}
+				tuples = append(tuples, tuple)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8jOp", "Errors.Query.CloseRows")
+			}
+			return tuples, nil
+		}
+}
+
+func prepareRelationObjectIDsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*RelationObjects, error)) {
+	return sq.Select(
+			RelationTupleColumnObjectID.identifier(),
+			countColumn.identifier(),
+		).
+			From(relationTupleTable.identifier() + db.Timetravel(call.Took(ctx))).
+			GroupBy(RelationTupleColumnObjectID.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*RelationObjects, error) {
+			ids := make([]string, 0)
+			var count uint64
+			for rows.Next() {
+				var id string
+				if err := rows.Scan(&id, &count); err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8hZu", "Errors.Query.CloseRows")
+			}
+			return &RelationObjects{
+				ObjectIDs: ids,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareRelationUserRolesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]string, error)) {
+	return sq.Select(
+			UserGrantRoles.identifier(),
+		).
+			From(userGrantTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]string, error) {
+			roles := make([]string, 0)
+			for rows.Next() {
+				var grantRoles database.TextArray[string]
+				if err := rows.Scan(&grantRoles); err != nil {
+					return nil, err
+				}
+				roles = append(roles, grantRoles...)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rl8iUi", "Errors.Query.CloseRows")
+			}
+			return roles, nil
+		}
+}
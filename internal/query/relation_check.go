@@ -0,0 +1,609 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RelationTree is the expansion of a relation or permission of an object.
+// Subjects are the users and Roles the project roles granting it directly,
+// Children the expansions of usersets, traversals and referenced relations.
+type RelationTree struct {
+	Object   domain.RelationSubject
+	Subjects []domain.RelationSubject
+	Roles    []string
+	Children []*RelationTree
+}
+
+// CheckRelation returns if the user has the relation or permission to the object
+// as defined by the relation schema of the project.
+func (q *Queries) CheckRelation(ctx context.Context, projectID, objectType, objectID, permission, userID string, consistency *domain.RelationConsistency) (allowed bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	graph, err := q.relationGraph(ctx, projectID, consistency)
+	if err != nil {
+		return false, err
+	}
+	return graph.check(ctx, objectType, objectID, permission, userID)
+}
+
+// ListRelationObjects returns the ids of the objects of the type the user has the relation or permission to.
+// The relations are walked backwards from the user, so only the objects reachable by the user are evaluated.
+func (q *Queries) ListRelationObjects(ctx context.Context, projectID, objectType, permission, userID string, search *SearchRequest, consistency *domain.RelationConsistency) (_ *RelationObjects, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	graph, err := q.relationGraph(ctx, projectID, consistency)
+	if err != nil {
+		return nil, err
+	}
+	objectDefinition := graph.schema.ObjectType(objectType)
+	if objectDefinition == nil || (objectDefinition.Relation(permission) == nil && objectDefinition.Permission(permission) == nil) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Rl9aWs", "Errors.Relation.NotAllowed")
+	}
+	search = relationObjectsSearchRequest(search)
+	objectIDs, all, err := graph.listObjects(ctx, objectType, permission, userID)
+	if err != nil {
+		return nil, err
+	}
+	// the permission is granted on every object of the type through a project role
+	if all {
+		return q.relationObjectIDs(ctx, projectID, objectType, search)
+	}
+	return pageRelationObjectIDs(objectIDs, search), nil
+}
+
+func relationObjectsSearchRequest(search *SearchRequest) *SearchRequest {
+	request := SearchRequest{
+		SortingColumn: RelationTupleColumnObjectID,
+		Limit:         domain.RelationMaxListLimit,
+	}
+	if search != nil {
+		request.Offset = search.Offset
+		request.Asc = search.Asc
+		if search.Limit > 0 && search.Limit < domain.RelationMaxListLimit {
+			request.Limit = search.Limit
+		}
+	}
+	return &request
+}
+
+func pageRelationObjectIDs(objectIDs []string, search *SearchRequest) *RelationObjects {
+	slices.Sort(objectIDs)
+	if !search.Asc {
+		slices.Reverse(objectIDs)
+	}
+	objects := &RelationObjects{
+		SearchResponse: SearchResponse{
+			Count: uint64(len(objectIDs)),
+		},
+		ObjectIDs: []string{},
+	}
+	if search.Offset >= uint64(len(objectIDs)) {
+		return objects
+	}
+	objectIDs = objectIDs[search.Offset:]
+	if uint64(len(objectIDs)) > search.Limit {
+		objectIDs = objectIDs[:search.Limit]
+	}
+	objects.ObjectIDs = objectIDs
+	return objects
+}
+
+// ExpandRelation returns the tree of subjects having the relation or permission to the object.
+func (q *Queries) ExpandRelation(ctx context.Context, projectID, objectType, objectID, permission string, consistency *domain.RelationConsistency) (_ *RelationTree, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	graph, err := q.relationGraph(ctx, projectID, consistency)
+	if err != nil {
+		return nil, err
+	}
+	return graph.expand(ctx, domain.RelationSubject{Type: objectType, ID: objectID, Relation: permission}, 0, make(map[string]bool))
+}
+
+func (q *Queries) relationGraph(ctx context.Context, projectID string, consistency *domain.RelationConsistency) (*relationGraph, error) {
+	ctx, err := q.ensureRelationConsistency(ctx, consistency)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := q.RelationSchemaByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return newRelationGraph(
+		schema.Schema,
+		func(ctx context.Context, objectType, objectID, relation string) ([]domain.RelationSubject, error) {
+			return q.relationSubjects(ctx, projectID, objectType, objectID, relation)
+		},
+		func(ctx context.Context, subject domain.RelationSubject) ([]*domain.RelationTuple, error) {
+			return q.relationObjects(ctx, projectID, subject)
+		},
+		func(ctx context.Context, userID string) ([]string, error) {
+			return q.relationUserRoles(ctx, projectID, userID)
+		},
+	), nil
+}
+
+func (q *Queries) relationSubjects(ctx context.Context, projectID, objectType, objectID, relation string) (subjects []domain.RelationSubject, err error) {
+	query, scan := prepareRelationSubjectsQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		RelationTupleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		RelationTupleColumnProjectID.identifier():  projectID,
+		RelationTupleColumnObjectType.identifier(): objectType,
+		RelationTupleColumnObjectID.identifier():   objectID,
+		RelationTupleColumnRelation.identifier():   relation,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl9bEd", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		subjects, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return subjects, err
+}
+
+// relationObjects returns the tuples having the subject.
+// If the id of the subject is empty, the tuples of all subjects of the type are returned.
+func (q *Queries) relationObjects(ctx context.Context, projectID string, subject domain.RelationSubject) (tuples []*domain.RelationTuple, err error) {
+	query, scan := prepareRelationObjectsQuery(ctx, q.client)
+	eq := sq.Eq{
+		RelationTupleColumnInstanceID.identifier():      authz.GetInstance(ctx).InstanceID(),
+		RelationTupleColumnProjectID.identifier():       projectID,
+		RelationTupleColumnSubjectType.identifier():     subject.Type,
+		RelationTupleColumnSubjectRelation.identifier(): subject.Relation,
+	}
+	if subject.ID != "" {
+		eq[RelationTupleColumnSubjectID.identifier()] = subject.ID
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl9iPo", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		tuples, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return tuples, err
+}
+
+func (q *Queries) relationObjectIDs(ctx context.Context, projectID, objectType string, search *SearchRequest) (objects *RelationObjects, err error) {
+	query, scan := prepareRelationObjectIDsQuery(ctx, q.client)
+	stmt, args, err := search.toQuery(query).Where(sq.Eq{
+		RelationTupleColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		RelationTupleColumnProjectID.identifier():  projectID,
+		RelationTupleColumnObjectType.identifier(): objectType,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl9cRf", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		objects, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return objects, err
+}
+
+// relationUserRoles returns the roles of the active user grants of the user on the project
+func (q *Queries) relationUserRoles(ctx context.Context, projectID, userID string) (roles []string, err error) {
+	query, scan := prepareRelationUserRolesQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		UserGrantInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		UserGrantProjectID.identifier():  projectID,
+		UserGrantUserID.identifier():     userID,
+		UserGrantState.identifier():      domain.UserGrantStateActive,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rl9dTg", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		roles, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return roles, err
+}
+
+type relationSubjectsLoader func(ctx context.Context, objectType, objectID, relation string) ([]domain.RelationSubject, error)
+
+type relationObjectsLoader func(ctx context.Context, subject domain.RelationSubject) ([]*domain.RelationTuple, error)
+
+type relationRolesLoader func(ctx context.Context, userID string) ([]string, error)
+
+// relationGraph evaluates the tuples of a project based on its schema.
+// Loaded subjects and roles are cached for the lifetime of the graph, which is a single request.
+type relationGraph struct {
+	schema       *domain.RelationSchema
+	loadSubjects relationSubjectsLoader
+	loadObjects  relationObjectsLoader
+	loadRoles    relationRolesLoader
+
+	subjects map[string][]domain.RelationSubject
+	objects  map[string][]*domain.RelationTuple
+	roles    map[string][]string
+}
+
+func newRelationGraph(schema *domain.RelationSchema, loadSubjects relationSubjectsLoader, loadObjects relationObjectsLoader, loadRoles relationRolesLoader) *relationGraph {
+	return &relationGraph{
+		schema:       schema,
+		loadSubjects: loadSubjects,
+		loadObjects:  loadObjects,
+		loadRoles:    loadRoles,
+		subjects:     make(map[string][]domain.RelationSubject),
+		objects:      make(map[string][]*domain.RelationTuple),
+		roles:        make(map[string][]string),
+	}
+}
+
+// relationSubjects returns the subjects of the relation which are still allowed by the schema
+func (g *relationGraph) relationSubjects(ctx context.Context, objectType, objectID, relation string) ([]domain.RelationSubject, error) {
+	key := objectType + ":" + objectID + "#" + relation
+	if subjects, ok := g.subjects[key]; ok {
+		return subjects, nil
+	}
+	subjects, err := g.loadSubjects(ctx, objectType, objectID, relation)
+	if err != nil {
+		return nil, err
+	}
+	subjects = slices.DeleteFunc(subjects, func(subject domain.RelationSubject) bool {
+		return !g.schema.Allows(&domain.RelationTuple{
+			ObjectType: objectType,
+			ObjectID:   objectID,
+			Relation:   relation,
+			Subject:    subject,
+		})
+	})
+	g.subjects[key] = subjects
+	return subjects, nil
+}
+
+// relationObjects returns the tuples of the subject which are still allowed by the schema
+func (g *relationGraph) relationObjects(ctx context.Context, subject domain.RelationSubject) ([]*domain.RelationTuple, error) {
+	key := subject.String()
+	if tuples, ok := g.objects[key]; ok {
+		return tuples, nil
+	}
+	tuples, err := g.loadObjects(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	tuples = slices.DeleteFunc(tuples, func(tuple *domain.RelationTuple) bool {
+		return !g.schema.Allows(tuple)
+	})
+	g.objects[key] = tuples
+	return tuples, nil
+}
+
+func (g *relationGraph) userRoles(ctx context.Context, userID string) ([]string, error) {
+	if roles, ok := g.roles[userID]; ok {
+		return roles, nil
+	}
+	roles, err := g.loadRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	g.roles[userID] = roles
+	return roles, nil
+}
+
+func (g *relationGraph) hasRole(ctx context.Context, userID, role string) (bool, error) {
+	roles, err := g.userRoles(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(roles, role), nil
+}
+
+// isSubject returns if any relation of the schema allows the subject (e.g. user or group#member)
+func (g *relationGraph) isSubject(subject domain.RelationSubject) bool {
+	name := subject.Type
+	if subject.Relation != "" {
+		name += "#" + subject.Relation
+	}
+	for _, objectType := range g.schema.Types {
+		for _, relation := range objectType.Relations {
+			if slices.Contains(relation.Subjects, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *relationGraph) check(ctx context.Context, objectType, objectID, permission, userID string) (bool, error) {
+	objectDefinition := g.schema.ObjectType(objectType)
+	if objectDefinition == nil || (objectDefinition.Relation(permission) == nil && objectDefinition.Permission(permission) == nil) {
+		return false, zerrors.ThrowInvalidArgument(nil, "QUERY-Rl9eZh", "Errors.Relation.NotAllowed")
+	}
+	checker := &relationChecker{
+		relationGraph: g,
+		userID:        userID,
+		results:       make(map[string]bool),
+	}
+	return checker.check(ctx, objectType, objectID, permission, 0)
+}
+
+// relationChecker checks the permissions of a single user on a single object.
+// As permissions are unions only, a cycle can be evaluated as not granted
+// without changing the result of the object being checked.
+type relationChecker struct {
+	*relationGraph
+	userID  string
+	results map[string]bool
+}
+
+func (c *relationChecker) check(ctx context.Context, objectType, objectID, name string, depth int) (bool, error) {
+	if depth > domain.RelationMaxDepth {
+		return false, zerrors.ThrowPreconditionFailed(nil, "QUERY-Rl9fUj", "Errors.Relation.MaxDepthExceeded")
+	}
+	key := objectType + ":" + objectID + "#" + name
+	if result, ok := c.results[key]; ok {
+		return result, nil
+	}
+	// mark as visited to break cycles
+	c.results[key] = false
+	result, err := c.evaluate(ctx, objectType, objectID, name, depth)
+	if err != nil {
+		return false, err
+	}
+	c.results[key] = result
+	return result, nil
+}
+
+func (c *relationChecker) evaluate(ctx context.Context, objectType, objectID, name string, depth int) (bool, error) {
+	objectDefinition := c.schema.ObjectType(objectType)
+	if objectDefinition == nil {
+		return false, nil
+	}
+	if objectDefinition.Relation(name) != nil {
+		subjects, err := c.relationSubjects(ctx, objectType, objectID, name)
+		if err != nil {
+			return false, err
+		}
+		for _, subject := range subjects {
+			if subject.Relation == "" {
+				if subject.Type == domain.RelationSubjectTypeUser && subject.ID == c.userID {
+					return true, nil
+				}
+				continue
+			}
+			if ok, err := c.check(ctx, subject.Type, subject.ID, subject.Relation, depth+1); ok || err != nil {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+	permission := objectDefinition.Permission(name)
+	if permission == nil {
+		return false, nil
+	}
+	for _, entry := range permission.Union {
+		ok, err := c.evaluateEntry(ctx, objectType, objectID, entry, depth)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func (c *relationChecker) evaluateEntry(ctx context.Context, objectType, objectID, entry string, depth int) (bool, error) {
+	if role, ok := strings.CutPrefix(entry, domain.RelationRolePrefix); ok {
+		return c.hasRole(ctx, c.userID, role)
+	}
+	relation, target, isArrow := domain.SplitRelationArrow(entry)
+	if !isArrow {
+		return c.check(ctx, objectType, objectID, entry, depth+1)
+	}
+	related, err := c.relationSubjects(ctx, objectType, objectID, relation)
+	if err != nil {
+		return false, err
+	}
+	for _, object := range related {
+		if object.Relation != "" {
+			continue
+		}
+		if ok, err := c.check(ctx, object.Type, object.ID, target, depth+1); ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// listObjects walks the relations and permissions backwards from the user and returns the ids of the objects
+// of the type with the relation or permission.
+// All is returned if the permission is granted on every object of the type through a project role.
+// Nodes without an id stand for all objects of the type.
+func (g *relationGraph) listObjects(ctx context.Context, objectType, permission, userID string) (objectIDs []string, all bool, err error) {
+	roles, err := g.userRoles(ctx, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	lister := &relationLister{visited: make(map[string]bool)}
+	lister.push(domain.RelationSubject{Type: domain.RelationSubjectTypeUser, ID: userID})
+	for _, definition := range g.schema.Types {
+		for _, permissionDefinition := range definition.Permissions {
+			for _, entry := range permissionDefinition.Union {
+				if role, ok := strings.CutPrefix(entry, domain.RelationRolePrefix); ok && slices.Contains(roles, role) {
+					lister.push(domain.RelationSubject{Type: definition.Name, Relation: permissionDefinition.Name})
+				}
+			}
+		}
+	}
+	for len(lister.queue) > 0 {
+		node := lister.queue[0]
+		lister.queue = lister.queue[1:]
+		if node.Type == objectType && node.Relation == permission {
+			if node.ID == "" {
+				return nil, true, nil
+			}
+			objectIDs = append(objectIDs, node.ID)
+		}
+		next, err := g.reverse(ctx, node)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, nextNode := range next {
+			if !lister.push(nextNode) {
+				return nil, false, zerrors.ThrowPreconditionFailed(nil, "QUERY-Rl9jAq", "Errors.Relation.MaxObjectsExceeded")
+			}
+		}
+	}
+	return objectIDs, false, nil
+}
+
+// relationLister queues each node of the backwards walk once
+type relationLister struct {
+	queue   []domain.RelationSubject
+	visited map[string]bool
+}
+
+// push returns false if the maximum of visited nodes is exceeded
+func (l *relationLister) push(node domain.RelationSubject) bool {
+	key := node.String()
+	if l.visited[key] {
+		return true
+	}
+	if len(l.visited) >= domain.RelationMaxObjects {
+		return false
+	}
+	l.visited[key] = true
+	l.queue = append(l.queue, node)
+	return true
+}
+
+// reverse returns the relations and permissions of objects which are granted by the node
+func (g *relationGraph) reverse(ctx context.Context, node domain.RelationSubject) ([]domain.RelationSubject, error) {
+	next := make([]domain.RelationSubject, 0)
+	// relations having the user or the userset as subject
+	if g.isSubject(node) {
+		tuples, err := g.relationObjects(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		for _, tuple := range tuples {
+			next = append(next, domain.RelationSubject{Type: tuple.ObjectType, ID: tuple.ObjectID, Relation: tuple.Relation})
+		}
+	}
+	if node.Relation == "" {
+		return next, nil
+	}
+	// permissions of the same object
+	if definition := g.schema.ObjectType(node.Type); definition != nil {
+		for _, permission := range definition.Permissions {
+			if slices.Contains(permission.Union, node.Relation) {
+				next = append(next, domain.RelationSubject{Type: node.Type, ID: node.ID, Relation: permission.Name})
+			}
+		}
+	}
+	// permissions of the objects traversing a relation to the node
+	for _, definition := range g.schema.Types {
+		for _, permission := range definition.Permissions {
+			for _, entry := range permission.Union {
+				relation, target, isArrow := domain.SplitRelationArrow(entry)
+				if !isArrow || target != node.Relation {
+					continue
+				}
+				tuples, err := g.relationObjects(ctx, domain.RelationSubject{Type: node.Type, ID: node.ID})
+				if err != nil {
+					return nil, err
+				}
+				for _, tuple := range tuples {
+					if tuple.ObjectType == definition.Name && tuple.Relation == relation {
+						next = append(next, domain.RelationSubject{Type: definition.Name, ID: tuple.ObjectID, Relation: permission.Name})
+					}
+				}
+			}
+		}
+	}
+	return next, nil
+}
+
+func (g *relationGraph) expand(ctx context.Context, object domain.RelationSubject, depth int, visited map[string]bool) (*RelationTree, error) {
+	if depth > domain.RelationMaxDepth {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "QUERY-Rl9gIk", "Errors.Relation.MaxDepthExceeded")
+	}
+	tree := &RelationTree{Object: object}
+	objectDefinition := g.schema.ObjectType(object.Type)
+	if objectDefinition == nil {
+		return tree, nil
+	}
+	// a node already expanded on the path is returned without children
+	key := object.String()
+	if visited[key] {
+		return tree, nil
+	}
+	visited[key] = true
+	defer delete(visited, key)
+
+	if objectDefinition.Relation(object.Relation) != nil {
+		subjects, err := g.relationSubjects(ctx, object.Type, object.ID, object.Relation)
+		if err != nil {
+			return nil, err
+		}
+		for _, subject := range subjects {
+			if subject.Relation == "" {
+				tree.Subjects = append(tree.Subjects, subject)
+				continue
+			}
+			child, err := g.expand(ctx, subject, depth+1, visited)
+			if err != nil {
+				return nil, err
+			}
+			tree.Children = append(tree.Children, child)
+		}
+		return tree, nil
+	}
+	permission := objectDefinition.Permission(object.Relation)
+	if permission == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Rl9hOl", "Errors.Relation.NotAllowed")
+	}
+	for _, entry := range permission.Union {
+		if role, ok := strings.CutPrefix(entry, domain.RelationRolePrefix); ok {
+			tree.Roles = append(tree.Roles, role)
+			continue
+		}
+		children, err := g.expandEntry(ctx, object, entry, depth, visited)
+		if err != nil {
+			return nil, err
+		}
+		tree.Children = append(tree.Children, children...)
+	}
+	return tree, nil
+}
+
+func (g *relationGraph) expandEntry(ctx context.Context, object domain.RelationSubject, entry string, depth int, visited map[string]bool) ([]*RelationTree, error) {
+	relation, target, isArrow := domain.SplitRelationArrow(entry)
+	if !isArrow {
+		child, err := g.expand(ctx, domain.RelationSubject{Type: object.Type, ID: object.ID, Relation: entry}, depth+1, visited)
+		if err != nil {
+			return nil, err
+		}
+		return []*RelationTree{child}, nil
+	}
+	related, err := g.relationSubjects(ctx, object.Type, object.ID, relation)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]*RelationTree, 0, len(related))
+	for _, relatedObject := range related {
+		if relatedObject.Relation != "" {
+			continue
+		}
+		child, err := g.expand(ctx, domain.RelationSubject{Type: relatedObject.Type, ID: relatedObject.ID, Relation: target}, depth+1, visited)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
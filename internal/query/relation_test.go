@@ -0,0 +1,559 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	relationSchemaQuery = `SELECT projections.relations.project_id,` +
+		` projections.relations.resource_owner,` +
+		` projections.relations.creation_date,` +
+		` projections.relations.change_date,` +
+		` projections.relations.sequence,` +
+		` projections.relations.schema` +
+		` FROM projections.relations AS OF SYSTEM TIME '-1 ms'`
+	relationSchemaCols = []string{
+		"project_id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"schema",
+	}
+	relationTuplesQuery = `SELECT projections.relations_tuples.project_id,` +
+		` projections.relations_tuples.resource_owner,` +
+		` projections.relations_tuples.creation_date,` +
+		` projections.relations_tuples.sequence,` +
+		` projections.relations_tuples.object_type,` +
+		` projections.relations_tuples.object_id,` +
+		` projections.relations_tuples.relation,` +
+		` projections.relations_tuples.subject_type,` +
+		` projections.relations_tuples.subject_id,` +
+		` projections.relations_tuples.subject_relation,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.relations_tuples AS OF SYSTEM TIME '-1 ms'`
+	relationTuplesCols = []string{
+		"project_id",
+		"resource_owner",
+		"creation_date",
+		"sequence",
+		"object_type",
+		"object_id",
+		"relation",
+		"subject_type",
+		"subject_id",
+		"subject_relation",
+		"count",
+	}
+	relationObjectIDsQuery = `SELECT projections.relations_tuples.object_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.relations_tuples AS OF SYSTEM TIME '-1 ms'` +
+		` GROUP BY projections.relations_tuples.object_id`
+	relationObjectIDsCols = []string{
+		"object_id",
+		"count",
+	}
+	relationObjectsQuery = `SELECT projections.relations_tuples.object_type,` +
+		` projections.relations_tuples.object_id,` +
+		` projections.relations_tuples.relation,` +
+		` projections.relations_tuples.subject_type,` +
+		` projections.relations_tuples.subject_id,` +
+		` projections.relations_tuples.subject_relation` +
+		` FROM projections.relations_tuples AS OF SYSTEM TIME '-1 ms'`
+	relationObjectsCols = []string{
+		"object_type",
+		"object_id",
+		"relation",
+		"subject_type",
+		"subject_id",
+		"subject_relation",
+	}
+)
+
+func Test_RelationPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareRelationSchemaQuery no result",
+			prepare: prepareRelationSchemaQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(relationSchemaQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*RelationSchema)(nil),
+		},
+		{
+			name:    "prepareRelationSchemaQuery found",
+			prepare: prepareRelationSchemaQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(relationSchemaQuery),
+					relationSchemaCols,
+					[]driver.Value{
+						"project-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						[]byte(`{"types":[{"name":"document","relations":[{"name":"editor","subjects":["user"]}]}]}`),
+					},
+				),
+			},
+			object: &RelationSchema{
+				ProjectID:     "project-id",
+				ResourceOwner: "ro",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211109,
+				Schema: &domain.RelationSchema{
+					Types: []*domain.RelationObjectType{
+						{
+							Name: "document",
+							Relations: []*domain.RelationDefinition{
+								{Name: "editor", Subjects: []string{"user"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareRelationTuplesQuery no result",
+			prepare: prepareRelationTuplesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(relationTuplesQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &RelationTuples{Tuples: []*RelationTuple{}},
+		},
+		{
+			name:    "prepareRelationTuplesQuery multiple results",
+			prepare: prepareRelationTuplesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(relationTuplesQuery),
+					relationTuplesCols,
+					[][]driver.Value{
+						{"project-id", "ro", testNow, uint64(20211109), "document", "1", "editor", "user", "user-id", ""},
+						{"project-id", "ro", testNow, uint64(20211110), "document", "1", "editor", "group", "eng", "member"},
+					},
+				),
+			},
+			object: &RelationTuples{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Tuples: []*RelationTuple{
+					{
+						ProjectID:     "project-id",
+						ResourceOwner: "ro",
+						CreationDate:  testNow,
+						Sequence:      20211109,
+						RelationTuple: domain.RelationTuple{
+							ObjectType: "document",
+							ObjectID:   "1",
+							Relation:   "editor",
+							Subject:    domain.RelationSubject{Type: "user", ID: "user-id"},
+						},
+					},
+					{
+						ProjectID:     "project-id",
+						ResourceOwner: "ro",
+						CreationDate:  testNow,
+						Sequence:      20211110,
+						RelationTuple: domain.RelationTuple{
+							ObjectType: "document",
+							ObjectID:   "1",
+							Relation:   "editor",
+							Subject:    domain.RelationSubject{Type: "group", ID: "eng", Relation: "member"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareRelationTuplesQuery sql err",
+			prepare: prepareRelationTuplesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(relationTuplesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*RelationTuples)(nil),
+		},
+		{
+			name:    "prepareRelationObjectIDsQuery multiple results",
+			prepare: prepareRelationObjectIDsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(relationObjectIDsQuery),
+					relationObjectIDsCols,
+					[][]driver.Value{
+						{"1"},
+						{"2"},
+					},
+				),
+			},
+			object: &RelationObjects{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				ObjectIDs: []string{"1", "2"},
+			},
+		},
+		{
+			name:    "prepareRelationObjectsQuery multiple results",
+			prepare: prepareRelationObjectsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(relationObjectsQuery),
+					relationObjectsCols,
+					[][]driver.Value{
+						{"document", "1", "editor", "group", "eng", "member"},
+						{"folder", "docs", "viewer", "group", "eng", "member"},
+					},
+				),
+			},
+			object: []*domain.RelationTuple{
+				{
+					ObjectType: "document",
+					ObjectID:   "1",
+					Relation:   "editor",
+					Subject:    domain.RelationSubject{Type: "group", ID: "eng", Relation: "member"},
+				},
+				{
+					ObjectType: "folder",
+					ObjectID:   "docs",
+					Relation:   "viewer",
+					Subject:    domain.RelationSubject{Type: "group", ID: "eng", Relation: "member"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func testRelationGraph(t *testing.T, tuples []string, roles map[string][]string) *relationGraph {
+	schema := &domain.RelationSchema{
+		Types: []*domain.RelationObjectType{
+			{
+				Name: "group",
+				Relations: []*domain.RelationDefinition{
+					{Name: "member", Subjects: []string{"user", "group#member"}},
+				},
+			},
+			{
+				Name: "folder",
+				Relations: []*domain.RelationDefinition{
+					{Name: "viewer", Subjects: []string{"user", "group#member"}},
+				},
+				Permissions: []*domain.PermissionDefinition{
+					{Name: "view", Union: []string{"viewer", "role:auditor"}},
+				},
+			},
+			{
+				Name: "document",
+				Relations: []*domain.RelationDefinition{
+					{Name: "parent", Subjects: []string{"folder"}},
+					{Name: "editor", Subjects: []string{"user", "group#member"}},
+				},
+				Permissions: []*domain.PermissionDefinition{
+					{Name: "edit", Union: []string{"editor"}},
+					{Name: "view", Union: []string{"edit", "parent->view"}},
+				},
+			},
+		},
+	}
+	require.True(t, schema.IsValid())
+	subjects := make(map[string][]domain.RelationSubject)
+	parsedTuples := make([]*domain.RelationTuple, 0, len(tuples))
+	for _, tuple := range tuples {
+		parsed, err := domain.ParseRelationTuple(tuple)
+		require.NoError(t, err)
+		key := parsed.ObjectType + ":" + parsed.ObjectID + "#" + parsed.Relation
+		subjects[key] = append(subjects[key], parsed.Subject)
+		parsedTuples = append(parsedTuples, parsed)
+	}
+	return newRelationGraph(
+		schema,
+		func(_ context.Context, objectType, objectID, relation string) ([]domain.RelationSubject, error) {
+			return subjects[objectType+":"+objectID+"#"+relation], nil
+		},
+		func(_ context.Context, subject domain.RelationSubject) ([]*domain.RelationTuple, error) {
+			objects := make([]*domain.RelationTuple, 0)
+			for _, tuple := range parsedTuples {
+				if tuple.Subject.Type == subject.Type && tuple.Subject.Relation == subject.Relation &&
+					(subject.ID == "" || tuple.Subject.ID == subject.ID) {
+					objects = append(objects, tuple)
+				}
+			}
+			return objects, nil
+		},
+		func(_ context.Context, userID string) ([]string, error) {
+			return roles[userID], nil
+		},
+	)
+}
+
+func TestRelationGraph_check(t *testing.T) {
+	tuples := []string{
+		"group:eng#member@user:alice",
+		"group:all#member@group:eng#member",
+		// cyclic membership must not loop
+		"group:eng#member@group:all#member",
+		"folder:docs#viewer@group:all#member",
+		"document:1#parent@folder:docs",
+		"document:1#editor@user:bob",
+		// removed from the schema, ignored
+		"document:1#editor@folder:docs",
+	}
+	roles := map[string][]string{
+		"carol": {"auditor"},
+	}
+	tests := []struct {
+		name       string
+		objectType string
+		objectID   string
+		permission string
+		userID     string
+		want       bool
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "direct relation",
+			objectType: "document", objectID: "1", permission: "editor", userID: "bob",
+			want: true,
+		},
+		{
+			name:       "permission from relation",
+			objectType: "document", objectID: "1", permission: "edit", userID: "bob",
+			want: true,
+		},
+		{
+			name:       "nested usersets through parent",
+			objectType: "document", objectID: "1", permission: "view", userID: "alice",
+			want: true,
+		},
+		{
+			name:       "not granted",
+			objectType: "document", objectID: "1", permission: "edit", userID: "alice",
+			want: false,
+		},
+		{
+			name:       "project role through parent",
+			objectType: "document", objectID: "1", permission: "view", userID: "carol",
+			want: true,
+		},
+		{
+			name:       "unknown user in cycle",
+			objectType: "group", objectID: "eng", permission: "member", userID: "mallory",
+			want: false,
+		},
+		{
+			name:       "unknown permission",
+			objectType: "document", objectID: "1", permission: "delete", userID: "bob",
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testRelationGraph(t, tuples, roles).check(context.Background(), tt.objectType, tt.objectID, tt.permission, tt.userID)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRelationGraph_listObjects(t *testing.T) {
+	tuples := []string{
+		"group:eng#member@user:alice",
+		"group:all#member@group:eng#member",
+		// cyclic membership must not loop
+		"group:eng#member@group:all#member",
+		"folder:docs#viewer@group:all#member",
+		"folder:private#viewer@user:bob",
+		"document:1#parent@folder:docs",
+		"document:2#parent@folder:docs",
+		"document:3#parent@folder:private",
+		"document:3#editor@user:alice",
+		"document:4#editor@user:bob",
+		// removed from the schema, ignored
+		"document:5#editor@folder:docs",
+	}
+	roles := map[string][]string{
+		"carol": {"auditor"},
+	}
+	tests := []struct {
+		name       string
+		objectType string
+		permission string
+		userID     string
+		want       []string
+		wantAll    bool
+	}{
+		{
+			name:       "direct relation",
+			objectType: "document", permission: "editor", userID: "bob",
+			want: []string{"4"},
+		},
+		{
+			name:       "nested usersets, traversals and permissions",
+			objectType: "document", permission: "view", userID: "alice",
+			want: []string{"3", "1", "2"},
+		},
+		{
+			name:       "usersets in cycle",
+			objectType: "group", permission: "member", userID: "alice",
+			want: []string{"eng", "all"},
+		},
+		{
+			name:       "project role on all objects",
+			objectType: "folder", permission: "view", userID: "carol",
+			wantAll: true,
+		},
+		{
+			name:       "project role through traversal",
+			objectType: "document", permission: "view", userID: "carol",
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name:       "unknown user",
+			objectType: "document", permission: "view", userID: "mallory",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, all, err := testRelationGraph(t, tuples, roles).listObjects(context.Background(), tt.objectType, tt.permission, tt.userID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAll, all)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func Test_pageRelationObjectIDs(t *testing.T) {
+	tests := []struct {
+		name   string
+		search *SearchRequest
+		want   *RelationObjects
+	}{
+		{
+			name:   "default",
+			search: nil,
+			want: &RelationObjects{
+				SearchResponse: SearchResponse{Count: 3},
+				ObjectIDs:      []string{"3", "2", "1"},
+			},
+		},
+		{
+			name:   "offset and limit",
+			search: &SearchRequest{Offset: 1, Limit: 1, Asc: true},
+			want: &RelationObjects{
+				SearchResponse: SearchResponse{Count: 3},
+				ObjectIDs:      []string{"2"},
+			},
+		},
+		{
+			name:   "offset out of range",
+			search: &SearchRequest{Offset: 3},
+			want: &RelationObjects{
+				SearchResponse: SearchResponse{Count: 3},
+				ObjectIDs:      []string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pageRelationObjectIDs([]string{"2", "3", "1"}, relationObjectsSearchRequest(tt.search))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRelationGraph_expand(t *testing.T) {
+	graph := testRelationGraph(t, []string{
+		"group:eng#member@user:alice",
+		"folder:docs#viewer@group:eng#member",
+		"document:1#parent@folder:docs",
+		"document:1#editor@user:bob",
+	}, nil)
+	got, err := graph.expand(context.Background(), domain.RelationSubject{Type: "document", ID: "1", Relation: "view"}, 0, make(map[string]bool))
+	require.NoError(t, err)
+	assert.Equal(t, &RelationTree{
+		Object: domain.RelationSubject{Type: "document", ID: "1", Relation: "view"},
+		Children: []*RelationTree{
+			{
+				Object: domain.RelationSubject{Type: "document", ID: "1", Relation: "edit"},
+				Children: []*RelationTree{
+					{
+						Object:   domain.RelationSubject{Type: "document", ID: "1", Relation: "editor"},
+						Subjects: []domain.RelationSubject{{Type: "user", ID: "bob"}},
+					},
+				},
+			},
+			{
+				Object: domain.RelationSubject{Type: "folder", ID: "docs", Relation: "view"},
+				Roles:  []string{"auditor"},
+				Children: []*RelationTree{
+					{
+						Object: domain.RelationSubject{Type: "folder", ID: "docs", Relation: "viewer"},
+						Children: []*RelationTree{
+							{
+								Object:   domain.RelationSubject{Type: "group", ID: "eng", Relation: "member"},
+								Subjects: []domain.RelationSubject{{Type: "user", ID: "alice"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, got)
+}
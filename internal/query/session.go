@@ -27,23 +27,25 @@ type Sessions struct {
 }
 
 type Session struct {
-	ID             string
-	CreationDate   time.Time
-	ChangeDate     time.Time
-	Sequence       uint64
-	State          domain.SessionState
-	ResourceOwner  string
-	Creator        string
-	UserFactor     SessionUserFactor
-	PasswordFactor SessionPasswordFactor
-	IntentFactor   SessionIntentFactor
-	WebAuthNFactor SessionWebAuthNFactor
-	TOTPFactor     SessionTOTPFactor
-	OTPSMSFactor   SessionOTPFactor
-	OTPEmailFactor SessionOTPFactor
-	Metadata       map[string][]byte
-	UserAgent      domain.UserAgent
-	Expiration     time.Time
+	ID                  string
+	CreationDate        time.Time
+	ChangeDate          time.Time
+	Sequence            uint64
+	State               domain.SessionState
+	ResourceOwner       string
+	Creator             string
+	UserFactor          SessionUserFactor
+	PasswordFactor      SessionPasswordFactor
+	IntentFactor        SessionIntentFactor
+	WebAuthNFactor      SessionWebAuthNFactor
+	TOTPFactor          SessionTOTPFactor
+	OTPSMSFactor        SessionOTPFactor
+	OTPEmailFactor      SessionOTPFactor
+	TrustedDeviceFactor SessionTrustedDeviceFactor
+	Metadata            map[string][]byte
+	UserAgent           domain.UserAgent
+	Expiration          time.Time
+	Risk                SessionRisk
 }
 
 type SessionUserFactor struct {
@@ -75,6 +77,17 @@ type SessionOTPFactor struct {
 	OTPCheckedAt time.Time
 }
 
+type SessionTrustedDeviceFactor struct {
+	TrustedDeviceCheckedAt time.Time
+}
+
+type SessionRisk struct {
+	Score       int
+	Action      domain.RiskAction
+	Signals     []domain.RiskSignal
+	EvaluatedAt time.Time
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -165,6 +178,10 @@ var (
 		name:  projection.SessionColumnOTPEmailCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnTrustedDeviceCheckedAt = Column{
+		name:  projection.SessionColumnTrustedDeviceCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -193,6 +210,22 @@ var (
 		name:  projection.SessionColumnExpiration,
 		table: sessionsTable,
 	}
+	SessionColumnRiskScore = Column{
+		name:  projection.SessionColumnRiskScore,
+		table: sessionsTable,
+	}
+	SessionColumnRiskAction = Column{
+		name:  projection.SessionColumnRiskAction,
+		table: sessionsTable,
+	}
+	SessionColumnRiskSignals = Column{
+		name:  projection.SessionColumnRiskSignals,
+		table: sessionsTable,
+	}
+	SessionColumnRiskEvaluatedAt = Column{
+		name:  projection.SessionColumnRiskEvaluatedAt,
+		table: sessionsTable,
+	}
 )
 
 func (q *Queries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string) (session *Session, err error) {
@@ -301,6 +334,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -308,6 +342,10 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
 			LeftJoin(join(HumanUserIDCol, SessionColumnUserID)).
@@ -316,23 +354,28 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session := new(Session)
 
 			var (
-				userID              sql.NullString
-				userResourceOwner   sql.NullString
-				userCheckedAt       sql.NullTime
-				loginName           sql.NullString
-				displayName         sql.NullString
-				passwordCheckedAt   sql.NullTime
-				intentCheckedAt     sql.NullTime
-				webAuthNCheckedAt   sql.NullTime
-				webAuthNUserPresent sql.NullBool
-				totpCheckedAt       sql.NullTime
-				otpSMSCheckedAt     sql.NullTime
-				otpEmailCheckedAt   sql.NullTime
-				metadata            database.Map[[]byte]
-				token               sql.NullString
-				userAgentIP         sql.NullString
-				userAgentHeader     database.Map[[]string]
-				expiration          sql.NullTime
+				userID                 sql.NullString
+				userResourceOwner      sql.NullString
+				userCheckedAt          sql.NullTime
+				loginName              sql.NullString
+				displayName            sql.NullString
+				passwordCheckedAt      sql.NullTime
+				intentCheckedAt        sql.NullTime
+				webAuthNCheckedAt      sql.NullTime
+				webAuthNUserPresent    sql.NullBool
+				totpCheckedAt          sql.NullTime
+				otpSMSCheckedAt        sql.NullTime
+				otpEmailCheckedAt      sql.NullTime
+				trustedDeviceCheckedAt sql.NullTime
+				metadata               database.Map[[]byte]
+				token                  sql.NullString
+				userAgentIP            sql.NullString
+				userAgentHeader        database.Map[[]string]
+				expiration             sql.NullTime
+				riskScore              sql.NullInt64
+				riskAction             sql.NullInt16
+				riskSignals            database.NumberArray[domain.RiskSignal]
+				riskEvaluatedAt        sql.NullTime
 			)
 
 			err := row.Scan(
@@ -355,6 +398,7 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&totpCheckedAt,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
+				&trustedDeviceCheckedAt,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -362,6 +406,10 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 				&session.UserAgent.Description,
 				&userAgentHeader,
 				&expiration,
+				&riskScore,
+				&riskAction,
+				&riskSignals,
+				&riskEvaluatedAt,
 			)
 
 			if err != nil {
@@ -383,12 +431,14 @@ func prepareSessionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuil
 			session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+			session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
 				session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 			}
 			session.Expiration = expiration.Time
+			session.Risk = sessionRisk(riskScore, riskAction, riskSignals, riskEvaluatedAt)
 			return session, token.String, nil
 		}
 }
@@ -414,8 +464,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
@@ -428,20 +483,25 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session := new(Session)
 
 				var (
-					userID              sql.NullString
-					userResourceOwner   sql.NullString
-					userCheckedAt       sql.NullTime
-					loginName           sql.NullString
-					displayName         sql.NullString
-					passwordCheckedAt   sql.NullTime
-					intentCheckedAt     sql.NullTime
-					webAuthNCheckedAt   sql.NullTime
-					webAuthNUserPresent sql.NullBool
-					totpCheckedAt       sql.NullTime
-					otpSMSCheckedAt     sql.NullTime
-					otpEmailCheckedAt   sql.NullTime
-					metadata            database.Map[[]byte]
-					expiration          sql.NullTime
+					userID                 sql.NullString
+					userResourceOwner      sql.NullString
+					userCheckedAt          sql.NullTime
+					loginName              sql.NullString
+					displayName            sql.NullString
+					passwordCheckedAt      sql.NullTime
+					intentCheckedAt        sql.NullTime
+					webAuthNCheckedAt      sql.NullTime
+					webAuthNUserPresent    sql.NullBool
+					totpCheckedAt          sql.NullTime
+					otpSMSCheckedAt        sql.NullTime
+					otpEmailCheckedAt      sql.NullTime
+					trustedDeviceCheckedAt sql.NullTime
+					metadata               database.Map[[]byte]
+					expiration             sql.NullTime
+					riskScore              sql.NullInt64
+					riskAction             sql.NullInt16
+					riskSignals            database.NumberArray[domain.RiskSignal]
+					riskEvaluatedAt        sql.NullTime
 				)
 
 				err := rows.Scan(
@@ -464,8 +524,13 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 					&totpCheckedAt,
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
+					&trustedDeviceCheckedAt,
 					&metadata,
 					&expiration,
+					&riskScore,
+					&riskAction,
+					&riskSignals,
+					&riskEvaluatedAt,
 					&sessions.Count,
 				)
 
@@ -484,8 +549,10 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 				session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+				session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
 				session.Metadata = metadata
 				session.Expiration = expiration.Time
+				session.Risk = sessionRisk(riskScore, riskAction, riskSignals, riskEvaluatedAt)
 
 				sessions.Sessions = append(sessions.Sessions, session)
 			}
@@ -493,3 +560,12 @@ func prepareSessionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBui
 			return sessions, nil
 		}
 }
+
+func sessionRisk(score sql.NullInt64, action sql.NullInt16, signals database.NumberArray[domain.RiskSignal], evaluatedAt sql.NullTime) SessionRisk {
+	return SessionRisk{
+		Score:       int(score.Int64),
+		Action:      domain.RiskAction(action.Int16),
+		Signals:     signals,
+		EvaluatedAt: evaluatedAt.Time,
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -39,13 +40,18 @@ var (
 		` projections.sessions8.totp_checked_at,` +
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
+		` projections.sessions8.trusted_device_checked_at,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.token_id,` +
 		` projections.sessions8.user_agent_fingerprint_id,` +
 		` projections.sessions8.user_agent_ip,` +
 		` projections.sessions8.user_agent_description,` +
 		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration` +
+		` projections.sessions8.expiration,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_action,` +
+		` projections.sessions8.risk_signals,` +
+		` projections.sessions8.risk_evaluated_at` +
 		` FROM projections.sessions8` +
 		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
 		` LEFT JOIN projections.users13_humans ON projections.sessions8.user_id = projections.users13_humans.user_id AND projections.sessions8.instance_id = projections.users13_humans.instance_id` +
@@ -70,8 +76,13 @@ var (
 		` projections.sessions8.totp_checked_at,` +
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
+		` projections.sessions8.trusted_device_checked_at,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.expiration,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_action,` +
+		` projections.sessions8.risk_signals,` +
+		` projections.sessions8.risk_evaluated_at,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sessions8` +
 		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
@@ -99,6 +110,7 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"trusted_device_checked_at",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -106,6 +118,10 @@ var (
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_score",
+		"risk_action",
+		"risk_signals",
+		"risk_evaluated_at",
 	}
 
 	sessionsCols = []string{
@@ -128,8 +144,13 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"trusted_device_checked_at",
 		"metadata",
 		"expiration",
+		"risk_score",
+		"risk_action",
+		"risk_signals",
+		"risk_evaluated_at",
 		"count",
 	}
 )
@@ -185,8 +206,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							40,
+							domain.RiskActionRequireMFA,
+							database.NumberArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+							testNow,
 						},
 					},
 				),
@@ -230,10 +256,19 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							Score:       40,
+							Action:      domain.RiskActionRequireMFA,
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+							EvaluatedAt: testNow,
+						},
 					},
 				},
 			},
@@ -266,8 +301,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							40,
+							domain.RiskActionRequireMFA,
+							database.NumberArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+							testNow,
 						},
 						{
 							"session-id2",
@@ -289,8 +329,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							testNow,
+							40,
+							domain.RiskActionRequireMFA,
+							database.NumberArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+							testNow,
 						},
 					},
 				),
@@ -334,10 +379,19 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							Score:       40,
+							Action:      domain.RiskActionRequireMFA,
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+							EvaluatedAt: testNow,
+						},
 					},
 					{
 						ID:            "session-id2",
@@ -373,10 +427,19 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							Score:       40,
+							Action:      domain.RiskActionRequireMFA,
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+							EvaluatedAt: testNow,
+						},
 					},
 				},
 			},
@@ -462,6 +525,7 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -469,6 +533,10 @@ func Test_SessionPrepare(t *testing.T) {
 						"agentDescription",
 						[]byte(`{"foo":["foo","bar"]}`),
 						testNow,
+						40,
+						domain.RiskActionRequireMFA,
+						database.NumberArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+						testNow,
 					},
 				),
 			},
@@ -506,6 +574,9 @@ func Test_SessionPrepare(t *testing.T) {
 				OTPEmailFactor: SessionOTPFactor{
 					OTPCheckedAt: testNow,
 				},
+				TrustedDeviceFactor: SessionTrustedDeviceFactor{
+					TrustedDeviceCheckedAt: testNow,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
@@ -516,6 +587,12 @@ func Test_SessionPrepare(t *testing.T) {
 					Header:        http.Header{"foo": []string{"foo", "bar"}},
 				},
 				Expiration: testNow,
+				Risk: SessionRisk{
+					Score:       40,
+					Action:      domain.RiskActionRequireMFA,
+					Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+					EvaluatedAt: testNow,
+				},
 			},
 		},
 		{
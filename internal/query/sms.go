@@ -32,8 +32,12 @@ type SMSConfig struct {
 	Sequence      uint64
 	Description   string
 
-	TwilioConfig *Twilio
-	HTTPConfig   *HTTP
+	TwilioConfig      *Twilio
+	HTTPConfig        *HTTP
+	VonageConfig      *Vonage
+	SNSConfig         *SNS
+	MessageBirdConfig *MessageBird
+	SMPPConfig        *SMPP
 }
 
 type Twilio struct {
@@ -167,15 +171,18 @@ func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config
 	return config, err
 }
 
-func (q *Queries) SMSProviderConfigActive(ctx context.Context, instanceID string) (config *SMSConfig, err error) {
+// SMSProviderConfigActive returns the active configuration of the resourceOwner,
+// which is either the instance or an organization overriding it.
+func (q *Queries) SMSProviderConfigActive(ctx context.Context, resourceOwner string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
 	query, scan := prepareSMSConfigQuery(ctx, q.client)
 	stmt, args, err := query.Where(
 		sq.Eq{
-			SMSColumnInstanceID.identifier(): instanceID,
-			SMSColumnState.identifier():      domain.SMSConfigStateActive,
+			SMSColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+			SMSColumnResourceOwner.identifier(): resourceOwner,
+			SMSColumnState.identifier():         domain.SMSConfigStateActive,
 		},
 	).ToSql()
 	if err != nil {
@@ -217,6 +224,10 @@ func NewSMSProviderStateQuery(state domain.SMSConfigState) (SearchQuery, error)
 	return NewNumberQuery(SMSColumnState, state, NumberEquals)
 }
 
+func NewSMSProviderResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMSColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, error)) {
 	return sq.Select(
 			SMSColumnID.identifier(),
@@ -236,18 +247,24 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
-		).From(smsConfigsTable.identifier()).
+		).Columns(smsProviderColumns()...).
+			From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
-			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVonageColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSNSColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSMessageBirdColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSMPPColumnSMSID, SMSColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
-				httpConfig   = sqlHTTPConfig{}
+				twilioConfig    = sqlTwilioConfig{}
+				httpConfig      = sqlHTTPConfig{}
+				providerConfigs = sqlSMSProviderConfigs{}
 			)
 
-			err := row.Scan(
+			err := row.Scan(append([]any{
 				&config.ID,
 				&config.AggregateID,
 				&config.CreationDate,
@@ -265,7 +282,7 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 
 				&httpConfig.id,
 				&httpConfig.endpoint,
-			)
+			}, providerConfigs.destinations()...)...)
 
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -276,6 +293,7 @@ func prepareSMSConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 
 			twilioConfig.set(config)
 			httpConfig.setSMS(config)
+			providerConfigs.set(config)
 
 			return config, nil
 		}
@@ -300,22 +318,27 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
-
-			countColumn.identifier(),
-		).From(smsConfigsTable.identifier()).
+		).Columns(smsProviderColumns()...).
+			Columns(countColumn.identifier()).
+			From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
-			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID) + db.Timetravel(call.Took(ctx))).
+			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVonageColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSNSColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSMessageBirdColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSMPPColumnSMSID, SMSColumnID) + db.Timetravel(call.Took(ctx))).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
-					httpConfig   = sqlHTTPConfig{}
+					twilioConfig    = sqlTwilioConfig{}
+					httpConfig      = sqlHTTPConfig{}
+					providerConfigs = sqlSMSProviderConfigs{}
 				)
 
-				err := row.Scan(
+				dest := append([]any{
 					&config.ID,
 					&config.AggregateID,
 					&config.CreationDate,
@@ -333,9 +356,8 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 
 					&httpConfig.id,
 					&httpConfig.endpoint,
-
-					&configs.Count,
-				)
+				}, providerConfigs.destinations()...)
+				err := row.Scan(append(dest, &configs.Count)...)
 
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-d9jJd", "Errors.Internal")
@@ -343,6 +365,7 @@ func prepareSMSConfigsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 
 				twilioConfig.set(config)
 				httpConfig.setSMS(config)
+				providerConfigs.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -0,0 +1,317 @@
+package query
+
+import (
+	"database/sql"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query/projection"
+)
+
+type Vonage struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+	VerifyBrand  string
+}
+
+type SNS struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey *crypto.CryptoValue
+	SenderID        string
+}
+
+type MessageBird struct {
+	AccessKey  *crypto.CryptoValue
+	Originator string
+	Verify     bool
+}
+
+type SMPP struct {
+	Host          string
+	TLS           bool
+	TLSCA         string
+	TLSServerName string
+	SystemID      string
+	Password      *crypto.CryptoValue
+	SystemType    string
+	SourceAddress string
+}
+
+var (
+	smsVonageTable = table{
+		name:          projection.SMSVonageTable,
+		instanceIDCol: projection.SMSVonageColumnInstanceID,
+	}
+	SMSVonageColumnSMSID = Column{
+		name:  projection.SMSVonageColumnSMSID,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnAPIKey = Column{
+		name:  projection.SMSVonageColumnAPIKey,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnAPISecret = Column{
+		name:  projection.SMSVonageColumnAPISecret,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnSenderNumber = Column{
+		name:  projection.SMSVonageColumnSenderNumber,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnVerifyBrand = Column{
+		name:  projection.SMSVonageColumnVerifyBrand,
+		table: smsVonageTable,
+	}
+)
+
+var (
+	smsSNSTable = table{
+		name:          projection.SMSSNSTable,
+		instanceIDCol: projection.SMSSNSColumnInstanceID,
+	}
+	SMSSNSColumnSMSID = Column{
+		name:  projection.SMSSNSColumnSMSID,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnRegion = Column{
+		name:  projection.SMSSNSColumnRegion,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnAccessKeyID = Column{
+		name:  projection.SMSSNSColumnAccessKeyID,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnSecretAccessKey = Column{
+		name:  projection.SMSSNSColumnSecretAccessKey,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnSenderID = Column{
+		name:  projection.SMSSNSColumnSenderID,
+		table: smsSNSTable,
+	}
+)
+
+var (
+	smsMessageBirdTable = table{
+		name:          projection.SMSMessageBirdTable,
+		instanceIDCol: projection.SMSMessageBirdColumnInstanceID,
+	}
+	SMSMessageBirdColumnSMSID = Column{
+		name:  projection.SMSMessageBirdColumnSMSID,
+		table: smsMessageBirdTable,
+	}
+	SMSMessageBirdColumnAccessKey = Column{
+		name:  projection.SMSMessageBirdColumnAccessKey,
+		table: smsMessageBirdTable,
+	}
+	SMSMessageBirdColumnOriginator = Column{
+		name:  projection.SMSMessageBirdColumnOriginator,
+		table: smsMessageBirdTable,
+	}
+	SMSMessageBirdColumnVerify = Column{
+		name:  projection.SMSMessageBirdColumnVerify,
+		table: smsMessageBirdTable,
+	}
+)
+
+var (
+	smsSMPPTable = table{
+		name:          projection.SMSSMPPTable,
+		instanceIDCol: projection.SMSSMPPColumnInstanceID,
+	}
+	SMSSMPPColumnSMSID = Column{
+		name:  projection.SMSSMPPColumnSMSID,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnHost = Column{
+		name:  projection.SMSSMPPColumnHost,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnTLS = Column{
+		name:  projection.SMSSMPPColumnTLS,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnTLSCA = Column{
+		name:  projection.SMSSMPPColumnTLSCA,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnTLSServerName = Column{
+		name:  projection.SMSSMPPColumnTLSServerName,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnSystemID = Column{
+		name:  projection.SMSSMPPColumnSystemID,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnPassword = Column{
+		name:  projection.SMSSMPPColumnPassword,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnSystemType = Column{
+		name:  projection.SMSSMPPColumnSystemType,
+		table: smsSMPPTable,
+	}
+	SMSSMPPColumnSourceAddress = Column{
+		name:  projection.SMSSMPPColumnSourceAddress,
+		table: smsSMPPTable,
+	}
+)
+
+// VerifiesCodes returns true if the provider generates and verifies the codes itself
+func (c *SMSConfig) VerifiesCodes() bool {
+	switch {
+	case c.TwilioConfig != nil:
+		return c.TwilioConfig.VerifyServiceSID != ""
+	case c.VonageConfig != nil:
+		return c.VonageConfig.VerifyBrand != ""
+	case c.MessageBirdConfig != nil:
+		return c.MessageBirdConfig.Verify
+	default:
+		return false
+	}
+}
+
+// smsProviderColumns are the columns of the provider tables joined to the sms configs
+func smsProviderColumns() []string {
+	return []string{
+		SMSVonageColumnSMSID.identifier(),
+		SMSVonageColumnAPIKey.identifier(),
+		SMSVonageColumnAPISecret.identifier(),
+		SMSVonageColumnSenderNumber.identifier(),
+		SMSVonageColumnVerifyBrand.identifier(),
+
+		SMSSNSColumnSMSID.identifier(),
+		SMSSNSColumnRegion.identifier(),
+		SMSSNSColumnAccessKeyID.identifier(),
+		SMSSNSColumnSecretAccessKey.identifier(),
+		SMSSNSColumnSenderID.identifier(),
+
+		SMSMessageBirdColumnSMSID.identifier(),
+		SMSMessageBirdColumnAccessKey.identifier(),
+		SMSMessageBirdColumnOriginator.identifier(),
+		SMSMessageBirdColumnVerify.identifier(),
+
+		SMSSMPPColumnSMSID.identifier(),
+		SMSSMPPColumnHost.identifier(),
+		SMSSMPPColumnTLS.identifier(),
+		SMSSMPPColumnTLSCA.identifier(),
+		SMSSMPPColumnTLSServerName.identifier(),
+		SMSSMPPColumnSystemID.identifier(),
+		SMSSMPPColumnPassword.identifier(),
+		SMSSMPPColumnSystemType.identifier(),
+		SMSSMPPColumnSourceAddress.identifier(),
+	}
+}
+
+type sqlSMSProviderConfigs struct {
+	vonage      sqlVonageConfig
+	sns         sqlSNSConfig
+	messageBird sqlMessageBirdConfig
+	smpp        sqlSMPPConfig
+}
+
+// destinations returns the scan destinations in the order of [smsProviderColumns]
+func (c *sqlSMSProviderConfigs) destinations() []any {
+	return []any{
+		&c.vonage.smsID,
+		&c.vonage.apiKey,
+		&c.vonage.apiSecret,
+		&c.vonage.senderNumber,
+		&c.vonage.verifyBrand,
+
+		&c.sns.smsID,
+		&c.sns.region,
+		&c.sns.accessKeyID,
+		&c.sns.secretAccessKey,
+		&c.sns.senderID,
+
+		&c.messageBird.smsID,
+		&c.messageBird.accessKey,
+		&c.messageBird.originator,
+		&c.messageBird.verify,
+
+		&c.smpp.smsID,
+		&c.smpp.host,
+		&c.smpp.tls,
+		&c.smpp.tlsCA,
+		&c.smpp.tlsServerName,
+		&c.smpp.systemID,
+		&c.smpp.password,
+		&c.smpp.systemType,
+		&c.smpp.sourceAddress,
+	}
+}
+
+func (c *sqlSMSProviderConfigs) set(smsConfig *SMSConfig) {
+	if c.vonage.smsID.Valid {
+		smsConfig.VonageConfig = &Vonage{
+			APIKey:       c.vonage.apiKey.String,
+			APISecret:    c.vonage.apiSecret,
+			SenderNumber: c.vonage.senderNumber.String,
+			VerifyBrand:  c.vonage.verifyBrand.String,
+		}
+	}
+	if c.sns.smsID.Valid {
+		smsConfig.SNSConfig = &SNS{
+			Region:          c.sns.region.String,
+			AccessKeyID:     c.sns.accessKeyID.String,
+			SecretAccessKey: c.sns.secretAccessKey,
+			SenderID:        c.sns.senderID.String,
+		}
+	}
+	if c.messageBird.smsID.Valid {
+		smsConfig.MessageBirdConfig = &MessageBird{
+			AccessKey:  c.messageBird.accessKey,
+			Originator: c.messageBird.originator.String,
+			Verify:     c.messageBird.verify.Bool,
+		}
+	}
+	if c.smpp.smsID.Valid {
+		smsConfig.SMPPConfig = &SMPP{
+			Host:          c.smpp.host.String,
+			TLS:           c.smpp.tls.Bool,
+			TLSCA:         c.smpp.tlsCA.String,
+			TLSServerName: c.smpp.tlsServerName.String,
+			SystemID:      c.smpp.systemID.String,
+			Password:      c.smpp.password,
+			SystemType:    c.smpp.systemType.String,
+			SourceAddress: c.smpp.sourceAddress.String,
+		}
+	}
+}
+
+type sqlVonageConfig struct {
+	smsID        sql.NullString
+	apiKey       sql.NullString
+	apiSecret    *crypto.CryptoValue
+	senderNumber sql.NullString
+	verifyBrand  sql.NullString
+}
+
+type sqlSNSConfig struct {
+	smsID           sql.NullString
+	region          sql.NullString
+	accessKeyID     sql.NullString
+	secretAccessKey *crypto.CryptoValue
+	senderID        sql.NullString
+}
+
+type sqlMessageBirdConfig struct {
+	smsID      sql.NullString
+	accessKey  *crypto.CryptoValue
+	originator sql.NullString
+	verify     sql.NullBool
+}
+
+type sqlSMPPConfig struct {
+	smsID         sql.NullString
+	host          sql.NullString
+	tls           sql.NullBool
+	tlsCA         sql.NullString
+	tlsServerName sql.NullString
+	systemID      sql.NullString
+	password      *crypto.CryptoValue
+	systemType    sql.NullString
+	sourceAddress sql.NullString
+}
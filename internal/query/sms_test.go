@@ -32,10 +32,44 @@ var (
 
 		// http config
 		` projections.sms_configs3_http.sms_id,` +
-		` projections.sms_configs3_http.endpoint` +
+		` projections.sms_configs3_http.endpoint,` +
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+		` projections.sms_configs3_vonage.verify_brand,` +
+
+		// sns config
+		` projections.sms_configs3_sns.sms_id,` +
+		` projections.sms_configs3_sns.region,` +
+		` projections.sms_configs3_sns.access_key_id,` +
+		` projections.sms_configs3_sns.secret_access_key,` +
+		` projections.sms_configs3_sns.sender_id,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator,` +
+		` projections.sms_configs3_messagebird.verify,` +
+
+		// smpp config
+		` projections.sms_configs3_smpp.sms_id,` +
+		` projections.sms_configs3_smpp.host,` +
+		` projections.sms_configs3_smpp.tls,` +
+		` projections.sms_configs3_smpp.tls_ca,` +
+		` projections.sms_configs3_smpp.tls_server_name,` +
+		` projections.sms_configs3_smpp.system_id,` +
+		` projections.sms_configs3_smpp.password,` +
+		` projections.sms_configs3_smpp.system_type,` +
+		` projections.sms_configs3_smpp.source_address` +
 		` FROM projections.sms_configs3` +
 		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
 		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_sns ON projections.sms_configs3.id = projections.sms_configs3_sns.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_sns.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
+		` LEFT JOIN projections.sms_configs3_smpp ON projections.sms_configs3.id = projections.sms_configs3_smpp.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_smpp.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
 		` projections.sms_configs3.aggregate_id,` +
@@ -56,10 +90,44 @@ var (
 		// http config
 		` projections.sms_configs3_http.sms_id,` +
 		` projections.sms_configs3_http.endpoint,` +
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+		` projections.sms_configs3_vonage.verify_brand,` +
+
+		// sns config
+		` projections.sms_configs3_sns.sms_id,` +
+		` projections.sms_configs3_sns.region,` +
+		` projections.sms_configs3_sns.access_key_id,` +
+		` projections.sms_configs3_sns.secret_access_key,` +
+		` projections.sms_configs3_sns.sender_id,` +
+
+		// messagebird config
+		` projections.sms_configs3_messagebird.sms_id,` +
+		` projections.sms_configs3_messagebird.access_key,` +
+		` projections.sms_configs3_messagebird.originator,` +
+		` projections.sms_configs3_messagebird.verify,` +
+
+		// smpp config
+		` projections.sms_configs3_smpp.sms_id,` +
+		` projections.sms_configs3_smpp.host,` +
+		` projections.sms_configs3_smpp.tls,` +
+		` projections.sms_configs3_smpp.tls_ca,` +
+		` projections.sms_configs3_smpp.tls_server_name,` +
+		` projections.sms_configs3_smpp.system_id,` +
+		` projections.sms_configs3_smpp.password,` +
+		` projections.sms_configs3_smpp.system_type,` +
+		` projections.sms_configs3_smpp.source_address,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sms_configs3` +
 		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
 		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_sns ON projections.sms_configs3.id = projections.sms_configs3_sns.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_sns.instance_id` +
+		` LEFT JOIN projections.sms_configs3_messagebird ON projections.sms_configs3.id = projections.sms_configs3_messagebird.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_messagebird.instance_id` +
+		` LEFT JOIN projections.sms_configs3_smpp ON projections.sms_configs3.id = projections.sms_configs3_smpp.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_smpp.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`)
 
 	smsConfigCols = []string{
@@ -80,6 +148,33 @@ var (
 		// http config
 		"sms_id",
 		"endpoint",
+		// vonage config
+		"sms_id",
+		"api_key",
+		"api_secret",
+		"sender_number",
+		"verify_brand",
+		// sns config
+		"sms_id",
+		"region",
+		"access_key_id",
+		"secret_access_key",
+		"sender_id",
+		// messagebird config
+		"sms_id",
+		"access_key",
+		"originator",
+		"verify",
+		// smpp config
+		"sms_id",
+		"host",
+		"tls",
+		"tls_ca",
+		"tls_server_name",
+		"system_id",
+		"password",
+		"system_type",
+		"source_address",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -133,6 +228,33 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -187,6 +309,33 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							"sms-id",
 							"endpoint",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -212,6 +361,87 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery vonage config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							"description",
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							// vonage config
+							"sms-id",
+							"api-key",
+							&crypto.CryptoValue{},
+							"sender-number",
+							"verify-brand",
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						Description:   "description",
+						VonageConfig: &Vonage{
+							APIKey:       "api-key",
+							APISecret:    &crypto.CryptoValue{},
+							SenderNumber: "sender-number",
+							VerifyBrand:  "verify-brand",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -238,6 +468,33 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -257,6 +514,33 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id3",
@@ -276,6 +560,33 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							"sms-id3",
 							"endpoint3",
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// messagebird config
+							nil,
+							nil,
+							nil,
+							nil,
+							// smpp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -412,6 +723,33 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						// http config
 						nil,
 						nil,
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// sns config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// messagebird config
+						nil,
+						nil,
+						nil,
+						nil,
+						// smpp config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -457,6 +795,33 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						// http config
 						"sms-id",
 						"endpoint",
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// sns config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// messagebird config
+						nil,
+						nil,
+						nil,
+						nil,
+						// smpp config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -105,6 +105,10 @@ var (
 		name:  projection.SMTPConfigSMTPColumnPassword,
 		table: smtpConfigsSMTPTable,
 	}
+	SMTPConfigSMTPColumnWebhookToken = Column{
+		name:  projection.SMTPConfigSMTPColumnWebhookToken,
+		table: smtpConfigsSMTPTable,
+	}
 
 	smtpConfigsHTTPTable = table{
 		name:          projection.SMTPConfigHTTPTable,
@@ -147,8 +151,12 @@ type SMTP struct {
 	Host           string
 	User           string
 	Password       *crypto.CryptoValue
+	// WebhookToken authenticates the delivery callbacks of the email relay, it's nil as long as none was generated.
+	WebhookToken *crypto.CryptoValue
 }
 
+// SMTPConfigActive returns the active configuration of the resourceOwner,
+// which is either the instance or an organization overriding it.
 func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (config *SMTPConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -156,7 +164,7 @@ func (q *Queries) SMTPConfigActive(ctx context.Context, resourceOwner string) (c
 	stmt, scan := prepareSMTPConfigQuery(ctx, q.client)
 	query, args, err := stmt.Where(sq.Eq{
 		SMTPConfigColumnResourceOwner.identifier(): resourceOwner,
-		SMTPConfigColumnInstanceID.identifier():    resourceOwner,
+		SMTPConfigColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
 		SMTPConfigColumnState.identifier():         domain.SMTPConfigStateActive,
 	}).ToSql()
 	if err != nil {
@@ -192,6 +200,7 @@ func (q *Queries) SMTPConfigByID(ctx context.Context, instanceID, id string) (co
 
 func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, error)) {
 	password := new(crypto.CryptoValue)
+	webhookToken := new(crypto.CryptoValue)
 
 	return sq.Select(
 			SMTPConfigColumnCreationDate.identifier(),
@@ -210,6 +219,7 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			SMTPConfigSMTPColumnHost.identifier(),
 			SMTPConfigSMTPColumnUser.identifier(),
 			SMTPConfigSMTPColumnPassword.identifier(),
+			SMTPConfigSMTPColumnWebhookToken.identifier(),
 
 			SMTPConfigHTTPColumnID.identifier(),
 			SMTPConfigHTTPColumnEndpoint.identifier()).
@@ -239,6 +249,7 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				&smtpConfig.host,
 				&smtpConfig.user,
 				&password,
+				&webhookToken,
 				&httpConfig.id,
 				&httpConfig.endpoint,
 			)
@@ -249,6 +260,7 @@ func prepareSMTPConfigQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				return nil, zerrors.ThrowInternal(err, "QUERY-9k87F", "Errors.Internal")
 			}
 			smtpConfig.password = password
+			smtpConfig.webhookToken = webhookToken
 			smtpConfig.set(config)
 			httpConfig.setSMTP(config)
 			return config, nil
@@ -273,6 +285,7 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			SMTPConfigSMTPColumnHost.identifier(),
 			SMTPConfigSMTPColumnUser.identifier(),
 			SMTPConfigSMTPColumnPassword.identifier(),
+			SMTPConfigSMTPColumnWebhookToken.identifier(),
 
 			SMTPConfigHTTPColumnID.identifier(),
 			SMTPConfigHTTPColumnEndpoint.identifier(),
@@ -286,6 +299,7 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 			for rows.Next() {
 				config := new(SMTPConfig)
 				password := new(crypto.CryptoValue)
+				webhookToken := new(crypto.CryptoValue)
 				var (
 					smtpConfig = sqlSmtpConfig{}
 					httpConfig = sqlHTTPConfig{}
@@ -306,6 +320,7 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					&smtpConfig.host,
 					&smtpConfig.user,
 					&password,
+					&webhookToken,
 					&httpConfig.id,
 					&httpConfig.endpoint,
 					&configs.Count,
@@ -317,6 +332,7 @@ func prepareSMTPConfigsQuery(ctx context.Context, db prepareDatabase) (sq.Select
 					return nil, zerrors.ThrowInternal(err, "QUERY-9k87F", "Errors.Internal")
 				}
 				smtpConfig.password = password
+				smtpConfig.webhookToken = webhookToken
 				smtpConfig.set(config)
 				httpConfig.setSMTP(config)
 				configs.Configs = append(configs.Configs, config)
@@ -349,6 +365,10 @@ func (q *Queries) SearchSMTPConfigs(ctx context.Context, queries *SMTPConfigsSea
 	return configs, err
 }
 
+func NewSMTPConfigResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(SMTPConfigColumnResourceOwner, resourceOwner, TextEquals)
+}
+
 type sqlSmtpConfig struct {
 	id             sql.NullString
 	tls            sql.NullBool
@@ -358,6 +378,7 @@ type sqlSmtpConfig struct {
 	host           sql.NullString
 	user           sql.NullString
 	password       *crypto.CryptoValue
+	webhookToken   *crypto.CryptoValue
 }
 
 func (c sqlSmtpConfig) set(smtpConfig *SMTPConfig) {
@@ -372,6 +393,7 @@ func (c sqlSmtpConfig) set(smtpConfig *SMTPConfig) {
 		Host:           c.host.String,
 		User:           c.user.String,
 		Password:       c.password,
+		WebhookToken:   c.webhookToken,
 	}
 }
 
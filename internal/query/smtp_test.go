@@ -14,26 +14,27 @@ import (
 )
 
 var (
-	prepareSMTPConfigStmt = `SELECT projections.smtp_configs5.creation_date,` +
-		` projections.smtp_configs5.change_date,` +
-		` projections.smtp_configs5.resource_owner,` +
-		` projections.smtp_configs5.sequence,` +
-		` projections.smtp_configs5.id,` +
-		` projections.smtp_configs5.state,` +
-		` projections.smtp_configs5.description,` +
-		` projections.smtp_configs5_smtp.id,` +
-		` projections.smtp_configs5_smtp.tls,` +
-		` projections.smtp_configs5_smtp.sender_address,` +
-		` projections.smtp_configs5_smtp.sender_name,` +
-		` projections.smtp_configs5_smtp.reply_to_address,` +
-		` projections.smtp_configs5_smtp.host,` +
-		` projections.smtp_configs5_smtp.username,` +
-		` projections.smtp_configs5_smtp.password,` +
-		` projections.smtp_configs5_http.id,` +
-		` projections.smtp_configs5_http.endpoint` +
-		` FROM projections.smtp_configs5` +
-		` LEFT JOIN projections.smtp_configs5_smtp ON projections.smtp_configs5.id = projections.smtp_configs5_smtp.id AND projections.smtp_configs5.instance_id = projections.smtp_configs5_smtp.instance_id` +
-		` LEFT JOIN projections.smtp_configs5_http ON projections.smtp_configs5.id = projections.smtp_configs5_http.id AND projections.smtp_configs5.instance_id = projections.smtp_configs5_http.instance_id` +
+	prepareSMTPConfigStmt = `SELECT projections.smtp_configs6.creation_date,` +
+		` projections.smtp_configs6.change_date,` +
+		` projections.smtp_configs6.resource_owner,` +
+		` projections.smtp_configs6.sequence,` +
+		` projections.smtp_configs6.id,` +
+		` projections.smtp_configs6.state,` +
+		` projections.smtp_configs6.description,` +
+		` projections.smtp_configs6_smtp.id,` +
+		` projections.smtp_configs6_smtp.tls,` +
+		` projections.smtp_configs6_smtp.sender_address,` +
+		` projections.smtp_configs6_smtp.sender_name,` +
+		` projections.smtp_configs6_smtp.reply_to_address,` +
+		` projections.smtp_configs6_smtp.host,` +
+		` projections.smtp_configs6_smtp.username,` +
+		` projections.smtp_configs6_smtp.password,` +
+		` projections.smtp_configs6_smtp.webhook_token,` +
+		` projections.smtp_configs6_http.id,` +
+		` projections.smtp_configs6_http.endpoint` +
+		` FROM projections.smtp_configs6` +
+		` LEFT JOIN projections.smtp_configs6_smtp ON projections.smtp_configs6.id = projections.smtp_configs6_smtp.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_smtp.instance_id` +
+		` LEFT JOIN projections.smtp_configs6_http ON projections.smtp_configs6.id = projections.smtp_configs6_http.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_http.instance_id` +
 		` AS OF SYSTEM TIME '-1 ms'`
 	prepareSMTPConfigCols = []string{
 		"creation_date",
@@ -51,6 +52,7 @@ var (
 		"smtp_host",
 		"smtp_user",
 		"smtp_password",
+		"webhook_token",
 		"id",
 		"endpoint",
 	}
@@ -108,6 +110,7 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						"host",
 						"user",
 						&crypto.CryptoValue{},
+						&crypto.CryptoValue{KeyID: "token"},
 						nil,
 						nil,
 					},
@@ -126,6 +129,7 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 					Host:           "host",
 					User:           "user",
 					Password:       &crypto.CryptoValue{},
+					WebhookToken:   &crypto.CryptoValue{KeyID: "token"},
 				},
 				ID:          "2232323",
 				State:       domain.SMTPConfigStateActive,
@@ -155,6 +159,7 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						"2232323",
 						"endpoint",
 					},
@@ -198,6 +203,7 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						&crypto.CryptoValue{},
 						nil,
 						nil,
+						nil,
 					},
 				),
 			},
@@ -245,6 +251,7 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 						&crypto.CryptoValue{},
 						nil,
 						nil,
+						nil,
 					},
 				),
 			},
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/target"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -64,6 +66,18 @@ var (
 		name:  projection.TargetSigningKey,
 		table: targetTable,
 	}
+	TargetColumnTLS = Column{
+		name:  projection.TargetTLSCol,
+		table: targetTable,
+	}
+	TargetColumnOAuth2 = Column{
+		name:  projection.TargetOAuth2Col,
+		table: targetTable,
+	}
+	TargetColumnModule = Column{
+		name:  projection.TargetModuleCol,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -85,11 +99,29 @@ type Target struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	tls              []byte
+	TLS              *domain.TargetTLS
+	oauth2           []byte
+	OAuth2           *domain.TargetOAuth2
+	Module           []byte
 }
 
-func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
-	if t.signingKey == nil {
-		return nil
+func (t *Target) GetTLS() *domain.TargetTLS {
+	return t.TLS
+}
+
+func (t *Target) GetOAuth2() *domain.TargetOAuth2 {
+	return t.OAuth2
+}
+
+func (t *Target) GetModule() []byte {
+	return t.Module
+}
+
+func (t *Target) decryptSecrets(alg crypto.EncryptionAlgorithm) (err error) {
+	t.TLS, t.OAuth2, err = decryptTargetTransports(t.tls, t.oauth2, alg)
+	if err != nil || t.signingKey == nil {
+		return err
 	}
 	keyValue, err := crypto.DecryptString(t.signingKey, alg)
 	if err != nil {
@@ -99,6 +131,49 @@ func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
 	return nil
 }
 
+// decryptTargetTransports maps the stored mutual TLS and OAuth2 configurations of a target
+// and decrypts the contained client key and secret
+func decryptTargetTransports(tlsConfig, oauth2Config []byte, alg crypto.EncryptionAlgorithm) (_ *domain.TargetTLS, _ *domain.TargetOAuth2, err error) {
+	var (
+		tls    *domain.TargetTLS
+		oauth2 *domain.TargetOAuth2
+	)
+	if len(tlsConfig) > 0 {
+		stored := new(target.TLS)
+		if err := json.Unmarshal(tlsConfig, stored); err != nil {
+			return nil, nil, zerrors.ThrowInternal(err, "QUERY-Hf9sKe", "Errors.Internal")
+		}
+		tls = &domain.TargetTLS{
+			ClientCertificate: stored.ClientCertificate,
+			CACertificates:    stored.CACertificates,
+		}
+		if stored.ClientKey != nil {
+			tls.ClientKey, err = crypto.Decrypt(stored.ClientKey, alg)
+			if err != nil {
+				return nil, nil, zerrors.ThrowInternal(err, "QUERY-Ws3nPq", "Errors.Internal")
+			}
+		}
+	}
+	if len(oauth2Config) > 0 {
+		stored := new(target.OAuth2)
+		if err := json.Unmarshal(oauth2Config, stored); err != nil {
+			return nil, nil, zerrors.ThrowInternal(err, "QUERY-Lp0vXa", "Errors.Internal")
+		}
+		oauth2 = &domain.TargetOAuth2{
+			TokenEndpoint: stored.TokenEndpoint,
+			ClientID:      stored.ClientID,
+			Scopes:        stored.Scopes,
+		}
+		if stored.ClientSecret != nil {
+			oauth2.ClientSecret, err = crypto.DecryptString(stored.ClientSecret, alg)
+			if err != nil {
+				return nil, nil, zerrors.ThrowInternal(err, "QUERY-Ey7rMb", "Errors.Internal")
+			}
+		}
+	}
+	return tls, oauth2, nil
+}
+
 type TargetSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -122,7 +197,7 @@ func (q *Queries) SearchTargets(ctx context.Context, queries *TargetSearchQuerie
 		return nil, err
 	}
 	for i := range targets.Targets {
-		if err := targets.Targets[i].decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
+		if err := targets.Targets[i].decryptSecrets(q.targetEncryptionAlgorithm); err != nil {
 			return nil, err
 		}
 	}
@@ -139,7 +214,7 @@ func (q *Queries) GetTargetByID(ctx context.Context, id string) (*Target, error)
 	if err != nil {
 		return nil, err
 	}
-	if err := target.decryptSigningKey(q.targetEncryptionAlgorithm); err != nil {
+	if err := target.decryptSecrets(q.targetEncryptionAlgorithm); err != nil {
 		return nil, err
 	}
 	return target, nil
@@ -165,6 +240,9 @@ func prepareTargetsQuery(context.Context, prepareDatabase) (sq.SelectBuilder, fu
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnTLS.identifier(),
+			TargetColumnOAuth2.identifier(),
+			TargetColumnModule.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -184,6 +262,9 @@ func prepareTargetsQuery(context.Context, prepareDatabase) (sq.SelectBuilder, fu
 					&target.Endpoint,
 					&target.InterruptOnError,
 					&target.signingKey,
+					&target.tls,
+					&target.oauth2,
+					&target.Module,
 					&count,
 				)
 				if err != nil {
@@ -217,6 +298,9 @@ func prepareTargetQuery(context.Context, prepareDatabase) (sq.SelectBuilder, fun
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnTLS.identifier(),
+			TargetColumnOAuth2.identifier(),
+			TargetColumnModule.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
@@ -232,6 +316,9 @@ func prepareTargetQuery(context.Context, prepareDatabase) (sq.SelectBuilder, fun
 				&target.Endpoint,
 				&target.InterruptOnError,
 				&target.signingKey,
+				&target.tls,
+				&target.oauth2,
+				&target.Module,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,191 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	targetDeliveryTable = table{
+		name:          projection.TargetDeliveryTable,
+		instanceIDCol: projection.TargetDeliveryInstanceIDCol,
+	}
+	TargetDeliveryColumnID = Column{
+		name:  projection.TargetDeliveryIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnCreationDate = Column{
+		name:  projection.TargetDeliveryCreationDateCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnChangeDate = Column{
+		name:  projection.TargetDeliveryChangeDateCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnInstanceID = Column{
+		name:  projection.TargetDeliveryInstanceIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnSequence = Column{
+		name:  projection.TargetDeliverySequenceCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnTargetID = Column{
+		name:  projection.TargetDeliveryTargetIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnExecutionID = Column{
+		name:  projection.TargetDeliveryExecutionIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnIdempotencyKey = Column{
+		name:  projection.TargetDeliveryIdempotencyKeyCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnState = Column{
+		name:  projection.TargetDeliveryStateCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnAttempts = Column{
+		name:  projection.TargetDeliveryAttemptsCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnLastError = Column{
+		name:  projection.TargetDeliveryLastErrorCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnReplayOf = Column{
+		name:  projection.TargetDeliveryReplayOfCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnReplayID = Column{
+		name:  projection.TargetDeliveryReplayIDCol,
+		table: targetDeliveryTable,
+	}
+)
+
+type TargetDeliveries struct {
+	SearchResponse
+	TargetDeliveries []*TargetDelivery
+}
+
+func (t *TargetDeliveries) SetState(s *State) {
+	t.State = s
+}
+
+type TargetDelivery struct {
+	domain.ObjectDetails
+
+	TargetID       string
+	ExecutionID    string
+	IdempotencyKey string
+	State          domain.TargetDeliveryState
+	Attempts       uint16
+	LastError      string
+	ReplayOf       string
+	ReplayID       string
+}
+
+type TargetDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *TargetDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchTargetDeliveries(ctx context.Context, queries *TargetDeliverySearchQueries) (*TargetDeliveries, error) {
+	eq := sq.Eq{
+		TargetDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareTargetDeliveriesQuery(ctx, q.client)
+	return genericRowsQueryWithState[*TargetDeliveries](ctx, q.client, targetDeliveryTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewTargetDeliveryTargetIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(TargetDeliveryColumnTargetID, value, TextEquals)
+}
+
+func NewTargetDeliveryStateSearchQuery(value domain.TargetDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(TargetDeliveryColumnState, value, NumberEquals)
+}
+
+func prepareTargetDeliveriesQuery(context.Context, prepareDatabase) (sq.SelectBuilder, func(rows *sql.Rows) (*TargetDeliveries, error)) {
+	return sq.Select(
+			TargetDeliveryColumnID.identifier(),
+			TargetDeliveryColumnCreationDate.identifier(),
+			TargetDeliveryColumnChangeDate.identifier(),
+			TargetDeliveryColumnInstanceID.identifier(),
+			TargetDeliveryColumnSequence.identifier(),
+			TargetDeliveryColumnTargetID.identifier(),
+			TargetDeliveryColumnExecutionID.identifier(),
+			TargetDeliveryColumnIdempotencyKey.identifier(),
+			TargetDeliveryColumnState.identifier(),
+			TargetDeliveryColumnAttempts.identifier(),
+			TargetDeliveryColumnLastError.identifier(),
+			TargetDeliveryColumnReplayOf.identifier(),
+			TargetDeliveryColumnReplayID.identifier(),
+			countColumn.identifier(),
+		).From(targetDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TargetDeliveries, error) {
+			deliveries := make([]*TargetDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery := new(TargetDelivery)
+				var (
+					executionID sql.NullString
+					lastError   sql.NullString
+					replayOf    sql.NullString
+					replayID    sql.NullString
+				)
+				err := rows.Scan(
+					&delivery.ID,
+					&delivery.CreationDate,
+					&delivery.EventDate,
+					&delivery.ResourceOwner,
+					&delivery.Sequence,
+					&delivery.TargetID,
+					&executionID,
+					&delivery.IdempotencyKey,
+					&delivery.State,
+					&delivery.Attempts,
+					&lastError,
+					&replayOf,
+					&replayID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				delivery.ExecutionID = executionID.String
+				delivery.LastError = lastError.String
+				delivery.ReplayOf = replayOf.String
+				delivery.ReplayID = replayID.String
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-q8w3nd0vhz", "Errors.Query.CloseRows")
+			}
+
+			return &TargetDeliveries{
+				TargetDeliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
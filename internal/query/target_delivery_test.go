@@ -0,0 +1,173 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareTargetDeliveriesStmt = `SELECT projections.target_deliveries.id,` +
+		` projections.target_deliveries.creation_date,` +
+		` projections.target_deliveries.change_date,` +
+		` projections.target_deliveries.instance_id,` +
+		` projections.target_deliveries.sequence,` +
+		` projections.target_deliveries.target_id,` +
+		` projections.target_deliveries.execution_id,` +
+		` projections.target_deliveries.idempotency_key,` +
+		` projections.target_deliveries.state,` +
+		` projections.target_deliveries.attempts,` +
+		` projections.target_deliveries.last_error,` +
+		` projections.target_deliveries.replay_of,` +
+		` projections.target_deliveries.replay_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.target_deliveries`
+	prepareTargetDeliveriesCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"instance_id",
+		"sequence",
+		"target_id",
+		"execution_id",
+		"idempotency_key",
+		"state",
+		"attempts",
+		"last_error",
+		"replay_of",
+		"replay_id",
+		"count",
+	}
+)
+
+func Test_TargetDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTargetDeliveriesQuery no result",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &TargetDeliveries{TargetDeliveries: []*TargetDelivery{}},
+		},
+		{
+			name:    "prepareTargetDeliveriesQuery multiple result",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					prepareTargetDeliveriesCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							"instance",
+							uint64(3),
+							"target",
+							"event/user.human.added",
+							"id-1",
+							domain.TargetDeliveryStateFailed,
+							uint16(10),
+							"timeout",
+							nil,
+							"id-2",
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							"instance",
+							uint64(2),
+							"target",
+							nil,
+							"id-1",
+							domain.TargetDeliveryStateSucceeded,
+							uint16(1),
+							nil,
+							"id-1",
+							nil,
+						},
+					},
+				),
+			},
+			object: &TargetDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				TargetDeliveries: []*TargetDelivery{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-1",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "instance",
+							Sequence:      3,
+						},
+						TargetID:       "target",
+						ExecutionID:    "event/user.human.added",
+						IdempotencyKey: "id-1",
+						State:          domain.TargetDeliveryStateFailed,
+						Attempts:       10,
+						LastError:      "timeout",
+						ReplayID:       "id-2",
+					},
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-2",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "instance",
+							Sequence:      2,
+						},
+						TargetID:       "target",
+						IdempotencyKey: "id-1",
+						State:          domain.TargetDeliveryStateSucceeded,
+						Attempts:       1,
+						ReplayOf:       "id-1",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTargetDeliveriesQuery sql err",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TargetDeliveries)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
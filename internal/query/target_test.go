@@ -9,6 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -25,6 +29,9 @@ var (
 		` projections.targets2.endpoint,` +
 		` projections.targets2.interrupt_on_error,` +
 		` projections.targets2.signing_key,` +
+		` projections.targets2.tls,` +
+		` projections.targets2.oauth2,` +
+		` projections.targets2.module,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.targets2`
 	prepareTargetsCols = []string{
@@ -38,6 +45,9 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"tls",
+		"oauth2",
+		"module",
 		"count",
 	}
 
@@ -50,7 +60,10 @@ var (
 		` projections.targets2.timeout,` +
 		` projections.targets2.endpoint,` +
 		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key` +
+		` projections.targets2.signing_key,` +
+		` projections.targets2.tls,` +
+		` projections.targets2.oauth2,` +
+		` projections.targets2.module` +
 		` FROM projections.targets2`
 	prepareTargetCols = []string{
 		"id",
@@ -63,6 +76,9 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"tls",
+		"oauth2",
+		"module",
 	}
 )
 
@@ -113,6 +129,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -168,6 +187,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"id-2",
@@ -185,6 +207,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"id-3",
@@ -202,6 +227,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -329,6 +357,9 @@ func Test_TargetPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						[]byte(`{"caCertificates":"Y2E="}`),
+						[]byte(`{"tokenEndpoint":"https://example.com/oauth/token","clientId":"client"}`),
+						nil,
 					},
 				),
 			},
@@ -350,6 +381,8 @@ func Test_TargetPrepares(t *testing.T) {
 					KeyID:      "encKey",
 					Crypted:    []byte("crypted"),
 				},
+				tls:    []byte(`{"caCertificates":"Y2E="}`),
+				oauth2: []byte(`{"tokenEndpoint":"https://example.com/oauth/token","clientId":"client"}`),
 			},
 		},
 		{
@@ -376,3 +409,29 @@ func Test_TargetPrepares(t *testing.T) {
 		})
 	}
 }
+
+func Test_decryptTargetTransports(t *testing.T) {
+	alg := crypto.CreateMockEncryptionAlg(gomock.NewController(t))
+	tls, oauth2, err := decryptTargetTransports(
+		[]byte(`{"clientCertificate":"Y2VydA==","clientKey":{"cryptoType":0,"algorithm":"enc","keyID":"id","crypted":"a2V5"},"caCertificates":"Y2E="}`),
+		[]byte(`{"tokenEndpoint":"https://example.com/oauth/token","clientId":"client","clientSecret":{"cryptoType":0,"algorithm":"enc","keyID":"id","crypted":"c2VjcmV0"},"scopes":["actions"]}`),
+		alg,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.TargetTLS{
+		ClientCertificate: []byte("cert"),
+		ClientKey:         []byte("key"),
+		CACertificates:    []byte("ca"),
+	}, tls)
+	assert.Equal(t, &domain.TargetOAuth2{
+		TokenEndpoint: "https://example.com/oauth/token",
+		ClientID:      "client",
+		ClientSecret:  "secret",
+		Scopes:        []string{"actions"},
+	}, oauth2)
+
+	tls, oauth2, err = decryptTargetTransports(nil, nil, alg)
+	require.NoError(t, err)
+	assert.Nil(t, tls)
+	assert.Nil(t, oauth2)
+}
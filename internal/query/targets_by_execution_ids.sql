@@ -12,7 +12,7 @@ WITH RECURSIVE
                    AND id = ANY($3)
                  ORDER BY id DESC
                  LIMIT 1)),
-    matched_targets_and_includes AS (SELECT pos.*
+    matched_targets_and_includes AS (SELECT pos.*, m.condition
                                      FROM matched m
                                               JOIN
                                           projections.executions1_targets pos
@@ -20,12 +20,13 @@ WITH RECURSIVE
                                               AND m.instance_id = pos.instance_id
                                      ORDER BY execution_id,
                                               position),
-    dissolved_execution_targets(execution_id, instance_id, position, "include", "target_id")
+    dissolved_execution_targets(execution_id, instance_id, position, "include", "target_id", "conditions")
         AS (SELECT execution_id
                  , instance_id
                  , ARRAY [position]
                  , "include"
                  , "target_id"
+                 , ARRAY_REMOVE(ARRAY [COALESCE(condition, '')], '')
             FROM matched_targets_and_includes
             UNION ALL
             SELECT e.execution_id
@@ -33,12 +34,16 @@ WITH RECURSIVE
                  , e.position || p.position
                  , p."include"
                  , p."target_id"
+                 , e.conditions || ARRAY_REMOVE(ARRAY [COALESCE(i.condition, '')], '')
             FROM dissolved_execution_targets e
                      JOIN projections.executions1_targets p
                           ON e.instance_id = p.instance_id
                               AND e.include IS NOT NULL
-                              AND e.include = p.execution_id)
-select e.execution_id, e.instance_id, e.target_id, t.target_type, t.endpoint, t.timeout, t.interrupt_on_error, t.signing_key
+                              AND e.include = p.execution_id
+                     JOIN projections.executions1 i
+                          ON p.instance_id = i.instance_id
+                              AND p.execution_id = i.id)
+select e.execution_id, e.instance_id, e.target_id, t.target_type, t.endpoint, t.timeout, t.interrupt_on_error, t.signing_key, t.tls, t.oauth2, t.module, e.conditions
 FROM dissolved_execution_targets e
          JOIN projections.targets2 t
               ON e.instance_id = t.instance_id
@@ -271,6 +271,10 @@ var (
 		name:  projection.HumanPasswordChanged,
 		table: humanTable,
 	}
+	HumanPasswordExpiryNotifiedCol = Column{
+		name:  projection.HumanPasswordExpiryNotified,
+		table: humanTable,
+	}
 )
 
 var (
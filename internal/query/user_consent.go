@@ -0,0 +1,264 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserConsents struct {
+	SearchResponse
+	Consents []*UserConsent
+}
+
+type UserConsent struct {
+	UserID        string
+	ClientID      string
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	Scopes        database.TextArray[string]
+	// AppName is the name of the application of the client
+	AppName string
+}
+
+type UserConsentSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	userConsentTable = table{
+		name:          projection.UserConsentProjectionTable,
+		instanceIDCol: projection.UserConsentColumnInstanceID,
+	}
+	UserConsentColumnInstanceID = Column{
+		name:  projection.UserConsentColumnInstanceID,
+		table: userConsentTable,
+	}
+	UserConsentColumnUserID = Column{
+		name:  projection.UserConsentColumnUserID,
+		table: userConsentTable,
+	}
+	UserConsentColumnClientID = Column{
+		name:  projection.UserConsentColumnClientID,
+		table: userConsentTable,
+	}
+	UserConsentColumnProjectID = Column{
+		name:  projection.UserConsentColumnProjectID,
+		table: userConsentTable,
+	}
+	UserConsentColumnResourceOwner = Column{
+		name:  projection.UserConsentColumnResourceOwner,
+		table: userConsentTable,
+	}
+	UserConsentColumnCreationDate = Column{
+		name:  projection.UserConsentColumnCreationDate,
+		table: userConsentTable,
+	}
+	UserConsentColumnChangeDate = Column{
+		name:  projection.UserConsentColumnChangeDate,
+		table: userConsentTable,
+	}
+	UserConsentColumnSequence = Column{
+		name:  projection.UserConsentColumnSequence,
+		table: userConsentTable,
+	}
+	UserConsentColumnScopes = Column{
+		name:  projection.UserConsentColumnScopes,
+		table: userConsentTable,
+	}
+)
+
+// UserConsentByClientID returns the consent of the user for the client (application)
+func (q *Queries) UserConsentByClientID(ctx context.Context, shouldTriggerBulk bool, userID, clientID string) (consent *UserConsent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserConsentProjection")
+		ctx, err = projection.UserConsentProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareUserConsentQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserConsentColumnUserID.identifier():     userID,
+		UserConsentColumnClientID.identifier():   clientID,
+		UserConsentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Wq4xNd", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		consent, err = scan(row)
+		return err
+	}, stmt, args...)
+	return consent, err
+}
+
+// SearchUserConsents returns the consents matching the queries, the permission has to be checked by the caller
+func (q *Queries) SearchUserConsents(ctx context.Context, queries *UserConsentSearchQueries) (consents *UserConsents, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserConsentsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserConsentColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pf7cKs", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		consents, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	consents.State, err = q.latestState(ctx, userConsentTable)
+	return consents, err
+}
+
+func (q *UserConsentSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewUserConsentUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnUserID, value, TextEquals)
+}
+
+func NewUserConsentClientIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnClientID, value, TextEquals)
+}
+
+func NewUserConsentProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserConsentColumnProjectID, value, TextEquals)
+}
+
+func prepareUserConsentQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserConsent, error)) {
+	return sq.Select(
+			UserConsentColumnUserID.identifier(),
+			UserConsentColumnClientID.identifier(),
+			UserConsentColumnProjectID.identifier(),
+			UserConsentColumnResourceOwner.identifier(),
+			UserConsentColumnCreationDate.identifier(),
+			UserConsentColumnChangeDate.identifier(),
+			UserConsentColumnSequence.identifier(),
+			UserConsentColumnScopes.identifier(),
+			AppColumnName.identifier(),
+		).
+			From(userConsentTable.identifier()).
+			LeftJoin(join(AppOIDCConfigColumnClientID, UserConsentColumnClientID)).
+			LeftJoin(join(AppColumnID, AppOIDCConfigColumnAppID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserConsent, error) {
+			consent := new(UserConsent)
+			var (
+				projectID sql.NullString
+				appName   sql.NullString
+			)
+			err := row.Scan(
+				&consent.UserID,
+				&consent.ClientID,
+				&projectID,
+				&consent.ResourceOwner,
+				&consent.CreationDate,
+				&consent.ChangeDate,
+				&consent.Sequence,
+				&consent.Scopes,
+				&appName,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Gm2tZe", "Errors.User.Consent.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Yb9sRq", "Errors.Internal")
+			}
+			consent.ProjectID = projectID.String
+			consent.AppName = appName.String
+			return consent, nil
+		}
+}
+
+func prepareUserConsentsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserConsents, error)) {
+	return sq.Select(
+			UserConsentColumnUserID.identifier(),
+			UserConsentColumnClientID.identifier(),
+			UserConsentColumnProjectID.identifier(),
+			UserConsentColumnResourceOwner.identifier(),
+			UserConsentColumnCreationDate.identifier(),
+			UserConsentColumnChangeDate.identifier(),
+			UserConsentColumnSequence.identifier(),
+			UserConsentColumnScopes.identifier(),
+			AppColumnName.identifier(),
+			countColumn.identifier(),
+		).
+			From(userConsentTable.identifier()).
+			LeftJoin(join(AppOIDCConfigColumnClientID, UserConsentColumnClientID)).
+			LeftJoin(join(AppColumnID, AppOIDCConfigColumnAppID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserConsents, error) {
+			consents := make([]*UserConsent, 0)
+			var count uint64
+			for rows.Next() {
+				consent := new(UserConsent)
+				var (
+					projectID sql.NullString
+					appName   sql.NullString
+				)
+				err := rows.Scan(
+					&consent.UserID,
+					&consent.ClientID,
+					&projectID,
+					&consent.ResourceOwner,
+					&consent.CreationDate,
+					&consent.ChangeDate,
+					&consent.Sequence,
+					&consent.Scopes,
+					&appName,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				consent.ProjectID = projectID.String
+				consent.AppName = appName.String
+				consents = append(consents, consent)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Vr3hLp", "Errors.Query.CloseRows")
+			}
+
+			return &UserConsents{
+				Consents: consents,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
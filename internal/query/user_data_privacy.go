@@ -0,0 +1,560 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserDataExports struct {
+	SearchResponse
+	Exports []*UserDataExport
+}
+
+type UserDataExport struct {
+	ID            string
+	UserID        string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.UserDataExportState
+}
+
+type UserDataExportSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type ScheduledUserDeletions struct {
+	SearchResponse
+	Deletions []*ScheduledUserDeletion
+}
+
+type ScheduledUserDeletion struct {
+	UserID        string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	DeletionDate  time.Time
+	// Reminded is set as soon as the user was reminded about the upcoming deletion
+	Reminded bool
+}
+
+type ScheduledUserDeletionSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	userDataExportTable = table{
+		name:          projection.UserDataExportProjectionTable,
+		instanceIDCol: projection.UserDataExportColumnInstanceID,
+	}
+	UserDataExportColumnID = Column{
+		name:  projection.UserDataExportColumnID,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnInstanceID = Column{
+		name:  projection.UserDataExportColumnInstanceID,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnUserID = Column{
+		name:  projection.UserDataExportColumnUserID,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnResourceOwner = Column{
+		name:  projection.UserDataExportColumnResourceOwner,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnCreationDate = Column{
+		name:  projection.UserDataExportColumnCreationDate,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnChangeDate = Column{
+		name:  projection.UserDataExportColumnChangeDate,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnSequence = Column{
+		name:  projection.UserDataExportColumnSequence,
+		table: userDataExportTable,
+	}
+	UserDataExportColumnState = Column{
+		name:  projection.UserDataExportColumnState,
+		table: userDataExportTable,
+	}
+
+	userScheduledDeletionTable = table{
+		name:          projection.UserScheduledDeletionProjectionTable,
+		instanceIDCol: projection.UserScheduledDeletionColumnInstanceID,
+	}
+	UserScheduledDeletionColumnInstanceID = Column{
+		name:  projection.UserScheduledDeletionColumnInstanceID,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnUserID = Column{
+		name:  projection.UserScheduledDeletionColumnUserID,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnResourceOwner = Column{
+		name:  projection.UserScheduledDeletionColumnResourceOwner,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnCreationDate = Column{
+		name:  projection.UserScheduledDeletionColumnCreationDate,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnChangeDate = Column{
+		name:  projection.UserScheduledDeletionColumnChangeDate,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnSequence = Column{
+		name:  projection.UserScheduledDeletionColumnSequence,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnDeletionDate = Column{
+		name:  projection.UserScheduledDeletionColumnDeletionDate,
+		table: userScheduledDeletionTable,
+	}
+	UserScheduledDeletionColumnReminded = Column{
+		name:  projection.UserScheduledDeletionColumnReminded,
+		table: userScheduledDeletionTable,
+	}
+)
+
+// SearchUserDataExports returns the exports of user data matching the queries
+func (q *Queries) SearchUserDataExports(ctx context.Context, queries *UserDataExportSearchQueries) (exports *UserDataExports, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserDataExportsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserDataExportColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Dp2aAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		exports, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	exports.State, err = q.latestState(ctx, userDataExportTable)
+	return exports, err
+}
+
+func (q *UserDataExportSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewUserDataExportUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserDataExportColumnUserID, value, TextEquals)
+}
+
+func NewUserDataExportResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserDataExportColumnResourceOwner, value, TextEquals)
+}
+
+func NewUserDataExportStateSearchQuery(value domain.UserDataExportState) (SearchQuery, error) {
+	return NewNumberQuery(UserDataExportColumnState, value, NumberEquals)
+}
+
+func prepareUserDataExportsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserDataExports, error)) {
+	return sq.Select(
+			UserDataExportColumnID.identifier(),
+			UserDataExportColumnUserID.identifier(),
+			UserDataExportColumnResourceOwner.identifier(),
+			UserDataExportColumnCreationDate.identifier(),
+			UserDataExportColumnChangeDate.identifier(),
+			UserDataExportColumnSequence.identifier(),
+			UserDataExportColumnState.identifier(),
+			countColumn.identifier(),
+		).
+			From(userDataExportTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserDataExports, error) {
+			exports := make([]*UserDataExport, 0)
+			var count uint64
+			for rows.Next() {
+				export := new(UserDataExport)
+				err := rows.Scan(
+					&export.ID,
+					&export.UserID,
+					&export.ResourceOwner,
+					&export.CreationDate,
+					&export.ChangeDate,
+					&export.Sequence,
+					&export.State,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				exports = append(exports, export)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dp2bBb", "Errors.Query.CloseRows")
+			}
+
+			return &UserDataExports{
+				Exports: exports,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+// ScheduledUserDeletionByUserID returns the scheduled deletion of the user, the resource owner is ignored if empty.
+func (q *Queries) ScheduledUserDeletionByUserID(ctx context.Context, userID, resourceOwner string) (deletion *ScheduledUserDeletion, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		UserScheduledDeletionColumnUserID.identifier():     userID,
+		UserScheduledDeletionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[UserScheduledDeletionColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareScheduledUserDeletionQuery(ctx, q.client)
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Dl2aAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		deletion, err = scan(row)
+		return err
+	}, stmt, args...)
+	return deletion, err
+}
+
+// SearchScheduledUserDeletions returns the scheduled deletions of users matching the queries
+func (q *Queries) SearchScheduledUserDeletions(ctx context.Context, queries *ScheduledUserDeletionSearchQueries) (deletions *ScheduledUserDeletions, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareScheduledUserDeletionsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserScheduledDeletionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Dl2bBb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		deletions, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	deletions.State, err = q.latestState(ctx, userScheduledDeletionTable)
+	return deletions, err
+}
+
+func (q *ScheduledUserDeletionSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// NewScheduledUserDeletionDeletionDateBeforeSearchQuery returns the deletions, which are due at the provided time.
+func NewScheduledUserDeletionDeletionDateBeforeSearchQuery(value time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(UserScheduledDeletionColumnDeletionDate, value, TimestampLessOrEquals)
+}
+
+func NewScheduledUserDeletionRemindedSearchQuery(value bool) (SearchQuery, error) {
+	return NewBoolQuery(UserScheduledDeletionColumnReminded, value)
+}
+
+func scheduledUserDeletionColumns() []string {
+	return []string{
+		UserScheduledDeletionColumnUserID.identifier(),
+		UserScheduledDeletionColumnResourceOwner.identifier(),
+		UserScheduledDeletionColumnCreationDate.identifier(),
+		UserScheduledDeletionColumnChangeDate.identifier(),
+		UserScheduledDeletionColumnSequence.identifier(),
+		UserScheduledDeletionColumnDeletionDate.identifier(),
+		UserScheduledDeletionColumnReminded.identifier(),
+	}
+}
+
+type scheduledUserDeletionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanScheduledUserDeletion(scanner scheduledUserDeletionScanner, additional ...any) (*ScheduledUserDeletion, error) {
+	deletion := new(ScheduledUserDeletion)
+	err := scanner.Scan(append([]any{
+		&deletion.UserID,
+		&deletion.ResourceOwner,
+		&deletion.CreationDate,
+		&deletion.ChangeDate,
+		&deletion.Sequence,
+		&deletion.DeletionDate,
+		&deletion.Reminded,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+func prepareScheduledUserDeletionQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*ScheduledUserDeletion, error)) {
+	return sq.Select(scheduledUserDeletionColumns()...).
+			From(userScheduledDeletionTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ScheduledUserDeletion, error) {
+			deletion, err := scanScheduledUserDeletion(row)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Dl2cCc", "Errors.User.Deletion.NotScheduled")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dl2dDd", "Errors.Internal")
+			}
+			return deletion, nil
+		}
+}
+
+func prepareScheduledUserDeletionsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ScheduledUserDeletions, error)) {
+	return sq.Select(append(scheduledUserDeletionColumns(), countColumn.identifier())...).
+			From(userScheduledDeletionTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ScheduledUserDeletions, error) {
+			deletions := make([]*ScheduledUserDeletion, 0)
+			var count uint64
+			for rows.Next() {
+				deletion, err := scanScheduledUserDeletion(rows, &count)
+				if err != nil {
+					return nil, err
+				}
+				deletions = append(deletions, deletion)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dl2eEe", "Errors.Query.CloseRows")
+			}
+
+			return &ScheduledUserDeletions{
+				Deletions: deletions,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+// UserDataArchive contains all data stored about a user, which is exported on the request of the user.
+type UserDataArchive struct {
+	ExportedAt  time.Time                    `json:"exported_at"`
+	User        *User                        `json:"user"`
+	Metadata    []*UserMetadata              `json:"metadata"`
+	Grants      []*UserGrant                 `json:"grants"`
+	IDPLinks    []*UserDataArchiveIDPLink    `json:"idp_links"`
+	AuthMethods []*UserDataArchiveAuthMethod `json:"auth_methods"`
+	Sessions    []*UserDataArchiveSession    `json:"sessions"`
+	Events      []*UserDataArchiveEvent      `json:"events"`
+}
+
+type UserDataArchiveIDPLink struct {
+	IDPID            string         `json:"idp_id"`
+	IDPName          string         `json:"idp_name"`
+	IDPType          domain.IDPType `json:"idp_type"`
+	ProvidedUserID   string         `json:"provided_user_id"`
+	ProvidedUsername string         `json:"provided_username"`
+}
+
+type UserDataArchiveAuthMethod struct {
+	Type         domain.UserAuthMethodType `json:"type"`
+	Name         string                    `json:"name,omitempty"`
+	State        domain.MFAState           `json:"state"`
+	CreationDate time.Time                 `json:"creation_date"`
+}
+
+type UserDataArchiveSession struct {
+	ID           string           `json:"id"`
+	CreationDate time.Time        `json:"creation_date"`
+	ChangeDate   time.Time        `json:"change_date"`
+	Expiration   time.Time        `json:"expiration,omitempty"`
+	UserAgent    domain.UserAgent `json:"user_agent"`
+}
+
+// UserDataArchiveEvent is an event of the user, the payload is not exported as it might contain secrets.
+type UserDataArchiveEvent struct {
+	Type         string    `json:"type"`
+	CreationDate time.Time `json:"creation_date"`
+	Sequence     uint64    `json:"sequence"`
+	EditorUserID string    `json:"editor_user_id"`
+}
+
+// UserDataArchive collects all data of the user for the export requested by the user.
+// Secrets of the user, like the hashed secret of machine users, are not part of the archive.
+func (q *Queries) UserDataArchive(ctx context.Context, userID string) (archive *UserDataArchive, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	archive = &UserDataArchive{
+		ExportedAt: time.Now(),
+	}
+	if archive.User, err = q.GetUserByID(ctx, false, userID); err != nil {
+		return nil, err
+	}
+	if archive.User.Machine != nil {
+		archive.User.Machine.EncodedSecret = ""
+	}
+	if archive.Metadata, err = q.userDataArchiveMetadata(ctx, userID); err != nil {
+		return nil, err
+	}
+	if archive.Grants, err = q.userDataArchiveGrants(ctx, userID); err != nil {
+		return nil, err
+	}
+	if archive.IDPLinks, err = q.userDataArchiveIDPLinks(ctx, userID); err != nil {
+		return nil, err
+	}
+	if archive.AuthMethods, err = q.userDataArchiveAuthMethods(ctx, userID); err != nil {
+		return nil, err
+	}
+	if archive.Sessions, err = q.userDataArchiveSessions(ctx, userID); err != nil {
+		return nil, err
+	}
+	if archive.Events, err = q.userDataArchiveEvents(ctx, userID); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+func (q *Queries) userDataArchiveMetadata(ctx context.Context, userID string) ([]*UserMetadata, error) {
+	metadata, err := q.SearchUserMetadata(ctx, false, userID, &UserMetadataSearchQueries{}, false)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.Metadata, nil
+}
+
+func (q *Queries) userDataArchiveGrants(ctx context.Context, userID string) ([]*UserGrant, error) {
+	userIDQuery, err := NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := q.UserGrants(ctx, &UserGrantsQueries{Queries: []SearchQuery{userIDQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+	return grants.UserGrants, nil
+}
+
+func (q *Queries) userDataArchiveIDPLinks(ctx context.Context, userID string) ([]*UserDataArchiveIDPLink, error) {
+	userIDQuery, err := NewIDPUserLinksUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := q.IDPUserLinks(ctx, &IDPUserLinksSearchQuery{Queries: []SearchQuery{userIDQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archived := make([]*UserDataArchiveIDPLink, len(links.Links))
+	for i, link := range links.Links {
+		archived[i] = &UserDataArchiveIDPLink{
+			IDPID:            link.IDPID,
+			IDPName:          link.IDPName,
+			IDPType:          link.IDPType,
+			ProvidedUserID:   link.ProvidedUserID,
+			ProvidedUsername: link.ProvidedUsername,
+		}
+	}
+	return archived, nil
+}
+
+func (q *Queries) userDataArchiveAuthMethods(ctx context.Context, userID string) ([]*UserDataArchiveAuthMethod, error) {
+	userIDQuery, err := NewUserAuthMethodUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := q.SearchUserAuthMethods(ctx, &UserAuthMethodSearchQueries{Queries: []SearchQuery{userIDQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archived := make([]*UserDataArchiveAuthMethod, len(methods.AuthMethods))
+	for i, method := range methods.AuthMethods {
+		archived[i] = &UserDataArchiveAuthMethod{
+			Type:         method.Type,
+			Name:         method.Name,
+			State:        method.State,
+			CreationDate: method.CreationDate,
+		}
+	}
+	return archived, nil
+}
+
+func (q *Queries) userDataArchiveSessions(ctx context.Context, userID string) ([]*UserDataArchiveSession, error) {
+	userIDQuery, err := NewUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := q.SearchSessions(ctx, &SessionsSearchQueries{Queries: []SearchQuery{userIDQuery}})
+	if err != nil {
+		return nil, err
+	}
+	archived := make([]*UserDataArchiveSession, len(sessions.Sessions))
+	for i, session := range sessions.Sessions {
+		archived[i] = &UserDataArchiveSession{
+			ID:           session.ID,
+			CreationDate: session.CreationDate,
+			ChangeDate:   session.ChangeDate,
+			Expiration:   session.Expiration,
+			UserAgent:    session.UserAgent,
+		}
+	}
+	return archived, nil
+}
+
+func (q *Queries) userDataArchiveEvents(ctx context.Context, userID string) ([]*UserDataArchiveEvent, error) {
+	events, err := q.SearchEvents(ctx, eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(authz.GetInstance(ctx).InstanceID()).
+		OrderAsc().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(userID).
+		Builder(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	archived := make([]*UserDataArchiveEvent, len(events))
+	for i, event := range events {
+		archived[i] = &UserDataArchiveEvent{
+			Type:         event.Type,
+			CreationDate: event.CreationDate,
+			Sequence:     event.Sequence,
+			EditorUserID: event.Editor.ID,
+		}
+	}
+	return archived, nil
+}
@@ -0,0 +1,241 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	userDataExportsQuery = `SELECT projections.user_data_exports.id,` +
+		` projections.user_data_exports.user_id,` +
+		` projections.user_data_exports.resource_owner,` +
+		` projections.user_data_exports.creation_date,` +
+		` projections.user_data_exports.change_date,` +
+		` projections.user_data_exports.sequence,` +
+		` projections.user_data_exports.state,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_data_exports AS OF SYSTEM TIME '-1 ms'`
+	userDataExportsCols = []string{
+		"id",
+		"user_id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"count",
+	}
+	scheduledUserDeletionSelect = `SELECT projections.user_scheduled_deletions.user_id,` +
+		` projections.user_scheduled_deletions.resource_owner,` +
+		` projections.user_scheduled_deletions.creation_date,` +
+		` projections.user_scheduled_deletions.change_date,` +
+		` projections.user_scheduled_deletions.sequence,` +
+		` projections.user_scheduled_deletions.deletion_date,` +
+		` projections.user_scheduled_deletions.reminded`
+	scheduledUserDeletionQuery = scheduledUserDeletionSelect +
+		` FROM projections.user_scheduled_deletions AS OF SYSTEM TIME '-1 ms'`
+	scheduledUserDeletionsQuery = scheduledUserDeletionSelect +
+		`, COUNT(*) OVER ()` +
+		` FROM projections.user_scheduled_deletions AS OF SYSTEM TIME '-1 ms'`
+	scheduledUserDeletionCols = []string{
+		"user_id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"deletion_date",
+		"reminded",
+	}
+	scheduledUserDeletionsCols = append(scheduledUserDeletionCols, "count")
+)
+
+func Test_UserDataPrivacyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserDataExportsQuery no result",
+			prepare: prepareUserDataExportsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDataExportsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &UserDataExports{Exports: []*UserDataExport{}},
+		},
+		{
+			name:    "prepareUserDataExportsQuery one result",
+			prepare: prepareUserDataExportsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDataExportsQuery),
+					userDataExportsCols,
+					[][]driver.Value{
+						{
+							"export-id",
+							"user-id",
+							"org-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							domain.UserDataExportStateReady,
+						},
+					},
+				),
+			},
+			object: &UserDataExports{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Exports: []*UserDataExport{
+					{
+						ID:            "export-id",
+						UserID:        "user-id",
+						ResourceOwner: "org-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						State:         domain.UserDataExportStateReady,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserDataExportsQuery sql err",
+			prepare: prepareUserDataExportsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userDataExportsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserDataExports)(nil),
+		},
+		{
+			name:    "prepareScheduledUserDeletionQuery no result",
+			prepare: prepareScheduledUserDeletionQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(scheduledUserDeletionQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ScheduledUserDeletion)(nil),
+		},
+		{
+			name:    "prepareScheduledUserDeletionQuery found",
+			prepare: prepareScheduledUserDeletionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(scheduledUserDeletionQuery),
+					scheduledUserDeletionCols,
+					[]driver.Value{
+						"user-id",
+						"org-id",
+						testNow,
+						testNow,
+						uint64(20211108),
+						testNow,
+						true,
+					},
+				),
+			},
+			object: &ScheduledUserDeletion{
+				UserID:        "user-id",
+				ResourceOwner: "org-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211108,
+				DeletionDate:  testNow,
+				Reminded:      true,
+			},
+		},
+		{
+			name:    "prepareScheduledUserDeletionsQuery one result",
+			prepare: prepareScheduledUserDeletionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(scheduledUserDeletionsQuery),
+					scheduledUserDeletionsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"org-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							testNow,
+							false,
+						},
+					},
+				),
+			},
+			object: &ScheduledUserDeletions{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Deletions: []*ScheduledUserDeletion{
+					{
+						UserID:        "user-id",
+						ResourceOwner: "org-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						DeletionDate:  testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareScheduledUserDeletionsQuery sql err",
+			prepare: prepareScheduledUserDeletionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(scheduledUserDeletionsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ScheduledUserDeletions)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
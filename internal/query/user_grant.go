@@ -29,6 +29,9 @@ type UserGrant struct {
 	// GrantID represents the project grant id
 	GrantID string                `json:"grant_id,omitempty"`
 	State   domain.UserGrantState `json:"state,omitempty"`
+	// ValidFrom and ValidUntil restrict the grant to a period of time, zero values mean unrestricted
+	ValidFrom  time.Time `json:"valid_from,omitempty"`
+	ValidUntil time.Time `json:"valid_until,omitempty"`
 
 	UserID             string          `json:"user_id,omitempty"`
 	Username           string          `json:"username,omitempty"`
@@ -147,6 +150,36 @@ func NewUserGrantStateQuery(value domain.UserGrantState) (SearchQuery, error) {
 	return NewNumberQuery(UserGrantState, value, NumberEquals)
 }
 
+// NewUserGrantValidAtQuery returns the user grants which are not restricted
+// or whose validity contains the provided point in time.
+func NewUserGrantValidAtQuery(t time.Time) (SearchQuery, error) {
+	fromNotSet, err := NewIsNullQuery(UserGrantValidFrom)
+	if err != nil {
+		return nil, err
+	}
+	fromReached, err := NewTimestampQuery(UserGrantValidFrom, t, TimestampLessOrEquals)
+	if err != nil {
+		return nil, err
+	}
+	started, err := NewOrQuery(fromNotSet, fromReached)
+	if err != nil {
+		return nil, err
+	}
+	untilNotSet, err := NewIsNullQuery(UserGrantValidUntil)
+	if err != nil {
+		return nil, err
+	}
+	untilNotReached, err := NewTimestampQuery(UserGrantValidUntil, t, TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	notEnded, err := NewOrQuery(untilNotSet, untilNotReached)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(started, notEnded)
+}
+
 func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	orgQuery, err := NewUserGrantResourceOwnerSearchQuery(owner)
 	if err != nil {
@@ -216,6 +249,14 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
 	GrantedOrgsTable = table{
 		name:          projection.OrgProjectionTable,
 		alias:         "granted_orgs",
@@ -307,6 +348,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -344,6 +387,9 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 			g := new(UserGrant)
 
 			var (
+				validFrom  sql.NullTime
+				validUntil sql.NullTime
+
 				username           sql.NullString
 				firstName          sql.NullString
 				userType           sql.NullInt32
@@ -372,6 +418,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				&g.GrantID,
 				&g.Roles,
 				&g.State,
+				&validFrom,
+				&validUntil,
 
 				&g.UserID,
 				&username,
@@ -402,6 +450,8 @@ func prepareUserGrantQuery(ctx context.Context, db prepareDatabase) (sq.SelectBu
 				return nil, zerrors.ThrowInternal(err, "QUERY-oQPcP", "Errors.Internal")
 			}
 
+			g.ValidFrom = validFrom.Time
+			g.ValidUntil = validUntil.Time
 			g.Username = username.String
 			g.UserType = domain.UserType(userType.Int32)
 			g.UserResourceOwner = userOwner.String
@@ -430,6 +480,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 			UserGrantGrantID.identifier(),
 			UserGrantRoles.identifier(),
 			UserGrantState.identifier(),
+			UserGrantValidFrom.identifier(),
+			UserGrantValidUntil.identifier(),
 
 			UserGrantUserID.identifier(),
 			UserUsernameCol.identifier(),
@@ -472,6 +524,9 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 				g := new(UserGrant)
 
 				var (
+					validFrom  sql.NullTime
+					validUntil sql.NullTime
+
 					username           sql.NullString
 					userType           sql.NullInt32
 					userOwner          sql.NullString
@@ -500,6 +555,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					&g.GrantID,
 					&g.Roles,
 					&g.State,
+					&validFrom,
+					&validUntil,
 
 					&g.UserID,
 					&username,
@@ -529,6 +586,8 @@ func prepareUserGrantsQuery(ctx context.Context, db prepareDatabase) (sq.SelectB
 					return nil, err
 				}
 
+				g.ValidFrom = validFrom.Time
+				g.ValidUntil = validUntil.Time
 				g.Username = username.String
 				g.UserType = domain.UserType(userType.Int32)
 				g.UserResourceOwner = userOwner.String
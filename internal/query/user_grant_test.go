@@ -22,6 +22,8 @@ var (
 			", projections.user_grants5.grant_id" +
 			", projections.user_grants5.roles" +
 			", projections.user_grants5.state" +
+			", projections.user_grants5.valid_from" +
+			", projections.user_grants5.valid_until" +
 			", projections.user_grants5.user_id" +
 			", projections.users13.username" +
 			", projections.users13.type" +
@@ -57,6 +59,8 @@ var (
 		"grant_id",
 		"roles",
 		"state",
+		"valid_from",
+		"valid_until",
 		"user_id",
 		"username",
 		"type",
@@ -84,6 +88,8 @@ var (
 			", projections.user_grants5.grant_id" +
 			", projections.user_grants5.roles" +
 			", projections.user_grants5.state" +
+			", projections.user_grants5.valid_from" +
+			", projections.user_grants5.valid_until" +
 			", projections.user_grants5.user_id" +
 			", projections.users13.username" +
 			", projections.users13.type" +
@@ -162,6 +168,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -226,6 +234,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeMachine,
@@ -290,6 +300,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -354,6 +366,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -418,6 +432,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 						"grant-id",
 						database.TextArray[string]{"role-key"},
 						domain.UserGrantStateActive,
+						nil,
+						nil,
 						"user-id",
 						"username",
 						domain.UserTypeHuman,
@@ -512,6 +528,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -585,6 +603,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -658,6 +678,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeMachine,
@@ -731,6 +753,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -804,6 +828,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -877,6 +903,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
@@ -904,6 +932,8 @@ func Test_UserGrantPrepares(t *testing.T) {
 							"grant-id",
 							database.TextArray[string]{"role-key"},
 							domain.UserGrantStateActive,
+							nil,
+							nil,
 							"user-id",
 							"username",
 							domain.UserTypeHuman,
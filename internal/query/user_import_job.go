@@ -0,0 +1,388 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserImportJobs struct {
+	SearchResponse
+	Jobs []*UserImportJob
+}
+
+type UserImportJob struct {
+	ID            string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	State         domain.UserImportJobState
+	Creator       string
+	Format        domain.UserImportFormat
+	DryRun        bool
+	TotalRows     uint64
+	ProcessedRows uint64
+	FailedRows    uint64
+	// FailureReason is only set if the job failed as a whole
+	FailureReason string
+}
+
+type UserImportJobSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+type UserImportJobErrors struct {
+	SearchResponse
+	Errors []*UserImportJobError
+}
+
+// UserImportJobError describes why a row of the job could not be imported.
+type UserImportJobError struct {
+	JobID   string
+	Row     uint64
+	UserID  string
+	Message string
+}
+
+type UserImportJobErrorSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	userImportJobTable = table{
+		name:          projection.UserImportJobProjectionTable,
+		instanceIDCol: projection.UserImportJobColumnInstanceID,
+	}
+	UserImportJobColumnID = Column{
+		name:  projection.UserImportJobColumnID,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnInstanceID = Column{
+		name:  projection.UserImportJobColumnInstanceID,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnResourceOwner = Column{
+		name:  projection.UserImportJobColumnResourceOwner,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnCreationDate = Column{
+		name:  projection.UserImportJobColumnCreationDate,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnChangeDate = Column{
+		name:  projection.UserImportJobColumnChangeDate,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnSequence = Column{
+		name:  projection.UserImportJobColumnSequence,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnState = Column{
+		name:  projection.UserImportJobColumnState,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnCreator = Column{
+		name:  projection.UserImportJobColumnCreator,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnFormat = Column{
+		name:  projection.UserImportJobColumnFormat,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnDryRun = Column{
+		name:  projection.UserImportJobColumnDryRun,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnTotalRows = Column{
+		name:  projection.UserImportJobColumnTotalRows,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnProcessedRows = Column{
+		name:  projection.UserImportJobColumnProcessedRows,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnFailedRows = Column{
+		name:  projection.UserImportJobColumnFailedRows,
+		table: userImportJobTable,
+	}
+	UserImportJobColumnFailureReason = Column{
+		name:  projection.UserImportJobColumnFailureReason,
+		table: userImportJobTable,
+	}
+)
+
+var (
+	userImportJobErrorTable = table{
+		name:          projection.UserImportJobErrorTable,
+		instanceIDCol: projection.UserImportJobErrorColumnInstanceID,
+	}
+	UserImportJobErrorColumnInstanceID = Column{
+		name:  projection.UserImportJobErrorColumnInstanceID,
+		table: userImportJobErrorTable,
+	}
+	UserImportJobErrorColumnJobID = Column{
+		name:  projection.UserImportJobErrorColumnJobID,
+		table: userImportJobErrorTable,
+	}
+	UserImportJobErrorColumnRow = Column{
+		name:  projection.UserImportJobErrorColumnRow,
+		table: userImportJobErrorTable,
+	}
+	UserImportJobErrorColumnUserID = Column{
+		name:  projection.UserImportJobErrorColumnUserID,
+		table: userImportJobErrorTable,
+	}
+	UserImportJobErrorColumnMessage = Column{
+		name:  projection.UserImportJobErrorColumnMessage,
+		table: userImportJobErrorTable,
+	}
+)
+
+// UserImportJobByID returns the import job, the resource owner is ignored if empty.
+func (q *Queries) UserImportJobByID(ctx context.Context, id, resourceOwner string) (job *UserImportJob, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		UserImportJobColumnID.identifier():         id,
+		UserImportJobColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	if resourceOwner != "" {
+		eq[UserImportJobColumnResourceOwner.identifier()] = resourceOwner
+	}
+	query, scan := prepareUserImportJobQuery(ctx, q.client)
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ui4aAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		job, err = scan(row)
+		return err
+	}, stmt, args...)
+	return job, err
+}
+
+// SearchUserImportJobs returns the import jobs matching the queries
+func (q *Queries) SearchUserImportJobs(ctx context.Context, queries *UserImportJobSearchQueries) (jobs *UserImportJobs, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserImportJobsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserImportJobColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ui4bBb", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		jobs, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	jobs.State, err = q.latestState(ctx, userImportJobTable)
+	return jobs, err
+}
+
+// SearchUserImportJobErrors returns the errors of the rows of the import job matching the queries
+func (q *Queries) SearchUserImportJobErrors(ctx context.Context, jobID string, queries *UserImportJobErrorSearchQueries) (rowErrors *UserImportJobErrors, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareUserImportJobErrorsQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserImportJobErrorColumnJobID.identifier():      jobID,
+		UserImportJobErrorColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ui4cCc", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		rowErrors, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	rowErrors.State, err = q.latestState(ctx, userImportJobTable)
+	return rowErrors, err
+}
+
+func (q *UserImportJobSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *UserImportJobErrorSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewUserImportJobResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserImportJobColumnResourceOwner, value, TextEquals)
+}
+
+func NewUserImportJobStateSearchQuery(value domain.UserImportJobState) (SearchQuery, error) {
+	return NewNumberQuery(UserImportJobColumnState, value, NumberEquals)
+}
+
+// NewUserImportJobChangeDateBeforeSearchQuery returns the jobs, which were not changed since the point in time,
+// e.g. running jobs, whose worker stopped.
+func NewUserImportJobChangeDateBeforeSearchQuery(value time.Time) (SearchQuery, error) {
+	return NewTimestampQuery(UserImportJobColumnChangeDate, value, TimestampLessOrEquals)
+}
+
+func userImportJobColumns() []string {
+	return []string{
+		UserImportJobColumnID.identifier(),
+		UserImportJobColumnResourceOwner.identifier(),
+		UserImportJobColumnCreationDate.identifier(),
+		UserImportJobColumnChangeDate.identifier(),
+		UserImportJobColumnSequence.identifier(),
+		UserImportJobColumnState.identifier(),
+		UserImportJobColumnCreator.identifier(),
+		UserImportJobColumnFormat.identifier(),
+		UserImportJobColumnDryRun.identifier(),
+		UserImportJobColumnTotalRows.identifier(),
+		UserImportJobColumnProcessedRows.identifier(),
+		UserImportJobColumnFailedRows.identifier(),
+		UserImportJobColumnFailureReason.identifier(),
+	}
+}
+
+type userImportJobScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUserImportJob(scanner userImportJobScanner, additional ...any) (*UserImportJob, error) {
+	job := new(UserImportJob)
+	err := scanner.Scan(append([]any{
+		&job.ID,
+		&job.ResourceOwner,
+		&job.CreationDate,
+		&job.ChangeDate,
+		&job.Sequence,
+		&job.State,
+		&job.Creator,
+		&job.Format,
+		&job.DryRun,
+		&job.TotalRows,
+		&job.ProcessedRows,
+		&job.FailedRows,
+		&job.FailureReason,
+	}, additional...)...)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func prepareUserImportJobQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*UserImportJob, error)) {
+	return sq.Select(userImportJobColumns()...).
+			From(userImportJobTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*UserImportJob, error) {
+			job, err := scanUserImportJob(row)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ui4dDd", "Errors.UserImport.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ui4eEe", "Errors.Internal")
+			}
+			return job, nil
+		}
+}
+
+func prepareUserImportJobsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserImportJobs, error)) {
+	return sq.Select(append(userImportJobColumns(), countColumn.identifier())...).
+			From(userImportJobTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserImportJobs, error) {
+			jobs := make([]*UserImportJob, 0)
+			var count uint64
+			for rows.Next() {
+				job, err := scanUserImportJob(rows, &count)
+				if err != nil {
+					return nil, err
+				}
+				jobs = append(jobs, job)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ui4fFf", "Errors.Query.CloseRows")
+			}
+
+			return &UserImportJobs{
+				Jobs: jobs,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareUserImportJobErrorsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*UserImportJobErrors, error)) {
+	return sq.Select(
+			UserImportJobErrorColumnJobID.identifier(),
+			UserImportJobErrorColumnRow.identifier(),
+			UserImportJobErrorColumnUserID.identifier(),
+			UserImportJobErrorColumnMessage.identifier(),
+			countColumn.identifier(),
+		).
+			From(userImportJobErrorTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserImportJobErrors, error) {
+			rowErrors := make([]*UserImportJobError, 0)
+			var count uint64
+			for rows.Next() {
+				rowError := new(UserImportJobError)
+				err := rows.Scan(
+					&rowError.JobID,
+					&rowError.Row,
+					&rowError.UserID,
+					&rowError.Message,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				rowErrors = append(rowErrors, rowError)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ui4gGg", "Errors.Query.CloseRows")
+			}
+
+			return &UserImportJobErrors{
+				Errors: rowErrors,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,262 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	userImportJobSelect = `SELECT projections.user_import_jobs.id,` +
+		` projections.user_import_jobs.resource_owner,` +
+		` projections.user_import_jobs.creation_date,` +
+		` projections.user_import_jobs.change_date,` +
+		` projections.user_import_jobs.sequence,` +
+		` projections.user_import_jobs.state,` +
+		` projections.user_import_jobs.creator,` +
+		` projections.user_import_jobs.format,` +
+		` projections.user_import_jobs.dry_run,` +
+		` projections.user_import_jobs.total_rows,` +
+		` projections.user_import_jobs.processed_rows,` +
+		` projections.user_import_jobs.failed_rows,` +
+		` projections.user_import_jobs.failure_reason`
+	userImportJobQuery = userImportJobSelect +
+		` FROM projections.user_import_jobs AS OF SYSTEM TIME '-1 ms'`
+	userImportJobsQuery = userImportJobSelect +
+		`, COUNT(*) OVER ()` +
+		` FROM projections.user_import_jobs AS OF SYSTEM TIME '-1 ms'`
+	userImportJobCols = []string{
+		"id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"creator",
+		"format",
+		"dry_run",
+		"total_rows",
+		"processed_rows",
+		"failed_rows",
+		"failure_reason",
+	}
+	userImportJobsCols = append(userImportJobCols, "count")
+
+	userImportJobErrorsQuery = `SELECT projections.user_import_jobs_errors.job_id,` +
+		` projections.user_import_jobs_errors.row,` +
+		` projections.user_import_jobs_errors.user_id,` +
+		` projections.user_import_jobs_errors.message,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_import_jobs_errors AS OF SYSTEM TIME '-1 ms'`
+	userImportJobErrorsCols = []string{
+		"job_id",
+		"row",
+		"user_id",
+		"message",
+		"count",
+	}
+)
+
+func Test_UserImportJobPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserImportJobQuery no result",
+			prepare: prepareUserImportJobQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(userImportJobQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserImportJob)(nil),
+		},
+		{
+			name:    "prepareUserImportJobQuery found",
+			prepare: prepareUserImportJobQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(userImportJobQuery),
+					userImportJobCols,
+					[]driver.Value{
+						"job-id",
+						"org-id",
+						testNow,
+						testNow,
+						uint64(20211108),
+						domain.UserImportJobStateRunning,
+						"creator-id",
+						domain.UserImportFormatCSV,
+						false,
+						uint64(50000),
+						uint64(1000),
+						uint64(2),
+						"",
+					},
+				),
+			},
+			object: &UserImportJob{
+				ID:            "job-id",
+				ResourceOwner: "org-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211108,
+				State:         domain.UserImportJobStateRunning,
+				Creator:       "creator-id",
+				Format:        domain.UserImportFormatCSV,
+				TotalRows:     50000,
+				ProcessedRows: 1000,
+				FailedRows:    2,
+			},
+		},
+		{
+			name:    "prepareUserImportJobsQuery found",
+			prepare: prepareUserImportJobsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userImportJobsQuery),
+					userImportJobsCols,
+					[][]driver.Value{
+						{
+							"job-id",
+							"org-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							domain.UserImportJobStateFailed,
+							"creator-id",
+							domain.UserImportFormatNDJSON,
+							true,
+							uint64(10),
+							uint64(0),
+							uint64(0),
+							"Errors.Assets.Object.GetFailed",
+						},
+					},
+				),
+			},
+			object: &UserImportJobs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Jobs: []*UserImportJob{
+					{
+						ID:            "job-id",
+						ResourceOwner: "org-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						State:         domain.UserImportJobStateFailed,
+						Creator:       "creator-id",
+						Format:        domain.UserImportFormatNDJSON,
+						DryRun:        true,
+						TotalRows:     10,
+						FailureReason: "Errors.Assets.Object.GetFailed",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserImportJobsQuery sql err",
+			prepare: prepareUserImportJobsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userImportJobsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserImportJobs)(nil),
+		},
+		{
+			name:    "prepareUserImportJobErrorsQuery found",
+			prepare: prepareUserImportJobErrorsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userImportJobErrorsQuery),
+					userImportJobErrorsCols,
+					[][]driver.Value{
+						{
+							"job-id",
+							uint64(3),
+							"",
+							"Errors.User.Profile.FirstNameEmpty",
+						},
+						{
+							"job-id",
+							uint64(7),
+							"user-id",
+							"Errors.User.NotFound",
+						},
+					},
+				),
+			},
+			object: &UserImportJobErrors{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Errors: []*UserImportJobError{
+					{
+						JobID:   "job-id",
+						Row:     3,
+						Message: "Errors.User.Profile.FirstNameEmpty",
+					},
+					{
+						JobID:   "job-id",
+						Row:     7,
+						UserID:  "user-id",
+						Message: "Errors.User.NotFound",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserImportJobErrorsQuery sql err",
+			prepare: prepareUserImportJobErrorsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userImportJobErrorsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserImportJobErrors)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type InactiveUsers struct {
+	SearchResponse
+	InactiveUsers []*InactiveUser
+}
+
+// InactiveUser is a user, for which an action of the inactivity policy is due
+// or who has to be warned about it.
+type InactiveUser struct {
+	UserID        string
+	ResourceOwner string
+	Username      string
+	Type          domain.UserType
+	State         domain.UserState
+	LastActivity  time.Time
+	Action        domain.InactivityAction
+	DueDate       time.Time
+	// Warning is set if the action is not due yet
+	Warning bool
+	// Notified is set if the user was already warned about the action
+	Notified bool
+}
+
+type InactiveUserSearchQueries struct {
+	SearchRequest
+	// ResourceOwner restricts the search to the users of the organization, all users of the instance are searched if empty
+	ResourceOwner string
+}
+
+var (
+	userActivityTable = table{
+		name:          projection.UserActivityProjectionTable,
+		instanceIDCol: projection.UserActivityColumnInstanceID,
+	}
+	UserActivityColInstanceID = Column{
+		name:  projection.UserActivityColumnInstanceID,
+		table: userActivityTable,
+	}
+	UserActivityColUserID = Column{
+		name:  projection.UserActivityColumnUserID,
+		table: userActivityTable,
+	}
+	UserActivityColResourceOwner = Column{
+		name:  projection.UserActivityColumnResourceOwner,
+		table: userActivityTable,
+	}
+	UserActivityColLastActivity = Column{
+		name:  projection.UserActivityColumnLastActivity,
+		table: userActivityTable,
+	}
+	UserActivityColNotifiedAction = Column{
+		name:  projection.UserActivityColumnNotifiedAction,
+		table: userActivityTable,
+	}
+)
+
+// SearchInactiveUsers returns the users, for which an action of the inactivity policy
+// of their organization (or its closest ancestor or the instance) is due or who have to be warned about it.
+// Machine users with a valid personal access token are never returned, as the usage of the token is not recorded.
+// The count of the response is the number of users, which have been inactive long enough to be evaluated.
+// The permission has to be checked by the caller.
+func (q *Queries) SearchInactiveUsers(ctx context.Context, queries *InactiveUserSearchQueries) (users *InactiveUsers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	policies, err := q.inactivityPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minAfterDays := inactivityMinAfterDays(policies)
+	if minAfterDays == 0 {
+		users = &InactiveUsers{InactiveUsers: []*InactiveUser{}}
+		users.State, err = q.latestState(ctx, userActivityTable)
+		return users, err
+	}
+	now := time.Now()
+
+	eq := sq.Eq{UserActivityColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if queries.ResourceOwner != "" {
+		eq[UserActivityColResourceOwner.identifier()] = queries.ResourceOwner
+	}
+	query, scan := prepareInactiveUsersQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(sq.And{
+		eq,
+		sq.LtOrEq{UserActivityColLastActivity.identifier(): now.Add(-time.Duration(minAfterDays) * 24 * time.Hour)},
+		machineWithoutValidTokenCondition(now),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-In7aAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		users, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	users.InactiveUsers, err = q.dueInactiveUsers(ctx, policies, users.InactiveUsers, now)
+	if err != nil {
+		return nil, err
+	}
+	users.State, err = q.latestState(ctx, userActivityTable)
+	return users, err
+}
+
+func (q *InactiveUserSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	if q.SortingColumn.isZero() {
+		query = query.OrderBy(UserActivityColLastActivity.identifier())
+	}
+	return query
+}
+
+// dueInactiveUsers evaluates the effective policy of each user and only returns the ones with a due action.
+func (q *Queries) dueInactiveUsers(ctx context.Context, policies []*InactivityPolicy, candidates []*InactiveUser, now time.Time) ([]*InactiveUser, error) {
+	policiesByID := make(map[string]*domain.InactivityPolicy, len(policies))
+	for _, policy := range policies {
+		policiesByID[policy.ID] = policy.ToDomain()
+	}
+	effective := make(map[string]*domain.InactivityPolicy)
+	users := make([]*InactiveUser, 0, len(candidates))
+	for _, user := range candidates {
+		policy, ok := effective[user.ResourceOwner]
+		if !ok {
+			chain, err := q.orgPolicyChain(ctx, user.ResourceOwner)
+			if err != nil {
+				return nil, err
+			}
+			policy = effectiveInactivityPolicy(policiesByID, chain)
+			effective[user.ResourceOwner] = policy
+		}
+		due := policy.DueAction(user.Type, user.State, user.LastActivity, now)
+		if due.Action == domain.InactivityActionUnspecified {
+			continue
+		}
+		user.Notified = due.Warning && user.Action == due.Action
+		user.Action, user.DueDate, user.Warning = due.Action, due.DueDate, due.Warning
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// inactivityPolicies returns the default inactivity policy of the instance and all policies of its organizations.
+func (q *Queries) inactivityPolicies(ctx context.Context) (policies []*InactivityPolicy, err error) {
+	query, scan := prepareInactivityPoliciesQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		InactivityPolicyColInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		InactivityPolicyColOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-In7bBb", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		policies, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return policies, err
+}
+
+// inactivityMinAfterDays returns the lowest number of days of inactivity, after which any policy requires an action or a warning.
+func inactivityMinAfterDays(policies []*InactivityPolicy) uint64 {
+	var minDays uint64
+	for _, policy := range policies {
+		afterDays := policy.ToDomain().MinAfterDays()
+		if afterDays > 0 && (minDays == 0 || afterDays < minDays) {
+			minDays = afterDays
+		}
+	}
+	return minDays
+}
+
+// effectiveInactivityPolicy returns the first policy of the chain, actions are disabled if none exists.
+func effectiveInactivityPolicy(policies map[string]*domain.InactivityPolicy, chain []string) *domain.InactivityPolicy {
+	for _, id := range chain {
+		if policy, ok := policies[id]; ok {
+			return policy
+		}
+	}
+	return new(domain.InactivityPolicy)
+}
+
+// machineWithoutValidTokenCondition excludes the machine users, which have a personal access token valid at the provided time.
+func machineWithoutValidTokenCondition(now time.Time) sq.Sqlizer {
+	return sq.Expr("NOT ("+UserTypeCol.identifier()+" = ? AND EXISTS (SELECT 1 FROM "+personalAccessTokensTable.identifier()+
+		" WHERE "+PersonalAccessTokenColumnInstanceID.identifier()+" = "+UserInstanceIDCol.identifier()+
+		" AND "+PersonalAccessTokenColumnUserID.identifier()+" = "+UserIDCol.identifier()+
+		" AND "+PersonalAccessTokenColumnExpiration.identifier()+" > ?))",
+		domain.UserTypeMachine, now,
+	)
+}
+
+func prepareInactivityPoliciesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*InactivityPolicy, error)) {
+	return sq.Select(
+			InactivityPolicyColID.identifier(),
+			InactivityPolicyColSequence.identifier(),
+			InactivityPolicyColCreationDate.identifier(),
+			InactivityPolicyColChangeDate.identifier(),
+			InactivityPolicyColResourceOwner.identifier(),
+			InactivityPolicyColWarnBeforeDays.identifier(),
+			InactivityPolicyColHumanDeactivateAfterDays.identifier(),
+			InactivityPolicyColHumanDeleteAfterDays.identifier(),
+			InactivityPolicyColMachineDeactivateAfterDays.identifier(),
+			InactivityPolicyColMachineDeleteAfterDays.identifier(),
+			InactivityPolicyColIsDefault.identifier(),
+			InactivityPolicyColState.identifier(),
+		).
+			From(inactivityPolicyTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*InactivityPolicy, error) {
+			policies := make([]*InactivityPolicy, 0)
+			for rows.Next() {
+				policy := new(InactivityPolicy)
+				err := rows.Scan(
+					&policy.ID,
+					&policy.Sequence,
+					&policy.CreationDate,
+					&policy.ChangeDate,
+					&policy.ResourceOwner,
+					&policy.WarnBeforeDays,
+					&policy.HumanDeactivateAfterDays,
+					&policy.HumanDeleteAfterDays,
+					&policy.MachineDeactivateAfterDays,
+					&policy.MachineDeleteAfterDays,
+					&policy.IsDefault,
+					&policy.State,
+				)
+				if err != nil {
+					return nil, err
+				}
+				policies = append(policies, policy)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-In7cCc", "Errors.Query.CloseRows")
+			}
+			return policies, nil
+		}
+}
+
+func prepareInactiveUsersQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*InactiveUsers, error)) {
+	return sq.Select(
+			UserActivityColUserID.identifier(),
+			UserActivityColResourceOwner.identifier(),
+			UserUsernameCol.identifier(),
+			UserTypeCol.identifier(),
+			UserStateCol.identifier(),
+			UserActivityColLastActivity.identifier(),
+			UserActivityColNotifiedAction.identifier(),
+			countColumn.identifier(),
+		).
+			From(userActivityTable.identifier()).
+			Join(join(UserIDCol, UserActivityColUserID) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*InactiveUsers, error) {
+			users := make([]*InactiveUser, 0)
+			var count uint64
+			for rows.Next() {
+				user := new(InactiveUser)
+				err := rows.Scan(
+					&user.UserID,
+					&user.ResourceOwner,
+					&user.Username,
+					&user.Type,
+					&user.State,
+					&user.LastActivity,
+					// the notified action is compared to the due action after the evaluation of the policy
+					&user.Action,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				users = append(users, user)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-In7dDd", "Errors.Query.CloseRows")
+			}
+			return &InactiveUsers{
+				InactiveUsers: users,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
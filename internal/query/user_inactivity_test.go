@@ -0,0 +1,276 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareInactiveUsersStmt = `SELECT projections.user_activities.user_id,` +
+		` projections.user_activities.resource_owner,` +
+		` projections.users13.username,` +
+		` projections.users13.type,` +
+		` projections.users13.state,` +
+		` projections.user_activities.last_activity,` +
+		` projections.user_activities.notified_action,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_activities` +
+		` JOIN projections.users13 ON projections.user_activities.user_id = projections.users13.id AND projections.user_activities.instance_id = projections.users13.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareInactiveUsersCols = []string{
+		"user_id",
+		"resource_owner",
+		"username",
+		"type",
+		"state",
+		"last_activity",
+		"notified_action",
+		"count",
+	}
+	prepareInactivityPoliciesStmt = `SELECT projections.inactivity_policies.id,` +
+		` projections.inactivity_policies.sequence,` +
+		` projections.inactivity_policies.creation_date,` +
+		` projections.inactivity_policies.change_date,` +
+		` projections.inactivity_policies.resource_owner,` +
+		` projections.inactivity_policies.warn_before_days,` +
+		` projections.inactivity_policies.human_deactivate_after_days,` +
+		` projections.inactivity_policies.human_delete_after_days,` +
+		` projections.inactivity_policies.machine_deactivate_after_days,` +
+		` projections.inactivity_policies.machine_delete_after_days,` +
+		` projections.inactivity_policies.is_default,` +
+		` projections.inactivity_policies.state` +
+		` FROM projections.inactivity_policies` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	prepareInactivityPoliciesCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"warn_before_days",
+		"human_deactivate_after_days",
+		"human_delete_after_days",
+		"machine_deactivate_after_days",
+		"machine_delete_after_days",
+		"is_default",
+		"state",
+	}
+)
+
+func Test_InactiveUsersPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareInactiveUsersQuery no result",
+			prepare: prepareInactiveUsersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareInactiveUsersStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &InactiveUsers{InactiveUsers: []*InactiveUser{}},
+		},
+		{
+			name:    "prepareInactiveUsersQuery multiple results",
+			prepare: prepareInactiveUsersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareInactiveUsersStmt),
+					prepareInactiveUsersCols,
+					[][]driver.Value{
+						{
+							"user1",
+							"ro",
+							"human",
+							domain.UserTypeHuman,
+							domain.UserStateActive,
+							testNow,
+							domain.InactivityActionUnspecified,
+						},
+						{
+							"user2",
+							"ro",
+							"machine",
+							domain.UserTypeMachine,
+							domain.UserStateInactive,
+							testNow,
+							domain.InactivityActionDelete,
+						},
+					},
+				),
+			},
+			object: &InactiveUsers{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				InactiveUsers: []*InactiveUser{
+					{
+						UserID:        "user1",
+						ResourceOwner: "ro",
+						Username:      "human",
+						Type:          domain.UserTypeHuman,
+						State:         domain.UserStateActive,
+						LastActivity:  testNow,
+						Action:        domain.InactivityActionUnspecified,
+					},
+					{
+						UserID:        "user2",
+						ResourceOwner: "ro",
+						Username:      "machine",
+						Type:          domain.UserTypeMachine,
+						State:         domain.UserStateInactive,
+						LastActivity:  testNow,
+						Action:        domain.InactivityActionDelete,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareInactiveUsersQuery sql err",
+			prepare: prepareInactiveUsersQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareInactiveUsersStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InactiveUsers)(nil),
+		},
+		{
+			name:    "prepareInactivityPoliciesQuery found",
+			prepare: prepareInactivityPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareInactivityPoliciesStmt),
+					prepareInactivityPoliciesCols,
+					[][]driver.Value{
+						{
+							"instance-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							"instance-id",
+							10,
+							90,
+							365,
+							0,
+							0,
+							true,
+							domain.PolicyStateActive,
+						},
+					},
+				),
+			},
+			object: []*InactivityPolicy{
+				{
+					ID:                       "instance-id",
+					Sequence:                 20211109,
+					CreationDate:             testNow,
+					ChangeDate:               testNow,
+					ResourceOwner:            "instance-id",
+					WarnBeforeDays:           10,
+					HumanDeactivateAfterDays: 90,
+					HumanDeleteAfterDays:     365,
+					IsDefault:                true,
+					State:                    domain.PolicyStateActive,
+				},
+			},
+		},
+		{
+			name:    "prepareInactivityPoliciesQuery sql err",
+			prepare: prepareInactivityPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareInactivityPoliciesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*InactivityPolicy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_effectiveInactivityPolicy(t *testing.T) {
+	orgPolicy := &domain.InactivityPolicy{HumanDeactivateAfterDays: 30}
+	instancePolicy := &domain.InactivityPolicy{HumanDeactivateAfterDays: 90}
+	policies := map[string]*domain.InactivityPolicy{
+		"org":      orgPolicy,
+		"instance": instancePolicy,
+	}
+	tests := []struct {
+		name  string
+		chain []string
+		want  *domain.InactivityPolicy
+	}{
+		{
+			name:  "own policy",
+			chain: []string{"org", "instance"},
+			want:  orgPolicy,
+		},
+		{
+			name:  "inherited policy",
+			chain: []string{"sub", "org", "instance"},
+			want:  orgPolicy,
+		},
+		{
+			name:  "default policy",
+			chain: []string{"other", "instance"},
+			want:  instancePolicy,
+		},
+		{
+			name:  "no policy, disabled",
+			chain: []string{"other"},
+			want:  &domain.InactivityPolicy{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, effectiveInactivityPolicy(policies, tt.chain))
+		})
+	}
+}
+
+func Test_inactivityMinAfterDays(t *testing.T) {
+	assert.Equal(t, uint64(0), inactivityMinAfterDays(nil))
+	assert.Equal(t, uint64(0), inactivityMinAfterDays([]*InactivityPolicy{{}}))
+	assert.Equal(t, uint64(20), inactivityMinAfterDays([]*InactivityPolicy{
+		{},
+		{WarnBeforeDays: 10, HumanDeactivateAfterDays: 90},
+		{HumanDeleteAfterDays: 30, MachineDeactivateAfterDays: 20},
+	}))
+}
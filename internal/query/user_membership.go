@@ -354,7 +354,8 @@ func prepareOrgMember(query *MembershipSearchQuery) (string, []interface{}) {
 		"NULL::TEXT AS "+membershipIAMID.name,
 		"NULL::TEXT AS "+membershipProjectID.name,
 		"NULL::TEXT AS "+membershipGrantID.name,
-	).From(orgMemberTable.identifier())
+	).From(orgMemberTable.identifier()).
+		Where(memberValidNow(OrgMemberValidFrom, OrgMemberValidUntil))
 
 	for _, q := range query.Queries {
 		if q.Col().table.name == membershipAlias.name || q.Col().table.name == orgMemberTable.name {
@@ -364,6 +365,14 @@ func prepareOrgMember(query *MembershipSearchQuery) (string, []interface{}) {
 	return builder.MustSql()
 }
 
+// memberValidNow excludes the memberships which are restricted in time and not valid at the moment.
+func memberValidNow(validFrom, validUntil Column) sq.Sqlizer {
+	return sq.And{
+		sq.Or{sq.Eq{validFrom.identifier(): nil}, sq.Expr(validFrom.identifier() + " <= now()")},
+		sq.Or{sq.Eq{validUntil.identifier(): nil}, sq.Expr(validUntil.identifier() + " > now()")},
+	}
+}
+
 func prepareIAMMember(query *MembershipSearchQuery) (string, []interface{}) {
 	builder := sq.Select(
 		InstanceMemberUserID.identifier(),
@@ -400,7 +409,8 @@ func prepareProjectMember(query *MembershipSearchQuery) (string, []interface{})
 		"NULL::TEXT AS "+membershipIAMID.name,
 		ProjectMemberProjectID.identifier(),
 		"NULL::TEXT AS "+membershipGrantID.name,
-	).From(projectMemberTable.identifier())
+	).From(projectMemberTable.identifier()).
+		Where(memberValidNow(ProjectMemberValidFrom, ProjectMemberValidUntil))
 
 	for _, q := range query.Queries {
 		if q.Col().table.name == membershipAlias.name || q.Col().table.name == projectMemberTable.name {
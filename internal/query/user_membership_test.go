@@ -44,6 +44,7 @@ var (
 			", NULL::TEXT AS project_id" +
 			", NULL::TEXT AS grant_id" +
 			" FROM projections.org_members4 AS members" +
+			" WHERE ((members.valid_from IS NULL OR members.valid_from <= now()) AND (members.valid_until IS NULL OR members.valid_until > now()))" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
 			", members.roles" +
@@ -70,6 +71,7 @@ var (
 			", members.project_id" +
 			", NULL::TEXT AS grant_id" +
 			" FROM projections.project_members4 AS members" +
+			" WHERE ((members.valid_from IS NULL OR members.valid_from <= now()) AND (members.valid_until IS NULL OR members.valid_until > now()))" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
 			", members.roles" +
@@ -0,0 +1,287 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ExpiringPasswords struct {
+	SearchResponse
+	ExpiringPasswords []*ExpiringPassword
+}
+
+// ExpiringPassword is the password of a user, which expires within the warn days of the password age policy
+// or is already expired.
+type ExpiringPassword struct {
+	UserID          string
+	ResourceOwner   string
+	Username        string
+	PasswordChanged time.Time
+	ExpirationDate  time.Time
+	Threshold       domain.PasswordExpiryThreshold
+}
+
+type ExpiringPasswordSearchQueries struct {
+	SearchRequest
+	// ResourceOwner restricts the search to the users of the organization, all users of the instance are searched if empty
+	ResourceOwner string
+	// NotNotified restricts the search to the users, who were not yet notified about the latest threshold
+	// reached by their password
+	NotNotified bool
+	// AfterUserID restricts the search to the users with a greater ID.
+	// Together with sorting by [UserIDCol] it allows to page through the users by the last returned ID,
+	// which, unlike an offset, does not skip users dropping out of the result (e.g. after being notified).
+	AfterUserID string
+}
+
+// SearchExpiringPasswords returns the active human users, whose password reached the warning threshold
+// of the password age policy of their organization (or the default policy of the instance).
+// The permission has to be checked by the caller.
+func (q *Queries) SearchExpiringPasswords(ctx context.Context, queries *ExpiringPasswordSearchQueries) (passwords *ExpiringPasswords, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	policies, err := q.passwordAgePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expiring := expiringPasswordsCondition(policies, now, queries.NotNotified)
+	if len(expiring) == 0 {
+		return &ExpiringPasswords{ExpiringPasswords: []*ExpiringPassword{}}, nil
+	}
+
+	query, scan := prepareExpiringPasswordsQuery(ctx, q.client)
+	stmt, args, err := queries.toQuery(query).Where(queries.where(authz.GetInstance(ctx).InstanceID(), expiring)).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pe7xAa", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		passwords, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, password := range passwords.ExpiringPasswords {
+		policy := effectivePasswordAgePolicy(policies, password.ResourceOwner)
+		password.ExpirationDate = domain.PasswordExpirationDate(policy.MaxAgeDays, password.PasswordChanged)
+		password.Threshold = domain.PasswordExpiryThresholdReached(password.ExpirationDate, policy.ExpireWarnDays, now)
+	}
+	passwords.State, err = q.latestState(ctx, userTable)
+	return passwords, err
+}
+
+func (q *ExpiringPasswordSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	if q.SortingColumn.isZero() {
+		query = query.OrderBy(HumanPasswordChangedCol.identifier())
+	}
+	return query
+}
+
+func (q *ExpiringPasswordSearchQueries) where(instanceID string, expiring sq.Or) sq.And {
+	eq := sq.Eq{
+		UserInstanceIDCol.identifier(): instanceID,
+		UserTypeCol.identifier():       domain.UserTypeHuman,
+		UserStateCol.identifier():      domain.UserStateActive,
+	}
+	if q.ResourceOwner != "" {
+		eq[UserResourceOwnerCol.identifier()] = q.ResourceOwner
+	}
+	where := sq.And{eq, expiring}
+	if q.AfterUserID != "" {
+		where = append(where, sq.Gt{UserIDCol.identifier(): q.AfterUserID})
+	}
+	return where
+}
+
+// passwordAgePolicies returns the default password age policy of the instance and all policies of its organizations.
+func (q *Queries) passwordAgePolicies(ctx context.Context) (policies []*PasswordAgePolicy, err error) {
+	query, scan := preparePasswordAgePoliciesQuery(ctx, q.client)
+	stmt, args, err := query.Where(sq.Eq{
+		PasswordAgeColInstanceID.identifier():   authz.GetInstance(ctx).InstanceID(),
+		PasswordAgeColOwnerRemoved.identifier(): false,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pe7xBb", "Errors.Query.SQLStatment")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		policies, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return policies, err
+}
+
+// expiringPasswordsCondition returns the condition for the passwords, which reached the warning threshold
+// of the policy applying to the user's organization at the provided time.
+// If notNotified is set, only passwords reaching a threshold the user was not notified about yet are matched.
+// An empty condition is returned if no policy restricts the age of passwords.
+func expiringPasswordsCondition(policies []*PasswordAgePolicy, now time.Time, notNotified bool) sq.Or {
+	var (
+		condition     sq.Or
+		orgsWithOwn   []string
+		defaultPolicy *PasswordAgePolicy
+	)
+	for _, policy := range policies {
+		if policy.IsDefault {
+			defaultPolicy = policy
+			continue
+		}
+		orgsWithOwn = append(orgsWithOwn, policy.ID)
+		if policy.MaxAgeDays == 0 {
+			continue
+		}
+		condition = append(condition, sq.And{
+			sq.Eq{UserResourceOwnerCol.identifier(): policy.ID},
+			passwordThresholdCondition(policy, now, notNotified),
+		})
+	}
+	if defaultPolicy != nil && defaultPolicy.MaxAgeDays > 0 {
+		defaultCondition := sq.And{
+			passwordThresholdCondition(defaultPolicy, now, notNotified),
+		}
+		if len(orgsWithOwn) > 0 {
+			defaultCondition = append(defaultCondition, sq.NotEq{UserResourceOwnerCol.identifier(): orgsWithOwn})
+		}
+		condition = append(condition, defaultCondition)
+	}
+	return condition
+}
+
+// passwordThresholdCondition returns the condition for the passwords, which reached a threshold of the policy.
+// If notNotified is set, the threshold must be later than the one the user was already notified about.
+func passwordThresholdCondition(policy *PasswordAgePolicy, now time.Time, notNotified bool) sq.Sqlizer {
+	warning := sq.LtOrEq{HumanPasswordChangedCol.identifier(): passwordWarningCutoff(policy, now)}
+	if !notNotified {
+		return warning
+	}
+	return sq.Or{
+		sq.And{
+			warning,
+			sq.Lt{HumanPasswordExpiryNotifiedCol.identifier(): domain.PasswordExpiryThresholdWarning},
+		},
+		sq.And{
+			sq.LtOrEq{HumanPasswordChangedCol.identifier(): passwordExpiredCutoff(policy, now)},
+			sq.Lt{HumanPasswordExpiryNotifiedCol.identifier(): domain.PasswordExpiryThresholdExpired},
+		},
+	}
+}
+
+// passwordExpiredCutoff returns the latest change date of a password, which is expired.
+func passwordExpiredCutoff(policy *PasswordAgePolicy, now time.Time) time.Time {
+	return now.Add(-time.Duration(policy.MaxAgeDays) * 24 * time.Hour)
+}
+
+// passwordWarningCutoff returns the latest change date of a password, for which the warning threshold is reached.
+func passwordWarningCutoff(policy *PasswordAgePolicy, now time.Time) time.Time {
+	warnDays := min(policy.ExpireWarnDays, policy.MaxAgeDays)
+	return now.Add(-time.Duration(policy.MaxAgeDays-warnDays) * 24 * time.Hour)
+}
+
+func effectivePasswordAgePolicy(policies []*PasswordAgePolicy, orgID string) *PasswordAgePolicy {
+	var defaultPolicy *PasswordAgePolicy
+	for _, policy := range policies {
+		if !policy.IsDefault && policy.ID == orgID {
+			return policy
+		}
+		if policy.IsDefault {
+			defaultPolicy = policy
+		}
+	}
+	if defaultPolicy == nil {
+		return new(PasswordAgePolicy)
+	}
+	return defaultPolicy
+}
+
+func preparePasswordAgePoliciesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) ([]*PasswordAgePolicy, error)) {
+	return sq.Select(
+			PasswordAgeColID.identifier(),
+			PasswordAgeColSequence.identifier(),
+			PasswordAgeColCreationDate.identifier(),
+			PasswordAgeColChangeDate.identifier(),
+			PasswordAgeColResourceOwner.identifier(),
+			PasswordAgeColWarnDays.identifier(),
+			PasswordAgeColMaxAge.identifier(),
+			PasswordAgeColIsDefault.identifier(),
+			PasswordAgeColState.identifier(),
+		).
+			From(passwordAgeTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*PasswordAgePolicy, error) {
+			policies := make([]*PasswordAgePolicy, 0)
+			for rows.Next() {
+				policy := new(PasswordAgePolicy)
+				err := rows.Scan(
+					&policy.ID,
+					&policy.Sequence,
+					&policy.CreationDate,
+					&policy.ChangeDate,
+					&policy.ResourceOwner,
+					&policy.ExpireWarnDays,
+					&policy.MaxAgeDays,
+					&policy.IsDefault,
+					&policy.State,
+				)
+				if err != nil {
+					return nil, err
+				}
+				policies = append(policies, policy)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pe7xCc", "Errors.Query.CloseRows")
+			}
+			return policies, nil
+		}
+}
+
+func prepareExpiringPasswordsQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*ExpiringPasswords, error)) {
+	return sq.Select(
+			UserIDCol.identifier(),
+			UserResourceOwnerCol.identifier(),
+			UserUsernameCol.identifier(),
+			HumanPasswordChangedCol.identifier(),
+			countColumn.identifier(),
+		).
+			From(userTable.identifier()).
+			Join(join(HumanUserIDCol, UserIDCol) + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ExpiringPasswords, error) {
+			passwords := make([]*ExpiringPassword, 0)
+			var count uint64
+			for rows.Next() {
+				password := new(ExpiringPassword)
+				err := rows.Scan(
+					&password.UserID,
+					&password.ResourceOwner,
+					&password.Username,
+					&password.PasswordChanged,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				passwords = append(passwords, password)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pe7xDd", "Errors.Query.CloseRows")
+			}
+			return &ExpiringPasswords{
+				ExpiringPasswords: passwords,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,237 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	expiringPasswordsQuery = `SELECT projections.users13.id,` +
+		` projections.users13.resource_owner,` +
+		` projections.users13.username,` +
+		` projections.users13_humans.password_changed,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.users13` +
+		` JOIN projections.users13_humans ON projections.users13.id = projections.users13_humans.user_id AND projections.users13.instance_id = projections.users13_humans.instance_id` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	expiringPasswordsCols = []string{
+		"id",
+		"resource_owner",
+		"username",
+		"password_changed",
+		"count",
+	}
+)
+
+func Test_ExpiringPasswordsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareExpiringPasswordsQuery no result",
+			prepare: prepareExpiringPasswordsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiringPasswordsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &ExpiringPasswords{ExpiringPasswords: []*ExpiringPassword{}},
+		},
+		{
+			name:    "prepareExpiringPasswordsQuery multiple results",
+			prepare: prepareExpiringPasswordsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(expiringPasswordsQuery),
+					expiringPasswordsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"ro",
+							"username",
+							testNow,
+						},
+						{
+							"user-id-2",
+							"ro",
+							"username2",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &ExpiringPasswords{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				ExpiringPasswords: []*ExpiringPassword{
+					{
+						UserID:          "user-id",
+						ResourceOwner:   "ro",
+						Username:        "username",
+						PasswordChanged: testNow,
+					},
+					{
+						UserID:          "user-id-2",
+						ResourceOwner:   "ro",
+						Username:        "username2",
+						PasswordChanged: testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareExpiringPasswordsQuery sql err",
+			prepare: prepareExpiringPasswordsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(expiringPasswordsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ExpiringPasswords)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
+
+func Test_expiringPasswordsCondition(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		policies    []*PasswordAgePolicy
+		notNotified bool
+		wantSQL     string
+		wantArgs    []interface{}
+	}{
+		{
+			name: "no max age, empty",
+			policies: []*PasswordAgePolicy{
+				{ID: "instance", IsDefault: true},
+			},
+		},
+		{
+			name: "default policy",
+			policies: []*PasswordAgePolicy{
+				{ID: "instance", IsDefault: true, MaxAgeDays: 30, ExpireWarnDays: 10},
+			},
+			wantSQL:  "((projections.users13_humans.password_changed <= ?))",
+			wantArgs: []interface{}{now.Add(-20 * 24 * time.Hour)},
+		},
+		{
+			name: "org policy without max age overrides default",
+			policies: []*PasswordAgePolicy{
+				{ID: "instance", IsDefault: true, MaxAgeDays: 30, ExpireWarnDays: 10},
+				{ID: "org1"},
+				{ID: "org2", MaxAgeDays: 10, ExpireWarnDays: 20},
+			},
+			wantSQL: "((projections.users13.resource_owner = ? AND projections.users13_humans.password_changed <= ?) OR " +
+				"(projections.users13_humans.password_changed <= ? AND projections.users13.resource_owner NOT IN (?,?)))",
+			wantArgs: []interface{}{"org2", now, now.Add(-20 * 24 * time.Hour), "org1", "org2"},
+		},
+		{
+			name: "default policy, not notified",
+			policies: []*PasswordAgePolicy{
+				{ID: "instance", IsDefault: true, MaxAgeDays: 30, ExpireWarnDays: 10},
+			},
+			notNotified: true,
+			wantSQL: "((((projections.users13_humans.password_changed <= ? AND projections.users13_humans.password_expiry_notified < ?) OR " +
+				"(projections.users13_humans.password_changed <= ? AND projections.users13_humans.password_expiry_notified < ?))))",
+			wantArgs: []interface{}{
+				now.Add(-20 * 24 * time.Hour), domain.PasswordExpiryThresholdWarning,
+				now.Add(-30 * 24 * time.Hour), domain.PasswordExpiryThresholdExpired,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := expiringPasswordsCondition(tt.policies, now, tt.notNotified)
+			if tt.wantSQL == "" {
+				assert.Empty(t, condition)
+				return
+			}
+			gotSQL, gotArgs, err := condition.ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, gotSQL)
+			assert.Equal(t, tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestExpiringPasswordSearchQueries_where(t *testing.T) {
+	expiring := sq.Or{sq.LtOrEq{HumanPasswordChangedCol.identifier(): "changed"}}
+	tests := []struct {
+		name     string
+		queries  *ExpiringPasswordSearchQueries
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "instance",
+			queries: &ExpiringPasswordSearchQueries{},
+			wantSQL: "(projections.users13.instance_id = ? AND projections.users13.state = ? AND projections.users13.type = ? AND " +
+				"(projections.users13_humans.password_changed <= ?))",
+			wantArgs: []interface{}{"instance", domain.UserStateActive, domain.UserTypeHuman, "changed"},
+		},
+		{
+			name: "organization after user",
+			queries: &ExpiringPasswordSearchQueries{
+				ResourceOwner: "org1",
+				AfterUserID:   "user1",
+			},
+			wantSQL: "(projections.users13.instance_id = ? AND projections.users13.resource_owner = ? AND projections.users13.state = ? AND projections.users13.type = ? AND " +
+				"(projections.users13_humans.password_changed <= ?) AND projections.users13.id > ?)",
+			wantArgs: []interface{}{"instance", "org1", domain.UserStateActive, domain.UserTypeHuman, "changed", "user1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotArgs, err := tt.queries.where("instance", expiring).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, gotSQL)
+			assert.Equal(t, tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func Test_effectivePasswordAgePolicy(t *testing.T) {
+	policies := []*PasswordAgePolicy{
+		{ID: "instance", IsDefault: true, MaxAgeDays: 30},
+		{ID: "org1", MaxAgeDays: 10},
+	}
+	assert.Equal(t, uint64(10), effectivePasswordAgePolicy(policies, "org1").MaxAgeDays)
+	assert.Equal(t, uint64(30), effectivePasswordAgePolicy(policies, "org2").MaxAgeDays)
+	assert.Equal(t, uint64(0), effectivePasswordAgePolicy(nil, "org2").MaxAgeDays)
+	assert.Equal(t, domain.PasswordExpiryThresholdWarning, domain.PasswordExpiryThresholdReached(
+		domain.PasswordExpirationDate(30, time.Now().Add(-25*24*time.Hour)), 10, time.Now()))
+}
@@ -0,0 +1,257 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type TrustedDevices struct {
+	SearchResponse
+	Devices []*TrustedDevice
+}
+
+type TrustedDevice struct {
+	UserID        string
+	DeviceID      string
+	ResourceOwner string
+	// CreationDate is the date the device was trusted the first time
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+	// TrustedAt is the date the device was trusted the last time, the trust lifetime starts from here
+	TrustedAt   time.Time
+	LastSeen    time.Time
+	Fingerprint string
+	IP          string
+}
+
+type TrustedDeviceSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+var (
+	userTrustedDeviceTable = table{
+		name:          projection.UserTrustedDeviceProjectionTable,
+		instanceIDCol: projection.UserTrustedDeviceColumnInstanceID,
+	}
+	UserTrustedDeviceColumnInstanceID = Column{
+		name:  projection.UserTrustedDeviceColumnInstanceID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnUserID = Column{
+		name:  projection.UserTrustedDeviceColumnUserID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnDeviceID = Column{
+		name:  projection.UserTrustedDeviceColumnDeviceID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnResourceOwner = Column{
+		name:  projection.UserTrustedDeviceColumnResourceOwner,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnCreationDate = Column{
+		name:  projection.UserTrustedDeviceColumnCreationDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnChangeDate = Column{
+		name:  projection.UserTrustedDeviceColumnChangeDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnSequence = Column{
+		name:  projection.UserTrustedDeviceColumnSequence,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnTrustedAt = Column{
+		name:  projection.UserTrustedDeviceColumnTrustedAt,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnLastSeen = Column{
+		name:  projection.UserTrustedDeviceColumnLastSeen,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnFingerprint = Column{
+		name:  projection.UserTrustedDeviceColumnFingerprint,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceColumnIP = Column{
+		name:  projection.UserTrustedDeviceColumnIP,
+		table: userTrustedDeviceTable,
+	}
+)
+
+// UserTrustedDevice returns the trusted device of the user, the lifetime of the trust has to be checked by the caller
+func (q *Queries) UserTrustedDevice(ctx context.Context, shouldTriggerBulk bool, userID, deviceID string) (device *TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserTrustedDeviceProjection")
+		ctx, err = projection.UserTrustedDeviceProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareTrustedDeviceQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserTrustedDeviceColumnUserID.identifier():     userID,
+		UserTrustedDeviceColumnDeviceID.identifier():   deviceID,
+		UserTrustedDeviceColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Td4kWm", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		device, err = scan(row)
+		return err
+	}, stmt, args...)
+	return device, err
+}
+
+// SearchTrustedDevices returns the trusted devices matching the queries, the permission has to be checked by the caller
+func (q *Queries) SearchTrustedDevices(ctx context.Context, queries *TrustedDeviceSearchQueries) (devices *TrustedDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareTrustedDevicesQuery(ctx, q.client)
+	eq := sq.Eq{
+		UserTrustedDeviceColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Td4kXn", "Errors.Query.SQLStatment")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		devices, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	devices.State, err = q.latestState(ctx, userTrustedDeviceTable)
+	return devices, err
+}
+
+func (q *TrustedDeviceSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewTrustedDeviceUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserTrustedDeviceColumnUserID, value, TextEquals)
+}
+
+func NewTrustedDeviceResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(UserTrustedDeviceColumnResourceOwner, value, TextEquals)
+}
+
+func prepareTrustedDeviceQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Row) (*TrustedDevice, error)) {
+	return sq.Select(
+			UserTrustedDeviceColumnUserID.identifier(),
+			UserTrustedDeviceColumnDeviceID.identifier(),
+			UserTrustedDeviceColumnResourceOwner.identifier(),
+			UserTrustedDeviceColumnCreationDate.identifier(),
+			UserTrustedDeviceColumnChangeDate.identifier(),
+			UserTrustedDeviceColumnSequence.identifier(),
+			UserTrustedDeviceColumnTrustedAt.identifier(),
+			UserTrustedDeviceColumnLastSeen.identifier(),
+			UserTrustedDeviceColumnFingerprint.identifier(),
+			UserTrustedDeviceColumnIP.identifier(),
+		).
+			From(userTrustedDeviceTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*TrustedDevice, error) {
+			device := new(TrustedDevice)
+			err := row.Scan(
+				&device.UserID,
+				&device.DeviceID,
+				&device.ResourceOwner,
+				&device.CreationDate,
+				&device.ChangeDate,
+				&device.Sequence,
+				&device.TrustedAt,
+				&device.LastSeen,
+				&device.Fingerprint,
+				&device.IP,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Td4kYp", "Errors.User.TrustedDevice.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Td4kZq", "Errors.Internal")
+			}
+			return device, nil
+		}
+}
+
+func prepareTrustedDevicesQuery(ctx context.Context, db prepareDatabase) (sq.SelectBuilder, func(*sql.Rows) (*TrustedDevices, error)) {
+	return sq.Select(
+			UserTrustedDeviceColumnUserID.identifier(),
+			UserTrustedDeviceColumnDeviceID.identifier(),
+			UserTrustedDeviceColumnResourceOwner.identifier(),
+			UserTrustedDeviceColumnCreationDate.identifier(),
+			UserTrustedDeviceColumnChangeDate.identifier(),
+			UserTrustedDeviceColumnSequence.identifier(),
+			UserTrustedDeviceColumnTrustedAt.identifier(),
+			UserTrustedDeviceColumnLastSeen.identifier(),
+			UserTrustedDeviceColumnFingerprint.identifier(),
+			UserTrustedDeviceColumnIP.identifier(),
+			countColumn.identifier(),
+		).
+			From(userTrustedDeviceTable.identifier() + db.Timetravel(call.Took(ctx))).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TrustedDevices, error) {
+			devices := make([]*TrustedDevice, 0)
+			var count uint64
+			for rows.Next() {
+				device := new(TrustedDevice)
+				err := rows.Scan(
+					&device.UserID,
+					&device.DeviceID,
+					&device.ResourceOwner,
+					&device.CreationDate,
+					&device.ChangeDate,
+					&device.Sequence,
+					&device.TrustedAt,
+					&device.LastSeen,
+					&device.Fingerprint,
+					&device.IP,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				devices = append(devices, device)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Td4kAr", "Errors.Query.CloseRows")
+			}
+
+			return &TrustedDevices{
+				Devices: devices,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
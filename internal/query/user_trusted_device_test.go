@@ -0,0 +1,235 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	trustedDeviceQuery = `SELECT projections.user_trusted_devices.user_id,` +
+		` projections.user_trusted_devices.device_id,` +
+		` projections.user_trusted_devices.resource_owner,` +
+		` projections.user_trusted_devices.creation_date,` +
+		` projections.user_trusted_devices.change_date,` +
+		` projections.user_trusted_devices.sequence,` +
+		` projections.user_trusted_devices.trusted_at,` +
+		` projections.user_trusted_devices.last_seen,` +
+		` projections.user_trusted_devices.fingerprint,` +
+		` projections.user_trusted_devices.ip` +
+		` FROM projections.user_trusted_devices` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	trustedDeviceCols = []string{
+		"user_id",
+		"device_id",
+		"resource_owner",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"trusted_at",
+		"last_seen",
+		"fingerprint",
+		"ip",
+	}
+	trustedDevicesQuery = `SELECT projections.user_trusted_devices.user_id,` +
+		` projections.user_trusted_devices.device_id,` +
+		` projections.user_trusted_devices.resource_owner,` +
+		` projections.user_trusted_devices.creation_date,` +
+		` projections.user_trusted_devices.change_date,` +
+		` projections.user_trusted_devices.sequence,` +
+		` projections.user_trusted_devices.trusted_at,` +
+		` projections.user_trusted_devices.last_seen,` +
+		` projections.user_trusted_devices.fingerprint,` +
+		` projections.user_trusted_devices.ip,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_trusted_devices` +
+		` AS OF SYSTEM TIME '-1 ms'`
+	trustedDevicesCols = append(trustedDeviceCols, "count")
+)
+
+func Test_TrustedDevicePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTrustedDeviceQuery no result",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					regexp.QuoteMeta(trustedDeviceQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TrustedDevice)(nil),
+		},
+		{
+			name:    "prepareTrustedDeviceQuery found",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(trustedDeviceQuery),
+					trustedDeviceCols,
+					[]driver.Value{
+						"user-id",
+						"device-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211108),
+						testNow,
+						testNow,
+						"Firefox, Linux",
+						"127.0.0.1",
+					},
+				),
+			},
+			object: &TrustedDevice{
+				UserID:        "user-id",
+				DeviceID:      "device-id",
+				ResourceOwner: "ro",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				Sequence:      20211108,
+				TrustedAt:     testNow,
+				LastSeen:      testNow,
+				Fingerprint:   "Firefox, Linux",
+				IP:            "127.0.0.1",
+			},
+		},
+		{
+			name:    "prepareTrustedDeviceQuery sql err",
+			prepare: prepareTrustedDeviceQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(trustedDeviceQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TrustedDevice)(nil),
+		},
+		{
+			name:    "prepareTrustedDevicesQuery no result",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(trustedDevicesQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &TrustedDevices{Devices: []*TrustedDevice{}},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery multiple results",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(trustedDevicesQuery),
+					trustedDevicesCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"device-id",
+							"ro",
+							testNow,
+							testNow,
+							uint64(20211108),
+							testNow,
+							testNow,
+							"Firefox, Linux",
+							"127.0.0.1",
+						},
+						{
+							"user-id",
+							"device-id-2",
+							"ro",
+							testNow,
+							testNow,
+							uint64(20211108),
+							testNow,
+							testNow,
+							"",
+							"",
+						},
+					},
+				),
+			},
+			object: &TrustedDevices{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Devices: []*TrustedDevice{
+					{
+						UserID:        "user-id",
+						DeviceID:      "device-id",
+						ResourceOwner: "ro",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						TrustedAt:     testNow,
+						LastSeen:      testNow,
+						Fingerprint:   "Firefox, Linux",
+						IP:            "127.0.0.1",
+					},
+					{
+						UserID:        "user-id",
+						DeviceID:      "device-id-2",
+						ResourceOwner: "ro",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						TrustedAt:     testNow,
+						LastSeen:      testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery sql err",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(trustedDevicesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TrustedDevices)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, defaultPrepareArgs...)
+		})
+	}
+}
@@ -39,6 +39,8 @@ user_grants as (
 	and instance_id = $2
 	and project_id = any($3)
     and state = 1
+	and (valid_from is null or valid_from <= now())
+	and (valid_until is null or valid_until > now())
 	{{ if . -}}
 	and resource_owner = any($4)
 	{{- end }}
@@ -0,0 +1,210 @@
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix           eventstore.EventType = "access_request."
+	AddedEventType                                 = eventTypePrefix + "added"
+	ApprovedEventType                              = eventTypePrefix + "approved"
+	DeniedEventType                                = eventTypePrefix + "denied"
+	CancelledEventType                             = eventTypePrefix + "cancelled"
+	NotificationSentEventType                      = eventTypePrefix + "notification.sent"
+
+	UniquePendingAccessRequest = "pending_access_request"
+)
+
+// NewAddPendingUniqueConstraint ensures a user has only one pending access request per project.
+func NewAddPendingUniqueConstraint(userID, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniquePendingAccessRequest,
+		fmt.Sprintf("%s:%s", userID, projectID),
+		"Errors.AccessRequest.AlreadyPending")
+}
+
+func NewRemovePendingUniqueConstraint(userID, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniquePendingAccessRequest,
+		fmt.Sprintf("%s:%s", userID, projectID))
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID    string   `json:"userId"`
+	ProjectID string   `json:"projectId"`
+	RoleKeys  []string `json:"roleKeys"`
+	Reason    string   `json:"reason,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddPendingUniqueConstraint(e.UserID, e.ProjectID)}
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID string,
+	roleKeys []string,
+	reason string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, AddedEventType),
+		UserID:    userID,
+		ProjectID: projectID,
+		RoleKeys:  roleKeys,
+		Reason:    reason,
+	}
+}
+
+type ApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID        string    `json:"userId"`
+	ProjectID     string    `json:"projectId"`
+	UserGrantID   string    `json:"userGrantId"`
+	Justification string    `json:"justification,omitempty"`
+	ValidUntil    time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *ApprovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ApprovedEvent) Payload() any {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.UserID, e.ProjectID)}
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	userGrantID,
+	justification string,
+	validUntil time.Time,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent:     *eventstore.NewBaseEventForPush(ctx, aggregate, ApprovedEventType),
+		UserID:        userID,
+		ProjectID:     projectID,
+		UserGrantID:   userGrantID,
+		Justification: justification,
+		ValidUntil:    validUntil,
+	}
+}
+
+type DeniedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID        string `json:"userId"`
+	ProjectID     string `json:"projectId"`
+	Justification string `json:"justification,omitempty"`
+}
+
+func (e *DeniedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeniedEvent) Payload() any {
+	return e
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.UserID, e.ProjectID)}
+}
+
+func NewDeniedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	justification string,
+) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent:     *eventstore.NewBaseEventForPush(ctx, aggregate, DeniedEventType),
+		UserID:        userID,
+		ProjectID:     projectID,
+		Justification: justification,
+	}
+}
+
+type CancelledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID    string `json:"userId"`
+	ProjectID string `json:"projectId"`
+}
+
+func (e *CancelledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CancelledEvent) Payload() any {
+	return e
+}
+
+func (e *CancelledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemovePendingUniqueConstraint(e.UserID, e.ProjectID)}
+}
+
+func NewCancelledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID string,
+) *CancelledEvent {
+	return &CancelledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, CancelledEventType),
+		UserID:    userID,
+		ProjectID: projectID,
+	}
+}
+
+// NotificationSentEvent records that an approver was notified about the access request.
+type NotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RecipientID string `json:"recipientId"`
+}
+
+func (e *NotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *NotificationSentEvent) Payload() any {
+	return e
+}
+
+func (e *NotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	recipientID string,
+) *NotificationSentEvent {
+	return &NotificationSentEvent{
+		BaseEvent:   *eventstore.NewBaseEventForPush(ctx, aggregate, NotificationSentEventType),
+		RecipientID: recipientID,
+	}
+}
@@ -0,0 +1,20 @@
+package accessrequest
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "access_request"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the access request,
+// which is owned by the organization of the requested project.
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
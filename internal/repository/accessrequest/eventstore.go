@@ -0,0 +1,11 @@
+package accessrequest
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApprovedEventType, eventstore.GenericEventMapper[ApprovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeniedEventType, eventstore.GenericEventMapper[DeniedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CancelledEventType, eventstore.GenericEventMapper[CancelledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, NotificationSentEventType, eventstore.GenericEventMapper[NotificationSentEvent])
+}
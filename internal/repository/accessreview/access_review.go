@@ -0,0 +1,195 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix       eventstore.EventType = "access_review."
+	AddedEventType                             = eventTypePrefix + "added"
+	ReviewersSetEventType                      = eventTypePrefix + "reviewers.set"
+	ItemDecidedEventType                       = eventTypePrefix + "item.decided"
+	CompletedEventType                         = eventTypePrefix + "completed"
+	CancelledEventType                         = eventTypePrefix + "cancelled"
+)
+
+// Item is the snapshot of a user grant or membership taken when the access review was added.
+type Item struct {
+	ID            string                      `json:"id"`
+	Type          domain.AccessReviewItemType `json:"type"`
+	ObjectID      string                      `json:"objectId"`
+	ResourceOwner string                      `json:"resourceOwner"`
+	UserID        string                      `json:"userId"`
+	ProjectID     string                      `json:"projectId,omitempty"`
+	Roles         []string                    `json:"roles,omitempty"`
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name       string    `json:"name"`
+	ProjectID  string    `json:"projectId,omitempty"`
+	RoleKeys   []string  `json:"roleKeys,omitempty"`
+	Reviewers  []string  `json:"reviewers"`
+	Deadline   time.Time `json:"deadline"`
+	AutoRevoke bool      `json:"autoRevoke,omitempty"`
+	Items      []*Item   `json:"items"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	projectID string,
+	roleKeys,
+	reviewers []string,
+	deadline time.Time,
+	autoRevoke bool,
+	items []*Item,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent:  *eventstore.NewBaseEventForPush(ctx, aggregate, AddedEventType),
+		Name:       name,
+		ProjectID:  projectID,
+		RoleKeys:   roleKeys,
+		Reviewers:  reviewers,
+		Deadline:   deadline,
+		AutoRevoke: autoRevoke,
+		Items:      items,
+	}
+}
+
+type ReviewersSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reviewers []string `json:"reviewers"`
+}
+
+func (e *ReviewersSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ReviewersSetEvent) Payload() any {
+	return e
+}
+
+func (e *ReviewersSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewReviewersSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reviewers []string,
+) *ReviewersSetEvent {
+	return &ReviewersSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, ReviewersSetEventType),
+		Reviewers: reviewers,
+	}
+}
+
+// ItemDecidedEvent records the decision of a reviewer on an item.
+// Automatic is set if the item was revoked because nobody decided on it until the deadline.
+type ItemDecidedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ItemID    string                      `json:"itemId"`
+	Decision  domain.AccessReviewDecision `json:"decision"`
+	Comment   string                      `json:"comment,omitempty"`
+	Automatic bool                        `json:"automatic,omitempty"`
+}
+
+func (e *ItemDecidedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ItemDecidedEvent) Payload() any {
+	return e
+}
+
+func (e *ItemDecidedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewItemDecidedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	itemID string,
+	decision domain.AccessReviewDecision,
+	comment string,
+	automatic bool,
+) *ItemDecidedEvent {
+	return &ItemDecidedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, ItemDecidedEventType),
+		ItemID:    itemID,
+		Decision:  decision,
+		Comment:   comment,
+		Automatic: automatic,
+	}
+}
+
+type CompletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CompletedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CompletedEvent) Payload() any {
+	return nil
+}
+
+func (e *CompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCompletedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *CompletedEvent {
+	return &CompletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, CompletedEventType),
+	}
+}
+
+type CancelledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CancelledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CancelledEvent) Payload() any {
+	return nil
+}
+
+func (e *CancelledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCancelledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *CancelledEvent {
+	return &CancelledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, CancelledEventType),
+	}
+}
@@ -0,0 +1,20 @@
+package accessreview
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "access_review"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the access review,
+// which is owned by the organization of the reviewed user grants and memberships.
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,11 @@
+package accessreview
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ReviewersSetEventType, eventstore.GenericEventMapper[ReviewersSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemDecidedEventType, eventstore.GenericEventMapper[ItemDecidedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CompletedEventType, eventstore.GenericEventMapper[CompletedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CancelledEventType, eventstore.GenericEventMapper[CancelledEvent])
+}
@@ -0,0 +1,77 @@
+package acrdefinition
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  eventstore.EventType = "acr_definition."
+	SetEventType                          = eventTypePrefix + "set"
+	RemovedEventType                      = eventTypePrefix + "removed"
+)
+
+type SetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ACR               string        `json:"acr"`
+	PhishingResistant bool          `json:"phishingResistant,omitempty"`
+	MultiFactor       bool          `json:"multiFactor,omitempty"`
+	MaxAge            time.Duration `json:"maxAge,omitempty"`
+}
+
+func (e *SetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SetEvent) Payload() any {
+	return e
+}
+
+func (e *SetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	acr string,
+	phishingResistant,
+	multiFactor bool,
+	maxAge time.Duration,
+) *SetEvent {
+	return &SetEvent{
+		BaseEvent:         *eventstore.NewBaseEventForPush(ctx, aggregate, SetEventType),
+		ACR:               acr,
+		PhishingResistant: phishingResistant,
+		MultiFactor:       multiFactor,
+		MaxAge:            maxAge,
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ACR string `json:"acr"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, acr string) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType),
+		ACR:       acr,
+	}
+}
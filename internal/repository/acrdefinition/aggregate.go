@@ -0,0 +1,26 @@
+package acrdefinition
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "acr_definition"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the acr definition of the resource owner,
+// which is either the instance (default definition) or an organization.
+func NewAggregate(acr, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            AggregateID(resourceOwner, acr),
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
+
+// AggregateID is composed of the resource owner and the acr value,
+// as the same acr value can be defined on the instance and on each organization.
+func AggregateID(resourceOwner, acr string) string {
+	return resourceOwner + "/" + acr
+}
@@ -38,6 +38,7 @@ type AddedEvent struct {
 	LoginHint        *string                   `json:"login_hint,omitempty"`
 	HintUserID       *string                   `json:"hint_user_id,omitempty"`
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
+	ACRValues        []string                  `json:"acr_values,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -66,6 +67,7 @@ func NewAddedEvent(ctx context.Context,
 	loginHint,
 	hintUserID *string,
 	needRefreshToken bool,
+	acrValues []string,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -89,6 +91,7 @@ func NewAddedEvent(ctx context.Context,
 		LoginHint:        loginHint,
 		HintUserID:       hintUserID,
 		NeedRefreshToken: needRefreshToken,
+		ACRValues:        acrValues,
 	}
 }
 
@@ -111,6 +114,7 @@ type SessionLinkedEvent struct {
 	UserID      string                      `json:"user_id"`
 	AuthTime    time.Time                   `json:"auth_time"`
 	AuthMethods []domain.UserAuthMethodType `json:"auth_methods"`
+	ACR         string                      `json:"acr,omitempty"`
 }
 
 func (e *SessionLinkedEvent) Payload() interface{} {
@@ -127,6 +131,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 	userID string,
 	authTime time.Time,
 	authMethods []domain.UserAuthMethodType,
+	acr string,
 ) *SessionLinkedEvent {
 	return &SessionLinkedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -138,6 +143,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 		UserID:      userID,
 		AuthTime:    authTime,
 		AuthMethods: authMethods,
+		ACR:         acr,
 	}
 }
 
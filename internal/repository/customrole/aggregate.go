@@ -0,0 +1,26 @@
+package customrole
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "custom_role"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the custom role of the resource owner,
+// which is either the instance or an organization.
+func NewAggregate(key, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            AggregateID(resourceOwner, key),
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
+
+// AggregateID is composed of the resource owner and the key of the role,
+// as the same key can be defined on the instance and on each organization.
+func AggregateID(resourceOwner, key string) string {
+	return resourceOwner + "/" + key
+}
@@ -38,6 +38,8 @@ type SetEventV2 struct {
 	*eventstore.BaseEvent `json:"-"`
 
 	Targets []*Target `json:"targets"`
+	// Condition is an optional expression which has to be fulfilled for the targets to be called
+	Condition string `json:"condition,omitempty"`
 }
 
 func (e *SetEventV2) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -68,12 +70,14 @@ func NewSetEventV2(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	targets []*Target,
+	condition string,
 ) *SetEventV2 {
 	return &SetEventV2{
 		BaseEvent: eventstore.NewBaseEventForPush(
 			ctx, aggregate, SetEventV2Type,
 		),
-		Targets: targets,
+		Targets:   targets,
+		Condition: condition,
 	}
 }
 
@@ -17,6 +17,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigActivatedEventType, eventstore.GenericEventMapper[SMTPConfigActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigDeactivatedEventType, eventstore.GenericEventMapper[SMTPConfigDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigPasswordChangedEventType, eventstore.GenericEventMapper[SMTPConfigPasswordChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigWebhookTokenGeneratedEventType, eventstore.GenericEventMapper[SMTPConfigWebhookTokenGeneratedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPAddedEventType, eventstore.GenericEventMapper[SMTPConfigHTTPAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPChangedEventType, eventstore.GenericEventMapper[SMTPConfigHTTPChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigRemovedEventType, eventstore.GenericEventMapper[SMTPConfigRemovedEvent])
@@ -31,6 +32,18 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigActivatedEventType, eventstore.GenericEventMapper[SMSConfigActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigDeactivatedEventType, eventstore.GenericEventMapper[SMSConfigDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigRemovedEventType, eventstore.GenericEventMapper[SMSConfigRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAddedEventType, eventstore.GenericEventMapper[SMSConfigVonageAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageChangedEventType, eventstore.GenericEventMapper[SMSConfigVonageChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAPISecretChangedEventType, eventstore.GenericEventMapper[SMSConfigVonageAPISecretChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSAddedEventType, eventstore.GenericEventMapper[SMSConfigSNSAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSChangedEventType, eventstore.GenericEventMapper[SMSConfigSNSChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSSecretAccessKeyChangedEventType, eventstore.GenericEventMapper[SMSConfigSNSSecretAccessKeyChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdAddedEventType, eventstore.GenericEventMapper[SMSConfigMessageBirdAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdChangedEventType, eventstore.GenericEventMapper[SMSConfigMessageBirdChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigMessageBirdAccessKeyChangedEventType, eventstore.GenericEventMapper[SMSConfigMessageBirdAccessKeyChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSMPPAddedEventType, eventstore.GenericEventMapper[SMSConfigSMPPAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSMPPChangedEventType, eventstore.GenericEventMapper[SMSConfigSMPPChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSMPPPasswordChangedEventType, eventstore.GenericEventMapper[SMSConfigSMPPPasswordChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileAddedEventType, DebugNotificationProviderFileAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileChangedEventType, DebugNotificationProviderFileChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, DebugNotificationProviderFileRemovedEventType, DebugNotificationProviderFileRemovedEventMapper)
@@ -60,6 +73,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, DomainPolicyChangedEventType, DomainPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PasswordAgePolicyAddedEventType, PasswordAgePolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PasswordAgePolicyChangedEventType, PasswordAgePolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityPolicyAddedEventType, InactivityPolicyAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityPolicyChangedEventType, InactivityPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PasswordComplexityPolicyAddedEventType, PasswordComplexityPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PasswordComplexityPolicyChangedEventType, PasswordComplexityPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LockoutPolicyAddedEventType, LockoutPolicyAddedEventMapper)
@@ -115,6 +130,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyMultiFactorRemovedEventType, MultiFactorRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateAddedEventType, MailTemplateAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTemplateChangedEventType, MailTemplateChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MessageTemplateSetEventType, MessageTemplateSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, MessageTemplateRemovedEventType, MessageTemplateRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTextAddedEventType, MailTextAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MailTextChangedEventType, MailTextChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, CustomTextSetEventType, CustomTextSetEventMapper)
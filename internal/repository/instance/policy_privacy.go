@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -25,6 +26,7 @@ func NewPrivacyPolicyAddedEvent(
 	helpLink string,
 	supportEmail domain.EmailAddress,
 	docsLink, customLink, customLinkText string,
+	deletionGracePeriod time.Duration,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
 		PrivacyPolicyAddedEvent: *policy.NewPrivacyPolicyAddedEvent(
@@ -38,7 +40,8 @@ func NewPrivacyPolicyAddedEvent(
 			supportEmail,
 			docsLink,
 			customLink,
-			customLinkText),
+			customLinkText,
+			deletionGracePeriod),
 	}
 }
 
@@ -0,0 +1,679 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	smsConfigVonagePrefix      = "vonage."
+	smsConfigSNSPrefix         = "sns."
+	smsConfigMessageBirdPrefix = "messagebird."
+	smsConfigSMPPPrefix        = "smpp."
+
+	SMSConfigVonageAddedEventType                 = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "added"
+	SMSConfigVonageChangedEventType               = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "changed"
+	SMSConfigVonageAPISecretChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "api_secret.changed"
+	SMSConfigSNSAddedEventType                    = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "added"
+	SMSConfigSNSChangedEventType                  = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "changed"
+	SMSConfigSNSSecretAccessKeyChangedEventType   = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "secret_access_key.changed"
+	SMSConfigMessageBirdAddedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigMessageBirdPrefix + "added"
+	SMSConfigMessageBirdChangedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigMessageBirdPrefix + "changed"
+	SMSConfigMessageBirdAccessKeyChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigMessageBirdPrefix + "access_key.changed"
+	SMSConfigSMPPAddedEventType                   = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "added"
+	SMSConfigSMPPChangedEventType                 = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "changed"
+	SMSConfigSMPPPasswordChangedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigSMPPPrefix + "password.changed"
+)
+
+type SMSConfigVonageAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	APIKey       string              `json:"apiKey,omitempty"`
+	APISecret    *crypto.CryptoValue `json:"apiSecret,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+	VerifyBrand  string              `json:"verifyBrand,omitempty"`
+}
+
+func NewSMSConfigVonageAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	apiKey string,
+	apiSecret *crypto.CryptoValue,
+	senderNumber,
+	verifyBrand string,
+) *SMSConfigVonageAddedEvent {
+	return &SMSConfigVonageAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAddedEventType,
+		),
+		ID:           id,
+		Description:  description,
+		APIKey:       apiKey,
+		APISecret:    apiSecret,
+		SenderNumber: senderNumber,
+		VerifyBrand:  verifyBrand,
+	}
+}
+
+func (e *SMSConfigVonageAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVonageChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	APIKey       *string `json:"apiKey,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+	VerifyBrand  *string `json:"verifyBrand,omitempty"`
+}
+
+func NewSMSConfigVonageChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigVonageChanges,
+) (*SMSConfigVonageChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Vn3kQa", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigVonageChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigVonageChanges func(event *SMSConfigVonageChangedEvent)
+
+func ChangeSMSConfigVonageDescription(description string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigVonageAPIKey(apiKey string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.APIKey = &apiKey
+	}
+}
+
+func ChangeSMSConfigVonageSenderNumber(senderNumber string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func ChangeSMSConfigVonageVerifyBrand(verifyBrand string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.VerifyBrand = &verifyBrand
+	}
+}
+
+func (e *SMSConfigVonageChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVonageAPISecretChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID        string              `json:"id,omitempty"`
+	APISecret *crypto.CryptoValue `json:"apiSecret,omitempty"`
+}
+
+func NewSMSConfigVonageAPISecretChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageAPISecretChangedEvent {
+	return &SMSConfigVonageAPISecretChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAPISecretChangedEventType,
+		),
+		ID:        id,
+		APISecret: apiSecret,
+	}
+}
+
+func (e *SMSConfigVonageAPISecretChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageAPISecretChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAPISecretChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID              string              `json:"id,omitempty"`
+	Description     string              `json:"description,omitempty"`
+	Region          string              `json:"region,omitempty"`
+	AccessKeyID     string              `json:"accessKeyId,omitempty"`
+	SecretAccessKey *crypto.CryptoValue `json:"secretAccessKey,omitempty"`
+	SenderID        string              `json:"senderId,omitempty"`
+}
+
+func NewSMSConfigSNSAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	region,
+	accessKeyID string,
+	secretAccessKey *crypto.CryptoValue,
+	senderID string,
+) *SMSConfigSNSAddedEvent {
+	return &SMSConfigSNSAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSAddedEventType,
+		),
+		ID:              id,
+		Description:     description,
+		Region:          region,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SenderID:        senderID,
+	}
+}
+
+func (e *SMSConfigSNSAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID          string  `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Region      *string `json:"region,omitempty"`
+	AccessKeyID *string `json:"accessKeyId,omitempty"`
+	SenderID    *string `json:"senderId,omitempty"`
+}
+
+func NewSMSConfigSNSChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigSNSChanges,
+) (*SMSConfigSNSChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Sn4lRa", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigSNSChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigSNSChanges func(event *SMSConfigSNSChangedEvent)
+
+func ChangeSMSConfigSNSDescription(description string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigSNSRegion(region string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.Region = &region
+	}
+}
+
+func ChangeSMSConfigSNSAccessKeyID(accessKeyID string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.AccessKeyID = &accessKeyID
+	}
+}
+
+func ChangeSMSConfigSNSSenderID(senderID string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.SenderID = &senderID
+	}
+}
+
+func (e *SMSConfigSNSChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSSecretAccessKeyChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID              string              `json:"id,omitempty"`
+	SecretAccessKey *crypto.CryptoValue `json:"secretAccessKey,omitempty"`
+}
+
+func NewSMSConfigSNSSecretAccessKeyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	secretAccessKey *crypto.CryptoValue,
+) *SMSConfigSNSSecretAccessKeyChangedEvent {
+	return &SMSConfigSNSSecretAccessKeyChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSSecretAccessKeyChangedEventType,
+		),
+		ID:              id,
+		SecretAccessKey: secretAccessKey,
+	}
+}
+
+func (e *SMSConfigSNSSecretAccessKeyChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSSecretAccessKeyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSSecretAccessKeyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigMessageBirdAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID          string              `json:"id,omitempty"`
+	Description string              `json:"description,omitempty"`
+	AccessKey   *crypto.CryptoValue `json:"accessKey,omitempty"`
+	Originator  string              `json:"originator,omitempty"`
+	Verify      bool                `json:"verify,omitempty"`
+}
+
+func NewSMSConfigMessageBirdAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description string,
+	accessKey *crypto.CryptoValue,
+	originator string,
+	verify bool,
+) *SMSConfigMessageBirdAddedEvent {
+	return &SMSConfigMessageBirdAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigMessageBirdAddedEventType,
+		),
+		ID:          id,
+		Description: description,
+		AccessKey:   accessKey,
+		Originator:  originator,
+		Verify:      verify,
+	}
+}
+
+func (e *SMSConfigMessageBirdAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigMessageBirdAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigMessageBirdAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigMessageBirdChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID          string  `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Originator  *string `json:"originator,omitempty"`
+	Verify      *bool   `json:"verify,omitempty"`
+}
+
+func NewSMSConfigMessageBirdChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigMessageBirdChanges,
+) (*SMSConfigMessageBirdChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Mb5mSa", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigMessageBirdChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigMessageBirdChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigMessageBirdChanges func(event *SMSConfigMessageBirdChangedEvent)
+
+func ChangeSMSConfigMessageBirdDescription(description string) func(event *SMSConfigMessageBirdChangedEvent) {
+	return func(e *SMSConfigMessageBirdChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigMessageBirdOriginator(originator string) func(event *SMSConfigMessageBirdChangedEvent) {
+	return func(e *SMSConfigMessageBirdChangedEvent) {
+		e.Originator = &originator
+	}
+}
+
+func ChangeSMSConfigMessageBirdVerify(verify bool) func(event *SMSConfigMessageBirdChangedEvent) {
+	return func(e *SMSConfigMessageBirdChangedEvent) {
+		e.Verify = &verify
+	}
+}
+
+func (e *SMSConfigMessageBirdChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigMessageBirdChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigMessageBirdChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigMessageBirdAccessKeyChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID        string              `json:"id,omitempty"`
+	AccessKey *crypto.CryptoValue `json:"accessKey,omitempty"`
+}
+
+func NewSMSConfigMessageBirdAccessKeyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	accessKey *crypto.CryptoValue,
+) *SMSConfigMessageBirdAccessKeyChangedEvent {
+	return &SMSConfigMessageBirdAccessKeyChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigMessageBirdAccessKeyChangedEventType,
+		),
+		ID:        id,
+		AccessKey: accessKey,
+	}
+}
+
+func (e *SMSConfigMessageBirdAccessKeyChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigMessageBirdAccessKeyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigMessageBirdAccessKeyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSMPPAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID            string              `json:"id,omitempty"`
+	Description   string              `json:"description,omitempty"`
+	Host          string              `json:"host,omitempty"`
+	TLS           bool                `json:"tls,omitempty"`
+	TLSCA         string              `json:"tlsCa,omitempty"`
+	TLSServerName string              `json:"tlsServerName,omitempty"`
+	SystemID      string              `json:"systemId,omitempty"`
+	Password      *crypto.CryptoValue `json:"password,omitempty"`
+	SystemType    string              `json:"systemType,omitempty"`
+	SourceAddress string              `json:"sourceAddress,omitempty"`
+}
+
+func NewSMSConfigSMPPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	host string,
+	tls bool,
+	tlsCA,
+	tlsServerName,
+	systemID string,
+	password *crypto.CryptoValue,
+	systemType,
+	sourceAddress string,
+) *SMSConfigSMPPAddedEvent {
+	return &SMSConfigSMPPAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPAddedEventType,
+		),
+		ID:            id,
+		Description:   description,
+		Host:          host,
+		TLS:           tls,
+		TLSCA:         tlsCA,
+		TLSServerName: tlsServerName,
+		SystemID:      systemID,
+		Password:      password,
+		SystemType:    systemType,
+		SourceAddress: sourceAddress,
+	}
+}
+
+func (e *SMSConfigSMPPAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSMPPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSMPPChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID            string  `json:"id,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Host          *string `json:"host,omitempty"`
+	TLS           *bool   `json:"tls,omitempty"`
+	TLSCA         *string `json:"tlsCa,omitempty"`
+	TLSServerName *string `json:"tlsServerName,omitempty"`
+	SystemID      *string `json:"systemId,omitempty"`
+	SystemType    *string `json:"systemType,omitempty"`
+	SourceAddress *string `json:"sourceAddress,omitempty"`
+}
+
+func NewSMSConfigSMPPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigSMPPChanges,
+) (*SMSConfigSMPPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Sp6nTa", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigSMPPChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigSMPPChanges func(event *SMSConfigSMPPChangedEvent)
+
+func ChangeSMSConfigSMPPDescription(description string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigSMPPHost(host string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.Host = &host
+	}
+}
+
+func ChangeSMSConfigSMPPTLS(tls bool) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.TLS = &tls
+	}
+}
+
+func ChangeSMSConfigSMPPTLSCA(tlsCA string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.TLSCA = &tlsCA
+	}
+}
+
+func ChangeSMSConfigSMPPTLSServerName(tlsServerName string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.TLSServerName = &tlsServerName
+	}
+}
+
+func ChangeSMSConfigSMPPSystemID(systemID string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SystemID = &systemID
+	}
+}
+
+func ChangeSMSConfigSMPPSystemType(systemType string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SystemType = &systemType
+	}
+}
+
+func ChangeSMSConfigSMPPSourceAddress(sourceAddress string) func(event *SMSConfigSMPPChangedEvent) {
+	return func(e *SMSConfigSMPPChangedEvent) {
+		e.SourceAddress = &sourceAddress
+	}
+}
+
+func (e *SMSConfigSMPPChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSMPPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSMPPPasswordChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID       string              `json:"id,omitempty"`
+	Password *crypto.CryptoValue `json:"password,omitempty"`
+}
+
+func NewSMSConfigSMPPPasswordChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password *crypto.CryptoValue,
+) *SMSConfigSMPPPasswordChangedEvent {
+	return &SMSConfigSMPPPasswordChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSMPPPasswordChangedEventType,
+		),
+		ID:       id,
+		Password: password,
+	}
+}
+
+func (e *SMSConfigSMPPPasswordChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSMPPPasswordChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSMPPPasswordChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
@@ -9,16 +9,17 @@ import (
 )
 
 const (
-	smtpConfigPrefix                   = "smtp.config."
-	httpConfigPrefix                   = "http."
-	SMTPConfigAddedEventType           = instanceEventTypePrefix + smtpConfigPrefix + "added"
-	SMTPConfigChangedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "changed"
-	SMTPConfigPasswordChangedEventType = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
-	SMTPConfigHTTPAddedEventType       = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "added"
-	SMTPConfigHTTPChangedEventType     = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "changed"
-	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
-	SMTPConfigActivatedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "activated"
-	SMTPConfigDeactivatedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
+	smtpConfigPrefix                         = "smtp.config."
+	httpConfigPrefix                         = "http."
+	SMTPConfigAddedEventType                 = instanceEventTypePrefix + smtpConfigPrefix + "added"
+	SMTPConfigChangedEventType               = instanceEventTypePrefix + smtpConfigPrefix + "changed"
+	SMTPConfigPasswordChangedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigWebhookTokenGeneratedEventType = instanceEventTypePrefix + smtpConfigPrefix + "webhook.token.generated"
+	SMTPConfigHTTPAddedEventType             = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "added"
+	SMTPConfigHTTPChangedEventType           = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "changed"
+	SMTPConfigRemovedEventType               = instanceEventTypePrefix + smtpConfigPrefix + "removed"
+	SMTPConfigActivatedEventType             = instanceEventTypePrefix + smtpConfigPrefix + "activated"
+	SMTPConfigDeactivatedEventType           = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
 )
 
 type SMTPConfigAddedEvent struct {
@@ -214,6 +215,41 @@ func (e *SMTPConfigPasswordChangedEvent) UniqueConstraints() []*eventstore.Uniqu
 	return nil
 }
 
+type SMTPConfigWebhookTokenGeneratedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	ID                    string              `json:"id,omitempty"`
+	Token                 *crypto.CryptoValue `json:"token,omitempty"`
+}
+
+func NewSMTPConfigWebhookTokenGeneratedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+) *SMTPConfigWebhookTokenGeneratedEvent {
+	return &SMTPConfigWebhookTokenGeneratedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigWebhookTokenGeneratedEventType,
+		),
+		ID:    id,
+		Token: token,
+	}
+}
+
+func (e *SMTPConfigWebhookTokenGeneratedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMTPConfigWebhookTokenGeneratedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigWebhookTokenGeneratedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
 type SMTPConfigHTTPAddedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 
@@ -0,0 +1,100 @@
+package member
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ValiditySetEventType = "member.validity.set"
+	ExpiredEventType     = "member.expired"
+)
+
+// MemberValiditySetEvent restricts the membership to a period of time.
+// Zero values remove the restriction on the respective side.
+type MemberValiditySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	ValidFrom  time.Time `json:"validFrom"`
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *MemberValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MemberValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewValiditySetEvent(
+	base *eventstore.BaseEvent,
+	userID string,
+	validFrom,
+	validUntil time.Time,
+) *MemberValiditySetEvent {
+	return &MemberValiditySetEvent{
+		BaseEvent:  *base,
+		UserID:     userID,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+func ValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MemberValiditySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Vl3dSe", "unable to unmarshal member validity")
+	}
+
+	return e, nil
+}
+
+// MemberExpiredEvent is pushed by the scheduler after the validity of the membership ended.
+// The membership is removed the same way as by the [MemberRemovedEvent].
+type MemberExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *MemberExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MemberExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveMemberUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewExpiredEvent(
+	base *eventstore.BaseEvent,
+	userID string,
+	validUntil time.Time,
+) *MemberExpiredEvent {
+	return &MemberExpiredEvent{
+		BaseEvent:  *base,
+		UserID:     userID,
+		ValidUntil: validUntil,
+	}
+}
+
+func ExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MemberExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Vl3eEx", "unable to unmarshal member expiry")
+	}
+
+	return e, nil
+}
@@ -16,6 +16,7 @@ const (
 	RetryRequestedType      = notificationEventPrefix + "retry.requested"
 	SentType                = notificationEventPrefix + "sent"
 	CanceledType            = notificationEventPrefix + "canceled"
+	DeliveryStatusType      = notificationEventPrefix + "delivery.status.changed"
 )
 
 type Request struct {
@@ -118,6 +119,12 @@ func NewRequestedEvent(ctx context.Context,
 
 type SentEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// ProviderID is the id of the email or sms provider configuration used to send the notification
+	ProviderID string `json:"providerID,omitempty"`
+	// ProviderMessageID is the id the provider assigned to the message, it's used to match status callbacks
+	ProviderMessageID string `json:"providerMessageID,omitempty"`
+	RecipientHash     string `json:"recipientHash,omitempty"`
 }
 
 func (e *SentEvent) Payload() interface{} {
@@ -134,6 +141,9 @@ func (e *SentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 
 func NewSentEvent(ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	providerID,
+	providerMessageID,
+	recipientHash string,
 ) *SentEvent {
 	return &SentEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -141,6 +151,45 @@ func NewSentEvent(ctx context.Context,
 			aggregate,
 			SentType,
 		),
+		ProviderID:        providerID,
+		ProviderMessageID: providerMessageID,
+		RecipientHash:     recipientHash,
+	}
+}
+
+// DeliveryStatusChangedEvent is pushed when the provider reports the delivery status of a sent notification
+type DeliveryStatusChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Status domain.NotificationDeliveryStatus `json:"status"`
+	Error  string                            `json:"error,omitempty"`
+}
+
+func (e *DeliveryStatusChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeliveryStatusChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DeliveryStatusChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewDeliveryStatusChangedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	status domain.NotificationDeliveryStatus,
+	errorMessage string,
+) *DeliveryStatusChangedEvent {
+	return &DeliveryStatusChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliveryStatusType,
+		),
+		Status: status,
+		Error:  errorMessage,
 	}
 }
 
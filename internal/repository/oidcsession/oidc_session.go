@@ -31,6 +31,7 @@ type AddedEvent struct {
 	Scope             []string                    `json:"scope"`
 	AuthMethods       []domain.UserAuthMethodType `json:"authMethods"`
 	AuthTime          time.Time                   `json:"authTime"`
+	ACR               string                      `json:"acr,omitempty"`
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
@@ -58,6 +59,7 @@ func NewAddedEvent(ctx context.Context,
 	scope []string,
 	authMethods []domain.UserAuthMethodType,
 	authTime time.Time,
+	acr,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
@@ -76,6 +78,7 @@ func NewAddedEvent(ctx context.Context,
 		Scope:             scope,
 		AuthMethods:       authMethods,
 		AuthTime:          authTime,
+		ACR:               acr,
 		Nonce:             nonce,
 		PreferredLanguage: preferredLanguage,
 		UserAgent:         userAgent,
@@ -0,0 +1,84 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	parentEventPrefix         = orgEventTypePrefix + "parent."
+	OrgParentSetEventType     = parentEventPrefix + "set"
+	OrgParentRemovedEventType = parentEventPrefix + "removed"
+)
+
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID          string `json:"parentOrgId"`
+	InheritIDPs          bool   `json:"inheritIdps,omitempty"`
+	InheritProjectGrants bool   `json:"inheritProjectGrants,omitempty"`
+	InheritMembers       bool   `json:"inheritMembers,omitempty"`
+}
+
+func (e *OrgParentSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *OrgParentSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	parentOrgID string,
+	inheritIDPs,
+	inheritProjectGrants,
+	inheritMembers bool,
+) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentOrgID:          parentOrgID,
+		InheritIDPs:          inheritIDPs,
+		InheritProjectGrants: inheritProjectGrants,
+		InheritMembers:       inheritMembers,
+	}
+}
+
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentOrgID string `json:"parentOrgId"`
+}
+
+func (e *OrgParentRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *OrgParentRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentOrgID string) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+		ParentOrgID: parentOrgID,
+	}
+}
@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -26,6 +27,7 @@ func NewPrivacyPolicyAddedEvent(
 	helpLink string,
 	supportEmail domain.EmailAddress,
 	docsLink, customLink, customLinkText string,
+	deletionGracePeriod time.Duration,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
 		PrivacyPolicyAddedEvent: *policy.NewPrivacyPolicyAddedEvent(
@@ -39,7 +41,8 @@ func NewPrivacyPolicyAddedEvent(
 			supportEmail,
 			docsLink,
 			customLink,
-			customLinkText),
+			customLinkText,
+			deletionGracePeriod),
 	}
 }
 
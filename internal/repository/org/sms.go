@@ -0,0 +1,210 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+// The SMS configurations of an organization override the ones of the instance.
+// The events share their payload with the events of the instance.
+// Code verification by the provider (Twilio Verify) is only available on the instance.
+const (
+	smsConfigPrefix                      = "sms.config."
+	smsConfigTwilioPrefix                = "twilio."
+	smsConfigHTTPPrefix                  = "http."
+	SMSConfigTwilioAddedEventType        = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType      = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigTwilioTokenChangedEventType = orgEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigHTTPAddedEventType          = orgEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType        = orgEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigActivatedEventType          = orgEventTypePrefix + smsConfigPrefix + "activated"
+	SMSConfigDeactivatedEventType        = orgEventTypePrefix + smsConfigPrefix + "deactivated"
+	SMSConfigRemovedEventType            = orgEventTypePrefix + smsConfigPrefix + "removed"
+)
+
+type SMSConfigTwilioAddedEvent struct {
+	instance.SMSConfigTwilioAddedEvent
+}
+
+func NewSMSConfigTwilioAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description string,
+	sid,
+	senderNumber string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioAddedEvent {
+	return &SMSConfigTwilioAddedEvent{
+		SMSConfigTwilioAddedEvent: instance.SMSConfigTwilioAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioAddedEventType,
+			),
+			ID:           id,
+			Description:  description,
+			SID:          sid,
+			Token:        token,
+			SenderNumber: senderNumber,
+		},
+	}
+}
+
+type SMSConfigTwilioChangedEvent struct {
+	instance.SMSConfigTwilioChangedEvent
+}
+
+func NewSMSConfigTwilioChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMSConfigTwilioChanges,
+) (*SMSConfigTwilioChangedEvent, error) {
+	event, err := instance.NewSMSConfigTwilioChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, err
+	}
+	event.BaseEvent = eventstore.NewBaseEventForPush(
+		ctx,
+		aggregate,
+		SMSConfigTwilioChangedEventType,
+	)
+	return &SMSConfigTwilioChangedEvent{SMSConfigTwilioChangedEvent: *event}, nil
+}
+
+type SMSConfigTwilioTokenChangedEvent struct {
+	instance.SMSConfigTwilioTokenChangedEvent
+}
+
+func NewSMSConfigTokenChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+) *SMSConfigTwilioTokenChangedEvent {
+	return &SMSConfigTwilioTokenChangedEvent{
+		SMSConfigTwilioTokenChangedEvent: instance.SMSConfigTwilioTokenChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigTwilioTokenChangedEventType,
+			),
+			ID:    id,
+			Token: token,
+		},
+	}
+}
+
+type SMSConfigHTTPAddedEvent struct {
+	instance.SMSConfigHTTPAddedEvent
+}
+
+func NewSMSConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	endpoint string,
+) *SMSConfigHTTPAddedEvent {
+	return &SMSConfigHTTPAddedEvent{
+		SMSConfigHTTPAddedEvent: instance.SMSConfigHTTPAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigHTTPAddedEventType,
+			),
+			ID:          id,
+			Description: description,
+			Endpoint:    endpoint,
+		},
+	}
+}
+
+type SMSConfigHTTPChangedEvent struct {
+	instance.SMSConfigHTTPChangedEvent
+}
+
+func NewSMSConfigHTTPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMSConfigHTTPChanges,
+) (*SMSConfigHTTPChangedEvent, error) {
+	event, err := instance.NewSMSConfigHTTPChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, err
+	}
+	event.BaseEvent = eventstore.NewBaseEventForPush(
+		ctx,
+		aggregate,
+		SMSConfigHTTPChangedEventType,
+	)
+	return &SMSConfigHTTPChangedEvent{SMSConfigHTTPChangedEvent: *event}, nil
+}
+
+type SMSConfigActivatedEvent struct {
+	instance.SMSConfigActivatedEvent
+}
+
+func NewSMSConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigActivatedEvent {
+	return &SMSConfigActivatedEvent{
+		SMSConfigActivatedEvent: instance.SMSConfigActivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMSConfigDeactivatedEvent struct {
+	instance.SMSConfigDeactivatedEvent
+}
+
+func NewSMSConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigDeactivatedEvent {
+	return &SMSConfigDeactivatedEvent{
+		SMSConfigDeactivatedEvent: instance.SMSConfigDeactivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMSConfigRemovedEvent struct {
+	instance.SMSConfigRemovedEvent
+}
+
+func NewSMSConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMSConfigRemovedEvent {
+	return &SMSConfigRemovedEvent{
+		SMSConfigRemovedEvent: instance.SMSConfigRemovedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMSConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
@@ -0,0 +1,238 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+// The SMTP configurations of an organization override the ones of the instance.
+// The events share their payload with the events of the instance.
+const (
+	smtpConfigPrefix                         = "smtp.config."
+	smtpConfigHTTPPrefix                     = "http."
+	SMTPConfigAddedEventType                 = orgEventTypePrefix + smtpConfigPrefix + "added"
+	SMTPConfigChangedEventType               = orgEventTypePrefix + smtpConfigPrefix + "changed"
+	SMTPConfigPasswordChangedEventType       = orgEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigWebhookTokenGeneratedEventType = orgEventTypePrefix + smtpConfigPrefix + "webhook.token.generated"
+	SMTPConfigHTTPAddedEventType             = orgEventTypePrefix + smtpConfigPrefix + smtpConfigHTTPPrefix + "added"
+	SMTPConfigHTTPChangedEventType           = orgEventTypePrefix + smtpConfigPrefix + smtpConfigHTTPPrefix + "changed"
+	SMTPConfigRemovedEventType               = orgEventTypePrefix + smtpConfigPrefix + "removed"
+	SMTPConfigActivatedEventType             = orgEventTypePrefix + smtpConfigPrefix + "activated"
+	SMTPConfigDeactivatedEventType           = orgEventTypePrefix + smtpConfigPrefix + "deactivated"
+)
+
+type SMTPConfigAddedEvent struct {
+	instance.SMTPConfigAddedEvent
+}
+
+func NewSMTPConfigAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	tls bool,
+	senderAddress,
+	senderName,
+	replyToAddress,
+	host,
+	user string,
+	password *crypto.CryptoValue,
+) *SMTPConfigAddedEvent {
+	return &SMTPConfigAddedEvent{
+		SMTPConfigAddedEvent: instance.SMTPConfigAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigAddedEventType,
+			),
+			ID:             id,
+			Description:    description,
+			TLS:            tls,
+			SenderAddress:  senderAddress,
+			SenderName:     senderName,
+			ReplyToAddress: replyToAddress,
+			Host:           host,
+			User:           user,
+			Password:       password,
+		},
+	}
+}
+
+type SMTPConfigChangedEvent struct {
+	instance.SMTPConfigChangedEvent
+}
+
+func NewSMTPConfigChangeEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMTPConfigChanges,
+) (*SMTPConfigChangedEvent, error) {
+	event, err := instance.NewSMTPConfigChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, err
+	}
+	event.BaseEvent = eventstore.NewBaseEventForPush(
+		ctx,
+		aggregate,
+		SMTPConfigChangedEventType,
+	)
+	return &SMTPConfigChangedEvent{SMTPConfigChangedEvent: *event}, nil
+}
+
+type SMTPConfigPasswordChangedEvent struct {
+	instance.SMTPConfigPasswordChangedEvent
+}
+
+func NewSMTPConfigPasswordChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password *crypto.CryptoValue,
+) *SMTPConfigPasswordChangedEvent {
+	return &SMTPConfigPasswordChangedEvent{
+		SMTPConfigPasswordChangedEvent: instance.SMTPConfigPasswordChangedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigPasswordChangedEventType,
+			),
+			ID:       id,
+			Password: password,
+		},
+	}
+}
+
+type SMTPConfigWebhookTokenGeneratedEvent struct {
+	instance.SMTPConfigWebhookTokenGeneratedEvent
+}
+
+func NewSMTPConfigWebhookTokenGeneratedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	token *crypto.CryptoValue,
+) *SMTPConfigWebhookTokenGeneratedEvent {
+	return &SMTPConfigWebhookTokenGeneratedEvent{
+		SMTPConfigWebhookTokenGeneratedEvent: instance.SMTPConfigWebhookTokenGeneratedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigWebhookTokenGeneratedEventType,
+			),
+			ID:    id,
+			Token: token,
+		},
+	}
+}
+
+type SMTPConfigHTTPAddedEvent struct {
+	instance.SMTPConfigHTTPAddedEvent
+}
+
+func NewSMTPConfigHTTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	endpoint string,
+) *SMTPConfigHTTPAddedEvent {
+	return &SMTPConfigHTTPAddedEvent{
+		SMTPConfigHTTPAddedEvent: instance.SMTPConfigHTTPAddedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigHTTPAddedEventType,
+			),
+			ID:          id,
+			Description: description,
+			Endpoint:    endpoint,
+		},
+	}
+}
+
+type SMTPConfigHTTPChangedEvent struct {
+	instance.SMTPConfigHTTPChangedEvent
+}
+
+func NewSMTPConfigHTTPChangeEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []instance.SMTPConfigHTTPChanges,
+) (*SMTPConfigHTTPChangedEvent, error) {
+	event, err := instance.NewSMTPConfigHTTPChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, err
+	}
+	event.BaseEvent = eventstore.NewBaseEventForPush(
+		ctx,
+		aggregate,
+		SMTPConfigHTTPChangedEventType,
+	)
+	return &SMTPConfigHTTPChangedEvent{SMTPConfigHTTPChangedEvent: *event}, nil
+}
+
+type SMTPConfigActivatedEvent struct {
+	instance.SMTPConfigActivatedEvent
+}
+
+func NewSMTPConfigActivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigActivatedEvent {
+	return &SMTPConfigActivatedEvent{
+		SMTPConfigActivatedEvent: instance.SMTPConfigActivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigActivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMTPConfigDeactivatedEvent struct {
+	instance.SMTPConfigDeactivatedEvent
+}
+
+func NewSMTPConfigDeactivatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigDeactivatedEvent {
+	return &SMTPConfigDeactivatedEvent{
+		SMTPConfigDeactivatedEvent: instance.SMTPConfigDeactivatedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigDeactivatedEventType,
+			),
+			ID: id,
+		},
+	}
+}
+
+type SMTPConfigRemovedEvent struct {
+	instance.SMTPConfigRemovedEvent
+}
+
+func NewSMTPConfigRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *SMTPConfigRemovedEvent {
+	return &SMTPConfigRemovedEvent{
+		SMTPConfigRemovedEvent: instance.SMTPConfigRemovedEvent{
+			BaseEvent: eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				SMTPConfigRemovedEventType,
+			),
+			ID: id,
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package policy
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	messageTemplatePrefix           = mailTemplatePolicyPrefix + "message."
+	MessageTemplateSetEventType     = messageTemplatePrefix + "set"
+	MessageTemplateRemovedEventType = messageTemplatePrefix + "removed"
+)
+
+// MessageTemplateSetEvent sets the template of a single message type and language.
+// The uploaded source is stored as asset (StoreKey), Template contains the compiled HTML.
+type MessageTemplateSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string                       `json:"messageType,omitempty"`
+	Language    language.Tag                 `json:"language,omitempty"`
+	Format      domain.MessageTemplateFormat `json:"format,omitempty"`
+	StoreKey    string                       `json:"storeKey,omitempty"`
+	Template    []byte                       `json:"template,omitempty"`
+}
+
+func (e *MessageTemplateSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateSetEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	language language.Tag,
+	format domain.MessageTemplateFormat,
+	storeKey string,
+	template []byte,
+) *MessageTemplateSetEvent {
+	return &MessageTemplateSetEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    language,
+		Format:      format,
+		StoreKey:    storeKey,
+		Template:    template,
+	}
+}
+
+func MessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Mt1sQa", "unable to unmarshal message template")
+	}
+
+	return e, nil
+}
+
+type MessageTemplateRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string       `json:"messageType,omitempty"`
+	Language    language.Tag `json:"language,omitempty"`
+	StoreKey    string       `json:"storeKey,omitempty"`
+}
+
+func (e *MessageTemplateRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MessageTemplateRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMessageTemplateRemovedEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	language language.Tag,
+	storeKey string,
+) *MessageTemplateRemovedEvent {
+	return &MessageTemplateRemovedEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    language,
+		StoreKey:    storeKey,
+	}
+}
+
+func MessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MessageTemplateRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Mt2rRa", "unable to unmarshal message template removed")
+	}
+
+	return e, nil
+}
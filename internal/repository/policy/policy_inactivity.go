@@ -0,0 +1,164 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InactivityPolicyAddedEventType   = "policy.inactivity.added"
+	InactivityPolicyChangedEventType = "policy.inactivity.changed"
+	InactivityPolicyRemovedEventType = "policy.inactivity.removed"
+)
+
+type InactivityPolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	WarnBeforeDays             uint64 `json:"warnBeforeDays,omitempty"`
+	HumanDeactivateAfterDays   uint64 `json:"humanDeactivateAfterDays,omitempty"`
+	HumanDeleteAfterDays       uint64 `json:"humanDeleteAfterDays,omitempty"`
+	MachineDeactivateAfterDays uint64 `json:"machineDeactivateAfterDays,omitempty"`
+	MachineDeleteAfterDays     uint64 `json:"machineDeleteAfterDays,omitempty"`
+}
+
+func (e *InactivityPolicyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	warnBeforeDays,
+	humanDeactivateAfterDays,
+	humanDeleteAfterDays,
+	machineDeactivateAfterDays,
+	machineDeleteAfterDays uint64,
+) *InactivityPolicyAddedEvent {
+	return &InactivityPolicyAddedEvent{
+		BaseEvent:                  *base,
+		WarnBeforeDays:             warnBeforeDays,
+		HumanDeactivateAfterDays:   humanDeactivateAfterDays,
+		HumanDeleteAfterDays:       humanDeleteAfterDays,
+		MachineDeactivateAfterDays: machineDeactivateAfterDays,
+		MachineDeleteAfterDays:     machineDeleteAfterDays,
+	}
+}
+
+func InactivityPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &InactivityPolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-In1aAa", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type InactivityPolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	WarnBeforeDays             *uint64 `json:"warnBeforeDays,omitempty"`
+	HumanDeactivateAfterDays   *uint64 `json:"humanDeactivateAfterDays,omitempty"`
+	HumanDeleteAfterDays       *uint64 `json:"humanDeleteAfterDays,omitempty"`
+	MachineDeactivateAfterDays *uint64 `json:"machineDeactivateAfterDays,omitempty"`
+	MachineDeleteAfterDays     *uint64 `json:"machineDeleteAfterDays,omitempty"`
+}
+
+func (e *InactivityPolicyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityPolicyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []InactivityPolicyChanges,
+) (*InactivityPolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-In1bBb", "Errors.NoChangesFound")
+	}
+	changeEvent := &InactivityPolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type InactivityPolicyChanges func(*InactivityPolicyChangedEvent)
+
+func ChangeInactivityWarnBeforeDays(warnBeforeDays uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.WarnBeforeDays = &warnBeforeDays
+	}
+}
+
+func ChangeHumanDeactivateAfterDays(humanDeactivateAfterDays uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.HumanDeactivateAfterDays = &humanDeactivateAfterDays
+	}
+}
+
+func ChangeHumanDeleteAfterDays(humanDeleteAfterDays uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.HumanDeleteAfterDays = &humanDeleteAfterDays
+	}
+}
+
+func ChangeMachineDeactivateAfterDays(machineDeactivateAfterDays uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.MachineDeactivateAfterDays = &machineDeactivateAfterDays
+	}
+}
+
+func ChangeMachineDeleteAfterDays(machineDeleteAfterDays uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.MachineDeleteAfterDays = &machineDeleteAfterDays
+	}
+}
+
+func InactivityPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &InactivityPolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-In1cCc", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type InactivityPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *InactivityPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *InactivityPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyRemovedEvent(base *eventstore.BaseEvent) *InactivityPolicyRemovedEvent {
+	return &InactivityPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func InactivityPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &InactivityPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
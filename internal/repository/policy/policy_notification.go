@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -51,7 +52,8 @@ func NotificationPolicyAddedEventMapper(event eventstore.Event) (eventstore.Even
 type NotificationPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	PasswordChange *bool `json:"passwordChange,omitempty"`
+	PasswordChange        *bool                         `json:"passwordChange,omitempty"`
+	SecurityNotifications *domain.SecurityNotifications `json:"securityNotifications,omitempty"`
 }
 
 func (e *NotificationPolicyChangedEvent) Payload() interface{} {
@@ -86,6 +88,12 @@ func ChangePasswordChange(passwordChange bool) func(*NotificationPolicyChangedEv
 	}
 }
 
+func ChangeSecurityNotifications(securityNotifications domain.SecurityNotifications) func(*NotificationPolicyChangedEvent) {
+	return func(e *NotificationPolicyChangedEvent) {
+		e.SecurityNotifications = &securityNotifications
+	}
+}
+
 func NotificationPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &NotificationPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -22,6 +24,8 @@ type PrivacyPolicyAddedEvent struct {
 	DocsLink       string              `json:"docsLink,omitempty"`
 	CustomLink     string              `json:"customLink,omitempty"`
 	CustomLinkText string              `json:"customLinkText,omitempty"`
+	// DeletionGracePeriod is the period after which the self-service deletion of a user is executed
+	DeletionGracePeriod time.Duration `json:"deletionGracePeriod,omitempty"`
 }
 
 func (e *PrivacyPolicyAddedEvent) Payload() interface{} {
@@ -39,16 +43,18 @@ func NewPrivacyPolicyAddedEvent(
 	helpLink string,
 	supportEmail domain.EmailAddress,
 	docsLink, customLink, customLinkText string,
+	deletionGracePeriod time.Duration,
 ) *PrivacyPolicyAddedEvent {
 	return &PrivacyPolicyAddedEvent{
-		BaseEvent:      *base,
-		TOSLink:        tosLink,
-		PrivacyLink:    privacyLink,
-		HelpLink:       helpLink,
-		SupportEmail:   supportEmail,
-		DocsLink:       docsLink,
-		CustomLink:     customLink,
-		CustomLinkText: customLinkText,
+		BaseEvent:           *base,
+		TOSLink:             tosLink,
+		PrivacyLink:         privacyLink,
+		HelpLink:            helpLink,
+		SupportEmail:        supportEmail,
+		DocsLink:            docsLink,
+		CustomLink:          customLink,
+		CustomLinkText:      customLinkText,
+		DeletionGracePeriod: deletionGracePeriod,
 	}
 }
 
@@ -67,13 +73,14 @@ func PrivacyPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, er
 type PrivacyPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	TOSLink        *string              `json:"tosLink,omitempty"`
-	PrivacyLink    *string              `json:"privacyLink,omitempty"`
-	HelpLink       *string              `json:"helpLink,omitempty"`
-	SupportEmail   *domain.EmailAddress `json:"supportEmail,omitempty"`
-	DocsLink       *string              `json:"docsLink,omitempty"`
-	CustomLink     *string              `json:"customLink,omitempty"`
-	CustomLinkText *string              `json:"customLinkText,omitempty"`
+	TOSLink             *string              `json:"tosLink,omitempty"`
+	PrivacyLink         *string              `json:"privacyLink,omitempty"`
+	HelpLink            *string              `json:"helpLink,omitempty"`
+	SupportEmail        *domain.EmailAddress `json:"supportEmail,omitempty"`
+	DocsLink            *string              `json:"docsLink,omitempty"`
+	CustomLink          *string              `json:"customLink,omitempty"`
+	CustomLinkText      *string              `json:"customLinkText,omitempty"`
+	DeletionGracePeriod *time.Duration       `json:"deletionGracePeriod,omitempty"`
 }
 
 func (e *PrivacyPolicyChangedEvent) Payload() interface{} {
@@ -144,6 +151,12 @@ func ChangeCustomLinkText(customLinkText string) func(*PrivacyPolicyChangedEvent
 	}
 }
 
+func ChangeDeletionGracePeriod(deletionGracePeriod time.Duration) func(*PrivacyPolicyChangedEvent) {
+	return func(e *PrivacyPolicyChangedEvent) {
+		e.DeletionGracePeriod = &deletionGracePeriod
+	}
+}
+
 func PrivacyPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &PrivacyPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,38 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	RelationSchemaSetType = projectEventTypePrefix + "relation_schema.set"
+)
+
+// RelationSchemaSetEvent replaces the relation schema of the project
+type RelationSchemaSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Schema *domain.RelationSchema `json:"schema"`
+}
+
+func (e *RelationSchemaSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RelationSchemaSetEvent) Payload() any {
+	return e
+}
+
+func (e *RelationSchemaSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRelationSchemaSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, schema *domain.RelationSchema) *RelationSchemaSetEvent {
+	return &RelationSchemaSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, RelationSchemaSetType),
+		Schema:    schema,
+	}
+}
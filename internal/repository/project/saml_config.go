@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -16,10 +17,11 @@ const (
 type SAMLConfigAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID       string `json:"appId"`
-	EntityID    string `json:"entityId"`
-	Metadata    []byte `json:"metadata,omitempty"`
-	MetadataURL string `json:"metadata_url,omitempty"`
+	AppID       string                 `json:"appId"`
+	EntityID    string                 `json:"entityId"`
+	Metadata    []byte                 `json:"metadata,omitempty"`
+	MetadataURL string                 `json:"metadata_url,omitempty"`
+	Options     *domain.SAMLAppOptions `json:"options,omitempty"`
 }
 
 func (e *SAMLConfigAddedEvent) Payload() interface{} {
@@ -50,6 +52,7 @@ func NewSAMLConfigAddedEvent(
 	entityID string,
 	metadata []byte,
 	metadataURL string,
+	options *domain.SAMLAppOptions,
 ) *SAMLConfigAddedEvent {
 	return &SAMLConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -61,6 +64,7 @@ func NewSAMLConfigAddedEvent(
 		EntityID:    entityID,
 		Metadata:    metadata,
 		MetadataURL: metadataURL,
+		Options:     options,
 	}
 }
 
@@ -80,10 +84,11 @@ func SAMLConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type SAMLConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID       string  `json:"appId"`
-	EntityID    string  `json:"entityId"`
-	Metadata    []byte  `json:"metadata,omitempty"`
-	MetadataURL *string `json:"metadata_url,omitempty"`
+	AppID       string                 `json:"appId"`
+	EntityID    string                 `json:"entityId"`
+	Metadata    []byte                 `json:"metadata,omitempty"`
+	MetadataURL *string                `json:"metadata_url,omitempty"`
+	Options     *domain.SAMLAppOptions `json:"options,omitempty"`
 	oldEntityID string
 }
 
@@ -147,6 +152,12 @@ func ChangeEntityID(entityID string) func(event *SAMLConfigChangedEvent) {
 	}
 }
 
+func ChangeOptions(options *domain.SAMLAppOptions) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.Options = options
+	}
+}
+
 func SAMLConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &SAMLConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
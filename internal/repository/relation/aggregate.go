@@ -0,0 +1,26 @@
+package relation
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "relation"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the object the relation tuples are written for.
+// The resource owner is the owner of the project which defines the schema.
+func NewAggregate(projectID, objectType, objectID, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            AggregateID(projectID, objectType, objectID),
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
+
+// AggregateID is composed of the project and the object,
+// as the same object can be used by multiple projects.
+func AggregateID(projectID, objectType, objectID string) string {
+	return projectID + "/" + objectType + ":" + objectID
+}
@@ -0,0 +1,8 @@
+package relation
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, TupleWrittenEventType, eventstore.GenericEventMapper[TupleWrittenEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TupleDeletedEventType, eventstore.GenericEventMapper[TupleDeletedEvent])
+}
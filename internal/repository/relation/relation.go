@@ -0,0 +1,99 @@
+package relation
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix       eventstore.EventType = "relation.tuple."
+	TupleWrittenEventType                      = eventTypePrefix + "written"
+	TupleDeletedEventType                      = eventTypePrefix + "deleted"
+)
+
+// Tuple is the payload of the tuple events.
+// The object is part of the aggregate as well, but kept in the payload to allow reducing without parsing the aggregate id.
+type Tuple struct {
+	ProjectID       string `json:"projectId"`
+	ObjectType      string `json:"objectType"`
+	ObjectID        string `json:"objectId"`
+	Relation        string `json:"relation"`
+	SubjectType     string `json:"subjectType"`
+	SubjectID       string `json:"subjectId"`
+	SubjectRelation string `json:"subjectRelation,omitempty"`
+}
+
+func newTuple(projectID string, tuple *domain.RelationTuple) Tuple {
+	return Tuple{
+		ProjectID:       projectID,
+		ObjectType:      tuple.ObjectType,
+		ObjectID:        tuple.ObjectID,
+		Relation:        tuple.Relation,
+		SubjectType:     tuple.Subject.Type,
+		SubjectID:       tuple.Subject.ID,
+		SubjectRelation: tuple.Subject.Relation,
+	}
+}
+
+func (t *Tuple) Domain() *domain.RelationTuple {
+	return &domain.RelationTuple{
+		ObjectType: t.ObjectType,
+		ObjectID:   t.ObjectID,
+		Relation:   t.Relation,
+		Subject: domain.RelationSubject{
+			Type:     t.SubjectType,
+			ID:       t.SubjectID,
+			Relation: t.SubjectRelation,
+		},
+	}
+}
+
+type TupleWrittenEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Tuple
+}
+
+func (e *TupleWrittenEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *TupleWrittenEvent) Payload() any {
+	return e
+}
+
+func (e *TupleWrittenEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewTupleWrittenEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID string, tuple *domain.RelationTuple) *TupleWrittenEvent {
+	return &TupleWrittenEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, TupleWrittenEventType),
+		Tuple:     newTuple(projectID, tuple),
+	}
+}
+
+type TupleDeletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Tuple
+}
+
+func (e *TupleDeletedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *TupleDeletedEvent) Payload() any {
+	return e
+}
+
+func (e *TupleDeletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewTupleDeletedEvent(ctx context.Context, aggregate *eventstore.Aggregate, projectID string, tuple *domain.RelationTuple) *TupleDeletedEvent {
+	return &TupleDeletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, TupleDeletedEventType),
+		Tuple:     newTuple(projectID, tuple),
+	}
+}
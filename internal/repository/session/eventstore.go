@@ -16,6 +16,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailChallengedType, eventstore.GenericEventMapper[OTPEmailChallengedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailSentType, eventstore.GenericEventMapper[OTPEmailSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailCheckedType, eventstore.GenericEventMapper[OTPEmailCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDeviceCheckedType, eventstore.GenericEventMapper[TrustedDeviceCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskEvaluatedType, eventstore.GenericEventMapper[RiskEvaluatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskCheckFailedType, eventstore.GenericEventMapper[RiskCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskNotificationSentType, eventstore.GenericEventMapper[RiskNotificationSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TokenSetType, TokenSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MetadataSetType, MetadataSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LifetimeSetType, eventstore.GenericEventMapper[LifetimeSetEvent])
@@ -15,24 +15,28 @@ import (
 )
 
 const (
-	sessionEventPrefix     = "session."
-	AddedType              = sessionEventPrefix + "added"
-	UserCheckedType        = sessionEventPrefix + "user.checked"
-	PasswordCheckedType    = sessionEventPrefix + "password.checked"
-	IntentCheckedType      = sessionEventPrefix + "intent.checked"
-	WebAuthNChallengedType = sessionEventPrefix + "webAuthN.challenged"
-	WebAuthNCheckedType    = sessionEventPrefix + "webAuthN.checked"
-	TOTPCheckedType        = sessionEventPrefix + "totp.checked"
-	OTPSMSChallengedType   = sessionEventPrefix + "otp.sms.challenged"
-	OTPSMSSentType         = sessionEventPrefix + "otp.sms.sent"
-	OTPSMSCheckedType      = sessionEventPrefix + "otp.sms.checked"
-	OTPEmailChallengedType = sessionEventPrefix + "otp.email.challenged"
-	OTPEmailSentType       = sessionEventPrefix + "otp.email.sent"
-	OTPEmailCheckedType    = sessionEventPrefix + "otp.email.checked"
-	TokenSetType           = sessionEventPrefix + "token.set"
-	MetadataSetType        = sessionEventPrefix + "metadata.set"
-	LifetimeSetType        = sessionEventPrefix + "lifetime.set"
-	TerminateType          = sessionEventPrefix + "terminated"
+	sessionEventPrefix       = "session."
+	AddedType                = sessionEventPrefix + "added"
+	UserCheckedType          = sessionEventPrefix + "user.checked"
+	PasswordCheckedType      = sessionEventPrefix + "password.checked"
+	IntentCheckedType        = sessionEventPrefix + "intent.checked"
+	WebAuthNChallengedType   = sessionEventPrefix + "webAuthN.challenged"
+	WebAuthNCheckedType      = sessionEventPrefix + "webAuthN.checked"
+	TOTPCheckedType          = sessionEventPrefix + "totp.checked"
+	OTPSMSChallengedType     = sessionEventPrefix + "otp.sms.challenged"
+	OTPSMSSentType           = sessionEventPrefix + "otp.sms.sent"
+	OTPSMSCheckedType        = sessionEventPrefix + "otp.sms.checked"
+	OTPEmailChallengedType   = sessionEventPrefix + "otp.email.challenged"
+	OTPEmailSentType         = sessionEventPrefix + "otp.email.sent"
+	OTPEmailCheckedType      = sessionEventPrefix + "otp.email.checked"
+	TrustedDeviceCheckedType = sessionEventPrefix + "trusted_device.checked"
+	RiskEvaluatedType        = sessionEventPrefix + "risk.evaluated"
+	RiskCheckFailedType      = sessionEventPrefix + "risk.check.failed"
+	RiskNotificationSentType = sessionEventPrefix + "risk.notification.sent"
+	TokenSetType             = sessionEventPrefix + "token.set"
+	MetadataSetType          = sessionEventPrefix + "metadata.set"
+	LifetimeSetType          = sessionEventPrefix + "lifetime.set"
+	TerminateType            = sessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -542,6 +546,175 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+// TrustedDeviceCheckedEvent is pushed if the user agent of the session is a trusted device of the user,
+// which replaces the verification of a second factor.
+type TrustedDeviceCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID  string    `json:"deviceId"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *TrustedDeviceCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TrustedDeviceCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TrustedDeviceCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewTrustedDeviceCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	checkedAt time.Time,
+) *TrustedDeviceCheckedEvent {
+	return &TrustedDeviceCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TrustedDeviceCheckedType,
+		),
+		DeviceID:  deviceID,
+		CheckedAt: checkedAt,
+	}
+}
+
+// RiskEvaluatedEvent stores the risk assessment of the first authentication check of the session.
+// The events of a user further serve as history for the evaluation of later sessions.
+type RiskEvaluatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID    string              `json:"userId"`
+	IP        string              `json:"ip,omitempty"`
+	DeviceID  string              `json:"deviceId,omitempty"`
+	Country   string              `json:"country,omitempty"`
+	ASN       string              `json:"asn,omitempty"`
+	Latitude  float64             `json:"latitude,omitempty"`
+	Longitude float64             `json:"longitude,omitempty"`
+	Score     int                 `json:"score"`
+	Signals   []domain.RiskSignal `json:"signals,omitempty"`
+	Action    domain.RiskAction   `json:"action"`
+}
+
+func (e *RiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskEvaluatedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	ip,
+	deviceID,
+	country,
+	asn string,
+	latitude,
+	longitude float64,
+	score int,
+	signals []domain.RiskSignal,
+	action domain.RiskAction,
+) *RiskEvaluatedEvent {
+	return &RiskEvaluatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskEvaluatedType,
+		),
+		UserID:    userID,
+		IP:        ip,
+		DeviceID:  deviceID,
+		Country:   country,
+		ASN:       asn,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Score:     score,
+		Signals:   signals,
+		Action:    action,
+	}
+}
+
+// RiskCheckFailedEvent is pushed in addition to the failure events of a check,
+// so failures across different users from the same IP can be detected.
+type RiskCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId,omitempty"`
+	IP     string `json:"ip"`
+}
+
+func (e *RiskCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskCheckFailedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	ip string,
+) *RiskCheckFailedEvent {
+	return &RiskCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskCheckFailedType,
+		),
+		UserID: userID,
+		IP:     ip,
+	}
+}
+
+// RiskNotificationSentEvent is pushed after the user was notified about the sign-in
+// from an unrecognized device of the session.
+type RiskNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RiskNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskNotificationSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *RiskNotificationSentEvent {
+	return &RiskNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskNotificationSentType,
+		),
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -16,6 +16,31 @@ const (
 	RemovedEventType                      = eventTypePrefix + "removed"
 )
 
+// TLS is the mutual TLS configuration used to call the target.
+type TLS struct {
+	ClientCertificate []byte              `json:"clientCertificate,omitempty"`
+	ClientKey         *crypto.CryptoValue `json:"clientKey,omitempty"`
+	CACertificates    []byte              `json:"caCertificates,omitempty"`
+}
+
+// IsEmpty returns if the configuration was removed.
+func (t *TLS) IsEmpty() bool {
+	return t == nil || (len(t.ClientCertificate) == 0 && t.ClientKey == nil && len(t.CACertificates) == 0)
+}
+
+// OAuth2 are the client credentials used to request a bearer token for calls to the target.
+type OAuth2 struct {
+	TokenEndpoint string              `json:"tokenEndpoint,omitempty"`
+	ClientID      string              `json:"clientId,omitempty"`
+	ClientSecret  *crypto.CryptoValue `json:"clientSecret,omitempty"`
+	Scopes        []string            `json:"scopes,omitempty"`
+}
+
+// IsEmpty returns if the configuration was removed.
+func (o *OAuth2) IsEmpty() bool {
+	return o == nil || (o.TokenEndpoint == "" && o.ClientID == "" && o.ClientSecret == nil)
+}
+
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -25,6 +50,9 @@ type AddedEvent struct {
 	Timeout          time.Duration       `json:"timeout"`
 	InterruptOnError bool                `json:"interruptOnError"`
 	SigningKey       *crypto.CryptoValue `json:"signingKey"`
+	TLS              *TLS                `json:"tls,omitempty"`
+	OAuth2           *OAuth2             `json:"oauth2,omitempty"`
+	Module           []byte              `json:"module,omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -48,12 +76,15 @@ func NewAddedEvent(
 	timeout time.Duration,
 	interruptOnError bool,
 	signingKey *crypto.CryptoValue,
+	tls *TLS,
+	oauth2 *OAuth2,
+	module []byte,
 ) *AddedEvent {
 	return &AddedEvent{
 		*eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		name, targetType, endpoint, timeout, interruptOnError, signingKey}
+		name, targetType, endpoint, timeout, interruptOnError, signingKey, tls, oauth2, module}
 }
 
 type ChangedEvent struct {
@@ -65,6 +96,9 @@ type ChangedEvent struct {
 	Timeout          *time.Duration      `json:"timeout,omitempty"`
 	InterruptOnError *bool               `json:"interruptOnError,omitempty"`
 	SigningKey       *crypto.CryptoValue `json:"signingKey,omitempty"`
+	TLS              *TLS                `json:"tls,omitempty"`
+	OAuth2           *OAuth2             `json:"oauth2,omitempty"`
+	Module           []byte              `json:"module,omitempty"`
 
 	oldName string
 }
@@ -144,6 +178,29 @@ func ChangeSigningKey(signingKey *crypto.CryptoValue) func(event *ChangedEvent)
 	}
 }
 
+// ChangeTLS sets the mutual TLS configuration of the target,
+// an empty configuration removes it.
+func ChangeTLS(tls *TLS) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.TLS = tls
+	}
+}
+
+// ChangeOAuth2 sets the client credentials of the target,
+// an empty configuration removes them.
+func ChangeOAuth2(oauth2 *OAuth2) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.OAuth2 = oauth2
+	}
+}
+
+// ChangeModule sets the WebAssembly module run by the target.
+func ChangeModule(module []byte) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Module = module
+	}
+}
+
 type RemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
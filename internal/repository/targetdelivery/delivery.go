@@ -0,0 +1,197 @@
+package targetdelivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix    eventstore.EventType = "target_delivery."
+	RequestedType                           = eventTypePrefix + "requested"
+	RetryRequestedType                      = eventTypePrefix + "retry.requested"
+	SucceededType                           = eventTypePrefix + "succeeded"
+	FailedType                              = eventTypePrefix + "failed"
+	ReplayedType                            = eventTypePrefix + "replayed"
+)
+
+// Request is the call of an async target, which is delivered by the worker
+type Request struct {
+	TargetID    string `json:"targetID"`
+	ExecutionID string `json:"executionID,omitempty"`
+	// IdempotencyKey is part of the signed payload, so that the target can detect repeated deliveries
+	IdempotencyKey string `json:"idempotencyKey"`
+	// Payload is the encrypted body sent to the target, as it can contain personal data of the users
+	Payload *crypto.CryptoValue `json:"payload"`
+	// ReplayOf is the ID of the failed delivery this delivery replays
+	ReplayOf string `json:"replayOf,omitempty"`
+}
+
+type RequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Request `json:"request"`
+}
+
+func (e *RequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RequestedEvent) Payload() any {
+	return e
+}
+
+func (e *RequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	targetID,
+	executionID,
+	idempotencyKey string,
+	payload *crypto.CryptoValue,
+	replayOf string,
+) *RequestedEvent {
+	return &RequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, RequestedType,
+		),
+		Request: Request{
+			TargetID:       targetID,
+			ExecutionID:    executionID,
+			IdempotencyKey: idempotencyKey,
+			Payload:        payload,
+			ReplayOf:       replayOf,
+		},
+	}
+}
+
+type RetryRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Request `json:"request"`
+	Error   string        `json:"error"`
+	BackOff time.Duration `json:"backOff"`
+	// Attempts is the number of failed attempts so far
+	Attempts uint16 `json:"attempts"`
+}
+
+func (e *RetryRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RetryRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *RetryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRetryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	request Request,
+	backOff time.Duration,
+	attempts uint16,
+	errorMessage string,
+) *RetryRequestedEvent {
+	return &RetryRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, RetryRequestedType,
+		),
+		Request:  request,
+		BackOff:  backOff,
+		Attempts: attempts,
+		Error:    errorMessage,
+	}
+}
+
+type SucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Attempts uint16 `json:"attempts"`
+}
+
+func (e *SucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *SucceededEvent) Payload() any {
+	return e
+}
+
+func (e *SucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSucceededEvent(ctx context.Context, aggregate *eventstore.Aggregate, attempts uint16) *SucceededEvent {
+	return &SucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, SucceededType,
+		),
+		Attempts: attempts,
+	}
+}
+
+// FailedEvent moves the delivery to the dead letters, it will not be retried anymore but can be replayed
+type FailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Error    string `json:"error"`
+	Attempts uint16 `json:"attempts"`
+}
+
+func (e *FailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *FailedEvent) Payload() any {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, attempts uint16, errorMessage string) *FailedEvent {
+	return &FailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, FailedType,
+		),
+		Attempts: attempts,
+		Error:    errorMessage,
+	}
+}
+
+// ReplayedEvent marks a failed delivery as replayed by a new delivery
+type ReplayedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ReplayID string `json:"replayID"`
+}
+
+func (e *ReplayedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ReplayedEvent) Payload() any {
+	return e
+}
+
+func (e *ReplayedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewReplayedEvent(ctx context.Context, aggregate *eventstore.Aggregate, replayID string) *ReplayedEvent {
+	return &ReplayedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, ReplayedType,
+		),
+		ReplayID: replayID,
+	}
+}
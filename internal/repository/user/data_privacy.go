@@ -0,0 +1,259 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	dataExportEventPrefix       = userEventTypePrefix + "data.export."
+	DataExportRequestedType     = dataExportEventPrefix + "requested"
+	DataExportCompletedType     = dataExportEventPrefix + "completed"
+	DataExportDownloadedType    = dataExportEventPrefix + "downloaded"
+	deletionEventPrefix         = userEventTypePrefix + "deletion."
+	DeletionScheduledType       = deletionEventPrefix + "scheduled"
+	DeletionCanceledType        = deletionEventPrefix + "canceled"
+	deletionReminderEventPrefix = deletionEventPrefix + "reminder."
+	DeletionReminderAddedType   = deletionReminderEventPrefix + "added"
+	DeletionReminderSentType    = deletionReminderEventPrefix + "sent"
+)
+
+// DataExportRequestedEvent is pushed when the user requests an export of all its data.
+// The archive is generated asynchronously by the notification handler.
+type DataExportRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID string,
+) *DataExportRequestedEvent {
+	return &DataExportRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportRequestedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+// DataExportCompletedEvent is pushed as soon as the archive is stored and can be downloaded.
+type DataExportCompletedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportCompletedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportCompletedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportCompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportCompletedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID string,
+) *DataExportCompletedEvent {
+	return &DataExportCompletedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportCompletedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+// DataExportDownloadedEvent is pushed when the archive was downloaded.
+// The archive is removed from the storage afterwards and can't be downloaded again.
+type DataExportDownloadedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportDownloadedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportDownloadedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportDownloadedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportDownloadedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	exportID string,
+) *DataExportDownloadedEvent {
+	return &DataExportDownloadedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportDownloadedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+// DeletionScheduledEvent is pushed when the user requests the deletion of its account
+// and the privacy policy defines a grace period. The user is deleted after the grace period
+// measured from the creation date of the event.
+type DeletionScheduledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	GracePeriod time.Duration `json:"gracePeriod"`
+}
+
+func (e *DeletionScheduledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DeletionScheduledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeletionScheduledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// DeletionDate returns the date after which the user is deleted.
+func (e *DeletionScheduledEvent) DeletionDate() time.Time {
+	return e.CreationDate().Add(e.GracePeriod)
+}
+
+func NewDeletionScheduledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	gracePeriod time.Duration,
+) *DeletionScheduledEvent {
+	return &DeletionScheduledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeletionScheduledType,
+		),
+		GracePeriod: gracePeriod,
+	}
+}
+
+type DeletionCanceledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeletionCanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DeletionCanceledEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *DeletionCanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeletionCanceledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *DeletionCanceledEvent {
+	return &DeletionCanceledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeletionCanceledType,
+		),
+	}
+}
+
+// DeletionReminderAddedEvent is pushed once per scheduled deletion before the user is deleted.
+// The user is notified about it by the notification handler.
+type DeletionReminderAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeletionDate time.Time `json:"deletionDate"`
+}
+
+func (e *DeletionReminderAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DeletionReminderAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeletionReminderAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeletionReminderAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deletionDate time.Time,
+) *DeletionReminderAddedEvent {
+	return &DeletionReminderAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeletionReminderAddedType,
+		),
+		DeletionDate: deletionDate,
+	}
+}
+
+type DeletionReminderSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeletionReminderSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DeletionReminderSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *DeletionReminderSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeletionReminderSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *DeletionReminderSentEvent {
+	return &DeletionReminderSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeletionReminderSentType,
+		),
+	}
+}
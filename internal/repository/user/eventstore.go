@@ -141,4 +141,25 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCodeSentType, eventstore.GenericEventMapper[HumanInviteCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckSucceededType, eventstore.GenericEventMapper[HumanInviteCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckFailedType, eventstore.GenericEventMapper[HumanInviteCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceAddedType, eventstore.GenericEventMapper[HumanTrustedDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceSeenType, eventstore.GenericEventMapper[HumanTrustedDeviceSeenEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceRemovedType, eventstore.GenericEventMapper[HumanTrustedDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRiskEvaluatedType, eventstore.GenericEventMapper[HumanRiskEvaluatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRiskCheckFailedType, eventstore.GenericEventMapper[HumanRiskCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangeUndoCodeAddedType, eventstore.GenericEventMapper[HumanEmailChangeUndoCodeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangeUndoCodeSentType, eventstore.GenericEventMapper[HumanEmailChangeUndoCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangeUndoneType, eventstore.GenericEventMapper[HumanEmailChangeUndoneEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangeUndoFailedType, eventstore.GenericEventMapper[HumanEmailChangeUndoFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanSecurityNotificationSentType, eventstore.GenericEventMapper[HumanSecurityNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordExpiryNotificationAddedType, eventstore.GenericEventMapper[HumanPasswordExpiryNotificationAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordExpiryNotificationSentType, eventstore.GenericEventMapper[HumanPasswordExpiryNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityNotificationAddedType, eventstore.GenericEventMapper[InactivityNotificationAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityNotificationSentType, eventstore.GenericEventMapper[InactivityNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportRequestedType, eventstore.GenericEventMapper[DataExportRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportCompletedType, eventstore.GenericEventMapper[DataExportCompletedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportDownloadedType, eventstore.GenericEventMapper[DataExportDownloadedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeletionScheduledType, eventstore.GenericEventMapper[DeletionScheduledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeletionCanceledType, eventstore.GenericEventMapper[DeletionCanceledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeletionReminderAddedType, eventstore.GenericEventMapper[DeletionReminderAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeletionReminderSentType, eventstore.GenericEventMapper[DeletionReminderSentEvent])
 }
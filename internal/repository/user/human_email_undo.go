@@ -0,0 +1,149 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	emailChangeUndoEventPrefix        = emailEventPrefix + "change.undo."
+	HumanEmailChangeUndoCodeAddedType = emailChangeUndoEventPrefix + "code.added"
+	HumanEmailChangeUndoCodeSentType  = emailChangeUndoEventPrefix + "code.sent"
+	HumanEmailChangeUndoneType        = emailChangeUndoEventPrefix + "succeeded"
+	HumanEmailChangeUndoFailedType    = emailChangeUndoEventPrefix + "failed"
+)
+
+// HumanEmailChangeUndoCodeAddedEvent is pushed when the user is notified about a change of the email address.
+// The code allows to restore the previous email address and is sent to the previous email address.
+type HumanEmailChangeUndoCodeAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	PreviousEmail     domain.EmailAddress `json:"previousEmail"`
+	PreviousVerified  bool                `json:"previousVerified,omitempty"`
+	Code              *crypto.CryptoValue `json:"code,omitempty"`
+	Expiry            time.Duration       `json:"expiry,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *HumanEmailChangeUndoCodeAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanEmailChangeUndoCodeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanEmailChangeUndoCodeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanEmailChangeUndoCodeAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewHumanEmailChangeUndoCodeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	previousEmail domain.EmailAddress,
+	previousVerified bool,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+) *HumanEmailChangeUndoCodeAddedEvent {
+	return &HumanEmailChangeUndoCodeAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailChangeUndoCodeAddedType,
+		),
+		PreviousEmail:     previousEmail,
+		PreviousVerified:  previousVerified,
+		Code:              code,
+		Expiry:            expiry,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type HumanEmailChangeUndoCodeSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanEmailChangeUndoCodeSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanEmailChangeUndoCodeSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanEmailChangeUndoCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanEmailChangeUndoCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanEmailChangeUndoCodeSentEvent {
+	return &HumanEmailChangeUndoCodeSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailChangeUndoCodeSentType,
+		),
+	}
+}
+
+// HumanEmailChangeUndoneEvent is pushed after the previous email address was restored using the undo code.
+// It is always preceded by the [HumanEmailChangedEvent] restoring the address.
+type HumanEmailChangeUndoneEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanEmailChangeUndoneEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanEmailChangeUndoneEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanEmailChangeUndoneEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanEmailChangeUndoneEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanEmailChangeUndoneEvent {
+	return &HumanEmailChangeUndoneEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailChangeUndoneType,
+		),
+	}
+}
+
+type HumanEmailChangeUndoFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanEmailChangeUndoFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanEmailChangeUndoFailedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanEmailChangeUndoFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanEmailChangeUndoFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanEmailChangeUndoFailedEvent {
+	return &HumanEmailChangeUndoFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailChangeUndoFailedType,
+		),
+	}
+}
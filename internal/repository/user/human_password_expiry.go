@@ -0,0 +1,90 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	passwordExpiryEventPrefix                = passwordEventPrefix + "expiry.notification."
+	HumanPasswordExpiryNotificationAddedType = passwordExpiryEventPrefix + "added"
+	HumanPasswordExpiryNotificationSentType  = passwordExpiryEventPrefix + "sent"
+)
+
+// HumanPasswordExpiryNotificationAddedEvent is pushed when the password of the user reached a threshold
+// of the password age policy. It's only pushed once per threshold and password,
+// the user is notified about it by the notification handler.
+type HumanPasswordExpiryNotificationAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Threshold       domain.PasswordExpiryThreshold `json:"threshold"`
+	PasswordChanged time.Time                      `json:"passwordChanged"`
+	ExpirationDate  time.Time                      `json:"expirationDate"`
+}
+
+func (e *HumanPasswordExpiryNotificationAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanPasswordExpiryNotificationAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPasswordExpiryNotificationAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	threshold domain.PasswordExpiryThreshold,
+	passwordChanged,
+	expirationDate time.Time,
+) *HumanPasswordExpiryNotificationAddedEvent {
+	return &HumanPasswordExpiryNotificationAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationAddedType,
+		),
+		Threshold:       threshold,
+		PasswordChanged: passwordChanged,
+		ExpirationDate:  expirationDate,
+	}
+}
+
+type HumanPasswordExpiryNotificationSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Threshold domain.PasswordExpiryThreshold `json:"threshold"`
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPasswordExpiryNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPasswordExpiryNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	threshold domain.PasswordExpiryThreshold,
+) *HumanPasswordExpiryNotificationSentEvent {
+	return &HumanPasswordExpiryNotificationSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordExpiryNotificationSentType,
+		),
+		Threshold: threshold,
+	}
+}
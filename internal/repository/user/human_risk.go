@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	riskEventPrefix          = humanEventPrefix + "risk."
+	HumanRiskEvaluatedType   = riskEventPrefix + "evaluated"
+	HumanRiskCheckFailedType = riskEventPrefix + "check.failed"
+)
+
+// HumanRiskEvaluatedEvent stores the risk assessment of the first factor of a sign-in on the login UI,
+// which is not based on a session.
+// Together with the risk events of the sessions, the events serve as history for the evaluation of later sign-ins.
+type HumanRiskEvaluatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	IP        string              `json:"ip,omitempty"`
+	DeviceID  string              `json:"deviceId,omitempty"`
+	Country   string              `json:"country,omitempty"`
+	ASN       string              `json:"asn,omitempty"`
+	Latitude  float64             `json:"latitude,omitempty"`
+	Longitude float64             `json:"longitude,omitempty"`
+	Score     int                 `json:"score"`
+	Signals   []domain.RiskSignal `json:"signals,omitempty"`
+	Action    domain.RiskAction   `json:"action"`
+}
+
+func (e *HumanRiskEvaluatedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanRiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	ip,
+	deviceID,
+	country,
+	asn string,
+	latitude,
+	longitude float64,
+	score int,
+	signals []domain.RiskSignal,
+	action domain.RiskAction,
+) *HumanRiskEvaluatedEvent {
+	return &HumanRiskEvaluatedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRiskEvaluatedType,
+		),
+		IP:        ip,
+		DeviceID:  deviceID,
+		Country:   country,
+		ASN:       asn,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Score:     score,
+		Signals:   signals,
+		Action:    action,
+	}
+}
+
+// HumanRiskCheckFailedEvent is pushed in addition to the failure events of a check on the login UI,
+// so failures across different users from the same IP can be detected.
+type HumanRiskCheckFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	IP string `json:"ip"`
+}
+
+func (e *HumanRiskCheckFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanRiskCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRiskCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanRiskCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	ip string,
+) *HumanRiskCheckFailedEvent {
+	return &HumanRiskCheckFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRiskCheckFailedType,
+		),
+		IP: ip,
+	}
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	HumanSecurityNotificationSentType = humanEventPrefix + "security.notification.sent"
+)
+
+// HumanSecurityNotificationSentEvent is pushed after the user was notified about a security relevant change
+// of the account, e.g. an added second factor.
+type HumanSecurityNotificationSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	MessageType string `json:"messageType"`
+}
+
+func (e *HumanSecurityNotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanSecurityNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanSecurityNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanSecurityNotificationSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, messageType string) *HumanSecurityNotificationSentEvent {
+	return &HumanSecurityNotificationSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSecurityNotificationSentType,
+		),
+		MessageType: messageType,
+	}
+}
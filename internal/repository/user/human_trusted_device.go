@@ -0,0 +1,126 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	trustedDeviceEventPrefix      = humanEventPrefix + "trusted_device."
+	HumanTrustedDeviceAddedType   = trustedDeviceEventPrefix + "added"
+	HumanTrustedDeviceSeenType    = trustedDeviceEventPrefix + "seen"
+	HumanTrustedDeviceRemovedType = trustedDeviceEventPrefix + "removed"
+)
+
+// HumanTrustedDeviceAddedEvent is pushed when the user chose to remember the device (user agent)
+// after a successful multi-factor verification.
+// Adding an already trusted device renews the trust.
+type HumanTrustedDeviceAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID    string `json:"deviceId"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	IP          string `json:"ip,omitempty"`
+}
+
+func (e *HumanTrustedDeviceAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanTrustedDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	fingerprint,
+	ip string,
+) *HumanTrustedDeviceAddedEvent {
+	return &HumanTrustedDeviceAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceAddedType,
+		),
+		DeviceID:    deviceID,
+		Fingerprint: fingerprint,
+		IP:          ip,
+	}
+}
+
+// HumanTrustedDeviceSeenEvent is pushed when a trusted device was used to skip the multi-factor verification.
+type HumanTrustedDeviceSeenEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+	IP       string `json:"ip,omitempty"`
+}
+
+func (e *HumanTrustedDeviceSeenEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanTrustedDeviceSeenEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceSeenEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceSeenEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	ip string,
+) *HumanTrustedDeviceSeenEvent {
+	return &HumanTrustedDeviceSeenEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceSeenType,
+		),
+		DeviceID: deviceID,
+		IP:       ip,
+	}
+}
+
+type HumanTrustedDeviceRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanTrustedDeviceRemovedEvent {
+	return &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
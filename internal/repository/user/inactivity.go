@@ -0,0 +1,102 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	inactivityEventPrefix           = userEventTypePrefix + "inactivity.notification."
+	InactivityNotificationAddedType = inactivityEventPrefix + "added"
+	InactivityNotificationSentType  = inactivityEventPrefix + "sent"
+)
+
+// ActivityEventTypes are the events of the user aggregate, which prove that the user is still in use
+// and therefore reset the period of inactivity of the inactivity policy.
+var ActivityEventTypes = []eventstore.EventType{
+	UserV1PasswordCheckSucceededType,
+	HumanPasswordCheckSucceededType,
+	UserIDPLoginCheckSucceededType,
+	HumanPasswordlessTokenCheckSucceededType,
+	MachineSecretCheckSucceededType,
+	UserTokenAddedType,
+	UserTokenV2AddedType,
+	UserReactivatedType,
+}
+
+// InactivityNotificationAddedEvent is pushed before the inactivity policy deactivates or deletes the user.
+// It's only pushed once per action and activity, the user is notified about it by the notification handler.
+type InactivityNotificationAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Action       domain.InactivityAction `json:"action"`
+	LastActivity time.Time               `json:"lastActivity"`
+	DueDate      time.Time               `json:"dueDate"`
+}
+
+func (e *InactivityNotificationAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityNotificationAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityNotificationAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.InactivityAction,
+	lastActivity,
+	dueDate time.Time,
+) *InactivityNotificationAddedEvent {
+	return &InactivityNotificationAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityNotificationAddedType,
+		),
+		Action:       action,
+		LastActivity: lastActivity,
+		DueDate:      dueDate,
+	}
+}
+
+type InactivityNotificationSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Action domain.InactivityAction `json:"action"`
+}
+
+func (e *InactivityNotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	action domain.InactivityAction,
+) *InactivityNotificationSentEvent {
+	return &InactivityNotificationSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityNotificationSentType,
+		),
+		Action: action,
+	}
+}
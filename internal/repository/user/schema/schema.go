@@ -7,6 +7,7 @@ import (
 	"github.com/muhlemmer/gu"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
 	"github.com/zitadel/zitadel/internal/eventstore"
 )
 
@@ -82,6 +83,7 @@ type UpdatedEvent struct {
 	Schema                 json.RawMessage            `json:"schema,omitempty"`
 	PossibleAuthenticators []domain.AuthenticatorType `json:"possibleAuthenticators,omitempty"`
 	SchemaRevision         *uint64                    `json:"schemaRevision,omitempty"`
+	Migrations             []*domain_schema.Migration `json:"migrations,omitempty"`
 	oldSchemaType          string
 	oldRevision            uint64
 }
@@ -143,6 +145,13 @@ func ChangePossibleAuthenticators(possibleAuthenticators []domain.AuthenticatorT
 	}
 }
 
+// ChangeMigrations sets the migrations, which are applied to the data of the users of the previous revision.
+func ChangeMigrations(migrations []*domain_schema.Migration) func(event *UpdatedEvent) {
+	return func(e *UpdatedEvent) {
+		e.Migrations = migrations
+	}
+}
+
 func IncreaseRevision(oldRevision uint64) func(event *UpdatedEvent) {
 	return func(e *UpdatedEvent) {
 		e.SchemaRevision = gu.Ptr(oldRevision + 1)
@@ -20,4 +20,16 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, PhoneCodeSentType, eventstore.GenericEventMapper[PhoneCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, PhoneVerifiedType, eventstore.GenericEventMapper[PhoneVerifiedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, PhoneVerificationFailedType, eventstore.GenericEventMapper[PhoneVerificationFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UsernameAddedType, eventstore.GenericEventMapper[UsernameAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UsernameRemovedType, eventstore.GenericEventMapper[UsernameRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PasswordChangedType, eventstore.GenericEventMapper[PasswordChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PasswordCodeAddedType, eventstore.GenericEventMapper[PasswordCodeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PasswordCodeSentType, eventstore.GenericEventMapper[PasswordCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PublicKeyAddedType, eventstore.GenericEventMapper[PublicKeyAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PublicKeyRemovedType, eventstore.GenericEventMapper[PublicKeyRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PATAddedType, eventstore.GenericEventMapper[PATAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PATRemovedType, eventstore.GenericEventMapper[PATRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, WebAuthNAddedType, eventstore.GenericEventMapper[WebAuthNAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, WebAuthNVerifiedType, eventstore.GenericEventMapper[WebAuthNVerifiedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, WebAuthNRemovedType, eventstore.GenericEventMapper[WebAuthNRemovedEvent])
 }
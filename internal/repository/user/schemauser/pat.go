@@ -0,0 +1,86 @@
+package schemauser
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	patEventPrefix = eventPrefix + "pat."
+	PATAddedType   = patEventPrefix + "added"
+	PATRemovedType = patEventPrefix + "removed"
+)
+
+type PATAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID             string    `json:"id"`
+	ExpirationDate time.Time `json:"expirationDate,omitempty"`
+	Scopes         []string  `json:"scopes,omitempty"`
+}
+
+func (e *PATAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PATAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PATAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPATAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	expirationDate time.Time,
+	scopes []string,
+) *PATAddedEvent {
+	return &PATAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PATAddedType,
+		),
+		ID:             id,
+		ExpirationDate: expirationDate,
+		Scopes:         scopes,
+	}
+}
+
+type PATRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id"`
+}
+
+func (e *PATRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PATRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PATRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPATRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *PATRemovedEvent {
+	return &PATRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PATRemovedType,
+		),
+		ID: id,
+	}
+}
@@ -0,0 +1,90 @@
+package schemauser
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	publicKeyEventPrefix = eventPrefix + "publickey."
+	PublicKeyAddedType   = publicKeyEventPrefix + "added"
+	PublicKeyRemovedType = publicKeyEventPrefix + "removed"
+)
+
+type PublicKeyAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID             string              `json:"id"`
+	KeyType        domain.AuthNKeyType `json:"type,omitempty"`
+	ExpirationDate time.Time           `json:"expirationDate,omitempty"`
+	PublicKey      []byte              `json:"publicKey,omitempty"`
+}
+
+func (e *PublicKeyAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PublicKeyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PublicKeyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPublicKeyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	keyType domain.AuthNKeyType,
+	expirationDate time.Time,
+	publicKey []byte,
+) *PublicKeyAddedEvent {
+	return &PublicKeyAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PublicKeyAddedType,
+		),
+		ID:             id,
+		KeyType:        keyType,
+		ExpirationDate: expirationDate,
+		PublicKey:      publicKey,
+	}
+}
+
+type PublicKeyRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id"`
+}
+
+func (e *PublicKeyRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *PublicKeyRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PublicKeyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPublicKeyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *PublicKeyRemovedEvent {
+	return &PublicKeyRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PublicKeyRemovedType,
+		),
+		ID: id,
+	}
+}
@@ -0,0 +1,94 @@
+package schemauser
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	usernameEventPrefix = eventPrefix + "username."
+	UsernameAddedType   = usernameEventPrefix + "added"
+	UsernameRemovedType = usernameEventPrefix + "removed"
+)
+
+type UsernameAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID                     string `json:"id"`
+	Username               string `json:"username"`
+	IsOrganizationSpecific bool   `json:"isOrganizationSpecific,omitempty"`
+}
+
+func (e *UsernameAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *UsernameAddedEvent) Payload() interface{} {
+	return e
+}
+
+// UniqueConstraints shares the constraint with the usernames of the other users,
+// so a username can only be used once for authentication.
+func (e *UsernameAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{user.NewAddUsernameUniqueConstraint(e.Username, e.Aggregate().ResourceOwner, e.IsOrganizationSpecific)}
+}
+
+func NewUsernameAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	username string,
+	isOrganizationSpecific bool,
+) *UsernameAddedEvent {
+	return &UsernameAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UsernameAddedType,
+		),
+		ID:                     id,
+		Username:               username,
+		IsOrganizationSpecific: isOrganizationSpecific,
+	}
+}
+
+type UsernameRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID                     string `json:"id"`
+	Username               string `json:"username"`
+	IsOrganizationSpecific bool   `json:"isOrganizationSpecific,omitempty"`
+}
+
+func (e *UsernameRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *UsernameRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UsernameRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{user.NewRemoveUsernameUniqueConstraint(e.Username, e.Aggregate().ResourceOwner, e.IsOrganizationSpecific)}
+}
+
+func NewUsernameRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	username string,
+	isOrganizationSpecific bool,
+) *UsernameRemovedEvent {
+	return &UsernameRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UsernameRemovedType,
+		),
+		ID:                     id,
+		Username:               username,
+		IsOrganizationSpecific: isOrganizationSpecific,
+	}
+}
@@ -0,0 +1,144 @@
+package schemauser
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	webAuthNEventPrefix  = eventPrefix + "webauthn."
+	WebAuthNAddedType    = webAuthNEventPrefix + "added"
+	WebAuthNVerifiedType = webAuthNEventPrefix + "verified"
+	WebAuthNRemovedType  = webAuthNEventPrefix + "removed"
+)
+
+type WebAuthNAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID               string                             `json:"id"`
+	Challenge        string                             `json:"challenge"`
+	UserVerification domain.UserVerificationRequirement `json:"userVerification,omitempty"`
+	RPID             string                             `json:"rpID,omitempty"`
+}
+
+func (e *WebAuthNAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *WebAuthNAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WebAuthNAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewWebAuthNAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	challenge string,
+	userVerification domain.UserVerificationRequirement,
+	rpID string,
+) *WebAuthNAddedEvent {
+	return &WebAuthNAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WebAuthNAddedType,
+		),
+		ID:               id,
+		Challenge:        challenge,
+		UserVerification: userVerification,
+		RPID:             rpID,
+	}
+}
+
+type WebAuthNVerifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID              string `json:"id"`
+	KeyID           []byte `json:"keyID"`
+	PublicKey       []byte `json:"publicKey"`
+	AttestationType string `json:"attestationType"`
+	AAGUID          []byte `json:"aaguid"`
+	SignCount       uint32 `json:"signCount"`
+	Name            string `json:"name"`
+	RPID            string `json:"rpID,omitempty"`
+}
+
+func (e *WebAuthNVerifiedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *WebAuthNVerifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WebAuthNVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewWebAuthNVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	keyID []byte,
+	publicKey []byte,
+	attestationType string,
+	aaguid []byte,
+	signCount uint32,
+	name string,
+	rpID string,
+) *WebAuthNVerifiedEvent {
+	return &WebAuthNVerifiedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WebAuthNVerifiedType,
+		),
+		ID:              id,
+		KeyID:           keyID,
+		PublicKey:       publicKey,
+		AttestationType: attestationType,
+		AAGUID:          aaguid,
+		SignCount:       signCount,
+		Name:            name,
+		RPID:            rpID,
+	}
+}
+
+type WebAuthNRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID string `json:"id"`
+}
+
+func (e *WebAuthNRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *WebAuthNRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WebAuthNRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewWebAuthNRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+) *WebAuthNRemovedEvent {
+	return &WebAuthNRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WebAuthNRemovedType,
+		),
+		ID: id,
+	}
+}
@@ -50,10 +50,16 @@ func NewRemoveUsernameUniqueConstraint(userName, resourceOwner string, userLogin
 
 type UserLockedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// Lockout is set if the user was locked because the lockout policy was exceeded
+	Lockout bool `json:"lockout,omitempty"`
 }
 
 func (e *UserLockedEvent) Payload() interface{} {
-	return nil
+	if !e.Lockout {
+		return nil
+	}
+	return e
 }
 
 func (e *UserLockedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
@@ -70,10 +76,22 @@ func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *U
 	}
 }
 
+// NewUserLockedByLockoutEvent is pushed if the user exceeded the maximum attempts of the lockout policy.
+func NewUserLockedByLockoutEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLockedEvent {
+	e := NewUserLockedEvent(ctx, aggregate)
+	e.Lockout = true
+	return e
+}
+
 func UserLockedEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &UserLockedEvent{
+	lockedEvent := &UserLockedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(lockedEvent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Lk3oPq", "unable to unmarshal user locked")
+	}
+	return lockedEvent, nil
 }
 
 type UserUnlockedEvent struct {
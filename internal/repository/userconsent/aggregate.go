@@ -0,0 +1,20 @@
+package userconsent
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "user_consent"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the consents of a user,
+// the ID is the ID of the user and the resource owner the organization of the user
+func NewAggregate(userID, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            userID,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,83 @@
+package userconsent
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix eventstore.EventType = "user_consent."
+	GrantedType                          = eventTypePrefix + "granted"
+	RevokedType                          = eventTypePrefix + "revoked"
+)
+
+type GrantedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID  string   `json:"clientId"`
+	ProjectID string   `json:"projectId,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+func (e *GrantedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *GrantedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// NewGrantedEvent stores the scopes the user consented to for the client,
+// the scopes replace the ones of a previous consent
+func NewGrantedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	projectID string,
+	scopes []string,
+) *GrantedEvent {
+	return &GrantedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, GrantedType,
+		),
+		ClientID:  clientID,
+		ProjectID: projectID,
+		Scopes:    scopes,
+	}
+}
+
+type RevokedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientId"`
+}
+
+func (e *RevokedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RevokedEvent) Payload() any {
+	return e
+}
+
+func (e *RevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+) *RevokedEvent {
+	return &RevokedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, RevokedType,
+		),
+		ClientID: clientID,
+	}
+}
@@ -0,0 +1,10 @@
+package userconsent
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, GrantedType, eventstore.GenericEventMapper[GrantedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RevokedType, eventstore.GenericEventMapper[RevokedEvent])
+}
@@ -12,4 +12,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantCascadeRemovedType, UserGrantCascadeRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantDeactivatedType, UserGrantDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantReactivatedType, UserGrantReactivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantValiditySetType, eventstore.GenericEventMapper[UserGrantValiditySetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiredType, eventstore.GenericEventMapper[UserGrantExpiredEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiryNotificationAddedType, eventstore.GenericEventMapper[UserGrantExpiryNotificationAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserGrantExpiryNotificationSentType, eventstore.GenericEventMapper[UserGrantExpiryNotificationSentEvent])
 }
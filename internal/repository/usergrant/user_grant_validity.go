@@ -0,0 +1,174 @@
+package usergrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UserGrantValiditySetType               = userGrantEventTypePrefix + "validity.set"
+	UserGrantExpiredType                   = userGrantEventTypePrefix + "expired"
+	userGrantExpiryNotificationEventPrefix = userGrantEventTypePrefix + "expiry.notification."
+	UserGrantExpiryNotificationAddedType   = userGrantExpiryNotificationEventPrefix + "added"
+	UserGrantExpiryNotificationSentType    = userGrantExpiryNotificationEventPrefix + "sent"
+)
+
+// UserGrantValiditySetEvent restricts the user grant to a period of time.
+// Zero values remove the restriction on the respective side.
+type UserGrantValiditySetEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ValidFrom  time.Time `json:"validFrom"`
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *UserGrantValiditySetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserGrantValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validFrom,
+	validUntil time.Time,
+) *UserGrantValiditySetEvent {
+	return &UserGrantValiditySetEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantValiditySetType,
+		),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+// UserGrantExpiredEvent is pushed by the scheduler after the validity of the user grant ended.
+// The user grant is removed the same way as by the [UserGrantRemovedEvent].
+type UserGrantExpiredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ValidUntil time.Time `json:"validUntil"`
+
+	userID         string
+	projectID      string
+	projectGrantID string
+}
+
+func (e *UserGrantExpiredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserGrantExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.userID, e.projectID, e.projectGrantID)}
+}
+
+func NewUserGrantExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	validUntil time.Time,
+) *UserGrantExpiredEvent {
+	return &UserGrantExpiredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiredType,
+		),
+		ValidUntil:     validUntil,
+		userID:         userID,
+		projectID:      projectID,
+		projectGrantID: projectGrantID,
+	}
+}
+
+// UserGrantExpiryNotificationAddedEvent is pushed once the end of the validity of the user grant approaches.
+// The grantee and the managers of the organization are notified about it by the notification handler.
+type UserGrantExpiryNotificationAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	ProjectID  string    `json:"projectId"`
+	ValidUntil time.Time `json:"validUntil"`
+}
+
+func (e *UserGrantExpiryNotificationAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserGrantExpiryNotificationAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiryNotificationAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiryNotificationAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID string,
+	validUntil time.Time,
+) *UserGrantExpiryNotificationAddedEvent {
+	return &UserGrantExpiryNotificationAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiryNotificationAddedType,
+		),
+		UserID:     userID,
+		ProjectID:  projectID,
+		ValidUntil: validUntil,
+	}
+}
+
+// UserGrantExpiryNotificationSentEvent is pushed for every recipient of the expiry notification.
+type UserGrantExpiryNotificationSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	RecipientID string `json:"recipientId"`
+}
+
+func (e *UserGrantExpiryNotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *UserGrantExpiryNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiryNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiryNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	recipientID string,
+) *UserGrantExpiryNotificationSentEvent {
+	return &UserGrantExpiryNotificationSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiryNotificationSentType,
+		),
+		RecipientID: recipientID,
+	}
+}
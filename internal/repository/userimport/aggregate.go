@@ -0,0 +1,20 @@
+package userimport
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "user_import"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of the import job,
+// which is owned by the organization the users are imported to.
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,12 @@
+package userimport
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, StartedEventType, eventstore.GenericEventMapper[StartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RowsProcessedEventType, eventstore.GenericEventMapper[RowsProcessedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CompletedEventType, eventstore.GenericEventMapper[CompletedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CanceledEventType, eventstore.GenericEventMapper[CanceledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, FailedEventType, eventstore.GenericEventMapper[FailedEvent])
+}
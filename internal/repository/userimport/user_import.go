@@ -0,0 +1,209 @@
+package userimport
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix        eventstore.EventType = "user_import."
+	AddedEventType                              = eventTypePrefix + "added"
+	StartedEventType                            = eventTypePrefix + "started"
+	RowsProcessedEventType                      = eventTypePrefix + "rows.processed"
+	CompletedEventType                          = eventTypePrefix + "completed"
+	CanceledEventType                           = eventTypePrefix + "canceled"
+	FailedEventType                             = eventTypePrefix + "failed"
+
+	UniqueStartedUserImport = "user_import_started"
+)
+
+// NewAddStartedUniqueConstraint ensures a job is only started by one worker.
+func NewAddStartedUniqueConstraint(jobID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueStartedUserImport,
+		jobID,
+		"Errors.UserImport.AlreadyStarted")
+}
+
+// AddedEvent is pushed when an import of users is requested.
+// The file was uploaded to the asset storage before and is processed asynchronously.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Format domain.UserImportFormat `json:"format"`
+	DryRun bool                    `json:"dryRun,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	format domain.UserImportFormat,
+	dryRun bool,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, AddedEventType),
+		Format:    format,
+		DryRun:    dryRun,
+	}
+}
+
+type StartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *StartedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *StartedEvent) Payload() any {
+	return nil
+}
+
+func (e *StartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddStartedUniqueConstraint(e.Aggregate().ID)}
+}
+
+func NewStartedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *StartedEvent {
+	return &StartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, StartedEventType),
+	}
+}
+
+// RowError describes why a row of the import could not be imported.
+// Row is the 1-based number of the row in the file, not counting the header of CSV files.
+type RowError struct {
+	Row     uint64 `json:"row"`
+	UserID  string `json:"userId,omitempty"`
+	Message string `json:"message"`
+}
+
+// RowsProcessedEvent is pushed after each processed batch of rows.
+// ProcessedRows and FailedRows are the totals of the job, Errors only contains the errors of the batch.
+// Offset is the byte offset of the next unprocessed row in the file.
+type RowsProcessedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProcessedRows uint64      `json:"processedRows"`
+	Offset        int64       `json:"offset"`
+	FailedRows    uint64      `json:"failedRows"`
+	Errors        []*RowError `json:"errors,omitempty"`
+}
+
+func (e *RowsProcessedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RowsProcessedEvent) Payload() any {
+	return e
+}
+
+func (e *RowsProcessedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRowsProcessedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	processedRows uint64,
+	offset int64,
+	failedRows uint64,
+	errors []*RowError,
+) *RowsProcessedEvent {
+	return &RowsProcessedEvent{
+		BaseEvent:     *eventstore.NewBaseEventForPush(ctx, aggregate, RowsProcessedEventType),
+		ProcessedRows: processedRows,
+		Offset:        offset,
+		FailedRows:    failedRows,
+		Errors:        errors,
+	}
+}
+
+// CompletedEvent is pushed after the last row of the file was processed.
+// TotalRows is only known at this point, as the file is parsed while it is processed.
+type CompletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TotalRows uint64 `json:"totalRows"`
+}
+
+func (e *CompletedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CompletedEvent) Payload() any {
+	return e
+}
+
+func (e *CompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCompletedEvent(ctx context.Context, aggregate *eventstore.Aggregate, totalRows uint64) *CompletedEvent {
+	return &CompletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, CompletedEventType),
+		TotalRows: totalRows,
+	}
+}
+
+type CanceledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *CanceledEvent) Payload() any {
+	return nil
+}
+
+func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate) *CanceledEvent {
+	return &CanceledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, CanceledEventType),
+	}
+}
+
+// FailedEvent is pushed if the job could not be processed at all, e.g. because the file is no longer available.
+// Errors of single rows don't fail the job.
+type FailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reason string `json:"reason"`
+}
+
+func (e *FailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *FailedEvent) Payload() any {
+	return e
+}
+
+func (e *FailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, reason string) *FailedEvent {
+	return &FailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, FailedEventType),
+		Reason:    reason,
+	}
+}
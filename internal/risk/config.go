@@ -0,0 +1,37 @@
+package risk
+
+import (
+	"time"
+)
+
+type Config struct {
+	// Enabled activates the evaluation of session checks
+	Enabled bool
+	// GeoIPDatabase is the path to a MaxMind DB (MMDB) file with the country and location of networks,
+	// e.g. GeoLite2 City, if empty no location based signals are detected
+	GeoIPDatabase string
+	// GeoIPASNDatabase is the path to a MaxMind DB (MMDB) file with the autonomous system of networks,
+	// e.g. GeoLite2 ASN, if empty the new ASN signal is not detected
+	GeoIPASNDatabase string
+	// HistoryWindow defines how far back previous sign-ins of a user are considered
+	HistoryWindow time.Duration
+	// HistoryLimit defines the maximum amount of previous sign-ins of a user to be considered
+	HistoryLimit uint16
+	// ImpossibleTravelSpeed in km/h, which must not be exceeded between two sign-ins of a user
+	ImpossibleTravelSpeed float64
+	// FailureWindow defines how far back failed checks from the same IP are considered
+	FailureWindow time.Duration
+	// FailureAccounts is the amount of distinct users with failed checks from the same IP,
+	// from which on the IPFailures signal is raised
+	FailureAccounts int
+	Weights         Weights
+}
+
+// Weights defines the score each detected signal adds to the assessment
+type Weights struct {
+	NewCountry       int
+	NewASN           int
+	ImpossibleTravel int
+	NewDevice        int
+	IPFailures       int
+}
@@ -0,0 +1,173 @@
+package risk
+
+import (
+	"math"
+	"net"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	earthRadiusKm = 6371
+	// travelToleranceKm ignores distances within the inaccuracy of GeoIP databases
+	travelToleranceKm = 100
+)
+
+// Engine evaluates the risk of a sign-in based on the previous sign-ins of the user
+// and the failed checks from the same IP.
+type Engine struct {
+	config Config
+	geoIP  *GeoIP
+}
+
+// NewEngine returns the engine for the provided [Config].
+// If the risk evaluation is disabled, nil is returned.
+func NewEngine(config Config) (*Engine, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	engine := &Engine{config: config}
+	if config.GeoIPDatabase == "" {
+		return engine, nil
+	}
+	geoIP, err := LoadGeoIP(config.GeoIPDatabase, config.GeoIPASNDatabase)
+	if err != nil {
+		return nil, err
+	}
+	engine.geoIP = geoIP
+	return engine, nil
+}
+
+// Config returns the configuration of the engine
+func (e *Engine) Config() Config {
+	return e.config
+}
+
+// Attempt is a (previous) sign-in of a user
+type Attempt struct {
+	IP       net.IP
+	DeviceID string
+	Time     time.Time
+	Location *Location
+}
+
+// Assessment is the result of the evaluation of an [Attempt]
+type Assessment struct {
+	Score    int
+	Signals  []domain.RiskSignal
+	Action   domain.RiskAction
+	Location *Location
+}
+
+// Locate resolves the location of the attempt's IP, if a GeoIP database is configured
+func (e *Engine) Locate(ip net.IP) *Location {
+	location, ok := e.geoIP.Lookup(ip)
+	if !ok {
+		return nil
+	}
+	return location
+}
+
+// Evaluate the attempt against the history (previous attempts of the same user, latest first)
+// and the amount of distinct users with failed checks from the attempt's IP.
+// The action is defined by the thresholds of the login policy of the user.
+func (e *Engine) Evaluate(attempt *Attempt, history []*Attempt, failedAccounts int, thresholds domain.RiskThresholds) *Assessment {
+	if attempt.Location == nil {
+		attempt.Location = e.Locate(attempt.IP)
+	}
+	assessment := &Assessment{
+		Location: attempt.Location,
+	}
+	// without any known sign-in, everything would be new
+	if len(history) > 0 {
+		e.evaluateHistory(assessment, attempt, history)
+	}
+	if e.config.FailureAccounts > 0 && failedAccounts >= e.config.FailureAccounts {
+		assessment.addSignal(domain.RiskSignalIPFailures, e.config.Weights.IPFailures)
+	}
+	assessment.Action = thresholds.Action(assessment.Score)
+	return assessment
+}
+
+func (e *Engine) evaluateHistory(assessment *Assessment, attempt *Attempt, history []*Attempt) {
+	if attempt.DeviceID != "" && !knownDevice(attempt.DeviceID, history) {
+		assessment.addSignal(domain.RiskSignalNewDevice, e.config.Weights.NewDevice)
+	}
+	if attempt.Location == nil {
+		return
+	}
+	if attempt.Location.Country != "" && !knownLocation(history, func(l *Location) bool { return l.Country == attempt.Location.Country }) {
+		assessment.addSignal(domain.RiskSignalNewCountry, e.config.Weights.NewCountry)
+	}
+	if attempt.Location.ASN != "" && !knownLocation(history, func(l *Location) bool { return l.ASN == attempt.Location.ASN }) {
+		assessment.addSignal(domain.RiskSignalNewASN, e.config.Weights.NewASN)
+	}
+	if e.impossibleTravel(attempt, latestLocated(history)) {
+		assessment.addSignal(domain.RiskSignalImpossibleTravel, e.config.Weights.ImpossibleTravel)
+	}
+}
+
+func (e *Engine) impossibleTravel(attempt, previous *Attempt) bool {
+	if e.config.ImpossibleTravelSpeed <= 0 || previous == nil {
+		return false
+	}
+	distance := distanceKm(previous.Location, attempt.Location)
+	if distance <= travelToleranceKm {
+		return false
+	}
+	hours := attempt.Time.Sub(previous.Time).Hours()
+	if hours <= 0 {
+		return true
+	}
+	return distance/hours > e.config.ImpossibleTravelSpeed
+}
+
+func (a *Assessment) addSignal(signal domain.RiskSignal, weight int) {
+	a.Signals = append(a.Signals, signal)
+	a.Score += weight
+}
+
+func knownDevice(deviceID string, history []*Attempt) bool {
+	for _, previous := range history {
+		if previous.DeviceID == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+func knownLocation(history []*Attempt, matches func(*Location) bool) bool {
+	for _, previous := range history {
+		if previous.Location != nil && matches(previous.Location) {
+			return true
+		}
+	}
+	return false
+}
+
+func latestLocated(history []*Attempt) *Attempt {
+	var latest *Attempt
+	for _, previous := range history {
+		if previous.Location == nil {
+			continue
+		}
+		if latest == nil || previous.Time.After(latest.Time) {
+			latest = previous
+		}
+	}
+	return latest
+}
+
+// distanceKm calculates the great-circle distance using the haversine formula
+func distanceKm(from, to *Location) float64 {
+	lat1, lat2 := radians(from.Latitude), radians(to.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(to.Longitude - from.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,173 @@
+package risk
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	testNow        = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testThresholds = domain.RiskThresholds{
+		Notify:     20,
+		RequireMFA: 40,
+		Block:      80,
+	}
+	zurich  = &Location{Country: "CH", ASN: "AS1", Latitude: 47.37, Longitude: 8.54}
+	geneva  = &Location{Country: "CH", ASN: "AS4", Latitude: 46.20, Longitude: 6.14}
+	newYork = &Location{Country: "US", ASN: "AS2", Latitude: 40.71, Longitude: -74.00}
+)
+
+func testEngine() *Engine {
+	return &Engine{
+		config: Config{
+			Enabled:               true,
+			ImpossibleTravelSpeed: 1000,
+			FailureAccounts:       5,
+			Weights: Weights{
+				NewCountry:       30,
+				NewASN:           10,
+				ImpossibleTravel: 50,
+				NewDevice:        20,
+				IPFailures:       40,
+			},
+		},
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	type args struct {
+		attempt        *Attempt
+		history        []*Attempt
+		failedAccounts int
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Assessment
+	}{
+		{
+			name: "no history, allow",
+			args: args{
+				attempt: &Attempt{DeviceID: "device1", Time: testNow, Location: zurich},
+			},
+			want: &Assessment{Action: domain.RiskActionAllow, Location: zurich},
+		},
+		{
+			name: "known device and location, allow",
+			args: args{
+				attempt: &Attempt{DeviceID: "device1", Time: testNow, Location: zurich},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{Action: domain.RiskActionAllow, Location: zurich},
+		},
+		{
+			name: "new device, notify",
+			args: args{
+				attempt: &Attempt{DeviceID: "device2", Time: testNow, Location: zurich},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{
+				Score:    20,
+				Signals:  []domain.RiskSignal{domain.RiskSignalNewDevice},
+				Action:   domain.RiskActionNotify,
+				Location: zurich,
+			},
+		},
+		{
+			name: "new asn within country, allow",
+			args: args{
+				attempt: &Attempt{DeviceID: "device1", Time: testNow, Location: geneva},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-24 * time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{
+				Score:    10,
+				Signals:  []domain.RiskSignal{domain.RiskSignalNewASN},
+				Action:   domain.RiskActionAllow,
+				Location: geneva,
+			},
+		},
+		{
+			name: "new country, require mfa",
+			args: args{
+				attempt: &Attempt{DeviceID: "device1", Time: testNow, Location: newYork},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-24 * time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{
+				Score:    40,
+				Signals:  []domain.RiskSignal{domain.RiskSignalNewCountry, domain.RiskSignalNewASN},
+				Action:   domain.RiskActionRequireMFA,
+				Location: newYork,
+			},
+		},
+		{
+			name: "impossible travel, block",
+			args: args{
+				attempt: &Attempt{DeviceID: "device1", Time: testNow, Location: newYork},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{
+				Score:    90,
+				Signals:  []domain.RiskSignal{domain.RiskSignalNewCountry, domain.RiskSignalNewASN, domain.RiskSignalImpossibleTravel},
+				Action:   domain.RiskActionBlock,
+				Location: newYork,
+			},
+		},
+		{
+			name: "failures from ip, require mfa",
+			args: args{
+				attempt:        &Attempt{DeviceID: "device1", Time: testNow, Location: zurich},
+				failedAccounts: 5,
+			},
+			want: &Assessment{
+				Score:    40,
+				Signals:  []domain.RiskSignal{domain.RiskSignalIPFailures},
+				Action:   domain.RiskActionRequireMFA,
+				Location: zurich,
+			},
+		},
+		{
+			name: "unknown location, only device evaluated",
+			args: args{
+				attempt: &Attempt{IP: net.ParseIP("192.0.2.1"), DeviceID: "device2", Time: testNow},
+				history: []*Attempt{
+					{DeviceID: "device1", Time: testNow.Add(-time.Hour), Location: zurich},
+				},
+			},
+			want: &Assessment{
+				Score:   20,
+				Signals: []domain.RiskSignal{domain.RiskSignalNewDevice},
+				Action:  domain.RiskActionNotify,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testEngine().Evaluate(tt.args.attempt, tt.args.history, tt.args.failedAccounts, testThresholds)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewEngine(t *testing.T) {
+	engine, err := NewEngine(Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, engine)
+
+	_, err = NewEngine(Config{Enabled: true, GeoIPDatabase: "/does/not/exist.csv"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package risk
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Location of an IP address as resolved by the [GeoIP] databases
+type Location struct {
+	Country   string
+	ASN       string
+	Latitude  float64
+	Longitude float64
+}
+
+// GeoIP resolves IP addresses to their [Location] with MaxMind DB (MMDB) files,
+// e.g. the GeoLite2 or GeoIP2 City and ASN databases.
+type GeoIP struct {
+	location *maxminddb.Reader
+	asn      *maxminddb.Reader
+}
+
+// locationRecord is the part of the City and Country databases used for the [Location]
+type locationRecord struct {
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+// asnRecord is the part of the ASN database used for the [Location]
+type asnRecord struct {
+	AutonomousSystemNumber uint `maxminddb:"autonomous_system_number"`
+}
+
+// LoadGeoIP opens the location (City or Country) database and the optional ASN database from the provided paths
+func LoadGeoIP(locationPath, asnPath string) (*GeoIP, error) {
+	location, err := openMMDB(locationPath)
+	if err != nil {
+		return nil, err
+	}
+	geo := &GeoIP{location: location}
+	if asnPath == "" {
+		return geo, nil
+	}
+	if geo.asn, err = openMMDB(asnPath); err != nil {
+		return nil, err
+	}
+	return geo, nil
+}
+
+func openMMDB(path string) (*maxminddb.Reader, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RISK-Gw3kq", "Errors.Session.Risk.GeoIP.OpenFailed")
+	}
+	if err = reader.Verify(); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "RISK-Gw3kr", "Errors.Session.Risk.GeoIP.Invalid")
+	}
+	return reader, nil
+}
+
+// Lookup returns the location of the network containing the ip,
+// the ASN is only set if an ASN database is configured
+func (g *GeoIP) Lookup(ip net.IP) (*Location, bool) {
+	if g == nil || len(ip) == 0 {
+		return nil, false
+	}
+	var record locationRecord
+	network, ok, err := g.location.LookupNetwork(ip, &record)
+	if err != nil || !ok || network == nil {
+		return nil, false
+	}
+	location := &Location{
+		Country:   record.Country.ISOCode,
+		Latitude:  record.Location.Latitude,
+		Longitude: record.Location.Longitude,
+	}
+	if g.asn == nil {
+		return location, true
+	}
+	var asn asnRecord
+	if _, ok, err = g.asn.LookupNetwork(ip, &asn); err == nil && ok && asn.AutonomousSystemNumber > 0 {
+		location.ASN = fmt.Sprintf("AS%d", asn.AutonomousSystemNumber)
+	}
+	return location, true
+}
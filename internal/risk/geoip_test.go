@@ -0,0 +1,252 @@
+package risk
+
+import (
+	"encoding/binary"
+	"math"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testLocationRecord(country string, latitude, longitude float64) map[string]any {
+	return map[string]any{
+		"country":  map[string]any{"iso_code": country},
+		"location": map[string]any{"latitude": latitude, "longitude": longitude},
+	}
+}
+
+func testASNRecord(asn uint32) map[string]any {
+	return map[string]any{"autonomous_system_number": asn}
+}
+
+func TestLoadGeoIP(t *testing.T) {
+	dir := t.TempDir()
+	location := writeTestMMDB(t, dir, "GeoLite2-City", map[string]map[string]any{
+		"192.0.2.0/24": testLocationRecord("CH", 47.37, 8.54),
+	})
+	invalid := filepath.Join(dir, "invalid.mmdb")
+	require.NoError(t, os.WriteFile(invalid, []byte("no mmdb"), 0600))
+
+	tests := []struct {
+		name         string
+		locationPath string
+		asnPath      string
+		wantErr      func(error) bool
+	}{
+		{
+			name:         "location only",
+			locationPath: location,
+		},
+		{
+			name:         "location and asn",
+			locationPath: location,
+			asnPath: writeTestMMDB(t, dir, "GeoLite2-ASN", map[string]map[string]any{
+				"192.0.2.0/24": testASNRecord(1),
+			}),
+		},
+		{
+			name:         "missing file, error",
+			locationPath: filepath.Join(dir, "missing.mmdb"),
+			wantErr:      zerrors.IsInternal,
+		},
+		{
+			name:         "invalid file, error",
+			locationPath: invalid,
+			wantErr:      zerrors.IsInternal,
+		},
+		{
+			name:         "invalid asn file, error",
+			locationPath: location,
+			asnPath:      invalid,
+			wantErr:      zerrors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadGeoIP(tt.locationPath, tt.asnPath)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGeoIP_Lookup(t *testing.T) {
+	dir := t.TempDir()
+	location := writeTestMMDB(t, dir, "GeoLite2-City", map[string]map[string]any{
+		"192.0.2.0/24":    testLocationRecord("CH", 47.37, 8.54),
+		"198.51.100.0/24": testLocationRecord("US", 40.71, -74.00),
+		"2001:db8::/32":   testLocationRecord("DE", 52.52, 13.40),
+	})
+	asn := writeTestMMDB(t, dir, "GeoLite2-ASN", map[string]map[string]any{
+		"192.0.2.0/24":  testASNRecord(1),
+		"2001:db8::/32": testASNRecord(3),
+	})
+	withASN, err := LoadGeoIP(location, asn)
+	require.NoError(t, err)
+	withoutASN, err := LoadGeoIP(location, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		geo    *GeoIP
+		ip     net.IP
+		want   *Location
+		wantOk bool
+	}{
+		{
+			name:   "ipv4",
+			geo:    withASN,
+			ip:     net.ParseIP("192.0.2.42"),
+			want:   &Location{Country: "CH", ASN: "AS1", Latitude: 47.37, Longitude: 8.54},
+			wantOk: true,
+		},
+		{
+			name:   "ipv4, no asn",
+			geo:    withASN,
+			ip:     net.ParseIP("198.51.100.255"),
+			want:   &Location{Country: "US", Latitude: 40.71, Longitude: -74.00},
+			wantOk: true,
+		},
+		{
+			name:   "ipv6",
+			geo:    withASN,
+			ip:     net.ParseIP("2001:db8::1"),
+			want:   &Location{Country: "DE", ASN: "AS3", Latitude: 52.52, Longitude: 13.40},
+			wantOk: true,
+		},
+		{
+			name:   "without asn database",
+			geo:    withoutASN,
+			ip:     net.ParseIP("192.0.2.42"),
+			want:   &Location{Country: "CH", Latitude: 47.37, Longitude: 8.54},
+			wantOk: true,
+		},
+		{
+			name: "unknown network",
+			geo:  withASN,
+			ip:   net.ParseIP("203.0.113.1"),
+		},
+		{
+			name: "no ip",
+			geo:  withASN,
+		},
+		{
+			name: "no database",
+			ip:   net.ParseIP("192.0.2.42"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.geo.Lookup(tt.ip)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// writeTestMMDB writes an IPv6 MaxMind DB with a record size of 24 bits containing the (not overlapping) networks,
+// see https://maxmind.github.io/MaxMind-DB/
+func writeTestMMDB(t *testing.T, dir, databaseType string, networks map[string]map[string]any) string {
+	type node struct {
+		children [2]*node
+		data     []byte
+	}
+	root := new(node)
+	for cidr, record := range networks {
+		prefix := netip.MustParsePrefix(cidr)
+		addr, bits := prefix.Addr().As16(), prefix.Bits()
+		if prefix.Addr().Is4() {
+			// IPv4 networks are stored in ::/96
+			addr, bits = netip.AddrFrom4(prefix.Addr().As4()).As16(), bits+96
+			clear(addr[:12])
+		}
+		current := root
+		for i := 0; i < bits; i++ {
+			bit := addr[i/8] >> (7 - i%8) & 1
+			if current.children[bit] == nil {
+				current.children[bit] = new(node)
+			}
+			current = current.children[bit]
+		}
+		current.data = testMMDBValue(record)
+	}
+	// number the nodes of the search tree breadth first
+	nodes := []*node{root}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].children {
+			if child != nil && child.data == nil {
+				nodes = append(nodes, child)
+			}
+		}
+	}
+	var data []byte
+	tree := make([]byte, 0, len(nodes)*6)
+	for _, n := range nodes {
+		for _, child := range n.children {
+			record := len(nodes)
+			switch {
+			case child == nil:
+			case child.data != nil:
+				record += 16 + len(data)
+				data = append(data, child.data...)
+			default:
+				record = slices.Index(nodes, child)
+			}
+			tree = append(tree, byte(record>>16), byte(record>>8), byte(record))
+		}
+	}
+	file := append(tree, make([]byte, 16)...)
+	file = append(file, data...)
+	file = append(file, "\xAB\xCD\xEFMaxMind.com"...)
+	file = append(file, testMMDBValue(map[string]any{
+		"binary_format_major_version": uint16(2),
+		"binary_format_minor_version": uint16(0),
+		"database_type":               databaseType,
+		"description":                 map[string]any{"en": "test database"},
+		"ip_version":                  uint16(6),
+		"node_count":                  uint32(len(nodes)),
+		"record_size":                 uint16(24),
+	})...)
+	path := filepath.Join(dir, databaseType+".mmdb")
+	require.NoError(t, os.WriteFile(path, file, 0600))
+	return path
+}
+
+// testMMDBValue encodes the value in the data section format, only the types used by the tests are supported
+func testMMDBValue(value any) []byte {
+	switch v := value.(type) {
+	case string:
+		return append([]byte{2<<5 | byte(len(v))}, v...)
+	case float64:
+		return binary.BigEndian.AppendUint64([]byte{3<<5 | 8}, math.Float64bits(v))
+	case uint16:
+		return binary.BigEndian.AppendUint16([]byte{5<<5 | 2}, v)
+	case uint32:
+		return binary.BigEndian.AppendUint32([]byte{6<<5 | 4}, v)
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		encoded := []byte{7<<5 | byte(len(v))}
+		for _, key := range keys {
+			encoded = append(encoded, testMMDBValue(key)...)
+			encoded = append(encoded, testMMDBValue(v[key])...)
+		}
+		return encoded
+	default:
+		panic("unsupported type")
+	}
+}
@@ -41,6 +41,20 @@ func (m *MockStorage) ExpectPutObjectError() *MockStorage {
 	return m
 }
 
+func (m *MockStorage) ExpectGetObject(data []byte) *MockStorage {
+	m.EXPECT().
+		GetObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(data, nil, nil)
+	return m
+}
+
+func (m *MockStorage) ExpectGetObjectError() *MockStorage {
+	m.EXPECT().
+		GetObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil, zerrors.ThrowInternal(nil, "", ""))
+	return m
+}
+
 func (m *MockStorage) ExpectRemoveObjectNoError() *MockStorage {
 	m.EXPECT().
 		RemoveObject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -61,3 +75,25 @@ func (m *MockStorage) ExpectRemoveObjectError() *MockStorage {
 		Return(zerrors.ThrowInternal(nil, "", ""))
 	return m
 }
+
+func (m *MockStorage) ExpectGetObjectInfo(size int64) *MockStorage {
+	m.EXPECT().
+		GetObjectInfo(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, instanceID, resourceOwner, name string) (*static.Asset, error) {
+			return &static.Asset{
+				InstanceID:    instanceID,
+				ResourceOwner: resourceOwner,
+				Name:          name,
+				Size:          size,
+				LastModified:  time.Now(),
+			}, nil
+		})
+	return m
+}
+
+func (m *MockStorage) ExpectGetObjectInfoError() *MockStorage {
+	m.EXPECT().
+		GetObjectInfo(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, zerrors.ThrowNotFound(nil, "", ""))
+	return m
+}
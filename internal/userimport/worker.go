@@ -0,0 +1,139 @@
+package userimport
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/call"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// WorkerCommands are the commands used by the Worker to process the jobs
+type WorkerCommands interface {
+	ProcessUserImportJob(ctx context.Context, resourceOwner, jobID string, batchSize uint16) error
+}
+
+// WorkerQueries are the queries used by the Worker to find the jobs to process
+type WorkerQueries interface {
+	ActiveInstances() []string
+	SearchUserImportJobs(ctx context.Context, queries *query.UserImportJobSearchQueries) (*query.UserImportJobs, error)
+}
+
+type WorkerConfig struct {
+	// CheckEvery is the interval in which queued jobs are searched, the worker is disabled if 0.
+	CheckEvery time.Duration
+	// BulkLimit is the maximum amount of jobs processed per instance and run.
+	BulkLimit uint16
+	// BatchSize is the amount of rows imported before the progress of the job is stored.
+	BatchSize uint16
+	// RestartAfter is the duration after which a running job without progress is picked up again,
+	// e.g. because the process handling it was stopped. Running jobs are not restarted if 0.
+	RestartAfter time.Duration
+}
+
+// nowFunc makes [time.Now] mockable
+type nowFunc func() time.Time
+
+// Worker processes the queued bulk imports of users.
+// The jobs of an instance are processed one after the other, in the order they were added.
+type Worker struct {
+	commands WorkerCommands
+	queries  WorkerQueries
+	config   WorkerConfig
+	now      nowFunc
+}
+
+func NewWorker(
+	config WorkerConfig,
+	commands WorkerCommands,
+	queries WorkerQueries,
+) *Worker {
+	if config.BulkLimit == 0 {
+		config.BulkLimit = 10
+	}
+	return &Worker{
+		commands: commands,
+		queries:  queries,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+func (w *Worker) Start(ctx context.Context) {
+	if w.config.CheckEvery <= 0 {
+		return
+	}
+	go w.schedule(ctx)
+}
+
+func (w *Worker) schedule(ctx context.Context) {
+	t := time.NewTimer(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			logging.Info("user import worker stopped")
+			return
+		case <-t.C:
+			for _, instance := range w.queries.ActiveInstances() {
+				err := w.trigger(authz.WithInstanceID(call.WithTimestamp(ctx), instance))
+				logging.WithFields("instance", instance).OnError(err).Info("user import failed")
+			}
+			t.Reset(w.config.CheckEvery)
+		}
+	}
+}
+
+// trigger processes the queued jobs and the running jobs, which didn't make any progress within RestartAfter.
+// A job, which can't be processed, doesn't prevent the others from being processed.
+func (w *Worker) trigger(ctx context.Context) error {
+	jobs, err := w.searchJobs(ctx, domain.UserImportJobStateQueued, time.Time{})
+	if err != nil {
+		return err
+	}
+	if w.config.RestartAfter > 0 {
+		stale, err := w.searchJobs(ctx, domain.UserImportJobStateRunning, w.now().Add(-w.config.RestartAfter))
+		if err != nil {
+			return err
+		}
+		jobs = append(stale, jobs...)
+	}
+	for _, job := range jobs {
+		err = w.commands.ProcessUserImportJob(ctx, job.ResourceOwner, job.ID, w.config.BatchSize)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "job", job.ID).OnError(err).Warn("user import job failed")
+	}
+	return nil
+}
+
+// searchJobs returns the oldest jobs in the state, which were not changed after changedBefore if set.
+func (w *Worker) searchJobs(ctx context.Context, state domain.UserImportJobState, changedBefore time.Time) ([]*query.UserImportJob, error) {
+	stateQuery, err := query.NewUserImportJobStateSearchQuery(state)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{stateQuery}
+	if !changedBefore.IsZero() {
+		changeDateQuery, err := query.NewUserImportJobChangeDateBeforeSearchQuery(changedBefore)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, changeDateQuery)
+	}
+	jobs, err := w.queries.SearchUserImportJobs(ctx, &query.UserImportJobSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Limit:         uint64(w.config.BulkLimit),
+			SortingColumn: query.UserImportJobColumnCreationDate,
+			Asc:           true,
+		},
+		Queries: queries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs.Jobs, nil
+}
@@ -0,0 +1,104 @@
+package userimport
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type mockWorkerCommands struct {
+	processed []string
+	err       error
+}
+
+func (m *mockWorkerCommands) ProcessUserImportJob(_ context.Context, resourceOwner, jobID string, batchSize uint16) error {
+	m.processed = append(m.processed, resourceOwner+"/"+jobID)
+	return m.err
+}
+
+type mockWorkerQueries struct {
+	queued   []*query.UserImportJob
+	running  []*query.UserImportJob
+	searches int
+}
+
+func (m *mockWorkerQueries) ActiveInstances() []string {
+	return []string{"instanceID"}
+}
+
+func (m *mockWorkerQueries) SearchUserImportJobs(_ context.Context, queries *query.UserImportJobSearchQueries) (*query.UserImportJobs, error) {
+	m.searches++
+	// the search for stale running jobs additionally filters the change date
+	if len(queries.Queries) == 2 {
+		return &query.UserImportJobs{Jobs: m.running}, nil
+	}
+	return &query.UserImportJobs{Jobs: m.queued}, nil
+}
+
+func TestWorker_trigger(t *testing.T) {
+	tests := []struct {
+		name          string
+		restartAfter  time.Duration
+		queries       *mockWorkerQueries
+		commandErr    error
+		wantProcessed []string
+		wantSearches  int
+	}{
+		{
+			name:         "no jobs",
+			queries:      &mockWorkerQueries{},
+			wantSearches: 1,
+		},
+		{
+			name: "queued jobs processed",
+			queries: &mockWorkerQueries{
+				queued: []*query.UserImportJob{
+					{ID: "job1", ResourceOwner: "org1"},
+					{ID: "job2", ResourceOwner: "org2"},
+				},
+			},
+			wantProcessed: []string{"org1/job1", "org2/job2"},
+			wantSearches:  1,
+		},
+		{
+			name: "failed job doesn't stop others",
+			queries: &mockWorkerQueries{
+				queued: []*query.UserImportJob{
+					{ID: "job1", ResourceOwner: "org1"},
+					{ID: "job2", ResourceOwner: "org1"},
+				},
+			},
+			commandErr:    errors.New("failed"),
+			wantProcessed: []string{"org1/job1", "org1/job2"},
+			wantSearches:  1,
+		},
+		{
+			name:         "stale running jobs restarted first",
+			restartAfter: time.Hour,
+			queries: &mockWorkerQueries{
+				queued: []*query.UserImportJob{
+					{ID: "job2", ResourceOwner: "org1"},
+				},
+				running: []*query.UserImportJob{
+					{ID: "job1", ResourceOwner: "org1"},
+				},
+			},
+			wantProcessed: []string{"org1/job1", "org1/job2"},
+			wantSearches:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &mockWorkerCommands{err: tt.commandErr}
+			w := NewWorker(WorkerConfig{RestartAfter: tt.restartAfter}, commands, tt.queries)
+			assert.NoError(t, w.trigger(context.Background()))
+			assert.Equal(t, tt.wantProcessed, commands.processed)
+			assert.Equal(t, tt.wantSearches, tt.queries.searches)
+		})
+	}
+}